@@ -26,6 +26,8 @@ package adminserviceclient
 import (
 	"context"
 	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"go.uber.org/thriftrw/wire"
 	"go.uber.org/yarpc"
@@ -36,17 +38,137 @@ import (
 
 // Interface is a client for the AdminService service.
 type Interface interface {
+	CheckReplicationConsistency(
+		ctx context.Context,
+		Request *admin.CheckReplicationConsistencyRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.CheckReplicationConsistencyResponse, error)
+
+	CloseShard(
+		ctx context.Context,
+		Request *history.CloseShardRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	DeleteDomain(
+		ctx context.Context,
+		Request *admin.DeleteDomainRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.DeleteDomainResponse, error)
+
 	DescribeHistoryHost(
 		ctx context.Context,
 		Request *shared.DescribeHistoryHostRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeHistoryHostResponse, error)
 
+	DescribeShard(
+		ctx context.Context,
+		Request *history.DescribeShardRequest,
+		opts ...yarpc.CallOption,
+	) (*history.DescribeShardResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		Request *admin.DescribeWorkflowExecutionRequest,
 		opts ...yarpc.CallOption,
 	) (*admin.DescribeWorkflowExecutionResponse, error)
+
+	DropQuarantinedTask(
+		ctx context.Context,
+		Request *history.DropQuarantinedTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	GetReplicationMessages(
+		ctx context.Context,
+		Request *replicator.GetReplicationMessagesRequest,
+		opts ...yarpc.CallOption,
+	) (*replicator.GetReplicationMessagesResponse, error)
+
+	GetReplicationStatus(
+		ctx context.Context,
+		Request *admin.GetReplicationStatusRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.GetReplicationStatusResponse, error)
+
+	GetWorkflowExecutionRawHistory(
+		ctx context.Context,
+		Request *shared.GetWorkflowExecutionRawHistoryRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.GetWorkflowExecutionRawHistoryResponse, error)
+
+	GetWorkflowReplicationTasks(
+		ctx context.Context,
+		Request *admin.GetWorkflowReplicationTasksRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.GetWorkflowReplicationTasksResponse, error)
+
+	GracefulFailoverDomain(
+		ctx context.Context,
+		Request *admin.GracefulFailoverDomainRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.GracefulFailoverDomainResponse, error)
+
+	ListQuarantinedTasks(
+		ctx context.Context,
+		Request *history.ListQuarantinedTasksRequest,
+		opts ...yarpc.CallOption,
+	) (*history.ListQuarantinedTasksResponse, error)
+
+	ListShardTasks(
+		ctx context.Context,
+		Request *history.ListShardTasksRequest,
+		opts ...yarpc.CallOption,
+	) (*history.ListShardTasksResponse, error)
+
+	MergeDLQMessages(
+		ctx context.Context,
+		Request *admin.MergeDLQMessagesRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.MergeDLQMessagesResponse, error)
+
+	MigrateWorkflowHistory(
+		ctx context.Context,
+		Request *admin.MigrateWorkflowHistoryRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.MigrateWorkflowHistoryResponse, error)
+
+	PurgeDLQMessages(
+		ctx context.Context,
+		Request *admin.PurgeDLQMessagesRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	ReadDLQMessages(
+		ctx context.Context,
+		Request *admin.ReadDLQMessagesRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.ReadDLQMessagesResponse, error)
+
+	RemoveShardTask(
+		ctx context.Context,
+		Request *history.RemoveShardTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	RescheduleShardTask(
+		ctx context.Context,
+		Request *history.RescheduleShardTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	ResendWorkflowHistory(
+		ctx context.Context,
+		Request *admin.ResendWorkflowHistoryRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	RetryQuarantinedTask(
+		ctx context.Context,
+		Request *history.RetryQuarantinedTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
 }
 
 // New builds a new client for the AdminService service.
@@ -73,6 +195,75 @@ type client struct {
 	c thrift.Client
 }
 
+func (c client) CheckReplicationConsistency(
+	ctx context.Context,
+	_Request *admin.CheckReplicationConsistencyRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.CheckReplicationConsistencyResponse, err error) {
+
+	args := admin.AdminService_CheckReplicationConsistency_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_CheckReplicationConsistency_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_CheckReplicationConsistency_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) CloseShard(
+	ctx context.Context,
+	_Request *history.CloseShardRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_CloseShard_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_CloseShard_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_CloseShard_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) DeleteDomain(
+	ctx context.Context,
+	_Request *admin.DeleteDomainRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.DeleteDomainResponse, err error) {
+
+	args := admin.AdminService_DeleteDomain_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_DeleteDomain_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_DeleteDomain_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeHistoryHost(
 	ctx context.Context,
 	_Request *shared.DescribeHistoryHostRequest,
@@ -96,6 +287,29 @@ func (c client) DescribeHistoryHost(
 	return
 }
 
+func (c client) DescribeShard(
+	ctx context.Context,
+	_Request *history.DescribeShardRequest,
+	opts ...yarpc.CallOption,
+) (success *history.DescribeShardResponse, err error) {
+
+	args := admin.AdminService_DescribeShard_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_DescribeShard_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_DescribeShard_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeWorkflowExecution(
 	ctx context.Context,
 	_Request *admin.DescribeWorkflowExecutionRequest,
@@ -118,3 +332,371 @@ func (c client) DescribeWorkflowExecution(
 	success, err = admin.AdminService_DescribeWorkflowExecution_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) DropQuarantinedTask(
+	ctx context.Context,
+	_Request *history.DropQuarantinedTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_DropQuarantinedTask_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_DropQuarantinedTask_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_DropQuarantinedTask_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) GetReplicationMessages(
+	ctx context.Context,
+	_Request *replicator.GetReplicationMessagesRequest,
+	opts ...yarpc.CallOption,
+) (success *replicator.GetReplicationMessagesResponse, err error) {
+
+	args := admin.AdminService_GetReplicationMessages_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_GetReplicationMessages_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_GetReplicationMessages_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) GetReplicationStatus(
+	ctx context.Context,
+	_Request *admin.GetReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.GetReplicationStatusResponse, err error) {
+
+	args := admin.AdminService_GetReplicationStatus_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_GetReplicationStatus_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_GetReplicationStatus_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) GetWorkflowExecutionRawHistory(
+	ctx context.Context,
+	_Request *shared.GetWorkflowExecutionRawHistoryRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetWorkflowExecutionRawHistoryResponse, err error) {
+
+	args := admin.AdminService_GetWorkflowExecutionRawHistory_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_GetWorkflowExecutionRawHistory_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_GetWorkflowExecutionRawHistory_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) GetWorkflowReplicationTasks(
+	ctx context.Context,
+	_Request *admin.GetWorkflowReplicationTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.GetWorkflowReplicationTasksResponse, err error) {
+
+	args := admin.AdminService_GetWorkflowReplicationTasks_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_GetWorkflowReplicationTasks_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_GetWorkflowReplicationTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) GracefulFailoverDomain(
+	ctx context.Context,
+	_Request *admin.GracefulFailoverDomainRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.GracefulFailoverDomainResponse, err error) {
+
+	args := admin.AdminService_GracefulFailoverDomain_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_GracefulFailoverDomain_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_GracefulFailoverDomain_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ListQuarantinedTasks(
+	ctx context.Context,
+	_Request *history.ListQuarantinedTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *history.ListQuarantinedTasksResponse, err error) {
+
+	args := admin.AdminService_ListQuarantinedTasks_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ListQuarantinedTasks_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_ListQuarantinedTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ListShardTasks(
+	ctx context.Context,
+	_Request *history.ListShardTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *history.ListShardTasksResponse, err error) {
+
+	args := admin.AdminService_ListShardTasks_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ListShardTasks_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_ListShardTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) MergeDLQMessages(
+	ctx context.Context,
+	_Request *admin.MergeDLQMessagesRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.MergeDLQMessagesResponse, err error) {
+
+	args := admin.AdminService_MergeDLQMessages_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_MergeDLQMessages_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_MergeDLQMessages_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) MigrateWorkflowHistory(
+	ctx context.Context,
+	_Request *admin.MigrateWorkflowHistoryRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.MigrateWorkflowHistoryResponse, err error) {
+
+	args := admin.AdminService_MigrateWorkflowHistory_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_MigrateWorkflowHistory_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_MigrateWorkflowHistory_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) PurgeDLQMessages(
+	ctx context.Context,
+	_Request *admin.PurgeDLQMessagesRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_PurgeDLQMessages_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_PurgeDLQMessages_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_PurgeDLQMessages_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ReadDLQMessages(
+	ctx context.Context,
+	_Request *admin.ReadDLQMessagesRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ReadDLQMessagesResponse, err error) {
+
+	args := admin.AdminService_ReadDLQMessages_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ReadDLQMessages_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_ReadDLQMessages_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) RemoveShardTask(
+	ctx context.Context,
+	_Request *history.RemoveShardTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_RemoveShardTask_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_RemoveShardTask_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_RemoveShardTask_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) RescheduleShardTask(
+	ctx context.Context,
+	_Request *history.RescheduleShardTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_RescheduleShardTask_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_RescheduleShardTask_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_RescheduleShardTask_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ResendWorkflowHistory(
+	ctx context.Context,
+	_Request *admin.ResendWorkflowHistoryRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_ResendWorkflowHistory_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ResendWorkflowHistory_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_ResendWorkflowHistory_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) RetryQuarantinedTask(
+	ctx context.Context,
+	_Request *history.RetryQuarantinedTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_RetryQuarantinedTask_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_RetryQuarantinedTask_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_RetryQuarantinedTask_Helper.UnwrapResponse(&result)
+	return
+}
@@ -26,6 +26,8 @@ package adminserviceserver
 import (
 	"context"
 	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"go.uber.org/thriftrw/wire"
 	"go.uber.org/yarpc/api/transport"
@@ -34,15 +36,115 @@ import (
 
 // Interface is the server-side interface for the AdminService service.
 type Interface interface {
+	CheckReplicationConsistency(
+		ctx context.Context,
+		Request *admin.CheckReplicationConsistencyRequest,
+	) (*admin.CheckReplicationConsistencyResponse, error)
+
+	CloseShard(
+		ctx context.Context,
+		Request *history.CloseShardRequest,
+	) error
+
+	DeleteDomain(
+		ctx context.Context,
+		Request *admin.DeleteDomainRequest,
+	) (*admin.DeleteDomainResponse, error)
+
 	DescribeHistoryHost(
 		ctx context.Context,
 		Request *shared.DescribeHistoryHostRequest,
 	) (*shared.DescribeHistoryHostResponse, error)
 
+	DescribeShard(
+		ctx context.Context,
+		Request *history.DescribeShardRequest,
+	) (*history.DescribeShardResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		Request *admin.DescribeWorkflowExecutionRequest,
 	) (*admin.DescribeWorkflowExecutionResponse, error)
+
+	DropQuarantinedTask(
+		ctx context.Context,
+		Request *history.DropQuarantinedTaskRequest,
+	) error
+
+	GetReplicationMessages(
+		ctx context.Context,
+		Request *replicator.GetReplicationMessagesRequest,
+	) (*replicator.GetReplicationMessagesResponse, error)
+
+	GetReplicationStatus(
+		ctx context.Context,
+		Request *admin.GetReplicationStatusRequest,
+	) (*admin.GetReplicationStatusResponse, error)
+
+	GetWorkflowExecutionRawHistory(
+		ctx context.Context,
+		Request *shared.GetWorkflowExecutionRawHistoryRequest,
+	) (*shared.GetWorkflowExecutionRawHistoryResponse, error)
+
+	GetWorkflowReplicationTasks(
+		ctx context.Context,
+		Request *admin.GetWorkflowReplicationTasksRequest,
+	) (*admin.GetWorkflowReplicationTasksResponse, error)
+
+	GracefulFailoverDomain(
+		ctx context.Context,
+		Request *admin.GracefulFailoverDomainRequest,
+	) (*admin.GracefulFailoverDomainResponse, error)
+
+	ListQuarantinedTasks(
+		ctx context.Context,
+		Request *history.ListQuarantinedTasksRequest,
+	) (*history.ListQuarantinedTasksResponse, error)
+
+	ListShardTasks(
+		ctx context.Context,
+		Request *history.ListShardTasksRequest,
+	) (*history.ListShardTasksResponse, error)
+
+	MergeDLQMessages(
+		ctx context.Context,
+		Request *admin.MergeDLQMessagesRequest,
+	) (*admin.MergeDLQMessagesResponse, error)
+
+	MigrateWorkflowHistory(
+		ctx context.Context,
+		Request *admin.MigrateWorkflowHistoryRequest,
+	) (*admin.MigrateWorkflowHistoryResponse, error)
+
+	PurgeDLQMessages(
+		ctx context.Context,
+		Request *admin.PurgeDLQMessagesRequest,
+	) error
+
+	ReadDLQMessages(
+		ctx context.Context,
+		Request *admin.ReadDLQMessagesRequest,
+	) (*admin.ReadDLQMessagesResponse, error)
+
+	RemoveShardTask(
+		ctx context.Context,
+		Request *history.RemoveShardTaskRequest,
+	) error
+
+	RescheduleShardTask(
+		ctx context.Context,
+		Request *history.RescheduleShardTaskRequest,
+	) error
+
+	ResendWorkflowHistory(
+		ctx context.Context,
+		Request *admin.ResendWorkflowHistoryRequest,
+	) error
+
+	RetryQuarantinedTask(
+		ctx context.Context,
+		Request *history.RetryQuarantinedTaskRequest,
+	) error
 }
 
 // New prepares an implementation of the AdminService service for
@@ -56,6 +158,39 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		Name: "AdminService",
 		Methods: []thrift.Method{
 
+			thrift.Method{
+				Name: "CheckReplicationConsistency",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.CheckReplicationConsistency),
+				},
+				Signature:    "CheckReplicationConsistency(Request *admin.CheckReplicationConsistencyRequest) (*admin.CheckReplicationConsistencyResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "CloseShard",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.CloseShard),
+				},
+				Signature:    "CloseShard(Request *history.CloseShardRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "DeleteDomain",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DeleteDomain),
+				},
+				Signature:    "DeleteDomain(Request *admin.DeleteDomainRequest) (*admin.DeleteDomainResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeHistoryHost",
 				HandlerSpec: thrift.HandlerSpec{
@@ -67,6 +202,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DescribeShard",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DescribeShard),
+				},
+				Signature:    "DescribeShard(Request *history.DescribeShardRequest) (*history.DescribeShardResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeWorkflowExecution",
 				HandlerSpec: thrift.HandlerSpec{
@@ -77,16 +223,249 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "DescribeWorkflowExecution(Request *admin.DescribeWorkflowExecutionRequest) (*admin.DescribeWorkflowExecutionResponse)",
 				ThriftModule: admin.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "DropQuarantinedTask",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DropQuarantinedTask),
+				},
+				Signature:    "DropQuarantinedTask(Request *history.DropQuarantinedTaskRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "GetReplicationMessages",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.GetReplicationMessages),
+				},
+				Signature:    "GetReplicationMessages(Request *replicator.GetReplicationMessagesRequest) (*replicator.GetReplicationMessagesResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "GetReplicationStatus",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.GetReplicationStatus),
+				},
+				Signature:    "GetReplicationStatus(Request *admin.GetReplicationStatusRequest) (*admin.GetReplicationStatusResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "GetWorkflowExecutionRawHistory",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.GetWorkflowExecutionRawHistory),
+				},
+				Signature:    "GetWorkflowExecutionRawHistory(Request *shared.GetWorkflowExecutionRawHistoryRequest) (*shared.GetWorkflowExecutionRawHistoryResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "GetWorkflowReplicationTasks",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.GetWorkflowReplicationTasks),
+				},
+				Signature:    "GetWorkflowReplicationTasks(Request *admin.GetWorkflowReplicationTasksRequest) (*admin.GetWorkflowReplicationTasksResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "GracefulFailoverDomain",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.GracefulFailoverDomain),
+				},
+				Signature:    "GracefulFailoverDomain(Request *admin.GracefulFailoverDomainRequest) (*admin.GracefulFailoverDomainResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ListQuarantinedTasks",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ListQuarantinedTasks),
+				},
+				Signature:    "ListQuarantinedTasks(Request *history.ListQuarantinedTasksRequest) (*history.ListQuarantinedTasksResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ListShardTasks",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ListShardTasks),
+				},
+				Signature:    "ListShardTasks(Request *history.ListShardTasksRequest) (*history.ListShardTasksResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "MergeDLQMessages",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.MergeDLQMessages),
+				},
+				Signature:    "MergeDLQMessages(Request *admin.MergeDLQMessagesRequest) (*admin.MergeDLQMessagesResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "MigrateWorkflowHistory",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.MigrateWorkflowHistory),
+				},
+				Signature:    "MigrateWorkflowHistory(Request *admin.MigrateWorkflowHistoryRequest) (*admin.MigrateWorkflowHistoryResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "PurgeDLQMessages",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.PurgeDLQMessages),
+				},
+				Signature:    "PurgeDLQMessages(Request *admin.PurgeDLQMessagesRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ReadDLQMessages",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ReadDLQMessages),
+				},
+				Signature:    "ReadDLQMessages(Request *admin.ReadDLQMessagesRequest) (*admin.ReadDLQMessagesResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "RemoveShardTask",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.RemoveShardTask),
+				},
+				Signature:    "RemoveShardTask(Request *history.RemoveShardTaskRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "RescheduleShardTask",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.RescheduleShardTask),
+				},
+				Signature:    "RescheduleShardTask(Request *history.RescheduleShardTaskRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ResendWorkflowHistory",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ResendWorkflowHistory),
+				},
+				Signature:    "ResendWorkflowHistory(Request *admin.ResendWorkflowHistoryRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "RetryQuarantinedTask",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.RetryQuarantinedTask),
+				},
+				Signature:    "RetryQuarantinedTask(Request *history.RetryQuarantinedTaskRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 2)
+	procedures := make([]transport.Procedure, 0, 22)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
 
 type handler struct{ impl Interface }
 
+func (h handler) CheckReplicationConsistency(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_CheckReplicationConsistency_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.CheckReplicationConsistency(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_CheckReplicationConsistency_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) CloseShard(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_CloseShard_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.CloseShard(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_CloseShard_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) DeleteDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DeleteDomain_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.DeleteDomain(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_DeleteDomain_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) DescribeHistoryHost(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_DescribeHistoryHost_Args
 	if err := args.FromWire(body); err != nil {
@@ -106,6 +485,25 @@ func (h handler) DescribeHistoryHost(ctx context.Context, body wire.Value) (thri
 	return response, err
 }
 
+func (h handler) DescribeShard(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DescribeShard_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.DescribeShard(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_DescribeShard_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) DescribeWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_DescribeWorkflowExecution_Args
 	if err := args.FromWire(body); err != nil {
@@ -124,3 +522,307 @@ func (h handler) DescribeWorkflowExecution(ctx context.Context, body wire.Value)
 	}
 	return response, err
 }
+
+func (h handler) DropQuarantinedTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DropQuarantinedTask_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.DropQuarantinedTask(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_DropQuarantinedTask_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) GetReplicationMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GetReplicationMessages_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.GetReplicationMessages(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_GetReplicationMessages_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) GetReplicationStatus(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GetReplicationStatus_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.GetReplicationStatus(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_GetReplicationStatus_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) GetWorkflowExecutionRawHistory(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GetWorkflowExecutionRawHistory_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.GetWorkflowExecutionRawHistory(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_GetWorkflowExecutionRawHistory_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) GetWorkflowReplicationTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GetWorkflowReplicationTasks_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.GetWorkflowReplicationTasks(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_GetWorkflowReplicationTasks_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) GracefulFailoverDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GracefulFailoverDomain_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.GracefulFailoverDomain(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_GracefulFailoverDomain_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) ListQuarantinedTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ListQuarantinedTasks_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.ListQuarantinedTasks(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_ListQuarantinedTasks_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) ListShardTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ListShardTasks_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.ListShardTasks(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_ListShardTasks_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) MergeDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_MergeDLQMessages_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.MergeDLQMessages(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_MergeDLQMessages_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) MigrateWorkflowHistory(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_MigrateWorkflowHistory_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.MigrateWorkflowHistory(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_MigrateWorkflowHistory_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) PurgeDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_PurgeDLQMessages_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.PurgeDLQMessages(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_PurgeDLQMessages_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) ReadDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ReadDLQMessages_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.ReadDLQMessages(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_ReadDLQMessages_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) RemoveShardTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RemoveShardTask_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.RemoveShardTask(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_RemoveShardTask_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) RescheduleShardTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RescheduleShardTask_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.RescheduleShardTask(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_RescheduleShardTask_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) ResendWorkflowHistory(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ResendWorkflowHistory_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.ResendWorkflowHistory(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_ResendWorkflowHistory_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) RetryQuarantinedTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RetryQuarantinedTask_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.RetryQuarantinedTask(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_RetryQuarantinedTask_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
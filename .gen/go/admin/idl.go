@@ -24,6 +24,8 @@
 package admin
 
 import (
+	"github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"go.uber.org/thriftrw/thriftreflect"
 )
@@ -33,11 +35,13 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "admin",
 	Package:  "github.com/uber/cadence/.gen/go/admin",
 	FilePath: "admin.thrift",
-	SHA1:     "bb61fbdf93f8f77bd9957ecfcacf5508cedfe81d",
+	SHA1:     "bfffe93557c0afb236370decd4b88b140612a1b5",
 	Includes: []*thriftreflect.ThriftModule{
+		history.ThriftModule,
+		replicator.ThriftModule,
 		shared.ThriftModule,
 	},
 	Raw: rawIDL,
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.admin\n\ninclude \"shared.thrift\"\n\n/**\n* AdminService provides advanced APIs for debugging and analysis with admin privillege\n**/\nservice AdminService {\n  /**\n  * DescribeWorkflowExecution returns information about the internal states of workflow execution.\n  **/\n  DescribeWorkflowExecutionResponse DescribeWorkflowExecution(1: DescribeWorkflowExecutionRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.EntityNotExistsError    entityNotExistError,\n      4: shared.AccessDeniedError       accessDeniedError,\n    )\n\n  /**\n    * DescribeHistoryHost returns information about the internal states of a history host\n    **/\n    shared.DescribeHistoryHostResponse DescribeHistoryHost(1: shared.DescribeHistoryHostRequest request)\n      throws (\n        1: shared.BadRequestError       badRequestError,\n        2: shared.InternalServiceError  internalServiceError,\n        3: shared.AccessDeniedError     accessDeniedError,\n      )\n}\n\nstruct DescribeWorkflowExecutionRequest {\n  10: optional string                       domain\n  20: optional shared.WorkflowExecution     execution\n}\n\nstruct DescribeWorkflowExecutionResponse{\n  10: optional string shardId\n  20: optional string historyAddr\n  40: optional string mutableStateInCache\n  50: optional string mutableStateInDatabase\n}"
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.admin\n\ninclude \"shared.thrift\"\ninclude \"replicator.thrift\"\ninclude \"history.thrift\"\n\n/**\n* AdminService provides advanced APIs for debugging and analysis with admin privillege\n**/\nservice AdminService {\n  /**\n  * DescribeWorkflowExecution returns information about the internal states of workflow execution.\n  **/\n  DescribeWorkflowExecutionResponse DescribeWorkflowExecution(1: DescribeWorkflowExecutionRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.EntityNotExistsError    entityNotExistError,\n      4: shared.AccessDeniedError       accessDeniedError,\n    )\n\n  /**\n    * DescribeHistoryHost returns information about the internal states of a history host\n    **/\n    shared.DescribeHistoryHostResponse DescribeHistoryHost(1: shared.DescribeHistoryHostRequest request)\n      throws (\n        1: shared.BadRequestError       badRequestError,\n        2: shared.InternalServiceError  internalServiceError,\n        3: shared.AccessDeniedError     accessDeniedError,\n      )\n\n  /**\n  * MigrateWorkflowHistory copies the history of a workflow execution from the deprecated history\n  * tables into a new history tree and switches the workflow execution to the new event store version.\n  **/\n  MigrateWorkflowHistoryResponse MigrateWorkflowHistory(1: MigrateWorkflowHistoryRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.EntityNotExistsError    entityNotExistError,\n      4: shared.ServiceBusyError        serviceBusyError,\n      5: shared.AccessDeniedError       accessDeniedError,\n    )\n\n  /**\n  * GetReplicationMessages returns new replication tasks since the read level provided in the token.\n  * It is used by remote clusters which pull replication tasks over rpc instead of consuming them from kafka.\n  **/\n  replicator.GetReplicationMessagesResponse GetReplicationMessages(1: replicator.GetReplicationMessagesRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.LimitExceededError      limitExceededError,\n      4: shared.ServiceBusyError        serviceBusyError,\n    )\n\n  /**\n  * GracefulFailoverDomain starts a graceful failover of a global domain to another cluster. The current active\n  * cluster stops accepting new writes for the domain and hands the domain over once the target cluster has caught\n  * up on replication, or once the failover timeout expires.\n  **/\n  GracefulFailoverDomainResponse GracefulFailoverDomain(1: GracefulFailoverDomainRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.EntityNotExistsError    entityNotExistError,\n      4: shared.ServiceBusyError        serviceBusyError,\n    )\n\n  /**\n  * DeleteDomain deletes a deprecated domain which has no open workflow executions. The domain status is set to\n  * DELETED and a system workflow is started to remove the executions, histories, task lists and visibility records\n  * of the domain, and finally the domain metadata, which frees the domain name.\n  **/\n  DeleteDomainResponse DeleteDomain(1: DeleteDomainRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.EntityNotExistsError    entityNotExistError,\n      4: shared.ServiceBusyError        serviceBusyError,\n    )\n\n  /**\n  * GetReplicationStatus returns the replication status of the current cluster against each remote cluster,\n  * aggregated over all the history shards and optionally narrowed down to a domain.\n  **/\n  GetReplicationStatusResponse GetReplicationStatus(1: GetReplicationStatusRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.EntityNotExistsError    entityNotExistError,\n      4: shared.ServiceBusyError        serviceBusyError,\n    )\n\n  /**\n  * GetWorkflowExecutionRawHistory returns the history of a workflow execution as the blobs stored by persistence,\n  * without deserializing them.\n  **/\n  shared.GetWorkflowExecutionRawHistoryResponse GetWorkflowExecutionRawHistory(1: shared.GetWorkflowExecutionRawHistoryRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.EntityNotExistsError    entityNotExistError,\n      4: shared.ServiceBusyError        serviceBusyError,\n    )\n\n  /**\n  * GetWorkflowReplicationTasks returns the history replication tasks of a range of events of a workflow,\n  * it is called by the remote clusters to fetch the history events they are missing.\n  **/\n  GetWorkflowReplicationTasksResponse GetWorkflowReplicationTasks(1: GetWorkflowReplicationTasksRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.EntityNotExistsError    entityNotExistError,\n      4: shared.ServiceBusyError        serviceBusyError,\n    )\n\n  /**\n  * ResendWorkflowHistory fetches a range of history events of a workflow from the source cluster\n  * and applies them to the current cluster.\n  **/\n  void ResendWorkflowHistory(1: ResendWorkflowHistoryRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.EntityNotExistsError    entityNotExistError,\n      4: shared.ServiceBusyError        serviceBusyError,\n    )\n\n  /**\n  * CheckReplicationConsistency compares the mutable state of a workflow execution in the current cluster\n  * with the one in a remote cluster, and optionally resends the missing history to the cluster behind.\n  **/\n  CheckReplicationConsistencyResponse CheckReplicationConsistency(1: CheckReplicationConsistencyRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.EntityNotExistsError    entityNotExistError,\n      4: shared.ServiceBusyError        serviceBusyError,\n    )\n\n  /**\n  * ReadDLQMessages returns replication tasks from the replication dead letter queue of a shard,\n  * which failed to be applied after all retries.\n  **/\n  ReadDLQMessagesResponse ReadDLQMessages(1: ReadDLQMessagesRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.ServiceBusyError        serviceBusyError,\n    )\n\n  /**\n  * PurgeDLQMessages deletes replication tasks up to and including the given message id\n  * from the replication dead letter queue of a shard.\n  **/\n  void PurgeDLQMessages(1: PurgeDLQMessagesRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.ServiceBusyError        serviceBusyError,\n    )\n\n  /**\n  * MergeDLQMessages re-applies a page of replication tasks from the replication dead letter queue of a shard\n  * and deletes them from the queue once they are applied.\n  **/\n  MergeDLQMessagesResponse MergeDLQMessages(1: MergeDLQMessagesRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.ServiceBusyError        serviceBusyError,\n    )\n\n  /**\n  * DescribeShard returns the ack levels, read levels, outstanding task counts and failover levels\n  * of the transfer, timer and replication queues of a shard.\n  **/\n  history.DescribeShardResponse DescribeShard(1: history.DescribeShardRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.ServiceBusyError        serviceBusyError,\n    )\n\n  /**\n  * ListShardTasks returns the pending tasks of a queue of a shard, starting from the queue ack level.\n  **/\n  history.ListShardTasksResponse ListShardTasks(1: history.ListShardTasksRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.ServiceBusyError        serviceBusyError,\n    )\n\n  /**\n  * RemoveShardTask force completes a transfer or timer task of a shard without processing it.\n  **/\n  void RemoveShardTask(1: history.RemoveShardTaskRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.EntityNotExistsError    entityNotExistError,\n      4: shared.ServiceBusyError        serviceBusyError,\n    )\n\n  /**\n  * RescheduleShardTask processes a transfer or timer task of a shard immediately,\n  * and acknowledges it if the processing succeeds.\n  **/\n  void RescheduleShardTask(1: history.RescheduleShardTaskRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.EntityNotExistsError    entityNotExistError,\n      4: shared.ServiceBusyError        serviceBusyError,\n    )\n\n  /**\n  * ListQuarantinedTasks returns the transfer or timer tasks of a shard which were moved to the quarantine\n  * after failing with non-transient errors.\n  **/\n  history.ListQuarantinedTasksResponse ListQuarantinedTasks(1: history.ListQuarantinedTasksRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.ServiceBusyError        serviceBusyError,\n    )\n\n  /**\n  * RetryQuarantinedTask processes a quarantined task immediately, and removes it from the quarantine\n  * if the processing succeeds.\n  **/\n  void RetryQuarantinedTask(1: history.RetryQuarantinedTaskRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.EntityNotExistsError    entityNotExistError,\n      4: shared.ServiceBusyError        serviceBusyError,\n    )\n\n  /**\n  * DropQuarantinedTask removes a quarantined task without processing it.\n  **/\n  void DropQuarantinedTask(1: history.DropQuarantinedTaskRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.ServiceBusyError        serviceBusyError,\n    )\n\n  /**\n  * CloseShard closes a shard on its current owner, so that the shard is reacquired by the host\n  * which owns it according to the membership ring, the drained hosts and the shard ownership overrides.\n  **/\n  void CloseShard(1: history.CloseShardRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.ServiceBusyError        serviceBusyError,\n    )\n}\n\nstruct DescribeWorkflowExecutionRequest {\n  10: optional string                       domain\n  20: optional shared.WorkflowExecution     execution\n}\n\nstruct DescribeWorkflowExecutionResponse{\n  10: optional string shardId\n  20: optional string historyAddr\n  40: optional string mutableStateInCache\n  50: optional string mutableStateInDatabase\n}\n\nstruct MigrateWorkflowHistoryRequest {\n  10: optional string                       domain\n  20: optional shared.WorkflowExecution     execution\n  30: optional bool                         dryRun\n}\n\nstruct MigrateWorkflowHistoryResponse {\n  10: optional bool migrated\n  20: optional i64 (js.type = \"Long\") historyBatchCount\n  30: optional i64 (js.type = \"Long\") historyEventCount\n  40: optional i64 (js.type = \"Long\") historySize\n}\n\nstruct GracefulFailoverDomainRequest {\n  10: optional string domain\n  20: optional string activeClusterName\n  30: optional i32 failoverTimeoutInSeconds\n}\n\nstruct GracefulFailoverDomainResponse {\n  10: optional shared.DomainFailoverInfo failoverInfo\n}\n\nstruct DeleteDomainRequest {\n  10: optional string domain\n}\n\nstruct DeleteDomainResponse {\n  // the system workflow deleting the domain, it can be queried for the deletion progress\n  10: optional string workflowId\n  20: optional string runId\n}\n\nstruct GetReplicationStatusRequest {\n  10: optional string domain\n}\n\nstruct ClusterReplicationStatus {\n  // replication tasks of the current cluster not yet processed by the remote cluster, the count is a\n  // lower bound if some of the shards have more pending tasks than scanned for the status\n  10: optional i64 (js.type = \"Long\") pendingTaskCount\n}\n\nstruct GetReplicationStatusResponse {\n  10: optional string currentClusterName\n  20: optional map<string, ClusterReplicationStatus> clusters\n  // shards not reporting their status, e.g. during shard movement\n  30: optional list<i32> missingShardIDs\n}\n\nstruct GetWorkflowReplicationTasksRequest {\n  10: optional string                   domainUUID\n  20: optional shared.WorkflowExecution execution\n  30: optional i64 (js.type = \"Long\")    firstEventId\n  40: optional i64 (js.type = \"Long\")    nextEventId\n}\n\nstruct GetWorkflowReplicationTasksResponse {\n  10: optional list<replicator.ReplicationTask> replicationTasks\n}\n\nstruct ResendWorkflowHistoryRequest {\n  10: optional string                   domain\n  20: optional shared.WorkflowExecution execution\n  30: optional string                   sourceCluster\n  40: optional i64 (js.type = \"Long\")    firstEventId\n  50: optional i64 (js.type = \"Long\")    nextEventId\n}\n\nstruct CheckReplicationConsistencyRequest {\n  10: optional string                   domain\n  20: optional shared.WorkflowExecution execution\n  30: optional string                   remoteCluster\n  // resend the missing history events to the cluster behind if the next event IDs do not match\n  40: optional bool                     resendOnMismatch\n}\n\nstruct MutableStateDiff {\n  10: optional string field\n  20: optional string currentCluster\n  30: optional string remoteCluster\n}\n\nstruct CheckReplicationConsistencyResponse {\n  10: optional list<MutableStateDiff> diffs\n  20: optional bool                   resent\n}\n\nstruct ReplicationDLQMessage {\n  10: optional i64 (js.type = \"Long\") messageId\n  20: optional i64 (js.type = \"Long\") createdTime\n  30: optional replicator.ReplicationTask replicationTask\n}\n\nstruct ReadDLQMessagesRequest {\n  10: optional string sourceCluster\n  20: optional i32 shardID\n  30: optional i64 (js.type = \"Long\") inclusiveEndMessageID\n  40: optional i32 maximumPageSize\n  50: optional binary nextPageToken\n}\n\nstruct ReadDLQMessagesResponse {\n  10: optional list<ReplicationDLQMessage> messages\n  20: optional binary nextPageToken\n}\n\nstruct PurgeDLQMessagesRequest {\n  10: optional string sourceCluster\n  20: optional i32 shardID\n  30: optional i64 (js.type = \"Long\") inclusiveEndMessageID\n}\n\nstruct MergeDLQMessagesRequest {\n  10: optional string sourceCluster\n  20: optional i32 shardID\n  30: optional i64 (js.type = \"Long\") inclusiveEndMessageID\n  40: optional i32 maximumPageSize\n  50: optional binary nextPageToken\n}\n\nstruct MergeDLQMessagesResponse {\n  10: optional binary nextPageToken\n}\n"
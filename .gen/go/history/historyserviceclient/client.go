@@ -26,6 +26,7 @@ package historyserviceclient
 import (
 	"context"
 	"github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"go.uber.org/thriftrw/wire"
 	"go.uber.org/yarpc"
@@ -36,6 +37,24 @@ import (
 
 // Interface is a client for the HistoryService service.
 type Interface interface {
+	CloseShard(
+		ctx context.Context,
+		Request *history.CloseShardRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	CompleteActivityTask(
+		ctx context.Context,
+		CompleteRequest *history.CompleteActivityTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	DeleteWorkflowExecution(
+		ctx context.Context,
+		DeleteRequest *history.DeleteWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) (*history.DeleteWorkflowExecutionResponse, error)
+
 	DescribeHistoryHost(
 		ctx context.Context,
 		Request *shared.DescribeHistoryHostRequest,
@@ -48,18 +67,78 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*history.DescribeMutableStateResponse, error)
 
+	DescribeShard(
+		ctx context.Context,
+		Request *history.DescribeShardRequest,
+		opts ...yarpc.CallOption,
+	) (*history.DescribeShardResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		DescribeRequest *history.DescribeWorkflowExecutionRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeWorkflowExecutionResponse, error)
 
+	DropQuarantinedTask(
+		ctx context.Context,
+		Request *history.DropQuarantinedTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	FailActivityTask(
+		ctx context.Context,
+		FailRequest *history.FailActivityTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	GetMutableState(
 		ctx context.Context,
 		GetRequest *history.GetMutableStateRequest,
 		opts ...yarpc.CallOption,
 	) (*history.GetMutableStateResponse, error)
 
+	GetReplicationMessages(
+		ctx context.Context,
+		Request *replicator.GetReplicationMessagesRequest,
+		opts ...yarpc.CallOption,
+	) (*replicator.GetReplicationMessagesResponse, error)
+
+	GetReplicationStatus(
+		ctx context.Context,
+		Request *history.GetReplicationStatusRequest,
+		opts ...yarpc.CallOption,
+	) (*history.GetReplicationStatusResponse, error)
+
+	GetWorkflowReplicationTasks(
+		ctx context.Context,
+		Request *history.GetWorkflowReplicationTasksRequest,
+		opts ...yarpc.CallOption,
+	) (*history.GetWorkflowReplicationTasksResponse, error)
+
+	ListQuarantinedTasks(
+		ctx context.Context,
+		Request *history.ListQuarantinedTasksRequest,
+		opts ...yarpc.CallOption,
+	) (*history.ListQuarantinedTasksResponse, error)
+
+	ListShardTasks(
+		ctx context.Context,
+		Request *history.ListShardTasksRequest,
+		opts ...yarpc.CallOption,
+	) (*history.ListShardTasksResponse, error)
+
+	MigrateWorkflowHistory(
+		ctx context.Context,
+		MigrateRequest *history.MigrateWorkflowHistoryRequest,
+		opts ...yarpc.CallOption,
+	) (*history.MigrateWorkflowHistoryResponse, error)
+
+	PauseWorkflowExecution(
+		ctx context.Context,
+		PauseRequest *history.PauseWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RecordActivityTaskHeartbeat(
 		ctx context.Context,
 		HeartbeatRequest *history.RecordActivityTaskHeartbeatRequest,
@@ -84,6 +163,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*history.RecordDecisionTaskStartedResponse, error)
 
+	RemoveShardTask(
+		ctx context.Context,
+		Request *history.RemoveShardTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RemoveSignalMutableState(
 		ctx context.Context,
 		RemoveRequest *history.RemoveSignalMutableStateRequest,
@@ -102,6 +187,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	RescheduleShardTask(
+		ctx context.Context,
+		Request *history.RescheduleShardTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	ResetStickyTaskList(
 		ctx context.Context,
 		ResetRequest *history.ResetStickyTaskListRequest,
@@ -138,6 +229,24 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	ResumeWorkflowExecution(
+		ctx context.Context,
+		ResumeRequest *history.ResumeWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	RetryActivityTask(
+		ctx context.Context,
+		RetryRequest *history.RetryActivityTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	RetryQuarantinedTask(
+		ctx context.Context,
+		Request *history.RetryQuarantinedTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	ScheduleDecisionTask(
 		ctx context.Context,
 		ScheduleRequest *history.ScheduleDecisionTaskRequest,
@@ -205,6 +314,75 @@ type client struct {
 	c thrift.Client
 }
 
+func (c client) CloseShard(
+	ctx context.Context,
+	_Request *history.CloseShardRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_CloseShard_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_CloseShard_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_CloseShard_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) CompleteActivityTask(
+	ctx context.Context,
+	_CompleteRequest *history.CompleteActivityTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_CompleteActivityTask_Helper.Args(_CompleteRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_CompleteActivityTask_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_CompleteActivityTask_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) DeleteWorkflowExecution(
+	ctx context.Context,
+	_DeleteRequest *history.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *history.DeleteWorkflowExecutionResponse, err error) {
+
+	args := history.HistoryService_DeleteWorkflowExecution_Helper.Args(_DeleteRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_DeleteWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_DeleteWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeHistoryHost(
 	ctx context.Context,
 	_Request *shared.DescribeHistoryHostRequest,
@@ -251,6 +429,29 @@ func (c client) DescribeMutableState(
 	return
 }
 
+func (c client) DescribeShard(
+	ctx context.Context,
+	_Request *history.DescribeShardRequest,
+	opts ...yarpc.CallOption,
+) (success *history.DescribeShardResponse, err error) {
+
+	args := history.HistoryService_DescribeShard_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_DescribeShard_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_DescribeShard_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeWorkflowExecution(
 	ctx context.Context,
 	_DescribeRequest *history.DescribeWorkflowExecutionRequest,
@@ -274,6 +475,52 @@ func (c client) DescribeWorkflowExecution(
 	return
 }
 
+func (c client) DropQuarantinedTask(
+	ctx context.Context,
+	_Request *history.DropQuarantinedTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_DropQuarantinedTask_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_DropQuarantinedTask_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_DropQuarantinedTask_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) FailActivityTask(
+	ctx context.Context,
+	_FailRequest *history.FailActivityTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_FailActivityTask_Helper.Args(_FailRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_FailActivityTask_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_FailActivityTask_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetMutableState(
 	ctx context.Context,
 	_GetRequest *history.GetMutableStateRequest,
@@ -297,6 +544,167 @@ func (c client) GetMutableState(
 	return
 }
 
+func (c client) GetReplicationMessages(
+	ctx context.Context,
+	_Request *replicator.GetReplicationMessagesRequest,
+	opts ...yarpc.CallOption,
+) (success *replicator.GetReplicationMessagesResponse, err error) {
+
+	args := history.HistoryService_GetReplicationMessages_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_GetReplicationMessages_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_GetReplicationMessages_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) GetReplicationStatus(
+	ctx context.Context,
+	_Request *history.GetReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (success *history.GetReplicationStatusResponse, err error) {
+
+	args := history.HistoryService_GetReplicationStatus_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_GetReplicationStatus_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_GetReplicationStatus_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) GetWorkflowReplicationTasks(
+	ctx context.Context,
+	_Request *history.GetWorkflowReplicationTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *history.GetWorkflowReplicationTasksResponse, err error) {
+
+	args := history.HistoryService_GetWorkflowReplicationTasks_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_GetWorkflowReplicationTasks_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_GetWorkflowReplicationTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ListQuarantinedTasks(
+	ctx context.Context,
+	_Request *history.ListQuarantinedTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *history.ListQuarantinedTasksResponse, err error) {
+
+	args := history.HistoryService_ListQuarantinedTasks_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_ListQuarantinedTasks_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_ListQuarantinedTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ListShardTasks(
+	ctx context.Context,
+	_Request *history.ListShardTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *history.ListShardTasksResponse, err error) {
+
+	args := history.HistoryService_ListShardTasks_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_ListShardTasks_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_ListShardTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) MigrateWorkflowHistory(
+	ctx context.Context,
+	_MigrateRequest *history.MigrateWorkflowHistoryRequest,
+	opts ...yarpc.CallOption,
+) (success *history.MigrateWorkflowHistoryResponse, err error) {
+
+	args := history.HistoryService_MigrateWorkflowHistory_Helper.Args(_MigrateRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_MigrateWorkflowHistory_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_MigrateWorkflowHistory_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) PauseWorkflowExecution(
+	ctx context.Context,
+	_PauseRequest *history.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_PauseWorkflowExecution_Helper.Args(_PauseRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_PauseWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_PauseWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RecordActivityTaskHeartbeat(
 	ctx context.Context,
 	_HeartbeatRequest *history.RecordActivityTaskHeartbeatRequest,
@@ -389,6 +797,29 @@ func (c client) RecordDecisionTaskStarted(
 	return
 }
 
+func (c client) RemoveShardTask(
+	ctx context.Context,
+	_Request *history.RemoveShardTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_RemoveShardTask_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_RemoveShardTask_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_RemoveShardTask_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RemoveSignalMutableState(
 	ctx context.Context,
 	_RemoveRequest *history.RemoveSignalMutableStateRequest,
@@ -458,6 +889,29 @@ func (c client) RequestCancelWorkflowExecution(
 	return
 }
 
+func (c client) RescheduleShardTask(
+	ctx context.Context,
+	_Request *history.RescheduleShardTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_RescheduleShardTask_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_RescheduleShardTask_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_RescheduleShardTask_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ResetStickyTaskList(
 	ctx context.Context,
 	_ResetRequest *history.ResetStickyTaskListRequest,
@@ -596,6 +1050,75 @@ func (c client) RespondDecisionTaskFailed(
 	return
 }
 
+func (c client) ResumeWorkflowExecution(
+	ctx context.Context,
+	_ResumeRequest *history.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_ResumeWorkflowExecution_Helper.Args(_ResumeRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_ResumeWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_ResumeWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) RetryActivityTask(
+	ctx context.Context,
+	_RetryRequest *history.RetryActivityTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_RetryActivityTask_Helper.Args(_RetryRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_RetryActivityTask_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_RetryActivityTask_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) RetryQuarantinedTask(
+	ctx context.Context,
+	_Request *history.RetryQuarantinedTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_RetryQuarantinedTask_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_RetryQuarantinedTask_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_RetryQuarantinedTask_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ScheduleDecisionTask(
 	ctx context.Context,
 	_ScheduleRequest *history.ScheduleDecisionTaskRequest,
@@ -0,0 +1,2513 @@
+// Code generated by protoc-gen-yarpc-go
+// source: uber/cadence/api/v1/workflow_service.proto
+// DO NOT EDIT!
+
+package apiv1
+
+import (
+	"context"
+	"io/ioutil"
+	"reflect"
+
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/fx"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/encoding/protobuf"
+	"go.uber.org/yarpc/encoding/protobuf/reflection"
+)
+
+var _ = ioutil.NopCloser
+
+// WorkflowServiceYARPCClient is the YARPC client-side interface for the WorkflowService service.
+type WorkflowServiceYARPCClient interface {
+	RegisterDomain(context.Context, *RegisterDomainRequest, ...yarpc.CallOption) (*RegisterDomainResponse, error)
+	DescribeDomain(context.Context, *DescribeDomainRequest, ...yarpc.CallOption) (*DescribeDomainResponse, error)
+	ListDomains(context.Context, *ListDomainsRequest, ...yarpc.CallOption) (*ListDomainsResponse, error)
+	UpdateDomain(context.Context, *UpdateDomainRequest, ...yarpc.CallOption) (*UpdateDomainResponse, error)
+	DeprecateDomain(context.Context, *DeprecateDomainRequest, ...yarpc.CallOption) (*DeprecateDomainResponse, error)
+	StartWorkflowExecution(context.Context, *StartWorkflowExecutionRequest, ...yarpc.CallOption) (*StartWorkflowExecutionResponse, error)
+	GetWorkflowExecutionHistory(context.Context, *GetWorkflowExecutionHistoryRequest, ...yarpc.CallOption) (*GetWorkflowExecutionHistoryResponse, error)
+	GetWorkflowExecutionRawHistory(context.Context, *GetWorkflowExecutionRawHistoryRequest, ...yarpc.CallOption) (*GetWorkflowExecutionRawHistoryResponse, error)
+	PollForDecisionTask(context.Context, *PollForDecisionTaskRequest, ...yarpc.CallOption) (*PollForDecisionTaskResponse, error)
+	RespondDecisionTaskCompleted(context.Context, *RespondDecisionTaskCompletedRequest, ...yarpc.CallOption) (*RespondDecisionTaskCompletedResponse, error)
+	RespondDecisionTaskFailed(context.Context, *RespondDecisionTaskFailedRequest, ...yarpc.CallOption) (*RespondDecisionTaskFailedResponse, error)
+	PollForActivityTask(context.Context, *PollForActivityTaskRequest, ...yarpc.CallOption) (*PollForActivityTaskResponse, error)
+	RecordActivityTaskHeartbeat(context.Context, *RecordActivityTaskHeartbeatRequest, ...yarpc.CallOption) (*RecordActivityTaskHeartbeatResponse, error)
+	RecordActivityTaskHeartbeatByID(context.Context, *RecordActivityTaskHeartbeatByIDRequest, ...yarpc.CallOption) (*RecordActivityTaskHeartbeatResponse, error)
+	RespondActivityTaskCompleted(context.Context, *RespondActivityTaskCompletedRequest, ...yarpc.CallOption) (*RespondActivityTaskCompletedResponse, error)
+	RespondActivityTaskCompletedByID(context.Context, *RespondActivityTaskCompletedByIDRequest, ...yarpc.CallOption) (*RespondActivityTaskCompletedByIDResponse, error)
+	RespondActivityTaskFailed(context.Context, *RespondActivityTaskFailedRequest, ...yarpc.CallOption) (*RespondActivityTaskFailedResponse, error)
+	RespondActivityTaskFailedByID(context.Context, *RespondActivityTaskFailedByIDRequest, ...yarpc.CallOption) (*RespondActivityTaskFailedByIDResponse, error)
+	RespondActivityTaskCanceled(context.Context, *RespondActivityTaskCanceledRequest, ...yarpc.CallOption) (*RespondActivityTaskCanceledResponse, error)
+	RespondActivityTaskCanceledByID(context.Context, *RespondActivityTaskCanceledByIDRequest, ...yarpc.CallOption) (*RespondActivityTaskCanceledByIDResponse, error)
+	RequestCancelWorkflowExecution(context.Context, *RequestCancelWorkflowExecutionRequest, ...yarpc.CallOption) (*RequestCancelWorkflowExecutionResponse, error)
+	SignalWorkflowExecution(context.Context, *SignalWorkflowExecutionRequest, ...yarpc.CallOption) (*SignalWorkflowExecutionResponse, error)
+	SignalWithStartWorkflowExecution(context.Context, *SignalWithStartWorkflowExecutionRequest, ...yarpc.CallOption) (*StartWorkflowExecutionResponse, error)
+	TerminateWorkflowExecution(context.Context, *TerminateWorkflowExecutionRequest, ...yarpc.CallOption) (*TerminateWorkflowExecutionResponse, error)
+	PauseWorkflowExecution(context.Context, *PauseWorkflowExecutionRequest, ...yarpc.CallOption) (*PauseWorkflowExecutionResponse, error)
+	ResumeWorkflowExecution(context.Context, *ResumeWorkflowExecutionRequest, ...yarpc.CallOption) (*ResumeWorkflowExecutionResponse, error)
+	RetryActivityTask(context.Context, *RetryActivityTaskRequest, ...yarpc.CallOption) (*RetryActivityTaskResponse, error)
+	CompleteActivityTask(context.Context, *CompleteActivityTaskRequest, ...yarpc.CallOption) (*CompleteActivityTaskResponse, error)
+	FailActivityTask(context.Context, *FailActivityTaskRequest, ...yarpc.CallOption) (*FailActivityTaskResponse, error)
+	ListOpenWorkflowExecutions(context.Context, *ListOpenWorkflowExecutionsRequest, ...yarpc.CallOption) (*ListOpenWorkflowExecutionsResponse, error)
+	ListClosedWorkflowExecutions(context.Context, *ListClosedWorkflowExecutionsRequest, ...yarpc.CallOption) (*ListClosedWorkflowExecutionsResponse, error)
+	RespondQueryTaskCompleted(context.Context, *RespondQueryTaskCompletedRequest, ...yarpc.CallOption) (*RespondQueryTaskCompletedResponse, error)
+	ResetStickyTaskList(context.Context, *ResetStickyTaskListRequest, ...yarpc.CallOption) (*ResetStickyTaskListResponse, error)
+	QueryWorkflow(context.Context, *QueryWorkflowRequest, ...yarpc.CallOption) (*QueryWorkflowResponse, error)
+	DescribeWorkflowExecution(context.Context, *DescribeWorkflowExecutionRequest, ...yarpc.CallOption) (*DescribeWorkflowExecutionResponse, error)
+	DescribeTaskList(context.Context, *DescribeTaskListRequest, ...yarpc.CallOption) (*DescribeTaskListResponse, error)
+}
+
+// NewWorkflowServiceYARPCClient builds a new YARPC client for the WorkflowService service.
+func NewWorkflowServiceYARPCClient(clientConfig transport.ClientConfig, options ...protobuf.ClientOption) WorkflowServiceYARPCClient {
+	return &_WorkflowServiceYARPCCaller{protobuf.NewStreamClient(
+		protobuf.ClientParams{
+			ServiceName:  "uber.cadence.api.v1.WorkflowService",
+			ClientConfig: clientConfig,
+			Options:      options,
+		},
+	)}
+}
+
+// WorkflowServiceYARPCServer is the YARPC server-side interface for the WorkflowService service.
+type WorkflowServiceYARPCServer interface {
+	RegisterDomain(context.Context, *RegisterDomainRequest) (*RegisterDomainResponse, error)
+	DescribeDomain(context.Context, *DescribeDomainRequest) (*DescribeDomainResponse, error)
+	ListDomains(context.Context, *ListDomainsRequest) (*ListDomainsResponse, error)
+	UpdateDomain(context.Context, *UpdateDomainRequest) (*UpdateDomainResponse, error)
+	DeprecateDomain(context.Context, *DeprecateDomainRequest) (*DeprecateDomainResponse, error)
+	StartWorkflowExecution(context.Context, *StartWorkflowExecutionRequest) (*StartWorkflowExecutionResponse, error)
+	GetWorkflowExecutionHistory(context.Context, *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryResponse, error)
+	GetWorkflowExecutionRawHistory(context.Context, *GetWorkflowExecutionRawHistoryRequest) (*GetWorkflowExecutionRawHistoryResponse, error)
+	PollForDecisionTask(context.Context, *PollForDecisionTaskRequest) (*PollForDecisionTaskResponse, error)
+	RespondDecisionTaskCompleted(context.Context, *RespondDecisionTaskCompletedRequest) (*RespondDecisionTaskCompletedResponse, error)
+	RespondDecisionTaskFailed(context.Context, *RespondDecisionTaskFailedRequest) (*RespondDecisionTaskFailedResponse, error)
+	PollForActivityTask(context.Context, *PollForActivityTaskRequest) (*PollForActivityTaskResponse, error)
+	RecordActivityTaskHeartbeat(context.Context, *RecordActivityTaskHeartbeatRequest) (*RecordActivityTaskHeartbeatResponse, error)
+	RecordActivityTaskHeartbeatByID(context.Context, *RecordActivityTaskHeartbeatByIDRequest) (*RecordActivityTaskHeartbeatResponse, error)
+	RespondActivityTaskCompleted(context.Context, *RespondActivityTaskCompletedRequest) (*RespondActivityTaskCompletedResponse, error)
+	RespondActivityTaskCompletedByID(context.Context, *RespondActivityTaskCompletedByIDRequest) (*RespondActivityTaskCompletedByIDResponse, error)
+	RespondActivityTaskFailed(context.Context, *RespondActivityTaskFailedRequest) (*RespondActivityTaskFailedResponse, error)
+	RespondActivityTaskFailedByID(context.Context, *RespondActivityTaskFailedByIDRequest) (*RespondActivityTaskFailedByIDResponse, error)
+	RespondActivityTaskCanceled(context.Context, *RespondActivityTaskCanceledRequest) (*RespondActivityTaskCanceledResponse, error)
+	RespondActivityTaskCanceledByID(context.Context, *RespondActivityTaskCanceledByIDRequest) (*RespondActivityTaskCanceledByIDResponse, error)
+	RequestCancelWorkflowExecution(context.Context, *RequestCancelWorkflowExecutionRequest) (*RequestCancelWorkflowExecutionResponse, error)
+	SignalWorkflowExecution(context.Context, *SignalWorkflowExecutionRequest) (*SignalWorkflowExecutionResponse, error)
+	SignalWithStartWorkflowExecution(context.Context, *SignalWithStartWorkflowExecutionRequest) (*StartWorkflowExecutionResponse, error)
+	TerminateWorkflowExecution(context.Context, *TerminateWorkflowExecutionRequest) (*TerminateWorkflowExecutionResponse, error)
+	PauseWorkflowExecution(context.Context, *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error)
+	ResumeWorkflowExecution(context.Context, *ResumeWorkflowExecutionRequest) (*ResumeWorkflowExecutionResponse, error)
+	RetryActivityTask(context.Context, *RetryActivityTaskRequest) (*RetryActivityTaskResponse, error)
+	CompleteActivityTask(context.Context, *CompleteActivityTaskRequest) (*CompleteActivityTaskResponse, error)
+	FailActivityTask(context.Context, *FailActivityTaskRequest) (*FailActivityTaskResponse, error)
+	ListOpenWorkflowExecutions(context.Context, *ListOpenWorkflowExecutionsRequest) (*ListOpenWorkflowExecutionsResponse, error)
+	ListClosedWorkflowExecutions(context.Context, *ListClosedWorkflowExecutionsRequest) (*ListClosedWorkflowExecutionsResponse, error)
+	RespondQueryTaskCompleted(context.Context, *RespondQueryTaskCompletedRequest) (*RespondQueryTaskCompletedResponse, error)
+	ResetStickyTaskList(context.Context, *ResetStickyTaskListRequest) (*ResetStickyTaskListResponse, error)
+	QueryWorkflow(context.Context, *QueryWorkflowRequest) (*QueryWorkflowResponse, error)
+	DescribeWorkflowExecution(context.Context, *DescribeWorkflowExecutionRequest) (*DescribeWorkflowExecutionResponse, error)
+	DescribeTaskList(context.Context, *DescribeTaskListRequest) (*DescribeTaskListResponse, error)
+}
+
+// BuildWorkflowServiceYARPCProcedures prepares an implementation of the WorkflowService service for YARPC registration.
+func BuildWorkflowServiceYARPCProcedures(server WorkflowServiceYARPCServer) []transport.Procedure {
+	handler := &_WorkflowServiceYARPCHandler{server}
+	return protobuf.BuildProcedures(
+		protobuf.BuildProceduresParams{
+			ServiceName: "uber.cadence.api.v1.WorkflowService",
+			UnaryHandlerParams: []protobuf.BuildProceduresUnaryHandlerParams{
+				{
+					MethodName: "RegisterDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RegisterDomain,
+							NewRequest: newWorkflowServiceServiceRegisterDomainYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.DescribeDomain,
+							NewRequest: newWorkflowServiceServiceDescribeDomainYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "ListDomains",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.ListDomains,
+							NewRequest: newWorkflowServiceServiceListDomainsYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "UpdateDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.UpdateDomain,
+							NewRequest: newWorkflowServiceServiceUpdateDomainYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "DeprecateDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.DeprecateDomain,
+							NewRequest: newWorkflowServiceServiceDeprecateDomainYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "StartWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.StartWorkflowExecution,
+							NewRequest: newWorkflowServiceServiceStartWorkflowExecutionYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "GetWorkflowExecutionHistory",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.GetWorkflowExecutionHistory,
+							NewRequest: newWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "GetWorkflowExecutionRawHistory",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.GetWorkflowExecutionRawHistory,
+							NewRequest: newWorkflowServiceServiceGetWorkflowExecutionRawHistoryYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "PollForDecisionTask",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.PollForDecisionTask,
+							NewRequest: newWorkflowServiceServicePollForDecisionTaskYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondDecisionTaskCompleted",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondDecisionTaskCompleted,
+							NewRequest: newWorkflowServiceServiceRespondDecisionTaskCompletedYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondDecisionTaskFailed",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondDecisionTaskFailed,
+							NewRequest: newWorkflowServiceServiceRespondDecisionTaskFailedYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "PollForActivityTask",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.PollForActivityTask,
+							NewRequest: newWorkflowServiceServicePollForActivityTaskYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RecordActivityTaskHeartbeat",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RecordActivityTaskHeartbeat,
+							NewRequest: newWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RecordActivityTaskHeartbeatByID",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RecordActivityTaskHeartbeatByID,
+							NewRequest: newWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskCompleted",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondActivityTaskCompleted,
+							NewRequest: newWorkflowServiceServiceRespondActivityTaskCompletedYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskCompletedByID",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondActivityTaskCompletedByID,
+							NewRequest: newWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskFailed",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondActivityTaskFailed,
+							NewRequest: newWorkflowServiceServiceRespondActivityTaskFailedYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskFailedByID",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondActivityTaskFailedByID,
+							NewRequest: newWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskCanceled",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondActivityTaskCanceled,
+							NewRequest: newWorkflowServiceServiceRespondActivityTaskCanceledYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskCanceledByID",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondActivityTaskCanceledByID,
+							NewRequest: newWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RequestCancelWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RequestCancelWorkflowExecution,
+							NewRequest: newWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "SignalWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.SignalWorkflowExecution,
+							NewRequest: newWorkflowServiceServiceSignalWorkflowExecutionYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "SignalWithStartWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.SignalWithStartWorkflowExecution,
+							NewRequest: newWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "TerminateWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.TerminateWorkflowExecution,
+							NewRequest: newWorkflowServiceServiceTerminateWorkflowExecutionYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "PauseWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.PauseWorkflowExecution,
+							NewRequest: newWorkflowServiceServicePauseWorkflowExecutionYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "ResumeWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.ResumeWorkflowExecution,
+							NewRequest: newWorkflowServiceServiceResumeWorkflowExecutionYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RetryActivityTask",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RetryActivityTask,
+							NewRequest: newWorkflowServiceServiceRetryActivityTaskYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "CompleteActivityTask",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.CompleteActivityTask,
+							NewRequest: newWorkflowServiceServiceCompleteActivityTaskYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "FailActivityTask",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.FailActivityTask,
+							NewRequest: newWorkflowServiceServiceFailActivityTaskYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "ListOpenWorkflowExecutions",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.ListOpenWorkflowExecutions,
+							NewRequest: newWorkflowServiceServiceListOpenWorkflowExecutionsYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "ListClosedWorkflowExecutions",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.ListClosedWorkflowExecutions,
+							NewRequest: newWorkflowServiceServiceListClosedWorkflowExecutionsYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondQueryTaskCompleted",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondQueryTaskCompleted,
+							NewRequest: newWorkflowServiceServiceRespondQueryTaskCompletedYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "ResetStickyTaskList",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.ResetStickyTaskList,
+							NewRequest: newWorkflowServiceServiceResetStickyTaskListYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "QueryWorkflow",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.QueryWorkflow,
+							NewRequest: newWorkflowServiceServiceQueryWorkflowYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.DescribeWorkflowExecution,
+							NewRequest: newWorkflowServiceServiceDescribeWorkflowExecutionYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeTaskList",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.DescribeTaskList,
+							NewRequest: newWorkflowServiceServiceDescribeTaskListYARPCRequest,
+						},
+					),
+				},
+			},
+			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
+			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
+		},
+	)
+}
+
+// FxWorkflowServiceYARPCClientParams defines the input
+// for NewFxWorkflowServiceYARPCClient. It provides the
+// paramaters to get a WorkflowServiceYARPCClient in an
+// Fx application.
+type FxWorkflowServiceYARPCClientParams struct {
+	fx.In
+
+	Provider yarpc.ClientConfig
+}
+
+// FxWorkflowServiceYARPCClientResult defines the output
+// of NewFxWorkflowServiceYARPCClient. It provides a
+// WorkflowServiceYARPCClient to an Fx application.
+type FxWorkflowServiceYARPCClientResult struct {
+	fx.Out
+
+	Client WorkflowServiceYARPCClient
+
+	// We are using an fx.Out struct here instead of just returning a client
+	// so that we can add more values or add named versions of the client in
+	// the future without breaking any existing code.
+}
+
+// NewFxWorkflowServiceYARPCClient provides a WorkflowServiceYARPCClient
+// to an Fx application using the given name for routing.
+//
+//	fx.Provide(
+//	  apiv1.NewFxWorkflowServiceYARPCClient("service-name"),
+//	  ...
+//	)
+func NewFxWorkflowServiceYARPCClient(name string, options ...protobuf.ClientOption) interface{} {
+	return func(params FxWorkflowServiceYARPCClientParams) FxWorkflowServiceYARPCClientResult {
+		return FxWorkflowServiceYARPCClientResult{
+			Client: NewWorkflowServiceYARPCClient(params.Provider.ClientConfig(name), options...),
+		}
+	}
+}
+
+// FxWorkflowServiceYARPCProceduresParams defines the input
+// for NewFxWorkflowServiceYARPCProcedures. It provides the
+// paramaters to get WorkflowServiceYARPCServer procedures in an
+// Fx application.
+type FxWorkflowServiceYARPCProceduresParams struct {
+	fx.In
+
+	Server WorkflowServiceYARPCServer
+}
+
+// FxWorkflowServiceYARPCProceduresResult defines the output
+// of NewFxWorkflowServiceYARPCProcedures. It provides
+// WorkflowServiceYARPCServer procedures to an Fx application.
+//
+// The procedures are provided to the "yarpcfx" value group.
+// Dig 1.2 or newer must be used for this feature to work.
+type FxWorkflowServiceYARPCProceduresResult struct {
+	fx.Out
+
+	Procedures     []transport.Procedure `group:"yarpcfx"`
+	ReflectionMeta reflection.ServerMeta `group:"yarpcfx"`
+}
+
+// NewFxWorkflowServiceYARPCProcedures provides WorkflowServiceYARPCServer procedures to an Fx application.
+// It expects a WorkflowServiceYARPCServer to be present in the container.
+//
+//	fx.Provide(
+//	  apiv1.NewFxWorkflowServiceYARPCProcedures(),
+//	  ...
+//	)
+func NewFxWorkflowServiceYARPCProcedures() interface{} {
+	return func(params FxWorkflowServiceYARPCProceduresParams) FxWorkflowServiceYARPCProceduresResult {
+		return FxWorkflowServiceYARPCProceduresResult{
+			Procedures: BuildWorkflowServiceYARPCProcedures(params.Server),
+			ReflectionMeta: reflection.ServerMeta{
+				ServiceName:     "uber.cadence.api.v1.WorkflowService",
+				FileDescriptors: yarpcFileDescriptorClosuredfc66e7e7bd77c3a,
+			},
+		}
+	}
+}
+
+type _WorkflowServiceYARPCCaller struct {
+	streamClient protobuf.StreamClient
+}
+
+func (c *_WorkflowServiceYARPCCaller) RegisterDomain(ctx context.Context, request *RegisterDomainRequest, options ...yarpc.CallOption) (*RegisterDomainResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RegisterDomain", request, newWorkflowServiceServiceRegisterDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RegisterDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRegisterDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) DescribeDomain(ctx context.Context, request *DescribeDomainRequest, options ...yarpc.CallOption) (*DescribeDomainResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeDomain", request, newWorkflowServiceServiceDescribeDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceDescribeDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) ListDomains(ctx context.Context, request *ListDomainsRequest, options ...yarpc.CallOption) (*ListDomainsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListDomains", request, newWorkflowServiceServiceListDomainsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListDomainsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceListDomainsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) UpdateDomain(ctx context.Context, request *UpdateDomainRequest, options ...yarpc.CallOption) (*UpdateDomainResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UpdateDomain", request, newWorkflowServiceServiceUpdateDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UpdateDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceUpdateDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) DeprecateDomain(ctx context.Context, request *DeprecateDomainRequest, options ...yarpc.CallOption) (*DeprecateDomainResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DeprecateDomain", request, newWorkflowServiceServiceDeprecateDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DeprecateDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceDeprecateDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) StartWorkflowExecution(ctx context.Context, request *StartWorkflowExecutionRequest, options ...yarpc.CallOption) (*StartWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "StartWorkflowExecution", request, newWorkflowServiceServiceStartWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*StartWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceStartWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) GetWorkflowExecutionHistory(ctx context.Context, request *GetWorkflowExecutionHistoryRequest, options ...yarpc.CallOption) (*GetWorkflowExecutionHistoryResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetWorkflowExecutionHistory", request, newWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetWorkflowExecutionHistoryResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) GetWorkflowExecutionRawHistory(ctx context.Context, request *GetWorkflowExecutionRawHistoryRequest, options ...yarpc.CallOption) (*GetWorkflowExecutionRawHistoryResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetWorkflowExecutionRawHistory", request, newWorkflowServiceServiceGetWorkflowExecutionRawHistoryYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetWorkflowExecutionRawHistoryResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceGetWorkflowExecutionRawHistoryYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) PollForDecisionTask(ctx context.Context, request *PollForDecisionTaskRequest, options ...yarpc.CallOption) (*PollForDecisionTaskResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "PollForDecisionTask", request, newWorkflowServiceServicePollForDecisionTaskYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*PollForDecisionTaskResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServicePollForDecisionTaskYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondDecisionTaskCompleted(ctx context.Context, request *RespondDecisionTaskCompletedRequest, options ...yarpc.CallOption) (*RespondDecisionTaskCompletedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondDecisionTaskCompleted", request, newWorkflowServiceServiceRespondDecisionTaskCompletedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondDecisionTaskCompletedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondDecisionTaskCompletedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondDecisionTaskFailed(ctx context.Context, request *RespondDecisionTaskFailedRequest, options ...yarpc.CallOption) (*RespondDecisionTaskFailedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondDecisionTaskFailed", request, newWorkflowServiceServiceRespondDecisionTaskFailedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondDecisionTaskFailedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondDecisionTaskFailedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) PollForActivityTask(ctx context.Context, request *PollForActivityTaskRequest, options ...yarpc.CallOption) (*PollForActivityTaskResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "PollForActivityTask", request, newWorkflowServiceServicePollForActivityTaskYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*PollForActivityTaskResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServicePollForActivityTaskYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RecordActivityTaskHeartbeat(ctx context.Context, request *RecordActivityTaskHeartbeatRequest, options ...yarpc.CallOption) (*RecordActivityTaskHeartbeatResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RecordActivityTaskHeartbeat", request, newWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RecordActivityTaskHeartbeatResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RecordActivityTaskHeartbeatByID(ctx context.Context, request *RecordActivityTaskHeartbeatByIDRequest, options ...yarpc.CallOption) (*RecordActivityTaskHeartbeatResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RecordActivityTaskHeartbeatByID", request, newWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RecordActivityTaskHeartbeatResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondActivityTaskCompleted(ctx context.Context, request *RespondActivityTaskCompletedRequest, options ...yarpc.CallOption) (*RespondActivityTaskCompletedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskCompleted", request, newWorkflowServiceServiceRespondActivityTaskCompletedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskCompletedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCompletedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondActivityTaskCompletedByID(ctx context.Context, request *RespondActivityTaskCompletedByIDRequest, options ...yarpc.CallOption) (*RespondActivityTaskCompletedByIDResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskCompletedByID", request, newWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskCompletedByIDResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondActivityTaskFailed(ctx context.Context, request *RespondActivityTaskFailedRequest, options ...yarpc.CallOption) (*RespondActivityTaskFailedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskFailed", request, newWorkflowServiceServiceRespondActivityTaskFailedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskFailedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskFailedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondActivityTaskFailedByID(ctx context.Context, request *RespondActivityTaskFailedByIDRequest, options ...yarpc.CallOption) (*RespondActivityTaskFailedByIDResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskFailedByID", request, newWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskFailedByIDResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondActivityTaskCanceled(ctx context.Context, request *RespondActivityTaskCanceledRequest, options ...yarpc.CallOption) (*RespondActivityTaskCanceledResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskCanceled", request, newWorkflowServiceServiceRespondActivityTaskCanceledYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskCanceledResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCanceledYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondActivityTaskCanceledByID(ctx context.Context, request *RespondActivityTaskCanceledByIDRequest, options ...yarpc.CallOption) (*RespondActivityTaskCanceledByIDResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskCanceledByID", request, newWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskCanceledByIDResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RequestCancelWorkflowExecution(ctx context.Context, request *RequestCancelWorkflowExecutionRequest, options ...yarpc.CallOption) (*RequestCancelWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RequestCancelWorkflowExecution", request, newWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RequestCancelWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) SignalWorkflowExecution(ctx context.Context, request *SignalWorkflowExecutionRequest, options ...yarpc.CallOption) (*SignalWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "SignalWorkflowExecution", request, newWorkflowServiceServiceSignalWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*SignalWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceSignalWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) SignalWithStartWorkflowExecution(ctx context.Context, request *SignalWithStartWorkflowExecutionRequest, options ...yarpc.CallOption) (*StartWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "SignalWithStartWorkflowExecution", request, newWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*StartWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) TerminateWorkflowExecution(ctx context.Context, request *TerminateWorkflowExecutionRequest, options ...yarpc.CallOption) (*TerminateWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "TerminateWorkflowExecution", request, newWorkflowServiceServiceTerminateWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*TerminateWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceTerminateWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) PauseWorkflowExecution(ctx context.Context, request *PauseWorkflowExecutionRequest, options ...yarpc.CallOption) (*PauseWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "PauseWorkflowExecution", request, newWorkflowServiceServicePauseWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*PauseWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServicePauseWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) ResumeWorkflowExecution(ctx context.Context, request *ResumeWorkflowExecutionRequest, options ...yarpc.CallOption) (*ResumeWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ResumeWorkflowExecution", request, newWorkflowServiceServiceResumeWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ResumeWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceResumeWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RetryActivityTask(ctx context.Context, request *RetryActivityTaskRequest, options ...yarpc.CallOption) (*RetryActivityTaskResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RetryActivityTask", request, newWorkflowServiceServiceRetryActivityTaskYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RetryActivityTaskResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRetryActivityTaskYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) CompleteActivityTask(ctx context.Context, request *CompleteActivityTaskRequest, options ...yarpc.CallOption) (*CompleteActivityTaskResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "CompleteActivityTask", request, newWorkflowServiceServiceCompleteActivityTaskYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*CompleteActivityTaskResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceCompleteActivityTaskYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) FailActivityTask(ctx context.Context, request *FailActivityTaskRequest, options ...yarpc.CallOption) (*FailActivityTaskResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "FailActivityTask", request, newWorkflowServiceServiceFailActivityTaskYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*FailActivityTaskResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceFailActivityTaskYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) ListOpenWorkflowExecutions(ctx context.Context, request *ListOpenWorkflowExecutionsRequest, options ...yarpc.CallOption) (*ListOpenWorkflowExecutionsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListOpenWorkflowExecutions", request, newWorkflowServiceServiceListOpenWorkflowExecutionsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListOpenWorkflowExecutionsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceListOpenWorkflowExecutionsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) ListClosedWorkflowExecutions(ctx context.Context, request *ListClosedWorkflowExecutionsRequest, options ...yarpc.CallOption) (*ListClosedWorkflowExecutionsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListClosedWorkflowExecutions", request, newWorkflowServiceServiceListClosedWorkflowExecutionsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListClosedWorkflowExecutionsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceListClosedWorkflowExecutionsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondQueryTaskCompleted(ctx context.Context, request *RespondQueryTaskCompletedRequest, options ...yarpc.CallOption) (*RespondQueryTaskCompletedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondQueryTaskCompleted", request, newWorkflowServiceServiceRespondQueryTaskCompletedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondQueryTaskCompletedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondQueryTaskCompletedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) ResetStickyTaskList(ctx context.Context, request *ResetStickyTaskListRequest, options ...yarpc.CallOption) (*ResetStickyTaskListResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ResetStickyTaskList", request, newWorkflowServiceServiceResetStickyTaskListYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ResetStickyTaskListResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceResetStickyTaskListYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) QueryWorkflow(ctx context.Context, request *QueryWorkflowRequest, options ...yarpc.CallOption) (*QueryWorkflowResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "QueryWorkflow", request, newWorkflowServiceServiceQueryWorkflowYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*QueryWorkflowResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceQueryWorkflowYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) DescribeWorkflowExecution(ctx context.Context, request *DescribeWorkflowExecutionRequest, options ...yarpc.CallOption) (*DescribeWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeWorkflowExecution", request, newWorkflowServiceServiceDescribeWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceDescribeWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) DescribeTaskList(ctx context.Context, request *DescribeTaskListRequest, options ...yarpc.CallOption) (*DescribeTaskListResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeTaskList", request, newWorkflowServiceServiceDescribeTaskListYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeTaskListResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceDescribeTaskListYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+type _WorkflowServiceYARPCHandler struct {
+	server WorkflowServiceYARPCServer
+}
+
+func (h *_WorkflowServiceYARPCHandler) RegisterDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RegisterDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RegisterDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRegisterDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RegisterDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) DescribeDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceDescribeDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) ListDomains(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListDomainsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListDomainsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceListDomainsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListDomains(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) UpdateDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UpdateDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UpdateDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceUpdateDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UpdateDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) DeprecateDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DeprecateDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DeprecateDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceDeprecateDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DeprecateDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) StartWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *StartWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*StartWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceStartWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.StartWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) GetWorkflowExecutionHistory(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetWorkflowExecutionHistoryRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetWorkflowExecutionHistoryRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetWorkflowExecutionHistory(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) GetWorkflowExecutionRawHistory(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetWorkflowExecutionRawHistoryRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetWorkflowExecutionRawHistoryRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceGetWorkflowExecutionRawHistoryYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetWorkflowExecutionRawHistory(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) PollForDecisionTask(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *PollForDecisionTaskRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*PollForDecisionTaskRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServicePollForDecisionTaskYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.PollForDecisionTask(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondDecisionTaskCompleted(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondDecisionTaskCompletedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondDecisionTaskCompletedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondDecisionTaskCompletedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondDecisionTaskCompleted(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondDecisionTaskFailed(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondDecisionTaskFailedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondDecisionTaskFailedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondDecisionTaskFailedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondDecisionTaskFailed(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) PollForActivityTask(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *PollForActivityTaskRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*PollForActivityTaskRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServicePollForActivityTaskYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.PollForActivityTask(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RecordActivityTaskHeartbeat(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RecordActivityTaskHeartbeatRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RecordActivityTaskHeartbeatRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RecordActivityTaskHeartbeat(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RecordActivityTaskHeartbeatByID(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RecordActivityTaskHeartbeatByIDRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RecordActivityTaskHeartbeatByIDRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RecordActivityTaskHeartbeatByID(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondActivityTaskCompleted(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskCompletedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskCompletedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCompletedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskCompleted(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondActivityTaskCompletedByID(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskCompletedByIDRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskCompletedByIDRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskCompletedByID(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondActivityTaskFailed(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskFailedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskFailedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskFailedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskFailed(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondActivityTaskFailedByID(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskFailedByIDRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskFailedByIDRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskFailedByID(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondActivityTaskCanceled(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskCanceledRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskCanceledRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCanceledYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskCanceled(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondActivityTaskCanceledByID(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskCanceledByIDRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskCanceledByIDRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskCanceledByID(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RequestCancelWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RequestCancelWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RequestCancelWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RequestCancelWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) SignalWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *SignalWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*SignalWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceSignalWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.SignalWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) SignalWithStartWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *SignalWithStartWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*SignalWithStartWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.SignalWithStartWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) TerminateWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *TerminateWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*TerminateWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceTerminateWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.TerminateWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) PauseWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *PauseWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*PauseWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServicePauseWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.PauseWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) ResumeWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ResumeWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ResumeWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceResumeWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ResumeWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RetryActivityTask(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RetryActivityTaskRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RetryActivityTaskRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRetryActivityTaskYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RetryActivityTask(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) CompleteActivityTask(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *CompleteActivityTaskRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*CompleteActivityTaskRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceCompleteActivityTaskYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.CompleteActivityTask(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) FailActivityTask(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *FailActivityTaskRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*FailActivityTaskRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceFailActivityTaskYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.FailActivityTask(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) ListOpenWorkflowExecutions(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListOpenWorkflowExecutionsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListOpenWorkflowExecutionsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceListOpenWorkflowExecutionsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListOpenWorkflowExecutions(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) ListClosedWorkflowExecutions(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListClosedWorkflowExecutionsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListClosedWorkflowExecutionsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceListClosedWorkflowExecutionsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListClosedWorkflowExecutions(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondQueryTaskCompleted(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondQueryTaskCompletedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondQueryTaskCompletedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondQueryTaskCompletedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondQueryTaskCompleted(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) ResetStickyTaskList(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ResetStickyTaskListRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ResetStickyTaskListRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceResetStickyTaskListYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ResetStickyTaskList(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) QueryWorkflow(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *QueryWorkflowRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*QueryWorkflowRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceQueryWorkflowYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.QueryWorkflow(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) DescribeWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceDescribeWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) DescribeTaskList(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeTaskListRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeTaskListRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceDescribeTaskListYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeTaskList(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func newWorkflowServiceServiceRegisterDomainYARPCRequest() proto.Message {
+	return &RegisterDomainRequest{}
+}
+
+func newWorkflowServiceServiceRegisterDomainYARPCResponse() proto.Message {
+	return &RegisterDomainResponse{}
+}
+
+func newWorkflowServiceServiceDescribeDomainYARPCRequest() proto.Message {
+	return &DescribeDomainRequest{}
+}
+
+func newWorkflowServiceServiceDescribeDomainYARPCResponse() proto.Message {
+	return &DescribeDomainResponse{}
+}
+
+func newWorkflowServiceServiceListDomainsYARPCRequest() proto.Message {
+	return &ListDomainsRequest{}
+}
+
+func newWorkflowServiceServiceListDomainsYARPCResponse() proto.Message {
+	return &ListDomainsResponse{}
+}
+
+func newWorkflowServiceServiceUpdateDomainYARPCRequest() proto.Message {
+	return &UpdateDomainRequest{}
+}
+
+func newWorkflowServiceServiceUpdateDomainYARPCResponse() proto.Message {
+	return &UpdateDomainResponse{}
+}
+
+func newWorkflowServiceServiceDeprecateDomainYARPCRequest() proto.Message {
+	return &DeprecateDomainRequest{}
+}
+
+func newWorkflowServiceServiceDeprecateDomainYARPCResponse() proto.Message {
+	return &DeprecateDomainResponse{}
+}
+
+func newWorkflowServiceServiceStartWorkflowExecutionYARPCRequest() proto.Message {
+	return &StartWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceStartWorkflowExecutionYARPCResponse() proto.Message {
+	return &StartWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCRequest() proto.Message {
+	return &GetWorkflowExecutionHistoryRequest{}
+}
+
+func newWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCResponse() proto.Message {
+	return &GetWorkflowExecutionHistoryResponse{}
+}
+
+func newWorkflowServiceServiceGetWorkflowExecutionRawHistoryYARPCRequest() proto.Message {
+	return &GetWorkflowExecutionRawHistoryRequest{}
+}
+
+func newWorkflowServiceServiceGetWorkflowExecutionRawHistoryYARPCResponse() proto.Message {
+	return &GetWorkflowExecutionRawHistoryResponse{}
+}
+
+func newWorkflowServiceServicePollForDecisionTaskYARPCRequest() proto.Message {
+	return &PollForDecisionTaskRequest{}
+}
+
+func newWorkflowServiceServicePollForDecisionTaskYARPCResponse() proto.Message {
+	return &PollForDecisionTaskResponse{}
+}
+
+func newWorkflowServiceServiceRespondDecisionTaskCompletedYARPCRequest() proto.Message {
+	return &RespondDecisionTaskCompletedRequest{}
+}
+
+func newWorkflowServiceServiceRespondDecisionTaskCompletedYARPCResponse() proto.Message {
+	return &RespondDecisionTaskCompletedResponse{}
+}
+
+func newWorkflowServiceServiceRespondDecisionTaskFailedYARPCRequest() proto.Message {
+	return &RespondDecisionTaskFailedRequest{}
+}
+
+func newWorkflowServiceServiceRespondDecisionTaskFailedYARPCResponse() proto.Message {
+	return &RespondDecisionTaskFailedResponse{}
+}
+
+func newWorkflowServiceServicePollForActivityTaskYARPCRequest() proto.Message {
+	return &PollForActivityTaskRequest{}
+}
+
+func newWorkflowServiceServicePollForActivityTaskYARPCResponse() proto.Message {
+	return &PollForActivityTaskResponse{}
+}
+
+func newWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCRequest() proto.Message {
+	return &RecordActivityTaskHeartbeatRequest{}
+}
+
+func newWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCResponse() proto.Message {
+	return &RecordActivityTaskHeartbeatResponse{}
+}
+
+func newWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCRequest() proto.Message {
+	return &RecordActivityTaskHeartbeatByIDRequest{}
+}
+
+func newWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCResponse() proto.Message {
+	return &RecordActivityTaskHeartbeatResponse{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCompletedYARPCRequest() proto.Message {
+	return &RespondActivityTaskCompletedRequest{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCompletedYARPCResponse() proto.Message {
+	return &RespondActivityTaskCompletedResponse{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCRequest() proto.Message {
+	return &RespondActivityTaskCompletedByIDRequest{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCResponse() proto.Message {
+	return &RespondActivityTaskCompletedByIDResponse{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskFailedYARPCRequest() proto.Message {
+	return &RespondActivityTaskFailedRequest{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskFailedYARPCResponse() proto.Message {
+	return &RespondActivityTaskFailedResponse{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCRequest() proto.Message {
+	return &RespondActivityTaskFailedByIDRequest{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCResponse() proto.Message {
+	return &RespondActivityTaskFailedByIDResponse{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCanceledYARPCRequest() proto.Message {
+	return &RespondActivityTaskCanceledRequest{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCanceledYARPCResponse() proto.Message {
+	return &RespondActivityTaskCanceledResponse{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCRequest() proto.Message {
+	return &RespondActivityTaskCanceledByIDRequest{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCResponse() proto.Message {
+	return &RespondActivityTaskCanceledByIDResponse{}
+}
+
+func newWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCRequest() proto.Message {
+	return &RequestCancelWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCResponse() proto.Message {
+	return &RequestCancelWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceSignalWorkflowExecutionYARPCRequest() proto.Message {
+	return &SignalWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceSignalWorkflowExecutionYARPCResponse() proto.Message {
+	return &SignalWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCRequest() proto.Message {
+	return &SignalWithStartWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCResponse() proto.Message {
+	return &StartWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceTerminateWorkflowExecutionYARPCRequest() proto.Message {
+	return &TerminateWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceTerminateWorkflowExecutionYARPCResponse() proto.Message {
+	return &TerminateWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServicePauseWorkflowExecutionYARPCRequest() proto.Message {
+	return &PauseWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServicePauseWorkflowExecutionYARPCResponse() proto.Message {
+	return &PauseWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceResumeWorkflowExecutionYARPCRequest() proto.Message {
+	return &ResumeWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceResumeWorkflowExecutionYARPCResponse() proto.Message {
+	return &ResumeWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceRetryActivityTaskYARPCRequest() proto.Message {
+	return &RetryActivityTaskRequest{}
+}
+
+func newWorkflowServiceServiceRetryActivityTaskYARPCResponse() proto.Message {
+	return &RetryActivityTaskResponse{}
+}
+
+func newWorkflowServiceServiceCompleteActivityTaskYARPCRequest() proto.Message {
+	return &CompleteActivityTaskRequest{}
+}
+
+func newWorkflowServiceServiceCompleteActivityTaskYARPCResponse() proto.Message {
+	return &CompleteActivityTaskResponse{}
+}
+
+func newWorkflowServiceServiceFailActivityTaskYARPCRequest() proto.Message {
+	return &FailActivityTaskRequest{}
+}
+
+func newWorkflowServiceServiceFailActivityTaskYARPCResponse() proto.Message {
+	return &FailActivityTaskResponse{}
+}
+
+func newWorkflowServiceServiceListOpenWorkflowExecutionsYARPCRequest() proto.Message {
+	return &ListOpenWorkflowExecutionsRequest{}
+}
+
+func newWorkflowServiceServiceListOpenWorkflowExecutionsYARPCResponse() proto.Message {
+	return &ListOpenWorkflowExecutionsResponse{}
+}
+
+func newWorkflowServiceServiceListClosedWorkflowExecutionsYARPCRequest() proto.Message {
+	return &ListClosedWorkflowExecutionsRequest{}
+}
+
+func newWorkflowServiceServiceListClosedWorkflowExecutionsYARPCResponse() proto.Message {
+	return &ListClosedWorkflowExecutionsResponse{}
+}
+
+func newWorkflowServiceServiceRespondQueryTaskCompletedYARPCRequest() proto.Message {
+	return &RespondQueryTaskCompletedRequest{}
+}
+
+func newWorkflowServiceServiceRespondQueryTaskCompletedYARPCResponse() proto.Message {
+	return &RespondQueryTaskCompletedResponse{}
+}
+
+func newWorkflowServiceServiceResetStickyTaskListYARPCRequest() proto.Message {
+	return &ResetStickyTaskListRequest{}
+}
+
+func newWorkflowServiceServiceResetStickyTaskListYARPCResponse() proto.Message {
+	return &ResetStickyTaskListResponse{}
+}
+
+func newWorkflowServiceServiceQueryWorkflowYARPCRequest() proto.Message {
+	return &QueryWorkflowRequest{}
+}
+
+func newWorkflowServiceServiceQueryWorkflowYARPCResponse() proto.Message {
+	return &QueryWorkflowResponse{}
+}
+
+func newWorkflowServiceServiceDescribeWorkflowExecutionYARPCRequest() proto.Message {
+	return &DescribeWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceDescribeWorkflowExecutionYARPCResponse() proto.Message {
+	return &DescribeWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceDescribeTaskListYARPCRequest() proto.Message {
+	return &DescribeTaskListRequest{}
+}
+
+func newWorkflowServiceServiceDescribeTaskListYARPCResponse() proto.Message {
+	return &DescribeTaskListResponse{}
+}
+
+var (
+	emptyWorkflowServiceServiceRegisterDomainYARPCRequest                    = &RegisterDomainRequest{}
+	emptyWorkflowServiceServiceRegisterDomainYARPCResponse                   = &RegisterDomainResponse{}
+	emptyWorkflowServiceServiceDescribeDomainYARPCRequest                    = &DescribeDomainRequest{}
+	emptyWorkflowServiceServiceDescribeDomainYARPCResponse                   = &DescribeDomainResponse{}
+	emptyWorkflowServiceServiceListDomainsYARPCRequest                       = &ListDomainsRequest{}
+	emptyWorkflowServiceServiceListDomainsYARPCResponse                      = &ListDomainsResponse{}
+	emptyWorkflowServiceServiceUpdateDomainYARPCRequest                      = &UpdateDomainRequest{}
+	emptyWorkflowServiceServiceUpdateDomainYARPCResponse                     = &UpdateDomainResponse{}
+	emptyWorkflowServiceServiceDeprecateDomainYARPCRequest                   = &DeprecateDomainRequest{}
+	emptyWorkflowServiceServiceDeprecateDomainYARPCResponse                  = &DeprecateDomainResponse{}
+	emptyWorkflowServiceServiceStartWorkflowExecutionYARPCRequest            = &StartWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceStartWorkflowExecutionYARPCResponse           = &StartWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCRequest       = &GetWorkflowExecutionHistoryRequest{}
+	emptyWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCResponse      = &GetWorkflowExecutionHistoryResponse{}
+	emptyWorkflowServiceServiceGetWorkflowExecutionRawHistoryYARPCRequest    = &GetWorkflowExecutionRawHistoryRequest{}
+	emptyWorkflowServiceServiceGetWorkflowExecutionRawHistoryYARPCResponse   = &GetWorkflowExecutionRawHistoryResponse{}
+	emptyWorkflowServiceServicePollForDecisionTaskYARPCRequest               = &PollForDecisionTaskRequest{}
+	emptyWorkflowServiceServicePollForDecisionTaskYARPCResponse              = &PollForDecisionTaskResponse{}
+	emptyWorkflowServiceServiceRespondDecisionTaskCompletedYARPCRequest      = &RespondDecisionTaskCompletedRequest{}
+	emptyWorkflowServiceServiceRespondDecisionTaskCompletedYARPCResponse     = &RespondDecisionTaskCompletedResponse{}
+	emptyWorkflowServiceServiceRespondDecisionTaskFailedYARPCRequest         = &RespondDecisionTaskFailedRequest{}
+	emptyWorkflowServiceServiceRespondDecisionTaskFailedYARPCResponse        = &RespondDecisionTaskFailedResponse{}
+	emptyWorkflowServiceServicePollForActivityTaskYARPCRequest               = &PollForActivityTaskRequest{}
+	emptyWorkflowServiceServicePollForActivityTaskYARPCResponse              = &PollForActivityTaskResponse{}
+	emptyWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCRequest       = &RecordActivityTaskHeartbeatRequest{}
+	emptyWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCResponse      = &RecordActivityTaskHeartbeatResponse{}
+	emptyWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCRequest   = &RecordActivityTaskHeartbeatByIDRequest{}
+	emptyWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCResponse  = &RecordActivityTaskHeartbeatResponse{}
+	emptyWorkflowServiceServiceRespondActivityTaskCompletedYARPCRequest      = &RespondActivityTaskCompletedRequest{}
+	emptyWorkflowServiceServiceRespondActivityTaskCompletedYARPCResponse     = &RespondActivityTaskCompletedResponse{}
+	emptyWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCRequest  = &RespondActivityTaskCompletedByIDRequest{}
+	emptyWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCResponse = &RespondActivityTaskCompletedByIDResponse{}
+	emptyWorkflowServiceServiceRespondActivityTaskFailedYARPCRequest         = &RespondActivityTaskFailedRequest{}
+	emptyWorkflowServiceServiceRespondActivityTaskFailedYARPCResponse        = &RespondActivityTaskFailedResponse{}
+	emptyWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCRequest     = &RespondActivityTaskFailedByIDRequest{}
+	emptyWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCResponse    = &RespondActivityTaskFailedByIDResponse{}
+	emptyWorkflowServiceServiceRespondActivityTaskCanceledYARPCRequest       = &RespondActivityTaskCanceledRequest{}
+	emptyWorkflowServiceServiceRespondActivityTaskCanceledYARPCResponse      = &RespondActivityTaskCanceledResponse{}
+	emptyWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCRequest   = &RespondActivityTaskCanceledByIDRequest{}
+	emptyWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCResponse  = &RespondActivityTaskCanceledByIDResponse{}
+	emptyWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCRequest    = &RequestCancelWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCResponse   = &RequestCancelWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceSignalWorkflowExecutionYARPCRequest           = &SignalWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceSignalWorkflowExecutionYARPCResponse          = &SignalWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCRequest  = &SignalWithStartWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCResponse = &StartWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceTerminateWorkflowExecutionYARPCRequest        = &TerminateWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceTerminateWorkflowExecutionYARPCResponse       = &TerminateWorkflowExecutionResponse{}
+	emptyWorkflowServiceServicePauseWorkflowExecutionYARPCRequest            = &PauseWorkflowExecutionRequest{}
+	emptyWorkflowServiceServicePauseWorkflowExecutionYARPCResponse           = &PauseWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceResumeWorkflowExecutionYARPCRequest           = &ResumeWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceResumeWorkflowExecutionYARPCResponse          = &ResumeWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceRetryActivityTaskYARPCRequest                 = &RetryActivityTaskRequest{}
+	emptyWorkflowServiceServiceRetryActivityTaskYARPCResponse                = &RetryActivityTaskResponse{}
+	emptyWorkflowServiceServiceCompleteActivityTaskYARPCRequest              = &CompleteActivityTaskRequest{}
+	emptyWorkflowServiceServiceCompleteActivityTaskYARPCResponse             = &CompleteActivityTaskResponse{}
+	emptyWorkflowServiceServiceFailActivityTaskYARPCRequest                  = &FailActivityTaskRequest{}
+	emptyWorkflowServiceServiceFailActivityTaskYARPCResponse                 = &FailActivityTaskResponse{}
+	emptyWorkflowServiceServiceListOpenWorkflowExecutionsYARPCRequest        = &ListOpenWorkflowExecutionsRequest{}
+	emptyWorkflowServiceServiceListOpenWorkflowExecutionsYARPCResponse       = &ListOpenWorkflowExecutionsResponse{}
+	emptyWorkflowServiceServiceListClosedWorkflowExecutionsYARPCRequest      = &ListClosedWorkflowExecutionsRequest{}
+	emptyWorkflowServiceServiceListClosedWorkflowExecutionsYARPCResponse     = &ListClosedWorkflowExecutionsResponse{}
+	emptyWorkflowServiceServiceRespondQueryTaskCompletedYARPCRequest         = &RespondQueryTaskCompletedRequest{}
+	emptyWorkflowServiceServiceRespondQueryTaskCompletedYARPCResponse        = &RespondQueryTaskCompletedResponse{}
+	emptyWorkflowServiceServiceResetStickyTaskListYARPCRequest               = &ResetStickyTaskListRequest{}
+	emptyWorkflowServiceServiceResetStickyTaskListYARPCResponse              = &ResetStickyTaskListResponse{}
+	emptyWorkflowServiceServiceQueryWorkflowYARPCRequest                     = &QueryWorkflowRequest{}
+	emptyWorkflowServiceServiceQueryWorkflowYARPCResponse                    = &QueryWorkflowResponse{}
+	emptyWorkflowServiceServiceDescribeWorkflowExecutionYARPCRequest         = &DescribeWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceDescribeWorkflowExecutionYARPCResponse        = &DescribeWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceDescribeTaskListYARPCRequest                  = &DescribeTaskListRequest{}
+	emptyWorkflowServiceServiceDescribeTaskListYARPCResponse                 = &DescribeTaskListResponse{}
+)
+
+var yarpcFileDescriptorClosuredfc66e7e7bd77c3a = [][]byte{
+	// uber/cadence/api/v1/workflow_service.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xdf, 0x6f, 0x5b, 0x35,
+		0x14, 0xd6, 0x7d, 0x80, 0x87, 0xc3, 0x8f, 0x81, 0x87, 0xd6, 0xb5, 0x1b, 0x5d, 0xd7, 0xad, 0x6b,
+		0x57, 0xd6, 0x84, 0x6e, 0x6c, 0xeb, 0xb6, 0xf2, 0xc0, 0x16, 0xc6, 0x90, 0x90, 0x28, 0xe9, 0x10,
+		0x12, 0x2f, 0xe0, 0xde, 0x9c, 0x6d, 0x56, 0x93, 0xf8, 0x62, 0x3b, 0xe9, 0x2a, 0x9e, 0x90, 0x78,
+		0x42, 0x42, 0x42, 0x42, 0x20, 0x90, 0x90, 0xf8, 0x13, 0xf9, 0x17, 0xd0, 0x8d, 0x7d, 0x13, 0x27,
+		0xd7, 0x3e, 0xb9, 0x37, 0x7b, 0xdb, 0x7a, 0xbf, 0xef, 0x7c, 0x27, 0xf6, 0x39, 0xf6, 0x77, 0x0c,
+		0xdb, 0x83, 0x23, 0x54, 0xcd, 0x94, 0x77, 0xb0, 0x9f, 0x62, 0x93, 0x67, 0xa2, 0x39, 0xdc, 0x6d,
+		0x9e, 0x48, 0x75, 0xfc, 0xac, 0x2b, 0x4f, 0xbe, 0xd3, 0xa8, 0x86, 0x22, 0xc5, 0x46, 0xa6, 0xa4,
+		0x91, 0xec, 0x6c, 0x8e, 0x6d, 0x38, 0x6c, 0x83, 0x67, 0xa2, 0x31, 0xdc, 0x5d, 0x59, 0x0b, 0x05,
+		0xd0, 0x2f, 0xb8, 0xc2, 0x8e, 0xa5, 0xad, 0x9f, 0x87, 0x73, 0x6d, 0x7c, 0x2e, 0xb4, 0x41, 0xd5,
+		0x92, 0x3d, 0x2e, 0xfa, 0x6d, 0xd4, 0x99, 0xec, 0x6b, 0x5c, 0x5f, 0x86, 0xa5, 0x16, 0x66, 0x0a,
+		0x53, 0x6e, 0x70, 0xe6, 0xd3, 0x15, 0xb8, 0x6c, 0xff, 0xdd, 0x69, 0x61, 0x2a, 0xb4, 0x90, 0xfd,
+		0xa7, 0x5c, 0x1f, 0x3f, 0xe6, 0xa2, 0x8b, 0x9d, 0x31, 0xe8, 0x1a, 0x5c, 0x75, 0xa0, 0x4f, 0x52,
+		0x23, 0x86, 0xc2, 0x9c, 0xe6, 0xa0, 0x47, 0xb2, 0x97, 0x75, 0xd1, 0x78, 0xb8, 0x6d, 0xd8, 0xa2,
+		0x70, 0x0f, 0x4f, 0x3f, 0x6f, 0x05, 0x84, 0x7d, 0xec, 0x8c, 0xf0, 0x26, 0x6c, 0x44, 0x41, 0x53,
+		0xd1, 0x36, 0xe0, 0x4a, 0x48, 0x99, 0xf7, 0x53, 0xf4, 0xe3, 0x5d, 0x87, 0x4d, 0x02, 0x36, 0x15,
+		0x71, 0x0b, 0xae, 0xb5, 0xf1, 0x87, 0x01, 0x6a, 0x63, 0x3f, 0x7f, 0xe3, 0xf6, 0xea, 0xd3, 0x97,
+		0x98, 0x0e, 0x8c, 0x90, 0x93, 0x25, 0xbc, 0x0c, 0x97, 0x0e, 0xc5, 0xf3, 0x3e, 0x27, 0x20, 0x57,
+		0x61, 0xfd, 0x29, 0xaa, 0x9e, 0xe8, 0x73, 0x83, 0x71, 0xd4, 0x1a, 0xac, 0x1e, 0xf0, 0x81, 0x46,
+		0x52, 0xaa, 0x8d, 0x7a, 0xd0, 0x23, 0x20, 0x17, 0x60, 0xb9, 0x8d, 0x46, 0x9d, 0xfa, 0x3f, 0x70,
+		0xfc, 0x71, 0x15, 0x2e, 0x16, 0xbb, 0x11, 0xfc, 0xbe, 0x02, 0xe7, 0xf3, 0xc5, 0x0d, 0x7e, 0x9b,
+		0x6c, 0xd8, 0x57, 0x03, 0x54, 0xe1, 0x0a, 0xb8, 0xf9, 0xdf, 0x06, 0x9c, 0x29, 0x72, 0x3b, 0xb4,
+		0x45, 0xcd, 0x04, 0xbc, 0x3d, 0x5d, 0x97, 0x6c, 0xbb, 0x11, 0xa8, 0xf0, 0xc6, 0x6c, 0xf1, 0x8e,
+		0x16, 0x7f, 0xe5, 0x83, 0x4a, 0x58, 0x2b, 0x9f, 0x4b, 0xb5, 0x50, 0xa7, 0x4a, 0x1c, 0x21, 0x29,
+		0x35, 0x0d, 0xa2, 0xa5, 0x66, 0xb1, 0x4e, 0xea, 0x7b, 0x78, 0xe3, 0x0b, 0xa1, 0x8d, 0xfd, 0xab,
+		0x66, 0x9b, 0x41, 0xae, 0x87, 0x28, 0x44, 0xb6, 0xe6, 0x03, 0x9d, 0x42, 0x0a, 0x6f, 0x7e, 0x9d,
+		0x75, 0xc6, 0x2d, 0xcb, 0xc2, 0x4c, 0x1f, 0x52, 0x68, 0x5c, 0xaf, 0x80, 0x74, 0x22, 0x5d, 0x38,
+		0x33, 0x73, 0x34, 0xb0, 0xd8, 0x32, 0xcc, 0x1c, 0x20, 0x56, 0xea, 0x46, 0x35, 0xb0, 0x53, 0xfb,
+		0x29, 0x81, 0x73, 0x87, 0x86, 0x2b, 0x53, 0xaa, 0x5f, 0x76, 0x33, 0x18, 0x28, 0x0c, 0x2e, 0xc4,
+		0x6f, 0xd5, 0xe2, 0xb8, 0x1c, 0x7e, 0x4b, 0xe0, 0xc2, 0x67, 0x58, 0x06, 0x3c, 0x11, 0xda, 0x48,
+		0x75, 0xca, 0xee, 0x06, 0x83, 0x12, 0x8c, 0x22, 0x9b, 0xbd, 0xfa, 0x44, 0x97, 0xd2, 0xdf, 0x09,
+		0xac, 0x86, 0x70, 0x6d, 0x7e, 0x52, 0x64, 0x75, 0xbf, 0x72, 0xf0, 0x09, 0xa9, 0x48, 0xec, 0xc1,
+		0x42, 0x5c, 0x97, 0xdb, 0x4b, 0x38, 0x7b, 0x20, 0xbb, 0xdd, 0xc7, 0x52, 0xf9, 0x17, 0x04, 0x6b,
+		0x06, 0x63, 0x06, 0x90, 0x45, 0x12, 0x1f, 0x56, 0x27, 0x38, 0xe5, 0xdf, 0x13, 0xb8, 0x18, 0xb8,
+		0x9b, 0xc6, 0x87, 0x0e, 0xdb, 0x8b, 0x1c, 0x0d, 0x71, 0x4a, 0x91, 0xcc, 0xbd, 0x05, 0x98, 0x2e,
+		0xab, 0x5f, 0x12, 0x58, 0xb6, 0xff, 0x09, 0xdc, 0x98, 0xec, 0x76, 0xd5, 0xc0, 0xc5, 0x45, 0x67,
+		0xf3, 0xb9, 0x53, 0x97, 0x56, 0xda, 0x1c, 0xff, 0xc8, 0xa6, 0x37, 0x67, 0xfa, 0x70, 0xaf, 0xb0,
+		0x39, 0xa1, 0xdb, 0x60, 0xd4, 0x45, 0x6d, 0x4c, 0xa5, 0x9a, 0xba, 0x49, 0x9f, 0x20, 0x57, 0xe6,
+		0x08, 0xb9, 0x89, 0x74, 0x11, 0xc1, 0xa0, 0xbb, 0x88, 0x24, 0xba, 0x94, 0xfe, 0x4a, 0xe0, 0x12,
+		0x81, 0xcb, 0x6f, 0x77, 0xf6, 0xa0, 0x6e, 0x74, 0xeb, 0x09, 0x5e, 0x35, 0x35, 0xaf, 0x94, 0x83,
+		0xce, 0x88, 0x2e, 0xe5, 0x88, 0xe9, 0xaa, 0x50, 0xca, 0xa4, 0x5d, 0x63, 0xff, 0x26, 0xb0, 0x36,
+		0xcf, 0xaf, 0xb1, 0xfd, 0xda, 0xf1, 0xfd, 0x25, 0xfb, 0x78, 0x41, 0x76, 0xb9, 0xd9, 0xca, 0x06,
+		0x90, 0x6e, 0xb6, 0x90, 0xab, 0xac, 0xd0, 0x6c, 0x71, 0x33, 0xca, 0xfe, 0x4c, 0xe0, 0x7d, 0xd2,
+		0x8d, 0xb2, 0x7b, 0xf5, 0x22, 0xfb, 0x0b, 0x75, 0x7f, 0x11, 0xea, 0x54, 0x2f, 0x46, 0x6d, 0x6d,
+		0xb4, 0x17, 0x09, 0xbf, 0x4c, 0x17, 0xfc, 0x5c, 0xa3, 0xcd, 0xfe, 0x19, 0xf5, 0x22, 0xe9, 0xb4,
+		0xa3, 0xbd, 0x38, 0xc7, 0x9f, 0xdb, 0xd4, 0xf6, 0x17, 0x23, 0x7b, 0x17, 0x2e, 0xed, 0xee, 0x59,
+		0x6c, 0x43, 0xe8, 0x91, 0x80, 0xba, 0x70, 0xab, 0x8d, 0x13, 0xec, 0xe7, 0x04, 0x96, 0x22, 0xf3,
+		0x04, 0x8b, 0x18, 0x9e, 0xd8, 0xf4, 0x61, 0xb3, 0xf9, 0xa8, 0x1e, 0xc9, 0xa5, 0xf1, 0x47, 0x02,
+		0x6b, 0x0e, 0x23, 0xcc, 0x8b, 0x88, 0x69, 0xdb, 0xa7, 0x42, 0x47, 0x69, 0xaf, 0x64, 0xdf, 0x7e,
+		0x4d, 0x60, 0x25, 0x3e, 0x4b, 0xb1, 0x70, 0x73, 0x53, 0xc3, 0x97, 0xcd, 0xe5, 0x6e, 0x6d, 0x9e,
+		0x67, 0x69, 0xc3, 0x53, 0x5b, 0xc4, 0xd2, 0xc6, 0x46, 0x3c, 0x6a, 0x4d, 0xe8, 0xb1, 0x70, 0x54,
+		0x32, 0x91, 0xb9, 0x30, 0x52, 0x32, 0xd1, 0x29, 0x92, 0x2a, 0x99, 0x39, 0xa3, 0x27, 0x53, 0xf0,
+		0x6e, 0x69, 0xf4, 0x64, 0x3b, 0x91, 0x50, 0xa5, 0x11, 0xd5, 0x2a, 0x37, 0xaa, 0xc2, 0x9d, 0xe6,
+		0x8f, 0xf0, 0x5e, 0x68, 0xa2, 0x65, 0x61, 0x47, 0x13, 0x1e, 0x7e, 0xad, 0xf2, 0x6e, 0x0d, 0x86,
+		0x13, 0x97, 0xf0, 0xce, 0xec, 0xb8, 0xcc, 0xc2, 0x03, 0x51, 0x79, 0xaa, 0xb6, 0xa2, 0x3b, 0x15,
+		0xd1, 0x5e, 0xf1, 0xe7, 0xa3, 0xe2, 0x97, 0x19, 0xf6, 0x4b, 0xfb, 0xa0, 0x23, 0xc5, 0x1f, 0x27,
+		0xd0, 0xc5, 0x4f, 0xf1, 0x3c, 0x5f, 0x93, 0xc3, 0x1e, 0x75, 0xa5, 0xc6, 0x4e, 0x20, 0xa3, 0xbd,
+		0x68, 0xe4, 0x18, 0x85, 0xf6, 0x35, 0x34, 0xb3, 0xec, 0x1a, 0xca, 0x4f, 0x15, 0xb4, 0x6b, 0x28,
+		0xe3, 0x2b, 0xb9, 0x86, 0xf8, 0x8b, 0x48, 0x6e, 0xd1, 0xdb, 0xa8, 0xd1, 0x1c, 0x1a, 0x91, 0x1e,
+		0x8f, 0x30, 0xf9, 0x6f, 0x88, 0x58, 0xf4, 0x00, 0x92, 0xb6, 0xe8, 0x41, 0x82, 0x53, 0x7e, 0x06,
+		0x6f, 0x8d, 0xf2, 0x2a, 0x56, 0x8a, 0x85, 0x9f, 0x05, 0xa6, 0x30, 0x85, 0xda, 0x76, 0x15, 0xa8,
+		0xb7, 0xdc, 0xc5, 0x23, 0x49, 0xf9, 0xfc, 0xb9, 0x4d, 0x3e, 0xaa, 0x44, 0x4f, 0xa0, 0x3b, 0x75,
+		0x69, 0x93, 0x96, 0x2c, 0x40, 0xe3, 0xb5, 0xbe, 0x41, 0xc6, 0x9a, 0x5d, 0xe8, 0x9d, 0x8a, 0x68,
+		0x2b, 0xf8, 0x70, 0x1d, 0x96, 0x52, 0xd9, 0x0b, 0x71, 0x0e, 0x92, 0x6f, 0x5f, 0xe3, 0x99, 0x18,
+		0xee, 0x1e, 0xbd, 0x3e, 0x7a, 0xa0, 0xbd, 0xf5, 0xff, 0x00, 0xbf, 0x06, 0xe7, 0x35, 0x05, 0x16,
+		0x00, 0x00,
+	},
+	// uber/cadence/api/v1/shared.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x6c, 0x1c, 0x49,
+		0x76, 0x58, 0x7a, 0x46, 0x94, 0xc4, 0x47, 0x4a, 0x1a, 0x95, 0x46, 0x12, 0x29, 0x89, 0x23, 0xb2,
+		0xa9, 0x8f, 0x11, 0x29, 0x91, 0x12, 0xa5, 0x5b, 0xad, 0xb4, 0xdc, 0xdd, 0x1b, 0xcd, 0x34, 0xc5,
+		0x39, 0x91, 0x43, 0x5e, 0xcd, 0x70, 0x75, 0xbb, 0x97, 0xb8, 0xdd, 0x9a, 0x69, 0x4a, 0x0d, 0x0d,
+		0x67, 0xe8, 0xee, 0xa6, 0x28, 0x9e, 0x63, 0xc3, 0x70, 0xce, 0xce, 0x87, 0x37, 0xbe, 0xb3, 0x0f,
+		0xd9, 0x1c, 0xd6, 0x40, 0x70, 0x38, 0x38, 0x76, 0x90, 0x38, 0x7b, 0xb8, 0xc0, 0xb0, 0x9d, 0x04,
+		0x70, 0xce, 0x1b, 0x27, 0x71, 0x8c, 0x38, 0x87, 0x24, 0x07, 0x3b, 0x09, 0x2e, 0x87, 0x04, 0x41,
+		0x8c, 0x18, 0x06, 0x1c, 0x3b, 0x40, 0x10, 0x24, 0x71, 0x90, 0xa0, 0xaa, 0xba, 0xa7, 0xbf, 0xaa,
+		0x7a, 0xba, 0x29, 0x6a, 0xb5, 0x6b, 0xeb, 0xdf, 0x4c, 0xd5, 0xab, 0xaa, 0x57, 0xef, 0xab, 0x5e,
+		0x55, 0xbd, 0x7a, 0x0d, 0xe3, 0x5b, 0x0f, 0x74, 0x73, 0xb6, 0xa9, 0xb5, 0xf4, 0x4e, 0x53, 0x9f,
+		0xd5, 0x36, 0x8d, 0xd9, 0x27, 0xd7, 0x66, 0xad, 0x47, 0x9a, 0xa9, 0xb7, 0x66, 0x36, 0xcd, 0xae,
+		0xdd, 0x45, 0xc7, 0x08, 0xc4, 0x8c, 0x03, 0x31, 0xa3, 0x6d, 0x1a, 0x33, 0x4f, 0xae, 0xc9, 0x5f,
+		0x94, 0x60, 0xff, 0xa2, 0xae, 0xb5, 0x74, 0x13, 0xbd, 0x09, 0xfb, 0xd7, 0x0d, 0xbd, 0xdd, 0xb2,
+		0x46, 0x60, 0x3c, 0x5b, 0x1c, 0x9a, 0xbb, 0x38, 0xc3, 0x69, 0x30, 0xc3, 0x80, 0x67, 0x16, 0x28,
+		0xa4, 0xd2, 0xb1, 0xcd, 0x1d, 0xec, 0x34, 0x3b, 0x75, 0x0b, 0x86, 0x7c, 0xc5, 0x28, 0x07, 0xd9,
+		0xc7, 0xfa, 0xce, 0x88, 0x34, 0x2e, 0x15, 0x07, 0x31, 0xf9, 0x89, 0xf2, 0x30, 0xf0, 0x44, 0x6b,
+		0x6f, 0xe9, 0x23, 0x99, 0x71, 0xa9, 0x38, 0x8c, 0xd9, 0x9f, 0xdb, 0x99, 0x57, 0x25, 0x59, 0x86,
+		0xe1, 0xfb, 0x5d, 0xf3, 0xf1, 0x7a, 0xbb, 0xbb, 0xdd, 0xd8, 0xd9, 0xd4, 0x11, 0x82, 0x7d, 0x1d,
+		0x6d, 0x43, 0x1f, 0x01, 0xda, 0x98, 0xfe, 0x26, 0x30, 0xa5, 0xa6, 0x6d, 0x3c, 0x31, 0xec, 0x1d,
+		0x21, 0xcc, 0x1a, 0x1c, 0x6c, 0x68, 0xd6, 0xe3, 0x25, 0xc3, 0xb2, 0x79, 0xf5, 0xe8, 0x53, 0xb0,
+		0xef, 0xb1, 0xd1, 0x69, 0x8d, 0xe4, 0xc7, 0xa5, 0xe2, 0xe1, 0xb9, 0x09, 0xee, 0x0c, 0xdd, 0x0e,
+		0xee, 0x19, 0x9d, 0x16, 0xa6, 0xe0, 0x72, 0x19, 0x72, 0x6e, 0xe9, 0xb2, 0x6e, 0x6b, 0x2d, 0xcd,
+		0xd6, 0xd0, 0x2c, 0xe4, 0x37, 0xb4, 0xa7, 0xaa, 0xad, 0x59, 0x8f, 0x2d, 0x75, 0x53, 0x37, 0x55,
+		0x4b, 0x6f, 0x76, 0x3b, 0x2d, 0x3a, 0x9c, 0x84, 0x8f, 0x6e, 0x68, 0x4f, 0x49, 0x13, 0x6b, 0x55,
+		0x37, 0xeb, 0xb4, 0x42, 0xbe, 0x07, 0x47, 0xdd, 0x39, 0x2a, 0x4f, 0xf5, 0xe6, 0x96, 0x6d, 0x74,
+		0x3b, 0xe8, 0x2c, 0x0c, 0x6d, 0x3b, 0x85, 0xaa, 0xd1, 0x72, 0x70, 0x05, 0xb7, 0xa8, 0xda, 0x42,
+		0xc7, 0x61, 0xbf, 0xb9, 0xd5, 0x51, 0x0d, 0x86, 0xf3, 0x20, 0x1e, 0x30, 0xb7, 0x3a, 0xd5, 0x96,
+		0xfc, 0xed, 0x0c, 0x1c, 0x8f, 0xf4, 0x56, 0xed, 0xac, 0x77, 0x51, 0x05, 0x06, 0x75, 0xb7, 0x80,
+		0xf6, 0x37, 0x34, 0x77, 0x81, 0x3b, 0xcf, 0x48, 0x73, 0xec, 0x35, 0x24, 0x84, 0xb2, 0x77, 0x36,
+		0x75, 0x3a, 0xe8, 0xd0, 0xdc, 0x44, 0x6c, 0x07, 0x84, 0x1b, 0x98, 0x82, 0xa3, 0x31, 0x00, 0xcb,
+		0xd6, 0x4c, 0x5b, 0xb5, 0x8d, 0x0d, 0x7d, 0xa4, 0x30, 0x2e, 0x15, 0xb3, 0x78, 0x90, 0x96, 0x34,
+		0x8c, 0x0d, 0x5a, 0xdd, 0x6c, 0x77, 0x2d, 0x9d, 0x55, 0x17, 0x59, 0x35, 0x2d, 0xa1, 0xd5, 0x0d,
+		0x18, 0x66, 0xd5, 0x96, 0xad, 0xd9, 0x5b, 0xd6, 0xc8, 0x1c, 0xe5, 0xd2, 0xb5, 0x64, 0xd8, 0x97,
+		0x49, 0xcb, 0x3a, 0x6d, 0x88, 0x87, 0x9a, 0xde, 0x1f, 0x74, 0x1e, 0x0e, 0x3f, 0x32, 0x2c, 0xbb,
+		0x6b, 0xee, 0xa8, 0x6d, 0xbd, 0xf3, 0xd0, 0x7e, 0x34, 0x32, 0x4f, 0x07, 0x3e, 0xe4, 0x94, 0x2e,
+		0xd1, 0x42, 0xf9, 0x9f, 0x64, 0xa0, 0x10, 0xed, 0xb4, 0xdb, 0x59, 0x37, 0x1e, 0x6e, 0x99, 0x1a,
+		0x25, 0xca, 0x6d, 0x18, 0x24, 0xec, 0x56, 0xdb, 0x86, 0x65, 0x3b, 0xa4, 0x1d, 0x8b, 0x15, 0x21,
+		0x7c, 0xd0, 0x76, 0x7e, 0xa1, 0x35, 0x28, 0xf6, 0xa8, 0xab, 0x3a, 0x34, 0xea, 0xaa, 0x1e, 0x35,
+		0xba, 0x5b, 0xb6, 0x23, 0x41, 0x16, 0x25, 0xfa, 0x00, 0x9e, 0xec, 0xc1, 0xd7, 0x29, 0x01, 0xbb,
+		0x65, 0x97, 0x50, 0xdd, 0x2d, 0x9b, 0xc9, 0x94, 0x85, 0xee, 0xc1, 0x24, 0x45, 0xa9, 0x4f, 0x8f,
+		0x05, 0xda, 0x63, 0x81, 0x80, 0xc6, 0x74, 0x56, 0x86, 0xe1, 0xe6, 0x23, 0xa3, 0xdd, 0x52, 0x37,
+		0xbb, 0x6d, 0xa3, 0xb9, 0x43, 0x19, 0x74, 0x78, 0x6e, 0x9c, 0x3b, 0xc5, 0x32, 0x01, 0x5c, 0xa5,
+		0x70, 0x78, 0xa8, 0xe9, 0xfd, 0x91, 0xbf, 0xb3, 0x0f, 0x2e, 0xd4, 0x9b, 0x8f, 0xf4, 0xd6, 0x56,
+		0x5b, 0xef, 0xe9, 0xab, 0x66, 0x3d, 0xae, 0xe8, 0x4d, 0xc3, 0x32, 0xba, 0x9d, 0x92, 0x6d, 0x9b,
+		0xc6, 0x83, 0x2d, 0x5b, 0xb7, 0x88, 0xf0, 0x6b, 0x0e, 0x84, 0x4f, 0xf8, 0xdd, 0xa2, 0x6a, 0x0b,
+		0x2d, 0xc0, 0xa1, 0x1e, 0x40, 0x5f, 0x71, 0xf4, 0x1b, 0x07, 0x3c, 0xac, 0xf9, 0xfe, 0xa1, 0x13,
+		0xb0, 0xbf, 0xd5, 0xdd, 0xd0, 0x8c, 0xce, 0xc8, 0x28, 0x1d, 0xc3, 0xf9, 0x17, 0x64, 0x68, 0x21,
+		0x1d, 0x43, 0xf3, 0x30, 0x60, 0x74, 0x36, 0xb7, 0x6c, 0x4a, 0xa5, 0x61, 0xcc, 0xfe, 0xa0, 0x45,
+		0x98, 0xb0, 0x9c, 0xc9, 0x8b, 0xb9, 0x71, 0x85, 0x72, 0x63, 0xcc, 0x05, 0xe4, 0x33, 0x23, 0xd4,
+		0x93, 0xa7, 0x56, 0xfe, 0x9e, 0xe6, 0xc2, 0x3d, 0xd5, 0x5d, 0x5d, 0xf3, 0xf5, 0x74, 0x07, 0x0a,
+		0x7d, 0xc4, 0xe3, 0x26, 0xed, 0xe6, 0x94, 0x25, 0x16, 0x8d, 0xdb, 0x30, 0xfa, 0x48, 0xd7, 0x4c,
+		0xfb, 0x81, 0xae, 0x45, 0xb1, 0x98, 0xa7, 0xcd, 0x4f, 0xf6, 0x00, 0xa2, 0x62, 0x65, 0xea, 0xb6,
+		0xb9, 0xe3, 0x8a, 0xd5, 0x02, 0x25, 0x34, 0x5f, 0xac, 0x30, 0x01, 0x74, 0xc5, 0xca, 0xf4, 0xfe,
+		0xc8, 0x35, 0x98, 0xc6, 0xfa, 0x0f, 0x6c, 0xe9, 0x96, 0x5d, 0xd6, 0x3a, 0x4d, 0xbd, 0xfd, 0x8c,
+		0xa2, 0x25, 0xff, 0x20, 0x9c, 0xe9, 0xd1, 0xca, 0xe4, 0x74, 0x30, 0x0a, 0x07, 0xc9, 0x34, 0x4d,
+		0xaf, 0xf5, 0x01, 0xfa, 0xbf, 0xda, 0x42, 0x9f, 0x86, 0xb1, 0x1e, 0x3d, 0xd7, 0x0d, 0x93, 0xaf,
+		0xbf, 0x59, 0x3c, 0xea, 0x90, 0x73, 0xc1, 0x30, 0x43, 0xd4, 0x94, 0x15, 0x98, 0x2e, 0x77, 0x37,
+		0x36, 0xdb, 0xba, 0xad, 0x47, 0x4c, 0x0e, 0x07, 0x97, 0x13, 0xb0, 0xdf, 0xd4, 0xad, 0xad, 0x36,
+		0x33, 0x3a, 0xc3, 0xd8, 0xf9, 0x27, 0x7f, 0x1e, 0x2e, 0x2e, 0x68, 0x46, 0x3b, 0x71, 0x17, 0x9a,
+		0xe5, 0x2c, 0x09, 0x83, 0xd8, 0xf9, 0x87, 0x46, 0xe0, 0x40, 0x4b, 0xb7, 0x35, 0xa3, 0xcd, 0xb0,
+		0x1e, 0xc6, 0xee, 0x5f, 0xf9, 0x36, 0x8c, 0x31, 0x4a, 0xa7, 0xa7, 0x90, 0xac, 0xc0, 0x25, 0xd6,
+		0x36, 0x09, 0x6a, 0x3e, 0x14, 0x20, 0x88, 0xc2, 0x77, 0x24, 0x78, 0x35, 0xc0, 0x74, 0xe5, 0xa9,
+		0xad, 0x9b, 0x1d, 0x2d, 0xe9, 0x8c, 0x1d, 0x9d, 0x87, 0x80, 0xce, 0x87, 0x56, 0xdc, 0x7c, 0xcc,
+		0x8a, 0x5b, 0xf0, 0xad, 0xb8, 0x04, 0xcd, 0x66, 0xb7, 0x63, 0x9b, 0xdd, 0xb6, 0xa3, 0xf1, 0xee,
+		0x5f, 0x34, 0x03, 0xc7, 0x98, 0xd9, 0xec, 0xf5, 0xdb, 0xed, 0xb4, 0x77, 0xa8, 0x6e, 0x1e, 0xc4,
+		0x47, 0x69, 0x95, 0x8b, 0xf0, 0x4a, 0xa7, 0xbd, 0x23, 0xff, 0x74, 0x06, 0xae, 0xd5, 0x8d, 0x87,
+		0x1d, 0x6d, 0x4f, 0xe6, 0x13, 0x58, 0xef, 0xf3, 0xbb, 0x5d, 0xef, 0xcf, 0xc2, 0x90, 0x45, 0x51,
+		0x52, 0xa9, 0xcf, 0xc4, 0x66, 0x0e, 0xac, 0xa8, 0x46, 0x3c, 0x27, 0xbe, 0xb9, 0xf3, 0x11, 0x65,
+		0x2e, 0x11, 0x51, 0xe6, 0x45, 0x44, 0xf9, 0xb2, 0x04, 0x05, 0xac, 0x37, 0xbb, 0x66, 0x6b, 0x59,
+		0x33, 0x1f, 0x73, 0x05, 0xee, 0x2c, 0x0c, 0x6d, 0xd0, 0x3a, 0xd5, 0xe7, 0xd7, 0x01, 0x2b, 0xa2,
+		0x38, 0x0a, 0x85, 0x19, 0x5d, 0x87, 0xfd, 0x8f, 0xa8, 0xe3, 0xea, 0x58, 0xf9, 0xd3, 0x31, 0xbe,
+		0x2d, 0x76, 0x40, 0xe5, 0xff, 0x97, 0x85, 0xab, 0xe5, 0x6e, 0xc7, 0x36, 0x3a, 0x5b, 0x7a, 0xc9,
+		0xaa, 0xe9, 0xdb, 0x49, 0xd8, 0xb4, 0x00, 0x87, 0x7a, 0x33, 0xa6, 0x4b, 0x16, 0x24, 0xf5, 0xa0,
+		0x86, 0xb7, 0x7d, 0xff, 0x82, 0x4b, 0x53, 0x7e, 0x97, 0x4b, 0x53, 0xc1, 0xcf, 0xab, 0x34, 0x1e,
+		0x48, 0x71, 0xcf, 0x3d, 0x90, 0xb9, 0x44, 0x1e, 0xc8, 0x22, 0x4c, 0x3c, 0xd0, 0x9a, 0x8f, 0xbb,
+		0xeb, 0xeb, 0x4e, 0x7f, 0x46, 0xc7, 0xd6, 0xcd, 0x27, 0x5a, 0x5b, 0x35, 0x3a, 0xa1, 0xe5, 0x66,
+		0xcc, 0x01, 0xa4, 0xbd, 0x55, 0x1d, 0xb0, 0x6a, 0x67, 0x4f, 0x17, 0x9d, 0x6f, 0x0c, 0xc0, 0x15,
+		0xda, 0x7f, 0xd9, 0x2f, 0xaf, 0xcf, 0xc5, 0xea, 0x44, 0xe4, 0xa6, 0xb0, 0x07, 0x72, 0x53, 0xdc,
+		0xa5, 0xdc, 0xcc, 0xed, 0x56, 0x6e, 0xe6, 0xf7, 0x5c, 0x6e, 0x16, 0x76, 0xe5, 0xb9, 0xae, 0xee,
+		0xc2, 0x73, 0xf5, 0x1b, 0xb3, 0x77, 0x82, 0xc6, 0xac, 0x09, 0x23, 0x3e, 0xee, 0xa9, 0xa6, 0xbe,
+		0x65, 0xe9, 0xee, 0x50, 0x2d, 0x3a, 0xd4, 0x54, 0x2c, 0x9f, 0xaa, 0x2d, 0x4c, 0x9a, 0x38, 0x83,
+		0x1e, 0xdf, 0xe6, 0x15, 0x47, 0x24, 0xb6, 0xb3, 0x1b, 0x89, 0xfd, 0x83, 0x23, 0x70, 0xd0, 0x15,
+		0x4b, 0x22, 0x53, 0x2d, 0xe7, 0xb7, 0x67, 0x8b, 0x44, 0xdb, 0x5e, 0xb7, 0x15, 0x93, 0xa9, 0x96,
+		0xef, 0x1f, 0xfa, 0xeb, 0x12, 0x4c, 0xf5, 0x7c, 0x51, 0xcf, 0x21, 0x27, 0xdc, 0xeb, 0xf5, 0xaf,
+		0xf5, 0x74, 0xc0, 0xb1, 0x56, 0xaf, 0x71, 0x47, 0x49, 0xb6, 0x33, 0xc0, 0x17, 0xac, 0x44, 0x70,
+		0xe8, 0x29, 0x9c, 0xf5, 0x1c, 0x63, 0x93, 0x8b, 0xcd, 0x28, 0xc5, 0x86, 0xbf, 0x89, 0x8c, 0xf3,
+		0x00, 0xf1, 0x19, 0x2b, 0xa6, 0x16, 0xfd, 0x9c, 0x04, 0xb3, 0x4d, 0xc7, 0x87, 0xf3, 0xd6, 0x38,
+		0x4f, 0x51, 0x78, 0xa8, 0x30, 0x95, 0xfe, 0x34, 0x5f, 0x2a, 0x93, 0xfb, 0x83, 0x78, 0xba, 0x99,
+		0x1c, 0x18, 0xbd, 0x2f, 0xc1, 0xf4, 0xba, 0x66, 0xb4, 0x93, 0x22, 0x39, 0x49, 0x91, 0x9c, 0xe7,
+		0x22, 0x99, 0xd0, 0xdb, 0xc4, 0x17, 0xd7, 0x93, 0x01, 0xa2, 0x9f, 0x97, 0xe0, 0xaa, 0xc9, 0x3c,
+		0x3c, 0xb5, 0x49, 0x5d, 0xbc, 0x04, 0xf2, 0x55, 0x8c, 0x21, 0x63, 0x8a, 0x3d, 0x02, 0x9e, 0x36,
+		0x93, 0x03, 0xa3, 0x1f, 0x84, 0x71, 0x07, 0x41, 0xb1, 0xa8, 0xcd, 0x51, 0xc4, 0xe6, 0xf8, 0xfc,
+		0x8d, 0xf3, 0xa5, 0xf1, 0x58, 0x33, 0xae, 0x1a, 0x7d, 0x4d, 0x82, 0x2b, 0xce, 0xe8, 0x09, 0xb9,
+		0x38, 0x4f, 0x51, 0x79, 0x23, 0x06, 0x95, 0x24, 0x7c, 0xbc, 0xd4, 0x4c, 0x0a, 0x8a, 0xbe, 0x2d,
+		0xc1, 0x1b, 0x21, 0x4e, 0xea, 0x8e, 0x77, 0x9b, 0x14, 0x67, 0xb6, 0x44, 0x2f, 0xf7, 0xe7, 0x6b,
+		0x0a, 0xb7, 0x19, 0xbf, 0x6a, 0xee, 0xb2, 0x25, 0xfa, 0x61, 0x98, 0x30, 0xa9, 0x43, 0xaa, 0x3a,
+		0x5e, 0x27, 0x0f, 0xe7, 0x55, 0x8a, 0xf3, 0x75, 0x01, 0xce, 0x71, 0xee, 0x2c, 0x2e, 0x98, 0xb1,
+		0xf5, 0xe8, 0x97, 0x25, 0x78, 0xa5, 0xe9, 0xb8, 0x9f, 0xaa, 0x66, 0xa9, 0x1d, 0x7d, 0x3b, 0x29,
+		0x25, 0xdf, 0xa1, 0x58, 0x29, 0x02, 0x43, 0x93, 0xce, 0xa3, 0xc5, 0x57, 0x9b, 0x29, 0x5b, 0xa0,
+		0xbf, 0x2b, 0xc1, 0x1c, 0x33, 0xcb, 0xa1, 0x2d, 0x40, 0x3c, 0xd6, 0x2d, 0x8a, 0xf5, 0x1d, 0xb1,
+		0xa5, 0x4e, 0xea, 0x85, 0xe1, 0x2b, 0x56, 0x1a, 0x70, 0xf4, 0xf7, 0x25, 0x78, 0xc5, 0xd9, 0xfd,
+		0xa4, 0x95, 0x59, 0xb6, 0x48, 0x2f, 0xf0, 0x71, 0x4e, 0xbb, 0xc7, 0xc3, 0xd7, 0xac, 0xb4, 0x4d,
+		0xe4, 0x7f, 0x70, 0x00, 0x2e, 0x46, 0xe0, 0x28, 0xb5, 0xf4, 0x96, 0xf2, 0x44, 0xef, 0xd8, 0xcf,
+		0x61, 0x6f, 0x72, 0x03, 0x4e, 0x6c, 0x6a, 0xa6, 0xde, 0xb1, 0x3d, 0x2a, 0x39, 0x4e, 0xef, 0x30,
+		0xf5, 0x6b, 0xf3, 0xac, 0xd6, 0xed, 0xa1, 0x42, 0xeb, 0xd0, 0x03, 0x18, 0x0d, 0xb7, 0xf2, 0x36,
+		0xae, 0x87, 0x53, 0x6d, 0x5c, 0x4f, 0x06, 0x07, 0xe8, 0x55, 0xa0, 0x5b, 0xbd, 0x31, 0x8c, 0x8e,
+		0x61, 0x1b, 0x9a, 0xad, 0xb7, 0x54, 0xfd, 0x09, 0xfd, 0xdf, 0x1a, 0xc9, 0xd1, 0x63, 0x19, 0x07,
+		0xf5, 0xaa, 0x5b, 0x4f, 0x89, 0x54, 0x6d, 0xfd, 0x29, 0xdd, 0x70, 0x85, 0x1d, 0xe7, 0x1b, 0xbb,
+		0x71, 0x9c, 0x5f, 0x83, 0x53, 0xae, 0x8d, 0x68, 0xf9, 0xf4, 0xc5, 0x39, 0x45, 0x79, 0x85, 0xca,
+		0xc4, 0xc9, 0x1e, 0x84, 0xc7, 0x46, 0x7a, 0xae, 0x72, 0x0a, 0x0e, 0x1a, 0x2d, 0xbd, 0x63, 0x1b,
+		0x36, 0x3b, 0x1d, 0x18, 0xc4, 0xbd, 0xff, 0x7b, 0xb2, 0x89, 0x23, 0x6e, 0xbd, 0x66, 0xdb, 0xfa,
+		0xc6, 0xa6, 0x4d, 0xad, 0xf5, 0x00, 0x76, 0xff, 0xa2, 0x6b, 0x90, 0xd7, 0x9f, 0x6e, 0x1a, 0xec,
+		0x74, 0x9f, 0x12, 0xd0, 0xb2, 0xb5, 0x8d, 0x4d, 0x6a, 0x3e, 0xb3, 0xf8, 0x98, 0x57, 0xd7, 0x70,
+		0xab, 0xd0, 0x12, 0x4c, 0xae, 0x1b, 0xa6, 0x65, 0x7b, 0xc6, 0x80, 0xf2, 0xa2, 0xb7, 0x69, 0x75,
+		0x88, 0xdf, 0xa2, 0x03, 0x9d, 0xa5, 0xa0, 0x3d, 0xc7, 0x5a, 0xb3, 0x1e, 0xdf, 0x71, 0xf6, 0xac,
+		0xce, 0x39, 0xe0, 0x4f, 0x48, 0x70, 0x89, 0x73, 0xe7, 0xc0, 0x9c, 0xbb, 0x88, 0xfa, 0x0a, 0x8e,
+		0x01, 0xd1, 0x02, 0x8c, 0x07, 0xb1, 0x71, 0xdd, 0x43, 0x9f, 0xec, 0xb3, 0x23, 0xc9, 0x33, 0x2d,
+		0x1f, 0x2e, 0xc1, 0x71, 0xaa, 0x2d, 0xf9, 0xeb, 0x12, 0x5c, 0x88, 0x60, 0x43, 0x5c, 0x3e, 0x01,
+		0x2a, 0x69, 0x8e, 0x13, 0x13, 0x21, 0x59, 0x48, 0x80, 0x64, 0x17, 0x8a, 0x11, 0x1c, 0x09, 0x7b,
+		0x5a, 0x2b, 0x5b, 0x76, 0x18, 0xcb, 0x32, 0x0c, 0xbb, 0x5a, 0xe1, 0xdb, 0xfe, 0xf0, 0xc5, 0xc7,
+		0xd1, 0x0b, 0x6a, 0xed, 0x86, 0x6c, 0xef, 0x8f, 0xfc, 0xcd, 0x7d, 0x30, 0xc3, 0xbb, 0x17, 0x62,
+		0xc2, 0x4c, 0xd7, 0xc4, 0xf0, 0xb8, 0xb3, 0x90, 0x27, 0x8b, 0x75, 0x44, 0x13, 0x18, 0xad, 0x8e,
+		0x76, 0xf4, 0xed, 0x90, 0x0e, 0x44, 0x0c, 0x73, 0x7e, 0x0f, 0x36, 0xff, 0x7b, 0x72, 0x9f, 0x91,
+		0xc6, 0x86, 0xcd, 0xed, 0xb9, 0x0d, 0x9b, 0x4f, 0x64, 0xc3, 0x92, 0x88, 0xd6, 0x42, 0x7f, 0xd1,
+		0x4a, 0x76, 0xf8, 0xb4, 0x9a, 0xe0, 0xf0, 0x49, 0xfe, 0x96, 0x04, 0xe7, 0xfc, 0x6a, 0xef, 0xee,
+		0x7a, 0x23, 0x7a, 0xf4, 0x2c, 0x37, 0x8a, 0xfd, 0xaf, 0x75, 0xf2, 0x7d, 0xaf, 0x75, 0x7c, 0xb6,
+		0x91, 0x29, 0x9f, 0xfb, 0x57, 0xfe, 0xab, 0x12, 0xc8, 0x81, 0x29, 0xf0, 0x5d, 0x8a, 0xcb, 0x80,
+		0xdc, 0xad, 0xba, 0x8f, 0xda, 0x40, 0xfb, 0xca, 0x59, 0x81, 0x69, 0x87, 0x6c, 0x7d, 0x3e, 0x64,
+		0xeb, 0xc7, 0x00, 0xdc, 0xfd, 0x43, 0xef, 0xe8, 0x7d, 0xd0, 0x29, 0xa9, 0xb6, 0xe4, 0x3f, 0x0c,
+		0x91, 0x54, 0x68, 0x25, 0xa7, 0xe1, 0xa8, 0x27, 0xb1, 0x64, 0xd1, 0xd1, 0x9f, 0xba, 0x06, 0x33,
+		0xa7, 0xfb, 0xf5, 0x57, 0x7f, 0x6a, 0x0b, 0xd0, 0xcf, 0x0b, 0xd0, 0x2f, 0x42, 0xce, 0x62, 0x64,
+		0x08, 0xdb, 0xac, 0xc3, 0x96, 0x8f, 0x3c, 0xa1, 0x89, 0x16, 0x43, 0x13, 0xbd, 0x08, 0x47, 0x1e,
+		0x18, 0x1d, 0xcd, 0xdc, 0x51, 0x9b, 0x8f, 0xf4, 0xe6, 0x63, 0x6b, 0x6b, 0x83, 0x6a, 0xce, 0x20,
+		0x3e, 0xcc, 0x8a, 0xcb, 0x4e, 0xa9, 0xfc, 0xa1, 0x04, 0x93, 0xfe, 0x29, 0x8b, 0xcc, 0x5c, 0x3a,
+		0x1e, 0xf0, 0x26, 0x91, 0xe7, 0x4e, 0x22, 0x6c, 0x3e, 0x0b, 0xbb, 0x31, 0x9f, 0xff, 0x43, 0x82,
+		0x09, 0xff, 0x24, 0xf8, 0xeb, 0xc9, 0xf3, 0x9a, 0xc2, 0x1d, 0x18, 0x68, 0x6a, 0x5b, 0x96, 0x8b,
+		0xfb, 0xe5, 0xf8, 0x93, 0xaf, 0x1e, 0x7a, 0x65, 0xd2, 0x06, 0xb3, 0xa6, 0xfe, 0x35, 0x6d, 0x32,
+		0xb8, 0xa6, 0xc5, 0x70, 0x59, 0x7e, 0x7f, 0x00, 0xce, 0xf9, 0x8f, 0x14, 0x84, 0x26, 0xe0, 0xe5,
+		0x25, 0xf8, 0x9f, 0x8a, 0x4b, 0xf0, 0x24, 0x8b, 0xd4, 0x3b, 0x09, 0x16, 0xa9, 0x3d, 0x39, 0x25,
+		0xfe, 0x79, 0x09, 0xe4, 0x80, 0x70, 0xbe, 0x58, 0xe3, 0xee, 0x5f, 0x86, 0x8a, 0x01, 0x17, 0x5d,
+		0xfe, 0x4d, 0x29, 0xa8, 0x46, 0xa9, 0x9d, 0xe3, 0x17, 0x61, 0xe1, 0x3d, 0xef, 0x78, 0xce, 0xef,
+		0x1d, 0xcb, 0xff, 0x5c, 0x82, 0x09, 0xff, 0x64, 0xf6, 0xca, 0xb7, 0xe6, 0xcf, 0xb1, 0x90, 0x62,
+		0x8e, 0xc5, 0xbe, 0x73, 0x9c, 0x0b, 0xd9, 0xb7, 0xef, 0x4a, 0x30, 0xe9, 0x9f, 0x8b, 0x68, 0x71,
+		0xf2, 0x61, 0x3d, 0x90, 0x04, 0xeb, 0x8f, 0xc9, 0xb2, 0xf5, 0xd7, 0x24, 0x98, 0x0a, 0xc8, 0x1d,
+		0x3d, 0x3e, 0x74, 0x4e, 0x21, 0x77, 0x65, 0xc4, 0xf7, 0x66, 0x8f, 0xf6, 0x0d, 0x09, 0xc4, 0x67,
+		0xdc, 0x7c, 0x79, 0xea, 0x8b, 0x5b, 0xde, 0x5d, 0x24, 0x9d, 0x08, 0x43, 0xfa, 0x67, 0xcf, 0x36,
+		0x6c, 0xff, 0x27, 0x24, 0x28, 0x0c, 0x6d, 0xbd, 0x15, 0x23, 0x28, 0x10, 0xd9, 0x3a, 0xb6, 0x35,
+		0xdb, 0x77, 0xb0, 0x6c, 0xba, 0x6c, 0x88, 0xd0, 0x8e, 0xc1, 0xf1, 0x98, 0x55, 0x6d, 0xbd, 0x10,
+		0x35, 0xf9, 0x0d, 0x09, 0x2e, 0xf9, 0x67, 0x4f, 0x4d, 0xb2, 0x50, 0x8c, 0xd2, 0xa9, 0x44, 0x88,
+		0xb1, 0xf9, 0x08, 0x63, 0x43, 0xde, 0xbd, 0xef, 0xe4, 0xc3, 0xb3, 0x31, 0xc5, 0x80, 0x8d, 0x89,
+		0x9b, 0xca, 0x87, 0x12, 0x9c, 0xa6, 0x17, 0x14, 0x82, 0xd5, 0xe2, 0x79, 0x46, 0x4c, 0xed, 0x99,
+		0x34, 0x7e, 0x3f, 0x8c, 0xd2, 0x39, 0x90, 0x21, 0xd2, 0xcc, 0x20, 0xb1, 0xf9, 0x91, 0xff, 0x99,
+		0x04, 0x67, 0xe8, 0x10, 0x22, 0x41, 0xdf, 0x8b, 0x51, 0xf6, 0x8a, 0x1e, 0xb1, 0x2e, 0xec, 0x2f,
+		0x4b, 0x30, 0xee, 0xbb, 0xb6, 0xe2, 0x5b, 0x97, 0x98, 0xd9, 0x3c, 0x57, 0xbb, 0x12, 0x8b, 0xf9,
+		0x5f, 0xc9, 0xc0, 0x6c, 0xf4, 0xcc, 0x26, 0xde, 0x84, 0xf7, 0xb0, 0x05, 0x3f, 0xb6, 0xaf, 0xc3,
+		0xe9, 0xde, 0x95, 0x00, 0xe7, 0x48, 0x99, 0x31, 0x67, 0xc4, 0x05, 0x89, 0x1c, 0x2a, 0xaf, 0xfb,
+		0x9a, 0x73, 0x4e, 0xbd, 0x0b, 0xa9, 0x4e, 0xbd, 0x47, 0x75, 0xd1, 0xbd, 0x40, 0x2c, 0x31, 0xde,
+		0x95, 0xa0, 0x28, 0x20, 0x06, 0xef, 0x8a, 0xa0, 0x3f, 0x77, 0x20, 0x01, 0x77, 0xc4, 0x71, 0x85,
+		0xbf, 0x23, 0xc1, 0x18, 0xbb, 0xf3, 0x62, 0xf7, 0x63, 0xdc, 0x05, 0x6b, 0xb7, 0x71, 0x5e, 0x7b,
+		0x25, 0x5c, 0x5e, 0xbc, 0x58, 0x31, 0x79, 0xbc, 0xd8, 0x0f, 0x71, 0xe8, 0xcc, 0xee, 0x80, 0xb8,
+		0x73, 0xf4, 0xc7, 0xdb, 0x81, 0x38, 0xde, 0x2e, 0xef, 0xdf, 0x59, 0xf9, 0xf9, 0x5c, 0x08, 0xf1,
+		0xf9, 0x0b, 0x30, 0x15, 0x3d, 0x19, 0xd5, 0xcd, 0x0d, 0xa3, 0xa3, 0xd9, 0x7b, 0xe1, 0x65, 0xc6,
+		0x8d, 0xfd, 0x67, 0x39, 0x27, 0xc7, 0xab, 0x44, 0x81, 0x12, 0x8f, 0x1b, 0xb3, 0x7b, 0x90, 0xff,
+		0x1c, 0xe7, 0x8a, 0x0b, 0xeb, 0xd6, 0xd6, 0xc6, 0xde, 0x74, 0xff, 0x6b, 0x19, 0x78, 0x23, 0xd9,
+		0xfd, 0x72, 0x50, 0xbf, 0x9f, 0x83, 0xda, 0x78, 0x7b, 0xf4, 0x7c, 0x60, 0x8f, 0xbe, 0x06, 0xe8,
+		0x99, 0xcd, 0xc7, 0xd1, 0xed, 0x70, 0xd1, 0x1e, 0xc6, 0xb4, 0x7e, 0x25, 0x0b, 0xaf, 0x25, 0xa3,
+		0x21, 0x7f, 0x19, 0x59, 0xf3, 0x5b, 0xdf, 0xc3, 0x73, 0x6f, 0xc6, 0x04, 0x2e, 0xf4, 0xe9, 0x39,
+		0x70, 0x76, 0xb3, 0x47, 0x6e, 0xb7, 0x8f, 0x2f, 0x85, 0x04, 0x7c, 0x29, 0x3e, 0x2b, 0x5f, 0x2e,
+		0x03, 0xe2, 0x2c, 0x36, 0x73, 0xcc, 0x0f, 0x34, 0xc2, 0x8b, 0x8c, 0x8f, 0x8b, 0xf3, 0x01, 0x2e,
+		0xca, 0xbf, 0x2d, 0xc1, 0x4d, 0x21, 0xb9, 0xfa, 0xac, 0x87, 0x7c, 0x1c, 0x40, 0x80, 0xc3, 0x47,
+		0x2b, 0xb8, 0xf2, 0x1f, 0x65, 0xe0, 0x66, 0x9f, 0xeb, 0xf5, 0x3f, 0x69, 0xba, 0x1a, 0x5a, 0x31,
+		0x8a, 0xe2, 0x15, 0x63, 0x4e, 0x10, 0xa1, 0x3d, 0x9f, 0x48, 0xc5, 0x17, 0x44, 0x2a, 0xfe, 0x6e,
+		0x16, 0x6e, 0xf4, 0xa1, 0xf9, 0x33, 0xe8, 0x76, 0xa2, 0x9e, 0x5f, 0xea, 0xb6, 0xa7, 0xdb, 0xbf,
+		0x27, 0xc1, 0x55, 0x21, 0xb9, 0x44, 0x6e, 0xc7, 0xc7, 0x59, 0xa9, 0xc5, 0xab, 0x91, 0xfc, 0x7f,
+		0x07, 0xe0, 0x7a, 0x4c, 0x04, 0x90, 0x50, 0xd5, 0x5f, 0x46, 0x63, 0xff, 0x49, 0x8c, 0xc6, 0x4e,
+		0xa2, 0xf8, 0xad, 0x04, 0x8a, 0x1f, 0x17, 0xd5, 0xdd, 0x79, 0x5e, 0x51, 0xdd, 0x4f, 0x77, 0x73,
+		0x5e, 0xff, 0x53, 0x59, 0xb8, 0x1a, 0x23, 0xff, 0xc2, 0x73, 0xe4, 0x17, 0x2b, 0xfc, 0x4b, 0xae,
+		0xdd, 0x67, 0xef, 0x48, 0x5f, 0x11, 0xf3, 0x3f, 0x89, 0xb9, 0x17, 0x3f, 0x35, 0xe2, 0x1b, 0xb5,
+		0x79, 0x81, 0x51, 0xdb, 0xa3, 0x68, 0x01, 0xf9, 0x2b, 0x19, 0xb8, 0xcc, 0x47, 0x5e, 0x70, 0x3e,
+		0x26, 0xe2, 0x07, 0x1f, 0xfd, 0xbc, 0x00, 0xfd, 0xe7, 0x64, 0x7b, 0x23, 0x3c, 0x2f, 0xee, 0x8a,
+		0xe7, 0xf2, 0xef, 0x64, 0x40, 0xc0, 0xd2, 0xd4, 0x37, 0x37, 0x1f, 0xf1, 0xea, 0xb3, 0x47, 0x14,
+		0x48, 0xb9, 0xbe, 0xf3, 0x4e, 0xfc, 0xe6, 0xb9, 0xe7, 0x8a, 0x7f, 0x90, 0x81, 0xe9, 0x94, 0xea,
+		0x9f, 0x72, 0x83, 0xff, 0x11, 0x3b, 0x42, 0x11, 0x82, 0xcf, 0xed, 0x25, 0xc1, 0xe7, 0x53, 0x10,
+		0x7c, 0x81, 0x4b, 0xf0, 0x7f, 0x9b, 0x81, 0x2b, 0x02, 0x51, 0x4e, 0x7d, 0x85, 0xf1, 0x52, 0x96,
+		0x03, 0xa4, 0xfd, 0x0b, 0x59, 0x11, 0x69, 0x9f, 0x67, 0x28, 0xdf, 0x4b, 0x2e, 0x04, 0xb9, 0xf0,
+		0xad, 0x0c, 0xcc, 0x0a, 0xb8, 0x10, 0x77, 0x6c, 0xc8, 0x5d, 0xc4, 0xf8, 0x24, 0xcc, 0xef, 0x39,
+		0x09, 0x0b, 0x7b, 0x49, 0xc2, 0x62, 0x0a, 0x12, 0xce, 0x71, 0x49, 0xf8, 0xbd, 0x5b, 0x30, 0xbc,
+		0xc8, 0xd2, 0x88, 0xd0, 0x22, 0x72, 0x1d, 0x12, 0xda, 0x56, 0x1d, 0xd0, 0x9d, 0x5e, 0xcf, 0xc0,
+		0xa0, 0x17, 0x62, 0xcc, 0x96, 0x77, 0xaf, 0x00, 0xbd, 0x0e, 0xc0, 0x1a, 0xfa, 0xee, 0xac, 0x0b,
+		0xdc, 0x69, 0xd2, 0x81, 0xe8, 0x1c, 0x07, 0x75, 0xf7, 0x27, 0x31, 0x3d, 0x4f, 0x74, 0x93, 0x38,
+		0x2b, 0x34, 0x48, 0x29, 0x8b, 0xdd, 0xbf, 0xf4, 0xfd, 0x57, 0x94, 0x35, 0x6a, 0x70, 0x82, 0x91,
+		0xd7, 0x55, 0xf3, 0xc9, 0x78, 0xc6, 0x77, 0x75, 0xf0, 0xc5, 0xed, 0x64, 0x80, 0xf4, 0x61, 0x13,
+		0x07, 0xb9, 0xb0, 0x4b, 0x16, 0x79, 0x63, 0xf5, 0x46, 0xc2, 0x9c, 0x30, 0x02, 0x9f, 0x03, 0x5f,
+		0xda, 0x4e, 0x0a, 0x4a, 0x1f, 0x3f, 0x72, 0x50, 0x5c, 0xa7, 0x8b, 0x6e, 0x14, 0xbf, 0xf9, 0x98,
+		0xc7, 0x8f, 0xc9, 0x56, 0x6e, 0x7c, 0x61, 0x3b, 0xd9, 0x0a, 0xff, 0x37, 0xf8, 0xc4, 0x23, 0x32,
+		0xd5, 0x52, 0x89, 0xa5, 0x8c, 0x20, 0xc7, 0xe2, 0xe7, 0x5f, 0x4f, 0x86, 0x9c, 0xc0, 0x14, 0xe3,
+		0xe2, 0x76, 0x52, 0xa3, 0xfd, 0x53, 0x12, 0x14, 0x83, 0xbe, 0x76, 0xf8, 0x76, 0x3b, 0xf2, 0x92,
+		0xea, 0x56, 0xdf, 0x08, 0x3d, 0x51, 0x20, 0x1d, 0x3e, 0xd7, 0x4a, 0x00, 0x85, 0x7e, 0x42, 0x82,
+		0x0b, 0x21, 0x9c, 0x44, 0x9a, 0xc0, 0x5e, 0x51, 0xdd, 0xec, 0x8f, 0x11, 0x5f, 0x09, 0xe4, 0x56,
+		0x5f, 0x18, 0x0e, 0x85, 0x62, 0x44, 0xbf, 0x95, 0x90, 0x42, 0x42, 0xa9, 0x3f, 0xd7, 0x4a, 0x00,
+		0x85, 0xbe, 0x14, 0xc1, 0x29, 0x46, 0xa2, 0x58, 0xf8, 0xd9, 0xab, 0x7d, 0x71, 0x12, 0x09, 0xd3,
+		0x64, 0xab, 0x3f, 0x10, 0xfa, 0x4b, 0x12, 0x9c, 0x0f, 0x62, 0x24, 0xd2, 0x3e, 0xb6, 0xbb, 0x7e,
+		0x25, 0x61, 0x98, 0x67, 0x18, 0x99, 0x89, 0x56, 0x3f, 0x10, 0xf4, 0xd3, 0x12, 0x14, 0x83, 0x2f,
+		0x54, 0x63, 0x44, 0xfa, 0x47, 0xa5, 0x18, 0x8e, 0x25, 0x09, 0x0e, 0xc5, 0xe7, 0xb4, 0x04, 0x50,
+		0xe8, 0x5d, 0x09, 0x2e, 0x84, 0x90, 0x12, 0xc9, 0xf4, 0xbb, 0x52, 0x8c, 0x50, 0xf7, 0x0f, 0x09,
+		0xc4, 0xb2, 0xd6, 0x17, 0x86, 0x43, 0xa3, 0x18, 0xa1, 0x7e, 0x2f, 0x29, 0x8d, 0xc4, 0x52, 0xad,
+		0x25, 0x80, 0x42, 0x7f, 0x59, 0x82, 0xf3, 0x41, 0xa4, 0x44, 0x32, 0xf4, 0x35, 0x29, 0x46, 0x88,
+		0xfa, 0x86, 0x5b, 0xe1, 0x09, 0xad, 0x1f, 0x08, 0xfa, 0x72, 0x84, 0x40, 0x31, 0x1a, 0xf6, 0xb7,
+		0xa5, 0x18, 0x15, 0x4b, 0x10, 0x81, 0x87, 0x27, 0xb5, 0xfe, 0x40, 0xe8, 0x09, 0x14, 0x58, 0x18,
+		0x87, 0x50, 0x72, 0x7e, 0x91, 0xe1, 0x71, 0x55, 0xe8, 0x72, 0x0b, 0xe2, 0x82, 0xf0, 0x69, 0x5b,
+		0x5c, 0x89, 0x36, 0xe1, 0x0c, 0x1b, 0x77, 0xdd, 0x30, 0x79, 0xa3, 0xfe, 0x2a, 0x1b, 0x75, 0x46,
+		0x3c, 0x2a, 0x2f, 0x92, 0x07, 0x8f, 0xda, 0xa2, 0x2a, 0xf4, 0x37, 0x25, 0x98, 0x0d, 0x49, 0x27,
+		0x3f, 0xaa, 0xcc, 0x87, 0xc5, 0x6f, 0x30, 0x2c, 0xde, 0xec, 0x2f, 0xa4, 0xb1, 0x77, 0x6a, 0x78,
+		0x4a, 0x4b, 0x0c, 0x8b, 0xfe, 0x9e, 0x04, 0x37, 0x62, 0x9f, 0xc6, 0x8b, 0xe4, 0xf7, 0x5f, 0x33,
+		0x64, 0xcb, 0xe9, 0xde, 0xc7, 0xf3, 0x85, 0x79, 0xc6, 0x4c, 0x05, 0x4f, 0xd6, 0x8e, 0x8b, 0x3c,
+		0xe2, 0xf2, 0xf0, 0xfc, 0x6e, 0x52, 0xc1, 0x16, 0x6c, 0xb7, 0x83, 0x82, 0x2d, 0x00, 0x42, 0x3b,
+		0x70, 0x96, 0x09, 0x98, 0x18, 0x91, 0xdf, 0x95, 0x62, 0x52, 0x44, 0xc4, 0x85, 0x72, 0xe1, 0x33,
+		0x76, 0x4c, 0x2d, 0xfa, 0x71, 0x09, 0xce, 0x05, 0x72, 0x06, 0x88, 0x38, 0xf6, 0x87, 0x0c, 0x81,
+		0x4f, 0xf5, 0x4b, 0x1c, 0xc0, 0xe7, 0xd1, 0x78, 0xb3, 0x0f, 0x04, 0xfa, 0xf3, 0x30, 0xee, 0x04,
+		0xd4, 0x98, 0x4e, 0xc8, 0x4d, 0x14, 0x87, 0xff, 0x2d, 0xc5, 0x24, 0x2f, 0x88, 0x8d, 0xd7, 0xc1,
+		0x63, 0x1b, 0x71, 0xd5, 0xc4, 0x4d, 0xbd, 0xcc, 0xdb, 0x80, 0x38, 0x57, 0x54, 0x51, 0x54, 0xbe,
+		0x98, 0x49, 0xe3, 0xa6, 0x0a, 0xae, 0xba, 0x38, 0x6e, 0xaa, 0x00, 0x12, 0xfd, 0xac, 0x04, 0x33,
+		0x1c, 0x04, 0xed, 0xde, 0x26, 0x38, 0x8a, 0xe2, 0x4f, 0x66, 0x62, 0x0c, 0x42, 0xf2, 0xed, 0x34,
+		0x9e, 0xda, 0x4e, 0x0c, 0x8b, 0x7e, 0x51, 0x82, 0x1b, 0xbc, 0xbd, 0x52, 0x5f, 0xeb, 0xf5, 0x55,
+		0x86, 0x6c, 0x25, 0xe1, 0x9e, 0x29, 0xde, 0x84, 0xcd, 0x6e, 0xa7, 0x6b, 0x20, 0x62, 0xbf, 0x58,
+		0x1d, 0xbf, 0x9e, 0x8a, 0xfd, 0x22, 0xd5, 0x2c, 0x6e, 0x27, 0x84, 0x44, 0xff, 0x59, 0x02, 0x25,
+		0x45, 0xe6, 0x8a, 0xf0, 0x39, 0x82, 0x0f, 0xf3, 0x5f, 0x60, 0x98, 0xd7, 0x9f, 0x21, 0x83, 0x85,
+		0xe8, 0x2a, 0x13, 0xbf, 0x61, 0x3e, 0x53, 0x7b, 0xf4, 0xef, 0x25, 0xb8, 0x93, 0x62, 0x96, 0x22,
+		0x53, 0xf5, 0x4b, 0x6c, 0x8a, 0xab, 0xcf, 0x30, 0x45, 0xbe, 0x15, 0x7b, 0xcd, 0xdc, 0x7d, 0x63,
+		0xf4, 0x5b, 0x12, 0xbc, 0x1e, 0x37, 0x9b, 0xfe, 0x3a, 0xf2, 0x2d, 0x36, 0xaf, 0x25, 0xfe, 0x89,
+		0xcb, 0xee, 0x42, 0x68, 0xf0, 0x4d, 0x7d, 0x77, 0x0d, 0xe9, 0xda, 0xcf, 0x9b, 0x46, 0xef, 0x91,
+		0xbd, 0x93, 0x0e, 0x24, 0x32, 0x8d, 0xdf, 0xcc, 0xc4, 0xac, 0xfd, 0xe9, 0x5e, 0x31, 0xe3, 0x99,
+		0xed, 0x54, 0xf0, 0xe8, 0xd7, 0x24, 0xb8, 0xd5, 0x27, 0xeb, 0x47, 0x8c, 0xee, 0x7c, 0x87, 0x61,
+		0xbe, 0x98, 0x36, 0xfb, 0x87, 0x50, 0x61, 0xae, 0x5b, 0xe9, 0x1b, 0xa1, 0x5f, 0x21, 0x99, 0x40,
+		0xe2, 0xe7, 0x20, 0xd2, 0x8c, 0xef, 0x65, 0x62, 0x92, 0xae, 0xa4, 0xbd, 0xbc, 0xc5, 0x57, 0xad,
+		0x94, 0x2d, 0xd0, 0xdf, 0x91, 0xe0, 0x9a, 0x10, 0x69, 0xa1, 0x57, 0xff, 0x5f, 0x19, 0xd6, 0xa5,
+		0x14, 0x77, 0xb3, 0x02, 0x37, 0xff, 0x72, 0x33, 0x05, 0x34, 0xfa, 0xa6, 0x04, 0xd7, 0x85, 0xd8,
+		0xc6, 0x6c, 0x17, 0xff, 0x7b, 0x9c, 0x80, 0xa7, 0xbb, 0x78, 0xc4, 0x33, 0xcd, 0x54, 0xf0, 0xe8,
+		0x6f, 0x49, 0x70, 0x35, 0xb5, 0x58, 0xfc, 0x71, 0x26, 0x2e, 0xe9, 0x57, 0x0a, 0x89, 0x98, 0x6e,
+		0xa6, 0x10, 0x86, 0x0f, 0x24, 0x98, 0x13, 0x93, 0x57, 0xb8, 0xf4, 0xfe, 0x78, 0x36, 0x26, 0x05,
+		0x4f, 0xaa, 0xbb, 0x30, 0x7c, 0xa5, 0x99, 0x06, 0x1c, 0x7d, 0x23, 0x4e, 0x1e, 0x62, 0x76, 0xc7,
+		0x5f, 0x4e, 0x8f, 0xb1, 0x68, 0x9f, 0x7c, 0xa5, 0x99, 0x06, 0x9c, 0xba, 0x63, 0x62, 0x8c, 0x63,
+		0x7c, 0xc7, 0xf7, 0xb3, 0x31, 0xee, 0x58, 0xca, 0xfb, 0x18, 0x3c, 0xdb, 0x4c, 0xd7, 0x80, 0x2e,
+		0x95, 0x09, 0x72, 0x1d, 0xc5, 0x58, 0xea, 0x9f, 0xcd, 0xc6, 0x2c, 0x95, 0xbb, 0x0c, 0xca, 0xc4,
+		0x37, 0xad, 0xdd, 0x35, 0x44, 0xbf, 0x2e, 0xc1, 0xed, 0x04, 0xf3, 0x11, 0xa9, 0xe7, 0x07, 0x6c,
+		0x32, 0xd5, 0xdd, 0xc7, 0x24, 0x86, 0x67, 0x72, 0xc3, 0xda, 0x45, 0x2b, 0x92, 0xec, 0xeb, 0x53,
+		0x71, 0xf8, 0x8b, 0x77, 0x4b, 0xbf, 0x92, 0x8d, 0x59, 0x77, 0xd2, 0x06, 0x08, 0xe2, 0xab, 0x7a,
+		0xca, 0x16, 0xe8, 0xab, 0xfc, 0xfb, 0x91, 0x4d, 0x1a, 0xfd, 0x1f, 0x45, 0xf7, 0xc3, 0x6c, 0x9a,
+		0x0b, 0x12, 0xee, 0x1b, 0x02, 0xce, 0x05, 0x09, 0x17, 0x0e, 0xfd, 0x0c, 0xff, 0xea, 0xcb, 0x64,
+		0x4f, 0x07, 0xa2, 0xb8, 0xfd, 0x8b, 0x6c, 0x9a, 0xbb, 0x2f, 0xfe, 0x0b, 0x04, 0xce, 0xdd, 0x17,
+		0x1f, 0x10, 0x7d, 0x5d, 0x82, 0x99, 0xe0, 0x59, 0x09, 0x0b, 0x14, 0x8b, 0xf1, 0x52, 0x7f, 0x3b,
+		0x1b, 0x73, 0xfb, 0x95, 0xf8, 0x99, 0x21, 0xbe, 0xa4, 0x25, 0x05, 0x95, 0x2b, 0x70, 0xc0, 0xb9,
+		0xdf, 0x44, 0xb7, 0x60, 0x3f, 0x45, 0xc8, 0xfd, 0x3e, 0x08, 0xff, 0x12, 0xd6, 0x7f, 0x1b, 0x8a,
+		0x9d, 0x06, 0xf2, 0x3a, 0x1c, 0xac, 0x68, 0xb6, 0x76, 0xa7, 0xdd, 0x7d, 0x40, 0xae, 0x74, 0xf5,
+		0x4e, 0xb3, 0xdb, 0x32, 0x3a, 0x0f, 0xfb, 0x27, 0x25, 0x55, 0x1c, 0x48, 0x76, 0xa5, 0xab, 0xfb,
+		0xfe, 0x91, 0xcf, 0x7b, 0xb4, 0x34, 0x5b, 0x73, 0x82, 0x58, 0xe8, 0x6f, 0xf9, 0x36, 0x9c, 0x8c,
+		0xea, 0x9b, 0xd1, 0xb6, 0x75, 0xb3, 0xef, 0x87, 0x36, 0xe4, 0x22, 0x20, 0xff, 0x05, 0xb2, 0xd3,
+		0x8c, 0xf7, 0x91, 0x91, 0xfb, 0x70, 0xa4, 0x97, 0x61, 0xc0, 0x01, 0x9b, 0x84, 0x43, 0xba, 0x66,
+		0xb6, 0x0d, 0xdd, 0x72, 0x3e, 0x7d, 0xc1, 0xee, 0x7e, 0x87, 0xdd, 0x42, 0x02, 0x4a, 0x50, 0x70,
+		0xde, 0xb1, 0x52, 0x10, 0x76, 0x05, 0x0c, 0xac, 0x88, 0x00, 0xc8, 0xef, 0x65, 0x00, 0x58, 0xb2,
+		0x34, 0xfa, 0x25, 0x0f, 0xce, 0xd8, 0x84, 0x09, 0xce, 0xc7, 0x31, 0xe2, 0x3e, 0x61, 0xc2, 0x3a,
+		0x71, 0x3e, 0x86, 0xe1, 0x34, 0x40, 0xe3, 0x30, 0xd4, 0xd2, 0xad, 0xa6, 0x69, 0x6c, 0xf6, 0xc2,
+		0x1b, 0x06, 0xb1, 0xbf, 0x88, 0x20, 0xd8, 0xdd, 0xee, 0xe8, 0xa6, 0xaa, 0x6f, 0x68, 0x46, 0xdb,
+		0x0d, 0x31, 0xa7, 0x45, 0x0a, 0x29, 0x41, 0xaf, 0x3b, 0x34, 0x9f, 0xa3, 0x02, 0x70, 0x29, 0x66,
+		0x6c, 0x32, 0x81, 0x19, 0xc2, 0x73, 0xf6, 0x89, 0x18, 0xda, 0xec, 0xd4, 0x4d, 0x18, 0xec, 0x15,
+		0xf5, 0xfb, 0x3c, 0xcc, 0xa0, 0xff, 0xf3, 0x30, 0x5f, 0x91, 0xe0, 0x18, 0xeb, 0x37, 0xf8, 0x41,
+		0x8e, 0xcf, 0x0b, 0xf4, 0xdb, 0xd6, 0x3b, 0xf4, 0xd7, 0xa6, 0x6e, 0x1a, 0xdd, 0x96, 0x6a, 0x74,
+		0xd4, 0x96, 0xb6, 0xc3, 0x82, 0x72, 0x06, 0x38, 0xd6, 0x03, 0xbb, 0x0d, 0x56, 0x29, 0x7c, 0xb5,
+		0x53, 0xd1, 0x76, 0xe8, 0x13, 0x2d, 0x7d, 0xc3, 0xb0, 0xd5, 0x0d, 0xdd, 0x36, 0x8d, 0x26, 0xa5,
+		0xf7, 0x41, 0x0c, 0xa4, 0x68, 0x99, 0x96, 0xc8, 0xff, 0x46, 0x82, 0xdc, 0xda, 0x66, 0x4b, 0xb3,
+		0x75, 0x1f, 0xd3, 0x42, 0x54, 0x86, 0xbe, 0x54, 0xce, 0x47, 0xa8, 0x5c, 0x76, 0xa8, 0x5c, 0xa0,
+		0x54, 0x9e, 0xe5, 0x52, 0x39, 0x3c, 0xee, 0xde, 0xd1, 0xba, 0x02, 0x67, 0xcb, 0xed, 0x2d, 0xcb,
+		0xd6, 0x4d, 0xac, 0x6f, 0xb6, 0x8d, 0xa6, 0x16, 0xfd, 0x0e, 0xca, 0x04, 0xf9, 0x4e, 0x0b, 0x05,
+		0xf1, 0xbf, 0x5e, 0x1b, 0x72, 0xca, 0xc8, 0x63, 0x04, 0x92, 0x4b, 0xac, 0xc0, 0xb0, 0x13, 0xf6,
+		0x32, 0x03, 0xc7, 0xa8, 0x1d, 0xd2, 0x55, 0x4e, 0x67, 0x47, 0x59, 0x55, 0xd9, 0xeb, 0x12, 0xad,
+		0xc2, 0x41, 0x07, 0x90, 0x08, 0x3f, 0x21, 0xcd, 0x0d, 0xbe, 0x0b, 0x15, 0x8f, 0x3d, 0xee, 0xf5,
+		0x22, 0x7f, 0x75, 0x1f, 0x1c, 0xc7, 0xfa, 0x43, 0x83, 0xfc, 0x73, 0x91, 0xa5, 0x76, 0x90, 0xab,
+		0x7a, 0x21, 0xce, 0xe6, 0xfb, 0x72, 0xb6, 0x10, 0xe1, 0x6c, 0x4a, 0x79, 0x2d, 0x3e, 0x8b, 0xbc,
+		0xce, 0x85, 0xe5, 0x35, 0x40, 0xc0, 0xf9, 0xbd, 0x20, 0xa0, 0x88, 0x85, 0x0b, 0x22, 0x16, 0x2e,
+		0x3a, 0x92, 0xbd, 0x1a, 0x33, 0x3a, 0x97, 0x21, 0x61, 0xf1, 0x26, 0x1f, 0xf5, 0xb1, 0xf4, 0xe6,
+		0x96, 0x49, 0xd7, 0xce, 0xee, 0x63, 0xbd, 0x43, 0x6f, 0xeb, 0x07, 0xf1, 0x21, 0xb7, 0xb4, 0x41,
+		0x0a, 0x77, 0xaf, 0x05, 0x6f, 0x03, 0x22, 0x41, 0xf8, 0x0c, 0x09, 0xcb, 0x15, 0x8b, 0xd3, 0x30,
+		0xb8, 0xa9, 0x3d, 0xd4, 0x55, 0xcb, 0xf8, 0x82, 0xee, 0x58, 0x93, 0x83, 0xa4, 0xa0, 0x6e, 0x7c,
+		0x41, 0x47, 0x17, 0xe0, 0x48, 0x47, 0x7f, 0x6a, 0xab, 0x14, 0x82, 0xe1, 0xc4, 0xd6, 0xa6, 0x43,
+		0xa4, 0x78, 0x55, 0x7b, 0xa8, 0x53, 0x9c, 0xc8, 0x27, 0xb7, 0x8e, 0x05, 0xfa, 0xb6, 0x36, 0xbb,
+		0x1d, 0x4b, 0x47, 0x0a, 0x1c, 0x60, 0xc1, 0x54, 0xee, 0x02, 0x3b, 0x2d, 0xb8, 0xc6, 0x26, 0x02,
+		0xf7, 0x40, 0x77, 0xe9, 0xc3, 0x5a, 0x63, 0xb7, 0x6d, 0x62, 0x34, 0xa6, 0xe1, 0x78, 0xb8, 0x2b,
+		0xa1, 0xec, 0xcb, 0x5f, 0x92, 0x00, 0x31, 0x28, 0xe2, 0xbe, 0x76, 0x9f, 0xe8, 0x26, 0x35, 0x76,
+		0xaf, 0xc3, 0xe9, 0x4d, 0xbd, 0x43, 0x97, 0x72, 0xb1, 0x2a, 0x8f, 0x38, 0x20, 0xa5, 0x88, 0x38,
+		0xdc, 0x86, 0xd1, 0x75, 0xa7, 0x3b, 0x95, 0x26, 0x5b, 0xd4, 0xd5, 0x70, 0x84, 0xd4, 0x49, 0x17,
+		0x40, 0xa1, 0xf5, 0xbd, 0x44, 0x8c, 0xf2, 0x3f, 0xca, 0xc2, 0x09, 0x3e, 0x29, 0xd0, 0xa7, 0x61,
+		0x88, 0x11, 0x43, 0x35, 0x3a, 0xeb, 0x5d, 0x27, 0xad, 0xda, 0xd9, 0x3e, 0x8b, 0x15, 0x86, 0x56,
+		0xef, 0x37, 0xaa, 0xc1, 0xa1, 0xa6, 0x5f, 0xe4, 0x9d, 0x40, 0xb6, 0x62, 0x4c, 0x1f, 0x41, 0x15,
+		0x09, 0x36, 0x47, 0x9b, 0x30, 0x6a, 0x7a, 0xda, 0xa4, 0x06, 0xfb, 0x2e, 0xc4, 0xa4, 0x10, 0x8e,
+		0x37, 0xa1, 0x78, 0xc4, 0x14, 0xd4, 0xa0, 0x4b, 0x90, 0xeb, 0x91, 0xd6, 0x0d, 0x0c, 0x63, 0xe1,
+		0x6e, 0x47, 0xdc, 0xf2, 0xb7, 0x58, 0x31, 0x89, 0x76, 0x33, 0x2c, 0xf5, 0x61, 0xbb, 0xfb, 0x40,
+		0x6b, 0xbb, 0x79, 0x5c, 0x99, 0xf1, 0x38, 0x6c, 0x58, 0x77, 0x69, 0x31, 0x43, 0x03, 0x2d, 0xc1,
+		0xa1, 0x5e, 0xa7, 0x94, 0xb4, 0x2c, 0xd8, 0xe9, 0x62, 0x0c, 0xea, 0x7e, 0x71, 0xc1, 0xc3, 0xeb,
+		0xbe, 0x7f, 0xf2, 0x77, 0x33, 0x70, 0xcc, 0xbf, 0x8c, 0xc5, 0xd9, 0xde, 0x45, 0x18, 0xde, 0xa2,
+		0xa0, 0x2d, 0x36, 0x30, 0xe3, 0xc7, 0xf9, 0x44, 0x4b, 0x23, 0x1e, 0x72, 0x9a, 0xf2, 0x59, 0x5b,
+		0x78, 0x8e, 0xac, 0x2d, 0x3e, 0x0f, 0xd6, 0x46, 0x4d, 0xdf, 0x1c, 0xc7, 0xf4, 0xc9, 0xff, 0x2d,
+		0x03, 0xf9, 0x20, 0x79, 0x5f, 0xaa, 0xc7, 0x73, 0x55, 0x0f, 0xb9, 0x4e, 0x2c, 0xd2, 0xa6, 0xa9,
+		0x37, 0x13, 0x89, 0x74, 0x94, 0x8d, 0x79, 0x1e, 0x1b, 0xff, 0x68, 0x00, 0xc6, 0xe8, 0x6e, 0x83,
+		0xb3, 0xff, 0x64, 0x9d, 0xbf, 0x7c, 0xe4, 0xf6, 0x42, 0x1e, 0xb9, 0xf9, 0x33, 0x0a, 0xac, 0xc6,
+		0xa6, 0x3b, 0x7b, 0x27, 0x9c, 0xee, 0xec, 0xa3, 0xfa, 0x9c, 0x48, 0xe0, 0x11, 0x5e, 0x67, 0x37,
+		0x8f, 0xf0, 0xf6, 0xe2, 0xf5, 0x1a, 0x9a, 0x85, 0x63, 0x2d, 0xbd, 0xad, 0xed, 0x38, 0x74, 0x77,
+		0xc9, 0x4c, 0xa3, 0xe3, 0x06, 0xf0, 0x51, 0x5a, 0x47, 0x09, 0xed, 0xa6, 0x4f, 0xbd, 0x09, 0x05,
+		0x91, 0xc8, 0x3b, 0x36, 0xcc, 0xfb, 0x46, 0x17, 0xf8, 0xbf, 0x8a, 0xf9, 0xae, 0x04, 0xa7, 0x56,
+		0xbb, 0xed, 0xf6, 0x42, 0xd7, 0xf4, 0x47, 0xfb, 0xf5, 0xd3, 0x94, 0x67, 0x49, 0xfd, 0x1d, 0x97,
+		0x15, 0xe3, 0xc3, 0x01, 0x38, 0xcd, 0x45, 0xc7, 0x99, 0xc5, 0x18, 0x00, 0x1d, 0x97, 0xa9, 0x3f,
+		0x7b, 0x32, 0x42, 0x31, 0xa1, 0xaa, 0xff, 0x71, 0x8f, 0xa9, 0x27, 0xb9, 0xd6, 0x4d, 0xfd, 0x89,
+		0xd1, 0xdd, 0xb2, 0x54, 0x41, 0xa6, 0xac, 0x13, 0x2e, 0x40, 0x3d, 0x98, 0xbd, 0x28, 0x71, 0x80,
+		0xbd, 0x3f, 0x85, 0xd5, 0xf5, 0x40, 0x82, 0x5a, 0x12, 0xd2, 0x4f, 0x92, 0xf0, 0xb6, 0xbb, 0x0f,
+		0xd5, 0x66, 0x77, 0xab, 0x63, 0xab, 0x8f, 0x8c, 0x8e, 0x4d, 0x93, 0x8d, 0x67, 0x71, 0xce, 0xa9,
+		0x29, 0x93, 0x8a, 0x45, 0xa3, 0x63, 0xa3, 0x57, 0xe0, 0x80, 0xf3, 0xb9, 0x4f, 0xc7, 0x69, 0x39,
+		0x13, 0x77, 0x7a, 0x85, 0x5d, 0x60, 0x9e, 0x37, 0xbd, 0xc0, 0xf1, 0xa6, 0xd1, 0xab, 0x30, 0xf0,
+		0x03, 0x5b, 0xba, 0xb9, 0xe3, 0x84, 0x31, 0xcb, 0xb1, 0xc4, 0xfc, 0x2c, 0x81, 0xc4, 0xac, 0x01,
+		0xfa, 0x3e, 0x38, 0xc3, 0xbb, 0x40, 0xe8, 0xc9, 0xe3, 0x3b, 0x49, 0xe4, 0x71, 0x34, 0x1a, 0x47,
+		0xe2, 0x0a, 0xe8, 0x1b, 0x30, 0x64, 0x6a, 0xdb, 0xaa, 0x3b, 0xfb, 0xd6, 0x78, 0x56, 0xd8, 0x9d,
+		0x7b, 0x46, 0x87, 0xc1, 0xd4, 0xb6, 0x1d, 0x52, 0xc8, 0x1f, 0x48, 0x30, 0x5a, 0xb7, 0x8d, 0xe6,
+		0xe3, 0x9d, 0x5e, 0xdf, 0xbe, 0x43, 0xcc, 0xbb, 0x90, 0x23, 0x43, 0xeb, 0xa6, 0x9a, 0x32, 0x8f,
+		0xf1, 0x61, 0xd6, 0xac, 0x87, 0x66, 0xa2, 0x4c, 0x9f, 0xf9, 0x04, 0x99, 0x3e, 0xe5, 0xdf, 0xca,
+		0xc2, 0x24, 0x53, 0xb1, 0x16, 0x37, 0x2a, 0xda, 0xb5, 0x06, 0x7d, 0xb4, 0xef, 0x35, 0x18, 0x74,
+		0xc3, 0x81, 0xdd, 0xf3, 0x86, 0xb1, 0xd8, 0xb8, 0x62, 0xec, 0xc1, 0xf3, 0x93, 0x10, 0x17, 0x04,
+		0x49, 0x88, 0xe3, 0x52, 0x49, 0x7e, 0x1e, 0x8e, 0x5a, 0x94, 0xf8, 0xd1, 0x37, 0x10, 0x33, 0x82,
+		0x9b, 0x66, 0x01, 0xab, 0x70, 0x8e, 0x75, 0xe4, 0x63, 0xde, 0x4d, 0x18, 0x31, 0x75, 0x7b, 0xcb,
+		0xec, 0xd0, 0x60, 0x82, 0x40, 0x84, 0xb5, 0xf3, 0xa1, 0xbe, 0xe3, 0xac, 0xbe, 0xa6, 0x6f, 0xfb,
+		0x49, 0x49, 0x92, 0xa9, 0xae, 0x77, 0xcd, 0xa6, 0xae, 0x36, 0x4d, 0x5d, 0xb3, 0x75, 0x4e, 0x73,
+		0x96, 0x45, 0xe2, 0x14, 0x85, 0x2a, 0x53, 0xa0, 0x70, 0x1f, 0x9c, 0x34, 0xc8, 0xab, 0xdc, 0x34,
+		0xc8, 0x3f, 0x04, 0xe7, 0xe2, 0xd9, 0xe9, 0x58, 0xd3, 0x35, 0x38, 0x14, 0xc4, 0x01, 0x62, 0x02,
+		0x56, 0x63, 0xcc, 0xb2, 0xef, 0xe3, 0x57, 0x9a, 0xf5, 0x58, 0xfe, 0x87, 0x12, 0x8c, 0x73, 0xc6,
+		0x67, 0xd7, 0x38, 0x09, 0x65, 0xe9, 0x8e, 0x3f, 0x13, 0xda, 0xb3, 0xa7, 0x21, 0x2e, 0x24, 0x4f,
+		0x43, 0xfc, 0x9f, 0xbc, 0x15, 0x31, 0x78, 0x3f, 0xf0, 0x42, 0x56, 0x44, 0x54, 0x07, 0xd4, 0xeb,
+		0x57, 0xdd, 0x70, 0x3e, 0xa5, 0x3d, 0x52, 0x8c, 0xd9, 0xcd, 0x85, 0xbf, 0xbb, 0x8d, 0x73, 0x76,
+		0xa8, 0x44, 0xfe, 0xf5, 0xfd, 0x70, 0x9a, 0x3b, 0xc7, 0x17, 0xba, 0xcc, 0x86, 0xb2, 0x33, 0x16,
+		0xfa, 0xe7, 0x75, 0x2e, 0xee, 0x2e, 0xaf, 0x33, 0xdf, 0x85, 0x9e, 0x85, 0x63, 0xde, 0xcb, 0x04,
+		0xef, 0x54, 0x85, 0x3d, 0x75, 0xf5, 0xb2, 0x4c, 0x7a, 0x5f, 0xb6, 0x48, 0x94, 0xb4, 0x79, 0x35,
+		0x49, 0xd2, 0xe6, 0x69, 0x38, 0xea, 0xac, 0xe2, 0x91, 0x6f, 0x6a, 0xb8, 0xcb, 0xbe, 0x37, 0x6c,
+		0xff, 0xbc, 0xcc, 0xad, 0x67, 0xcb, 0xcb, 0xdc, 0x89, 0xcf, 0xcb, 0xec, 0x73, 0x30, 0x9e, 0x06,
+		0x73, 0x64, 0x2e, 0xc3, 0x24, 0x87, 0x82, 0x6a, 0x77, 0x5d, 0xb5, 0x1f, 0x19, 0x96, 0xea, 0xb6,
+		0xa2, 0x6e, 0x6c, 0x16, 0x9f, 0x8d, 0x92, 0x74, 0x65, 0xbd, 0xf1, 0xc8, 0xb0, 0x4a, 0x3d, 0x7f,
+		0xe5, 0xa8, 0x87, 0xa4, 0xab, 0xc9, 0xf4, 0x39, 0xc6, 0x30, 0xce, 0xf5, 0x6a, 0x2a, 0x8e, 0x4e,
+		0xdf, 0x0d, 0x3b, 0x69, 0xef, 0x49, 0x31, 0xd2, 0x11, 0xe3, 0xa5, 0x15, 0xe1, 0x48, 0xf8, 0x23,
+		0x3d, 0x5f, 0x63, 0x27, 0xa0, 0x87, 0xb7, 0x03, 0xdf, 0xe7, 0x91, 0x77, 0x40, 0x66, 0x21, 0xbc,
+		0x7e, 0x25, 0x5a, 0x74, 0x11, 0x4b, 0x68, 0xe9, 0x76, 0x97, 0x3e, 0xee, 0xdb, 0x12, 0x5c, 0x88,
+		0x19, 0xfb, 0xce, 0x4e, 0xb5, 0xf2, 0xcc, 0xbb, 0x5d, 0xc1, 0x67, 0x7d, 0x43, 0x6a, 0x5a, 0xe4,
+		0x25, 0x51, 0x75, 0x67, 0x34, 0x27, 0x9e, 0x51, 0xe8, 0xcb, 0x35, 0xf2, 0x2a, 0x4c, 0xc6, 0x4c,
+		0xa8, 0x67, 0x9a, 0x2e, 0x41, 0x2e, 0x1c, 0x9b, 0x48, 0xe7, 0x75, 0x10, 0x1f, 0x69, 0x06, 0x43,
+		0x04, 0xe5, 0xa7, 0x3d, 0xaf, 0x86, 0xfb, 0x2a, 0x26, 0x21, 0x7f, 0xbc, 0x64, 0x0b, 0xf9, 0x40,
+		0xb2, 0x85, 0x38, 0xee, 0x7c, 0xc9, 0x5b, 0x01, 0xa3, 0x2f, 0x00, 0xd2, 0x8c, 0x4b, 0xb3, 0x11,
+		0xe4, 0x45, 0xd9, 0x08, 0x52, 0xac, 0x6a, 0x54, 0x54, 0xa3, 0xb4, 0x70, 0x62, 0x8b, 0x9e, 0xab,
+		0xa8, 0xfe, 0x4b, 0x09, 0x2e, 0xc6, 0xf1, 0xe1, 0x85, 0xca, 0xaa, 0xc7, 0xdd, 0x39, 0x21, 0x77,
+		0xc3, 0x92, 0xfa, 0x5f, 0x24, 0x38, 0xc7, 0x99, 0x10, 0xe3, 0xee, 0x8b, 0x9f, 0x4d, 0x34, 0x8d,
+		0xba, 0x9f, 0x71, 0xf3, 0x62, 0xc6, 0x2d, 0x70, 0x6d, 0x8c, 0x50, 0x68, 0x3e, 0x81, 0x36, 0xe6,
+		0x5f, 0x49, 0x70, 0x3e, 0x10, 0x72, 0x9d, 0xfa, 0x88, 0xf0, 0x39, 0xb9, 0x3e, 0x71, 0x1e, 0x60,
+		0xf0, 0x68, 0xac, 0x18, 0xfe, 0xcc, 0xcb, 0xef, 0x67, 0x40, 0xbe, 0xab, 0x47, 0x4f, 0x7e, 0xdc,
+		0x8d, 0x79, 0x9f, 0x09, 0xed, 0xcd, 0xc7, 0xd0, 0xa7, 0xe0, 0xe8, 0x86, 0xf6, 0xd4, 0xd8, 0xd8,
+		0xda, 0x50, 0xbd, 0xeb, 0x3e, 0x96, 0x41, 0xfb, 0x88, 0x53, 0xb1, 0x1a, 0x73, 0xeb, 0x57, 0xe4,
+		0x1d, 0x10, 0x4c, 0x03, 0xda, 0xd6, 0x0c, 0x5b, 0x5d, 0xef, 0x9a, 0x5e, 0xe8, 0xb6, 0x73, 0x90,
+		0x7c, 0x84, 0xd4, 0x2c, 0x74, 0x4d, 0x37, 0xa8, 0x1a, 0xad, 0xc3, 0xa8, 0xb3, 0x5f, 0x67, 0x70,
+		0xea, 0x3a, 0x0d, 0x33, 0x61, 0x9e, 0xc0, 0x3c, 0xdd, 0x43, 0x4c, 0xf7, 0x8d, 0xbe, 0x61, 0xa1,
+		0x29, 0xd4, 0x25, 0x38, 0xf1, 0x88, 0x5b, 0x2e, 0xff, 0x98, 0x04, 0x93, 0xb1, 0xd4, 0x76, 0x96,
+		0x29, 0xdf, 0xe9, 0x09, 0x3c, 0xe3, 0xe9, 0x09, 0xf7, 0x2e, 0xf2, 0xdf, 0x49, 0x70, 0x9e, 0x87,
+		0x07, 0xd6, 0xb6, 0x3f, 0xe9, 0x8c, 0x27, 0x1f, 0xb6, 0xbf, 0xd0, 0x6f, 0x6e, 0x0e, 0x99, 0x39,
+		0x5d, 0x02, 0x4f, 0x96, 0x42, 0x47, 0x3a, 0xf9, 0xb4, 0x47, 0x3a, 0x3f, 0x93, 0x81, 0x02, 0x0b,
+		0xe7, 0xfb, 0xb8, 0x58, 0x8c, 0x50, 0x72, 0xcb, 0x82, 0x38, 0xb9, 0x65, 0x51, 0x94, 0x0e, 0x79,
+		0x2e, 0xd6, 0xd0, 0xcc, 0x73, 0x3e, 0x39, 0xe2, 0xa6, 0x13, 0x5b, 0x08, 0x26, 0x1b, 0x7c, 0x6f,
+		0x3f, 0x5c, 0x74, 0xa8, 0x63, 0xd8, 0x8f, 0x5e, 0xde, 0xbd, 0xbc, 0xbc, 0x7b, 0x49, 0x7d, 0xf7,
+		0x12, 0x12, 0xe7, 0x4e, 0x44, 0x9c, 0x27, 0x60, 0xd8, 0x01, 0x60, 0x1c, 0x78, 0x4a, 0x39, 0xe0,
+		0x34, 0xaa, 0x52, 0x3e, 0x8c, 0x7a, 0x02, 0xfa, 0xa3, 0x52, 0x30, 0xe1, 0x5d, 0x25, 0x74, 0x2b,
+		0xf3, 0xae, 0xb4, 0x87, 0xd7, 0x32, 0xef, 0x09, 0xaf, 0x65, 0xfe, 0xa3, 0x04, 0x13, 0xbd, 0xc0,
+		0xf2, 0x8f, 0x8b, 0xe5, 0xf0, 0x9c, 0xc4, 0x82, 0xc8, 0x49, 0x2c, 0x8a, 0x5d, 0x2a, 0xce, 0x37,
+		0x2e, 0xc6, 0x68, 0x2c, 0xf1, 0xc7, 0x7d, 0x7a, 0x71, 0xbb, 0xa3, 0x7f, 0x2c, 0x41, 0x81, 0xc5,
+		0x1c, 0x7f, 0x92, 0x67, 0xf1, 0x63, 0x19, 0x18, 0xa1, 0xb2, 0x9b, 0xe6, 0xdc, 0xf2, 0x45, 0x9d,
+		0xe5, 0x9d, 0x87, 0xc3, 0xa6, 0x6e, 0xe9, 0xb6, 0x7b, 0x2a, 0xc4, 0x84, 0xee, 0x20, 0x3e, 0x44,
+		0x4b, 0x9d, 0x23, 0x20, 0x4b, 0xb8, 0xa3, 0x89, 0xf3, 0xf2, 0xff, 0x97, 0x04, 0xa7, 0xdd, 0xdd,
+		0xe5, 0x27, 0x81, 0x14, 0xde, 0x1e, 0xb4, 0x18, 0x4e, 0xe7, 0x98, 0x7a, 0xee, 0xff, 0x53, 0x82,
+		0x93, 0x64, 0x23, 0xfa, 0x89, 0x99, 0x37, 0xe7, 0x93, 0x3a, 0xbb, 0xdb, 0xdb, 0x7d, 0x31, 0x0b,
+		0x13, 0x64, 0x91, 0x5e, 0xd9, 0xd4, 0x3b, 0x11, 0xfc, 0xac, 0x7e, 0x34, 0xe0, 0xfa, 0xb1, 0xf9,
+		0xc4, 0x7e, 0x6c, 0x81, 0xe7, 0x74, 0xae, 0x3a, 0xa7, 0xba, 0x74, 0xc9, 0x76, 0x36, 0x24, 0x8e,
+		0xc7, 0x71, 0x4e, 0xfc, 0xe6, 0xd1, 0x8b, 0x91, 0xc7, 0x47, 0xac, 0x60, 0x01, 0xba, 0x0f, 0x39,
+		0xdf, 0x03, 0x1d, 0xd6, 0x21, 0xbb, 0xda, 0xba, 0x9c, 0x30, 0x7d, 0x96, 0xd3, 0xb1, 0x1e, 0x2c,
+		0x40, 0x8b, 0x30, 0x44, 0x7c, 0x2a, 0xb7, 0xcf, 0xb8, 0x28, 0xb5, 0x68, 0xc8, 0x3f, 0x06, 0xbb,
+		0xf7, 0x5b, 0xfe, 0xaa, 0x04, 0x72, 0x1c, 0x1b, 0x1c, 0xc7, 0xfd, 0x33, 0x00, 0x3d, 0x1c, 0xdc,
+		0xe8, 0xcd, 0xa9, 0x64, 0x73, 0x60, 0xc1, 0x55, 0x5e, 0xeb, 0xc4, 0x7b, 0xa6, 0xef, 0x65, 0x61,
+		0x92, 0xa0, 0x46, 0xfd, 0xa3, 0xd6, 0x4b, 0x19, 0x79, 0x51, 0x32, 0x82, 0xde, 0x82, 0x43, 0xec,
+		0x85, 0x85, 0xdb, 0xd7, 0x02, 0x75, 0x23, 0xaf, 0x25, 0x7c, 0x83, 0x4d, 0xd8, 0xe7, 0xbc, 0xd4,
+		0x18, 0x66, 0xfd, 0x38, 0xb2, 0xf7, 0xbe, 0x04, 0xe7, 0xe2, 0x19, 0xfc, 0x02, 0xa5, 0xef, 0x03,
+		0x09, 0xf2, 0x34, 0x8c, 0xc1, 0xed, 0xf2, 0xa3, 0xd9, 0xa0, 0xf7, 0xc2, 0x2c, 0x0a, 0x29, 0xc3,
+		0x2c, 0xe4, 0xdb, 0x70, 0x3c, 0x84, 0xaf, 0x43, 0xbd, 0x09, 0x18, 0xa6, 0x10, 0x6a, 0x20, 0x09,
+		0xf1, 0x10, 0x2d, 0xc3, 0xb4, 0x48, 0x5e, 0x86, 0x43, 0x81, 0x3e, 0xc9, 0x1e, 0x83, 0xb5, 0xe9,
+		0x3d, 0x60, 0x1a, 0xc4, 0x83, 0xb4, 0x84, 0x6e, 0xca, 0x7a, 0xd5, 0x9a, 0xf9, 0xd0, 0x3d, 0x5c,
+		0x66, 0xd5, 0x25, 0xf3, 0xa1, 0x25, 0x7f, 0x01, 0x4e, 0x61, 0xdd, 0xd2, 0x6d, 0x76, 0x55, 0xdf,
+		0xdb, 0x98, 0x7d, 0x14, 0x04, 0x94, 0xc7, 0xe0, 0x34, 0x77, 0x6c, 0x46, 0x0c, 0xf9, 0x3f, 0x78,
+		0x47, 0xfd, 0x74, 0xa6, 0xbb, 0xb9, 0x62, 0xc0, 0x70, 0xd8, 0x7b, 0xc0, 0xdd, 0xfb, 0x60, 0xad,
+		0xe8, 0xc4, 0x2a, 0x3a, 0x0c, 0xdd, 0xe5, 0x1e, 0x6a, 0xfa, 0xff, 0x46, 0x98, 0x54, 0x88, 0x30,
+		0x89, 0x3e, 0xc1, 0x32, 0xcd, 0xae, 0xa9, 0x6e, 0xe8, 0x96, 0xa5, 0x3d, 0x74, 0xbf, 0x90, 0x31,
+		0x4c, 0x0b, 0x97, 0x59, 0x99, 0xfc, 0x23, 0x12, 0x8c, 0xbb, 0x51, 0xe3, 0xa9, 0x9d, 0xe3, 0xbd,
+		0xe1, 0xc0, 0xcf, 0x65, 0xe0, 0xd8, 0xaa, 0x2f, 0x22, 0x9e, 0x78, 0x0f, 0x24, 0xa8, 0xf6, 0x23,
+		0xfb, 0x0e, 0xf0, 0x9b, 0x30, 0x40, 0xec, 0x8c, 0x9b, 0x64, 0x94, 0xff, 0x8a, 0x2b, 0x84, 0x21,
+		0x31, 0x50, 0x3a, 0x66, 0xed, 0xc8, 0xfd, 0x6e, 0xf4, 0x26, 0xb3, 0x28, 0xb8, 0xc8, 0x7c, 0x15,
+		0x46, 0xda, 0x9a, 0x65, 0xab, 0xc1, 0x0b, 0x5a, 0x76, 0x27, 0xcc, 0x42, 0xbe, 0x4e, 0x90, 0xfa,
+		0x45, 0xff, 0xf5, 0x2c, 0xad, 0x95, 0x7f, 0x37, 0x03, 0x13, 0x31, 0xbc, 0x72, 0xd4, 0xb7, 0x0d,
+		0x27, 0x03, 0x91, 0x36, 0xbe, 0xc8, 0x61, 0x88, 0x89, 0x1c, 0xe6, 0xe5, 0xc2, 0xf0, 0x9a, 0xe2,
+		0x13, 0x3a, 0xb7, 0x1c, 0x3d, 0x80, 0x93, 0xdc, 0xc7, 0xd2, 0xbd, 0x90, 0xf4, 0x34, 0x76, 0xf7,
+		0xf8, 0x36, 0xaf, 0x18, 0xdd, 0x07, 0x14, 0x78, 0x54, 0x61, 0xd8, 0x86, 0x6e, 0x39, 0x8f, 0xc1,
+		0x8a, 0x49, 0x98, 0x45, 0x3b, 0x3f, 0xba, 0x19, 0x28, 0x34, 0x58, 0xee, 0x5e, 0xf6, 0x2a, 0xd7,
+		0xd9, 0x9c, 0x38, 0xff, 0xe4, 0x5f, 0x92, 0xe0, 0xa4, 0x4b, 0xe8, 0xa4, 0xd6, 0xe8, 0x59, 0x02,
+		0x44, 0xee, 0xc2, 0xe1, 0x5e, 0x5b, 0x7f, 0xba, 0xdb, 0x89, 0xd8, 0x0e, 0x98, 0x24, 0xdb, 0xbe,
+		0x7f, 0xf2, 0x1a, 0x8c, 0x44, 0xf1, 0x76, 0xe4, 0xe2, 0x16, 0x1c, 0xd8, 0xec, 0xb6, 0xdb, 0xba,
+		0xe9, 0xae, 0x88, 0x67, 0x85, 0x71, 0x40, 0xce, 0xeb, 0x04, 0x17, 0x5e, 0xc6, 0x00, 0x5e, 0x31,
+		0x89, 0x55, 0xa4, 0x02, 0xac, 0x35, 0x9b, 0xba, 0x65, 0xf9, 0x5f, 0x77, 0x1e, 0x26, 0xe5, 0x25,
+		0x5a, 0x4c, 0xdf, 0x77, 0xc6, 0x7d, 0x63, 0xec, 0xf7, 0x33, 0x30, 0xe4, 0x3b, 0x89, 0x21, 0x2f,
+		0x67, 0xd8, 0x13, 0x7b, 0x72, 0x02, 0x64, 0xeb, 0xe6, 0x13, 0xfa, 0xa3, 0x77, 0x08, 0xc3, 0xde,
+		0x16, 0x8d, 0x38, 0x20, 0x55, 0x07, 0xa2, 0xda, 0x71, 0x0f, 0xc0, 0x66, 0xe1, 0x18, 0x09, 0x71,
+		0xec, 0xae, 0xaf, 0xab, 0xcd, 0xae, 0xbe, 0xbe, 0x6e, 0x34, 0x0d, 0xbd, 0xc3, 0x18, 0x21, 0x61,
+		0xe4, 0x54, 0x95, 0xbd, 0x1a, 0x32, 0x9e, 0xeb, 0xed, 0xf1, 0xc6, 0x63, 0x67, 0xdc, 0x23, 0x0e,
+		0x48, 0x74, 0xbc, 0x4b, 0x90, 0x73, 0x9b, 0x07, 0x76, 0xb8, 0x9e, 0xaf, 0xd8, 0xdb, 0xe3, 0xbe,
+		0x0e, 0xa7, 0x3b, 0xec, 0x51, 0x9b, 0x69, 0x68, 0x0f, 0xda, 0xba, 0xca, 0xac, 0x32, 0xdb, 0x0d,
+		0x59, 0xf4, 0xe9, 0xe8, 0x20, 0x1e, 0xe9, 0x10, 0x15, 0x76, 0x20, 0x14, 0x02, 0x80, 0x59, 0x3d,
+		0x2a, 0x43, 0x81, 0x3e, 0x05, 0xd2, 0x1c, 0xcd, 0x8a, 0xe2, 0xca, 0x0e, 0x1d, 0x4f, 0x7b, 0x50,
+		0x11, 0x74, 0xa7, 0xfe, 0x62, 0x06, 0x8e, 0x73, 0x0f, 0xec, 0xd0, 0x39, 0x18, 0xbf, 0xbf, 0x82,
+		0xef, 0x2d, 0x2c, 0xad, 0xdc, 0x57, 0xab, 0x15, 0x15, 0x2b, 0x6b, 0x75, 0x45, 0x5d, 0x5d, 0x59,
+		0xaa, 0x96, 0xdf, 0x56, 0xab, 0xb5, 0xb7, 0x4a, 0x4b, 0xd5, 0x4a, 0xee, 0xcf, 0xa0, 0x57, 0xe1,
+		0x86, 0x10, 0xaa, 0xb4, 0x44, 0x4a, 0x2b, 0x6b, 0xab, 0x4b, 0xd5, 0x72, 0xa9, 0xa1, 0xa8, 0x0b,
+		0xa5, 0xea, 0x92, 0x52, 0x51, 0x57, 0x6a, 0x4b, 0x6f, 0xe7, 0x24, 0x74, 0x19, 0x8a, 0x49, 0x5b,
+		0xe6, 0x32, 0xe8, 0x0a, 0x5c, 0x12, 0x42, 0x63, 0xe5, 0x33, 0x4a, 0xb9, 0xe1, 0x03, 0xcf, 0xa2,
+		0x6b, 0x70, 0x45, 0x08, 0xde, 0x50, 0xf0, 0x72, 0xb5, 0x46, 0x10, 0xaa, 0x2e, 0xa8, 0x78, 0xad,
+		0x56, 0xab, 0xd6, 0xee, 0xe6, 0xf6, 0x4d, 0xfd, 0x88, 0x04, 0xc3, 0xfe, 0xd7, 0xc0, 0x68, 0x14,
+		0x8e, 0x57, 0x56, 0x96, 0x4b, 0xd5, 0x9a, 0x5a, 0x6f, 0x94, 0x1a, 0x6b, 0x75, 0xdf, 0xac, 0xcf,
+		0xc0, 0x48, 0xb0, 0x0a, 0x2b, 0x77, 0xab, 0xf5, 0x86, 0x82, 0x95, 0x4a, 0x4e, 0x8a, 0xd6, 0x56,
+		0x94, 0x55, 0xac, 0x10, 0xcc, 0x2a, 0xb9, 0x4c, 0xb4, 0xdb, 0x8a, 0xb2, 0xa4, 0x90, 0xaa, 0xec,
+		0xd4, 0x2f, 0x48, 0x30, 0xe4, 0x4b, 0xc9, 0x8e, 0x46, 0x20, 0xdf, 0xa8, 0x2e, 0x2b, 0x2b, 0x6b,
+		0x0d, 0xb5, 0xf1, 0xf6, 0xaa, 0xe2, 0x43, 0xe0, 0x2c, 0x9c, 0x0e, 0xd4, 0xd4, 0x1b, 0x25, 0xdc,
+		0x50, 0x1b, 0x2b, 0x6a, 0x79, 0x69, 0xa5, 0xae, 0xe4, 0x24, 0x24, 0x43, 0x21, 0x08, 0x50, 0x5e,
+		0x54, 0x2a, 0x6b, 0x4b, 0x0a, 0x81, 0xa1, 0xc0, 0xb9, 0x4c, 0x2c, 0x0c, 0xeb, 0x27, 0x8b, 0x4e,
+		0xc1, 0x89, 0x00, 0xcc, 0xa2, 0x52, 0xc2, 0x8d, 0x3b, 0x4a, 0xa9, 0x91, 0xdb, 0x37, 0xf5, 0x4f,
+		0xf7, 0xc1, 0x70, 0x2f, 0x14, 0x8f, 0xe0, 0x4b, 0xa6, 0xa6, 0x94, 0xab, 0xf5, 0xea, 0x4a, 0x2d,
+		0x8c, 0x70, 0x11, 0xce, 0x05, 0xab, 0x7a, 0x83, 0x95, 0xca, 0x8d, 0xea, 0x5b, 0xd5, 0xc6, 0xdb,
+		0x6a, 0xa3, 0x54, 0xbf, 0x97, 0x93, 0xd0, 0x0c, 0x4c, 0x05, 0x21, 0xb1, 0xf2, 0xd9, 0x35, 0xa5,
+		0xde, 0x50, 0xcb, 0xa5, 0x5a, 0x59, 0x59, 0x0a, 0xc1, 0x67, 0xd0, 0x18, 0x8c, 0x86, 0x7a, 0x66,
+		0xb4, 0xa8, 0x2e, 0x2b, 0x38, 0x97, 0x25, 0x82, 0x13, 0xac, 0x2e, 0xaf, 0x2c, 0xaf, 0x12, 0x82,
+		0xab, 0x3d, 0x01, 0x51, 0x3e, 0xa7, 0x94, 0xd7, 0x1a, 0xd5, 0x95, 0x5a, 0x6e, 0x1f, 0xba, 0x04,
+		0xe7, 0x83, 0xe0, 0x44, 0x68, 0x79, 0xa0, 0x03, 0xa8, 0x00, 0xa7, 0x42, 0x3d, 0x33, 0x04, 0xd9,
+		0xc8, 0xfb, 0xd1, 0x34, 0x5c, 0xe4, 0xd6, 0x73, 0x3a, 0x3b, 0x80, 0xe6, 0xe1, 0xd5, 0xd8, 0x59,
+		0x2b, 0x9f, 0x6b, 0x28, 0xb8, 0x56, 0xe2, 0xb6, 0x3e, 0x48, 0xc4, 0x21, 0xdc, 0xba, 0xbc, 0x82,
+		0x2b, 0xea, 0x72, 0x09, 0xdf, 0x53, 0x70, 0x6e, 0x10, 0xdd, 0x80, 0xab, 0x61, 0x2a, 0xd4, 0x1a,
+		0xd5, 0xda, 0x9a, 0xa2, 0x96, 0xea, 0x6a, 0x4d, 0xb9, 0xcf, 0xeb, 0x16, 0xd0, 0x55, 0xb8, 0xcc,
+		0x23, 0x6d, 0x79, 0xb1, 0xba, 0x54, 0xe1, 0xb5, 0x18, 0x8a, 0x8e, 0x53, 0xaf, 0xde, 0xad, 0x95,
+		0xe2, 0xd1, 0x1f, 0x9e, 0xfa, 0xe3, 0x23, 0x30, 0xd8, 0xcb, 0xd5, 0x8e, 0x4e, 0x00, 0x52, 0xde,
+		0x52, 0x6a, 0x11, 0x99, 0xbf, 0x04, 0xe7, 0x7d, 0xe5, 0xd1, 0x8e, 0x18, 0x76, 0x54, 0x03, 0xa7,
+		0xe1, 0x62, 0x3c, 0xa8, 0x2b, 0x04, 0x44, 0x21, 0x8b, 0x70, 0x2e, 0x1e, 0x98, 0xd9, 0xad, 0x5c,
+		0xb6, 0x7f, 0xb7, 0x84, 0xf5, 0x15, 0x75, 0x65, 0xad, 0x91, 0xdb, 0x87, 0x2e, 0x80, 0xec, 0x03,
+		0xf6, 0xa8, 0x52, 0xaa, 0xdf, 0xeb, 0x09, 0x7f, 0x25, 0x37, 0x40, 0xec, 0xac, 0x18, 0xce, 0x99,
+		0xd1, 0xfe, 0xd8, 0xde, 0xbc, 0xc9, 0x1c, 0x88, 0x85, 0xf3, 0xb0, 0x3b, 0x88, 0x26, 0xe1, 0xac,
+		0x10, 0xce, 0x99, 0xef, 0x60, 0xa8, 0xb3, 0x80, 0xe2, 0xf9, 0xa6, 0x00, 0xa1, 0x29, 0x84, 0xe0,
+		0x9c, 0x29, 0x0c, 0xc5, 0xf6, 0xe6, 0x4d, 0x61, 0x38, 0x84, 0x5a, 0x10, 0xce, 0x41, 0xed, 0x50,
+		0x6c, 0x67, 0xde, 0x3c, 0x0f, 0x13, 0xf5, 0x17, 0x0f, 0xca, 0x54, 0xcb, 0xd1, 0x34, 0xa5, 0x92,
+		0x3b, 0x82, 0xe6, 0x60, 0xc6, 0x07, 0x1e, 0x67, 0x79, 0x5c, 0x54, 0x72, 0xe8, 0x3c, 0x4c, 0xf4,
+		0x19, 0x42, 0xa9, 0xe4, 0x8e, 0x92, 0x55, 0xc1, 0x07, 0x46, 0x8d, 0x44, 0x8f, 0x38, 0x88, 0xd8,
+		0xd9, 0x48, 0xed, 0x42, 0x95, 0xac, 0x27, 0xc7, 0x88, 0x9d, 0xf6, 0xd5, 0xf9, 0xad, 0x8c, 0x8b,
+		0x44, 0x9e, 0x58, 0xc1, 0x48, 0xfb, 0xde, 0xe0, 0xc7, 0x43, 0xf3, 0xe2, 0x29, 0x44, 0x98, 0x16,
+		0x27, 0xd0, 0x14, 0x5c, 0x48, 0xd2, 0x46, 0xa9, 0xe4, 0x4e, 0xa2, 0x32, 0xbc, 0x29, 0xa6, 0x5b,
+		0x8c, 0xf2, 0xab, 0xd5, 0x5a, 0xb5, 0x51, 0xa5, 0x2b, 0xe3, 0x08, 0xfa, 0x34, 0xcc, 0xef, 0xae,
+		0x13, 0x87, 0x0a, 0xa3, 0xe8, 0x35, 0xb8, 0xe9, 0xeb, 0x21, 0xae, 0x49, 0x64, 0xbe, 0xa7, 0x88,
+		0x3d, 0xf7, 0x35, 0x66, 0xa6, 0xd3, 0x31, 0xa4, 0x4a, 0x25, 0x77, 0xba, 0x3f, 0x3d, 0x98, 0xad,
+		0x53, 0x2a, 0xb9, 0x33, 0xc4, 0xb9, 0xe9, 0x63, 0x29, 0x5c, 0x27, 0xa4, 0x92, 0x1b, 0x4b, 0xc0,
+		0x1d, 0xc7, 0x5a, 0x57, 0x1c, 0x73, 0x9d, 0x2b, 0xa0, 0x9b, 0x70, 0xdd, 0xd7, 0x26, 0xde, 0x30,
+		0xfb, 0xa8, 0x7c, 0x96, 0x98, 0xe8, 0xe4, 0x0d, 0x1d, 0xca, 0x8e, 0xa3, 0x59, 0x98, 0xf6, 0xcb,
+		0xa0, 0x08, 0xde, 0x15, 0xe8, 0x09, 0xe2, 0x81, 0x25, 0x69, 0xe0, 0x29, 0xbe, 0x4c, 0x56, 0xfe,
+		0x24, 0x4d, 0x1c, 0x9c, 0x26, 0xc9, 0xf2, 0x94, 0x68, 0x08, 0x57, 0x4c, 0xcf, 0x25, 0x45, 0xca,
+		0x33, 0x20, 0xe7, 0x43, 0xbc, 0x11, 0x37, 0xf1, 0xf8, 0x79, 0x21, 0x24, 0x86, 0xfd, 0x97, 0x40,
+		0x1f, 0x7f, 0x2e, 0x12, 0x8f, 0x3a, 0x5d, 0x63, 0x87, 0x1e, 0x45, 0x74, 0x1d, 0x66, 0x13, 0x4a,
+		0x7f, 0x4f, 0x52, 0x2f, 0xf5, 0x5f, 0xfd, 0x56, 0x4b, 0x6b, 0x75, 0xa5, 0x92, 0x9b, 0xea, 0xbf,
+		0xfe, 0x62, 0xa5, 0xbe, 0xb6, 0xac, 0x54, 0x72, 0xd3, 0x21, 0xf1, 0x0f, 0x9a, 0x44, 0xac, 0x34,
+		0xf0, 0xdb, 0x3e, 0xc5, 0xbb, 0x3c, 0xf5, 0xab, 0x00, 0x27, 0x7b, 0x7e, 0x64, 0xf0, 0x49, 0x07,
+		0xb1, 0xf3, 0xbc, 0xc5, 0x49, 0x2d, 0x13, 0xac, 0x7c, 0xce, 0xc1, 0x35, 0xb8, 0x12, 0x03, 0xb7,
+		0x56, 0x5b, 0x2c, 0xd5, 0x2a, 0xe4, 0xbf, 0x0b, 0x94, 0x93, 0xd0, 0x9b, 0xf0, 0x5a, 0x4c, 0x93,
+		0x3b, 0xa5, 0x0a, 0xc7, 0x47, 0x2d, 0x35, 0x1a, 0xb8, 0x7a, 0x67, 0xad, 0xa1, 0xd4, 0x73, 0x19,
+		0xa4, 0x40, 0xa9, 0x4f, 0x07, 0xa2, 0x05, 0xc4, 0xd7, 0x4d, 0x16, 0xdd, 0x82, 0x4f, 0xf5, 0xc3,
+		0xc3, 0xf3, 0x68, 0xfd, 0x4d, 0xf7, 0xa1, 0xdb, 0xf0, 0x4a, 0x9f, 0xa6, 0x81, 0xd5, 0xc2, 0xd7,
+		0x76, 0x80, 0x08, 0x69, 0x5f, 0xec, 0x7d, 0x4e, 0xa4, 0xbf, 0xf1, 0x7e, 0x54, 0x05, 0xa5, 0xdf,
+		0xc0, 0x62, 0x37, 0xdb, 0xdf, 0xd5, 0x81, 0x04, 0x54, 0x14, 0xb8, 0xe0, 0xfe, 0x6e, 0x0e, 0xa2,
+		0xbb, 0x50, 0x4e, 0x46, 0x8a, 0xf8, 0x8e, 0x06, 0xd1, 0xe7, 0xa0, 0x91, 0x8e, 0xab, 0x71, 0x8a,
+		0xe6, 0xeb, 0x19, 0xd0, 0xeb, 0x70, 0xab, 0x2f, 0xd1, 0x82, 0x5e, 0xb9, 0xaf, 0xf9, 0x10, 0xb1,
+		0xf8, 0x31, 0xcd, 0xfd, 0x32, 0xe2, 0x6d, 0xb9, 0xab, 0xc4, 0xa1, 0x0a, 0x38, 0xe5, 0x91, 0x86,
+		0x58, 0xa9, 0x2b, 0x0d, 0xb5, 0xde, 0xa8, 0x96, 0xef, 0x31, 0x0d, 0x5d, 0xaa, 0xd6, 0x1b, 0xb9,
+		0x43, 0x64, 0x35, 0x8e, 0x69, 0xd5, 0x9b, 0x2b, 0xf9, 0xa1, 0x60, 0x9f, 0x86, 0x11, 0xb0, 0x35,
+		0xac, 0xe4, 0x0e, 0x27, 0x60, 0x89, 0x63, 0xdd, 0xe2, 0x09, 0x77, 0x84, 0x78, 0x17, 0x89, 0x34,
+		0x84, 0x59, 0x67, 0x6e, 0x27, 0x39, 0x62, 0x1d, 0x63, 0x3a, 0x59, 0x58, 0xc1, 0x65, 0x85, 0xed,
+		0x7a, 0x3d, 0x1b, 0x71, 0x14, 0xbd, 0x02, 0x73, 0x71, 0x8d, 0x4a, 0xd5, 0xa5, 0x95, 0xb7, 0x14,
+		0x1c, 0x6e, 0x87, 0xfa, 0x90, 0xdc, 0x37, 0xf5, 0x6a, 0x6d, 0x75, 0xad, 0xa1, 0xd6, 0xab, 0xef,
+		0x28, 0xb9, 0x63, 0x7d, 0x46, 0xeb, 0x51, 0x69, 0xa9, 0xba, 0x5c, 0x6d, 0xa8, 0xca, 0xe7, 0xca,
+		0x8a, 0x42, 0x3c, 0x93, 0xfc, 0xd4, 0x87, 0x12, 0x4c, 0x25, 0xff, 0xe2, 0x3b, 0xd1, 0xfc, 0xc4,
+		0x4e, 0x55, 0xc4, 0xd0, 0xde, 0x87, 0x7a, 0xda, 0xc6, 0x6b, 0xb5, 0x7b, 0xb5, 0x95, 0xfb, 0xb5,
+		0xd8, 0x4d, 0xa0, 0x44, 0x27, 0x91, 0xfc, 0xd3, 0xd6, 0x64, 0x12, 0x89, 0xd7, 0x46, 0xde, 0x24,
+		0xd2, 0x36, 0x4e, 0x36, 0x89, 0xf7, 0x25, 0x98, 0xe8, 0xfb, 0x9d, 0x56, 0xb2, 0x58, 0xf5, 0xf1,
+		0x6e, 0x22, 0x18, 0xdf, 0x81, 0x37, 0x92, 0x35, 0xe9, 0xd5, 0x97, 0x96, 0xb0, 0x52, 0xaa, 0xbc,
+		0xdd, 0x3b, 0xe1, 0x92, 0xa6, 0x7e, 0x2f, 0x03, 0x67, 0xe2, 0x6e, 0x55, 0xc9, 0xfe, 0x96, 0xe7,
+		0x3f, 0x51, 0xe1, 0x8e, 0x9c, 0x81, 0xf9, 0x4f, 0xe4, 0x04, 0xc0, 0x9e, 0x73, 0x27, 0x11, 0xef,
+		0xb9, 0x1f, 0xb8, 0xe3, 0xc8, 0x64, 0x02, 0x47, 0x83, 0xa2, 0xae, 0x5d, 0xa7, 0x2e, 0x4b, 0xdc,
+		0xc6, 0x7e, 0xd0, 0x3e, 0xef, 0x6c, 0x1f, 0xd1, 0xcd, 0xfe, 0x88, 0x87, 0xfc, 0xed, 0x81, 0x24,
+		0xd3, 0xf5, 0xdc, 0xc6, 0xfd, 0x53, 0x3f, 0x0c, 0x43, 0xe5, 0xc0, 0x27, 0x9a, 0xf3, 0x8c, 0x7d,
+		0x91, 0x03, 0xd4, 0x53, 0x70, 0x22, 0x50, 0xd3, 0x43, 0x35, 0x27, 0x91, 0x63, 0x9d, 0x40, 0x5d,
+		0x70, 0xe1, 0xc9, 0x65, 0x22, 0xdd, 0x96, 0xee, 0x94, 0x6a, 0x95, 0x95, 0x5a, 0x2e, 0x3b, 0xf5,
+		0x93, 0x12, 0x9c, 0xe0, 0x5f, 0x18, 0x92, 0xfd, 0xea, 0x67, 0xd7, 0x14, 0x1c, 0xde, 0x79, 0x87,
+		0x8f, 0x5b, 0x2e, 0xc2, 0xa4, 0x18, 0xcc, 0xcf, 0xd9, 0x73, 0x30, 0x2e, 0x06, 0x74, 0x79, 0x3a,
+		0xf5, 0x4d, 0x09, 0xf2, 0xbc, 0xab, 0x32, 0xb2, 0xbb, 0x5d, 0x55, 0x6a, 0x95, 0x6a, 0xed, 0xae,
+		0xe7, 0x1f, 0x11, 0x82, 0xfa, 0x71, 0x39, 0x07, 0xe3, 0x02, 0x18, 0xef, 0x18, 0x42, 0x8a, 0xe9,
+		0xc9, 0xdd, 0x96, 0x64, 0x88, 0x88, 0x0b, 0x60, 0x22, 0x3b, 0xc2, 0xec, 0xd4, 0x57, 0x24, 0x38,
+		0xc1, 0x7f, 0x27, 0x40, 0x88, 0xb8, 0x58, 0xad, 0x37, 0x56, 0xf0, 0xdb, 0x2a, 0xf3, 0x74, 0x17,
+		0xaa, 0x4b, 0x0d, 0x05, 0x73, 0x88, 0x28, 0x06, 0x2b, 0x2d, 0x2d, 0xb1, 0xd2, 0x9c, 0x44, 0x9c,
+		0x6b, 0x31, 0x20, 0x93, 0x32, 0x06, 0x9a, 0x99, 0xfa, 0x7e, 0x18, 0x76, 0xef, 0x70, 0xee, 0x19,
+		0x9d, 0x16, 0x3d, 0xa2, 0x25, 0x94, 0x27, 0xeb, 0xb6, 0x7a, 0xaf, 0x5a, 0xab, 0xf8, 0xc6, 0x1f,
+		0x85, 0xe3, 0xa1, 0xba, 0xda, 0x0a, 0x5e, 0x2e, 0x2d, 0xe5, 0x24, 0x4e, 0x15, 0xf3, 0x01, 0x72,
+		0x99, 0xa9, 0xef, 0x83, 0x61, 0x7f, 0x3a, 0x51, 0x02, 0xaa, 0xd4, 0xca, 0x2b, 0x94, 0x6a, 0xa1,
+		0x09, 0x9e, 0x86, 0x93, 0xc1, 0xaa, 0xc6, 0x22, 0xae, 0x2e, 0x34, 0x54, 0x7c, 0x3f, 0x27, 0xd1,
+		0x93, 0xbc, 0x40, 0xe5, 0x67, 0xea, 0x2b, 0xb5, 0x5c, 0x66, 0xaa, 0xe5, 0xcd, 0x80, 0xf6, 0x1f,
+		0x98, 0x41, 0x74, 0x80, 0x50, 0x9d, 0xcf, 0x85, 0x8f, 0x56, 0xba, 0x4c, 0xcd, 0x65, 0xee, 0xc8,
+		0x70, 0xb2, 0xd9, 0xdd, 0xe0, 0xdd, 0x65, 0xad, 0x4a, 0xef, 0x0c, 0x68, 0x9b, 0xc6, 0x93, 0x6b,
+		0x0f, 0xf6, 0x6f, 0x9a, 0x5d, 0xbb, 0x7b, 0xfd, 0xff, 0x0f, 0x00, 0x1e, 0x17, 0x9d, 0xe9, 0x14,
+		0xba, 0x00, 0x00,
+	},
+}
+
+func init() {
+	yarpc.RegisterClientBuilder(
+		func(clientConfig transport.ClientConfig, structField reflect.StructField) WorkflowServiceYARPCClient {
+			return NewWorkflowServiceYARPCClient(clientConfig, protobuf.ClientBuilderOptions(clientConfig, structField)...)
+		},
+	)
+}
@@ -21,12 +21,14 @@
 package client
 
 import (
+	"github.com/uber-common/bark"
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 	"time"
 )
 
@@ -45,17 +47,22 @@ type rpcClientFactory struct {
 	df                    common.RPCFactory
 	monitor               membership.Monitor
 	metricsClient         metrics.Client
+	dynamicCollection     *dynamicconfig.Collection
 	numberOfHistoryShards int
+	logger                bark.Logger
 }
 
 // NewRPCClientFactory creates an instance of client factory that knows how to dispatch RPC calls.
 func NewRPCClientFactory(df common.RPCFactory,
-	monitor membership.Monitor, metricsClient metrics.Client, numberOfHistoryShards int) Factory {
+	monitor membership.Monitor, metricsClient metrics.Client, dc *dynamicconfig.Collection,
+	numberOfHistoryShards int, logger bark.Logger) Factory {
 	return &rpcClientFactory{
 		df:                    df,
 		monitor:               monitor,
 		metricsClient:         metricsClient,
+		dynamicCollection:     dc,
 		numberOfHistoryShards: numberOfHistoryShards,
+		logger:                logger,
 	}
 }
 
@@ -72,7 +79,10 @@ func (cf *rpcClientFactory) NewFrontendClient() (frontend.Client, error) {
 }
 
 func (cf *rpcClientFactory) NewHistoryClientWithTimeout(timeout time.Duration) (history.Client, error) {
-	client, err := history.NewClient(cf.df, cf.monitor, cf.numberOfHistoryShards, timeout)
+	client, err := history.NewClient(cf.df, cf.monitor, cf.numberOfHistoryShards, timeout,
+		cf.dynamicCollection.GetStringProperty(dynamicconfig.DrainedHosts, ""),
+		cf.dynamicCollection.GetStringProperty(dynamicconfig.ShardOwnershipOverrides, ""),
+		cf.logger)
 	if err != nil {
 		return nil, err
 	}
@@ -25,11 +25,14 @@ import (
 	"sync"
 	"time"
 
+	"github.com/uber-common/bark"
 	h "github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/history/historyserviceclient"
+	"github.com/uber/cadence/.gen/go/replicator"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 	"go.uber.org/yarpc"
 )
 
@@ -49,10 +52,15 @@ type clientImpl struct {
 	thriftCache     map[string]historyserviceclient.Interface
 	rpcFactory      common.RPCFactory
 	timeout         time.Duration
+	// requests are routed to the shard owners as resolved by the history hosts,
+	// taking the drained hosts and the shard ownership overrides into account
+	shardOwnership *membership.ShardOwnership
 }
 
 // NewClient creates a new history service TChannel client
-func NewClient(d common.RPCFactory, monitor membership.Monitor, numberOfShards int, timeout time.Duration) (Client, error) {
+func NewClient(d common.RPCFactory, monitor membership.Monitor, numberOfShards int, timeout time.Duration,
+	drainedHosts dynamicconfig.StringPropertyFn, shardOwnershipOverrides dynamicconfig.StringPropertyFn,
+	logger bark.Logger) (Client, error) {
 	sResolver, err := monitor.GetResolver(common.HistoryServiceName)
 	if err != nil {
 		return nil, err
@@ -65,6 +73,7 @@ func NewClient(d common.RPCFactory, monitor membership.Monitor, numberOfShards i
 		numberOfShards:  numberOfShards,
 		thriftCache:     make(map[string]historyserviceclient.Interface),
 		timeout:         timeout,
+		shardOwnership:  membership.NewShardOwnership(sResolver, drainedHosts, shardOwnershipOverrides, logger),
 	}
 	return client, nil
 }
@@ -133,11 +142,11 @@ func (c *clientImpl) DescribeHistoryHost(
 			shardID = common.WorkflowIDToHistoryShard(*request.ExecutionForHost.WorkflowId, c.numberOfShards)
 		}
 
-		host, err := c.resolver.Lookup(string(shardID))
+		var err error
+		hostAddr, err = c.shardOwnership.Route(shardID)
 		if err != nil {
 			return nil, err
 		}
-		hostAddr = host.GetAddress()
 	}
 
 	client := c.getThriftClient(hostAddr)
@@ -182,6 +191,78 @@ func (c *clientImpl) DescribeMutableState(
 	return response, nil
 }
 
+func (c *clientImpl) MigrateWorkflowHistory(
+	ctx context.Context,
+	request *h.MigrateWorkflowHistoryRequest,
+	opts ...yarpc.CallOption) (*h.MigrateWorkflowHistoryResponse, error) {
+	client, err := c.getHostForRequest(*request.Execution.WorkflowId)
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *h.MigrateWorkflowHistoryResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.MigrateWorkflowHistory(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) GetWorkflowReplicationTasks(
+	ctx context.Context,
+	request *h.GetWorkflowReplicationTasksRequest,
+	opts ...yarpc.CallOption) (*h.GetWorkflowReplicationTasksResponse, error) {
+	client, err := c.getHostForRequest(*request.Execution.WorkflowId)
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *h.GetWorkflowReplicationTasksResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.GetWorkflowReplicationTasks(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *h.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (*h.DeleteWorkflowExecutionResponse, error) {
+	client, err := c.getHostForRequest(*request.Execution.WorkflowId)
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *h.DeleteWorkflowExecutionResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.DeleteWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) ResetStickyTaskList(
 	ctx context.Context,
 	request *h.ResetStickyTaskListRequest,
@@ -515,6 +596,96 @@ func (c *clientImpl) TerminateWorkflowExecution(
 	return err
 }
 
+func (c *clientImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *h.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	client, err := c.getHostForRequest(*request.PauseRequest.WorkflowExecution.WorkflowId)
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.PauseWorkflowExecution(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	return err
+}
+
+func (c *clientImpl) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *h.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	client, err := c.getHostForRequest(*request.ResumeRequest.WorkflowExecution.WorkflowId)
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.ResumeWorkflowExecution(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	return err
+}
+
+func (c *clientImpl) RetryActivityTask(
+	ctx context.Context,
+	request *h.RetryActivityTaskRequest,
+	opts ...yarpc.CallOption) error {
+	client, err := c.getHostForRequest(*request.RetryRequest.WorkflowExecution.WorkflowId)
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.RetryActivityTask(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	return err
+}
+
+func (c *clientImpl) CompleteActivityTask(
+	ctx context.Context,
+	request *h.CompleteActivityTaskRequest,
+	opts ...yarpc.CallOption) error {
+	client, err := c.getHostForRequest(*request.CompleteRequest.WorkflowExecution.WorkflowId)
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.CompleteActivityTask(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	return err
+}
+
+func (c *clientImpl) FailActivityTask(
+	ctx context.Context,
+	request *h.FailActivityTaskRequest,
+	opts ...yarpc.CallOption) error {
+	client, err := c.getHostForRequest(*request.FailRequest.WorkflowExecution.WorkflowId)
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.FailActivityTask(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	return err
+}
+
 func (c *clientImpl) ScheduleDecisionTask(
 	ctx context.Context,
 	request *h.ScheduleDecisionTaskRequest,
@@ -575,11 +746,10 @@ func (c *clientImpl) SyncShardStatus(
 	opts ...yarpc.CallOption) error {
 
 	// we do not have a workflow ID here, instead, we have something even better
-	host, err := c.resolver.Lookup(string(request.GetShardId()))
+	client, err := c.getHostForShard(int32(request.GetShardId()))
 	if err != nil {
 		return err
 	}
-	client := c.getThriftClient(host.GetAddress())
 
 	opts = common.AggregateYarpcOptions(ctx, opts...)
 	op := func(ctx context.Context, client historyserviceclient.Interface) error {
@@ -610,14 +780,348 @@ func (c *clientImpl) SyncActivity(
 	return err
 }
 
+func (c *clientImpl) GetReplicationMessages(
+	ctx context.Context,
+	request *replicator.GetReplicationMessagesRequest,
+	opts ...yarpc.CallOption) (*replicator.GetReplicationMessagesResponse, error) {
+
+	tokens := make(map[int32]*replicator.ReplicationToken)
+	var shardIDs []int32
+	for _, token := range request.Tokens {
+		tokens[token.GetShardID()] = token
+		shardIDs = append(shardIDs, token.GetShardID())
+	}
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var responseLock sync.Mutex
+	response := &replicator.GetReplicationMessagesResponse{
+		MessagesByShard: make(map[int32]*replicator.ReplicationMessages),
+	}
+	// shards owned by a failing host are simply missing from the response,
+	// the caller will ask for them again in the next poll
+	err := c.callShardHosts(shardIDs, func(client historyserviceclient.Interface, shardIDs []int32) ([]int32, error) {
+		hostRequest := &replicator.GetReplicationMessagesRequest{
+			ClusterName: request.ClusterName,
+		}
+		for _, shardID := range shardIDs {
+			hostRequest.Tokens = append(hostRequest.Tokens, tokens[shardID])
+		}
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		resp, err := client.GetReplicationMessages(ctx, hostRequest, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		responseLock.Lock()
+		defer responseLock.Unlock()
+		var servedShardIDs []int32
+		for shardID, messages := range resp.MessagesByShard {
+			response.MessagesByShard[shardID] = messages
+			servedShardIDs = append(servedShardIDs, shardID)
+		}
+		return servedShardIDs, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) GetReplicationStatus(
+	ctx context.Context,
+	request *h.GetReplicationStatusRequest,
+	opts ...yarpc.CallOption) (*h.GetReplicationStatusResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var responseLock sync.Mutex
+	response := &h.GetReplicationStatusResponse{}
+	// shards owned by a failing host are missing from the response, it is up to the caller
+	// to decide whether a partial status is good enough
+	err := c.callShardHosts(request.ShardIDs, func(client historyserviceclient.Interface, shardIDs []int32) ([]int32, error) {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		resp, err := client.GetReplicationStatus(ctx, &h.GetReplicationStatusRequest{
+			DomainUUID: request.DomainUUID,
+			ShardIDs:   shardIDs,
+		}, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		responseLock.Lock()
+		defer responseLock.Unlock()
+		var servedShardIDs []int32
+		for _, status := range resp.Shards {
+			response.Shards = append(response.Shards, status)
+			servedShardIDs = append(servedShardIDs, status.GetShardID())
+		}
+		return servedShardIDs, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) DescribeShard(
+	ctx context.Context,
+	request *h.DescribeShardRequest,
+	opts ...yarpc.CallOption) (*h.DescribeShardResponse, error) {
+	client, err := c.getHostForShard(request.GetShardID())
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *h.DescribeShardResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.DescribeShard(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) ListShardTasks(
+	ctx context.Context,
+	request *h.ListShardTasksRequest,
+	opts ...yarpc.CallOption) (*h.ListShardTasksResponse, error) {
+	client, err := c.getHostForShard(request.GetShardID())
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *h.ListShardTasksResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.ListShardTasks(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) RemoveShardTask(
+	ctx context.Context,
+	request *h.RemoveShardTaskRequest,
+	opts ...yarpc.CallOption) error {
+	client, err := c.getHostForShard(request.GetShardID())
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.RemoveShardTask(ctx, request, opts...)
+	}
+	return c.executeWithRedirect(ctx, client, op)
+}
+
+func (c *clientImpl) RescheduleShardTask(
+	ctx context.Context,
+	request *h.RescheduleShardTaskRequest,
+	opts ...yarpc.CallOption) error {
+	client, err := c.getHostForShard(request.GetShardID())
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.RescheduleShardTask(ctx, request, opts...)
+	}
+	return c.executeWithRedirect(ctx, client, op)
+}
+
+func (c *clientImpl) ListQuarantinedTasks(
+	ctx context.Context,
+	request *h.ListQuarantinedTasksRequest,
+	opts ...yarpc.CallOption) (*h.ListQuarantinedTasksResponse, error) {
+	client, err := c.getHostForShard(request.GetShardID())
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *h.ListQuarantinedTasksResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.ListQuarantinedTasks(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) RetryQuarantinedTask(
+	ctx context.Context,
+	request *h.RetryQuarantinedTaskRequest,
+	opts ...yarpc.CallOption) error {
+	client, err := c.getHostForShard(request.GetShardID())
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.RetryQuarantinedTask(ctx, request, opts...)
+	}
+	return c.executeWithRedirect(ctx, client, op)
+}
+
+func (c *clientImpl) DropQuarantinedTask(
+	ctx context.Context,
+	request *h.DropQuarantinedTaskRequest,
+	opts ...yarpc.CallOption) error {
+	client, err := c.getHostForShard(request.GetShardID())
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.DropQuarantinedTask(ctx, request, opts...)
+	}
+	return c.executeWithRedirect(ctx, client, op)
+}
+
+func (c *clientImpl) CloseShard(
+	ctx context.Context,
+	request *h.CloseShardRequest,
+	opts ...yarpc.CallOption) error {
+	client, err := c.getHostForShard(request.GetShardID())
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.CloseShard(ctx, request, opts...)
+	}
+	return c.executeWithRedirect(ctx, client, op)
+}
+
+func (c *clientImpl) getHostForShard(shardID int32) (historyserviceclient.Interface, error) {
+	hostAddr, err := c.shardOwnership.Route(int(shardID))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getThriftClient(hostAddr), nil
+}
+
 func (c *clientImpl) getHostForRequest(workflowID string) (historyserviceclient.Interface, error) {
-	key := common.WorkflowIDToHistoryShard(workflowID, c.numberOfShards)
-	host, err := c.resolver.Lookup(string(key))
+	shardID := common.WorkflowIDToHistoryShard(workflowID, c.numberOfShards)
+	hostAddr, err := c.shardOwnership.Route(shardID)
 	if err != nil {
 		return nil, err
 	}
 
-	return c.getThriftClient(host.GetAddress()), nil
+	return c.getThriftClient(hostAddr), nil
+}
+
+// callShardHosts groups the given shards by the history host they are routed to and calls the hosts
+// in parallel, call returns the shards served by a host. A drained host leaves out the shards it
+// already handed over, these are sent to their new owners in a second round. An error is only
+// returned when no shard is served at all.
+func (c *clientImpl) callShardHosts(shardIDs []int32,
+	call func(client historyserviceclient.Interface, shardIDs []int32) ([]int32, error)) error {
+
+	served, err := c.callHosts(shardIDs, c.shardOwnership.Route, call)
+	if served == nil {
+		return err
+	}
+
+	var handedOver []int32
+	for _, shardID := range shardIDs {
+		if served[shardID] {
+			continue
+		}
+		if _, drainedHost, lookupErr := c.shardOwnership.Lookup(int(shardID)); lookupErr == nil && drainedHost != "" {
+			handedOver = append(handedOver, shardID)
+		}
+	}
+	if len(handedOver) != 0 {
+		servedByOwners, ownersErr := c.callHosts(handedOver, func(shardID int) (string, error) {
+			owner, _, err := c.shardOwnership.Lookup(shardID)
+			if err != nil {
+				return "", err
+			}
+			return owner.GetAddress(), nil
+		}, call)
+		for shardID := range servedByOwners {
+			served[shardID] = true
+		}
+		if err == nil {
+			err = ownersErr
+		}
+	}
+
+	if len(served) != 0 {
+		return nil
+	}
+	return err
+}
+
+// callHosts calls the hosts the given shards are routed to in parallel, returning the shards served by them.
+// The returned shards are nil if a shard cannot be routed.
+func (c *clientImpl) callHosts(shardIDs []int32, route func(shardID int) (string, error),
+	call func(client historyserviceclient.Interface, shardIDs []int32) ([]int32, error)) (map[int32]bool, error) {
+
+	shardsByHost := make(map[string][]int32)
+	for _, shardID := range shardIDs {
+		hostAddr, err := route(int(shardID))
+		if err != nil {
+			return nil, err
+		}
+		shardsByHost[hostAddr] = append(shardsByHost[hostAddr], shardID)
+	}
+
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	served := make(map[int32]bool)
+	var firstErr error
+	for hostAddr, hostShardIDs := range shardsByHost {
+		wg.Add(1)
+		go func(client historyserviceclient.Interface, hostShardIDs []int32) {
+			defer wg.Done()
+			servedShardIDs, err := call(client, hostShardIDs)
+
+			lock.Lock()
+			defer lock.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				return
+			}
+			for _, shardID := range servedShardIDs {
+				served[shardID] = true
+			}
+		}(c.getThriftClient(hostAddr), hostShardIDs)
+	}
+	wg.Wait()
+	return served, firstErr
 }
 
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
@@ -24,6 +24,7 @@ import (
 	"context"
 
 	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/metrics"
 	"go.uber.org/yarpc"
@@ -79,6 +80,57 @@ func (c *metricClient) DescribeMutableState(
 	return resp, err
 }
 
+func (c *metricClient) MigrateWorkflowHistory(
+	context context.Context,
+	request *h.MigrateWorkflowHistoryRequest,
+	opts ...yarpc.CallOption) (*h.MigrateWorkflowHistoryResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientMigrateWorkflowHistoryScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientMigrateWorkflowHistoryScope, metrics.CadenceClientLatency)
+	resp, err := c.client.MigrateWorkflowHistory(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientMigrateWorkflowHistoryScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) GetWorkflowReplicationTasks(
+	context context.Context,
+	request *h.GetWorkflowReplicationTasksRequest,
+	opts ...yarpc.CallOption) (*h.GetWorkflowReplicationTasksResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientGetWorkflowReplicationTasksScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientGetWorkflowReplicationTasksScope, metrics.CadenceClientLatency)
+	resp, err := c.client.GetWorkflowReplicationTasks(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientGetWorkflowReplicationTasksScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) DeleteWorkflowExecution(
+	context context.Context,
+	request *h.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (*h.DeleteWorkflowExecutionResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientDeleteWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDeleteWorkflowExecutionScope, metrics.CadenceClientLatency)
+	resp, err := c.client.DeleteWorkflowExecution(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDeleteWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) GetMutableState(
 	context context.Context,
 	request *h.GetMutableStateRequest,
@@ -351,6 +403,91 @@ func (c *metricClient) TerminateWorkflowExecution(
 	return err
 }
 
+func (c *metricClient) PauseWorkflowExecution(
+	context context.Context,
+	request *h.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientPauseWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientPauseWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.PauseWorkflowExecution(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientPauseWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) ResumeWorkflowExecution(
+	context context.Context,
+	request *h.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientResumeWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientResumeWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.ResumeWorkflowExecution(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientResumeWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) RetryActivityTask(
+	context context.Context,
+	request *h.RetryActivityTaskRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientRetryActivityTaskScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientRetryActivityTaskScope, metrics.CadenceClientLatency)
+	err := c.client.RetryActivityTask(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientRetryActivityTaskScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) CompleteActivityTask(
+	context context.Context,
+	request *h.CompleteActivityTaskRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientCompleteActivityTaskScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientCompleteActivityTaskScope, metrics.CadenceClientLatency)
+	err := c.client.CompleteActivityTask(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientCompleteActivityTaskScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) FailActivityTask(
+	context context.Context,
+	request *h.FailActivityTaskRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientFailActivityTaskScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientFailActivityTaskScope, metrics.CadenceClientLatency)
+	err := c.client.FailActivityTask(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientFailActivityTaskScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
 func (c *metricClient) ScheduleDecisionTask(
 	context context.Context,
 	request *h.ScheduleDecisionTaskRequest,
@@ -435,3 +572,173 @@ func (c *metricClient) SyncActivity(
 
 	return err
 }
+
+func (c *metricClient) GetReplicationMessages(
+	context context.Context,
+	request *replicator.GetReplicationMessagesRequest,
+	opts ...yarpc.CallOption) (*replicator.GetReplicationMessagesResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientGetReplicationMessagesScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientGetReplicationMessagesScope, metrics.CadenceClientLatency)
+	resp, err := c.client.GetReplicationMessages(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientGetReplicationMessagesScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) GetReplicationStatus(
+	context context.Context,
+	request *h.GetReplicationStatusRequest,
+	opts ...yarpc.CallOption) (*h.GetReplicationStatusResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientGetReplicationStatusScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientGetReplicationStatusScope, metrics.CadenceClientLatency)
+	resp, err := c.client.GetReplicationStatus(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientGetReplicationStatusScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) DescribeShard(
+	context context.Context,
+	request *h.DescribeShardRequest,
+	opts ...yarpc.CallOption) (*h.DescribeShardResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientDescribeShardScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDescribeShardScope, metrics.CadenceClientLatency)
+	resp, err := c.client.DescribeShard(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDescribeShardScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) ListShardTasks(
+	context context.Context,
+	request *h.ListShardTasksRequest,
+	opts ...yarpc.CallOption) (*h.ListShardTasksResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientListShardTasksScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientListShardTasksScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ListShardTasks(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientListShardTasksScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) RemoveShardTask(
+	context context.Context,
+	request *h.RemoveShardTaskRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientRemoveShardTaskScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientRemoveShardTaskScope, metrics.CadenceClientLatency)
+	err := c.client.RemoveShardTask(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientRemoveShardTaskScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) RescheduleShardTask(
+	context context.Context,
+	request *h.RescheduleShardTaskRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientRescheduleShardTaskScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientRescheduleShardTaskScope, metrics.CadenceClientLatency)
+	err := c.client.RescheduleShardTask(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientRescheduleShardTaskScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) ListQuarantinedTasks(
+	context context.Context,
+	request *h.ListQuarantinedTasksRequest,
+	opts ...yarpc.CallOption) (*h.ListQuarantinedTasksResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientListQuarantinedTasksScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientListQuarantinedTasksScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ListQuarantinedTasks(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientListQuarantinedTasksScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) RetryQuarantinedTask(
+	context context.Context,
+	request *h.RetryQuarantinedTaskRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientRetryQuarantinedTaskScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientRetryQuarantinedTaskScope, metrics.CadenceClientLatency)
+	err := c.client.RetryQuarantinedTask(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientRetryQuarantinedTaskScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) DropQuarantinedTask(
+	context context.Context,
+	request *h.DropQuarantinedTaskRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientDropQuarantinedTaskScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDropQuarantinedTaskScope, metrics.CadenceClientLatency)
+	err := c.client.DropQuarantinedTask(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDropQuarantinedTaskScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) CloseShard(
+	context context.Context,
+	request *h.CloseShardRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientCloseShardScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientCloseShardScope, metrics.CadenceClientLatency)
+	err := c.client.CloseShard(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientCloseShardScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
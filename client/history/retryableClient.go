@@ -24,6 +24,7 @@ import (
 	"context"
 
 	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/backoff"
 	"go.uber.org/yarpc"
@@ -94,6 +95,54 @@ func (c *retryableClient) DescribeMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) MigrateWorkflowHistory(
+	ctx context.Context,
+	request *h.MigrateWorkflowHistoryRequest,
+	opts ...yarpc.CallOption) (*h.MigrateWorkflowHistoryResponse, error) {
+
+	var resp *h.MigrateWorkflowHistoryResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.MigrateWorkflowHistory(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetWorkflowReplicationTasks(
+	ctx context.Context,
+	request *h.GetWorkflowReplicationTasksRequest,
+	opts ...yarpc.CallOption) (*h.GetWorkflowReplicationTasksResponse, error) {
+
+	var resp *h.GetWorkflowReplicationTasksResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetWorkflowReplicationTasks(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *h.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (*h.DeleteWorkflowExecutionResponse, error) {
+
+	var resp *h.DeleteWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DeleteWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetMutableState(
 	ctx context.Context,
 	request *h.GetMutableStateRequest,
@@ -318,6 +367,66 @@ func (c *retryableClient) TerminateWorkflowExecution(
 	return backoff.Retry(op, c.policy, c.isRetryable)
 }
 
+func (c *retryableClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *h.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.PauseWorkflowExecution(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *h.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.ResumeWorkflowExecution(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) RetryActivityTask(
+	ctx context.Context,
+	request *h.RetryActivityTaskRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.RetryActivityTask(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) CompleteActivityTask(
+	ctx context.Context,
+	request *h.CompleteActivityTaskRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.CompleteActivityTask(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) FailActivityTask(
+	ctx context.Context,
+	request *h.FailActivityTaskRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.FailActivityTask(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
 func (c *retryableClient) ScheduleDecisionTask(
 	ctx context.Context,
 	request *h.ScheduleDecisionTaskRequest,
@@ -377,3 +486,143 @@ func (c *retryableClient) SyncActivity(
 
 	return backoff.Retry(op, c.policy, c.isRetryable)
 }
+
+func (c *retryableClient) GetReplicationMessages(
+	ctx context.Context,
+	request *replicator.GetReplicationMessagesRequest,
+	opts ...yarpc.CallOption) (*replicator.GetReplicationMessagesResponse, error) {
+
+	var resp *replicator.GetReplicationMessagesResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetReplicationMessages(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetReplicationStatus(
+	ctx context.Context,
+	request *h.GetReplicationStatusRequest,
+	opts ...yarpc.CallOption) (*h.GetReplicationStatusResponse, error) {
+
+	var resp *h.GetReplicationStatusResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetReplicationStatus(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeShard(
+	ctx context.Context,
+	request *h.DescribeShardRequest,
+	opts ...yarpc.CallOption) (*h.DescribeShardResponse, error) {
+
+	var resp *h.DescribeShardResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeShard(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListShardTasks(
+	ctx context.Context,
+	request *h.ListShardTasksRequest,
+	opts ...yarpc.CallOption) (*h.ListShardTasksResponse, error) {
+
+	var resp *h.ListShardTasksResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListShardTasks(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RemoveShardTask(
+	ctx context.Context,
+	request *h.RemoveShardTaskRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.RemoveShardTask(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) RescheduleShardTask(
+	ctx context.Context,
+	request *h.RescheduleShardTaskRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.RescheduleShardTask(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ListQuarantinedTasks(
+	ctx context.Context,
+	request *h.ListQuarantinedTasksRequest,
+	opts ...yarpc.CallOption) (*h.ListQuarantinedTasksResponse, error) {
+
+	var resp *h.ListQuarantinedTasksResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListQuarantinedTasks(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RetryQuarantinedTask(
+	ctx context.Context,
+	request *h.RetryQuarantinedTaskRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.RetryQuarantinedTask(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) DropQuarantinedTask(
+	ctx context.Context,
+	request *h.DropQuarantinedTaskRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.DropQuarantinedTask(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) CloseShard(
+	ctx context.Context,
+	request *h.CloseShardRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.CloseShard(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
@@ -100,6 +100,7 @@ func (s *server) startService() common.Daemon {
 	params.Name = "cadence-" + s.name
 	params.Logger = s.cfg.Log.NewBarkLogger()
 	params.PersistenceConfig = s.cfg.Persistence
+	params.AuthorizationConfig = s.cfg.Authorization
 
 	params.RingpopFactory, err = s.cfg.Ringpop.NewFactory()
 	if err != nil {
@@ -111,8 +112,9 @@ func (s *server) startService() common.Daemon {
 
 	svcCfg := s.cfg.Services[s.name]
 	params.MetricScope = svcCfg.Metrics.NewScope()
-	params.RPCFactory = svcCfg.RPC.NewFactory(params.Name, params.Logger)
+	params.RPCFactory = svcCfg.RPC.NewFactory(params.Name, s.cfg.Authorization.ServiceToken, params.Logger)
 	params.PProfInitializer = svcCfg.PProf.NewInitializer(params.Logger)
+	params.HTTPConfig = svcCfg.HTTP
 	enableGlobalDomain := dc.GetBoolProperty(dynamicconfig.EnableGlobalDomain, s.cfg.ClustersInfo.EnableGlobalDomain)
 	params.ClusterMetadata = cluster.NewMetadata(
 		enableGlobalDomain,
@@ -120,6 +122,7 @@ func (s *server) startService() common.Daemon {
 		s.cfg.ClustersInfo.MasterClusterName,
 		s.cfg.ClustersInfo.CurrentClusterName,
 		s.cfg.ClustersInfo.ClusterInitialFailoverVersions,
+		s.cfg.ClustersInfo.ReplicationConsumers,
 	)
 	// TODO: We need to switch Cadence to use zap logger, until then just pass zap.NewNop
 	if params.ClusterMetadata.IsGlobalDomainEnabled() {
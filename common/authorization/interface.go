@@ -0,0 +1,79 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common"
+	"go.uber.org/yarpc"
+)
+
+const (
+	// CallerIdentityHeaderName is the yarpc header carrying the identity of the caller
+	CallerIdentityHeaderName = common.CallerIdentityHeaderName
+)
+
+const (
+	// DecisionDeny means the api call is denied
+	DecisionDeny Decision = iota
+	// DecisionAllow means the api call is allowed
+	DecisionAllow
+)
+
+type (
+	// Decision is the result of authorizing an api call
+	Decision int
+
+	// Attributes is the set of properties an api call is authorized on
+	Attributes struct {
+		// Actor is the identity of the caller
+		Actor string
+		// APIName is the name of the api being called
+		APIName string
+		// DomainName is the name of the domain targeted by the api call, empty if the api
+		// does not target a single domain
+		DomainName string
+	}
+
+	// Result is the result of authorizing an api call
+	Result struct {
+		Decision Decision
+	}
+
+	// Authorizer decides whether an api call is allowed
+	Authorizer interface {
+		Authorize(ctx context.Context, attributes *Attributes) (Result, error)
+	}
+)
+
+// GetCallerIdentity returns the identity of the caller carried by the yarpc headers of the inbound call,
+// empty string is returned if the caller did not provide one. The header is asserted by the caller itself,
+// see config.Authorization.TrustCallerIdentityHeader
+func GetCallerIdentity(ctx context.Context) string {
+	return yarpc.CallFromContext(ctx).Header(CallerIdentityHeaderName)
+}
+
+// IsInboundCall returns true if the context is the one of an inbound rpc call, as opposed to
+// a call made by the cadence service itself
+func IsInboundCall(ctx context.Context) bool {
+	return yarpc.CallFromContext(ctx) != nil
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/service/config"
+	"go.uber.org/yarpc"
+)
+
+const (
+	roleNone role = iota
+	roleReader
+	roleWriter
+	roleAdmin
+)
+
+const (
+	// allDomains is the domain name under which a role is granted on all domains
+	allDomains = "*"
+)
+
+type (
+	role int
+
+	rbacAuthorizer struct {
+		// principals maps a caller identity to its roles keyed by domain name
+		principals map[string]map[string]role
+		// serviceToken authenticates the calls made with the service principal
+		serviceToken string
+	}
+)
+
+var _ Authorizer = (*rbacAuthorizer)(nil)
+
+var (
+	roleNames = map[string]role{
+		"reader": roleReader,
+		"writer": roleWriter,
+		"admin":  roleAdmin,
+	}
+
+	// apiRoles is the role required by each api, the apis not listed here require the admin role
+	apiRoles = map[string]role{
+		"DescribeDomain":                   roleReader,
+		"ListDomains":                      roleReader,
+		"GetWorkflowExecutionHistory":      roleReader,
+		"GetWorkflowExecutionRawHistory":   roleReader,
+		"ListOpenWorkflowExecutions":       roleReader,
+		"ListClosedWorkflowExecutions":     roleReader,
+		"QueryWorkflow":                    roleReader,
+		"DescribeWorkflowExecution":        roleReader,
+		"DescribeTaskList":                 roleReader,
+		"PollForActivityTask":              roleWriter,
+		"PollForDecisionTask":              roleWriter,
+		"RecordActivityTaskHeartbeat":      roleWriter,
+		"RecordActivityTaskHeartbeatByID":  roleWriter,
+		"RespondActivityTaskCompleted":     roleWriter,
+		"RespondActivityTaskCompletedByID": roleWriter,
+		"RespondActivityTaskFailed":        roleWriter,
+		"RespondActivityTaskFailedByID":    roleWriter,
+		"RespondActivityTaskCanceled":      roleWriter,
+		"RespondActivityTaskCanceledByID":  roleWriter,
+		"RespondDecisionTaskCompleted":     roleWriter,
+		"RespondDecisionTaskFailed":        roleWriter,
+		"RespondQueryTaskCompleted":        roleWriter,
+		"StartWorkflowExecution":           roleWriter,
+		"SignalWorkflowExecution":          roleWriter,
+		"SignalWithStartWorkflowExecution": roleWriter,
+		"TerminateWorkflowExecution":       roleWriter,
+		"PauseWorkflowExecution":           roleWriter,
+		"ResumeWorkflowExecution":          roleWriter,
+		"RequestCancelWorkflowExecution":   roleWriter,
+		"ResetStickyTaskList":              roleWriter,
+	}
+)
+
+// NewAuthorizer creates the authorizer described by the config, all api calls are allowed
+// when no authorizer is configured
+func NewAuthorizer(cfg *config.Authorization) (Authorizer, error) {
+	if cfg.RBAC == nil {
+		return NewNopAuthorizer(), nil
+	}
+	if !cfg.TrustCallerIdentityHeader {
+		return nil, errors.New("rbac authorization requires trustCallerIdentityHeader, the caller identity header is not authenticated")
+	}
+	if cfg.ServiceToken == "" {
+		return nil, errors.New("rbac authorization requires serviceToken to authenticate the calls made by the cadence services")
+	}
+	return NewRBACAuthorizer(cfg.RBAC, cfg.ServiceToken)
+}
+
+// NewRBACAuthorizer creates an authorizer granting the api calls based on the per domain
+// roles of the caller. The service principal is granted all the apis when the call carries
+// the service token, and cannot be granted roles in the config.
+func NewRBACAuthorizer(cfg *config.RBAC, serviceToken string) (Authorizer, error) {
+	principals := make(map[string]map[string]role, len(cfg.Principals))
+	for principal, domainRoles := range cfg.Principals {
+		if principal == common.ServicePrincipal {
+			return nil, fmt.Errorf("principal %v is reserved for the calls made by the cadence services", principal)
+		}
+		roles := make(map[string]role, len(domainRoles))
+		for domain, roleName := range domainRoles {
+			r, ok := roleNames[roleName]
+			if !ok {
+				return nil, fmt.Errorf("unknown role %v granted to principal %v on domain %v", roleName, principal, domain)
+			}
+			roles[domain] = r
+		}
+		principals[principal] = roles
+	}
+	return &rbacAuthorizer{principals: principals, serviceToken: serviceToken}, nil
+}
+
+// Authorize allows the api call if the caller has been granted the role required by the api
+// either on the target domain or on all domains
+func (a *rbacAuthorizer) Authorize(ctx context.Context, attributes *Attributes) (Result, error) {
+	if attributes.Actor == common.ServicePrincipal {
+		if a.isServiceCall(ctx) {
+			return Result{Decision: DecisionAllow}, nil
+		}
+		return Result{Decision: DecisionDeny}, nil
+	}
+
+	roles, ok := a.principals[attributes.Actor]
+	if !ok {
+		return Result{Decision: DecisionDeny}, nil
+	}
+
+	granted := roles[allDomains]
+	if attributes.DomainName != "" {
+		if r, ok := roles[attributes.DomainName]; ok && r > granted {
+			granted = r
+		}
+	}
+
+	if granted >= getRequiredRole(attributes.APIName) {
+		return Result{Decision: DecisionAllow}, nil
+	}
+	return Result{Decision: DecisionDeny}, nil
+}
+
+// isServiceCall returns whether the call carries the service token
+func (a *rbacAuthorizer) isServiceCall(ctx context.Context) bool {
+	token := yarpc.CallFromContext(ctx).Header(common.ServiceTokenHeaderName)
+	return a.serviceToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(a.serviceToken)) == 1
+}
+
+func getRequiredRole(apiName string) role {
+	if r, ok := apiRoles[apiName]; ok {
+		return r
+	}
+	return roleAdmin
+}
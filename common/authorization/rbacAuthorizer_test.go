@@ -0,0 +1,189 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/service/config"
+	"go.uber.org/yarpc/api/encoding"
+	"go.uber.org/yarpc/api/transport"
+)
+
+type (
+	rbacAuthorizerSuite struct {
+		suite.Suite
+		authorizer Authorizer
+	}
+)
+
+func TestRBACAuthorizerSuite(t *testing.T) {
+	s := new(rbacAuthorizerSuite)
+	suite.Run(t, s)
+}
+
+func (s *rbacAuthorizerSuite) SetupTest() {
+	authorizer, err := NewAuthorizer(&config.Authorization{
+		RBAC: &config.RBAC{
+			Principals: map[string]map[string]string{
+				"worker": {
+					"samples": "writer",
+				},
+				"viewer": {
+					"*": "reader",
+				},
+				"operator": {
+					"*":       "reader",
+					"samples": "admin",
+				},
+				"superuser": {
+					"*": "admin",
+				},
+			},
+		},
+		TrustCallerIdentityHeader: true,
+		ServiceToken:              "secret",
+	})
+	s.NoError(err)
+	s.authorizer = authorizer
+}
+
+func (s *rbacAuthorizerSuite) TestNopAuthorizer() {
+	authorizer, err := NewAuthorizer(&config.Authorization{})
+	s.NoError(err)
+	s.assertDecision(authorizer, DecisionAllow, "", "CloseShard", "")
+}
+
+func (s *rbacAuthorizerSuite) TestUntrustedCallerIdentityHeader() {
+	_, err := NewAuthorizer(&config.Authorization{
+		RBAC: &config.RBAC{
+			Principals: map[string]map[string]string{
+				"worker": {"samples": "writer"},
+			},
+		},
+	})
+	s.Error(err)
+}
+
+func (s *rbacAuthorizerSuite) TestMissingServiceToken() {
+	_, err := NewAuthorizer(&config.Authorization{
+		RBAC: &config.RBAC{
+			Principals: map[string]map[string]string{
+				"worker": {"samples": "writer"},
+			},
+		},
+		TrustCallerIdentityHeader: true,
+	})
+	s.Error(err)
+}
+
+func (s *rbacAuthorizerSuite) TestUnknownRole() {
+	_, err := NewRBACAuthorizer(&config.RBAC{
+		Principals: map[string]map[string]string{
+			"worker": {"samples": "owner"},
+		},
+	}, "secret")
+	s.Error(err)
+}
+
+func (s *rbacAuthorizerSuite) TestReservedServicePrincipal() {
+	_, err := NewRBACAuthorizer(&config.RBAC{
+		Principals: map[string]map[string]string{
+			common.ServicePrincipal: {"*": "admin"},
+		},
+	}, "secret")
+	s.Error(err)
+}
+
+func (s *rbacAuthorizerSuite) TestServicePrincipal() {
+	attributes := &Attributes{
+		Actor:   common.ServicePrincipal,
+		APIName: "CloseShard",
+	}
+	// the service principal asserted by the caller is not authenticated by the token
+	for _, token := range []string{"", "guess"} {
+		result, err := s.authorizer.Authorize(s.newInboundCallContext(token), attributes)
+		s.NoError(err)
+		s.Equal(DecisionDeny, result.Decision)
+	}
+	result, err := s.authorizer.Authorize(context.Background(), attributes)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+
+	result, err = s.authorizer.Authorize(s.newInboundCallContext("secret"), attributes)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+}
+
+func (s *rbacAuthorizerSuite) TestUnknownPrincipal() {
+	s.assertDecision(s.authorizer, DecisionDeny, "", "DescribeWorkflowExecution", "samples")
+	s.assertDecision(s.authorizer, DecisionDeny, "stranger", "DescribeWorkflowExecution", "samples")
+}
+
+func (s *rbacAuthorizerSuite) TestDomainRole() {
+	s.assertDecision(s.authorizer, DecisionAllow, "worker", "DescribeWorkflowExecution", "samples")
+	s.assertDecision(s.authorizer, DecisionAllow, "worker", "StartWorkflowExecution", "samples")
+	s.assertDecision(s.authorizer, DecisionDeny, "worker", "UpdateDomain", "samples")
+	s.assertDecision(s.authorizer, DecisionDeny, "worker", "StartWorkflowExecution", "other")
+	s.assertDecision(s.authorizer, DecisionDeny, "worker", "ListDomains", "")
+}
+
+func (s *rbacAuthorizerSuite) TestAllDomainsRole() {
+	s.assertDecision(s.authorizer, DecisionAllow, "viewer", "DescribeWorkflowExecution", "samples")
+	s.assertDecision(s.authorizer, DecisionAllow, "viewer", "ListDomains", "")
+	s.assertDecision(s.authorizer, DecisionDeny, "viewer", "TerminateWorkflowExecution", "samples")
+
+	s.assertDecision(s.authorizer, DecisionAllow, "operator", "UpdateDomain", "samples")
+	s.assertDecision(s.authorizer, DecisionDeny, "operator", "UpdateDomain", "other")
+	s.assertDecision(s.authorizer, DecisionAllow, "operator", "QueryWorkflow", "other")
+
+	s.assertDecision(s.authorizer, DecisionAllow, "superuser", "TerminateWorkflowExecution", "samples")
+	s.assertDecision(s.authorizer, DecisionAllow, "superuser", "CloseShard", "")
+}
+
+func (s *rbacAuthorizerSuite) TestUnlistedAPIRequiresAdmin() {
+	s.assertDecision(s.authorizer, DecisionDeny, "operator", "CloseShard", "")
+	s.assertDecision(s.authorizer, DecisionDeny, "worker", "SomeNewAPI", "samples")
+	s.assertDecision(s.authorizer, DecisionAllow, "operator", "SomeNewAPI", "samples")
+}
+
+func (s *rbacAuthorizerSuite) newInboundCallContext(serviceToken string) context.Context {
+	headers := transport.NewHeaders().With(CallerIdentityHeaderName, common.ServicePrincipal)
+	if serviceToken != "" {
+		headers = headers.With(common.ServiceTokenHeaderName, serviceToken)
+	}
+	ctx, call := encoding.NewInboundCall(context.Background())
+	s.NoError(call.ReadFromRequest(&transport.Request{Headers: headers}))
+	return ctx
+}
+
+func (s *rbacAuthorizerSuite) assertDecision(authorizer Authorizer, expected Decision, actor, apiName, domainName string) {
+	result, err := authorizer.Authorize(context.Background(), &Attributes{
+		Actor:      actor,
+		APIName:    apiName,
+		DomainName: domainName,
+	})
+	s.NoError(err)
+	s.Equal(expected, result.Decision, "actor: %v, api: %v, domain: %v", actor, apiName, domainName)
+}
@@ -22,6 +22,8 @@ package cache
 
 import (
 	"time"
+
+	"github.com/uber/cadence/common/metrics"
 )
 
 // A Cache is a generalized interface to a cache.  See cache.LRU for a specific
@@ -66,6 +68,23 @@ type Options struct {
 	// RemovedFunc is an optional function called when an element
 	// is scheduled for deletion
 	RemovedFunc RemovedFunc
+
+	// SizeBudget optionally bounds the cache by the estimated size in bytes of its values,
+	// in addition to the max number of entries. The budget can be shared by several caches.
+	// Values which do not implement Sizeable are counted as zero bytes
+	SizeBudget *SizeBudget
+
+	// MetricsClient is optional, the cache reports hits, misses and evictions to MetricsScope if set
+	MetricsClient metrics.Client
+
+	// MetricsScope is the scope the cache metrics are reported to
+	MetricsScope int
+}
+
+// Sizeable is implemented by cache values which can estimate their memory footprint
+type Sizeable interface {
+	// CacheSize returns the estimated size of the value in bytes
+	CacheSize() int64
 }
 
 // RemovedFunc is a type for notifying applications when an item is
@@ -113,6 +113,8 @@ type (
 		isGlobalDomain              bool
 		failoverNotificationVersion int64
 		notificationVersion         int64
+		pendingActiveClusterName    string
+		failoverEndTime             int64
 		expiry                      time.Time
 	}
 )
@@ -149,6 +151,14 @@ func NewDomainCacheEntryWithInfo(info *persistence.DomainInfo) *DomainCacheEntry
 	}
 }
 
+// NewDomainCacheEntryWithInfoAndConfig returns an entry with domainInfo and domainConfig
+func NewDomainCacheEntryWithInfoAndConfig(info *persistence.DomainInfo, config *persistence.DomainConfig) *DomainCacheEntry {
+	return &DomainCacheEntry{
+		info:   info,
+		config: config,
+	}
+}
+
 func (c *domainCache) GetCacheSize() (sizeOfCacheByName int64, sizeOfCacheByID int64) {
 	return int64(c.cacheByID.Size()), int64(c.cacheNameToID.Size())
 }
@@ -389,6 +399,8 @@ func (c *domainCache) updateIDToDomainCache(id string, record *DomainCacheEntry)
 	entry.isGlobalDomain = record.isGlobalDomain
 	entry.failoverNotificationVersion = record.failoverNotificationVersion
 	entry.notificationVersion = record.notificationVersion
+	entry.pendingActiveClusterName = record.pendingActiveClusterName
+	entry.failoverEndTime = record.failoverEndTime
 	entry.expiry = c.timeSource.Now().Add(domainCacheEntryTTL)
 
 	nextDomain := entry.duplicate()
@@ -508,6 +520,8 @@ func (c *domainCache) buildEntryFromRecord(record *persistence.GetDomainResponse
 	newEntry.isGlobalDomain = record.IsGlobalDomain
 	newEntry.failoverNotificationVersion = record.FailoverNotificationVersion
 	newEntry.notificationVersion = record.NotificationVersion
+	newEntry.pendingActiveClusterName = record.PendingActiveClusterName
+	newEntry.failoverEndTime = record.FailoverEndTime
 	return newEntry
 }
 
@@ -527,6 +541,8 @@ func (entry *DomainCacheEntry) duplicate() *DomainCacheEntry {
 	result.isGlobalDomain = entry.isGlobalDomain
 	result.failoverNotificationVersion = entry.failoverNotificationVersion
 	result.notificationVersion = entry.notificationVersion
+	result.pendingActiveClusterName = entry.pendingActiveClusterName
+	result.failoverEndTime = entry.failoverEndTime
 	return result
 }
 
@@ -574,6 +590,16 @@ func (entry *DomainCacheEntry) GetNotificationVersion() int64 {
 	return entry.notificationVersion
 }
 
+// GetPendingActiveClusterName return the target cluster of the graceful failover in progress, empty if there is none
+func (entry *DomainCacheEntry) GetPendingActiveClusterName() string {
+	return entry.pendingActiveClusterName
+}
+
+// GetFailoverEndTime return the time in unix nanoseconds by which the graceful failover in progress is completed
+func (entry *DomainCacheEntry) GetFailoverEndTime() int64 {
+	return entry.failoverEndTime
+}
+
 // IsDomainActive return whether the domain is active, i.e. non global domain or global domain which active cluster is the current cluster
 func (entry *DomainCacheEntry) IsDomainActive() bool {
 	if !entry.isGlobalDomain {
@@ -599,6 +625,19 @@ func (entry *DomainCacheEntry) GetDomainNotActiveErr() error {
 	return errors.NewDomainNotActiveError(entry.info.Name, entry.clusterMetadata.GetCurrentClusterName(), entry.replicationConfig.ActiveClusterName)
 }
 
+// GetDomainPendingFailoverErr return err if the domain is draining for a graceful failover to another cluster, nil otherwise.
+// New external writes are not accepted by the current active cluster until the domain is handed over, the error is
+// retryable as the domain is still active in the current cluster and becomes writable again once the failover completes.
+func (entry *DomainCacheEntry) GetDomainPendingFailoverErr() error {
+	if !entry.isGlobalDomain || entry.pendingActiveClusterName == "" ||
+		entry.pendingActiveClusterName == entry.replicationConfig.ActiveClusterName {
+		return nil
+	}
+	return &workflow.ServiceBusyError{
+		Message: fmt.Sprintf("Domain: %s is failing over, new writes are rejected until the failover completes.", entry.info.Name),
+	}
+}
+
 // Len return length
 func (t DomainCacheEntries) Len() int {
 	return len(t)
@@ -28,6 +28,8 @@ import (
 	"github.com/pborman/uuid"
 	"github.com/uber-go/tally"
 
+	workflow "github.com/uber/cadence/.gen/go/shared"
+
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -570,6 +572,8 @@ func (s *domainCacheSuite) buildEntryFromRecord(record *persistence.GetDomainRes
 	newEntry.isGlobalDomain = record.IsGlobalDomain
 	newEntry.failoverNotificationVersion = record.FailoverNotificationVersion
 	newEntry.notificationVersion = record.NotificationVersion
+	newEntry.pendingActiveClusterName = record.PendingActiveClusterName
+	newEntry.failoverEndTime = record.FailoverEndTime
 	return newEntry
 }
 
@@ -650,3 +654,27 @@ func Test_IsSampledForLongerRetention(t *testing.T) {
 	d.info.Data[SampleRateKey] = "invalid-value"
 	require.False(t, d.IsSampledForLongerRetention(wid))
 }
+
+func Test_GetDomainPendingFailoverErr(t *testing.T) {
+	d := &DomainCacheEntry{
+		clusterMetadata: cluster.GetTestClusterMetadata(true, true),
+		info:            &persistence.DomainInfo{Name: "some random domain name"},
+		replicationConfig: &persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+		},
+		isGlobalDomain: true,
+	}
+	require.Nil(t, d.GetDomainPendingFailoverErr())
+
+	d.pendingActiveClusterName = cluster.TestAlternativeClusterName
+	err := d.GetDomainPendingFailoverErr()
+	require.IsType(t, &workflow.ServiceBusyError{}, err)
+	require.Nil(t, d.GetDomainNotActiveErr())
+
+	d.replicationConfig.ActiveClusterName = cluster.TestAlternativeClusterName
+	require.Nil(t, d.GetDomainPendingFailoverErr())
+
+	d.isGlobalDomain = false
+	d.replicationConfig.ActiveClusterName = cluster.TestCurrentClusterName
+	require.Nil(t, d.GetDomainPendingFailoverErr())
+}
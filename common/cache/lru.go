@@ -25,6 +25,8 @@ import (
 	"errors"
 	"sync"
 	"time"
+
+	"github.com/uber/cadence/common/metrics"
 )
 
 var (
@@ -35,13 +37,17 @@ var (
 // lru is a concurrent fixed size cache that evicts elements in lru order
 type (
 	lru struct {
-		mut      sync.Mutex
-		byAccess *list.List
-		byKey    map[interface{}]*list.Element
-		maxSize  int
-		ttl      time.Duration
-		pin      bool
-		rmFunc   RemovedFunc
+		mut           sync.Mutex
+		byAccess      *list.List
+		byKey         map[interface{}]*list.Element
+		maxSize       int
+		ttl           time.Duration
+		pin           bool
+		rmFunc        RemovedFunc
+		budget        *SizeBudget
+		usedBytes     int64
+		metricsClient metrics.Client
+		metricsScope  int
 	}
 
 	iteratorImpl struct {
@@ -55,6 +61,7 @@ type (
 		createTime time.Time
 		value      interface{}
 		refCount   int
+		size       int64
 	}
 )
 
@@ -132,12 +139,15 @@ func New(maxSize int, opts *Options) Cache {
 	}
 
 	return &lru{
-		byAccess: list.New(),
-		byKey:    make(map[interface{}]*list.Element, opts.InitialCapacity),
-		ttl:      opts.TTL,
-		maxSize:  maxSize,
-		pin:      opts.Pin,
-		rmFunc:   opts.RemovedFunc,
+		byAccess:      list.New(),
+		byKey:         make(map[interface{}]*list.Element, opts.InitialCapacity),
+		ttl:           opts.TTL,
+		maxSize:       maxSize,
+		pin:           opts.Pin,
+		rmFunc:        opts.RemovedFunc,
+		budget:        opts.SizeBudget,
+		metricsClient: opts.MetricsClient,
+		metricsScope:  opts.MetricsScope,
 	}
 }
 
@@ -162,6 +172,7 @@ func (c *lru) Get(key interface{}) interface{} {
 
 	element := c.byKey[key]
 	if element == nil {
+		c.incCounter(metrics.CacheLookupMissCounter)
 		return nil
 	}
 
@@ -170,6 +181,7 @@ func (c *lru) Get(key interface{}) interface{} {
 	if c.isEntryExpired(entry, time.Now()) {
 		// Entry has expired
 		c.deleteInternal(element)
+		c.incCounter(metrics.CacheLookupMissCounter)
 		return nil
 	}
 
@@ -177,6 +189,7 @@ func (c *lru) Get(key interface{}) interface{} {
 		entry.refCount++
 	}
 	c.byAccess.MoveToFront(element)
+	c.incCounter(metrics.CacheLookupHitCounter)
 	return entry.value
 }
 
@@ -223,6 +236,12 @@ func (c *lru) Release(key interface{}) {
 	elt := c.byKey[key]
 	entry := elt.Value.(*entryImpl)
 	entry.refCount--
+
+	if c.budget != nil {
+		// the value may have grown or shrunk while it was in use
+		c.resizeInternal(entry)
+		c.evictForBudget()
+	}
 }
 
 // Size returns the number of entries currently in the lru, useful if cache is not full
@@ -252,6 +271,10 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 				if c.ttl != 0 {
 					entry.createTime = time.Now()
 				}
+				if c.budget != nil {
+					c.resizeInternal(entry)
+					c.evictForBudget()
+				}
 			}
 
 			c.byAccess.MoveToFront(elt)
@@ -267,6 +290,13 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 		value: value,
 	}
 
+	if c.budget != nil {
+		if sizeable, ok := value.(Sizeable); ok && c.budget.isExceeded(sizeable.CacheSize()) {
+			// the new entry alone exceeds the budget
+			return nil, ErrCacheFull
+		}
+	}
+
 	if c.pin {
 		entry.refCount++
 	}
@@ -286,7 +316,23 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 			return nil, ErrCacheFull
 		}
 
-		c.deleteInternal(c.byAccess.Back())
+		c.evictInternal(c.byAccess.Back())
+	}
+
+	if c.budget != nil {
+		c.resizeInternal(entry)
+		c.evictForBudget()
+		elt, ok := c.byKey[key]
+		if !ok {
+			// the new entry was evicted right away as the budget is used up by other caches
+			return nil, ErrCacheFull
+		}
+		if c.budget.isExceeded(c.usedBytes) {
+			// the entries of this cache which are in use exceed the whole budget
+			// revert the insert and return
+			c.deleteInternal(elt)
+			return nil, ErrCacheFull
+		}
 	}
 
 	return nil, nil
@@ -298,6 +344,47 @@ func (c *lru) deleteInternal(element *list.Element) {
 		go c.rmFunc(entry.value)
 	}
 	delete(c.byKey, entry.key)
+	if c.budget != nil {
+		c.usedBytes -= entry.size
+		c.budget.update(-entry.size)
+	}
+}
+
+func (c *lru) evictInternal(element *list.Element) {
+	c.deleteInternal(element)
+	c.incCounter(metrics.CacheEvictionCounter)
+}
+
+// evictForBudget evicts the least recently used entries which are not in use until the size budget is met
+func (c *lru) evictForBudget() {
+	element := c.byAccess.Back()
+	for element != nil && c.budget.isExceeded(c.budget.UsedBytes()) {
+		prev := element.Prev()
+		if element.Value.(*entryImpl).refCount == 0 {
+			c.evictInternal(element)
+		}
+		element = prev
+	}
+	if c.metricsClient != nil {
+		c.metricsClient.UpdateGauge(c.metricsScope, metrics.CacheSizeBytesGauge, float64(c.budget.UsedBytes()))
+	}
+}
+
+// resizeInternal updates the size of an entry and of the budget to the current size of the entry value
+func (c *lru) resizeInternal(entry *entryImpl) {
+	var size int64
+	if sizeable, ok := entry.value.(Sizeable); ok {
+		size = sizeable.CacheSize()
+	}
+	c.usedBytes += size - entry.size
+	c.budget.update(size - entry.size)
+	entry.size = size
+}
+
+func (c *lru) incCounter(counter int) {
+	if c.metricsClient != nil {
+		c.metricsClient.IncCounter(c.metricsScope, counter)
+	}
 }
 
 func (c *lru) isEntryExpired(entry *entryImpl, currentTime time.Time) bool {
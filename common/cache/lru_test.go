@@ -26,6 +26,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 type keyType struct {
@@ -33,6 +35,14 @@ type keyType struct {
 	dummyInt    int
 }
 
+type sizeableValue struct {
+	size int64
+}
+
+func (v *sizeableValue) CacheSize() int64 {
+	return v.size
+}
+
 func TestLRU(t *testing.T) {
 	cache := NewLRU(5)
 
@@ -263,3 +273,103 @@ func TestIterator(t *testing.T) {
 	it.Close()
 	assert.Equal(t, expected, actual)
 }
+
+func TestLRUWithSizeBudget(t *testing.T) {
+	budget := NewSizeBudget(dynamicconfig.GetIntPropertyFn(100))
+	cache := New(10, &Options{
+		SizeBudget: budget,
+	})
+
+	cache.Put("A", &sizeableValue{size: 40})
+	cache.Put("B", &sizeableValue{size: 40})
+	assert.Equal(t, int64(80), budget.UsedBytes())
+
+	// A is the least recently used entry
+	cache.Put("C", &sizeableValue{size: 40})
+	assert.Nil(t, cache.Get("A"))
+	assert.NotNil(t, cache.Get("B"))
+	assert.NotNil(t, cache.Get("C"))
+	assert.Equal(t, int64(80), budget.UsedBytes())
+
+	// an entry bigger than the whole budget is not admitted
+	_, err := cache.PutIfNotExist("D", &sizeableValue{size: 200})
+	assert.Equal(t, ErrCacheFull, err)
+	assert.Nil(t, cache.Get("D"))
+	assert.Equal(t, 2, cache.Size())
+	assert.Equal(t, int64(80), budget.UsedBytes())
+
+	cache.Put("E", "not sizeable")
+	assert.Equal(t, 3, cache.Size())
+	assert.Equal(t, int64(80), budget.UsedBytes())
+	cache.Delete("B")
+	cache.Delete("C")
+	assert.Equal(t, 1, cache.Size())
+	assert.Equal(t, int64(0), budget.UsedBytes())
+}
+
+func TestLRUWithSizeBudget_Pin(t *testing.T) {
+	budget := NewSizeBudget(dynamicconfig.GetIntPropertyFn(100))
+	cache := New(10, &Options{
+		Pin:        true,
+		SizeBudget: budget,
+	})
+
+	a := &sizeableValue{}
+	_, err := cache.PutIfNotExist("A", a)
+	assert.NoError(t, err)
+	// the value grows while in use, the size is picked up on release
+	a.size = 60
+	assert.Equal(t, int64(0), budget.UsedBytes())
+	cache.Release("A")
+	assert.Equal(t, int64(60), budget.UsedBytes())
+
+	b := &sizeableValue{}
+	_, err = cache.PutIfNotExist("B", b)
+	assert.NoError(t, err)
+	b.size = 60
+	cache.Release("B")
+	// A is evicted since it is not in use
+	assert.Nil(t, cache.Get("A"))
+	assert.Equal(t, int64(60), budget.UsedBytes())
+
+	// entries in use are not evicted, and new entries are refused once they exceed the budget
+	assert.Equal(t, b, cache.Get("B"))
+	_, err = cache.PutIfNotExist("C", &sizeableValue{size: 50})
+	assert.Equal(t, ErrCacheFull, err)
+	assert.Equal(t, int64(60), budget.UsedBytes())
+	cache.Release("B")
+	_, err = cache.PutIfNotExist("C", &sizeableValue{size: 50})
+	assert.NoError(t, err)
+	assert.Nil(t, cache.Get("B"))
+	assert.Equal(t, int64(50), budget.UsedBytes())
+}
+
+func TestLRUSharedSizeBudget(t *testing.T) {
+	maxBytes := 100
+	budget := NewSizeBudget(func(opts ...dynamicconfig.FilterOption) int {
+		return maxBytes
+	})
+	cache1 := New(10, &Options{
+		SizeBudget: budget,
+	})
+	cache2 := New(10, &Options{
+		SizeBudget: budget,
+	})
+
+	cache1.Put("A", &sizeableValue{size: 60})
+	cache2.Put("B", &sizeableValue{size: 30})
+	assert.Equal(t, int64(90), budget.UsedBytes())
+
+	// each cache evicts its own entries
+	cache2.Put("C", &sizeableValue{size: 30})
+	assert.NotNil(t, cache1.Get("A"))
+	assert.Nil(t, cache2.Get("B"))
+	assert.NotNil(t, cache2.Get("C"))
+	assert.Equal(t, int64(90), budget.UsedBytes())
+
+	// an unbounded budget never evicts
+	maxBytes = 0
+	cache1.Put("D", &sizeableValue{size: 1000})
+	assert.Equal(t, 2, cache1.Size())
+	assert.Equal(t, int64(1090), budget.UsedBytes())
+}
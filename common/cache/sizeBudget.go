@@ -0,0 +1,63 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cache
+
+import (
+	"sync/atomic"
+
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+// SizeBudget is a memory budget in bytes which can be shared by several caches. While the budget is
+// exceeded, a cache evicts its least recently used entries which are not pinned, and refuses new
+// entries once its own pinned entries alone exceed the budget.
+// The budget is soft: a cache only evicts its own entries, and the sizes are estimates reported by
+// the entries, so the used bytes can exceed the budget until the other caches are accessed
+type SizeBudget struct {
+	maxBytes  dynamicconfig.IntPropertyFn
+	usedBytes int64
+}
+
+// NewSizeBudget creates a new budget, the budget is unbounded while maxBytes returns 0
+func NewSizeBudget(maxBytes dynamicconfig.IntPropertyFn) *SizeBudget {
+	return &SizeBudget{
+		maxBytes: maxBytes,
+	}
+}
+
+// UsedBytes returns the estimated number of bytes used by the caches sharing the budget
+func (b *SizeBudget) UsedBytes() int64 {
+	return atomic.LoadInt64(&b.usedBytes)
+}
+
+// MaxBytes returns the number of bytes of the budget, 0 means unbounded
+func (b *SizeBudget) MaxBytes() int64 {
+	return int64(b.maxBytes())
+}
+
+func (b *SizeBudget) update(delta int64) {
+	atomic.AddInt64(&b.usedBytes, delta)
+}
+
+func (b *SizeBudget) isExceeded(usedBytes int64) bool {
+	maxBytes := b.MaxBytes()
+	return maxBytes > 0 && usedBytes > maxBytes
+}
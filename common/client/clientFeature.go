@@ -39,6 +39,7 @@ type (
 	// Feature provides information about client's capibility
 	Feature interface {
 		SupportStickyQuery() bool
+		SupportRawHistory() bool
 	}
 
 	// FeatureImpl is used for determining the client's capibility.
@@ -74,6 +75,12 @@ func (feature *FeatureImpl) SupportStickyQuery() bool {
 	return feature.featureVersion.major > 0
 }
 
+// SupportRawHistory whether a client support the raw history in poll for decision task response
+func (feature *FeatureImpl) SupportRawHistory() bool {
+	return feature.featureVersion.major > 1 ||
+		(feature.featureVersion.major == 1 && feature.featureVersion.minor > 0)
+}
+
 func parseVersion(versionStr string) version {
 	var major int64
 	var minor int64
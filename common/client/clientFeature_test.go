@@ -55,3 +55,18 @@ func (s *FeatureSuite) TestSupportStickyQuery() {
 	feature = NewFeatureImpl(libVersion, featureVersion, lang)
 	s.False(feature.SupportStickyQuery(), "Should not support sticky query")
 }
+
+func (s *FeatureSuite) TestSupportRawHistory() {
+	lang := "go"
+	feature := NewFeatureImpl("0.5.0", "1.1.0", lang)
+	s.True(feature.SupportRawHistory(), "Should support raw history")
+
+	feature = NewFeatureImpl("0.5.0", "2.0.0", lang)
+	s.True(feature.SupportRawHistory(), "Should support raw history")
+
+	feature = NewFeatureImpl("0.5.0", "1.0.0", lang)
+	s.False(feature.SupportRawHistory(), "Should not support raw history")
+
+	feature = NewFeatureImpl("", "", "")
+	s.False(feature.SupportRawHistory(), "Should not support raw history")
+}
@@ -23,6 +23,7 @@ package cluster
 import (
 	"fmt"
 
+	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
@@ -46,6 +47,8 @@ type (
 		GetAllClusterFailoverVersions() map[string]int64
 		// ClusterNameForFailoverVersion return the corresponding cluster name for a given failover version
 		ClusterNameForFailoverVersion(failoverVersion int64) string
+		// GetReplicationConsumerConfig return how the replication tasks generated by the given cluster are consumed
+		GetReplicationConsumerConfig(clusterName string) *config.ReplicationConsumerConfig
 	}
 
 	metadataImpl struct {
@@ -63,12 +66,15 @@ type (
 		clusterInitialFailoverVersions map[string]int64
 		// clusterInitialFailoverVersions contains all initial failover version -> corresponding cluster name
 		initialFailoverVersionClusters map[int64]string
+		// replicationConsumers contains cluster name -> how the replication tasks generated by that cluster are consumed
+		replicationConsumers map[string]config.ReplicationConsumerConfig
 	}
 )
 
 // NewMetadata create a new instance of Metadata
 func NewMetadata(enableGlobalDomain dynamicconfig.BoolPropertyFn, failoverVersionIncrement int64,
-	masterClusterName string, currentClusterName string, clusterInitialFailoverVersions map[string]int64) Metadata {
+	masterClusterName string, currentClusterName string, clusterInitialFailoverVersions map[string]int64,
+	replicationConsumers map[string]config.ReplicationConsumerConfig) Metadata {
 
 	if len(clusterInitialFailoverVersions) < 0 {
 		panic("Empty initial failover versions for cluster")
@@ -101,6 +107,20 @@ func NewMetadata(enableGlobalDomain dynamicconfig.BoolPropertyFn, failoverVersio
 	if len(initialFailoverVersionClusters) != len(clusterInitialFailoverVersions) {
 		panic("Cluster to initial failover versions have duplicate initial versions")
 	}
+	for clusterName, consumer := range replicationConsumers {
+		if _, ok := clusterInitialFailoverVersions[clusterName]; !ok {
+			panic(fmt.Sprintf("Replication consumer cluster %v is not specified in all cluster names", clusterName))
+		}
+		switch consumer.Type {
+		case "", config.ReplicationConsumerTypeKafka:
+		case config.ReplicationConsumerTypeRPC:
+			if clusterName != currentClusterName && len(consumer.RPCAddress) == 0 {
+				panic(fmt.Sprintf("Replication consumer of cluster %v is rpc but rpc address is empty", clusterName))
+			}
+		default:
+			panic(fmt.Sprintf("Unknown replication consumer type %v for cluster %v", consumer.Type, clusterName))
+		}
+	}
 
 	return &metadataImpl{
 		enableGlobalDomain:             enableGlobalDomain,
@@ -109,6 +129,7 @@ func NewMetadata(enableGlobalDomain dynamicconfig.BoolPropertyFn, failoverVersio
 		currentClusterName:             currentClusterName,
 		clusterInitialFailoverVersions: clusterInitialFailoverVersions,
 		initialFailoverVersionClusters: initialFailoverVersionClusters,
+		replicationConsumers:           replicationConsumers,
 	}
 }
 
@@ -173,3 +194,13 @@ func (metadata *metadataImpl) ClusterNameForFailoverVersion(failoverVersion int6
 	}
 	return clusterName
 }
+
+// GetReplicationConsumerConfig return how the replication tasks generated by the given cluster are consumed,
+// clusters without replication consumer config are replicated through kafka
+func (metadata *metadataImpl) GetReplicationConsumerConfig(clusterName string) *config.ReplicationConsumerConfig {
+	consumer := metadata.replicationConsumers[clusterName]
+	if len(consumer.Type) == 0 {
+		consumer.Type = config.ReplicationConsumerTypeKafka
+	}
+	return &consumer
+}
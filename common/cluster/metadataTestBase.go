@@ -57,5 +57,6 @@ func GetTestClusterMetadata(enableGlobalDomain bool, isMasterCluster bool) Metad
 		masterClusterName,
 		TestCurrentClusterName,
 		TestAllClusterFailoverVersions,
+		nil,
 	)
 }
@@ -48,12 +48,21 @@ const (
 	WorkerServiceName = "cadence-worker"
 )
 
+const (
+	// SystemDomainName is the domain of the cadence system workflows
+	SystemDomainName = "cadence-system"
+	// SystemTaskListName is the task list the cadence system workflows are polled from
+	SystemTaskListName = "system-task-list"
+)
+
 // Data encoding types
 const (
-	EncodingTypeJSON     EncodingType = "json"
-	EncodingTypeThriftRW              = "thriftrw"
-	EncodingTypeGob                   = "gob"
-	EncodingTypeUnknown               = "unknow"
+	EncodingTypeJSON           EncodingType = "json"
+	EncodingTypeThriftRW                    = "thriftrw"
+	EncodingTypeThriftRWSnappy              = "thriftrw-snappy"
+	EncodingTypeThriftRWGzip                = "thriftrw-gzip"
+	EncodingTypeGob                         = "gob"
+	EncodingTypeUnknown                     = "unknow"
 )
 
 // NoRetryBackoff is used to represent backoff when no retry is needed
@@ -0,0 +1,69 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package common
+
+const (
+	// DomainDeletionWorkflowTypeName is the workflow type of the system workflow cleaning up a deleted domain
+	DomainDeletionWorkflowTypeName = "cadence-sys-domain-deletion-workflow"
+	// DomainDeletionProgressQueryType is the query type returning the DomainDeletionProgress of a deletion workflow
+	DomainDeletionProgressQueryType = "progress"
+
+	domainDeletionWorkflowIDPrefix = "cadence-sys-domain-deletion-"
+)
+
+// Stages of the domain deletion workflow, in the order they are run
+const (
+	// DomainDeletionStageExecutions deletes the closed executions of the domain, with their histories and task lists
+	DomainDeletionStageExecutions = "executions"
+	// DomainDeletionStageVisibility deletes the visibility records of the domain
+	DomainDeletionStageVisibility = "visibility"
+	// DomainDeletionStageMetadata deletes the domain metadata, which frees the domain name
+	DomainDeletionStageMetadata = "metadata"
+	// DomainDeletionStageCompleted is reported once the domain is gone
+	DomainDeletionStageCompleted = "completed"
+)
+
+type (
+	// DomainDeletionParams is the input of the domain deletion workflow
+	DomainDeletionParams struct {
+		DomainID   string
+		DomainName string
+		// LatestStartTime, NextPageToken and Progress are carried over when the workflow continues as new,
+		// the start time range of the listed executions is fixed so the page token stays valid
+		LatestStartTime int64
+		NextPageToken   []byte
+		Progress        *DomainDeletionProgress
+	}
+
+	// DomainDeletionProgress is the progress of a domain deletion workflow, returned by the progress query
+	DomainDeletionProgress struct {
+		Stage             string
+		ExecutionsDeleted int64
+		TaskListsDeleted  int64
+		// LastError is the error of the last failed attempt of the current stage, the stage is retried until it succeeds
+		LastError string
+	}
+)
+
+// DomainDeletionWorkflowID returns the ID of the system workflow deleting the given domain
+func DomainDeletionWorkflowID(domainName string) string {
+	return domainDeletionWorkflowIDPrefix + domainName
+}
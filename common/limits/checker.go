@@ -0,0 +1,74 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package limits
+
+import (
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	// Checker checks the sizes and counts of a workflow execution against the warn and error limits of its domain.
+	// Crossing a warn limit is only logged and counted, crossing an error limit is reported to the caller.
+	Checker struct {
+		domainName    string
+		metricsClient metrics.Client
+		scope         int
+		logger        bark.Logger
+	}
+)
+
+// NewChecker creates a limit checker, the logger is expected to be tagged with the workflow execution
+func NewChecker(domainName string, metricsClient metrics.Client, scope int, logger bark.Logger) *Checker {
+	return &Checker{
+		domainName:    domainName,
+		metricsClient: metricsClient,
+		scope:         scope,
+		logger:        logger,
+	}
+}
+
+// CheckLimit returns true if the value is over the error limit
+func (c *Checker) CheckLimit(limitType string, value int, warnLimit int, errorLimit int) bool {
+	if value <= warnLimit && value <= errorLimit {
+		return false
+	}
+
+	metricsClient := c.metricsClient.Tagged(map[string]string{
+		metrics.DomainTagName:    c.domainName,
+		metrics.LimitTypeTagName: limitType,
+	})
+	logger := c.logger.WithFields(bark.Fields{
+		logging.TagLimitType: limitType,
+		logging.TagSize:      value,
+	})
+
+	if value > errorLimit {
+		metricsClient.IncCounter(c.scope, metrics.LimitErrorCounter)
+		logger.WithField(logging.TagLimit, errorLimit).Error("Workflow execution exceeds error limit.")
+		return true
+	}
+
+	metricsClient.IncCounter(c.scope, metrics.LimitWarnCounter)
+	logger.WithField(logging.TagLimit, warnLimit).Warn("Workflow execution exceeds warn limit.")
+	return false
+}
@@ -36,6 +36,7 @@ const (
 	TagHistoryBuilderAction       = "history-builder-action"
 	TagStoreOperation             = "store-operation"
 	TagDomainID                   = "domain-id"
+	TagDomainName                 = "domain-name"
 	TagWorkflowExecutionID        = "execution-id"
 	TagWorkflowRunID              = "run-id"
 	TagHistoryShardID             = "shard-id"
@@ -49,6 +50,8 @@ const (
 	TagTaskType                   = "task-type"
 	TagSourceCluster              = "source-cluster"
 	TagPrevActiveCluster          = "prev-active-cluster"
+	TagPendingActiveCluster       = "pending-active-cluster"
+	TagRemoteCluster              = "remote-cluster"
 	TagTopicName                  = "topic-name"
 	TagConsumerName               = "consumer-name"
 	TagPartition                  = "partition"
@@ -72,6 +75,8 @@ const (
 	TagAttemptStart               = "attempt-start"
 	TagAttemptEnd                 = "attempt-end"
 	TagSize                       = "size"
+	TagLimit                      = "limit"
+	TagLimitType                  = "limit-type"
 
 	// workflow logging tag values
 	// TagWorkflowComponent Values
@@ -86,6 +91,10 @@ const (
 	TagValueReplicatorComponent               = "replicator"
 	TagValueReplicationTaskProcessorComponent = "replication-task-processor"
 	TagValueHistoryReplicatorComponent        = "history-replicator"
+	TagValueDomainFailoverWatcherComponent    = "domain-failover-watcher"
+	TagValueConsistencyCheckerComponent       = "consistency-checker"
+	TagValueDomainDeletionComponent           = "domain-deletion"
+	TagValueHTTPGatewayComponent              = "http-gateway"
 
 	// TagHistoryBuilderAction values
 	TagValueActionWorkflowStarted                 = "add-workflowexecution-started-event"
@@ -102,6 +111,7 @@ const (
 	TagValueActionActivityTaskCanceled            = "add-activitytask-canceled-event"
 	TagValueActionActivityTaskCancelRequest       = "add-activitytask-cancel-request-event"
 	TagValueActionActivityTaskCancelRequestFailed = "add-activitytask-cancel-request-failed-event"
+	TagValueActionActivityTaskRetryRequested      = "add-activitytask-retry-requested-event"
 	TagValueActionCompleteWorkflow                = "add-complete-workflow-event"
 	TagValueActionFailWorkflow                    = "add-fail-workflow-event"
 	TagValueActionTimeoutWorkflow                 = "add-timeout-workflow-event"
@@ -111,6 +121,8 @@ const (
 	TagValueActionTimerCanceled                   = "add-timer-Canceled-event"
 	TagValueActionWorkflowTerminated              = "add-workflowexecution-terminated-event"
 	TagValueActionWorkflowSignaled                = "add-workflowexecution-signaled-event"
+	TagValueActionWorkflowPaused                  = "add-workflowexecution-paused-event"
+	TagValueActionWorkflowResumed                 = "add-workflowexecution-resumed-event"
 	TagValueActionContinueAsNew                   = "add-continue-as-new-event"
 	TagValueActionWorkflowCanceled                = "add-workflowexecution-canceled-event"
 	TagValueActionChildExecutionStarted           = "add-childexecution-started-event"
@@ -135,6 +135,17 @@ func (r *ringpopServiceResolver) Lookup(key string) (*HostInfo, error) {
 	return NewHostInfo(addr, r.getLabelsMap()), nil
 }
 
+// Members returns all the hosts which are currently part of the ring
+func (r *ringpopServiceResolver) Members() []*HostInfo {
+	r.ringLock.RLock()
+	defer r.ringLock.RUnlock()
+	var members []*HostInfo
+	for _, addr := range r.ring.Servers() {
+		members = append(members, NewHostInfo(addr, r.getLabelsMap()))
+	}
+	return members
+}
+
 func (r *ringpopServiceResolver) AddListener(name string, notifyChannel chan<- *ChangedEvent) error {
 	r.listenerLock.Lock()
 	defer r.listenerLock.Unlock()
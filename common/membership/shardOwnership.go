@@ -0,0 +1,200 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	// ShardOwnership resolves the history host owning a shard. By default a shard is owned by the host the
+	// membership ring maps it to. Shards can be pinned to specific hosts by the shard ownership overrides,
+	// and drained hosts hand their shards over to the other hosts of the ring. It is shared by the history
+	// hosts acquiring shards and the history clients routing requests to them.
+	ShardOwnership struct {
+		resolver     ServiceResolver
+		drainedHosts dynamicconfig.StringPropertyFn
+		overrides    dynamicconfig.StringPropertyFn
+		logger       bark.Logger
+		// config caches the parsed drained hosts and overrides, it is parsed again when they change
+		config atomic.Value
+	}
+
+	shardOwnershipConfig struct {
+		drainedHostsValue string
+		overridesValue    string
+		drainedHosts      map[string]struct{}
+		overrides         map[int]string
+	}
+)
+
+// NewShardOwnership creates a shard ownership resolver, drainedHosts is a comma separated list of
+// host:port addresses and overrides is a comma separated list of shardID=host:port pairs
+func NewShardOwnership(resolver ServiceResolver, drainedHosts dynamicconfig.StringPropertyFn,
+	overrides dynamicconfig.StringPropertyFn, logger bark.Logger) *ShardOwnership {
+	return &ShardOwnership{
+		resolver:     resolver,
+		drainedHosts: drainedHosts,
+		overrides:    overrides,
+		logger:       logger,
+	}
+}
+
+// ShardKey returns the key the membership ring maps a shard with
+func ShardKey(shardID int) string {
+	return string(rune(shardID))
+}
+
+// Lookup returns the owner of the given shard. drainedHost is set to the ring owner of the shard
+// when the shard was handed over to another host because its ring owner is drained.
+func (o *ShardOwnership) Lookup(shardID int) (owner *HostInfo, drainedHost string, err error) {
+	config := o.getConfig()
+	if pinned, ok := config.overrides[shardID]; ok && !config.isDrained(pinned) {
+		for _, member := range o.resolver.Members() {
+			if member.Identity() == pinned {
+				return member, "", nil
+			}
+		}
+	}
+
+	ringOwner, err := o.resolver.Lookup(ShardKey(shardID))
+	if err != nil {
+		return nil, "", err
+	}
+	if !config.isDrained(ringOwner.Identity()) {
+		return ringOwner, "", nil
+	}
+
+	members := o.resolver.Members()
+	candidates := config.undrainedMembers(members)
+	if len(candidates) == 0 {
+		// every host is drained, nobody to hand the shard over to
+		return ringOwner, "", nil
+	}
+	// look up the shard with different keys so the shards of a drained host are spread
+	// over the ring, falling back to a deterministic pick if all lookups hit drained hosts
+	for i := 1; i <= len(members); i++ {
+		candidate, err := o.resolver.Lookup(ShardKey(shardID) + "_" + strconv.Itoa(i))
+		if err != nil {
+			return nil, "", err
+		}
+		if !config.isDrained(candidate.Identity()) {
+			return candidate, ringOwner.Identity(), nil
+		}
+	}
+	return candidates[shardID%len(candidates)], ringOwner.Identity(), nil
+}
+
+// Route returns the address of the host to send the requests of the given shard to. A drained host
+// keeps its shards until their new owners take them over a few at a time, so the requests are sent
+// to the drained host, which redirects them to the new owner once the shard is taken over.
+func (o *ShardOwnership) Route(shardID int) (string, error) {
+	owner, drainedHost, err := o.Lookup(shardID)
+	if err != nil {
+		return "", err
+	}
+	if drainedHost != "" {
+		return drainedHost, nil
+	}
+	return owner.GetAddress(), nil
+}
+
+// IsDrained returns whether the given host is drained and gives away its shards
+func (o *ShardOwnership) IsDrained(host string) bool {
+	return o.getConfig().isDrained(host)
+}
+
+func (o *ShardOwnership) getConfig() *shardOwnershipConfig {
+	drainedHosts := o.drainedHosts()
+	overrides := o.overrides()
+	if config, ok := o.config.Load().(*shardOwnershipConfig); ok &&
+		config.drainedHostsValue == drainedHosts && config.overridesValue == overrides {
+		return config
+	}
+
+	config := &shardOwnershipConfig{
+		drainedHostsValue: drainedHosts,
+		overridesValue:    overrides,
+		drainedHosts:      parseDrainedHosts(drainedHosts),
+		overrides:         parseShardOwnershipOverrides(overrides, o.logger),
+	}
+	o.config.Store(config)
+	return config
+}
+
+func (c *shardOwnershipConfig) isDrained(host string) bool {
+	_, ok := c.drainedHosts[host]
+	return ok
+}
+
+func (c *shardOwnershipConfig) undrainedMembers(members []*HostInfo) []*HostInfo {
+	var hosts []*HostInfo
+	for _, member := range members {
+		if !c.isDrained(member.Identity()) {
+			hosts = append(hosts, member)
+		}
+	}
+	sort.Slice(hosts, func(i, j int) bool {
+		return hosts[i].Identity() < hosts[j].Identity()
+	})
+	return hosts
+}
+
+// parseDrainedHosts parses a comma separated list of host:port addresses
+func parseDrainedHosts(value string) map[string]struct{} {
+	hosts := make(map[string]struct{})
+	for _, host := range strings.Split(value, ",") {
+		if host = strings.TrimSpace(host); host != "" {
+			hosts[host] = struct{}{}
+		}
+	}
+	return hosts
+}
+
+// parseShardOwnershipOverrides parses a comma separated list of shardID=host:port pairs,
+// invalid pairs are logged and skipped
+func parseShardOwnershipOverrides(value string, logger bark.Logger) map[int]string {
+	overrides := make(map[int]string)
+	for _, pair := range strings.Split(value, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			logger.Warnf("Skipping invalid shard ownership override: %v", pair)
+			continue
+		}
+		shardID, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+		host := strings.TrimSpace(parts[1])
+		if err != nil || host == "" {
+			logger.Warnf("Skipping invalid shard ownership override: %v", pair)
+			continue
+		}
+		overrides[shardID] = host
+	}
+	return overrides
+}
@@ -0,0 +1,229 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"strconv"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	shardOwnershipSuite struct {
+		suite.Suite
+		mockServiceResolver *mockServiceResolver
+		drainedHosts        string
+		overrides           string
+		logger              bark.Logger
+		hostA               *HostInfo
+		hostB               *HostInfo
+		hostC               *HostInfo
+	}
+
+	mockServiceResolver struct {
+		mock.Mock
+	}
+)
+
+func TestShardOwnershipSuite(t *testing.T) {
+	s := new(shardOwnershipSuite)
+	suite.Run(t, s)
+}
+
+func (s *shardOwnershipSuite) SetupTest() {
+	s.logger = bark.NewLoggerFromLogrus(log.New())
+	s.drainedHosts = ""
+	s.overrides = ""
+	s.mockServiceResolver = &mockServiceResolver{}
+	s.hostA = NewHostInfo("10.0.0.1:7934", nil)
+	s.hostB = NewHostInfo("10.0.0.2:7934", nil)
+	s.hostC = NewHostInfo("10.0.0.3:7934", nil)
+}
+
+func (s *shardOwnershipSuite) TearDownTest() {
+	s.mockServiceResolver.AssertExpectations(s.T())
+}
+
+func (s *shardOwnershipSuite) TestLookup_RingOwner() {
+	s.mockServiceResolver.On("Lookup", ShardKey(5)).Return(s.hostA, nil).Once()
+
+	owner, drainedHost, err := s.newShardOwnership().Lookup(5)
+	s.NoError(err)
+	s.Equal(s.hostA.Identity(), owner.Identity())
+	s.Empty(drainedHost)
+}
+
+func (s *shardOwnershipSuite) TestLookup_Pinned() {
+	s.overrides = "5=" + s.hostB.Identity() + ", 6=" + s.hostC.Identity()
+	s.mockServiceResolver.On("Members").Return([]*HostInfo{s.hostA, s.hostB}).Twice()
+	s.mockServiceResolver.On("Lookup", ShardKey(6)).Return(s.hostA, nil).Once()
+
+	ownership := s.newShardOwnership()
+	owner, drainedHost, err := ownership.Lookup(5)
+	s.NoError(err)
+	s.Equal(s.hostB.Identity(), owner.Identity())
+	s.Empty(drainedHost)
+
+	// pinned host is not part of the ring
+	owner, drainedHost, err = ownership.Lookup(6)
+	s.NoError(err)
+	s.Equal(s.hostA.Identity(), owner.Identity())
+	s.Empty(drainedHost)
+}
+
+func (s *shardOwnershipSuite) TestLookup_Drained() {
+	s.drainedHosts = s.hostA.Identity()
+	s.mockServiceResolver.On("Members").Return([]*HostInfo{s.hostA, s.hostB, s.hostC}).Once()
+	s.mockServiceResolver.On("Lookup", ShardKey(5)).Return(s.hostA, nil).Once()
+	s.mockServiceResolver.On("Lookup", ShardKey(5)+"_1").Return(s.hostA, nil).Once()
+	s.mockServiceResolver.On("Lookup", ShardKey(5)+"_2").Return(s.hostC, nil).Once()
+
+	owner, drainedHost, err := s.newShardOwnership().Lookup(5)
+	s.NoError(err)
+	s.Equal(s.hostC.Identity(), owner.Identity())
+	s.Equal(s.hostA.Identity(), drainedHost)
+}
+
+func (s *shardOwnershipSuite) TestLookup_Drained_FallbackPick() {
+	s.drainedHosts = s.hostA.Identity() + "," + s.hostB.Identity()
+	s.mockServiceResolver.On("Members").Return([]*HostInfo{s.hostA, s.hostB, s.hostC}).Once()
+	s.mockServiceResolver.On("Lookup", ShardKey(5)).Return(s.hostA, nil).Once()
+	s.mockServiceResolver.On("Lookup", mock.Anything).Return(s.hostB, nil).Times(3)
+
+	owner, drainedHost, err := s.newShardOwnership().Lookup(5)
+	s.NoError(err)
+	s.Equal(s.hostC.Identity(), owner.Identity())
+	s.Equal(s.hostA.Identity(), drainedHost)
+}
+
+func (s *shardOwnershipSuite) TestLookup_AllDrained() {
+	s.drainedHosts = s.hostA.Identity() + "," + s.hostB.Identity()
+	s.mockServiceResolver.On("Members").Return([]*HostInfo{s.hostA, s.hostB}).Once()
+	s.mockServiceResolver.On("Lookup", ShardKey(5)).Return(s.hostA, nil).Once()
+
+	owner, drainedHost, err := s.newShardOwnership().Lookup(5)
+	s.NoError(err)
+	s.Equal(s.hostA.Identity(), owner.Identity())
+	s.Empty(drainedHost)
+}
+
+func (s *shardOwnershipSuite) TestLookup_PinnedToDrainedHost() {
+	s.drainedHosts = s.hostB.Identity()
+	s.overrides = "5=" + s.hostB.Identity()
+	s.mockServiceResolver.On("Lookup", ShardKey(5)).Return(s.hostA, nil).Once()
+
+	owner, drainedHost, err := s.newShardOwnership().Lookup(5)
+	s.NoError(err)
+	s.Equal(s.hostA.Identity(), owner.Identity())
+	s.Empty(drainedHost)
+}
+
+func (s *shardOwnershipSuite) TestRoute() {
+	s.drainedHosts = s.hostA.Identity()
+	s.mockServiceResolver.On("Members").Return([]*HostInfo{s.hostA, s.hostB}).Once()
+	s.mockServiceResolver.On("Lookup", ShardKey(5)).Return(s.hostA, nil).Once()
+	s.mockServiceResolver.On("Lookup", ShardKey(5)+"_1").Return(s.hostB, nil).Once()
+	s.mockServiceResolver.On("Lookup", ShardKey(6)).Return(s.hostB, nil).Once()
+
+	// requests go to the drained host holding the shard until the new owner takes it over
+	ownership := s.newShardOwnership()
+	host, err := ownership.Route(5)
+	s.NoError(err)
+	s.Equal(s.hostA.GetAddress(), host)
+
+	host, err = ownership.Route(6)
+	s.NoError(err)
+	s.Equal(s.hostB.GetAddress(), host)
+}
+
+func (s *shardOwnershipSuite) TestConfigChange() {
+	s.mockServiceResolver.On("Lookup", ShardKey(5)).Return(s.hostA, nil).Twice()
+	s.mockServiceResolver.On("Members").Return([]*HostInfo{s.hostA, s.hostB}).Once()
+	s.mockServiceResolver.On("Lookup", ShardKey(5)+"_1").Return(s.hostB, nil).Once()
+
+	ownership := s.newShardOwnership()
+	s.False(ownership.IsDrained(s.hostA.Identity()))
+	config := ownership.getConfig()
+	owner, drainedHost, err := ownership.Lookup(5)
+	s.NoError(err)
+	s.Equal(s.hostA.Identity(), owner.Identity())
+	s.Empty(drainedHost)
+	// the parsed config is reused as long as the dynamic config does not change
+	s.True(config == ownership.getConfig())
+
+	s.drainedHosts = s.hostA.Identity()
+	s.True(ownership.IsDrained(s.hostA.Identity()))
+	owner, drainedHost, err = ownership.Lookup(5)
+	s.NoError(err)
+	s.Equal(s.hostB.Identity(), owner.Identity())
+	s.Equal(s.hostA.Identity(), drainedHost)
+}
+
+func (s *shardOwnershipSuite) TestParseShardOwnershipOverrides() {
+	overrides := parseShardOwnershipOverrides(" 1=10.0.0.1:7934,2=, x=10.0.0.2:7934,3, 4 = 10.0.0.4:7934 ,", s.logger)
+	s.Equal(map[int]string{
+		1: "10.0.0.1:7934",
+		4: "10.0.0.4:7934",
+	}, overrides)
+}
+
+func (s *shardOwnershipSuite) TestparseDrainedHosts() {
+	hosts := parseDrainedHosts(" 10.0.0.1:7934,,10.0.0.2:7934 ")
+	s.Len(hosts, 2)
+	for i := 1; i <= 2; i++ {
+		_, ok := hosts["10.0.0."+strconv.Itoa(i)+":7934"]
+		s.True(ok)
+	}
+}
+
+func (s *shardOwnershipSuite) newShardOwnership() *ShardOwnership {
+	return NewShardOwnership(s.mockServiceResolver,
+		func(opts ...dynamicconfig.FilterOption) string {
+			return s.drainedHosts
+		},
+		func(opts ...dynamicconfig.FilterOption) string {
+			return s.overrides
+		},
+		s.logger)
+}
+
+func (r *mockServiceResolver) Lookup(key string) (*HostInfo, error) {
+	args := r.Called(key)
+	host, _ := args.Get(0).(*HostInfo)
+	return host, args.Error(1)
+}
+
+func (r *mockServiceResolver) Members() []*HostInfo {
+	return r.Called().Get(0).([]*HostInfo)
+}
+
+func (r *mockServiceResolver) AddListener(name string, notifyChannel chan<- *ChangedEvent) error {
+	return r.Called(name, notifyChannel).Error(0)
+}
+
+func (r *mockServiceResolver) RemoveListener(name string) error {
+	return r.Called(name).Error(0)
+}
@@ -69,6 +69,11 @@ const (
 	ShardTagName       = "shard"
 	CadenceRoleTagName = "cadence-role"
 	StatsTypeTagName   = "stats-type"
+	DomainTagName      = "domain"
+	DomainIDTagName    = "domain-id"
+	LimitTypeTagName   = "limit-type"
+	// SourceClusterTagName is the remote cluster which the replication tasks are received from
+	SourceClusterTagName = "source-cluster"
 )
 
 // This package should hold all the metrics and tags for cadence
@@ -83,6 +88,17 @@ const (
 
 	SizeStatsTypeTagValue  = "size"
 	CountStatsTypeTagValue = "count"
+
+	BlobSizeLimitTypeTagValue               = "blob-size"
+	HistorySizeLimitTypeTagValue            = "history-size"
+	HistoryCountLimitTypeTagValue           = "history-count"
+	PendingActivitiesLimitTypeTagValue      = "pending-activities"
+	PendingChildExecutionsLimitTypeTagValue = "pending-child-executions"
+	PendingTimersLimitTypeTagValue          = "pending-timers"
+	PendingSignalsLimitTypeTagValue         = "pending-signals"
+	HostRPSLimitTypeTagValue                = "host-rps"
+	DomainRPSLimitTypeTagValue              = "domain-rps"
+	DomainWorkerRPSLimitTypeTagValue        = "domain-worker-rps"
 )
 
 // Common service base metrics
@@ -137,6 +153,8 @@ const (
 	PersistenceResetMutableStateScope
 	// PersistenceDeleteWorkflowExecutionScope tracks DeleteWorkflowExecution calls made by service to persistence layer
 	PersistenceDeleteWorkflowExecutionScope
+	// PersistenceDeleteCurrentWorkflowExecutionScope tracks DeleteCurrentWorkflowExecution calls made by service to persistence layer
+	PersistenceDeleteCurrentWorkflowExecutionScope
 	// PersistenceGetCurrentExecutionScope tracks GetCurrentExecution calls made by service to persistence layer
 	PersistenceGetCurrentExecutionScope
 	// PersistenceGetTransferTasksScope tracks GetTransferTasks calls made by service to persistence layer
@@ -149,6 +167,22 @@ const (
 	PersistenceRangeCompleteTransferTaskScope
 	// PersistenceCompleteReplicationTaskScope tracks CompleteReplicationTasks calls made by service to persistence layer
 	PersistenceCompleteReplicationTaskScope
+	// PersistencePutReplicationTaskToDLQScope tracks PutReplicationTaskToDLQ calls made by service to persistence layer
+	PersistencePutReplicationTaskToDLQScope
+	// PersistenceGetReplicationTasksFromDLQScope tracks GetReplicationTasksFromDLQ calls made by service to persistence layer
+	PersistenceGetReplicationTasksFromDLQScope
+	// PersistenceDeleteReplicationTaskFromDLQScope tracks DeleteReplicationTaskFromDLQ calls made by service to persistence layer
+	PersistenceDeleteReplicationTaskFromDLQScope
+	// PersistenceRangeDeleteReplicationTaskFromDLQScope tracks RangeDeleteReplicationTaskFromDLQ calls made by service to persistence layer
+	PersistenceRangeDeleteReplicationTaskFromDLQScope
+	// PersistencePutQuarantinedTaskScope tracks PutQuarantinedTask calls made by service to persistence layer
+	PersistencePutQuarantinedTaskScope
+	// PersistenceGetQuarantinedTasksScope tracks GetQuarantinedTasks calls made by service to persistence layer
+	PersistenceGetQuarantinedTasksScope
+	// PersistenceGetQuarantinedTaskScope tracks GetQuarantinedTask calls made by service to persistence layer
+	PersistenceGetQuarantinedTaskScope
+	// PersistenceDeleteQuarantinedTaskScope tracks DeleteQuarantinedTask calls made by service to persistence layer
+	PersistenceDeleteQuarantinedTaskScope
 	// PersistenceGetTimerIndexTasksScope tracks GetTimerIndexTasks calls made by service to persistence layer
 	PersistenceGetTimerIndexTasksScope
 	// PersistenceCompleteTimerTaskScope tracks CompleteTimerTasks calls made by service to persistence layer
@@ -161,6 +195,8 @@ const (
 	PersistenceGetTasksScope
 	// PersistenceCompleteTaskScope tracks CompleteTask calls made by service to persistence layer
 	PersistenceCompleteTaskScope
+	// PersistenceDeleteTaskListScope tracks DeleteTaskList calls made by service to persistence layer
+	PersistenceDeleteTaskListScope
 	// PersistenceLeaseTaskListScope tracks LeaseTaskList calls made by service to persistence layer
 	PersistenceLeaseTaskListScope
 	// PersistenceUpdateTaskListScope tracks PersistenceUpdateTaskListScope calls made by service to persistence layer
@@ -205,6 +241,8 @@ const (
 	PersistenceListClosedWorkflowExecutionsByStatusScope
 	// PersistenceGetClosedWorkflowExecutionScope tracks GetClosedWorkflowExecution calls made by service to persistence layer
 	PersistenceGetClosedWorkflowExecutionScope
+	// PersistenceDeleteWorkflowExecutionsByDomainScope tracks DeleteWorkflowExecutionsByDomain calls made by service to persistence layer
+	PersistenceDeleteWorkflowExecutionsByDomainScope
 	// HistoryClientStartWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientStartWorkflowExecutionScope
 	// HistoryClientRecordActivityTaskHeartbeatScope tracks RPC calls to history service
@@ -239,6 +277,16 @@ const (
 	HistoryClientRemoveSignalMutableStateScope
 	// HistoryClientTerminateWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientTerminateWorkflowExecutionScope
+	// HistoryClientPauseWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientPauseWorkflowExecutionScope
+	// HistoryClientResumeWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientResumeWorkflowExecutionScope
+	// HistoryClientRetryActivityTaskScope tracks RPC calls to history service
+	HistoryClientRetryActivityTaskScope
+	// HistoryClientCompleteActivityTaskScope tracks RPC calls to history service
+	HistoryClientCompleteActivityTaskScope
+	// HistoryClientFailActivityTaskScope tracks RPC calls to history service
+	HistoryClientFailActivityTaskScope
 	// HistoryClientScheduleDecisionTaskScope tracks RPC calls to history service
 	HistoryClientScheduleDecisionTaskScope
 	// HistoryClientRecordChildExecutionCompletedScope tracks RPC calls to history service
@@ -249,6 +297,32 @@ const (
 	HistoryClientSyncShardStatusScope
 	// HistoryClientSyncActivityScope tracks RPC calls to history service
 	HistoryClientSyncActivityScope
+	// HistoryClientMigrateWorkflowHistoryScope tracks RPC calls to history service
+	HistoryClientMigrateWorkflowHistoryScope
+	// HistoryClientDeleteWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientDeleteWorkflowExecutionScope
+	// HistoryClientGetWorkflowReplicationTasksScope tracks RPC calls to history service
+	HistoryClientGetWorkflowReplicationTasksScope
+	// HistoryClientGetReplicationMessagesScope tracks RPC calls to history service
+	HistoryClientGetReplicationMessagesScope
+	// HistoryClientGetReplicationStatusScope tracks RPC calls to history service
+	HistoryClientGetReplicationStatusScope
+	// HistoryClientDescribeShardScope tracks RPC calls to history service
+	HistoryClientDescribeShardScope
+	// HistoryClientListShardTasksScope tracks RPC calls to history service
+	HistoryClientListShardTasksScope
+	// HistoryClientRemoveShardTaskScope tracks RPC calls to history service
+	HistoryClientRemoveShardTaskScope
+	// HistoryClientRescheduleShardTaskScope tracks RPC calls to history service
+	HistoryClientRescheduleShardTaskScope
+	// HistoryClientListQuarantinedTasksScope tracks RPC calls to history service
+	HistoryClientListQuarantinedTasksScope
+	// HistoryClientRetryQuarantinedTaskScope tracks RPC calls to history service
+	HistoryClientRetryQuarantinedTaskScope
+	// HistoryClientDropQuarantinedTaskScope tracks RPC calls to history service
+	HistoryClientDropQuarantinedTaskScope
+	// HistoryClientCloseShardScope tracks RPC calls to history service
+	HistoryClientCloseShardScope
 	// MatchingClientPollForDecisionTaskScope tracks RPC calls to matching service
 	MatchingClientPollForDecisionTaskScope
 	// MatchingClientPollForActivityTaskScope tracks RPC calls to matching service
@@ -332,12 +406,16 @@ const (
 	PersistenceAppendHistoryNodesScope
 	// PersistenceReadHistoryBranchScope tracks ReadHistoryBranch calls made by service to persistence layer
 	PersistenceReadHistoryBranchScope
+	// PersistenceReadRawHistoryBranchScope tracks ReadRawHistoryBranch calls made by service to persistence layer
+	PersistenceReadRawHistoryBranchScope
 	// PersistenceForkHistoryBranchScope tracks ForkHistoryBranch calls made by service to persistence layer
 	PersistenceForkHistoryBranchScope
 	// PersistenceDeleteHistoryBranchScope tracks DeleteHistoryBranch calls made by service to persistence layer
 	PersistenceDeleteHistoryBranchScope
 	// PersistenceGetHistoryTreeScope tracks GetHistoryTree calls made by service to persistence layer
 	PersistenceGetHistoryTreeScope
+	// PersistenceDomainRateLimiterScope tracks persistence calls throttled by the per domain rate limiter
+	PersistenceDomainRateLimiterScope
 
 	NumCommonScopes
 )
@@ -374,12 +452,24 @@ const (
 	FrontendRespondActivityTaskCanceledByIDScope
 	// FrontendGetWorkflowExecutionHistoryScope is the metric scope for frontend.GetWorkflowExecutionHistory
 	FrontendGetWorkflowExecutionHistoryScope
+	// FrontendGetWorkflowExecutionRawHistoryScope is the metric scope for frontend.GetWorkflowExecutionRawHistory
+	FrontendGetWorkflowExecutionRawHistoryScope
 	// FrontendSignalWorkflowExecutionScope is the metric scope for frontend.SignalWorkflowExecution
 	FrontendSignalWorkflowExecutionScope
 	// FrontendSignalWithStartWorkflowExecutionScope is the metric scope for frontend.SignalWithStartWorkflowExecution
 	FrontendSignalWithStartWorkflowExecutionScope
 	// FrontendTerminateWorkflowExecutionScope is the metric scope for frontend.TerminateWorkflowExecution
 	FrontendTerminateWorkflowExecutionScope
+	// FrontendPauseWorkflowExecutionScope is the metric scope for frontend.PauseWorkflowExecution
+	FrontendPauseWorkflowExecutionScope
+	// FrontendResumeWorkflowExecutionScope is the metric scope for frontend.ResumeWorkflowExecution
+	FrontendResumeWorkflowExecutionScope
+	// FrontendRetryActivityTaskScope is the metric scope for frontend.RetryActivityTask
+	FrontendRetryActivityTaskScope
+	// FrontendCompleteActivityTaskScope is the metric scope for frontend.CompleteActivityTask
+	FrontendCompleteActivityTaskScope
+	// FrontendFailActivityTaskScope is the metric scope for frontend.FailActivityTask
+	FrontendFailActivityTaskScope
 	// FrontendRequestCancelWorkflowExecutionScope is the metric scope for frontend.RequestCancelWorkflowExecution
 	FrontendRequestCancelWorkflowExecutionScope
 	// FrontendListOpenWorkflowExecutionsScope is the metric scope for frontend.ListOpenWorkflowExecutions
@@ -442,6 +532,16 @@ const (
 	HistoryRemoveSignalMutableStateScope
 	// HistoryTerminateWorkflowExecutionScope tracks TerminateWorkflowExecution API calls received by service
 	HistoryTerminateWorkflowExecutionScope
+	// HistoryPauseWorkflowExecutionScope tracks PauseWorkflowExecution API calls received by service
+	HistoryPauseWorkflowExecutionScope
+	// HistoryResumeWorkflowExecutionScope tracks ResumeWorkflowExecution API calls received by service
+	HistoryResumeWorkflowExecutionScope
+	// HistoryRetryActivityTaskScope tracks RetryActivityTask API calls received by service
+	HistoryRetryActivityTaskScope
+	// HistoryCompleteActivityTaskScope tracks CompleteActivityTask API calls received by service
+	HistoryCompleteActivityTaskScope
+	// HistoryFailActivityTaskScope tracks FailActivityTask API calls received by service
+	HistoryFailActivityTaskScope
 	// HistoryScheduleDecisionTaskScope tracks ScheduleDecisionTask API calls received by service
 	HistoryScheduleDecisionTaskScope
 	// HistoryRecordChildExecutionCompletedScope tracks CompleteChildExecution API calls received by service
@@ -456,6 +556,32 @@ const (
 	HistorySyncActivityScope
 	// HistoryDescribeMutableStateScope tracks HistoryActivity API calls received by service
 	HistoryDescribeMutableStateScope
+	// HistoryMigrateWorkflowHistoryScope tracks MigrateWorkflowHistory API calls received by service
+	HistoryMigrateWorkflowHistoryScope
+	// HistoryDeleteWorkflowExecutionScope tracks DeleteWorkflowExecution API calls received by service
+	HistoryDeleteWorkflowExecutionScope
+	// HistoryGetWorkflowReplicationTasksScope tracks GetWorkflowReplicationTasks API calls received by service
+	HistoryGetWorkflowReplicationTasksScope
+	// HistoryGetReplicationMessagesScope tracks GetReplicationMessages API calls received by service
+	HistoryGetReplicationMessagesScope
+	// HistoryGetReplicationStatusScope tracks GetReplicationStatus API calls received by service
+	HistoryGetReplicationStatusScope
+	// HistoryDescribeShardScope tracks DescribeShard API calls received by service
+	HistoryDescribeShardScope
+	// HistoryListShardTasksScope tracks ListShardTasks API calls received by service
+	HistoryListShardTasksScope
+	// HistoryRemoveShardTaskScope tracks RemoveShardTask API calls received by service
+	HistoryRemoveShardTaskScope
+	// HistoryRescheduleShardTaskScope tracks RescheduleShardTask API calls received by service
+	HistoryRescheduleShardTaskScope
+	// HistoryListQuarantinedTasksScope tracks ListQuarantinedTasks API calls received by service
+	HistoryListQuarantinedTasksScope
+	// HistoryRetryQuarantinedTaskScope tracks RetryQuarantinedTask API calls received by service
+	HistoryRetryQuarantinedTaskScope
+	// HistoryDropQuarantinedTaskScope tracks DropQuarantinedTask API calls received by service
+	HistoryDropQuarantinedTaskScope
+	// HistoryCloseShardScope tracks CloseShard API calls received by service
+	HistoryCloseShardScope
 	// HistoryShardControllerScope is the scope used by shard controller
 	HistoryShardControllerScope
 	// TransferQueueProcessorScope is the scope used by all metric emitted by transfer queue processor
@@ -542,6 +668,8 @@ const (
 	HistoryCacheGetOrCreateScope
 	// HistoryCacheGetCurrentExecutionScope is the scope used by history cache for getting current execution
 	HistoryCacheGetCurrentExecutionScope
+	// HistoryCacheScope is the scope used by the history cache to report hits, misses and evictions
+	HistoryCacheScope
 	// ExecutionSizeStatsScope is the scope used for emiting workflow execution size related stats
 	ExecutionSizeStatsScope
 	// ExecutionCountStatsScope is the scope used for emiting workflow execution count related stats
@@ -590,6 +718,12 @@ const (
 	SyncShardTaskScope
 	// SyncActivityTaskScope is the scope used by sync activity information processing
 	SyncActivityTaskScope
+	// ReplicationTaskFetcherScope is the scope used by fetching replication tasks from remote clusters over rpc
+	ReplicationTaskFetcherScope
+	// ConsistencyCheckerScope is the scope used by comparing the mutable state of workflows across clusters
+	ConsistencyCheckerScope
+	// DomainDeletionScope is the scope used by the activities of the domain deletion workflow
+	DomainDeletionScope
 
 	NumWorkerScopes
 )
@@ -606,18 +740,28 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceUpdateWorkflowExecutionScope:                  {operation: "UpdateWorkflowExecution"},
 		PersistenceResetMutableStateScope:                        {operation: "ResetMutableState"},
 		PersistenceDeleteWorkflowExecutionScope:                  {operation: "DeleteWorkflowExecution"},
+		PersistenceDeleteCurrentWorkflowExecutionScope:           {operation: "DeleteCurrentWorkflowExecution"},
 		PersistenceGetCurrentExecutionScope:                      {operation: "GetCurrentExecution"},
 		PersistenceGetTransferTasksScope:                         {operation: "GetTransferTasks"},
 		PersistenceGetReplicationTasksScope:                      {operation: "GetReplicationTasks"},
 		PersistenceCompleteTransferTaskScope:                     {operation: "CompleteTransferTask"},
 		PersistenceRangeCompleteTransferTaskScope:                {operation: "RangeCompleteTransferTask"},
 		PersistenceCompleteReplicationTaskScope:                  {operation: "CompleteReplicationTask"},
+		PersistencePutReplicationTaskToDLQScope:                  {operation: "PutReplicationTaskToDLQ"},
+		PersistenceGetReplicationTasksFromDLQScope:               {operation: "GetReplicationTasksFromDLQ"},
+		PersistenceDeleteReplicationTaskFromDLQScope:             {operation: "DeleteReplicationTaskFromDLQ"},
+		PersistenceRangeDeleteReplicationTaskFromDLQScope:        {operation: "RangeDeleteReplicationTaskFromDLQ"},
+		PersistencePutQuarantinedTaskScope:                       {operation: "PutQuarantinedTask"},
+		PersistenceGetQuarantinedTasksScope:                      {operation: "GetQuarantinedTasks"},
+		PersistenceGetQuarantinedTaskScope:                       {operation: "GetQuarantinedTask"},
+		PersistenceDeleteQuarantinedTaskScope:                    {operation: "DeleteQuarantinedTask"},
 		PersistenceGetTimerIndexTasksScope:                       {operation: "GetTimerIndexTasks"},
 		PersistenceCompleteTimerTaskScope:                        {operation: "CompleteTimerTask"},
 		PersistenceRangeCompleteTimerTaskScope:                   {operation: "RangeCompleteTimerTask"},
 		PersistenceCreateTaskScope:                               {operation: "CreateTask", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceGetTasksScope:                                 {operation: "GetTasks", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceCompleteTaskScope:                             {operation: "CompleteTask", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceDeleteTaskListScope:                           {operation: "DeleteTaskList", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceLeaseTaskListScope:                            {operation: "LeaseTaskList", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceUpdateTaskListScope:                           {operation: "UpdateTaskList", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceAppendHistoryEventsScope:                      {operation: "AppendHistoryEvents", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
@@ -640,11 +784,14 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceListClosedWorkflowExecutionsByWorkflowIDScope: {operation: "ListClosedWorkflowExecutionsByWorkflowID"},
 		PersistenceListClosedWorkflowExecutionsByStatusScope:     {operation: "ListClosedWorkflowExecutionsByStatus"},
 		PersistenceGetClosedWorkflowExecutionScope:               {operation: "GetClosedWorkflowExecution"},
+		PersistenceDeleteWorkflowExecutionsByDomainScope:         {operation: "DeleteWorkflowExecutionsByDomain"},
 		PersistenceAppendHistoryNodesScope:                       {operation: "AppendHistoryNodes", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceReadHistoryBranchScope:                        {operation: "ReadHistoryBranch", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceReadRawHistoryBranchScope:                     {operation: "ReadRawHistoryBranch", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceForkHistoryBranchScope:                        {operation: "ForkHistoryBranch", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceDeleteHistoryBranchScope:                      {operation: "DeleteHistoryBranch", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceGetHistoryTreeScope:                           {operation: "GetHistoryTree", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceDomainRateLimiterScope:                        {operation: "DomainRateLimiter", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 
 		HistoryClientStartWorkflowExecutionScope:            {operation: "HistoryClientStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientRecordActivityTaskHeartbeatScope:       {operation: "HistoryClientRecordActivityTaskHeartbeat", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
@@ -663,11 +810,29 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientSignalWithStartWorkflowExecutionScope:  {operation: "HistoryClientSignalWithStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientRemoveSignalMutableStateScope:          {operation: "HistoryClientRemoveSignalMutableStateScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientTerminateWorkflowExecutionScope:        {operation: "HistoryClientTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientPauseWorkflowExecutionScope:            {operation: "HistoryClientPauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientResumeWorkflowExecutionScope:           {operation: "HistoryClientResumeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientRetryActivityTaskScope:                 {operation: "HistoryClientRetryActivityTask", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientCompleteActivityTaskScope:              {operation: "HistoryClientCompleteActivityTask", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientFailActivityTaskScope:                  {operation: "HistoryClientFailActivityTask", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientScheduleDecisionTaskScope:              {operation: "HistoryClientScheduleDecisionTask", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientRecordChildExecutionCompletedScope:     {operation: "HistoryClientRecordChildExecutionCompleted", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientReplicateEventsScope:                   {operation: "HistoryClientReplicateEvents", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientSyncShardStatusScope:                   {operation: "HistoryClientSyncShardStatusScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientSyncActivityScope:                      {operation: "HistoryClientSyncActivityScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientMigrateWorkflowHistoryScope:            {operation: "HistoryClientMigrateWorkflowHistory", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientDeleteWorkflowExecutionScope:           {operation: "HistoryClientDeleteWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientGetWorkflowReplicationTasksScope:       {operation: "HistoryClientGetWorkflowReplicationTasks", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientGetReplicationMessagesScope:            {operation: "HistoryClientGetReplicationMessages", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientGetReplicationStatusScope:              {operation: "HistoryClientGetReplicationStatus", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientDescribeShardScope:                     {operation: "HistoryClientDescribeShard", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientListShardTasksScope:                    {operation: "HistoryClientListShardTasks", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientRemoveShardTaskScope:                   {operation: "HistoryClientRemoveShardTask", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientRescheduleShardTaskScope:               {operation: "HistoryClientRescheduleShardTask", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientListQuarantinedTasksScope:              {operation: "HistoryClientListQuarantinedTasks", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientRetryQuarantinedTaskScope:              {operation: "HistoryClientRetryQuarantinedTask", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientDropQuarantinedTaskScope:               {operation: "HistoryClientDropQuarantinedTask", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientCloseShardScope:                        {operation: "HistoryClientCloseShard", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		MatchingClientPollForDecisionTaskScope:              {operation: "MatchingClientPollForDecisionTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientPollForActivityTaskScope:              {operation: "MatchingClientPollForActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientAddActivityTaskScope:                  {operation: "MatchingClientAddActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
@@ -725,9 +890,15 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendRespondActivityTaskFailedByIDScope:    {operation: "RespondActivityTaskFailedByID"},
 		FrontendRespondActivityTaskCanceledByIDScope:  {operation: "RespondActivityTaskCanceledByID"},
 		FrontendGetWorkflowExecutionHistoryScope:      {operation: "GetWorkflowExecutionHistory"},
+		FrontendGetWorkflowExecutionRawHistoryScope:   {operation: "GetWorkflowExecutionRawHistory"},
 		FrontendSignalWorkflowExecutionScope:          {operation: "SignalWorkflowExecution"},
 		FrontendSignalWithStartWorkflowExecutionScope: {operation: "SignalWithStartWorkflowExecution"},
 		FrontendTerminateWorkflowExecutionScope:       {operation: "TerminateWorkflowExecution"},
+		FrontendPauseWorkflowExecutionScope:           {operation: "PauseWorkflowExecution"},
+		FrontendResumeWorkflowExecutionScope:          {operation: "ResumeWorkflowExecution"},
+		FrontendRetryActivityTaskScope:                {operation: "RetryActivityTask"},
+		FrontendCompleteActivityTaskScope:             {operation: "CompleteActivityTask"},
+		FrontendFailActivityTaskScope:                 {operation: "FailActivityTask"},
 		FrontendRequestCancelWorkflowExecutionScope:   {operation: "RequestCancelWorkflowExecution"},
 		FrontendListOpenWorkflowExecutionsScope:       {operation: "ListOpenWorkflowExecutions"},
 		FrontendListClosedWorkflowExecutionsScope:     {operation: "ListClosedWorkflowExecutions"},
@@ -759,6 +930,11 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistorySignalWithStartWorkflowExecutionScope: {operation: "SignalWithStartWorkflowExecution"},
 		HistoryRemoveSignalMutableStateScope:         {operation: "RemoveSignalMutableState"},
 		HistoryTerminateWorkflowExecutionScope:       {operation: "TerminateWorkflowExecution"},
+		HistoryPauseWorkflowExecutionScope:           {operation: "PauseWorkflowExecution"},
+		HistoryResumeWorkflowExecutionScope:          {operation: "ResumeWorkflowExecution"},
+		HistoryRetryActivityTaskScope:                {operation: "RetryActivityTask"},
+		HistoryCompleteActivityTaskScope:             {operation: "CompleteActivityTask"},
+		HistoryFailActivityTaskScope:                 {operation: "FailActivityTask"},
 		HistoryScheduleDecisionTaskScope:             {operation: "ScheduleDecisionTask"},
 		HistoryRecordChildExecutionCompletedScope:    {operation: "RecordChildExecutionCompleted"},
 		HistoryRequestCancelWorkflowExecutionScope:   {operation: "RequestCancelWorkflowExecution"},
@@ -766,6 +942,19 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistorySyncShardStatusScope:                  {operation: "SyncShardStatus"},
 		HistorySyncActivityScope:                     {operation: "SyncActivity"},
 		HistoryDescribeMutableStateScope:             {operation: "DescribeMutableState"},
+		HistoryMigrateWorkflowHistoryScope:           {operation: "MigrateWorkflowHistory"},
+		HistoryDeleteWorkflowExecutionScope:          {operation: "DeleteWorkflowExecution"},
+		HistoryGetWorkflowReplicationTasksScope:      {operation: "GetWorkflowReplicationTasks"},
+		HistoryGetReplicationMessagesScope:           {operation: "GetReplicationMessages"},
+		HistoryGetReplicationStatusScope:             {operation: "GetReplicationStatus"},
+		HistoryDescribeShardScope:                    {operation: "DescribeShard"},
+		HistoryListShardTasksScope:                   {operation: "ListShardTasks"},
+		HistoryRemoveShardTaskScope:                  {operation: "RemoveShardTask"},
+		HistoryRescheduleShardTaskScope:              {operation: "RescheduleShardTask"},
+		HistoryListQuarantinedTasksScope:             {operation: "ListQuarantinedTasks"},
+		HistoryRetryQuarantinedTaskScope:             {operation: "RetryQuarantinedTask"},
+		HistoryDropQuarantinedTaskScope:              {operation: "DropQuarantinedTask"},
+		HistoryCloseShardScope:                       {operation: "CloseShard"},
 		HistoryShardControllerScope:                  {operation: "ShardController"},
 		TransferQueueProcessorScope:                  {operation: "TransferQueueProcessor"},
 		TransferActiveQueueProcessorScope:            {operation: "TransferActiveQueueProcessor"},
@@ -809,6 +998,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryCacheGetAndCreateScope:                {operation: "HistoryCacheGetAndCreate"},
 		HistoryCacheGetOrCreateScope:                 {operation: "HistoryCacheGetOrCreate"},
 		HistoryCacheGetCurrentExecutionScope:         {operation: "HistoryCacheGetCurrentExecution"},
+		HistoryCacheScope:                            {operation: "HistoryCache"},
 		ExecutionSizeStatsScope:                      {operation: "ExecutionStats", tags: map[string]string{StatsTypeTagName: SizeStatsTypeTagValue}},
 		ExecutionCountStatsScope:                     {operation: "ExecutionStats", tags: map[string]string{StatsTypeTagName: CountStatsTypeTagValue}},
 		SessionSizeStatsScope:                        {operation: "SessionStats", tags: map[string]string{StatsTypeTagName: SizeStatsTypeTagValue}},
@@ -833,6 +1023,9 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryReplicationTaskScope: {operation: "HistoryReplicationTask"},
 		SyncShardTaskScope:          {operation: "SyncShardTask"},
 		SyncActivityTaskScope:       {operation: "SyncActivityTask"},
+		ReplicationTaskFetcherScope: {operation: "ReplicationTaskFetcher"},
+		ConsistencyCheckerScope:     {operation: "ConsistencyChecker"},
+		DomainDeletionScope:         {operation: "DomainDeletion"},
 	},
 }
 
@@ -853,6 +1046,7 @@ const (
 	CadenceErrLimitExceededCounter
 	CadenceErrContextTimeoutCounter
 	CadenceErrRetryTaskCounter
+	CadenceErrUnauthorizedCounter
 	PersistenceRequests
 	PersistenceFailures
 	PersistenceLatency
@@ -867,6 +1061,7 @@ const (
 	PersistenceErrDomainAlreadyExistsCounter
 	PersistenceErrBadRequestCounter
 	PersistenceSampledCounter
+	PersistenceDomainThrottledCounter
 
 	CadenceClientRequests
 	CadenceClientFailures
@@ -878,6 +1073,14 @@ const (
 
 	HistorySize
 
+	LimitWarnCounter
+	LimitErrorCounter
+
+	CacheLookupHitCounter
+	CacheLookupMissCounter
+	CacheEvictionCounter
+	CacheSizeBytesGauge
+
 	NumCommonMetrics // Needs to be last on this list for iota numbering
 )
 
@@ -891,6 +1094,9 @@ const (
 	TaskStandbyRetryCounter
 	TaskNotActiveCounter
 	TaskLimitExceededCounter
+	TaskHeldWhilePausedCounter
+	TaskQuarantinedCounter
+	TaskQuarantineFailedCounter
 	TaskBatchCompleteCounter
 	TaskProcessingLatency
 	TaskQueueLatency
@@ -932,6 +1138,7 @@ const (
 	ShardInfoTimerActivePendingTasksTimer
 	ShardInfoTimerStandbyPendingTasksTimer
 	ShardInfoReplicationLagTimer
+	ShardInfoReplicationRemoteLagTimer
 	ShardInfoTransferLagTimer
 	ShardInfoTimerLagTimer
 	ShardInfoTransferDiffTimer
@@ -986,6 +1193,11 @@ const (
 	DeleteChildInfoCount
 	DeleteSignalInfoCount
 	DeleteRequestCancelInfoCount
+	HistoryMigrationCounter
+	HistoryMigrationDryRunCounter
+	HistoryMigrationSize
+	HistoryMigrationEventCount
+	ReplicationTaskLagTimer
 
 	NumHistoryMetrics
 )
@@ -1011,6 +1223,17 @@ const (
 	ReplicatorMessages = iota + NumCommonMetrics
 	ReplicatorFailures
 	ReplicatorLatency
+	ReplicatorDLQMessages
+	ReplicatorDLQFailures
+	ReplicatorSkippedBatches
+	ReplicatorTaskLag
+	ReplicatorHistoryResendCounter
+	ConsistencyCheckerWorkflowsChecked
+	ConsistencyCheckerMismatches
+	ConsistencyCheckerFailures
+	DomainDeletionExecutionsDeleted
+	DomainDeletionTaskListsDeleted
+	DomainDeletionFailures
 
 	NumWorkerMetrics
 )
@@ -1033,6 +1256,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		CadenceErrLimitExceededCounter:                      {metricName: "cadence.errors.limit-exceeded", metricType: Counter},
 		CadenceErrContextTimeoutCounter:                     {metricName: "cadence.errors.context-timeout", metricType: Counter},
 		CadenceErrRetryTaskCounter:                          {metricName: "cadence.errors.retry-task", metricType: Counter},
+		CadenceErrUnauthorizedCounter:                       {metricName: "cadence.errors.unauthorized", metricType: Counter},
 		PersistenceRequests:                                 {metricName: "persistence.requests", metricType: Counter},
 		PersistenceFailures:                                 {metricName: "persistence.errors", metricType: Counter},
 		PersistenceLatency:                                  {metricName: "persistence.latency", metricType: Timer},
@@ -1047,6 +1271,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		PersistenceErrDomainAlreadyExistsCounter:            {metricName: "persistence.errors.domain-already-exists", metricType: Counter},
 		PersistenceErrBadRequestCounter:                     {metricName: "persistence.errors.bad-request", metricType: Counter},
 		PersistenceSampledCounter:                           {metricName: "persistence.sampled", metricType: Counter},
+		PersistenceDomainThrottledCounter:                   {metricName: "persistence.domain.throttled", metricType: Counter},
 		CadenceClientRequests:                               {metricName: "cadence.client.requests", metricType: Counter},
 		CadenceClientFailures:                               {metricName: "cadence.client.errors", metricType: Counter},
 		CadenceClientLatency:                                {metricName: "cadence.client.latency", metricType: Timer},
@@ -1054,6 +1279,12 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		DomainCacheBeforeCallbackLatency:                    {metricName: "domain-cache.before-callbacks.latency", metricType: Timer},
 		DomainCacheAfterCallbackLatency:                     {metricName: "domain-cache.after-callbacks.latency", metricType: Timer},
 		HistorySize:                                         {metricName: "history-size", metricType: Timer},
+		LimitWarnCounter:                                    {metricName: "limit.warn", metricType: Counter},
+		LimitErrorCounter:                                   {metricName: "limit.error", metricType: Counter},
+		CacheLookupHitCounter:                               {metricName: "cache.hit", metricType: Counter},
+		CacheLookupMissCounter:                              {metricName: "cache.miss", metricType: Counter},
+		CacheEvictionCounter:                                {metricName: "cache.evicted", metricType: Counter},
+		CacheSizeBytesGauge:                                 {metricName: "cache.size-bytes", metricType: Gauge},
 	},
 	Frontend: {},
 	History: {
@@ -1065,6 +1296,9 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		TaskStandbyRetryCounter:                      {metricName: "task.errors.standby-retry-counter", metricType: Counter},
 		TaskNotActiveCounter:                         {metricName: "task.errors.not-active-counter", metricType: Counter},
 		TaskLimitExceededCounter:                     {metricName: "task.errors.limit-exceeded-counter", metricType: Counter},
+		TaskHeldWhilePausedCounter:                   {metricName: "task.held-while-paused", metricType: Counter},
+		TaskQuarantinedCounter:                       {metricName: "task.quarantined", metricType: Counter},
+		TaskQuarantineFailedCounter:                  {metricName: "task.errors.quarantine-failed", metricType: Counter},
 		TaskProcessingLatency:                        {metricName: "task.latency.processing", metricType: Timer},
 		TaskQueueLatency:                             {metricName: "task.latency.queue", metricType: Timer},
 		TaskBatchCompleteCounter:                     {metricName: "task.batch-complete-counter", metricType: Counter},
@@ -1105,6 +1339,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ShardInfoTimerActivePendingTasksTimer:        {metricName: "shardinfo-timer-active-pending-task", metricType: Timer},
 		ShardInfoTimerStandbyPendingTasksTimer:       {metricName: "shardinfo-timer-standby-pending-task", metricType: Timer},
 		ShardInfoReplicationLagTimer:                 {metricName: "shardinfo-replication-lag", metricType: Timer},
+		ShardInfoReplicationRemoteLagTimer:           {metricName: "shardinfo-replication-remote-lag", metricType: Timer},
 		ShardInfoTransferLagTimer:                    {metricName: "shardinfo-transfer-lag", metricType: Timer},
 		ShardInfoTimerLagTimer:                       {metricName: "shardinfo-timer-lag", metricType: Timer},
 		ShardInfoTransferDiffTimer:                   {metricName: "shardinfo-transfer-diff", metricType: Timer},
@@ -1159,6 +1394,11 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		DeleteChildInfoCount:                         {metricName: "delete-child-info", metricType: Timer},
 		DeleteSignalInfoCount:                        {metricName: "delete-signal-info", metricType: Timer},
 		DeleteRequestCancelInfoCount:                 {metricName: "delete-request-cancel-info", metricType: Timer},
+		HistoryMigrationCounter:                      {metricName: "history-migration", metricType: Counter},
+		HistoryMigrationDryRunCounter:                {metricName: "history-migration-dry-run", metricType: Counter},
+		HistoryMigrationSize:                         {metricName: "history-migration-size", metricType: Timer},
+		HistoryMigrationEventCount:                   {metricName: "history-migration-event-count", metricType: Timer},
+		ReplicationTaskLagTimer:                      {metricName: "replication-task-lag", metricType: Timer},
 	},
 	Matching: {
 		PollSuccessCounter:            {metricName: "poll.success"},
@@ -1173,9 +1413,20 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		SyncMatchLatency:              {metricName: "syncmatch.latency", metricType: Timer},
 	},
 	Worker: {
-		ReplicatorMessages: {metricName: "replicator.messages"},
-		ReplicatorFailures: {metricName: "replicator.errors"},
-		ReplicatorLatency:  {metricName: "replicator.latency"},
+		ReplicatorMessages:                 {metricName: "replicator.messages"},
+		ReplicatorFailures:                 {metricName: "replicator.errors"},
+		ReplicatorLatency:                  {metricName: "replicator.latency"},
+		ReplicatorDLQMessages:              {metricName: "replicator.dlq-messages"},
+		ReplicatorDLQFailures:              {metricName: "replicator.dlq-errors"},
+		ReplicatorSkippedBatches:           {metricName: "replicator.skipped-batches"},
+		ReplicatorTaskLag:                  {metricName: "replicator.task-lag", metricType: Timer},
+		ReplicatorHistoryResendCounter:     {metricName: "replicator.history-resend"},
+		ConsistencyCheckerWorkflowsChecked: {metricName: "consistency-checker.checked"},
+		ConsistencyCheckerMismatches:       {metricName: "consistency-checker.mismatches"},
+		ConsistencyCheckerFailures:         {metricName: "consistency-checker.errors"},
+		DomainDeletionExecutionsDeleted:    {metricName: "domain-deletion.executions-deleted"},
+		DomainDeletionTaskListsDeleted:     {metricName: "domain-deletion.task-lists-deleted"},
+		DomainDeletionFailures:             {metricName: "domain-deletion.errors"},
 	},
 }
 
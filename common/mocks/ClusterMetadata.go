@@ -20,7 +20,10 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	mock "github.com/stretchr/testify/mock"
+	"github.com/uber/cadence/common/service/config"
+)
 
 // ClusterMetadata is an autogenerated mock type for the Metadata type
 type ClusterMetadata struct {
@@ -113,6 +116,22 @@ func (_m *ClusterMetadata) IsVersionFromSameCluster(_a0 int64, _a1 int64) bool {
 	return r0
 }
 
+// GetReplicationConsumerConfig provides a mock function with given fields: clusterName
+func (_m *ClusterMetadata) GetReplicationConsumerConfig(clusterName string) *config.ReplicationConsumerConfig {
+	ret := _m.Called(clusterName)
+
+	var r0 *config.ReplicationConsumerConfig
+	if rf, ok := ret.Get(0).(func(string) *config.ReplicationConsumerConfig); ok {
+		r0 = rf(clusterName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*config.ReplicationConsumerConfig)
+		}
+	}
+
+	return r0
+}
+
 // IsGlobalDomainEnabled provides a mock function with given fields:
 func (_m *ClusterMetadata) IsGlobalDomainEnabled() bool {
 	ret := _m.Called()
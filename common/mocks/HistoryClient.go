@@ -28,6 +28,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/history/historyserviceclient"
+	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 )
 
@@ -84,6 +85,75 @@ func (_m *HistoryClient) DescribeMutableState(ctx context.Context, request *hist
 	return r0, r1
 }
 
+// MigrateWorkflowHistory provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) MigrateWorkflowHistory(ctx context.Context, request *history.MigrateWorkflowHistoryRequest, opts ...yarpc.CallOption) (*history.MigrateWorkflowHistoryResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *history.MigrateWorkflowHistoryResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *history.MigrateWorkflowHistoryRequest) *history.MigrateWorkflowHistoryResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*history.MigrateWorkflowHistoryResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *history.MigrateWorkflowHistoryRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWorkflowReplicationTasks provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) GetWorkflowReplicationTasks(ctx context.Context, request *history.GetWorkflowReplicationTasksRequest, opts ...yarpc.CallOption) (*history.GetWorkflowReplicationTasksResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *history.GetWorkflowReplicationTasksResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *history.GetWorkflowReplicationTasksRequest) *history.GetWorkflowReplicationTasksResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*history.GetWorkflowReplicationTasksResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *history.GetWorkflowReplicationTasksRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteWorkflowExecution provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) DeleteWorkflowExecution(ctx context.Context, request *history.DeleteWorkflowExecutionRequest, opts ...yarpc.CallOption) (*history.DeleteWorkflowExecutionResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *history.DeleteWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *history.DeleteWorkflowExecutionRequest) *history.DeleteWorkflowExecutionResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*history.DeleteWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *history.DeleteWorkflowExecutionRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMutableState provides a mock function with given fields: ctx, getRequest
 func (_m *HistoryClient) GetMutableState(ctx context.Context, getRequest *history.GetMutableStateRequest, opts ...yarpc.CallOption) (*history.GetMutableStateResponse, error) {
 	ret := _m.Called(ctx, getRequest)
@@ -405,6 +475,76 @@ func (_m *HistoryClient) TerminateWorkflowExecution(ctx context.Context, termina
 	return r0
 }
 
+// PauseWorkflowExecution provides a mock function with given fields: ctx, pauseRequest
+func (_m *HistoryClient) PauseWorkflowExecution(ctx context.Context, pauseRequest *history.PauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, pauseRequest)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *history.PauseWorkflowExecutionRequest) error); ok {
+		r0 = rf(ctx, pauseRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResumeWorkflowExecution provides a mock function with given fields: ctx, resumeRequest
+func (_m *HistoryClient) ResumeWorkflowExecution(ctx context.Context, resumeRequest *history.ResumeWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, resumeRequest)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *history.ResumeWorkflowExecutionRequest) error); ok {
+		r0 = rf(ctx, resumeRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RetryActivityTask provides a mock function with given fields: ctx, retryRequest
+func (_m *HistoryClient) RetryActivityTask(ctx context.Context, retryRequest *history.RetryActivityTaskRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, retryRequest)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *history.RetryActivityTaskRequest) error); ok {
+		r0 = rf(ctx, retryRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CompleteActivityTask provides a mock function with given fields: ctx, completeRequest
+func (_m *HistoryClient) CompleteActivityTask(ctx context.Context, completeRequest *history.CompleteActivityTaskRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, completeRequest)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *history.CompleteActivityTaskRequest) error); ok {
+		r0 = rf(ctx, completeRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FailActivityTask provides a mock function with given fields: ctx, failRequest
+func (_m *HistoryClient) FailActivityTask(ctx context.Context, failRequest *history.FailActivityTaskRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, failRequest)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *history.FailActivityTaskRequest) error); ok {
+		r0 = rf(ctx, failRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ScheduleDecisionTask provides a mock function with given fields: ctx, request
 func (_m *HistoryClient) ScheduleDecisionTask(ctx context.Context, request *history.ScheduleDecisionTaskRequest, opts ...yarpc.CallOption) error {
 	ret := _m.Called(ctx, request)
@@ -474,3 +614,188 @@ func (_m *HistoryClient) SyncActivity(ctx context.Context, request *history.Sync
 
 	return r0
 }
+
+// GetReplicationMessages provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) GetReplicationMessages(ctx context.Context, request *replicator.GetReplicationMessagesRequest, opts ...yarpc.CallOption) (*replicator.GetReplicationMessagesResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *replicator.GetReplicationMessagesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *replicator.GetReplicationMessagesRequest) *replicator.GetReplicationMessagesResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*replicator.GetReplicationMessagesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *replicator.GetReplicationMessagesRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReplicationStatus provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) GetReplicationStatus(ctx context.Context, request *history.GetReplicationStatusRequest, opts ...yarpc.CallOption) (*history.GetReplicationStatusResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *history.GetReplicationStatusResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *history.GetReplicationStatusRequest) *history.GetReplicationStatusResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*history.GetReplicationStatusResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *history.GetReplicationStatusRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeShard provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) DescribeShard(ctx context.Context, request *history.DescribeShardRequest, opts ...yarpc.CallOption) (*history.DescribeShardResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *history.DescribeShardResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *history.DescribeShardRequest) *history.DescribeShardResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*history.DescribeShardResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *history.DescribeShardRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListShardTasks provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) ListShardTasks(ctx context.Context, request *history.ListShardTasksRequest, opts ...yarpc.CallOption) (*history.ListShardTasksResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *history.ListShardTasksResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *history.ListShardTasksRequest) *history.ListShardTasksResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*history.ListShardTasksResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *history.ListShardTasksRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveShardTask provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) RemoveShardTask(ctx context.Context, request *history.RemoveShardTaskRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *history.RemoveShardTaskRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RescheduleShardTask provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) RescheduleShardTask(ctx context.Context, request *history.RescheduleShardTaskRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *history.RescheduleShardTaskRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListQuarantinedTasks provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) ListQuarantinedTasks(ctx context.Context, request *history.ListQuarantinedTasksRequest, opts ...yarpc.CallOption) (*history.ListQuarantinedTasksResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *history.ListQuarantinedTasksResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *history.ListQuarantinedTasksRequest) *history.ListQuarantinedTasksResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*history.ListQuarantinedTasksResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *history.ListQuarantinedTasksRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RetryQuarantinedTask provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) RetryQuarantinedTask(ctx context.Context, request *history.RetryQuarantinedTaskRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *history.RetryQuarantinedTaskRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DropQuarantinedTask provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) DropQuarantinedTask(ctx context.Context, request *history.DropQuarantinedTaskRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *history.DropQuarantinedTaskRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CloseShard provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) CloseShard(ctx context.Context, request *history.CloseShardRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *history.CloseShardRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
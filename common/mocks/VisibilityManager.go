@@ -47,6 +47,20 @@ func (_m *VisibilityManager) Close() {
 	_m.Called()
 }
 
+// DeleteWorkflowExecutionsByDomain provides a mock function with given fields: request
+func (_m *VisibilityManager) DeleteWorkflowExecutionsByDomain(request *persistence.DeleteWorkflowExecutionsByDomainRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.DeleteWorkflowExecutionsByDomainRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetClosedWorkflowExecution provides a mock function with given fields: request
 func (_m *VisibilityManager) GetClosedWorkflowExecution(request *persistence.GetClosedWorkflowExecutionRequest) (*persistence.GetClosedWorkflowExecutionResponse, error) {
 	ret := _m.Called(request)
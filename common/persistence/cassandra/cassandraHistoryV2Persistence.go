@@ -164,6 +164,7 @@ func (h *cassandraHistoryV2Persistence) ReadHistoryBranch(request *p.InternalRea
 	pagingToken := iter.PageState()
 
 	history := make([]*p.DataBlob, 0, int(request.PageSize))
+	nodeIDs := make([]int64, 0, int(request.PageSize))
 	lastNodeID := int64(-1)
 	lastTxnID := int64(-1)
 	eventBlob := &p.DataBlob{}
@@ -184,6 +185,7 @@ func (h *cassandraHistoryV2Persistence) ReadHistoryBranch(request *p.InternalRea
 		lastTxnID = txnID
 		lastNodeID = nodeID
 		history = append(history, eventBlob)
+		nodeIDs = append(nodeIDs, nodeID)
 		eventBlob = &p.DataBlob{}
 	}
 
@@ -195,6 +197,7 @@ func (h *cassandraHistoryV2Persistence) ReadHistoryBranch(request *p.InternalRea
 
 	response := &p.InternalReadHistoryBranchResponse{
 		History:       history,
+		NodeIDs:       nodeIDs,
 		NextPageToken: pagingToken,
 	}
 
@@ -46,7 +46,9 @@ const (
 		`config_version, ` +
 		`failover_version, ` +
 		`failover_notification_version, ` +
-		`notification_version ` +
+		`notification_version, ` +
+		`pending_active_cluster_name, ` +
+		`failover_end_time ` +
 		`FROM domains_by_name_v2 ` +
 		`WHERE domains_partition = ? ` +
 		`and name = ?`
@@ -58,7 +60,9 @@ const (
 		`config_version = ? ,` +
 		`failover_version = ? ,` +
 		`failover_notification_version = ? , ` +
-		`notification_version = ? ` +
+		`notification_version = ? , ` +
+		`pending_active_cluster_name = ? , ` +
+		`failover_end_time = ? ` +
 		`WHERE domains_partition = ? ` +
 		`and name = ?`
 
@@ -84,7 +88,9 @@ const (
 		`config_version, ` +
 		`failover_version, ` +
 		`failover_notification_version, ` +
-		`notification_version ` +
+		`notification_version, ` +
+		`pending_active_cluster_name, ` +
+		`failover_end_time ` +
 		`FROM domains_by_name_v2 ` +
 		`WHERE domains_partition = ? `
 )
@@ -220,6 +226,8 @@ func (m *cassandraMetadataPersistenceV2) UpdateDomain(request *p.UpdateDomainReq
 		request.FailoverVersion,
 		request.FailoverNotificationVersion,
 		request.NotificationVersion,
+		request.PendingActiveClusterName,
+		request.FailoverEndTime,
 		constDomainPartition,
 		request.Info.Name,
 	)
@@ -259,6 +267,8 @@ func (m *cassandraMetadataPersistenceV2) GetDomain(request *p.GetDomainRequest)
 	var failoverVersion int64
 	var configVersion int64
 	var isGlobalDomain bool
+	var pendingActiveClusterName string
+	var failoverEndTime int64
 
 	if len(request.ID) > 0 && len(request.Name) > 0 {
 		return nil, &workflow.BadRequestError{
@@ -311,6 +321,8 @@ func (m *cassandraMetadataPersistenceV2) GetDomain(request *p.GetDomainRequest)
 		&failoverVersion,
 		&failoverNotificationVersion,
 		&notificationVersion,
+		&pendingActiveClusterName,
+		&failoverEndTime,
 	)
 
 	if err != nil {
@@ -331,6 +343,8 @@ func (m *cassandraMetadataPersistenceV2) GetDomain(request *p.GetDomainRequest)
 		FailoverNotificationVersion: failoverNotificationVersion,
 		NotificationVersion:         notificationVersion,
 		TableVersion:                p.DomainTableVersionV2,
+		PendingActiveClusterName:    pendingActiveClusterName,
+		FailoverEndTime:             failoverEndTime,
 	}, nil
 }
 
@@ -361,6 +375,7 @@ func (m *cassandraMetadataPersistenceV2) ListDomains(request *p.ListDomainsReque
 		&domain.ReplicationConfig.ActiveClusterName, &replicationClusters,
 		&domain.IsGlobalDomain, &domain.ConfigVersion, &domain.FailoverVersion,
 		&domain.FailoverNotificationVersion, &domain.NotificationVersion,
+		&domain.PendingActiveClusterName, &domain.FailoverEndTime,
 	) {
 		if name != domainMetadataRecordName {
 			// do not inlcude the metadata record
@@ -109,6 +109,7 @@ const (
 		`timer_ack_level: ?, ` +
 		`cluster_transfer_ack_level: ?, ` +
 		`cluster_timer_ack_level: ?, ` +
+		`cluster_replication_level: ?, ` +
 		`domain_notification_version: ? ` +
 		`}`
 
@@ -160,7 +161,10 @@ const (
 		`non_retriable_errors: ?, ` +
 		`event_store_version: ?, ` +
 		`current_reset_version: ?, ` +
-		`history_branches: ? ` +
+		`history_branches: ?, ` +
+		`paused: ?, ` +
+		`has_decision_backoff: ?, ` +
+		`has_events_v1_history: ? ` +
 		`}`
 
 	templateReplicationStateType = `{` +
@@ -705,6 +709,9 @@ workflow_state = ? ` +
 		`and visibility_ts = ? ` +
 		`and task_id = ? `
 
+	templateDeleteWorkflowExecutionCurrentRowQuery = templateDeleteWorkflowExecutionMutableStateQuery +
+		`IF current_run_id = ? `
+
 	templateDeleteWorkflowExecutionSignalRequestedQuery = `UPDATE executions ` +
 		`SET signal_requested = signal_requested - ? ` +
 		`WHERE shard_id = ? ` +
@@ -809,6 +816,11 @@ workflow_state = ? ` +
 		`and type = ? ` +
 		`and task_id = ?`
 
+	templateDeleteTaskListQuery = `DELETE FROM tasks ` +
+		`WHERE domain_id = ? ` +
+		`and task_list_name = ? ` +
+		`and task_list_type = ?`
+
 	templateGetTaskList = `SELECT ` +
 		`range_id, ` +
 		`task_list ` +
@@ -947,6 +959,7 @@ func (d *cassandraPersistence) CreateShard(request *p.CreateShardRequest) error
 		shardInfo.TimerAckLevel,
 		shardInfo.ClusterTransferAckLevel,
 		shardInfo.ClusterTimerAckLevel,
+		shardInfo.ClusterReplicationLevel,
 		shardInfo.DomainNotificationVersion,
 		shardInfo.RangeID)
 
@@ -1022,6 +1035,7 @@ func (d *cassandraPersistence) UpdateShard(request *p.UpdateShardRequest) error
 		shardInfo.TimerAckLevel,
 		shardInfo.ClusterTransferAckLevel,
 		shardInfo.ClusterTimerAckLevel,
+		shardInfo.ClusterReplicationLevel,
 		shardInfo.DomainNotificationVersion,
 		shardInfo.RangeID,
 		shardInfo.ShardID,
@@ -1362,6 +1376,9 @@ func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *p.Cre
 			request.EventStoreVersion,
 			initialResetVersion,
 			historyBranches,
+			false, // paused
+			request.HasDecisionBackoff,
+			false, // has events v1 history
 			request.NextEventID,
 			defaultVisibilityTimestamp,
 			rowTypeExecutionTaskID)
@@ -1425,6 +1442,9 @@ func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *p.Cre
 			request.EventStoreVersion,
 			initialResetVersion,
 			historyBranches,
+			false, // paused
+			request.HasDecisionBackoff,
+			false, // has events v1 history
 			request.ReplicationState.CurrentVersion,
 			request.ReplicationState.StartVersion,
 			request.ReplicationState.LastWriteVersion,
@@ -1611,6 +1631,9 @@ func (d *cassandraPersistence) UpdateWorkflowExecution(request *p.InternalUpdate
 			executionInfo.EventStoreVersion,
 			executionInfo.CurrentResetVersion,
 			historyBranches,
+			executionInfo.Paused,
+			executionInfo.HasDecisionBackoff,
+			executionInfo.HasEventsV1History,
 			executionInfo.NextEventID,
 			d.shardID,
 			rowTypeExecution,
@@ -1675,6 +1698,9 @@ func (d *cassandraPersistence) UpdateWorkflowExecution(request *p.InternalUpdate
 			executionInfo.EventStoreVersion,
 			executionInfo.CurrentResetVersion,
 			historyBranches,
+			executionInfo.Paused,
+			executionInfo.HasDecisionBackoff,
+			executionInfo.HasEventsV1History,
 			replicationState.CurrentVersion,
 			replicationState.StartVersion,
 			replicationState.LastWriteVersion,
@@ -1735,6 +1761,8 @@ func (d *cassandraPersistence) UpdateWorkflowExecution(request *p.InternalUpdate
 		d.CreateWorkflowExecutionWithinBatch(startReq, batch, cqlNowTimestamp)
 		d.createTransferTasks(batch, startReq.TransferTasks, startReq.DomainID, startReq.Execution.GetWorkflowId(),
 			startReq.Execution.GetRunId())
+		d.createReplicationTasks(batch, startReq.ReplicationTasks, startReq.DomainID, startReq.Execution.GetWorkflowId(),
+			startReq.Execution.GetRunId())
 		d.createTimerTasks(batch, startReq.TimerTasks, nil, startReq.DomainID, startReq.Execution.GetWorkflowId(),
 			startReq.Execution.GetRunId(), cqlNowTimestamp)
 	} else {
@@ -1932,6 +1960,9 @@ func (d *cassandraPersistence) ResetMutableState(request *p.InternalResetMutable
 		executionInfo.EventStoreVersion,
 		executionInfo.CurrentResetVersion,
 		historyBranches,
+		executionInfo.Paused,
+		executionInfo.HasDecisionBackoff,
+		executionInfo.HasEventsV1History,
 		replicationState.CurrentVersion,
 		replicationState.StartVersion,
 		replicationState.LastWriteVersion,
@@ -2120,6 +2151,34 @@ func (d *cassandraPersistence) DeleteWorkflowExecution(request *p.DeleteWorkflow
 	return nil
 }
 
+func (d *cassandraPersistence) DeleteCurrentWorkflowExecution(request *p.DeleteCurrentWorkflowExecutionRequest) error {
+	query := d.session.Query(templateDeleteWorkflowExecutionCurrentRowQuery,
+		d.shardID,
+		rowTypeExecution,
+		request.DomainID,
+		request.WorkflowID,
+		permanentRunID,
+		defaultVisibilityTimestamp,
+		rowTypeExecutionTaskID,
+		request.RunID)
+
+	// the row is left alone if it points to another run, or does not exist anymore
+	previous := make(map[string]interface{})
+	_, err := query.MapScanCAS(previous)
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("DeleteCurrentWorkflowExecution operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteCurrentWorkflowExecution operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
+
 func (d *cassandraPersistence) GetCurrentExecution(request *p.GetCurrentExecutionRequest) (*p.GetCurrentExecutionResponse,
 	error) {
 	query := d.session.Query(templateGetCurrentExecutionQuery,
@@ -2686,6 +2745,29 @@ func (d *cassandraPersistence) CompleteTask(request *p.CompleteTaskRequest) erro
 	return nil
 }
 
+// From TaskManager interface
+func (d *cassandraPersistence) DeleteTaskList(request *p.DeleteTaskListRequest) error {
+	// the task list row and its tasks share the same partition
+	query := d.session.Query(templateDeleteTaskListQuery,
+		request.DomainID,
+		request.TaskList,
+		request.TaskType)
+
+	err := query.Exec()
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("DeleteTaskList operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteTaskList operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
+
 func (d *cassandraPersistence) GetTimerIndexTasks(request *p.GetTimerIndexTasksRequest) (*p.GetTimerIndexTasksResponse,
 	error) {
 	// Reading timer tasks need to be quorum level consistent, otherwise we could loose task
@@ -3420,6 +3502,8 @@ func createShardInfo(currentCluster string, result map[string]interface{}) *p.Sh
 			info.ClusterTransferAckLevel = v.(map[string]int64)
 		case "cluster_timer_ack_level":
 			info.ClusterTimerAckLevel = v.(map[string]time.Time)
+		case "cluster_replication_level":
+			info.ClusterReplicationLevel = v.(map[string]int64)
 		case "domain_notification_version":
 			info.DomainNotificationVersion = v.(int64)
 		}
@@ -3435,6 +3519,9 @@ func createShardInfo(currentCluster string, result map[string]interface{}) *p.Sh
 			currentCluster: info.TimerAckLevel,
 		}
 	}
+	if info.ClusterReplicationLevel == nil {
+		info.ClusterReplicationLevel = make(map[string]int64)
+	}
 
 	return info
 }
@@ -3541,6 +3628,12 @@ func createWorkflowExecutionInfo(result map[string]interface{}) *p.InternalWorkf
 			info.CurrentResetVersion = int32(v.(int))
 		case "history_branches":
 			info.HistoryBranches = deserializeHistoryBranch(v.(map[int]map[string]interface{}))
+		case "paused":
+			info.Paused = v.(bool)
+		case "has_decision_backoff":
+			info.HasDecisionBackoff = v.(bool)
+		case "has_events_v1_history":
+			info.HasEventsV1History = v.(bool)
 		}
 	}
 	info.CompletionEvent = p.NewDataBlob(completionEventData, completionEventEncoding)
@@ -0,0 +1,239 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/uber-common/bark"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	templateQuarantinedTaskColumns = `task_id, visibility_ts, domain_id, workflow_id, run_id, task_type, attempt, ` +
+		`created_time, error, stack_trace, data, data_encoding`
+
+	templatePutQuarantinedTaskQuery = `INSERT INTO quarantined_tasks (` +
+		`shard_id, queue_type, ` + templateQuarantinedTaskColumns + `) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	templateGetQuarantinedTasksQuery = `SELECT ` + templateQuarantinedTaskColumns + ` FROM quarantined_tasks ` +
+		`WHERE shard_id = ? ` +
+		`AND queue_type = ?`
+
+	templateGetQuarantinedTaskQuery = templateGetQuarantinedTasksQuery +
+		` AND task_id = ? ` +
+		`AND visibility_ts = ?`
+
+	templateDeleteQuarantinedTaskQuery = `DELETE FROM quarantined_tasks ` +
+		`WHERE shard_id = ? ` +
+		`AND queue_type = ? ` +
+		`AND task_id = ? ` +
+		`AND visibility_ts = ?`
+)
+
+type (
+	cassandraQuarantinePersistence struct {
+		cassandraStore
+	}
+)
+
+// newQuarantinePersistence is used to create an instance of QuarantineManager implementation
+func newQuarantinePersistence(cfg config.Cassandra, logger bark.Logger) (p.QuarantineStore, error) {
+	cluster := NewCassandraCluster(cfg.Hosts, cfg.Port, cfg.User, cfg.Password, cfg.Datacenter)
+	cluster.Keyspace = cfg.Keyspace
+	cluster.ProtoVersion = cassandraProtoVersion
+	cluster.Consistency = gocql.LocalQuorum
+	cluster.SerialConsistency = gocql.LocalSerial
+	cluster.Timeout = defaultSessionTimeout
+	session, err := cluster.CreateSession()
+	if err != nil {
+		return nil, err
+	}
+
+	return &cassandraQuarantinePersistence{cassandraStore: cassandraStore{session: session, logger: logger}}, nil
+}
+
+// Close gracefully releases the resources held by this object
+func (d *cassandraQuarantinePersistence) Close() {
+	if d.session != nil {
+		d.session.Close()
+	}
+}
+
+func (d *cassandraQuarantinePersistence) PutQuarantinedTask(request *p.PutQuarantinedTaskRequest) error {
+	task := request.TaskInfo
+	query := d.session.Query(templatePutQuarantinedTaskQuery,
+		task.ShardID,
+		task.QueueType,
+		task.TaskID,
+		task.VisibilityTimestamp,
+		task.DomainID,
+		task.WorkflowID,
+		task.RunID,
+		task.TaskType,
+		task.Attempt,
+		task.CreatedTime,
+		task.Error,
+		task.StackTrace,
+		task.Data,
+		task.Encoding)
+
+	err := query.Exec()
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("PutQuarantinedTask operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("PutQuarantinedTask operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
+
+func (d *cassandraQuarantinePersistence) GetQuarantinedTasks(
+	request *p.GetQuarantinedTasksRequest) (*p.GetQuarantinedTasksResponse, error) {
+
+	query := d.session.Query(templateGetQuarantinedTasksQuery,
+		request.ShardID,
+		request.QueueType,
+	).PageSize(request.BatchSize).PageState(request.NextPageToken)
+
+	iter := query.Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "GetQuarantinedTasks operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	response := &p.GetQuarantinedTasksResponse{}
+	for {
+		task := &p.QuarantinedTaskInfo{
+			ShardID:   request.ShardID,
+			QueueType: request.QueueType,
+		}
+		if !scanQuarantinedTask(iter, task) {
+			break
+		}
+		response.Tasks = append(response.Tasks, task)
+	}
+	nextPageToken := iter.PageState()
+	response.NextPageToken = make([]byte, len(nextPageToken))
+	copy(response.NextPageToken, nextPageToken)
+
+	if err := iter.Close(); err != nil {
+		if isThrottlingError(err) {
+			return nil, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("GetQuarantinedTasks operation failed. Error: %v", err),
+			}
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetQuarantinedTasks operation failed. Error: %v", err),
+		}
+	}
+
+	return response, nil
+}
+
+func (d *cassandraQuarantinePersistence) GetQuarantinedTask(
+	request *p.GetQuarantinedTaskRequest) (*p.GetQuarantinedTaskResponse, error) {
+
+	query := d.session.Query(templateGetQuarantinedTaskQuery,
+		request.ShardID,
+		request.QueueType,
+		request.TaskID,
+		request.VisibilityTimestamp)
+
+	iter := query.Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "GetQuarantinedTask operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	task := &p.QuarantinedTaskInfo{
+		ShardID:   request.ShardID,
+		QueueType: request.QueueType,
+	}
+	found := scanQuarantinedTask(iter, task)
+	if err := iter.Close(); err != nil {
+		if isThrottlingError(err) {
+			return nil, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("GetQuarantinedTask operation failed. Error: %v", err),
+			}
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetQuarantinedTask operation failed. Error: %v", err),
+		}
+	}
+
+	if !found {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Quarantined task not found.  ShardId: %v, TaskId: %v", request.ShardID, request.TaskID),
+		}
+	}
+
+	return &p.GetQuarantinedTaskResponse{TaskInfo: task}, nil
+}
+
+func (d *cassandraQuarantinePersistence) DeleteQuarantinedTask(request *p.DeleteQuarantinedTaskRequest) error {
+	query := d.session.Query(templateDeleteQuarantinedTaskQuery,
+		request.ShardID,
+		request.QueueType,
+		request.TaskID,
+		request.VisibilityTimestamp)
+
+	err := query.Exec()
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("DeleteQuarantinedTask operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteQuarantinedTask operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
+
+func scanQuarantinedTask(iter *gocql.Iter, task *p.QuarantinedTaskInfo) bool {
+	var visibilityTimestamp time.Time
+	var createdTime time.Time
+	var encoding string
+	if !iter.Scan(&task.TaskID, &visibilityTimestamp, &task.DomainID, &task.WorkflowID, &task.RunID, &task.TaskType,
+		&task.Attempt, &createdTime, &task.Error, &task.StackTrace, &task.Data, &encoding) {
+		return false
+	}
+	task.VisibilityTimestamp = visibilityTimestamp
+	task.CreatedTime = createdTime
+	task.Encoding = common.EncodingType(encoding)
+	return true
+}
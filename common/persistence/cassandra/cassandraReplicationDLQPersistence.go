@@ -0,0 +1,213 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/uber-common/bark"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	templatePutReplicationTaskToDLQQuery = `INSERT INTO replication_dlq (` +
+		`source_cluster, shard_id, task_id, created_time, data, data_encoding) ` +
+		`VALUES (?, ?, ?, ?, ?, ?) IF NOT EXISTS`
+
+	templateGetReplicationTasksFromDLQQuery = `SELECT task_id, created_time, data, data_encoding FROM replication_dlq ` +
+		`WHERE source_cluster = ? ` +
+		`AND shard_id = ? ` +
+		`AND task_id > ? ` +
+		`AND task_id <= ?`
+
+	templateDeleteReplicationTaskFromDLQQuery = `DELETE FROM replication_dlq ` +
+		`WHERE source_cluster = ? ` +
+		`AND shard_id = ? ` +
+		`AND task_id = ?`
+
+	templateRangeDeleteReplicationTaskFromDLQQuery = `DELETE FROM replication_dlq ` +
+		`WHERE source_cluster = ? ` +
+		`AND shard_id = ? ` +
+		`AND task_id > ? ` +
+		`AND task_id <= ?`
+)
+
+type (
+	cassandraReplicationDLQPersistence struct {
+		cassandraStore
+	}
+)
+
+// newReplicationDLQPersistence is used to create an instance of ReplicationDLQManager implementation
+func newReplicationDLQPersistence(cfg config.Cassandra, logger bark.Logger) (p.ReplicationDLQStore, error) {
+	cluster := NewCassandraCluster(cfg.Hosts, cfg.Port, cfg.User, cfg.Password, cfg.Datacenter)
+	cluster.Keyspace = cfg.Keyspace
+	cluster.ProtoVersion = cassandraProtoVersion
+	cluster.Consistency = gocql.LocalQuorum
+	cluster.SerialConsistency = gocql.LocalSerial
+	cluster.Timeout = defaultSessionTimeout
+	session, err := cluster.CreateSession()
+	if err != nil {
+		return nil, err
+	}
+
+	return &cassandraReplicationDLQPersistence{cassandraStore: cassandraStore{session: session, logger: logger}}, nil
+}
+
+// Close gracefully releases the resources held by this object
+func (d *cassandraReplicationDLQPersistence) Close() {
+	if d.session != nil {
+		d.session.Close()
+	}
+}
+
+func (d *cassandraReplicationDLQPersistence) PutReplicationTaskToDLQ(request *p.PutReplicationTaskToDLQRequest) error {
+	task := request.TaskInfo
+	query := d.session.Query(templatePutReplicationTaskToDLQQuery,
+		task.SourceCluster,
+		task.ShardID,
+		task.TaskID,
+		task.CreatedTime,
+		task.Data,
+		task.Encoding)
+
+	previous := make(map[string]interface{})
+	applied, err := query.MapScanCAS(previous)
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("PutReplicationTaskToDLQ operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("PutReplicationTaskToDLQ operation failed. Error: %v", err),
+		}
+	}
+
+	if !applied {
+		return &p.ConditionFailedError{
+			Msg: fmt.Sprintf("PutReplicationTaskToDLQ operation failed. Task with ID %v already exists.", task.TaskID),
+		}
+	}
+
+	return nil
+}
+
+func (d *cassandraReplicationDLQPersistence) GetReplicationTasksFromDLQ(
+	request *p.GetReplicationTasksFromDLQRequest) (*p.GetReplicationTasksFromDLQResponse, error) {
+
+	query := d.session.Query(templateGetReplicationTasksFromDLQQuery,
+		request.SourceCluster,
+		request.ShardID,
+		request.ReadLevel,
+		request.MaxReadLevel,
+	).PageSize(request.BatchSize).PageState(request.NextPageToken)
+
+	iter := query.Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "GetReplicationTasksFromDLQ operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	response := &p.GetReplicationTasksFromDLQResponse{}
+	var taskID int64
+	var createdTime time.Time
+	var data []byte
+	var encoding string
+	for iter.Scan(&taskID, &createdTime, &data, &encoding) {
+		response.Tasks = append(response.Tasks, &p.ReplicationDLQTaskInfo{
+			SourceCluster: request.SourceCluster,
+			ShardID:       request.ShardID,
+			TaskID:        taskID,
+			CreatedTime:   createdTime,
+			Data:          data,
+			Encoding:      common.EncodingType(encoding),
+		})
+		data = nil
+	}
+	nextPageToken := iter.PageState()
+	response.NextPageToken = make([]byte, len(nextPageToken))
+	copy(response.NextPageToken, nextPageToken)
+
+	if err := iter.Close(); err != nil {
+		if isThrottlingError(err) {
+			return nil, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("GetReplicationTasksFromDLQ operation failed. Error: %v", err),
+			}
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetReplicationTasksFromDLQ operation failed. Error: %v", err),
+		}
+	}
+
+	return response, nil
+}
+
+func (d *cassandraReplicationDLQPersistence) DeleteReplicationTaskFromDLQ(request *p.DeleteReplicationTaskFromDLQRequest) error {
+	query := d.session.Query(templateDeleteReplicationTaskFromDLQQuery,
+		request.SourceCluster,
+		request.ShardID,
+		request.TaskID)
+
+	err := query.Exec()
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("DeleteReplicationTaskFromDLQ operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteReplicationTaskFromDLQ operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
+
+func (d *cassandraReplicationDLQPersistence) RangeDeleteReplicationTaskFromDLQ(
+	request *p.RangeDeleteReplicationTaskFromDLQRequest) error {
+
+	query := d.session.Query(templateRangeDeleteReplicationTaskFromDLQQuery,
+		request.SourceCluster,
+		request.ShardID,
+		request.ExclusiveBeginTaskID,
+		request.InclusiveEndTaskID)
+
+	err := query.Exec()
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("RangeDeleteReplicationTaskFromDLQ operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("RangeDeleteReplicationTaskFromDLQ operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
@@ -132,6 +132,18 @@ const (
 		`AND domain_partition = ? ` +
 		`AND workflow_id = ? ` +
 		`AND run_id = ? ALLOW FILTERING `
+
+	templateDeleteOpenWorkflowExecutionsByDomain = `DELETE FROM open_executions ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ?`
+
+	templateDeleteClosedWorkflowExecutionsByDomain = `DELETE FROM closed_executions ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ?`
+
+	templateDeleteClosedWorkflowExecutionsByDomainV2 = `DELETE FROM closed_executions_v2 ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ?`
 )
 
 type (
@@ -620,6 +632,28 @@ func (v *cassandraVisibilityPersistence) GetClosedWorkflowExecution(
 	}, nil
 }
 
+// DeleteWorkflowExecutionsByDomain drops the whole domain partition of the open and closed execution tables
+func (v *cassandraVisibilityPersistence) DeleteWorkflowExecutionsByDomain(
+	request *p.DeleteWorkflowExecutionsByDomainRequest) error {
+	batch := v.session.NewBatch(gocql.LoggedBatch)
+	batch.Query(templateDeleteOpenWorkflowExecutionsByDomain, request.DomainUUID, domainPartition)
+	batch.Query(templateDeleteClosedWorkflowExecutionsByDomain, request.DomainUUID, domainPartition)
+	batch.Query(templateDeleteClosedWorkflowExecutionsByDomainV2, request.DomainUUID, domainPartition)
+
+	err := v.session.ExecuteBatch(batch)
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("DeleteWorkflowExecutionsByDomain operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteWorkflowExecutionsByDomain operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
 func readOpenWorkflowExecutionRecord(iter *gocql.Iter) (*workflow.WorkflowExecutionInfo, bool) {
 	var workflowID string
 	var runID gocql.UUID
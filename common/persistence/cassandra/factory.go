@@ -103,6 +103,16 @@ func (f *Factory) NewVisibilityStore() (p.VisibilityStore, error) {
 	return newVisibilityPersistence(f.cfg, f.logger)
 }
 
+// NewReplicationDLQStore returns a replication DLQ store
+func (f *Factory) NewReplicationDLQStore() (p.ReplicationDLQStore, error) {
+	return newReplicationDLQPersistence(f.cfg, f.logger)
+}
+
+// NewQuarantineStore returns a task quarantine store
+func (f *Factory) NewQuarantineStore() (p.QuarantineStore, error) {
+	return newQuarantinePersistence(f.cfg, f.logger)
+}
+
 // Close closes the factory
 func (f *Factory) Close() {
 	f.Lock()
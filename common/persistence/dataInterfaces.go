@@ -118,6 +118,12 @@ const (
 	TaskTypeWorkflowRetryTimer
 )
 
+// Types of queues a quarantined task can come from
+const (
+	QuarantineQueueTypeTransfer = iota
+	QuarantineQueueTypeTimer
+)
+
 const (
 	// InitialFailoverNotificationVersion is the initial failover version for a domain
 	InitialFailoverNotificationVersion int64 = 0
@@ -184,6 +190,7 @@ type (
 		TimerAckLevel             time.Time
 		ClusterTransferAckLevel   map[string]int64
 		ClusterTimerAckLevel      map[string]time.Time
+		ClusterReplicationLevel   map[string]int64                 // cluster -> last replication task processed by the cluster
 		TransferFailoverLevels    map[string]TransferFailoverLevel // uuid -> TransferFailoverLevel
 		TimerFailoverLevels       map[string]TimerFailoverLevel    // uuid -> TimerFailoverLevel
 		DomainNotificationVersion int64
@@ -238,8 +245,11 @@ type (
 		DecisionTimeout              int32
 		DecisionAttempt              int64
 		DecisionTimestamp            int64
+		HasDecisionBackoff           bool // the first decision is scheduled by a backoff timer
+		HasEventsV1History           bool // the execution is migrated to events v2, its events v1 history is deleted by retention
 		CancelRequested              bool
 		CancelRequestID              string
+		Paused                       bool
 		StickyTaskList               string
 		StickyScheduleToStartTimeout int32
 		ClientLibraryVersion         string
@@ -646,6 +656,7 @@ type (
 		DecisionScheduleID          int64
 		DecisionStartedID           int64
 		DecisionStartToCloseTimeout int32
+		HasDecisionBackoff          bool
 		CreateWorkflowMode          int
 		PreviousRunID               string
 		PreviousLastWriteVersion    int64
@@ -755,6 +766,14 @@ type (
 		RunID      string
 	}
 
+	// DeleteCurrentWorkflowExecutionRequest is used to delete the current workflow execution row of a workflow id,
+	// the row is only deleted while it points to the given run
+	DeleteCurrentWorkflowExecutionRequest struct {
+		DomainID   string
+		WorkflowID string
+		RunID      string
+	}
+
 	// GetTransferTasksRequest is used to read tasks from the transfer task queue
 	GetTransferTasksRequest struct {
 		ReadLevel     int64
@@ -799,6 +818,113 @@ type (
 		TaskID int64
 	}
 
+	// ReplicationDLQTaskInfo describes a replication task from a remote cluster which failed to be applied
+	// on current cluster, the task is kept as encoded by the source cluster
+	ReplicationDLQTaskInfo struct {
+		SourceCluster string
+		ShardID       int
+		TaskID        int64
+		CreatedTime   time.Time
+		Data          []byte
+		Encoding      common.EncodingType
+	}
+
+	// PutReplicationTaskToDLQRequest is used to put a replication task to the replication DLQ,
+	// ConditionFailedError is returned if a task with the same task ID already exists
+	PutReplicationTaskToDLQRequest struct {
+		TaskInfo *ReplicationDLQTaskInfo
+	}
+
+	// GetReplicationTasksFromDLQRequest is used to read the replication tasks of a shard from the replication DLQ
+	GetReplicationTasksFromDLQRequest struct {
+		SourceCluster string
+		ShardID       int
+		ReadLevel     int64
+		MaxReadLevel  int64
+		BatchSize     int
+		NextPageToken []byte
+	}
+
+	// GetReplicationTasksFromDLQResponse is the response to GetReplicationTasksFromDLQ
+	GetReplicationTasksFromDLQResponse struct {
+		Tasks         []*ReplicationDLQTaskInfo
+		NextPageToken []byte
+	}
+
+	// DeleteReplicationTaskFromDLQRequest is used to delete a replication task from the replication DLQ
+	DeleteReplicationTaskFromDLQRequest struct {
+		SourceCluster string
+		ShardID       int
+		TaskID        int64
+	}
+
+	// RangeDeleteReplicationTaskFromDLQRequest is used to delete a range of replication tasks from the replication DLQ
+	RangeDeleteReplicationTaskFromDLQRequest struct {
+		SourceCluster        string
+		ShardID              int
+		ExclusiveBeginTaskID int64
+		InclusiveEndTaskID   int64
+	}
+
+	// QuarantinedTaskInfo describes a transfer or timer task which kept failing with a non-transient error
+	// and was moved out of its queue, the task is kept as read from the queue
+	QuarantinedTaskInfo struct {
+		ShardID             int
+		QueueType           int
+		TaskID              int64
+		VisibilityTimestamp time.Time
+		DomainID            string
+		WorkflowID          string
+		RunID               string
+		TaskType            int
+		Attempt             int
+		CreatedTime         time.Time
+		Error               string
+		StackTrace          string
+		Data                []byte
+		Encoding            common.EncodingType
+	}
+
+	// PutQuarantinedTaskRequest is used to move a task into the quarantine
+	PutQuarantinedTaskRequest struct {
+		TaskInfo *QuarantinedTaskInfo
+	}
+
+	// GetQuarantinedTasksRequest is used to read the quarantined tasks of a shard queue
+	GetQuarantinedTasksRequest struct {
+		ShardID       int
+		QueueType     int
+		BatchSize     int
+		NextPageToken []byte
+	}
+
+	// GetQuarantinedTasksResponse is the response to GetQuarantinedTasks
+	GetQuarantinedTasksResponse struct {
+		Tasks         []*QuarantinedTaskInfo
+		NextPageToken []byte
+	}
+
+	// GetQuarantinedTaskRequest is used to read a single quarantined task
+	GetQuarantinedTaskRequest struct {
+		ShardID             int
+		QueueType           int
+		TaskID              int64
+		VisibilityTimestamp time.Time
+	}
+
+	// GetQuarantinedTaskResponse is the response to GetQuarantinedTask
+	GetQuarantinedTaskResponse struct {
+		TaskInfo *QuarantinedTaskInfo
+	}
+
+	// DeleteQuarantinedTaskRequest is used to delete a task from the quarantine
+	DeleteQuarantinedTaskRequest struct {
+		ShardID             int
+		QueueType           int
+		TaskID              int64
+		VisibilityTimestamp time.Time
+	}
+
 	// RangeCompleteTimerTaskRequest is used to complete a range of tasks in the timer task queue
 	RangeCompleteTimerTaskRequest struct {
 		InclusiveBeginTimestamp time.Time
@@ -833,6 +959,13 @@ type (
 	UpdateTaskListResponse struct {
 	}
 
+	// DeleteTaskListRequest is used to delete a task list together with all its tasks
+	DeleteTaskListRequest struct {
+		DomainID string
+		TaskList string
+		TaskType int
+	}
+
 	// CreateTasksRequest is used to create a new task for a workflow exectution
 	CreateTasksRequest struct {
 		TaskListInfo *TaskListInfo
@@ -930,6 +1063,20 @@ type (
 		Size int
 	}
 
+	// GetWorkflowExecutionHistoryByBatchResponse is the response to GetWorkflowExecutionHistoryRequest
+	// with history events grouped by batch
+	//Deprecated: use v2 API-ReadHistoryBranch() instead
+	GetWorkflowExecutionHistoryByBatchResponse struct {
+		History []*workflow.History
+		// Token to read next page if there are more events beyond page size.
+		// Use this to set NextPageToken on GetworkflowExecutionHistoryRequest to read the next page.
+		NextPageToken []byte
+		// the first_event_id of last loaded batch
+		LastFirstEventID int64
+		// Size of history read from store
+		Size int
+	}
+
 	// DeleteWorkflowExecutionHistoryRequest is used to delete workflow execution history
 	//Deprecated: use v2 API-AppendHistoryNodes() instead
 	DeleteWorkflowExecutionHistoryRequest struct {
@@ -997,6 +1144,10 @@ type (
 		FailoverNotificationVersion int64
 		NotificationVersion         int64
 		TableVersion                int
+		// PendingActiveClusterName is the target cluster of a graceful failover in progress, empty if there is none
+		PendingActiveClusterName string
+		// FailoverEndTime is the time in unix nanoseconds by which a graceful failover in progress is completed
+		FailoverEndTime int64
 	}
 
 	// UpdateDomainRequest is used to update domain
@@ -1009,6 +1160,8 @@ type (
 		FailoverNotificationVersion int64
 		NotificationVersion         int64
 		TableVersion                int
+		PendingActiveClusterName    string
+		FailoverEndTime             int64
 	}
 
 	// DeleteDomainRequest is used to delete domain entry from domains table
@@ -1144,6 +1297,18 @@ type (
 		LastFirstEventID int64
 	}
 
+	// ReadRawHistoryBranchResponse is the response to ReadHistoryBranchRequest when reading the raw history
+	ReadRawHistoryBranchResponse struct {
+		// HistoryEventBlobs history event blobs as they are stored, batches are not deserialized
+		HistoryEventBlobs []*DataBlob
+		// Token to read next page if there are more events beyond page size.
+		// Use this to set NextPageToken on ReadHistoryBranchRequest to read the next page.
+		// Empty means we have reached the last page, not need to continue
+		NextPageToken []byte
+		// Size of history read from store
+		Size int
+	}
+
 	// ForkHistoryBranchRequest is used to fork a history branch
 	ForkHistoryBranchRequest struct {
 		// The branch to be fork
@@ -1209,6 +1374,7 @@ type (
 		UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error)
 		ResetMutableState(request *ResetMutableStateRequest) error
 		DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error
+		DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error
 		GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error)
 
 		// Transfer task related methods
@@ -1241,6 +1407,7 @@ type (
 		CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error)
 		GetTasks(request *GetTasksRequest) (*GetTasksResponse, error)
 		CompleteTask(request *CompleteTaskRequest) error
+		DeleteTaskList(request *DeleteTaskListRequest) error
 	}
 
 	// HistoryManager is used to manage Workflow Execution HistoryEventBatch
@@ -1254,6 +1421,10 @@ type (
 		// GetWorkflowExecutionHistory retrieves the paginated list of history events for given execution
 		//Deprecated: use v2 API-ReadHistoryBranch() instead
 		GetWorkflowExecutionHistory(request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryResponse, error)
+		// GetWorkflowExecutionHistoryByBatch retrieves the paginated list of history events for given execution
+		// grouped by the batches they were appended in
+		//Deprecated: use v2 API-ReadHistoryBranch() instead
+		GetWorkflowExecutionHistoryByBatch(request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryByBatchResponse, error)
 		//Deprecated: use v2 API-DeleteHistoryBranch instead
 		DeleteWorkflowExecutionHistory(request *DeleteWorkflowExecutionHistoryRequest) error
 	}
@@ -1271,6 +1442,8 @@ type (
 		AppendHistoryNodes(request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error)
 		// ReadHistoryBranch returns history node data for a branch
 		ReadHistoryBranch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error)
+		// ReadRawHistoryBranch returns history node raw data for a branch, without deserializing the batches
+		ReadRawHistoryBranch(request *ReadHistoryBranchRequest) (*ReadRawHistoryBranchResponse, error)
 		// ForkHistoryBranch forks a new branch from a old branch
 		ForkHistoryBranch(request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error)
 		// DeleteHistoryBranch removes a branch
@@ -1280,6 +1453,26 @@ type (
 		GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error)
 	}
 
+	// ReplicationDLQManager is used to manage the replication tasks from remote clusters which failed to be applied
+	ReplicationDLQManager interface {
+		Closeable
+		GetName() string
+		PutReplicationTaskToDLQ(request *PutReplicationTaskToDLQRequest) error
+		GetReplicationTasksFromDLQ(request *GetReplicationTasksFromDLQRequest) (*GetReplicationTasksFromDLQResponse, error)
+		DeleteReplicationTaskFromDLQ(request *DeleteReplicationTaskFromDLQRequest) error
+		RangeDeleteReplicationTaskFromDLQ(request *RangeDeleteReplicationTaskFromDLQRequest) error
+	}
+
+	// QuarantineManager is used to manage the transfer and timer tasks which were moved out of their queues
+	QuarantineManager interface {
+		Closeable
+		GetName() string
+		PutQuarantinedTask(request *PutQuarantinedTaskRequest) error
+		GetQuarantinedTasks(request *GetQuarantinedTasksRequest) (*GetQuarantinedTasksResponse, error)
+		GetQuarantinedTask(request *GetQuarantinedTaskRequest) (*GetQuarantinedTaskResponse, error)
+		DeleteQuarantinedTask(request *DeleteQuarantinedTaskRequest) error
+	}
+
 	// MetadataManager is used to manage metadata CRUD for domain entities
 	MetadataManager interface {
 		Closeable
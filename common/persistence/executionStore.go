@@ -155,8 +155,11 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(info *InternalWorkflowEx
 		DecisionTimeout:              info.DecisionTimeout,
 		DecisionAttempt:              info.DecisionAttempt,
 		DecisionTimestamp:            info.DecisionTimestamp,
+		HasDecisionBackoff:           info.HasDecisionBackoff,
+		HasEventsV1History:           info.HasEventsV1History,
 		CancelRequested:              info.CancelRequested,
 		CancelRequestID:              info.CancelRequestID,
+		Paused:                       info.Paused,
 		StickyTaskList:               info.StickyTaskList,
 		StickyScheduleToStartTimeout: info.StickyScheduleToStartTimeout,
 		ClientLibraryVersion:         info.ClientLibraryVersion,
@@ -513,8 +516,11 @@ func (m *executionManagerImpl) SerializeExecutionInfo(info *WorkflowExecutionInf
 		DecisionTimeout:              info.DecisionTimeout,
 		DecisionAttempt:              info.DecisionAttempt,
 		DecisionTimestamp:            info.DecisionTimestamp,
+		HasDecisionBackoff:           info.HasDecisionBackoff,
+		HasEventsV1History:           info.HasEventsV1History,
 		CancelRequested:              info.CancelRequested,
 		CancelRequestID:              info.CancelRequestID,
+		Paused:                       info.Paused,
 		StickyTaskList:               info.StickyTaskList,
 		StickyScheduleToStartTimeout: info.StickyScheduleToStartTimeout,
 		ClientLibraryVersion:         info.ClientLibraryVersion,
@@ -571,6 +577,10 @@ func (m *executionManagerImpl) DeleteWorkflowExecution(request *DeleteWorkflowEx
 	return m.persistence.DeleteWorkflowExecution(request)
 }
 
+func (m *executionManagerImpl) DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error {
+	return m.persistence.DeleteCurrentWorkflowExecution(request)
+}
+
 func (m *executionManagerImpl) GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
 	return m.persistence.GetCurrentExecution(request)
 }
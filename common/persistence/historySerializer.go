@@ -21,9 +21,13 @@
 package persistence
 
 import (
+	"bytes"
+	"compress/gzip"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 
+	"github.com/golang/snappy"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/codec"
@@ -79,7 +83,7 @@ func (t *serializerImpl) SerializeBatchEvents(events []*workflow.HistoryEvent, e
 	switch encodingType {
 	case common.EncodingTypeGob:
 		return nil, NewUnknownEncodingTypeError(encodingType)
-	case common.EncodingTypeThriftRW:
+	case common.EncodingTypeThriftRW, common.EncodingTypeThriftRWSnappy, common.EncodingTypeThriftRWGzip:
 		history := &workflow.History{
 			Events: batch.Events,
 		}
@@ -87,6 +91,10 @@ func (t *serializerImpl) SerializeBatchEvents(events []*workflow.HistoryEvent, e
 		if err != nil {
 			return nil, NewHistorySerializationError(err.Error())
 		}
+		data, err = compress(data, encodingType)
+		if err != nil {
+			return nil, NewHistorySerializationError(err.Error())
+		}
 		return NewDataBlob(data, encodingType), nil
 	default:
 		fallthrough
@@ -117,9 +125,12 @@ func (t *serializerImpl) DeserializeBatchEvents(data *DataBlob) ([]*workflow.His
 			return nil, NewHistoryDeserializationError(fmt.Sprintf("DeserializeBatchEvents encoding: \"%v\", error: %v", data.Encoding, err.Error()))
 		}
 		return events, nil
-	case common.EncodingTypeThriftRW:
+	case common.EncodingTypeThriftRW, common.EncodingTypeThriftRWSnappy, common.EncodingTypeThriftRWGzip:
 		var history workflow.History
-		err := t.thriftrwEncoder.Decode(data.Data, &history)
+		decompressed, err := decompress(data.Data, data.GetEncoding())
+		if err == nil {
+			err = t.thriftrwEncoder.Decode(decompressed, &history)
+		}
 		if err != nil {
 			return nil, NewHistoryDeserializationError(fmt.Sprintf("DeserializeBatchEvents encoding: \"%v\", error: %v", data.Encoding, err.Error()))
 		}
@@ -136,11 +147,15 @@ func (t *serializerImpl) SerializeEvent(event *workflow.HistoryEvent, encodingTy
 	switch encodingType {
 	case common.EncodingTypeGob:
 		return nil, NewUnknownEncodingTypeError(encodingType)
-	case common.EncodingTypeThriftRW:
+	case common.EncodingTypeThriftRW, common.EncodingTypeThriftRWSnappy, common.EncodingTypeThriftRWGzip:
 		data, err := t.thriftrwEncoder.Encode(event)
 		if err != nil {
 			return nil, NewHistorySerializationError(err.Error())
 		}
+		data, err = compress(data, encodingType)
+		if err != nil {
+			return nil, NewHistorySerializationError(err.Error())
+		}
 		return NewDataBlob(data, encodingType), nil
 	default:
 		fallthrough
@@ -171,8 +186,11 @@ func (t *serializerImpl) DeserializeEvent(data *DataBlob) (*workflow.HistoryEven
 			return nil, NewHistoryDeserializationError(fmt.Sprintf("DeserializeEvent encoding: \"%v\", error: %v", data.Encoding, err.Error()))
 		}
 		return &event, nil
-	case common.EncodingTypeThriftRW:
-		err := t.thriftrwEncoder.Decode(data.Data, &event)
+	case common.EncodingTypeThriftRW, common.EncodingTypeThriftRWSnappy, common.EncodingTypeThriftRWGzip:
+		decompressed, err := decompress(data.Data, data.GetEncoding())
+		if err == nil {
+			err = t.thriftrwEncoder.Decode(decompressed, &event)
+		}
 		if err != nil {
 			return nil, NewHistoryDeserializationError(fmt.Sprintf("DeserializeEvent encoding: \"%v\", error: %v", data.Encoding, err.Error()))
 		}
@@ -182,6 +200,53 @@ func (t *serializerImpl) DeserializeEvent(data *DataBlob) (*workflow.HistoryEven
 	}
 }
 
+// compress wraps thriftrw encoded data with the compression of the given encoding type
+func compress(data []byte, encodingType common.EncodingType) ([]byte, error) {
+	switch encodingType {
+	case common.EncodingTypeThriftRWSnappy:
+		return snappy.Encode(nil, data), nil
+	case common.EncodingTypeThriftRWGzip:
+		var buf bytes.Buffer
+		writer := gzip.NewWriter(&buf)
+		if _, err := writer.Write(data); err != nil {
+			return nil, err
+		}
+		if err := writer.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	default:
+		return data, nil
+	}
+}
+
+// decompress unwraps the compression of the given encoding type, returning thriftrw encoded data
+func decompress(data []byte, encodingType common.EncodingType) ([]byte, error) {
+	switch encodingType {
+	case common.EncodingTypeThriftRWSnappy:
+		return snappy.Decode(nil, data)
+	case common.EncodingTypeThriftRWGzip:
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+		return ioutil.ReadAll(reader)
+	default:
+		return data, nil
+	}
+}
+
+// isThriftRWEncoding returns true if the data of the encoding type is thriftrw, compressed or not
+func isThriftRWEncoding(encodingType common.EncodingType) bool {
+	switch encodingType {
+	case common.EncodingTypeThriftRW, common.EncodingTypeThriftRWSnappy, common.EncodingTypeThriftRWGzip:
+		return true
+	default:
+		return false
+	}
+}
+
 // NewUnknownEncodingTypeError returns a new instance of encoding type error
 func NewUnknownEncodingTypeError(encodingType common.EncodingType) error {
 	return &UnknownEncodingTypeError{encodingType: encodingType}
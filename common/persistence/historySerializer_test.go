@@ -21,6 +21,7 @@
 package persistence
 
 import (
+	"fmt"
 	"sync"
 	"testing"
 	"time"
@@ -153,3 +154,122 @@ func (s *historySerializerSuite) TestSerializer() {
 	succ := common.AwaitWaitGroup(&doneWG, 10*time.Second)
 	s.True(succ, "test timed out")
 }
+
+func (s *historySerializerSuite) TestSerializer_Compression() {
+	serializer := NewHistorySerializer()
+	events := newSerializerTestEvents(100)
+	history0 := &workflow.History{Events: events}
+
+	dThrift, err := serializer.SerializeBatchEvents(events, common.EncodingTypeThriftRW)
+	s.Nil(err)
+
+	for _, encoding := range []common.EncodingType{common.EncodingTypeThriftRWSnappy, common.EncodingTypeThriftRWGzip} {
+		dEvent, err := serializer.SerializeEvent(events[0], encoding)
+		s.Nil(err)
+		s.Equal(encoding, dEvent.GetEncoding())
+		event, err := serializer.DeserializeEvent(dEvent)
+		s.Nil(err)
+		s.True(events[0].Equals(event))
+
+		dEvents, err := serializer.SerializeBatchEvents(events, encoding)
+		s.Nil(err)
+		s.Equal(encoding, dEvents.GetEncoding())
+		s.True(len(dEvents.Data) < len(dThrift.Data))
+		deserialized, err := serializer.DeserializeBatchEvents(dEvents)
+		s.Nil(err)
+		s.True(history0.Equals(&workflow.History{Events: deserialized}))
+
+		// data written with one encoding cannot be read as another
+		_, err = serializer.DeserializeBatchEvents(NewDataBlob(dEvents.Data, common.EncodingTypeThriftRW))
+		s.NotNil(err)
+	}
+
+	// uncompressed data is still readable after compression is enabled
+	deserialized, err := serializer.DeserializeBatchEvents(dThrift)
+	s.Nil(err)
+	s.True(history0.Equals(&workflow.History{Events: deserialized}))
+}
+
+func (s *historySerializerSuite) TestDataBlob_ToThrift() {
+	serializer := NewHistorySerializer()
+	events := newSerializerTestEvents(10)
+
+	dThrift, err := serializer.SerializeBatchEvents(events, common.EncodingTypeThriftRW)
+	s.Nil(err)
+
+	for _, encoding := range serializerBenchmarkEncodings {
+		blob, err := serializer.SerializeBatchEvents(events, encoding)
+		s.Nil(err)
+		thriftBlob, err := blob.ToThrift()
+		s.Nil(err)
+
+		if encoding == common.EncodingTypeJSON {
+			s.Equal(workflow.EncodingTypeJSON, thriftBlob.GetEncodingType())
+			s.Equal(blob.Data, thriftBlob.Data)
+		} else {
+			// compressed blobs are handed out as plain thriftrw
+			s.Equal(workflow.EncodingTypeThriftRW, thriftBlob.GetEncodingType())
+			s.Equal(dThrift.Data, thriftBlob.Data)
+		}
+	}
+
+	_, err = NewDataBlob([]byte("data"), common.EncodingTypeGob).ToThrift()
+	s.NotNil(err)
+}
+
+func BenchmarkSerializeBatchEvents(b *testing.B) {
+	serializer := NewHistorySerializer()
+	events := newSerializerTestEvents(100)
+	for _, encoding := range serializerBenchmarkEncodings {
+		b.Run(string(encoding), func(b *testing.B) {
+			var blob *DataBlob
+			for i := 0; i < b.N; i++ {
+				blob, _ = serializer.SerializeBatchEvents(events, encoding)
+			}
+			b.Logf("encoding: %v, size: %v bytes", encoding, len(blob.Data))
+		})
+	}
+}
+
+func BenchmarkDeserializeBatchEvents(b *testing.B) {
+	serializer := NewHistorySerializer()
+	events := newSerializerTestEvents(100)
+	for _, encoding := range serializerBenchmarkEncodings {
+		blob, err := serializer.SerializeBatchEvents(events, encoding)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Run(string(encoding), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				serializer.DeserializeBatchEvents(blob)
+			}
+		})
+	}
+}
+
+var serializerBenchmarkEncodings = []common.EncodingType{
+	common.EncodingTypeJSON,
+	common.EncodingTypeThriftRW,
+	common.EncodingTypeThriftRWSnappy,
+	common.EncodingTypeThriftRWGzip,
+}
+
+// newSerializerTestEvents creates activity completed events with payloads resembling real workflow data
+func newSerializerTestEvents(count int) []*workflow.HistoryEvent {
+	events := make([]*workflow.HistoryEvent, 0, count)
+	for i := 0; i < count; i++ {
+		result := []byte(fmt.Sprintf(`{"orderId":"order-%v","status":"COMPLETED","items":[{"sku":"sku-1","quantity":1},{"sku":"sku-2","quantity":2}]}`, i))
+		events = append(events, &workflow.HistoryEvent{
+			EventId:   common.Int64Ptr(int64(i + 1)),
+			Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+			EventType: common.EventTypePtr(workflow.EventTypeActivityTaskCompleted),
+			ActivityTaskCompletedEventAttributes: &workflow.ActivityTaskCompletedEventAttributes{
+				Result:           result,
+				ScheduledEventId: common.Int64Ptr(int64(i)),
+				StartedEventId:   common.Int64Ptr(int64(i)),
+				Identity:         common.StringPtr("worker-identity"),
+			},
+		})
+	}
+	return events
+}
@@ -87,6 +87,29 @@ func (m *historyManagerImpl) AppendHistoryEvents(request *AppendHistoryEventsReq
 
 // GetWorkflowExecutionHistory retrieves the paginated list of history events for given execution
 func (m *historyManagerImpl) GetWorkflowExecutionHistory(request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryResponse, error) {
+	response, err := m.GetWorkflowExecutionHistoryByBatch(request)
+	if err != nil {
+		return nil, err
+	}
+
+	history := &workflow.History{
+		Events: make([]*workflow.HistoryEvent, 0, request.PageSize),
+	}
+	for _, batch := range response.History {
+		history.Events = append(history.Events, batch.Events...)
+	}
+
+	return &GetWorkflowExecutionHistoryResponse{
+		History:          history,
+		NextPageToken:    response.NextPageToken,
+		LastFirstEventID: response.LastFirstEventID,
+		Size:             response.Size,
+	}, nil
+}
+
+// GetWorkflowExecutionHistoryByBatch retrieves the paginated list of history events for given execution,
+// grouped by the batches they were appended in
+func (m *historyManagerImpl) GetWorkflowExecutionHistoryByBatch(request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryByBatchResponse, error) {
 	token, err := m.deserializeToken(request)
 	if err != nil {
 		return nil, err
@@ -111,11 +134,9 @@ func (m *historyManagerImpl) GetWorkflowExecutionHistory(request *GetWorkflowExe
 	token.LastEventBatchVersion = response.LastEventBatchVersion
 	token.Data = response.NextPageToken
 
-	newResponse := &GetWorkflowExecutionHistoryResponse{}
+	newResponse := &GetWorkflowExecutionHistoryByBatchResponse{}
 
-	history := &workflow.History{
-		Events: make([]*workflow.HistoryEvent, 0, request.PageSize),
-	}
+	history := make([]*workflow.History, 0, request.PageSize)
 
 	// first_event_id of the last batch
 	lastFirstEventID := common.EmptyEventID
@@ -144,7 +165,7 @@ func (m *historyManagerImpl) GetWorkflowExecutionHistory(request *GetWorkflowExe
 		}
 
 		lastFirstEventID = historyBatch[0].GetEventId()
-		history.Events = append(history.Events, historyBatch...)
+		history = append(history, &workflow.History{Events: historyBatch})
 		token.LastEventID = historyBatch[len(historyBatch)-1].GetEventId()
 	}
 
@@ -194,77 +194,7 @@ func (m *historyV2ManagerImpl) AppendHistoryNodes(request *AppendHistoryNodesReq
 // ReadHistoryBranch returns history node data for a branch
 // Pagination is implemented here, the actual minNodeID passing to persistence layer is calculated along with token's LastNodeID
 func (m *historyV2ManagerImpl) ReadHistoryBranch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
-	var branch workflow.HistoryBranch
-	err := m.thrifteEncoder.Decode(request.BranchToken, &branch)
-	if err != nil {
-		return nil, err
-	}
-	treeID := *branch.TreeID
-	branchID := *branch.BranchID
-
-	if request.PageSize <= 0 || request.MinEventID >= request.MaxEventID {
-		return nil, &InvalidPersistenceRequestError{
-			Msg: fmt.Sprintf("no events can be found for pageSize %v, minEventID %v, maxEventID: %v", request.PageSize, request.MinEventID, request.MaxEventID),
-		}
-	}
-
-	token, err := m.pagingTokenSerializer.Deserialize(request.NextPageToken, request.MinEventID-1, common.EmptyVersion)
-	if err != nil {
-		return nil, err
-	}
-
-	allBRs := branch.Ancestors
-	// We may also query the current branch from beginNodeID
-	beginNodeID := int64(1)
-	if len(branch.Ancestors) > 0 {
-		beginNodeID = *branch.Ancestors[len(branch.Ancestors)-1].EndNodeID
-	}
-	allBRs = append(allBRs, &workflow.HistoryBranchRange{
-		BranchID:    &branchID,
-		BeginNodeID: common.Int64Ptr(beginNodeID),
-		EndNodeID:   common.Int64Ptr(request.MaxEventID),
-	})
-
-	if token.CurrentRangeIndex == notStartedIndex {
-		for idx, br := range allBRs {
-			// this range won't contain any nodes needed
-			if request.MinEventID >= *br.EndNodeID {
-				continue
-			}
-			// similarly, the ranges and the rest won't contain any nodes needed,
-			if request.MaxEventID <= *br.BeginNodeID {
-				break
-			}
-
-			if token.CurrentRangeIndex == notStartedIndex {
-				token.CurrentRangeIndex = idx
-			}
-			token.FinalRangeIndex = idx
-		}
-
-		if token.CurrentRangeIndex == notStartedIndex {
-			return nil, &workflow.InternalServiceError{
-				Message: fmt.Sprintf("branchRange is corrupted"),
-			}
-		}
-	}
-
-	minNodeID := token.LastEventID + 1
-	maxNodeID := *allBRs[token.CurrentRangeIndex].EndNodeID
-	if request.MaxEventID < maxNodeID {
-		maxNodeID = request.MaxEventID
-	}
-
-	req := &InternalReadHistoryBranchRequest{
-		TreeID:        treeID,
-		BranchID:      *allBRs[token.CurrentRangeIndex].BranchID,
-		MinNodeID:     minNodeID,
-		MaxNodeID:     maxNodeID,
-		PageSize:      request.PageSize,
-		NextPageToken: token.StoreToken,
-	}
-
-	resp, err := m.persistence.ReadHistoryBranch(req)
+	branch, token, resp, err := m.readRawHistoryBranch(request)
 	if err != nil {
 		return nil, err
 	}
@@ -335,27 +265,141 @@ func (m *historyV2ManagerImpl) ReadHistoryBranch(request *ReadHistoryBranchReque
 		LastFirstEventID: lastFirstEventID,
 	}
 
-	if len(token.StoreToken) == 0 {
-		if token.CurrentRangeIndex == token.FinalRangeIndex {
-			// this means that we have reached the final page of final branchRange
-			response.NextPageToken = nil
-		} else {
-			token.CurrentRangeIndex++
-			token.StoreToken = nil
-			response.NextPageToken, err = m.pagingTokenSerializer.Serialize(token)
-			if err != nil {
-				return nil, err
+	response.NextPageToken, err = m.serializeNextPageToken(token, resp.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// ReadRawHistoryBranch returns history node raw data for a branch
+// Pagination is implemented the same way as ReadHistoryBranch. Since the batches are not deserialized,
+// stale batches are filtered out by their node ID, a node written again by a later transaction is only
+// returned once
+func (m *historyV2ManagerImpl) ReadRawHistoryBranch(request *ReadHistoryBranchRequest) (*ReadRawHistoryBranchResponse, error) {
+	_, token, resp, err := m.readRawHistoryBranch(request)
+	if err != nil {
+		return nil, err
+	}
+
+	blobs := make([]*DataBlob, 0, len(resp.History))
+	dataSize := 0
+	for i, b := range resp.History {
+		nodeID := resp.NodeIDs[i]
+		if nodeID <= token.LastNodeID {
+			// the batches of a node are ordered by descending txn_id, the batches after the first one are stale
+			m.logger.Infof("Stale event batch with nodeID: %v", nodeID)
+			continue
+		}
+		token.LastNodeID = nodeID
+		blobs = append(blobs, b)
+		dataSize += len(b.Data)
+	}
+
+	response := &ReadRawHistoryBranchResponse{
+		HistoryEventBlobs: blobs,
+		Size:              dataSize,
+	}
+
+	response.NextPageToken, err = m.serializeNextPageToken(token, resp.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (m *historyV2ManagerImpl) readRawHistoryBranch(request *ReadHistoryBranchRequest) (
+	*workflow.HistoryBranch, *historyV2PagingToken, *InternalReadHistoryBranchResponse, error) {
+	var branch workflow.HistoryBranch
+	err := m.thrifteEncoder.Decode(request.BranchToken, &branch)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	treeID := *branch.TreeID
+	branchID := *branch.BranchID
+
+	if request.PageSize <= 0 || request.MinEventID >= request.MaxEventID {
+		return nil, nil, nil, &InvalidPersistenceRequestError{
+			Msg: fmt.Sprintf("no events can be found for pageSize %v, minEventID %v, maxEventID: %v", request.PageSize, request.MinEventID, request.MaxEventID),
+		}
+	}
+
+	token, err := m.pagingTokenSerializer.Deserialize(request.NextPageToken, request.MinEventID-1, common.EmptyVersion)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	allBRs := branch.Ancestors
+	// We may also query the current branch from beginNodeID
+	beginNodeID := int64(1)
+	if len(branch.Ancestors) > 0 {
+		beginNodeID = *branch.Ancestors[len(branch.Ancestors)-1].EndNodeID
+	}
+	allBRs = append(allBRs, &workflow.HistoryBranchRange{
+		BranchID:    &branchID,
+		BeginNodeID: common.Int64Ptr(beginNodeID),
+		EndNodeID:   common.Int64Ptr(request.MaxEventID),
+	})
+
+	if token.CurrentRangeIndex == notStartedIndex {
+		for idx, br := range allBRs {
+			// this range won't contain any nodes needed
+			if request.MinEventID >= *br.EndNodeID {
+				continue
+			}
+			// similarly, the ranges and the rest won't contain any nodes needed,
+			if request.MaxEventID <= *br.BeginNodeID {
+				break
 			}
+
+			if token.CurrentRangeIndex == notStartedIndex {
+				token.CurrentRangeIndex = idx
+			}
+			token.FinalRangeIndex = idx
 		}
-	} else {
-		token.StoreToken = resp.NextPageToken
-		response.NextPageToken, err = m.pagingTokenSerializer.Serialize(token)
-		if err != nil {
-			return nil, err
+
+		if token.CurrentRangeIndex == notStartedIndex {
+			return nil, nil, nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("branchRange is corrupted"),
+			}
 		}
 	}
 
-	return response, nil
+	minNodeID := token.LastEventID + 1
+	maxNodeID := *allBRs[token.CurrentRangeIndex].EndNodeID
+	if request.MaxEventID < maxNodeID {
+		maxNodeID = request.MaxEventID
+	}
+
+	req := &InternalReadHistoryBranchRequest{
+		TreeID:        treeID,
+		BranchID:      *allBRs[token.CurrentRangeIndex].BranchID,
+		MinNodeID:     minNodeID,
+		MaxNodeID:     maxNodeID,
+		PageSize:      request.PageSize,
+		NextPageToken: token.StoreToken,
+	}
+
+	resp, err := m.persistence.ReadHistoryBranch(req)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return &branch, token, resp, nil
+}
+
+func (m *historyV2ManagerImpl) serializeNextPageToken(token *historyV2PagingToken, storeToken []byte) ([]byte, error) {
+	if len(storeToken) == 0 {
+		if token.CurrentRangeIndex == token.FinalRangeIndex {
+			// this means that we have reached the final page of final branchRange
+			return nil, nil
+		}
+		token.CurrentRangeIndex++
+		token.StoreToken = nil
+		return m.pagingTokenSerializer.Serialize(token)
+	}
+
+	token.StoreToken = storeToken
+	return m.pagingTokenSerializer.Serialize(token)
 }
 
 func (m *historyV2ManagerImpl) Close() {
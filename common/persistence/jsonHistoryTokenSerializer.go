@@ -29,6 +29,8 @@ type (
 	historyV2PagingToken struct {
 		LastEventVersion int64
 		LastEventID      int64
+		// the node ID of the last batch returned by a raw read
+		LastNodeID int64
 		// the pagination token passing to persistence
 		StoreToken []byte
 		// recording which branchRange it is reading
@@ -59,6 +61,7 @@ func (j *jsonHistoryTokenSerializer) Deserialize(data []byte, defaultLastNodeID,
 		token := historyV2PagingToken{
 			LastEventVersion:  defaultLastEventVersion,
 			LastEventID:       defaultLastNodeID,
+			LastNodeID:        defaultLastNodeID,
 			CurrentRangeIndex: notStartedIndex,
 		}
 		return &token, nil
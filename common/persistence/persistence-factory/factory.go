@@ -54,6 +54,10 @@ type (
 		NewExecutionManager(shardID int) (p.ExecutionManager, error)
 		// NewVisibilityManager returns a new visibility manager
 		NewVisibilityManager(enableSampling bool) (p.VisibilityManager, error)
+		// NewReplicationDLQManager returns a new replication DLQ manager
+		NewReplicationDLQManager() (p.ReplicationDLQManager, error)
+		// NewQuarantineManager returns a new task quarantine manager
+		NewQuarantineManager() (p.QuarantineManager, error)
 	}
 	// DataStoreFactory is a low level interface to be implemented by a datastore
 	// Examples of datastores are cassandra, mysql etc
@@ -78,11 +82,15 @@ type (
 		NewExecutionStore(shardID int) (p.ExecutionStore, error)
 		// NewVisibilityStore returns a new visibility store
 		NewVisibilityStore() (p.VisibilityStore, error)
+		// NewReplicationDLQStore returns a new replication DLQ store
+		NewReplicationDLQStore() (p.ReplicationDLQStore, error)
+		// NewQuarantineStore returns a new task quarantine store
+		NewQuarantineStore() (p.QuarantineStore, error)
 	}
 	// Datastore represents a datastore
 	Datastore struct {
 		factory   DataStoreFactory
-		ratelimit common.TokenBucket
+		ratelimit p.DomainRateLimiter
 	}
 	factoryImpl struct {
 		sync.RWMutex
@@ -105,6 +113,8 @@ const (
 	storeTypeMetadata
 	storeTypeExecution
 	storeTypeVisibility
+	storeTypeReplicationDLQ
+	storeTypeQuarantine
 )
 
 const (
@@ -117,7 +127,8 @@ const (
 )
 
 var storeTypes = []storeType{
-	storeTypeHistory, storeTypeTask, storeTypeShard, storeTypeMetadata, storeTypeExecution, storeTypeVisibility}
+	storeTypeHistory, storeTypeTask, storeTypeShard, storeTypeMetadata, storeTypeExecution, storeTypeVisibility,
+	storeTypeReplicationDLQ, storeTypeQuarantine}
 
 // New returns an implementation of factory that vends persistence objects based on
 // specified configuration. This factory takes as input a config.Persistence object
@@ -125,7 +136,9 @@ var storeTypes = []storeType{
 // also contains config for individual datastores themselves.
 //
 // The objects returned by this factory enforce ratelimit and maxconns according to
-// given configuration. In addition, all objects will emit metrics automatically
+// given configuration. The ratelimit of a datastore is shared by all domains, and
+// every domain is capped by the quota returned by config.DomainMaxQPS.
+// In addition, all objects will emit metrics automatically
 func New(
 	cfg *config.Persistence,
 	clusterName string,
@@ -138,14 +151,16 @@ func New(
 	}
 	defaultCfg := cfg.DataStores[cfg.DefaultStore]
 	visibilityCfg := cfg.DataStores[cfg.VisibilityStore]
-	limiters := buildRatelimiters(cfg)
+	limiters := buildRatelimiters(cfg, metricsClient)
 	factory.datastores = map[storeType]Datastore{
-		storeTypeTask:       newStore(defaultCfg, limiters[cfg.DefaultStore], clusterName, 0, logger),
-		storeTypeShard:      newStore(defaultCfg, limiters[cfg.DefaultStore], clusterName, 0, logger),
-		storeTypeMetadata:   newStore(defaultCfg, limiters[cfg.DefaultStore], clusterName, 0, logger),
-		storeTypeExecution:  newStore(defaultCfg, limiters[cfg.DefaultStore], clusterName, 0, logger),
-		storeTypeHistory:    newStore(defaultCfg, limiters[cfg.DefaultStore], clusterName, cfg.HistoryMaxConns, logger),
-		storeTypeVisibility: newStore(visibilityCfg, limiters[cfg.VisibilityStore], clusterName, 0, logger),
+		storeTypeTask:           newStore(defaultCfg, limiters[cfg.DefaultStore], clusterName, 0, logger),
+		storeTypeShard:          newStore(defaultCfg, limiters[cfg.DefaultStore], clusterName, 0, logger),
+		storeTypeMetadata:       newStore(defaultCfg, limiters[cfg.DefaultStore], clusterName, 0, logger),
+		storeTypeExecution:      newStore(defaultCfg, limiters[cfg.DefaultStore], clusterName, 0, logger),
+		storeTypeHistory:        newStore(defaultCfg, limiters[cfg.DefaultStore], clusterName, cfg.HistoryMaxConns, logger),
+		storeTypeVisibility:     newStore(visibilityCfg, limiters[cfg.VisibilityStore], clusterName, 0, logger),
+		storeTypeReplicationDLQ: newStore(defaultCfg, limiters[cfg.DefaultStore], clusterName, 0, logger),
+		storeTypeQuarantine:     newStore(defaultCfg, limiters[cfg.DefaultStore], clusterName, 0, logger),
 	}
 	return factory
 }
@@ -281,15 +296,47 @@ func (f *factoryImpl) NewVisibilityManager(enableSampling bool) (p.VisibilityMan
 	return result, nil
 }
 
+// NewReplicationDLQManager returns a new replication DLQ manager
+func (f *factoryImpl) NewReplicationDLQManager() (p.ReplicationDLQManager, error) {
+	ds := f.datastores[storeTypeReplicationDLQ]
+	result, err := ds.factory.NewReplicationDLQStore()
+	if err != nil {
+		return nil, err
+	}
+	if ds.ratelimit != nil {
+		result = p.NewReplicationDLQPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
+	}
+	if f.metricsClient != nil {
+		result = p.NewReplicationDLQPersistenceMetricsClient(result, f.metricsClient, f.logger)
+	}
+	return result, nil
+}
+
+// NewQuarantineManager returns a new task quarantine manager
+func (f *factoryImpl) NewQuarantineManager() (p.QuarantineManager, error) {
+	ds := f.datastores[storeTypeQuarantine]
+	result, err := ds.factory.NewQuarantineStore()
+	if err != nil {
+		return nil, err
+	}
+	if ds.ratelimit != nil {
+		result = p.NewQuarantinePersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
+	}
+	if f.metricsClient != nil {
+		result = p.NewQuarantinePersistenceMetricsClient(result, f.metricsClient, f.logger)
+	}
+	return result, nil
+}
+
 // Close closes this factory
 func (f *factoryImpl) Close() {
 	ds := f.datastores[storeTypeExecution]
 	ds.factory.Close()
 }
 
-func newStore(cfg config.DataStore, tb common.TokenBucket, clusterName string, maxConnsOverride int, logger bark.Logger) Datastore {
+func newStore(cfg config.DataStore, limiter p.DomainRateLimiter, clusterName string, maxConnsOverride int, logger bark.Logger) Datastore {
 	var ds Datastore
-	ds.ratelimit = tb
+	ds.ratelimit = limiter
 	if cfg.SQL != nil {
 		ds.factory = newSQLStore(*cfg.SQL, clusterName, maxConnsOverride, logger)
 		return ds
@@ -312,8 +359,8 @@ func newCassandraStore(cfg config.Cassandra, clusterName string, maxConnsOverrid
 	return cassandra.NewFactory(cfg, clusterName, logger)
 }
 
-func buildRatelimiters(cfg *config.Persistence) map[string]common.TokenBucket {
-	result := make(map[string]common.TokenBucket, len(cfg.DataStores))
+func buildRatelimiters(cfg *config.Persistence, metricsClient metrics.Client) map[string]p.DomainRateLimiter {
+	result := make(map[string]p.DomainRateLimiter, len(cfg.DataStores))
 	for dsName, ds := range cfg.DataStores {
 		qps := 0
 		if ds.Cassandra != nil {
@@ -323,7 +370,8 @@ func buildRatelimiters(cfg *config.Persistence) map[string]common.TokenBucket {
 			qps = ds.SQL.MaxQPS
 		}
 		if qps > 0 {
-			result[dsName] = common.NewTokenBucket(qps, common.NewRealTimeSource())
+			globalLimiter := common.NewTokenBucket(qps, common.NewRealTimeSource())
+			result[dsName] = p.NewDomainRateLimiter(globalLimiter, cfg.DomainMaxQPS, metricsClient)
 		}
 	}
 	return result
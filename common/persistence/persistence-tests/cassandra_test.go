@@ -68,6 +68,20 @@ func TestCassandraShardPersistence(t *testing.T) {
 	suite.Run(t, s)
 }
 
+func TestCassandraReplicationDLQPersistence(t *testing.T) {
+	s := new(ReplicationDLQPersistenceSuite)
+	s.TestBase = NewTestBaseWithCassandra(&TestBaseOptions{})
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestCassandraQuarantinePersistence(t *testing.T) {
+	s := new(QuarantinePersistenceSuite)
+	s.TestBase = NewTestBaseWithCassandra(&TestBaseOptions{})
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
 func TestCassandraVisibilityPersistence(t *testing.T) {
 	s := new(VisibilityPersistenceSuite)
 	s.TestBase = NewTestBaseWithCassandra(&TestBaseOptions{})
@@ -744,6 +744,43 @@ func (s *ExecutionManagerSuite) TestDeleteCurrentWorkflow() {
 	s.NoError(err2)
 }
 
+// TestDeleteCurrentWorkflowExecution test
+func (s *ExecutionManagerSuite) TestDeleteCurrentWorkflowExecution() {
+	domainID := "1b4b63fb-5a44-4ecb-a3f5-2a3b4ff61a25"
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("delete-current-workflow-execution-test"),
+		RunId:      common.StringPtr("0d5b4a46-c8c3-4ae4-9b1a-64c4a7dd1a0a"),
+	}
+
+	task0, err0 := s.CreateWorkflowExecution(domainID, workflowExecution, "queue1", "wType", 20, 13, nil, 3, 0, 2, nil)
+	s.NoError(err0)
+	s.NotNil(task0, "Expected non empty task identifier.")
+
+	// the row points to another run, it is kept
+	err1 := s.ExecutionManager.DeleteCurrentWorkflowExecution(&p.DeleteCurrentWorkflowExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: workflowExecution.GetWorkflowId(),
+		RunID:      "5a3ba8e6-3a38-4f5e-8bd1-8f9b1ba0ed39",
+	})
+	s.NoError(err1)
+	runID, err2 := s.GetCurrentWorkflowRunID(domainID, workflowExecution.GetWorkflowId())
+	s.NoError(err2)
+	s.Equal(workflowExecution.GetRunId(), runID)
+
+	err3 := s.ExecutionManager.DeleteCurrentWorkflowExecution(&p.DeleteCurrentWorkflowExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: workflowExecution.GetWorkflowId(),
+		RunID:      workflowExecution.GetRunId(),
+	})
+	s.NoError(err3)
+	_, err4 := s.GetCurrentWorkflowRunID(domainID, workflowExecution.GetWorkflowId())
+	s.Error(err4)
+
+	// execution record should still be there
+	_, err5 := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.NoError(err5)
+}
+
 // TestGetCurrentWorkflow test
 func (s *ExecutionManagerSuite) TestGetCurrentWorkflow() {
 	domainID := "54d15308-e20e-4b91-a00f-a518a3892790"
@@ -3040,6 +3077,7 @@ func (s *ExecutionManagerSuite) TestCreateGetShardBackfill() {
 	shardInfo.ClusterTimerAckLevel = map[string]time.Time{
 		s.ClusterMetadata.GetCurrentClusterName(): currentClusterTimerAck,
 	}
+	shardInfo.ClusterReplicationLevel = map[string]int64{}
 	resp, err := s.ShardMgr.GetShard(&p.GetShardRequest{ShardID: shardID})
 	s.NoError(err)
 	s.True(timeComparator(shardInfo.UpdatedAt, resp.ShardInfo.UpdatedAt, TimePrecision))
@@ -3063,6 +3101,7 @@ func (s *ExecutionManagerSuite) TestCreateGetUpdateGetShard() {
 	alternativeClusterTransferAck := int64(32)
 	currentClusterTimerAck := timestampConvertor(time.Now().Add(-10 * time.Second))
 	alternativeClusterTimerAck := timestampConvertor(time.Now().Add(-20 * time.Second))
+	alternativeClusterReplicationLevel := int64(25)
 	domainNotificationVersion := int64(8192)
 	shardInfo := &p.ShardInfo{
 		ShardID:             shardID,
@@ -3081,6 +3120,9 @@ func (s *ExecutionManagerSuite) TestCreateGetUpdateGetShard() {
 			cluster.TestCurrentClusterName:     currentClusterTimerAck,
 			cluster.TestAlternativeClusterName: alternativeClusterTimerAck,
 		},
+		ClusterReplicationLevel: map[string]int64{
+			cluster.TestAlternativeClusterName: alternativeClusterReplicationLevel,
+		},
 		DomainNotificationVersion: domainNotificationVersion,
 	}
 	createRequest := &p.CreateShardRequest{
@@ -3104,6 +3146,7 @@ func (s *ExecutionManagerSuite) TestCreateGetUpdateGetShard() {
 	alternativeClusterTransferAck = int64(320)
 	currentClusterTimerAck = timestampConvertor(time.Now().Add(-100 * time.Second))
 	alternativeClusterTimerAck = timestampConvertor(time.Now().Add(-200 * time.Second))
+	alternativeClusterReplicationLevel = int64(250)
 	domainNotificationVersion = int64(16384)
 	shardInfo = &p.ShardInfo{
 		ShardID:             shardID,
@@ -3122,6 +3165,9 @@ func (s *ExecutionManagerSuite) TestCreateGetUpdateGetShard() {
 			cluster.TestCurrentClusterName:     currentClusterTimerAck,
 			cluster.TestAlternativeClusterName: alternativeClusterTimerAck,
 		},
+		ClusterReplicationLevel: map[string]int64{
+			cluster.TestAlternativeClusterName: alternativeClusterReplicationLevel,
+		},
 		DomainNotificationVersion: domainNotificationVersion,
 	}
 	updateRequest := &p.UpdateShardRequest{
@@ -237,6 +237,46 @@ func (s *HistoryV2PersistenceSuite) TestConcurrentlyCreateAndAppendBranches() {
 
 }
 
+// TestReadRawHistoryBranchSkipsStaleBatches test
+func (s *HistoryV2PersistenceSuite) TestReadRawHistoryBranchSkipsStaleBatches() {
+	treeID := uuid.New()
+	bi, err := s.newHistoryBranch(treeID)
+	s.Nil(err)
+
+	err = s.append(bi, s.genRandomEvents([]int64{1, 2}, 1), 2, true)
+	s.Nil(err)
+	err = s.append(bi, s.genRandomEvents([]int64{3}, 1), 2, false)
+	s.Nil(err)
+	// override with smaller txn_id, the batch is stale
+	err = s.append(bi, s.genRandomEvents([]int64{3}, 1), 1, false)
+	s.Nil(err)
+	err = s.append(bi, s.genRandomEvents([]int64{4, 5}, 1), 2, false)
+	s.Nil(err)
+
+	// page size 1 makes the stale batch the first one of a page
+	var blobs []*p.DataBlob
+	token := []byte{}
+	for {
+		resp, err := s.HistoryV2Mgr.ReadRawHistoryBranch(&p.ReadHistoryBranchRequest{
+			BranchToken:   bi,
+			MinEventID:    1,
+			MaxEventID:    6,
+			PageSize:      1,
+			NextPageToken: token,
+		})
+		s.Nil(err)
+		blobs = append(blobs, resp.HistoryEventBlobs...)
+		token = resp.NextPageToken
+		if len(token) == 0 {
+			break
+		}
+	}
+	s.Equal(3, len(blobs))
+
+	err = s.deleteHistoryBranch(bi)
+	s.Nil(err)
+}
+
 // TestConcurrentlyForkAndAppendBranches test
 func (s *HistoryV2PersistenceSuite) TestConcurrentlyForkAndAppendBranches() {
 	treeID := uuid.New()
@@ -196,6 +196,38 @@ func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskList() {
 	s.Error(err)
 }
 
+// TestDeleteTaskList test
+func (s *MatchingPersistenceSuite) TestDeleteTaskList() {
+	domainID := "6a0b0b0c-40a4-4a4e-9d3b-32c4d8b7e4b2"
+	workflowExecution := gen.WorkflowExecution{WorkflowId: common.StringPtr("delete-task-list-test"),
+		RunId: common.StringPtr("8f3a2b8e-0c55-4bd6-8d4a-0c6e6a3f2f11")}
+	taskList := "0c6e6a3f2f11"
+	_, err := s.CreateActivityTasks(domainID, workflowExecution, map[int64]string{
+		10: taskList,
+		20: taskList,
+	})
+	s.NoError(err)
+
+	err = s.TaskMgr.DeleteTaskList(&p.DeleteTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+
+	response, err := s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+	s.EqualValues(1, response.TaskListInfo.RangeID)
+
+	tasksResponse, err := s.GetTasks(domainID, taskList, p.TaskListTypeActivity, 10)
+	s.NoError(err)
+	s.Equal(0, len(tasksResponse.Tasks))
+}
+
 // TestLeaseAndUpdateTaskListSticky test
 func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskListSticky() {
 	domainID := uuid.New()
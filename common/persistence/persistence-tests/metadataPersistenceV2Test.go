@@ -28,6 +28,7 @@ import (
 	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	gen "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/cluster"
@@ -626,6 +627,73 @@ func (m *MetadataPersistenceSuiteV2) TestUpdateDomain() {
 	m.Equal(notificationVersion, resp5.NotificationVersion)
 }
 
+// TestUpdateDomainGracefulFailover test
+func (m *MetadataPersistenceSuiteV2) TestUpdateDomainGracefulFailover() {
+	id := uuid.New()
+	name := "update-domain-graceful-failover-test-name"
+	clusterActive := "some random active cluster name"
+	clusterStandby := "some random standby cluster name"
+	info := &p.DomainInfo{
+		ID:     id,
+		Name:   name,
+		Status: p.DomainStatusRegistered,
+		Data:   map[string]string{},
+	}
+	config := &p.DomainConfig{
+		Retention:  10,
+		EmitMetric: true,
+	}
+	replicationConfig := &p.DomainReplicationConfig{
+		ActiveClusterName: clusterActive,
+		Clusters: []*p.ClusterReplicationConfig{
+			{ClusterName: clusterActive},
+			{ClusterName: clusterStandby},
+		},
+	}
+
+	_, err := m.CreateDomain(info, config, replicationConfig, true, 10, 59)
+	m.NoError(err)
+
+	resp1, err := m.GetDomain(id, "")
+	m.NoError(err)
+	m.Empty(resp1.PendingActiveClusterName)
+	m.Equal(int64(0), resp1.FailoverEndTime)
+
+	metadata, err := m.MetadataManagerV2.GetMetadata()
+	m.NoError(err)
+	failoverEndTime := time.Now().Add(time.Minute).UnixNano()
+	err = m.MetadataManagerV2.UpdateDomain(&p.UpdateDomainRequest{
+		Info:                        resp1.Info,
+		Config:                      resp1.Config,
+		ReplicationConfig:           resp1.ReplicationConfig,
+		ConfigVersion:               resp1.ConfigVersion,
+		FailoverVersion:             resp1.FailoverVersion,
+		FailoverNotificationVersion: resp1.FailoverNotificationVersion,
+		NotificationVersion:         metadata.NotificationVersion,
+		PendingActiveClusterName:    clusterStandby,
+		FailoverEndTime:             failoverEndTime,
+	})
+	m.NoError(err)
+
+	resp2, err := m.GetDomain("", name)
+	m.NoError(err)
+	m.Equal(clusterActive, resp2.ReplicationConfig.ActiveClusterName)
+	m.Equal(clusterStandby, resp2.PendingActiveClusterName)
+	m.Equal(failoverEndTime, resp2.FailoverEndTime)
+
+	listResp, err := m.ListDomains(100, nil)
+	m.NoError(err)
+	found := false
+	for _, domain := range listResp.Domains {
+		if domain.Info.ID == id {
+			found = true
+			m.Equal(clusterStandby, domain.PendingActiveClusterName)
+			m.Equal(failoverEndTime, domain.FailoverEndTime)
+		}
+	}
+	m.True(found)
+}
+
 // TestDeleteDomain test
 func (m *MetadataPersistenceSuiteV2) TestDeleteDomain() {
 	id := uuid.New()
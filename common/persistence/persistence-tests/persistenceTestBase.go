@@ -81,6 +81,8 @@ type (
 		MetadataManagerV2     p.MetadataManager
 		MetadataProxy         p.MetadataManager
 		VisibilityMgr         p.VisibilityManager
+		ReplicationDLQMgr     p.ReplicationDLQManager
+		QuarantineMgr         p.QuarantineManager
 		ShardInfo             *p.ShardInfo
 		TaskIDGenerator       TransferTaskIDGenerator
 		ClusterMetadata       cluster.Metadata
@@ -179,6 +181,18 @@ func (s *TestBase) Setup() {
 	s.ShardMgr, err = factory.NewShardManager()
 	s.fatalOnError("NewShardManager", err)
 
+	s.ReplicationDLQMgr, err = factory.NewReplicationDLQManager()
+	// TODO SQL currently doesn't have support for ReplicationDLQMgr.
+	if err != nil {
+		log.Warnf(fmt.Sprintf("failed to initialize replication DLQ, it is expected for MySQL, %v", err))
+	}
+
+	s.QuarantineMgr, err = factory.NewQuarantineManager()
+	// TODO SQL currently doesn't have support for QuarantineMgr.
+	if err != nil {
+		log.Warnf(fmt.Sprintf("failed to initialize task quarantine, it is expected for MySQL, %v", err))
+	}
+
 	s.ExecutionMgrFactory = factory
 	s.ExecutionManager, err = factory.NewExecutionManager(shardID)
 	s.fatalOnError("NewExecutionManager", err)
@@ -0,0 +1,172 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencetests
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// QuarantinePersistenceSuite contains task quarantine persistence tests
+	QuarantinePersistenceSuite struct {
+		TestBase
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+// SetupSuite implementation
+func (s *QuarantinePersistenceSuite) SetupSuite() {
+	if testing.Verbose() {
+		log.SetOutput(os.Stdout)
+	}
+}
+
+// SetupTest implementation
+func (s *QuarantinePersistenceSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+}
+
+// TearDownSuite implementation
+func (s *QuarantinePersistenceSuite) TearDownSuite() {
+	s.TearDownWorkflowStore()
+}
+
+// TestPutAndGetQuarantinedTasks test
+func (s *QuarantinePersistenceSuite) TestPutAndGetQuarantinedTasks() {
+	shardID := 11
+	visibilityTimestamp := time.Unix(0, time.Now().UnixNano()/int64(time.Millisecond)*int64(time.Millisecond)).UTC()
+	for taskID := int64(1); taskID <= 3; taskID++ {
+		err := s.putQuarantinedTask(shardID, p.QuarantineQueueTypeTransfer, taskID, visibilityTimestamp)
+		s.Nil(err)
+	}
+	// tasks of another queue should not be returned
+	s.Nil(s.putQuarantinedTask(shardID, p.QuarantineQueueTypeTimer, 2, visibilityTimestamp))
+
+	tasks, token, err := s.getQuarantinedTasks(shardID, p.QuarantineQueueTypeTransfer, 10, nil)
+	s.Nil(err)
+	s.Empty(token)
+	s.Equal(3, len(tasks))
+	s.Equal(int64(1), tasks[0].TaskID)
+	s.Equal(int64(3), tasks[2].TaskID)
+	s.Equal(shardID, tasks[1].ShardID)
+	s.Equal(p.QuarantineQueueTypeTransfer, tasks[1].QueueType)
+	s.Equal(visibilityTimestamp, tasks[1].VisibilityTimestamp.UTC())
+	s.Equal("task failed", tasks[1].Error)
+	s.Equal(common.EncodingTypeJSON, tasks[1].Encoding)
+
+	tasks, token, err = s.getQuarantinedTasks(shardID, p.QuarantineQueueTypeTransfer, 2, nil)
+	s.Nil(err)
+	s.NotEmpty(token)
+	s.Equal(2, len(tasks))
+	tasks, _, err = s.getQuarantinedTasks(shardID, p.QuarantineQueueTypeTransfer, 2, token)
+	s.Nil(err)
+	s.Equal(1, len(tasks))
+	s.Equal(int64(3), tasks[0].TaskID)
+
+	response, err := s.QuarantineMgr.GetQuarantinedTask(&p.GetQuarantinedTaskRequest{
+		ShardID:             shardID,
+		QueueType:           p.QuarantineQueueTypeTimer,
+		TaskID:              2,
+		VisibilityTimestamp: visibilityTimestamp,
+	})
+	s.Nil(err)
+	s.Equal(int64(2), response.TaskInfo.TaskID)
+	s.Equal(p.QuarantineQueueTypeTimer, response.TaskInfo.QueueType)
+}
+
+// TestDeleteQuarantinedTask test
+func (s *QuarantinePersistenceSuite) TestDeleteQuarantinedTask() {
+	shardID := 12
+	visibilityTimestamp := time.Now()
+	for taskID := int64(1); taskID <= 2; taskID++ {
+		err := s.putQuarantinedTask(shardID, p.QuarantineQueueTypeTimer, taskID, visibilityTimestamp)
+		s.Nil(err)
+	}
+
+	err := s.QuarantineMgr.DeleteQuarantinedTask(&p.DeleteQuarantinedTaskRequest{
+		ShardID:             shardID,
+		QueueType:           p.QuarantineQueueTypeTimer,
+		TaskID:              1,
+		VisibilityTimestamp: visibilityTimestamp,
+	})
+	s.Nil(err)
+
+	tasks, _, err := s.getQuarantinedTasks(shardID, p.QuarantineQueueTypeTimer, 10, nil)
+	s.Nil(err)
+	s.Equal(1, len(tasks))
+	s.Equal(int64(2), tasks[0].TaskID)
+
+	_, err = s.QuarantineMgr.GetQuarantinedTask(&p.GetQuarantinedTaskRequest{
+		ShardID:             shardID,
+		QueueType:           p.QuarantineQueueTypeTimer,
+		TaskID:              1,
+		VisibilityTimestamp: visibilityTimestamp,
+	})
+	s.IsType(&gen.EntityNotExistsError{}, err)
+}
+
+func (s *QuarantinePersistenceSuite) putQuarantinedTask(shardID, queueType int, taskID int64,
+	visibilityTimestamp time.Time) error {
+	return s.QuarantineMgr.PutQuarantinedTask(&p.PutQuarantinedTaskRequest{
+		TaskInfo: &p.QuarantinedTaskInfo{
+			ShardID:             shardID,
+			QueueType:           queueType,
+			TaskID:              taskID,
+			VisibilityTimestamp: visibilityTimestamp,
+			DomainID:            uuid.New(),
+			WorkflowID:          "quarantine-test-workflow",
+			RunID:               uuid.New(),
+			TaskType:            p.TransferTaskTypeDecisionTask,
+			Attempt:             100,
+			CreatedTime:         time.Now(),
+			Error:               "task failed",
+			StackTrace:          "goroutine 1 [running]",
+			Data:                []byte("{}"),
+			Encoding:            common.EncodingTypeJSON,
+		},
+	})
+}
+
+func (s *QuarantinePersistenceSuite) getQuarantinedTasks(shardID, queueType int, batchSize int,
+	token []byte) ([]*p.QuarantinedTaskInfo, []byte, error) {
+	response, err := s.QuarantineMgr.GetQuarantinedTasks(&p.GetQuarantinedTasksRequest{
+		ShardID:       shardID,
+		QueueType:     queueType,
+		BatchSize:     batchSize,
+		NextPageToken: token,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return response.Tasks, response.NextPageToken, nil
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencetests
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"github.com/uber/cadence/common"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// ReplicationDLQPersistenceSuite contains replication DLQ persistence tests
+	ReplicationDLQPersistenceSuite struct {
+		TestBase
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+// SetupSuite implementation
+func (s *ReplicationDLQPersistenceSuite) SetupSuite() {
+	if testing.Verbose() {
+		log.SetOutput(os.Stdout)
+	}
+}
+
+// SetupTest implementation
+func (s *ReplicationDLQPersistenceSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+}
+
+// TearDownSuite implementation
+func (s *ReplicationDLQPersistenceSuite) TearDownSuite() {
+	s.TearDownWorkflowStore()
+}
+
+// TestPutAndGetReplicationTasks test
+func (s *ReplicationDLQPersistenceSuite) TestPutAndGetReplicationTasks() {
+	sourceCluster := "test_put_and_get_cluster"
+	shardID := 1
+	for taskID := int64(1); taskID <= 3; taskID++ {
+		err := s.putReplicationTaskToDLQ(sourceCluster, shardID, taskID)
+		s.Nil(err)
+	}
+	// task of another shard should not be returned
+	s.Nil(s.putReplicationTaskToDLQ(sourceCluster, shardID+1, 2))
+
+	tasks, token, err := s.getReplicationTasksFromDLQ(sourceCluster, shardID, 0, 2, 10, nil)
+	s.Nil(err)
+	s.Empty(token)
+	s.Equal(2, len(tasks))
+	s.Equal(int64(1), tasks[0].TaskID)
+	s.Equal(int64(2), tasks[1].TaskID)
+	s.Equal(sourceCluster, tasks[1].SourceCluster)
+	s.Equal(shardID, tasks[1].ShardID)
+	s.Equal([]byte("task-2"), tasks[1].Data)
+	s.Equal(common.EncodingTypeThriftRW, tasks[1].Encoding)
+
+	tasks, token, err = s.getReplicationTasksFromDLQ(sourceCluster, shardID, 0, 3, 2, nil)
+	s.Nil(err)
+	s.NotEmpty(token)
+	s.Equal(2, len(tasks))
+	tasks, token, err = s.getReplicationTasksFromDLQ(sourceCluster, shardID, 0, 3, 2, token)
+	s.Nil(err)
+	s.Equal(1, len(tasks))
+	s.Equal(int64(3), tasks[0].TaskID)
+}
+
+// TestDeleteReplicationTasks test
+func (s *ReplicationDLQPersistenceSuite) TestDeleteReplicationTasks() {
+	sourceCluster := "test_delete_cluster"
+	shardID := 1
+	for taskID := int64(1); taskID <= 5; taskID++ {
+		err := s.putReplicationTaskToDLQ(sourceCluster, shardID, taskID)
+		s.Nil(err)
+	}
+
+	err := s.ReplicationDLQMgr.DeleteReplicationTaskFromDLQ(&p.DeleteReplicationTaskFromDLQRequest{
+		SourceCluster: sourceCluster,
+		ShardID:       shardID,
+		TaskID:        1,
+	})
+	s.Nil(err)
+
+	err = s.ReplicationDLQMgr.RangeDeleteReplicationTaskFromDLQ(&p.RangeDeleteReplicationTaskFromDLQRequest{
+		SourceCluster:        sourceCluster,
+		ShardID:              shardID,
+		ExclusiveBeginTaskID: 2,
+		InclusiveEndTaskID:   4,
+	})
+	s.Nil(err)
+
+	tasks, _, err := s.getReplicationTasksFromDLQ(sourceCluster, shardID, 0, 5, 10, nil)
+	s.Nil(err)
+	s.Equal(2, len(tasks))
+	s.Equal(int64(2), tasks[0].TaskID)
+	s.Equal(int64(5), tasks[1].TaskID)
+}
+
+func (s *ReplicationDLQPersistenceSuite) putReplicationTaskToDLQ(sourceCluster string, shardID int, taskID int64) error {
+	return s.ReplicationDLQMgr.PutReplicationTaskToDLQ(&p.PutReplicationTaskToDLQRequest{
+		TaskInfo: &p.ReplicationDLQTaskInfo{
+			SourceCluster: sourceCluster,
+			ShardID:       shardID,
+			TaskID:        taskID,
+			CreatedTime:   time.Now(),
+			Data:          []byte(fmt.Sprintf("task-%v", taskID)),
+			Encoding:      common.EncodingTypeThriftRW,
+		},
+	})
+}
+
+func (s *ReplicationDLQPersistenceSuite) getReplicationTasksFromDLQ(sourceCluster string, shardID int, readLevel,
+	maxReadLevel int64, batchSize int, token []byte) ([]*p.ReplicationDLQTaskInfo, []byte, error) {
+	response, err := s.ReplicationDLQMgr.GetReplicationTasksFromDLQ(&p.GetReplicationTasksFromDLQRequest{
+		SourceCluster: sourceCluster,
+		ShardID:       shardID,
+		ReadLevel:     readLevel,
+		MaxReadLevel:  maxReadLevel,
+		BatchSize:     batchSize,
+		NextPageToken: token,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return response.Tasks, response.NextPageToken, nil
+}
@@ -455,3 +455,55 @@ func (s *VisibilityPersistenceSuite) TestGetClosedExecution() {
 	s.Equal(workflowExecution.WorkflowId, resp.Execution.Execution.WorkflowId)
 	s.Equal(int64(3), *resp.Execution.HistoryLength)
 }
+
+// TestDeleteWorkflowExecutionsByDomain test
+func (s *VisibilityPersistenceSuite) TestDeleteWorkflowExecutionsByDomain() {
+	testDomainUUID := uuid.New()
+
+	openExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("visibility-delete-test-open"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	closedExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("visibility-delete-test-closed"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+
+	startTime := time.Now().Add(time.Second * -5).UnixNano()
+	for _, execution := range []gen.WorkflowExecution{openExecution, closedExecution} {
+		err := s.VisibilityMgr.RecordWorkflowExecutionStarted(&p.RecordWorkflowExecutionStartedRequest{
+			DomainUUID:       testDomainUUID,
+			Execution:        execution,
+			WorkflowTypeName: "visibility-workflow",
+			StartTimestamp:   startTime,
+		})
+		s.Nil(err)
+	}
+	err := s.VisibilityMgr.RecordWorkflowExecutionClosed(&p.RecordWorkflowExecutionClosedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        closedExecution,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		CloseTimestamp:   time.Now().UnixNano(),
+		HistoryLength:    3,
+	})
+	s.Nil(err)
+
+	err = s.VisibilityMgr.DeleteWorkflowExecutionsByDomain(&p.DeleteWorkflowExecutionsByDomainRequest{
+		DomainUUID: testDomainUUID,
+	})
+	s.Nil(err)
+
+	listRequest := &p.ListWorkflowExecutionsRequest{
+		DomainUUID:        testDomainUUID,
+		EarliestStartTime: startTime,
+		LatestStartTime:   time.Now().UnixNano(),
+		PageSize:          10,
+	}
+	openResp, err := s.VisibilityMgr.ListOpenWorkflowExecutions(listRequest)
+	s.Nil(err)
+	s.Equal(0, len(openResp.Executions))
+	closedResp, err := s.VisibilityMgr.ListClosedWorkflowExecutions(listRequest)
+	s.Nil(err)
+	s.Equal(0, len(closedResp.Executions))
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+const (
+	// domainBucketIdleTimeout is the time after which the bucket of a domain without
+	// requests is evicted, so that the buckets of deleted or idle domains are not kept
+	domainBucketIdleTimeout = 10 * time.Minute
+)
+
+type (
+	// DomainRateLimiter decides whether a persistence request issued on behalf of
+	// a domain is allowed to go through. Each domain is capped by its own quota,
+	// and the requests of all domains are limited by a quota shared by all domains.
+	DomainRateLimiter interface {
+		// Allow returns true if a request for the given domain can be issued
+		Allow(domainID string) bool
+	}
+
+	domainRateLimiterImpl struct {
+		sync.RWMutex
+		globalLimiter common.TokenBucket
+		domainMaxQPS  dynamicconfig.IntPropertyFnWithDomainIDFilter
+		metricsClient metrics.Client
+		timeSource    common.TimeSource
+		domainBuckets map[string]*domainTokenBucket
+		// lastEvictTime is the last time the buckets of the idle domains were evicted
+		lastEvictTime time.Time
+	}
+
+	domainTokenBucket struct {
+		qps           int
+		rateLimiter   common.TokenBucket
+		metricsClient metrics.Client
+		// lastUsedTime is the unix nano time of the last request of the domain
+		lastUsedTime int64
+	}
+)
+
+var _ DomainRateLimiter = (*domainRateLimiterImpl)(nil)
+
+// NewDomainRateLimiter creates a domain aware rate limiter. globalLimiter is the
+// quota shared by all domains, domainMaxQPS returns the quota of a given domain
+// id; a quota of 0 means the domain is only limited by the shared quota.
+func NewDomainRateLimiter(
+	globalLimiter common.TokenBucket,
+	domainMaxQPS dynamicconfig.IntPropertyFnWithDomainIDFilter,
+	metricsClient metrics.Client,
+) DomainRateLimiter {
+	timeSource := common.NewRealTimeSource()
+	return &domainRateLimiterImpl{
+		globalLimiter: globalLimiter,
+		domainMaxQPS:  domainMaxQPS,
+		metricsClient: metricsClient,
+		timeSource:    timeSource,
+		domainBuckets: make(map[string]*domainTokenBucket),
+		lastEvictTime: timeSource.Now(),
+	}
+}
+
+func (r *domainRateLimiterImpl) Allow(domainID string) bool {
+	if domainID == "" || r.domainMaxQPS == nil {
+		ok, _ := r.globalLimiter.TryConsume(1)
+		return ok
+	}
+
+	bucket := r.getDomainBucket(domainID)
+	atomic.StoreInt64(&bucket.lastUsedTime, r.timeSource.Now().UnixNano())
+	if bucket.rateLimiter != nil {
+		if ok, _ := bucket.rateLimiter.TryConsume(1); !ok {
+			bucket.incThrottledCounter()
+			return false
+		}
+	}
+	if ok, _ := r.globalLimiter.TryConsume(1); !ok {
+		// the request is not issued, the domain quota is left for its next requests
+		if bucket.rateLimiter != nil {
+			bucket.rateLimiter.Return(1)
+		}
+		bucket.incThrottledCounter()
+		return false
+	}
+	return true
+}
+
+// getDomainBucket returns the token bucket for the domain, the bucket is
+// recreated whenever the configured quota of the domain changes
+func (r *domainRateLimiterImpl) getDomainBucket(domainID string) *domainTokenBucket {
+	qps := r.domainMaxQPS(domainID)
+
+	r.RLock()
+	bucket, ok := r.domainBuckets[domainID]
+	r.RUnlock()
+	if ok && bucket.qps == qps {
+		return bucket
+	}
+
+	r.Lock()
+	defer r.Unlock()
+	if bucket, ok = r.domainBuckets[domainID]; ok && bucket.qps == qps { // read again to ensure no duplicate create
+		return bucket
+	}
+
+	newBucket := &domainTokenBucket{qps: qps}
+	if qps > 0 {
+		newBucket.rateLimiter = common.NewTokenBucket(qps, r.timeSource)
+	}
+	if ok {
+		newBucket.metricsClient = bucket.metricsClient
+	} else if r.metricsClient != nil {
+		newBucket.metricsClient = r.metricsClient.Tagged(map[string]string{metrics.DomainIDTagName: domainID})
+	}
+	r.evictIdleBuckets()
+	r.domainBuckets[domainID] = newBucket
+	return newBucket
+}
+
+// evictIdleBuckets removes the buckets of the domains without requests for domainBucketIdleTimeout,
+// it is called with the lock held whenever a bucket is added
+func (r *domainRateLimiterImpl) evictIdleBuckets() {
+	now := r.timeSource.Now()
+	if now.Sub(r.lastEvictTime) < domainBucketIdleTimeout {
+		return
+	}
+	r.lastEvictTime = now
+	for domainID, bucket := range r.domainBuckets {
+		if now.Sub(time.Unix(0, atomic.LoadInt64(&bucket.lastUsedTime))) >= domainBucketIdleTimeout {
+			delete(r.domainBuckets, domainID)
+		}
+	}
+}
+
+func (b *domainTokenBucket) incThrottledCounter() {
+	if b.metricsClient != nil {
+		b.metricsClient.IncCounter(metrics.PersistenceDomainRateLimiterScope, metrics.PersistenceDomainThrottledCounter)
+	}
+}
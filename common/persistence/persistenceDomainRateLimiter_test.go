@@ -0,0 +1,146 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	domainRateLimiterSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+
+		timeSource   *frozenTimeSource
+		domainMaxQPS map[string]int
+	}
+
+	// frozenTimeSource never advances so token buckets are never refilled
+	frozenTimeSource struct {
+		now time.Time
+	}
+)
+
+func (ts *frozenTimeSource) Now() time.Time {
+	return ts.now
+}
+
+func TestDomainRateLimiterSuite(t *testing.T) {
+	s := new(domainRateLimiterSuite)
+	suite.Run(t, s)
+}
+
+func (s *domainRateLimiterSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.timeSource = &frozenTimeSource{now: time.Now()}
+	s.domainMaxQPS = make(map[string]int)
+}
+
+// newRateLimiter creates a limiter whose buckets hold qps/10 tokens, i.e. one refill interval worth of tokens
+func (s *domainRateLimiterSuite) newRateLimiter(globalQPS int) *domainRateLimiterImpl {
+	limiter := NewDomainRateLimiter(
+		common.NewTokenBucket(globalQPS, s.timeSource),
+		func(domainID string) int { return s.domainMaxQPS[domainID] },
+		metrics.NewClient(tally.NoopScope, metrics.History),
+	).(*domainRateLimiterImpl)
+	limiter.timeSource = s.timeSource
+	limiter.lastEvictTime = s.timeSource.now
+	return limiter
+}
+
+func (s *domainRateLimiterSuite) TestAllow_NoDomain() {
+	limiter := s.newRateLimiter(20)
+	s.True(limiter.Allow(""))
+	s.True(limiter.Allow(""))
+	s.False(limiter.Allow(""))
+}
+
+func (s *domainRateLimiterSuite) TestAllow_NoDomainQuota() {
+	limiter := s.newRateLimiter(20)
+	s.True(limiter.Allow("domain-1"))
+	s.True(limiter.Allow("domain-2"))
+	s.False(limiter.Allow("domain-1"))
+	s.False(limiter.Allow("domain-2"))
+}
+
+func (s *domainRateLimiterSuite) TestAllow_DomainQuotaCapsDomain() {
+	s.domainMaxQPS["domain-1"] = 10
+	limiter := s.newRateLimiter(30)
+
+	s.True(limiter.Allow("domain-1"))
+	// domain-1 is capped by its own quota, the shared quota is left to the other domains
+	s.False(limiter.Allow("domain-1"))
+	s.True(limiter.Allow("domain-2"))
+	s.True(limiter.Allow("domain-2"))
+	s.False(limiter.Allow("domain-2"))
+}
+
+func (s *domainRateLimiterSuite) TestAllow_SharedQuotaLimitsDomain() {
+	s.domainMaxQPS["domain-1"] = 20
+	limiter := s.newRateLimiter(10)
+
+	s.True(limiter.Allow("domain-1"))
+	// the domain quota does not add to the shared quota
+	s.False(limiter.Allow("domain-1"))
+	s.False(limiter.Allow("domain-2"))
+
+	// the domain token taken by the rejected request is given back
+	ok, _ := limiter.domainBuckets["domain-1"].rateLimiter.TryConsume(1)
+	s.True(ok)
+}
+
+func (s *domainRateLimiterSuite) TestAllow_DomainQuotaChange() {
+	s.domainMaxQPS["domain-1"] = 10
+	limiter := s.newRateLimiter(100)
+
+	s.True(limiter.Allow("domain-1"))
+	s.False(limiter.Allow("domain-1"))
+
+	s.domainMaxQPS["domain-1"] = 20
+	s.True(limiter.Allow("domain-1"))
+	s.True(limiter.Allow("domain-1"))
+	s.False(limiter.Allow("domain-1"))
+
+	s.domainMaxQPS["domain-1"] = 0
+	s.True(limiter.Allow("domain-1"))
+}
+
+func (s *domainRateLimiterSuite) TestAllow_IdleDomainEvicted() {
+	s.domainMaxQPS["domain-1"] = 10
+	limiter := s.newRateLimiter(100)
+
+	s.True(limiter.Allow("domain-1"))
+	s.Len(limiter.domainBuckets, 1)
+
+	s.timeSource.now = s.timeSource.now.Add(domainBucketIdleTimeout)
+	s.True(limiter.Allow("domain-2"))
+	s.Len(limiter.domainBuckets, 1)
+	s.Contains(limiter.domainBuckets, "domain-2")
+}
@@ -45,6 +45,10 @@ type (
 	MetadataStore = MetadataManager
 	// VisibilityStore is the store interface for visibility
 	VisibilityStore = VisibilityManager
+	// ReplicationDLQStore is a lower level of ReplicationDLQManager
+	ReplicationDLQStore = ReplicationDLQManager
+	// QuarantineStore is a lower level of QuarantineManager
+	QuarantineStore = QuarantineManager
 
 	// ExecutionStore is used to manage workflow executions for Persistence layer
 	ExecutionStore interface {
@@ -58,6 +62,7 @@ type (
 
 		CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error)
 		DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error
+		DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error
 		GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error)
 
 		// Transfer task related methods
@@ -149,8 +154,11 @@ type (
 		DecisionTimeout              int32
 		DecisionAttempt              int64
 		DecisionTimestamp            int64
+		HasDecisionBackoff           bool
+		HasEventsV1History           bool
 		CancelRequested              bool
 		CancelRequestID              string
+		Paused                       bool
 		StickyTaskList               string
 		StickyScheduleToStartTimeout int32
 		ClientLibraryVersion         string
@@ -390,6 +398,8 @@ type (
 	InternalReadHistoryBranchResponse struct {
 		// History events
 		History []*DataBlob
+		// Node IDs of the history events batches
+		NodeIDs []int64
 		// Pagination token
 		NextPageToken []byte
 	}
@@ -400,7 +410,7 @@ func NewDataBlob(data []byte, encodingType common.EncodingType) *DataBlob {
 	if data == nil || len(data) == 0 {
 		return nil
 	}
-	if encodingType != "thriftrw" && data[0] == 'Y' {
+	if !isThriftRWEncoding(encodingType) && data[0] == 'Y' {
 		panic(fmt.Sprintf("Invlid incoding: \"%v\"", encodingType))
 	}
 	return &DataBlob{
@@ -428,7 +438,34 @@ func (d *DataBlob) GetEncoding() common.EncodingType {
 		return common.EncodingTypeJSON
 	case common.EncodingTypeThriftRW:
 		return common.EncodingTypeThriftRW
+	case common.EncodingTypeThriftRWSnappy:
+		return common.EncodingTypeThriftRWSnappy
+	case common.EncodingTypeThriftRWGzip:
+		return common.EncodingTypeThriftRWGzip
 	default:
 		return common.EncodingTypeUnknown
 	}
 }
+
+// ToThrift converts the blob into the DataBlob returned to clients of the raw history APIs.
+// Compressed thriftrw blobs are decompressed, since clients only understand plain thriftrw and json.
+func (d *DataBlob) ToThrift() (*workflow.DataBlob, error) {
+	switch encoding := d.GetEncoding(); encoding {
+	case common.EncodingTypeJSON:
+		return &workflow.DataBlob{
+			EncodingType: workflow.EncodingTypeJSON.Ptr(),
+			Data:         d.Data,
+		}, nil
+	case common.EncodingTypeThriftRW, common.EncodingTypeThriftRWSnappy, common.EncodingTypeThriftRWGzip:
+		data, err := decompress(d.Data, encoding)
+		if err != nil {
+			return nil, NewHistoryDeserializationError(fmt.Sprintf("ToThrift encoding: \"%v\", error: %v", d.Encoding, err.Error()))
+		}
+		return &workflow.DataBlob{
+			EncodingType: workflow.EncodingTypeThriftRW.Ptr(),
+			Data:         data,
+		}, nil
+	default:
+		return nil, NewUnknownEncodingTypeError(encoding)
+	}
+}
@@ -58,6 +58,18 @@ type (
 		logger       bark.Logger
 	}
 
+	replicationDLQPersistenceClient struct {
+		metricClient metrics.Client
+		persistence  ReplicationDLQManager
+		logger       bark.Logger
+	}
+
+	quarantinePersistenceClient struct {
+		metricClient metrics.Client
+		persistence  QuarantineManager
+		logger       bark.Logger
+	}
+
 	metadataPersistenceClient struct {
 		metricClient metrics.Client
 		persistence  MetadataManager
@@ -78,6 +90,8 @@ var _ HistoryManager = (*historyPersistenceClient)(nil)
 var _ HistoryV2Manager = (*historyV2PersistenceClient)(nil)
 var _ MetadataManager = (*metadataPersistenceClient)(nil)
 var _ VisibilityManager = (*visibilityPersistenceClient)(nil)
+var _ ReplicationDLQManager = (*replicationDLQPersistenceClient)(nil)
+var _ QuarantineManager = (*quarantinePersistenceClient)(nil)
 
 // NewShardPersistenceMetricsClient creates a client to manage shards
 func NewShardPersistenceMetricsClient(persistence ShardManager, metricClient metrics.Client, logger bark.Logger) ShardManager {
@@ -133,6 +147,24 @@ func NewMetadataPersistenceMetricsClient(persistence MetadataManager, metricClie
 	}
 }
 
+// NewReplicationDLQPersistenceMetricsClient creates a client to manage the replication DLQ
+func NewReplicationDLQPersistenceMetricsClient(persistence ReplicationDLQManager, metricClient metrics.Client, logger bark.Logger) ReplicationDLQManager {
+	return &replicationDLQPersistenceClient{
+		persistence:  persistence,
+		metricClient: metricClient,
+		logger:       logger,
+	}
+}
+
+// NewQuarantinePersistenceMetricsClient creates a client to manage the task quarantine
+func NewQuarantinePersistenceMetricsClient(persistence QuarantineManager, metricClient metrics.Client, logger bark.Logger) QuarantineManager {
+	return &quarantinePersistenceClient{
+		persistence:  persistence,
+		metricClient: metricClient,
+		logger:       logger,
+	}
+}
+
 // NewVisibilityPersistenceMetricsClient creates a client to manage visibility
 func NewVisibilityPersistenceMetricsClient(persistence VisibilityManager, metricClient metrics.Client, logger bark.Logger) VisibilityManager {
 	return &visibilityPersistenceClient{
@@ -291,6 +323,20 @@ func (p *workflowExecutionPersistenceClient) DeleteWorkflowExecution(request *De
 	return err
 }
 
+func (p *workflowExecutionPersistenceClient) DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceDeleteCurrentWorkflowExecutionScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteCurrentWorkflowExecutionScope, metrics.PersistenceLatency)
+	err := p.persistence.DeleteCurrentWorkflowExecution(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceDeleteCurrentWorkflowExecutionScope, err)
+	}
+
+	return err
+}
+
 func (p *workflowExecutionPersistenceClient) GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetCurrentExecutionScope, metrics.PersistenceRequests)
 
@@ -495,6 +541,20 @@ func (p *taskPersistenceClient) CompleteTask(request *CompleteTaskRequest) error
 	return err
 }
 
+func (p *taskPersistenceClient) DeleteTaskList(request *DeleteTaskListRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceDeleteTaskListScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteTaskListScope, metrics.PersistenceLatency)
+	err := p.persistence.DeleteTaskList(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceDeleteTaskListScope, err)
+	}
+
+	return err
+}
+
 func (p *taskPersistenceClient) LeaseTaskList(request *LeaseTaskListRequest) (*LeaseTaskListResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceLeaseTaskListScope, metrics.PersistenceRequests)
 
@@ -579,6 +639,21 @@ func (p *historyPersistenceClient) GetWorkflowExecutionHistory(
 	return response, err
 }
 
+func (p *historyPersistenceClient) GetWorkflowExecutionHistoryByBatch(
+	request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryByBatchResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetWorkflowExecutionHistoryScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetWorkflowExecutionHistoryScope, metrics.PersistenceLatency)
+	response, err := p.persistence.GetWorkflowExecutionHistoryByBatch(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceGetWorkflowExecutionHistoryScope, err)
+	}
+
+	return response, err
+}
+
 func (p *historyPersistenceClient) DeleteWorkflowExecutionHistory(
 	request *DeleteWorkflowExecutionHistoryRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteWorkflowExecutionHistoryScope, metrics.PersistenceRequests)
@@ -889,6 +964,20 @@ func (p *visibilityPersistenceClient) GetClosedWorkflowExecution(request *GetClo
 	return response, err
 }
 
+func (p *visibilityPersistenceClient) DeleteWorkflowExecutionsByDomain(request *DeleteWorkflowExecutionsByDomainRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceDeleteWorkflowExecutionsByDomainScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteWorkflowExecutionsByDomainScope, metrics.PersistenceLatency)
+	err := p.persistence.DeleteWorkflowExecutionsByDomain(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceDeleteWorkflowExecutionsByDomainScope, err)
+	}
+
+	return err
+}
+
 func (p *visibilityPersistenceClient) updateErrorMetric(scope int, err error) {
 	switch err.(type) {
 	case *ConditionFailedError:
@@ -946,6 +1035,18 @@ func (p *historyV2PersistenceClient) ReadHistoryBranch(request *ReadHistoryBranc
 	return response, err
 }
 
+// ReadRawHistoryBranch returns history node raw data for a branch
+func (p *historyV2PersistenceClient) ReadRawHistoryBranch(request *ReadHistoryBranchRequest) (*ReadRawHistoryBranchResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceReadRawHistoryBranchScope, metrics.PersistenceRequests)
+	sw := p.metricClient.StartTimer(metrics.PersistenceReadRawHistoryBranchScope, metrics.PersistenceLatency)
+	response, err := p.persistence.ReadRawHistoryBranch(request)
+	sw.Stop()
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceReadRawHistoryBranchScope, err)
+	}
+	return response, err
+}
+
 // ForkHistoryBranch forks a new branch from a old branch
 func (p *historyV2PersistenceClient) ForkHistoryBranch(request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceForkHistoryBranchScope, metrics.PersistenceRequests)
@@ -1002,3 +1103,164 @@ func (p *historyV2PersistenceClient) updateErrorMetric(scope int, err error) {
 		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
 	}
 }
+
+func (p *replicationDLQPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *replicationDLQPersistenceClient) PutReplicationTaskToDLQ(request *PutReplicationTaskToDLQRequest) error {
+	p.metricClient.IncCounter(metrics.PersistencePutReplicationTaskToDLQScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistencePutReplicationTaskToDLQScope, metrics.PersistenceLatency)
+	err := p.persistence.PutReplicationTaskToDLQ(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistencePutReplicationTaskToDLQScope, err)
+	}
+
+	return err
+}
+
+func (p *replicationDLQPersistenceClient) GetReplicationTasksFromDLQ(
+	request *GetReplicationTasksFromDLQRequest) (*GetReplicationTasksFromDLQResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetReplicationTasksFromDLQScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetReplicationTasksFromDLQScope, metrics.PersistenceLatency)
+	response, err := p.persistence.GetReplicationTasksFromDLQ(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceGetReplicationTasksFromDLQScope, err)
+	}
+
+	return response, err
+}
+
+func (p *replicationDLQPersistenceClient) DeleteReplicationTaskFromDLQ(request *DeleteReplicationTaskFromDLQRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceDeleteReplicationTaskFromDLQScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteReplicationTaskFromDLQScope, metrics.PersistenceLatency)
+	err := p.persistence.DeleteReplicationTaskFromDLQ(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceDeleteReplicationTaskFromDLQScope, err)
+	}
+
+	return err
+}
+
+func (p *replicationDLQPersistenceClient) RangeDeleteReplicationTaskFromDLQ(request *RangeDeleteReplicationTaskFromDLQRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceRangeDeleteReplicationTaskFromDLQScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceRangeDeleteReplicationTaskFromDLQScope, metrics.PersistenceLatency)
+	err := p.persistence.RangeDeleteReplicationTaskFromDLQ(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceRangeDeleteReplicationTaskFromDLQScope, err)
+	}
+
+	return err
+}
+
+func (p *replicationDLQPersistenceClient) updateErrorMetric(scope int, err error) {
+	switch err.(type) {
+	case *workflow.ServiceBusyError:
+		p.metricClient.IncCounter(scope, metrics.PersistenceErrBusyCounter)
+		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	default:
+		p.logger.WithFields(bark.Fields{
+			logging.TagScope: scope,
+			logging.TagErr:   err,
+		}).Error("Operation failed with internal error.")
+		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	}
+}
+
+func (p *replicationDLQPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *quarantinePersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *quarantinePersistenceClient) PutQuarantinedTask(request *PutQuarantinedTaskRequest) error {
+	p.metricClient.IncCounter(metrics.PersistencePutQuarantinedTaskScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistencePutQuarantinedTaskScope, metrics.PersistenceLatency)
+	err := p.persistence.PutQuarantinedTask(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistencePutQuarantinedTaskScope, err)
+	}
+
+	return err
+}
+
+func (p *quarantinePersistenceClient) GetQuarantinedTasks(
+	request *GetQuarantinedTasksRequest) (*GetQuarantinedTasksResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetQuarantinedTasksScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetQuarantinedTasksScope, metrics.PersistenceLatency)
+	response, err := p.persistence.GetQuarantinedTasks(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceGetQuarantinedTasksScope, err)
+	}
+
+	return response, err
+}
+
+func (p *quarantinePersistenceClient) GetQuarantinedTask(
+	request *GetQuarantinedTaskRequest) (*GetQuarantinedTaskResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetQuarantinedTaskScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetQuarantinedTaskScope, metrics.PersistenceLatency)
+	response, err := p.persistence.GetQuarantinedTask(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceGetQuarantinedTaskScope, err)
+	}
+
+	return response, err
+}
+
+func (p *quarantinePersistenceClient) DeleteQuarantinedTask(request *DeleteQuarantinedTaskRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceDeleteQuarantinedTaskScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteQuarantinedTaskScope, metrics.PersistenceLatency)
+	err := p.persistence.DeleteQuarantinedTask(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceDeleteQuarantinedTaskScope, err)
+	}
+
+	return err
+}
+
+func (p *quarantinePersistenceClient) updateErrorMetric(scope int, err error) {
+	switch err.(type) {
+	case *workflow.EntityNotExistsError:
+		p.metricClient.IncCounter(scope, metrics.PersistenceErrEntityNotExistsCounter)
+	case *workflow.ServiceBusyError:
+		p.metricClient.IncCounter(scope, metrics.PersistenceErrBusyCounter)
+		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	default:
+		p.logger.WithFields(bark.Fields{
+			logging.TagScope: scope,
+			logging.TagErr:   err,
+		}).Error("Operation failed with internal error.")
+		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	}
+}
+
+func (p *quarantinePersistenceClient) Close() {
+	p.persistence.Close()
+}
@@ -23,7 +23,6 @@ package persistence
 import (
 	"github.com/uber-common/bark"
 	workflow "github.com/uber/cadence/.gen/go/shared"
-	"github.com/uber/cadence/common"
 )
 
 var (
@@ -35,46 +34,58 @@ var (
 
 type (
 	shardRateLimitedPersistenceClient struct {
-		rateLimiter common.TokenBucket
+		rateLimiter DomainRateLimiter
 		persistence ShardManager
 		logger      bark.Logger
 	}
 
 	workflowExecutionRateLimitedPersistenceClient struct {
-		rateLimiter common.TokenBucket
+		rateLimiter DomainRateLimiter
 		persistence ExecutionManager
 		logger      bark.Logger
 	}
 
 	taskRateLimitedPersistenceClient struct {
-		rateLimiter common.TokenBucket
+		rateLimiter DomainRateLimiter
 		persistence TaskManager
 		logger      bark.Logger
 	}
 
 	historyRateLimitedPersistenceClient struct {
-		rateLimiter common.TokenBucket
+		rateLimiter DomainRateLimiter
 		persistence HistoryManager
 		logger      bark.Logger
 	}
 
 	historyV2RateLimitedPersistenceClient struct {
-		rateLimiter common.TokenBucket
+		rateLimiter DomainRateLimiter
 		persistence HistoryV2Manager
 		logger      bark.Logger
 	}
 
 	metadataRateLimitedPersistenceClient struct {
-		rateLimiter common.TokenBucket
+		rateLimiter DomainRateLimiter
 		persistence MetadataManager
 		logger      bark.Logger
 	}
 
 	visibilityRateLimitedPersistenceClient struct {
-		rateLimiter common.TokenBucket
+		rateLimiter DomainRateLimiter
 		persistence VisibilityManager
 		logger      bark.Logger
 	}
+
+	replicationDLQRateLimitedPersistenceClient struct {
+		rateLimiter DomainRateLimiter
+		persistence ReplicationDLQManager
+		logger      bark.Logger
+	}
+
+	quarantineRateLimitedPersistenceClient struct {
+		rateLimiter DomainRateLimiter
+		persistence QuarantineManager
+		logger      bark.Logger
+	}
 )
 
 var _ ShardManager = (*shardRateLimitedPersistenceClient)(nil)
@@ -84,9 +95,11 @@ var _ HistoryManager = (*historyRateLimitedPersistenceClient)(nil)
 var _ HistoryV2Manager = (*historyV2RateLimitedPersistenceClient)(nil)
 var _ MetadataManager = (*metadataRateLimitedPersistenceClient)(nil)
 var _ VisibilityManager = (*visibilityRateLimitedPersistenceClient)(nil)
+var _ ReplicationDLQManager = (*replicationDLQRateLimitedPersistenceClient)(nil)
+var _ QuarantineManager = (*quarantineRateLimitedPersistenceClient)(nil)
 
 // NewShardPersistenceRateLimitedClient creates a client to manage shards
-func NewShardPersistenceRateLimitedClient(persistence ShardManager, rateLimiter common.TokenBucket, logger bark.Logger) ShardManager {
+func NewShardPersistenceRateLimitedClient(persistence ShardManager, rateLimiter DomainRateLimiter, logger bark.Logger) ShardManager {
 	return &shardRateLimitedPersistenceClient{
 		persistence: persistence,
 		rateLimiter: rateLimiter,
@@ -95,7 +108,7 @@ func NewShardPersistenceRateLimitedClient(persistence ShardManager, rateLimiter
 }
 
 // NewWorkflowExecutionPersistenceRateLimitedClient creates a client to manage executions
-func NewWorkflowExecutionPersistenceRateLimitedClient(persistence ExecutionManager, rateLimiter common.TokenBucket, logger bark.Logger) ExecutionManager {
+func NewWorkflowExecutionPersistenceRateLimitedClient(persistence ExecutionManager, rateLimiter DomainRateLimiter, logger bark.Logger) ExecutionManager {
 	return &workflowExecutionRateLimitedPersistenceClient{
 		persistence: persistence,
 		rateLimiter: rateLimiter,
@@ -104,7 +117,7 @@ func NewWorkflowExecutionPersistenceRateLimitedClient(persistence ExecutionManag
 }
 
 // NewTaskPersistenceRateLimitedClient creates a client to manage tasks
-func NewTaskPersistenceRateLimitedClient(persistence TaskManager, rateLimiter common.TokenBucket, logger bark.Logger) TaskManager {
+func NewTaskPersistenceRateLimitedClient(persistence TaskManager, rateLimiter DomainRateLimiter, logger bark.Logger) TaskManager {
 	return &taskRateLimitedPersistenceClient{
 		persistence: persistence,
 		rateLimiter: rateLimiter,
@@ -113,7 +126,7 @@ func NewTaskPersistenceRateLimitedClient(persistence TaskManager, rateLimiter co
 }
 
 // NewHistoryPersistenceRateLimitedClient creates a HistoryManager client to manage workflow execution history
-func NewHistoryPersistenceRateLimitedClient(persistence HistoryManager, rateLimiter common.TokenBucket, logger bark.Logger) HistoryManager {
+func NewHistoryPersistenceRateLimitedClient(persistence HistoryManager, rateLimiter DomainRateLimiter, logger bark.Logger) HistoryManager {
 	return &historyRateLimitedPersistenceClient{
 		persistence: persistence,
 		rateLimiter: rateLimiter,
@@ -122,7 +135,7 @@ func NewHistoryPersistenceRateLimitedClient(persistence HistoryManager, rateLimi
 }
 
 // NewHistoryV2PersistenceRateLimitedClient creates a HistoryManager client to manage workflow execution history
-func NewHistoryV2PersistenceRateLimitedClient(persistence HistoryV2Manager, rateLimiter common.TokenBucket, logger bark.Logger) HistoryV2Manager {
+func NewHistoryV2PersistenceRateLimitedClient(persistence HistoryV2Manager, rateLimiter DomainRateLimiter, logger bark.Logger) HistoryV2Manager {
 	return &historyV2RateLimitedPersistenceClient{
 		persistence: persistence,
 		rateLimiter: rateLimiter,
@@ -131,7 +144,7 @@ func NewHistoryV2PersistenceRateLimitedClient(persistence HistoryV2Manager, rate
 }
 
 // NewMetadataPersistenceRateLimitedClient creates a MetadataManager client to manage metadata
-func NewMetadataPersistenceRateLimitedClient(persistence MetadataManager, rateLimiter common.TokenBucket, logger bark.Logger) MetadataManager {
+func NewMetadataPersistenceRateLimitedClient(persistence MetadataManager, rateLimiter DomainRateLimiter, logger bark.Logger) MetadataManager {
 	return &metadataRateLimitedPersistenceClient{
 		persistence: persistence,
 		rateLimiter: rateLimiter,
@@ -140,7 +153,7 @@ func NewMetadataPersistenceRateLimitedClient(persistence MetadataManager, rateLi
 }
 
 // NewVisibilityPersistenceRateLimitedClient creates a client to manage visibility
-func NewVisibilityPersistenceRateLimitedClient(persistence VisibilityManager, rateLimiter common.TokenBucket, logger bark.Logger) VisibilityManager {
+func NewVisibilityPersistenceRateLimitedClient(persistence VisibilityManager, rateLimiter DomainRateLimiter, logger bark.Logger) VisibilityManager {
 	return &visibilityRateLimitedPersistenceClient{
 		persistence: persistence,
 		rateLimiter: rateLimiter,
@@ -148,12 +161,30 @@ func NewVisibilityPersistenceRateLimitedClient(persistence VisibilityManager, ra
 	}
 }
 
+// NewReplicationDLQPersistenceRateLimitedClient creates a client to manage the replication DLQ
+func NewReplicationDLQPersistenceRateLimitedClient(persistence ReplicationDLQManager, rateLimiter DomainRateLimiter, logger bark.Logger) ReplicationDLQManager {
+	return &replicationDLQRateLimitedPersistenceClient{
+		persistence: persistence,
+		rateLimiter: rateLimiter,
+		logger:      logger,
+	}
+}
+
+// NewQuarantinePersistenceRateLimitedClient creates a client to manage the task quarantine
+func NewQuarantinePersistenceRateLimitedClient(persistence QuarantineManager, rateLimiter DomainRateLimiter, logger bark.Logger) QuarantineManager {
+	return &quarantineRateLimitedPersistenceClient{
+		persistence: persistence,
+		rateLimiter: rateLimiter,
+		logger:      logger,
+	}
+}
+
 func (p *shardRateLimitedPersistenceClient) GetName() string {
 	return p.persistence.GetName()
 }
 
 func (p *shardRateLimitedPersistenceClient) CreateShard(request *CreateShardRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow("") {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -162,7 +193,7 @@ func (p *shardRateLimitedPersistenceClient) CreateShard(request *CreateShardRequ
 }
 
 func (p *shardRateLimitedPersistenceClient) GetShard(request *GetShardRequest) (*GetShardResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow("") {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -171,7 +202,7 @@ func (p *shardRateLimitedPersistenceClient) GetShard(request *GetShardRequest) (
 }
 
 func (p *shardRateLimitedPersistenceClient) UpdateShard(request *UpdateShardRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow("") {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -192,7 +223,7 @@ func (p *workflowExecutionRateLimitedPersistenceClient) GetShardID() int {
 }
 
 func (p *workflowExecutionRateLimitedPersistenceClient) CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(request.DomainID) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -201,7 +232,7 @@ func (p *workflowExecutionRateLimitedPersistenceClient) CreateWorkflowExecution(
 }
 
 func (p *workflowExecutionRateLimitedPersistenceClient) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(request.DomainID) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -210,7 +241,7 @@ func (p *workflowExecutionRateLimitedPersistenceClient) GetWorkflowExecution(req
 }
 
 func (p *workflowExecutionRateLimitedPersistenceClient) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(request.ExecutionInfo.DomainID) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -219,7 +250,7 @@ func (p *workflowExecutionRateLimitedPersistenceClient) UpdateWorkflowExecution(
 }
 
 func (p *workflowExecutionRateLimitedPersistenceClient) ResetMutableState(request *ResetMutableStateRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(request.ExecutionInfo.DomainID) {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -228,7 +259,7 @@ func (p *workflowExecutionRateLimitedPersistenceClient) ResetMutableState(reques
 }
 
 func (p *workflowExecutionRateLimitedPersistenceClient) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(request.DomainID) {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -236,8 +267,17 @@ func (p *workflowExecutionRateLimitedPersistenceClient) DeleteWorkflowExecution(
 	return err
 }
 
+func (p *workflowExecutionRateLimitedPersistenceClient) DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error {
+	if !p.rateLimiter.Allow(request.DomainID) {
+		return ErrPersistenceLimitExceeded
+	}
+
+	err := p.persistence.DeleteCurrentWorkflowExecution(request)
+	return err
+}
+
 func (p *workflowExecutionRateLimitedPersistenceClient) GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(request.DomainID) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -246,7 +286,7 @@ func (p *workflowExecutionRateLimitedPersistenceClient) GetCurrentExecution(requ
 }
 
 func (p *workflowExecutionRateLimitedPersistenceClient) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow("") {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -255,7 +295,7 @@ func (p *workflowExecutionRateLimitedPersistenceClient) GetTransferTasks(request
 }
 
 func (p *workflowExecutionRateLimitedPersistenceClient) GetReplicationTasks(request *GetReplicationTasksRequest) (*GetReplicationTasksResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow("") {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -264,7 +304,7 @@ func (p *workflowExecutionRateLimitedPersistenceClient) GetReplicationTasks(requ
 }
 
 func (p *workflowExecutionRateLimitedPersistenceClient) CompleteTransferTask(request *CompleteTransferTaskRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow("") {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -273,7 +313,7 @@ func (p *workflowExecutionRateLimitedPersistenceClient) CompleteTransferTask(req
 }
 
 func (p *workflowExecutionRateLimitedPersistenceClient) RangeCompleteTransferTask(request *RangeCompleteTransferTaskRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow("") {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -282,7 +322,7 @@ func (p *workflowExecutionRateLimitedPersistenceClient) RangeCompleteTransferTas
 }
 
 func (p *workflowExecutionRateLimitedPersistenceClient) CompleteReplicationTask(request *CompleteReplicationTaskRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow("") {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -291,7 +331,7 @@ func (p *workflowExecutionRateLimitedPersistenceClient) CompleteReplicationTask(
 }
 
 func (p *workflowExecutionRateLimitedPersistenceClient) GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow("") {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -300,7 +340,7 @@ func (p *workflowExecutionRateLimitedPersistenceClient) GetTimerIndexTasks(reque
 }
 
 func (p *workflowExecutionRateLimitedPersistenceClient) CompleteTimerTask(request *CompleteTimerTaskRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow("") {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -309,7 +349,7 @@ func (p *workflowExecutionRateLimitedPersistenceClient) CompleteTimerTask(reques
 }
 
 func (p *workflowExecutionRateLimitedPersistenceClient) RangeCompleteTimerTask(request *RangeCompleteTimerTaskRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow("") {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -326,7 +366,7 @@ func (p *taskRateLimitedPersistenceClient) GetName() string {
 }
 
 func (p *taskRateLimitedPersistenceClient) CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(request.TaskListInfo.DomainID) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -335,7 +375,7 @@ func (p *taskRateLimitedPersistenceClient) CreateTasks(request *CreateTasksReque
 }
 
 func (p *taskRateLimitedPersistenceClient) GetTasks(request *GetTasksRequest) (*GetTasksResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(request.DomainID) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -344,7 +384,7 @@ func (p *taskRateLimitedPersistenceClient) GetTasks(request *GetTasksRequest) (*
 }
 
 func (p *taskRateLimitedPersistenceClient) CompleteTask(request *CompleteTaskRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(request.TaskList.DomainID) {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -352,8 +392,17 @@ func (p *taskRateLimitedPersistenceClient) CompleteTask(request *CompleteTaskReq
 	return err
 }
 
+func (p *taskRateLimitedPersistenceClient) DeleteTaskList(request *DeleteTaskListRequest) error {
+	if !p.rateLimiter.Allow(request.DomainID) {
+		return ErrPersistenceLimitExceeded
+	}
+
+	err := p.persistence.DeleteTaskList(request)
+	return err
+}
+
 func (p *taskRateLimitedPersistenceClient) LeaseTaskList(request *LeaseTaskListRequest) (*LeaseTaskListResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(request.DomainID) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -362,7 +411,7 @@ func (p *taskRateLimitedPersistenceClient) LeaseTaskList(request *LeaseTaskListR
 }
 
 func (p *taskRateLimitedPersistenceClient) UpdateTaskList(request *UpdateTaskListRequest) (*UpdateTaskListResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(request.TaskListInfo.DomainID) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -379,7 +428,7 @@ func (p *historyRateLimitedPersistenceClient) GetName() string {
 }
 
 func (p *historyRateLimitedPersistenceClient) AppendHistoryEvents(request *AppendHistoryEventsRequest) (*AppendHistoryEventsResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(request.DomainID) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -388,7 +437,7 @@ func (p *historyRateLimitedPersistenceClient) AppendHistoryEvents(request *Appen
 }
 
 func (p *historyRateLimitedPersistenceClient) GetWorkflowExecutionHistory(request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(request.DomainID) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -396,8 +445,17 @@ func (p *historyRateLimitedPersistenceClient) GetWorkflowExecutionHistory(reques
 	return response, err
 }
 
+func (p *historyRateLimitedPersistenceClient) GetWorkflowExecutionHistoryByBatch(request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryByBatchResponse, error) {
+	if !p.rateLimiter.Allow(request.DomainID) {
+		return nil, ErrPersistenceLimitExceeded
+	}
+
+	response, err := p.persistence.GetWorkflowExecutionHistoryByBatch(request)
+	return response, err
+}
+
 func (p *historyRateLimitedPersistenceClient) DeleteWorkflowExecutionHistory(request *DeleteWorkflowExecutionHistoryRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(request.DomainID) {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -414,7 +472,7 @@ func (p *metadataRateLimitedPersistenceClient) GetName() string {
 }
 
 func (p *metadataRateLimitedPersistenceClient) CreateDomain(request *CreateDomainRequest) (*CreateDomainResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow("") {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -423,7 +481,7 @@ func (p *metadataRateLimitedPersistenceClient) CreateDomain(request *CreateDomai
 }
 
 func (p *metadataRateLimitedPersistenceClient) GetDomain(request *GetDomainRequest) (*GetDomainResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow("") {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -432,7 +490,7 @@ func (p *metadataRateLimitedPersistenceClient) GetDomain(request *GetDomainReque
 }
 
 func (p *metadataRateLimitedPersistenceClient) UpdateDomain(request *UpdateDomainRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow("") {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -441,7 +499,7 @@ func (p *metadataRateLimitedPersistenceClient) UpdateDomain(request *UpdateDomai
 }
 
 func (p *metadataRateLimitedPersistenceClient) DeleteDomain(request *DeleteDomainRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow("") {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -450,7 +508,7 @@ func (p *metadataRateLimitedPersistenceClient) DeleteDomain(request *DeleteDomai
 }
 
 func (p *metadataRateLimitedPersistenceClient) DeleteDomainByName(request *DeleteDomainByNameRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow("") {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -459,7 +517,7 @@ func (p *metadataRateLimitedPersistenceClient) DeleteDomainByName(request *Delet
 }
 
 func (p *metadataRateLimitedPersistenceClient) ListDomains(request *ListDomainsRequest) (*ListDomainsResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow("") {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -468,7 +526,7 @@ func (p *metadataRateLimitedPersistenceClient) ListDomains(request *ListDomainsR
 }
 
 func (p *metadataRateLimitedPersistenceClient) GetMetadata() (*GetMetadataResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow("") {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -485,7 +543,7 @@ func (p *visibilityRateLimitedPersistenceClient) GetName() string {
 }
 
 func (p *visibilityRateLimitedPersistenceClient) RecordWorkflowExecutionStarted(request *RecordWorkflowExecutionStartedRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(request.DomainUUID) {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -494,7 +552,7 @@ func (p *visibilityRateLimitedPersistenceClient) RecordWorkflowExecutionStarted(
 }
 
 func (p *visibilityRateLimitedPersistenceClient) RecordWorkflowExecutionClosed(request *RecordWorkflowExecutionClosedRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(request.DomainUUID) {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -503,7 +561,7 @@ func (p *visibilityRateLimitedPersistenceClient) RecordWorkflowExecutionClosed(r
 }
 
 func (p *visibilityRateLimitedPersistenceClient) ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(request.DomainUUID) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -512,7 +570,7 @@ func (p *visibilityRateLimitedPersistenceClient) ListOpenWorkflowExecutions(requ
 }
 
 func (p *visibilityRateLimitedPersistenceClient) ListClosedWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(request.DomainUUID) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -521,7 +579,7 @@ func (p *visibilityRateLimitedPersistenceClient) ListClosedWorkflowExecutions(re
 }
 
 func (p *visibilityRateLimitedPersistenceClient) ListOpenWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(request.DomainUUID) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -530,7 +588,7 @@ func (p *visibilityRateLimitedPersistenceClient) ListOpenWorkflowExecutionsByTyp
 }
 
 func (p *visibilityRateLimitedPersistenceClient) ListClosedWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(request.DomainUUID) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -539,7 +597,7 @@ func (p *visibilityRateLimitedPersistenceClient) ListClosedWorkflowExecutionsByT
 }
 
 func (p *visibilityRateLimitedPersistenceClient) ListOpenWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(request.DomainUUID) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -548,7 +606,7 @@ func (p *visibilityRateLimitedPersistenceClient) ListOpenWorkflowExecutionsByWor
 }
 
 func (p *visibilityRateLimitedPersistenceClient) ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(request.DomainUUID) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -557,7 +615,7 @@ func (p *visibilityRateLimitedPersistenceClient) ListClosedWorkflowExecutionsByW
 }
 
 func (p *visibilityRateLimitedPersistenceClient) ListClosedWorkflowExecutionsByStatus(request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(request.DomainUUID) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -566,7 +624,7 @@ func (p *visibilityRateLimitedPersistenceClient) ListClosedWorkflowExecutionsByS
 }
 
 func (p *visibilityRateLimitedPersistenceClient) GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(request.DomainUUID) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -574,6 +632,15 @@ func (p *visibilityRateLimitedPersistenceClient) GetClosedWorkflowExecution(requ
 	return response, err
 }
 
+func (p *visibilityRateLimitedPersistenceClient) DeleteWorkflowExecutionsByDomain(request *DeleteWorkflowExecutionsByDomainRequest) error {
+	if !p.rateLimiter.Allow(request.DomainUUID) {
+		return ErrPersistenceLimitExceeded
+	}
+
+	err := p.persistence.DeleteWorkflowExecutionsByDomain(request)
+	return err
+}
+
 func (p *visibilityRateLimitedPersistenceClient) Close() {
 	p.persistence.Close()
 }
@@ -588,7 +655,7 @@ func (p *historyV2RateLimitedPersistenceClient) Close() {
 
 // AppendHistoryNodes add(or override) a node to a history branch
 func (p *historyV2RateLimitedPersistenceClient) AppendHistoryNodes(request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow("") {
 		return nil, ErrPersistenceLimitExceeded
 	}
 	return p.persistence.AppendHistoryNodes(request)
@@ -596,16 +663,25 @@ func (p *historyV2RateLimitedPersistenceClient) AppendHistoryNodes(request *Appe
 
 // ReadHistoryBranch returns history node data for a branch
 func (p *historyV2RateLimitedPersistenceClient) ReadHistoryBranch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow("") {
 		return nil, ErrPersistenceLimitExceeded
 	}
 	response, err := p.persistence.ReadHistoryBranch(request)
 	return response, err
 }
 
+// ReadRawHistoryBranch returns history node raw data for a branch
+func (p *historyV2RateLimitedPersistenceClient) ReadRawHistoryBranch(request *ReadHistoryBranchRequest) (*ReadRawHistoryBranchResponse, error) {
+	if !p.rateLimiter.Allow("") {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	response, err := p.persistence.ReadRawHistoryBranch(request)
+	return response, err
+}
+
 // ForkHistoryBranch forks a new branch from a old branch
 func (p *historyV2RateLimitedPersistenceClient) ForkHistoryBranch(request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow("") {
 		return nil, ErrPersistenceLimitExceeded
 	}
 	response, err := p.persistence.ForkHistoryBranch(request)
@@ -614,7 +690,7 @@ func (p *historyV2RateLimitedPersistenceClient) ForkHistoryBranch(request *ForkH
 
 // DeleteHistoryBranch removes a branch
 func (p *historyV2RateLimitedPersistenceClient) DeleteHistoryBranch(request *DeleteHistoryBranchRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow("") {
 		return ErrPersistenceLimitExceeded
 	}
 	err := p.persistence.DeleteHistoryBranch(request)
@@ -623,9 +699,100 @@ func (p *historyV2RateLimitedPersistenceClient) DeleteHistoryBranch(request *Del
 
 // GetHistoryTree returns all branch information of a tree
 func (p *historyV2RateLimitedPersistenceClient) GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow("") {
 		return nil, ErrPersistenceLimitExceeded
 	}
 	response, err := p.persistence.GetHistoryTree(request)
 	return response, err
 }
+
+func (p *replicationDLQRateLimitedPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *replicationDLQRateLimitedPersistenceClient) PutReplicationTaskToDLQ(request *PutReplicationTaskToDLQRequest) error {
+	if !p.rateLimiter.Allow("") {
+		return ErrPersistenceLimitExceeded
+	}
+
+	err := p.persistence.PutReplicationTaskToDLQ(request)
+	return err
+}
+
+func (p *replicationDLQRateLimitedPersistenceClient) GetReplicationTasksFromDLQ(
+	request *GetReplicationTasksFromDLQRequest) (*GetReplicationTasksFromDLQResponse, error) {
+	if !p.rateLimiter.Allow("") {
+		return nil, ErrPersistenceLimitExceeded
+	}
+
+	response, err := p.persistence.GetReplicationTasksFromDLQ(request)
+	return response, err
+}
+
+func (p *replicationDLQRateLimitedPersistenceClient) DeleteReplicationTaskFromDLQ(request *DeleteReplicationTaskFromDLQRequest) error {
+	if !p.rateLimiter.Allow("") {
+		return ErrPersistenceLimitExceeded
+	}
+
+	err := p.persistence.DeleteReplicationTaskFromDLQ(request)
+	return err
+}
+
+func (p *replicationDLQRateLimitedPersistenceClient) RangeDeleteReplicationTaskFromDLQ(request *RangeDeleteReplicationTaskFromDLQRequest) error {
+	if !p.rateLimiter.Allow("") {
+		return ErrPersistenceLimitExceeded
+	}
+
+	err := p.persistence.RangeDeleteReplicationTaskFromDLQ(request)
+	return err
+}
+
+func (p *replicationDLQRateLimitedPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *quarantineRateLimitedPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *quarantineRateLimitedPersistenceClient) PutQuarantinedTask(request *PutQuarantinedTaskRequest) error {
+	if !p.rateLimiter.Allow("") {
+		return ErrPersistenceLimitExceeded
+	}
+
+	err := p.persistence.PutQuarantinedTask(request)
+	return err
+}
+
+func (p *quarantineRateLimitedPersistenceClient) GetQuarantinedTasks(
+	request *GetQuarantinedTasksRequest) (*GetQuarantinedTasksResponse, error) {
+	if !p.rateLimiter.Allow("") {
+		return nil, ErrPersistenceLimitExceeded
+	}
+
+	response, err := p.persistence.GetQuarantinedTasks(request)
+	return response, err
+}
+
+func (p *quarantineRateLimitedPersistenceClient) GetQuarantinedTask(
+	request *GetQuarantinedTaskRequest) (*GetQuarantinedTaskResponse, error) {
+	if !p.rateLimiter.Allow("") {
+		return nil, ErrPersistenceLimitExceeded
+	}
+
+	response, err := p.persistence.GetQuarantinedTask(request)
+	return response, err
+}
+
+func (p *quarantineRateLimitedPersistenceClient) DeleteQuarantinedTask(request *DeleteQuarantinedTaskRequest) error {
+	if !p.rateLimiter.Allow("") {
+		return ErrPersistenceLimitExceeded
+	}
+
+	err := p.persistence.DeleteQuarantinedTask(request)
+	return err
+}
+
+func (p *quarantineRateLimitedPersistenceClient) Close() {
+	p.persistence.Close()
+}
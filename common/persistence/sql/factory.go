@@ -100,6 +100,16 @@ func (f *Factory) NewVisibilityStore() (p.VisibilityStore, error) {
 	return NewSQLVisibilityStore(f.cfg, f.logger)
 }
 
+// NewReplicationDLQStore returns a replication DLQ store
+func (f *Factory) NewReplicationDLQStore() (p.ReplicationDLQStore, error) {
+	return nil, fmt.Errorf("Not implemented")
+}
+
+// NewQuarantineStore returns a task quarantine store
+func (f *Factory) NewQuarantineStore() (p.QuarantineStore, error) {
+	return nil, fmt.Errorf("Not implemented")
+}
+
 // Close closes the factory
 func (f *Factory) Close() {
 	f.Lock()
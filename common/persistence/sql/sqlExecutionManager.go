@@ -103,6 +103,9 @@ type (
 		ClientLibraryVersion         string
 		ClientFeatureVersion         string
 		ClientImpl                   string
+		Paused                       int64
+		HasDecisionBackoff           int64
+		HasEventsV1History           int64
 		ShardID                      int64
 	}
 
@@ -187,6 +190,9 @@ sticky_schedule_to_start_timeout,
 client_library_version,
 client_feature_version,
 client_impl,
+paused,
+has_decision_backoff,
+has_events_v1_history,
 completion_event_encoding`
 
 	executionsNonNullableColumnsTags = `:shard_id,
@@ -216,6 +222,9 @@ completion_event_encoding`
 :client_library_version,
 :client_feature_version,
 :client_impl,
+:paused,
+:has_decision_backoff,
+:has_events_v1_history,
 :completion_event_encoding`
 
 	executionsBlobColumns = `completion_event,
@@ -300,6 +309,9 @@ sticky_schedule_to_start_timeout = :sticky_schedule_to_start_timeout,
 client_library_version = :client_library_version,
 client_feature_version = :client_feature_version,
 client_impl = :client_impl,
+paused = :paused,
+has_decision_backoff = :has_decision_backoff,
+has_events_v1_history = :has_events_v1_history,
 start_version = :start_version,
 current_version = :current_version,
 last_write_version = :last_write_version,
@@ -328,6 +340,12 @@ run_id = ?`
 shard_id = ? AND
 domain_id = ? AND
 workflow_id = ? AND
+run_id = ?`
+
+	deleteCurrentExecutionSQLQuery = `DELETE FROM current_executions WHERE
+shard_id = ? AND
+domain_id = ? AND
+workflow_id = ? AND
 run_id = ?`
 
 	transferTaskInfoColumns = `task_id,
@@ -698,6 +716,9 @@ func (m *sqlExecutionManager) GetWorkflowExecution(request *p.GetWorkflowExecuti
 		ClientLibraryVersion:         execution.ClientLibraryVersion,
 		ClientFeatureVersion:         execution.ClientFeatureVersion,
 		ClientImpl:                   execution.ClientImpl,
+		Paused:                       int64ToBool(execution.Paused),
+		HasDecisionBackoff:           int64ToBool(execution.HasDecisionBackoff),
+		HasEventsV1History:           int64ToBool(execution.HasEventsV1History),
 	}
 
 	if execution.ExecutionContext != nil && len(*execution.ExecutionContext) > 0 {
@@ -1000,6 +1021,15 @@ func (m *sqlExecutionManager) updateWorkflowExecutionTx(tx *sqlx.Tx, request *p.
 			return err
 		}
 
+		if err := createReplicationTasks(tx,
+			request.ContinueAsNew.ReplicationTasks,
+			shardID,
+			request.ContinueAsNew.DomainID,
+			request.ContinueAsNew.Execution.GetWorkflowId(),
+			request.ContinueAsNew.Execution.GetRunId()); err != nil {
+			return err
+		}
+
 		if err := createTimerTasks(tx,
 			request.ContinueAsNew.TimerTasks,
 			nil,
@@ -1290,6 +1320,16 @@ func (m *sqlExecutionManager) DeleteWorkflowExecution(request *p.DeleteWorkflowE
 	return nil
 }
 
+func (m *sqlExecutionManager) DeleteCurrentWorkflowExecution(request *p.DeleteCurrentWorkflowExecutionRequest) error {
+	if _, err := m.db.Exec(deleteCurrentExecutionSQLQuery, m.shardID, request.DomainID, request.WorkflowID,
+		request.RunID); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteCurrentWorkflowExecution operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
 func (m *sqlExecutionManager) GetCurrentExecution(request *p.GetCurrentExecutionRequest) (*p.GetCurrentExecutionResponse, error) {
 	var row currentExecutionRow
 	if err := m.db.Get(&row, getCurrentExecutionSQLQuery, m.shardID, request.DomainID, request.WorkflowID); err != nil {
@@ -1554,6 +1594,9 @@ func createExecution(tx *sqlx.Tx, request *p.CreateWorkflowExecutionRequest, sha
 		ClientLibraryVersion:         "",
 		ClientFeatureVersion:         "",
 		ClientImpl:                   "",
+		Paused:                       0,
+		HasDecisionBackoff:           boolToInt64(request.HasDecisionBackoff),
+		HasEventsV1History:           0,
 	}
 
 	if request.ReplicationState != nil {
@@ -2023,6 +2066,9 @@ func updateExecution(tx *sqlx.Tx,
 			ClientLibraryVersion:         executionInfo.ClientLibraryVersion,
 			ClientFeatureVersion:         executionInfo.ClientFeatureVersion,
 			ClientImpl:                   executionInfo.ClientImpl,
+			Paused:                       boolToInt64(executionInfo.Paused),
+			HasDecisionBackoff:           boolToInt64(executionInfo.HasDecisionBackoff),
+			HasEventsV1History:           boolToInt64(executionInfo.HasEventsV1History),
 			ShardID:                      int64(shardID),
 			LastWriteVersion:             common.EmptyVersion,
 			CurrentVersion:               common.EmptyVersion,
@@ -58,6 +58,9 @@ type (
 		Clusters          *[]byte
 		ConfigVersion     int64
 		FailoverVersion   int64
+
+		PendingActiveClusterName string
+		FailoverEndTime          int64
 	}
 
 	flatUpdateDomainRequest struct {
@@ -125,7 +128,9 @@ const (
 		clusters,
 		notification_version,
 		failover_notification_version,
-		data
+		data,
+		pending_active_cluster_name,
+		failover_end_time
 FROM domains
 `
 	getDomainByIDSQLQuery = getDomainPart +
@@ -146,7 +151,9 @@ SET
 		clusters = :clusters,
 		notification_version = :notification_version,
 		failover_notification_version = :failover_notification_version,
-		data = :data
+		data = :data,
+		pending_active_cluster_name = :pending_active_cluster_name,
+		failover_end_time = :failover_end_time
 WHERE
 name = :name AND
 id = :id`
@@ -371,6 +378,8 @@ func (m *sqlMetadataManagerV2) domainRowToGetDomainResponse(result *domainRow) (
 		ConfigVersion:               result.ConfigVersion,
 		NotificationVersion:         result.NotificationVersion,
 		FailoverNotificationVersion: result.FailoverNotificationVersion,
+		PendingActiveClusterName:    result.PendingActiveClusterName,
+		FailoverEndTime:             result.FailoverEndTime,
 	}, nil
 }
 
@@ -405,6 +414,9 @@ func (m *sqlMetadataManagerV2) UpdateDomain(request *persistence.UpdateDomainReq
 				Clusters:          &clusters,
 				ConfigVersion:     request.ConfigVersion,
 				FailoverVersion:   request.FailoverVersion,
+
+				PendingActiveClusterName: request.PendingActiveClusterName,
+				FailoverEndTime:          request.FailoverEndTime,
 			},
 			FailoverNotificationVersion: request.FailoverNotificationVersion,
 			NotificationVersion:         request.NotificationVersion,
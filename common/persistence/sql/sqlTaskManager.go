@@ -105,6 +105,12 @@ task_type = :task_type
 
 	deleteTaskSQLQuery = `DELETE FROM tasks ` +
 		`WHERE domain_id = ? AND task_list_name = ? AND task_list_type = ? AND task_id = ?`
+
+	deleteTasksOfTaskListSQLQuery = `DELETE FROM tasks ` +
+		`WHERE domain_id = ? AND task_list_name = ? AND task_list_type = ?`
+
+	deleteTaskListSQLQuery = `DELETE FROM task_lists ` +
+		`WHERE domain_id = ? AND name = ? AND task_type = ?`
 )
 
 // newTaskPersistence creates a new instance of TaskManager
@@ -318,6 +324,16 @@ func (m *sqlTaskManager) CompleteTask(request *persistence.CompleteTaskRequest)
 	return nil
 }
 
+func (m *sqlTaskManager) DeleteTaskList(request *persistence.DeleteTaskListRequest) error {
+	return m.txExecute("DeleteTaskList", func(tx *sqlx.Tx) error {
+		if _, err := tx.Exec(deleteTasksOfTaskListSQLQuery, request.DomainID, request.TaskList, request.TaskType); err != nil {
+			return err
+		}
+		_, err := tx.Exec(deleteTaskListSQLQuery, request.DomainID, request.TaskList, request.TaskType)
+		return err
+	})
+}
+
 func lockTaskList(tx *sqlx.Tx, domainID, name string, taskListType int, oldRangeID int64) error {
 	var rangeID int64
 	if err := tx.Get(&rangeID, lockTaskListSQLQuery, domainID, name, taskListType); err != nil {
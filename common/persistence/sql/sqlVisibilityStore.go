@@ -76,6 +76,8 @@ const (
 		 FROM executions_visibility
 		 WHERE domain_id = ? AND close_status IS NOT NULL
 		 AND run_id = ?`
+
+	templateDeleteWorkflowExecutionsByDomain = `DELETE FROM executions_visibility WHERE domain_id = ?`
 )
 
 type (
@@ -277,6 +279,15 @@ func (s *sqlVisibilityStore) GetClosedWorkflowExecution(request *p.GetClosedWork
 	return &p.GetClosedWorkflowExecutionResponse{Execution: rowToInfo(row)}, nil
 }
 
+func (s *sqlVisibilityStore) DeleteWorkflowExecutionsByDomain(request *p.DeleteWorkflowExecutionsByDomainRequest) error {
+	if _, err := s.db.Exec(templateDeleteWorkflowExecutionsByDomain, request.DomainUUID); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteWorkflowExecutionsByDomain operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
 func rowToInfo(row executionVisibilityRow) *workflow.WorkflowExecutionInfo {
 	info := &workflow.WorkflowExecutionInfo{
 		Execution: &workflow.WorkflowExecution{
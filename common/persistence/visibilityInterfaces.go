@@ -107,6 +107,11 @@ type (
 		Execution *s.WorkflowExecutionInfo
 	}
 
+	// DeleteWorkflowExecutionsByDomainRequest is used to delete the records of all the executions of a domain
+	DeleteWorkflowExecutionsByDomainRequest struct {
+		DomainUUID string
+	}
+
 	// VisibilityManager is used to manage the visibility store
 	VisibilityManager interface {
 		Closeable
@@ -121,5 +126,6 @@ type (
 		ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error)
 		ListClosedWorkflowExecutionsByStatus(request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error)
 		GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error)
+		DeleteWorkflowExecutionsByDomain(request *DeleteWorkflowExecutionsByDomainRequest) error
 	}
 )
@@ -202,6 +202,10 @@ func (p *visibilitySamplingClient) GetClosedWorkflowExecution(request *GetClosed
 	return p.persistence.GetClosedWorkflowExecution(request)
 }
 
+func (p *visibilitySamplingClient) DeleteWorkflowExecutionsByDomain(request *DeleteWorkflowExecutionsByDomainRequest) error {
+	return p.persistence.DeleteWorkflowExecutionsByDomain(request)
+}
+
 func (p *visibilitySamplingClient) Close() {
 	p.persistence.Close()
 }
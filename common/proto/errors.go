@@ -0,0 +1,64 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package proto
+
+import (
+	"github.com/uber/cadence/.gen/go/shared"
+	"go.uber.org/yarpc/yarpcerrors"
+)
+
+// FromError converts an error returned by the thrift handlers to a yarpc
+// status error, so that gRPC clients receive a matching status code
+func FromError(err error) error {
+	switch e := err.(type) {
+	case nil:
+		return nil
+	case *shared.BadRequestError:
+		return newStatus(yarpcerrors.CodeInvalidArgument, e.Message)
+	case *shared.InternalServiceError:
+		return newStatus(yarpcerrors.CodeInternal, e.Message)
+	case *shared.DomainAlreadyExistsError:
+		return newStatus(yarpcerrors.CodeAlreadyExists, e.Message)
+	case *shared.WorkflowExecutionAlreadyStartedError:
+		return newStatus(yarpcerrors.CodeAlreadyExists, e.GetMessage())
+	case *shared.EntityNotExistsError:
+		return newStatus(yarpcerrors.CodeNotFound, e.Message)
+	case *shared.ServiceBusyError:
+		return newStatus(yarpcerrors.CodeResourceExhausted, e.Message)
+	case *shared.LimitExceededError:
+		return newStatus(yarpcerrors.CodeResourceExhausted, e.Message)
+	case *shared.CancellationAlreadyRequestedError:
+		return newStatus(yarpcerrors.CodeAlreadyExists, e.Message)
+	case *shared.QueryFailedError:
+		return newStatus(yarpcerrors.CodeInvalidArgument, e.Message)
+	case *shared.DomainNotActiveError:
+		return newStatus(yarpcerrors.CodeFailedPrecondition, e.Message)
+	case *shared.AccessDeniedError:
+		return newStatus(yarpcerrors.CodePermissionDenied, e.Message)
+	case *yarpcerrors.Status:
+		return e
+	}
+	return yarpcerrors.Newf(yarpcerrors.CodeUnknown, "%v", err.Error())
+}
+
+func newStatus(code yarpcerrors.Code, message string) error {
+	return yarpcerrors.Newf(code, "%v", message)
+}
@@ -0,0 +1,4600 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package proto
+
+import (
+	"github.com/uber/cadence/.gen/go/shared"
+	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
+	"github.com/uber/cadence/common"
+)
+
+// FromWorkflowIDReusePolicy converts thrift WorkflowIdReusePolicy type to proto
+func FromWorkflowIDReusePolicy(t *shared.WorkflowIdReusePolicy) apiv1.WorkflowIdReusePolicy {
+	if t == nil {
+		return apiv1.WORKFLOW_ID_REUSE_POLICY_INVALID
+	}
+	switch *t {
+	case shared.WorkflowIdReusePolicyAllowDuplicateFailedOnly:
+		return apiv1.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE_FAILED_ONLY
+	case shared.WorkflowIdReusePolicyAllowDuplicate:
+		return apiv1.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE
+	case shared.WorkflowIdReusePolicyRejectDuplicate:
+		return apiv1.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE
+	case shared.WorkflowIdReusePolicyTerminateIfRunning:
+		return apiv1.WORKFLOW_ID_REUSE_POLICY_TERMINATE_IF_RUNNING
+	}
+	return apiv1.WORKFLOW_ID_REUSE_POLICY_INVALID
+}
+
+// ToWorkflowIDReusePolicy converts proto WorkflowIdReusePolicy type to thrift
+func ToWorkflowIDReusePolicy(p apiv1.WorkflowIdReusePolicy) *shared.WorkflowIdReusePolicy {
+	switch p {
+	case apiv1.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE_FAILED_ONLY:
+		return shared.WorkflowIdReusePolicyAllowDuplicateFailedOnly.Ptr()
+	case apiv1.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE:
+		return shared.WorkflowIdReusePolicyAllowDuplicate.Ptr()
+	case apiv1.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE:
+		return shared.WorkflowIdReusePolicyRejectDuplicate.Ptr()
+	case apiv1.WORKFLOW_ID_REUSE_POLICY_TERMINATE_IF_RUNNING:
+		return shared.WorkflowIdReusePolicyTerminateIfRunning.Ptr()
+	}
+	return nil
+}
+
+// FromDomainStatus converts thrift DomainStatus type to proto
+func FromDomainStatus(t *shared.DomainStatus) apiv1.DomainStatus {
+	if t == nil {
+		return apiv1.DOMAIN_STATUS_INVALID
+	}
+	switch *t {
+	case shared.DomainStatusRegistered:
+		return apiv1.DOMAIN_STATUS_REGISTERED
+	case shared.DomainStatusDeprecated:
+		return apiv1.DOMAIN_STATUS_DEPRECATED
+	case shared.DomainStatusDeleted:
+		return apiv1.DOMAIN_STATUS_DELETED
+	}
+	return apiv1.DOMAIN_STATUS_INVALID
+}
+
+// ToDomainStatus converts proto DomainStatus type to thrift
+func ToDomainStatus(p apiv1.DomainStatus) *shared.DomainStatus {
+	switch p {
+	case apiv1.DOMAIN_STATUS_REGISTERED:
+		return shared.DomainStatusRegistered.Ptr()
+	case apiv1.DOMAIN_STATUS_DEPRECATED:
+		return shared.DomainStatusDeprecated.Ptr()
+	case apiv1.DOMAIN_STATUS_DELETED:
+		return shared.DomainStatusDeleted.Ptr()
+	}
+	return nil
+}
+
+// FromTimeoutType converts thrift TimeoutType type to proto
+func FromTimeoutType(t *shared.TimeoutType) apiv1.TimeoutType {
+	if t == nil {
+		return apiv1.TIMEOUT_TYPE_INVALID
+	}
+	switch *t {
+	case shared.TimeoutTypeStartToClose:
+		return apiv1.TIMEOUT_TYPE_START_TO_CLOSE
+	case shared.TimeoutTypeScheduleToStart:
+		return apiv1.TIMEOUT_TYPE_SCHEDULE_TO_START
+	case shared.TimeoutTypeScheduleToClose:
+		return apiv1.TIMEOUT_TYPE_SCHEDULE_TO_CLOSE
+	case shared.TimeoutTypeHeartbeat:
+		return apiv1.TIMEOUT_TYPE_HEARTBEAT
+	}
+	return apiv1.TIMEOUT_TYPE_INVALID
+}
+
+// ToTimeoutType converts proto TimeoutType type to thrift
+func ToTimeoutType(p apiv1.TimeoutType) *shared.TimeoutType {
+	switch p {
+	case apiv1.TIMEOUT_TYPE_START_TO_CLOSE:
+		return shared.TimeoutTypeStartToClose.Ptr()
+	case apiv1.TIMEOUT_TYPE_SCHEDULE_TO_START:
+		return shared.TimeoutTypeScheduleToStart.Ptr()
+	case apiv1.TIMEOUT_TYPE_SCHEDULE_TO_CLOSE:
+		return shared.TimeoutTypeScheduleToClose.Ptr()
+	case apiv1.TIMEOUT_TYPE_HEARTBEAT:
+		return shared.TimeoutTypeHeartbeat.Ptr()
+	}
+	return nil
+}
+
+// FromDecisionType converts thrift DecisionType type to proto
+func FromDecisionType(t *shared.DecisionType) apiv1.DecisionType {
+	if t == nil {
+		return apiv1.DECISION_TYPE_INVALID
+	}
+	switch *t {
+	case shared.DecisionTypeScheduleActivityTask:
+		return apiv1.DECISION_TYPE_SCHEDULE_ACTIVITY_TASK
+	case shared.DecisionTypeRequestCancelActivityTask:
+		return apiv1.DECISION_TYPE_REQUEST_CANCEL_ACTIVITY_TASK
+	case shared.DecisionTypeStartTimer:
+		return apiv1.DECISION_TYPE_START_TIMER
+	case shared.DecisionTypeCompleteWorkflowExecution:
+		return apiv1.DECISION_TYPE_COMPLETE_WORKFLOW_EXECUTION
+	case shared.DecisionTypeFailWorkflowExecution:
+		return apiv1.DECISION_TYPE_FAIL_WORKFLOW_EXECUTION
+	case shared.DecisionTypeCancelTimer:
+		return apiv1.DECISION_TYPE_CANCEL_TIMER
+	case shared.DecisionTypeCancelWorkflowExecution:
+		return apiv1.DECISION_TYPE_CANCEL_WORKFLOW_EXECUTION
+	case shared.DecisionTypeRequestCancelExternalWorkflowExecution:
+		return apiv1.DECISION_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION
+	case shared.DecisionTypeRecordMarker:
+		return apiv1.DECISION_TYPE_RECORD_MARKER
+	case shared.DecisionTypeContinueAsNewWorkflowExecution:
+		return apiv1.DECISION_TYPE_CONTINUE_AS_NEW_WORKFLOW_EXECUTION
+	case shared.DecisionTypeStartChildWorkflowExecution:
+		return apiv1.DECISION_TYPE_START_CHILD_WORKFLOW_EXECUTION
+	case shared.DecisionTypeSignalExternalWorkflowExecution:
+		return apiv1.DECISION_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION
+	}
+	return apiv1.DECISION_TYPE_INVALID
+}
+
+// ToDecisionType converts proto DecisionType type to thrift
+func ToDecisionType(p apiv1.DecisionType) *shared.DecisionType {
+	switch p {
+	case apiv1.DECISION_TYPE_SCHEDULE_ACTIVITY_TASK:
+		return shared.DecisionTypeScheduleActivityTask.Ptr()
+	case apiv1.DECISION_TYPE_REQUEST_CANCEL_ACTIVITY_TASK:
+		return shared.DecisionTypeRequestCancelActivityTask.Ptr()
+	case apiv1.DECISION_TYPE_START_TIMER:
+		return shared.DecisionTypeStartTimer.Ptr()
+	case apiv1.DECISION_TYPE_COMPLETE_WORKFLOW_EXECUTION:
+		return shared.DecisionTypeCompleteWorkflowExecution.Ptr()
+	case apiv1.DECISION_TYPE_FAIL_WORKFLOW_EXECUTION:
+		return shared.DecisionTypeFailWorkflowExecution.Ptr()
+	case apiv1.DECISION_TYPE_CANCEL_TIMER:
+		return shared.DecisionTypeCancelTimer.Ptr()
+	case apiv1.DECISION_TYPE_CANCEL_WORKFLOW_EXECUTION:
+		return shared.DecisionTypeCancelWorkflowExecution.Ptr()
+	case apiv1.DECISION_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION:
+		return shared.DecisionTypeRequestCancelExternalWorkflowExecution.Ptr()
+	case apiv1.DECISION_TYPE_RECORD_MARKER:
+		return shared.DecisionTypeRecordMarker.Ptr()
+	case apiv1.DECISION_TYPE_CONTINUE_AS_NEW_WORKFLOW_EXECUTION:
+		return shared.DecisionTypeContinueAsNewWorkflowExecution.Ptr()
+	case apiv1.DECISION_TYPE_START_CHILD_WORKFLOW_EXECUTION:
+		return shared.DecisionTypeStartChildWorkflowExecution.Ptr()
+	case apiv1.DECISION_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION:
+		return shared.DecisionTypeSignalExternalWorkflowExecution.Ptr()
+	}
+	return nil
+}
+
+// FromEventType converts thrift EventType type to proto
+func FromEventType(t *shared.EventType) apiv1.EventType {
+	if t == nil {
+		return apiv1.EVENT_TYPE_INVALID
+	}
+	switch *t {
+	case shared.EventTypeWorkflowExecutionStarted:
+		return apiv1.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED
+	case shared.EventTypeWorkflowExecutionCompleted:
+		return apiv1.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED
+	case shared.EventTypeWorkflowExecutionFailed:
+		return apiv1.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED
+	case shared.EventTypeWorkflowExecutionTimedOut:
+		return apiv1.EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT
+	case shared.EventTypeDecisionTaskScheduled:
+		return apiv1.EVENT_TYPE_DECISION_TASK_SCHEDULED
+	case shared.EventTypeDecisionTaskStarted:
+		return apiv1.EVENT_TYPE_DECISION_TASK_STARTED
+	case shared.EventTypeDecisionTaskCompleted:
+		return apiv1.EVENT_TYPE_DECISION_TASK_COMPLETED
+	case shared.EventTypeDecisionTaskTimedOut:
+		return apiv1.EVENT_TYPE_DECISION_TASK_TIMED_OUT
+	case shared.EventTypeDecisionTaskFailed:
+		return apiv1.EVENT_TYPE_DECISION_TASK_FAILED
+	case shared.EventTypeActivityTaskScheduled:
+		return apiv1.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED
+	case shared.EventTypeActivityTaskStarted:
+		return apiv1.EVENT_TYPE_ACTIVITY_TASK_STARTED
+	case shared.EventTypeActivityTaskCompleted:
+		return apiv1.EVENT_TYPE_ACTIVITY_TASK_COMPLETED
+	case shared.EventTypeActivityTaskFailed:
+		return apiv1.EVENT_TYPE_ACTIVITY_TASK_FAILED
+	case shared.EventTypeActivityTaskTimedOut:
+		return apiv1.EVENT_TYPE_ACTIVITY_TASK_TIMED_OUT
+	case shared.EventTypeActivityTaskCancelRequested:
+		return apiv1.EVENT_TYPE_ACTIVITY_TASK_CANCEL_REQUESTED
+	case shared.EventTypeRequestCancelActivityTaskFailed:
+		return apiv1.EVENT_TYPE_REQUEST_CANCEL_ACTIVITY_TASK_FAILED
+	case shared.EventTypeActivityTaskCanceled:
+		return apiv1.EVENT_TYPE_ACTIVITY_TASK_CANCELED
+	case shared.EventTypeTimerStarted:
+		return apiv1.EVENT_TYPE_TIMER_STARTED
+	case shared.EventTypeTimerFired:
+		return apiv1.EVENT_TYPE_TIMER_FIRED
+	case shared.EventTypeCancelTimerFailed:
+		return apiv1.EVENT_TYPE_CANCEL_TIMER_FAILED
+	case shared.EventTypeTimerCanceled:
+		return apiv1.EVENT_TYPE_TIMER_CANCELED
+	case shared.EventTypeWorkflowExecutionCancelRequested:
+		return apiv1.EVENT_TYPE_WORKFLOW_EXECUTION_CANCEL_REQUESTED
+	case shared.EventTypeWorkflowExecutionCanceled:
+		return apiv1.EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED
+	case shared.EventTypeRequestCancelExternalWorkflowExecutionInitiated:
+		return apiv1.EVENT_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_INITIATED
+	case shared.EventTypeRequestCancelExternalWorkflowExecutionFailed:
+		return apiv1.EVENT_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_FAILED
+	case shared.EventTypeExternalWorkflowExecutionCancelRequested:
+		return apiv1.EVENT_TYPE_EXTERNAL_WORKFLOW_EXECUTION_CANCEL_REQUESTED
+	case shared.EventTypeMarkerRecorded:
+		return apiv1.EVENT_TYPE_MARKER_RECORDED
+	case shared.EventTypeWorkflowExecutionSignaled:
+		return apiv1.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED
+	case shared.EventTypeWorkflowExecutionTerminated:
+		return apiv1.EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED
+	case shared.EventTypeWorkflowExecutionContinuedAsNew:
+		return apiv1.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW
+	case shared.EventTypeStartChildWorkflowExecutionInitiated:
+		return apiv1.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED
+	case shared.EventTypeStartChildWorkflowExecutionFailed:
+		return apiv1.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_FAILED
+	case shared.EventTypeChildWorkflowExecutionStarted:
+		return apiv1.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_STARTED
+	case shared.EventTypeChildWorkflowExecutionCompleted:
+		return apiv1.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_COMPLETED
+	case shared.EventTypeChildWorkflowExecutionFailed:
+		return apiv1.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_FAILED
+	case shared.EventTypeChildWorkflowExecutionCanceled:
+		return apiv1.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_CANCELED
+	case shared.EventTypeChildWorkflowExecutionTimedOut:
+		return apiv1.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TIMED_OUT
+	case shared.EventTypeChildWorkflowExecutionTerminated:
+		return apiv1.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TERMINATED
+	case shared.EventTypeSignalExternalWorkflowExecutionInitiated:
+		return apiv1.EVENT_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_INITIATED
+	case shared.EventTypeSignalExternalWorkflowExecutionFailed:
+		return apiv1.EVENT_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_FAILED
+	case shared.EventTypeExternalWorkflowExecutionSignaled:
+		return apiv1.EVENT_TYPE_EXTERNAL_WORKFLOW_EXECUTION_SIGNALED
+	case shared.EventTypeWorkflowExecutionPaused:
+		return apiv1.EVENT_TYPE_WORKFLOW_EXECUTION_PAUSED
+	case shared.EventTypeWorkflowExecutionResumed:
+		return apiv1.EVENT_TYPE_WORKFLOW_EXECUTION_RESUMED
+	case shared.EventTypeActivityTaskRetryRequested:
+		return apiv1.EVENT_TYPE_ACTIVITY_TASK_RETRY_REQUESTED
+	}
+	return apiv1.EVENT_TYPE_INVALID
+}
+
+// ToEventType converts proto EventType type to thrift
+func ToEventType(p apiv1.EventType) *shared.EventType {
+	switch p {
+	case apiv1.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED:
+		return shared.EventTypeWorkflowExecutionStarted.Ptr()
+	case apiv1.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED:
+		return shared.EventTypeWorkflowExecutionCompleted.Ptr()
+	case apiv1.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED:
+		return shared.EventTypeWorkflowExecutionFailed.Ptr()
+	case apiv1.EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT:
+		return shared.EventTypeWorkflowExecutionTimedOut.Ptr()
+	case apiv1.EVENT_TYPE_DECISION_TASK_SCHEDULED:
+		return shared.EventTypeDecisionTaskScheduled.Ptr()
+	case apiv1.EVENT_TYPE_DECISION_TASK_STARTED:
+		return shared.EventTypeDecisionTaskStarted.Ptr()
+	case apiv1.EVENT_TYPE_DECISION_TASK_COMPLETED:
+		return shared.EventTypeDecisionTaskCompleted.Ptr()
+	case apiv1.EVENT_TYPE_DECISION_TASK_TIMED_OUT:
+		return shared.EventTypeDecisionTaskTimedOut.Ptr()
+	case apiv1.EVENT_TYPE_DECISION_TASK_FAILED:
+		return shared.EventTypeDecisionTaskFailed.Ptr()
+	case apiv1.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED:
+		return shared.EventTypeActivityTaskScheduled.Ptr()
+	case apiv1.EVENT_TYPE_ACTIVITY_TASK_STARTED:
+		return shared.EventTypeActivityTaskStarted.Ptr()
+	case apiv1.EVENT_TYPE_ACTIVITY_TASK_COMPLETED:
+		return shared.EventTypeActivityTaskCompleted.Ptr()
+	case apiv1.EVENT_TYPE_ACTIVITY_TASK_FAILED:
+		return shared.EventTypeActivityTaskFailed.Ptr()
+	case apiv1.EVENT_TYPE_ACTIVITY_TASK_TIMED_OUT:
+		return shared.EventTypeActivityTaskTimedOut.Ptr()
+	case apiv1.EVENT_TYPE_ACTIVITY_TASK_CANCEL_REQUESTED:
+		return shared.EventTypeActivityTaskCancelRequested.Ptr()
+	case apiv1.EVENT_TYPE_REQUEST_CANCEL_ACTIVITY_TASK_FAILED:
+		return shared.EventTypeRequestCancelActivityTaskFailed.Ptr()
+	case apiv1.EVENT_TYPE_ACTIVITY_TASK_CANCELED:
+		return shared.EventTypeActivityTaskCanceled.Ptr()
+	case apiv1.EVENT_TYPE_TIMER_STARTED:
+		return shared.EventTypeTimerStarted.Ptr()
+	case apiv1.EVENT_TYPE_TIMER_FIRED:
+		return shared.EventTypeTimerFired.Ptr()
+	case apiv1.EVENT_TYPE_CANCEL_TIMER_FAILED:
+		return shared.EventTypeCancelTimerFailed.Ptr()
+	case apiv1.EVENT_TYPE_TIMER_CANCELED:
+		return shared.EventTypeTimerCanceled.Ptr()
+	case apiv1.EVENT_TYPE_WORKFLOW_EXECUTION_CANCEL_REQUESTED:
+		return shared.EventTypeWorkflowExecutionCancelRequested.Ptr()
+	case apiv1.EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED:
+		return shared.EventTypeWorkflowExecutionCanceled.Ptr()
+	case apiv1.EVENT_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_INITIATED:
+		return shared.EventTypeRequestCancelExternalWorkflowExecutionInitiated.Ptr()
+	case apiv1.EVENT_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_FAILED:
+		return shared.EventTypeRequestCancelExternalWorkflowExecutionFailed.Ptr()
+	case apiv1.EVENT_TYPE_EXTERNAL_WORKFLOW_EXECUTION_CANCEL_REQUESTED:
+		return shared.EventTypeExternalWorkflowExecutionCancelRequested.Ptr()
+	case apiv1.EVENT_TYPE_MARKER_RECORDED:
+		return shared.EventTypeMarkerRecorded.Ptr()
+	case apiv1.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED:
+		return shared.EventTypeWorkflowExecutionSignaled.Ptr()
+	case apiv1.EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED:
+		return shared.EventTypeWorkflowExecutionTerminated.Ptr()
+	case apiv1.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW:
+		return shared.EventTypeWorkflowExecutionContinuedAsNew.Ptr()
+	case apiv1.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED:
+		return shared.EventTypeStartChildWorkflowExecutionInitiated.Ptr()
+	case apiv1.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_FAILED:
+		return shared.EventTypeStartChildWorkflowExecutionFailed.Ptr()
+	case apiv1.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_STARTED:
+		return shared.EventTypeChildWorkflowExecutionStarted.Ptr()
+	case apiv1.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_COMPLETED:
+		return shared.EventTypeChildWorkflowExecutionCompleted.Ptr()
+	case apiv1.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_FAILED:
+		return shared.EventTypeChildWorkflowExecutionFailed.Ptr()
+	case apiv1.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_CANCELED:
+		return shared.EventTypeChildWorkflowExecutionCanceled.Ptr()
+	case apiv1.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TIMED_OUT:
+		return shared.EventTypeChildWorkflowExecutionTimedOut.Ptr()
+	case apiv1.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TERMINATED:
+		return shared.EventTypeChildWorkflowExecutionTerminated.Ptr()
+	case apiv1.EVENT_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_INITIATED:
+		return shared.EventTypeSignalExternalWorkflowExecutionInitiated.Ptr()
+	case apiv1.EVENT_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_FAILED:
+		return shared.EventTypeSignalExternalWorkflowExecutionFailed.Ptr()
+	case apiv1.EVENT_TYPE_EXTERNAL_WORKFLOW_EXECUTION_SIGNALED:
+		return shared.EventTypeExternalWorkflowExecutionSignaled.Ptr()
+	case apiv1.EVENT_TYPE_WORKFLOW_EXECUTION_PAUSED:
+		return shared.EventTypeWorkflowExecutionPaused.Ptr()
+	case apiv1.EVENT_TYPE_WORKFLOW_EXECUTION_RESUMED:
+		return shared.EventTypeWorkflowExecutionResumed.Ptr()
+	case apiv1.EVENT_TYPE_ACTIVITY_TASK_RETRY_REQUESTED:
+		return shared.EventTypeActivityTaskRetryRequested.Ptr()
+	}
+	return nil
+}
+
+// FromDecisionTaskFailedCause converts thrift DecisionTaskFailedCause type to proto
+func FromDecisionTaskFailedCause(t *shared.DecisionTaskFailedCause) apiv1.DecisionTaskFailedCause {
+	if t == nil {
+		return apiv1.DECISION_TASK_FAILED_CAUSE_INVALID
+	}
+	switch *t {
+	case shared.DecisionTaskFailedCauseUnhandledDecision:
+		return apiv1.DECISION_TASK_FAILED_CAUSE_UNHANDLED_DECISION
+	case shared.DecisionTaskFailedCauseBadScheduleActivityAttributes:
+		return apiv1.DECISION_TASK_FAILED_CAUSE_BAD_SCHEDULE_ACTIVITY_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseBadRequestCancelActivityAttributes:
+		return apiv1.DECISION_TASK_FAILED_CAUSE_BAD_REQUEST_CANCEL_ACTIVITY_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseBadStartTimerAttributes:
+		return apiv1.DECISION_TASK_FAILED_CAUSE_BAD_START_TIMER_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseBadCancelTimerAttributes:
+		return apiv1.DECISION_TASK_FAILED_CAUSE_BAD_CANCEL_TIMER_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseBadRecordMarkerAttributes:
+		return apiv1.DECISION_TASK_FAILED_CAUSE_BAD_RECORD_MARKER_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseBadCompleteWorkflowExecutionAttributes:
+		return apiv1.DECISION_TASK_FAILED_CAUSE_BAD_COMPLETE_WORKFLOW_EXECUTION_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseBadFailWorkflowExecutionAttributes:
+		return apiv1.DECISION_TASK_FAILED_CAUSE_BAD_FAIL_WORKFLOW_EXECUTION_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseBadCancelWorkflowExecutionAttributes:
+		return apiv1.DECISION_TASK_FAILED_CAUSE_BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseBadRequestCancelExternalWorkflowExecutionAttributes:
+		return apiv1.DECISION_TASK_FAILED_CAUSE_BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseBadContinueAsNewAttributes:
+		return apiv1.DECISION_TASK_FAILED_CAUSE_BAD_CONTINUE_AS_NEW_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseStartTimerDuplicateID:
+		return apiv1.DECISION_TASK_FAILED_CAUSE_START_TIMER_DUPLICATE_ID
+	case shared.DecisionTaskFailedCauseResetStickyTasklist:
+		return apiv1.DECISION_TASK_FAILED_CAUSE_RESET_STICKY_TASKLIST
+	case shared.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure:
+		return apiv1.DECISION_TASK_FAILED_CAUSE_WORKFLOW_WORKER_UNHANDLED_FAILURE
+	case shared.DecisionTaskFailedCauseBadSignalWorkflowExecutionAttributes:
+		return apiv1.DECISION_TASK_FAILED_CAUSE_BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseBadStartChildExecutionAttributes:
+		return apiv1.DECISION_TASK_FAILED_CAUSE_BAD_START_CHILD_EXECUTION_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseForceCloseDecision:
+		return apiv1.DECISION_TASK_FAILED_CAUSE_FORCE_CLOSE_DECISION
+	case shared.DecisionTaskFailedCauseFailoverCloseDecision:
+		return apiv1.DECISION_TASK_FAILED_CAUSE_FAILOVER_CLOSE_DECISION
+	case shared.DecisionTaskFailedCauseBadSignalInputSize:
+		return apiv1.DECISION_TASK_FAILED_CAUSE_BAD_SIGNAL_INPUT_SIZE
+	case shared.DecisionTaskFailedCauseWorkflowLimitExceeded:
+		return apiv1.DECISION_TASK_FAILED_CAUSE_WORKFLOW_LIMIT_EXCEEDED
+	}
+	return apiv1.DECISION_TASK_FAILED_CAUSE_INVALID
+}
+
+// ToDecisionTaskFailedCause converts proto DecisionTaskFailedCause type to thrift
+func ToDecisionTaskFailedCause(p apiv1.DecisionTaskFailedCause) *shared.DecisionTaskFailedCause {
+	switch p {
+	case apiv1.DECISION_TASK_FAILED_CAUSE_UNHANDLED_DECISION:
+		return shared.DecisionTaskFailedCauseUnhandledDecision.Ptr()
+	case apiv1.DECISION_TASK_FAILED_CAUSE_BAD_SCHEDULE_ACTIVITY_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadScheduleActivityAttributes.Ptr()
+	case apiv1.DECISION_TASK_FAILED_CAUSE_BAD_REQUEST_CANCEL_ACTIVITY_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadRequestCancelActivityAttributes.Ptr()
+	case apiv1.DECISION_TASK_FAILED_CAUSE_BAD_START_TIMER_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadStartTimerAttributes.Ptr()
+	case apiv1.DECISION_TASK_FAILED_CAUSE_BAD_CANCEL_TIMER_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadCancelTimerAttributes.Ptr()
+	case apiv1.DECISION_TASK_FAILED_CAUSE_BAD_RECORD_MARKER_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadRecordMarkerAttributes.Ptr()
+	case apiv1.DECISION_TASK_FAILED_CAUSE_BAD_COMPLETE_WORKFLOW_EXECUTION_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadCompleteWorkflowExecutionAttributes.Ptr()
+	case apiv1.DECISION_TASK_FAILED_CAUSE_BAD_FAIL_WORKFLOW_EXECUTION_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadFailWorkflowExecutionAttributes.Ptr()
+	case apiv1.DECISION_TASK_FAILED_CAUSE_BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadCancelWorkflowExecutionAttributes.Ptr()
+	case apiv1.DECISION_TASK_FAILED_CAUSE_BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadRequestCancelExternalWorkflowExecutionAttributes.Ptr()
+	case apiv1.DECISION_TASK_FAILED_CAUSE_BAD_CONTINUE_AS_NEW_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadContinueAsNewAttributes.Ptr()
+	case apiv1.DECISION_TASK_FAILED_CAUSE_START_TIMER_DUPLICATE_ID:
+		return shared.DecisionTaskFailedCauseStartTimerDuplicateID.Ptr()
+	case apiv1.DECISION_TASK_FAILED_CAUSE_RESET_STICKY_TASKLIST:
+		return shared.DecisionTaskFailedCauseResetStickyTasklist.Ptr()
+	case apiv1.DECISION_TASK_FAILED_CAUSE_WORKFLOW_WORKER_UNHANDLED_FAILURE:
+		return shared.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure.Ptr()
+	case apiv1.DECISION_TASK_FAILED_CAUSE_BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadSignalWorkflowExecutionAttributes.Ptr()
+	case apiv1.DECISION_TASK_FAILED_CAUSE_BAD_START_CHILD_EXECUTION_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadStartChildExecutionAttributes.Ptr()
+	case apiv1.DECISION_TASK_FAILED_CAUSE_FORCE_CLOSE_DECISION:
+		return shared.DecisionTaskFailedCauseForceCloseDecision.Ptr()
+	case apiv1.DECISION_TASK_FAILED_CAUSE_FAILOVER_CLOSE_DECISION:
+		return shared.DecisionTaskFailedCauseFailoverCloseDecision.Ptr()
+	case apiv1.DECISION_TASK_FAILED_CAUSE_BAD_SIGNAL_INPUT_SIZE:
+		return shared.DecisionTaskFailedCauseBadSignalInputSize.Ptr()
+	case apiv1.DECISION_TASK_FAILED_CAUSE_WORKFLOW_LIMIT_EXCEEDED:
+		return shared.DecisionTaskFailedCauseWorkflowLimitExceeded.Ptr()
+	}
+	return nil
+}
+
+// FromCancelExternalWorkflowExecutionFailedCause converts thrift CancelExternalWorkflowExecutionFailedCause type to proto
+func FromCancelExternalWorkflowExecutionFailedCause(t *shared.CancelExternalWorkflowExecutionFailedCause) apiv1.CancelExternalWorkflowExecutionFailedCause {
+	if t == nil {
+		return apiv1.CANCEL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID
+	}
+	switch *t {
+	case shared.CancelExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution:
+		return apiv1.CANCEL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION
+	}
+	return apiv1.CANCEL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID
+}
+
+// ToCancelExternalWorkflowExecutionFailedCause converts proto CancelExternalWorkflowExecutionFailedCause type to thrift
+func ToCancelExternalWorkflowExecutionFailedCause(p apiv1.CancelExternalWorkflowExecutionFailedCause) *shared.CancelExternalWorkflowExecutionFailedCause {
+	switch p {
+	case apiv1.CANCEL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION:
+		return shared.CancelExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution.Ptr()
+	}
+	return nil
+}
+
+// FromSignalExternalWorkflowExecutionFailedCause converts thrift SignalExternalWorkflowExecutionFailedCause type to proto
+func FromSignalExternalWorkflowExecutionFailedCause(t *shared.SignalExternalWorkflowExecutionFailedCause) apiv1.SignalExternalWorkflowExecutionFailedCause {
+	if t == nil {
+		return apiv1.SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID
+	}
+	switch *t {
+	case shared.SignalExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution:
+		return apiv1.SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION
+	}
+	return apiv1.SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID
+}
+
+// ToSignalExternalWorkflowExecutionFailedCause converts proto SignalExternalWorkflowExecutionFailedCause type to thrift
+func ToSignalExternalWorkflowExecutionFailedCause(p apiv1.SignalExternalWorkflowExecutionFailedCause) *shared.SignalExternalWorkflowExecutionFailedCause {
+	switch p {
+	case apiv1.SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION:
+		return shared.SignalExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution.Ptr()
+	}
+	return nil
+}
+
+// FromChildWorkflowExecutionFailedCause converts thrift ChildWorkflowExecutionFailedCause type to proto
+func FromChildWorkflowExecutionFailedCause(t *shared.ChildWorkflowExecutionFailedCause) apiv1.ChildWorkflowExecutionFailedCause {
+	if t == nil {
+		return apiv1.CHILD_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID
+	}
+	switch *t {
+	case shared.ChildWorkflowExecutionFailedCauseWorkflowAlreadyRunning:
+		return apiv1.CHILD_WORKFLOW_EXECUTION_FAILED_CAUSE_WORKFLOW_ALREADY_RUNNING
+	}
+	return apiv1.CHILD_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID
+}
+
+// ToChildWorkflowExecutionFailedCause converts proto ChildWorkflowExecutionFailedCause type to thrift
+func ToChildWorkflowExecutionFailedCause(p apiv1.ChildWorkflowExecutionFailedCause) *shared.ChildWorkflowExecutionFailedCause {
+	switch p {
+	case apiv1.CHILD_WORKFLOW_EXECUTION_FAILED_CAUSE_WORKFLOW_ALREADY_RUNNING:
+		return shared.ChildWorkflowExecutionFailedCauseWorkflowAlreadyRunning.Ptr()
+	}
+	return nil
+}
+
+// FromWorkflowExecutionCloseStatus converts thrift WorkflowExecutionCloseStatus type to proto
+func FromWorkflowExecutionCloseStatus(t *shared.WorkflowExecutionCloseStatus) apiv1.WorkflowExecutionCloseStatus {
+	if t == nil {
+		return apiv1.WORKFLOW_EXECUTION_CLOSE_STATUS_INVALID
+	}
+	switch *t {
+	case shared.WorkflowExecutionCloseStatusCompleted:
+		return apiv1.WORKFLOW_EXECUTION_CLOSE_STATUS_COMPLETED
+	case shared.WorkflowExecutionCloseStatusFailed:
+		return apiv1.WORKFLOW_EXECUTION_CLOSE_STATUS_FAILED
+	case shared.WorkflowExecutionCloseStatusCanceled:
+		return apiv1.WORKFLOW_EXECUTION_CLOSE_STATUS_CANCELED
+	case shared.WorkflowExecutionCloseStatusTerminated:
+		return apiv1.WORKFLOW_EXECUTION_CLOSE_STATUS_TERMINATED
+	case shared.WorkflowExecutionCloseStatusContinuedAsNew:
+		return apiv1.WORKFLOW_EXECUTION_CLOSE_STATUS_CONTINUED_AS_NEW
+	case shared.WorkflowExecutionCloseStatusTimedOut:
+		return apiv1.WORKFLOW_EXECUTION_CLOSE_STATUS_TIMED_OUT
+	}
+	return apiv1.WORKFLOW_EXECUTION_CLOSE_STATUS_INVALID
+}
+
+// ToWorkflowExecutionCloseStatus converts proto WorkflowExecutionCloseStatus type to thrift
+func ToWorkflowExecutionCloseStatus(p apiv1.WorkflowExecutionCloseStatus) *shared.WorkflowExecutionCloseStatus {
+	switch p {
+	case apiv1.WORKFLOW_EXECUTION_CLOSE_STATUS_COMPLETED:
+		return shared.WorkflowExecutionCloseStatusCompleted.Ptr()
+	case apiv1.WORKFLOW_EXECUTION_CLOSE_STATUS_FAILED:
+		return shared.WorkflowExecutionCloseStatusFailed.Ptr()
+	case apiv1.WORKFLOW_EXECUTION_CLOSE_STATUS_CANCELED:
+		return shared.WorkflowExecutionCloseStatusCanceled.Ptr()
+	case apiv1.WORKFLOW_EXECUTION_CLOSE_STATUS_TERMINATED:
+		return shared.WorkflowExecutionCloseStatusTerminated.Ptr()
+	case apiv1.WORKFLOW_EXECUTION_CLOSE_STATUS_CONTINUED_AS_NEW:
+		return shared.WorkflowExecutionCloseStatusContinuedAsNew.Ptr()
+	case apiv1.WORKFLOW_EXECUTION_CLOSE_STATUS_TIMED_OUT:
+		return shared.WorkflowExecutionCloseStatusTimedOut.Ptr()
+	}
+	return nil
+}
+
+// FromChildPolicy converts thrift ChildPolicy type to proto
+func FromChildPolicy(t *shared.ChildPolicy) apiv1.ChildPolicy {
+	if t == nil {
+		return apiv1.CHILD_POLICY_INVALID
+	}
+	switch *t {
+	case shared.ChildPolicyTerminate:
+		return apiv1.CHILD_POLICY_TERMINATE
+	case shared.ChildPolicyRequestCancel:
+		return apiv1.CHILD_POLICY_REQUEST_CANCEL
+	case shared.ChildPolicyAbandon:
+		return apiv1.CHILD_POLICY_ABANDON
+	}
+	return apiv1.CHILD_POLICY_INVALID
+}
+
+// ToChildPolicy converts proto ChildPolicy type to thrift
+func ToChildPolicy(p apiv1.ChildPolicy) *shared.ChildPolicy {
+	switch p {
+	case apiv1.CHILD_POLICY_TERMINATE:
+		return shared.ChildPolicyTerminate.Ptr()
+	case apiv1.CHILD_POLICY_REQUEST_CANCEL:
+		return shared.ChildPolicyRequestCancel.Ptr()
+	case apiv1.CHILD_POLICY_ABANDON:
+		return shared.ChildPolicyAbandon.Ptr()
+	}
+	return nil
+}
+
+// FromQueryTaskCompletedType converts thrift QueryTaskCompletedType type to proto
+func FromQueryTaskCompletedType(t *shared.QueryTaskCompletedType) apiv1.QueryTaskCompletedType {
+	if t == nil {
+		return apiv1.QUERY_TASK_COMPLETED_TYPE_INVALID
+	}
+	switch *t {
+	case shared.QueryTaskCompletedTypeCompleted:
+		return apiv1.QUERY_TASK_COMPLETED_TYPE_COMPLETED
+	case shared.QueryTaskCompletedTypeFailed:
+		return apiv1.QUERY_TASK_COMPLETED_TYPE_FAILED
+	}
+	return apiv1.QUERY_TASK_COMPLETED_TYPE_INVALID
+}
+
+// ToQueryTaskCompletedType converts proto QueryTaskCompletedType type to thrift
+func ToQueryTaskCompletedType(p apiv1.QueryTaskCompletedType) *shared.QueryTaskCompletedType {
+	switch p {
+	case apiv1.QUERY_TASK_COMPLETED_TYPE_COMPLETED:
+		return shared.QueryTaskCompletedTypeCompleted.Ptr()
+	case apiv1.QUERY_TASK_COMPLETED_TYPE_FAILED:
+		return shared.QueryTaskCompletedTypeFailed.Ptr()
+	}
+	return nil
+}
+
+// FromPendingActivityState converts thrift PendingActivityState type to proto
+func FromPendingActivityState(t *shared.PendingActivityState) apiv1.PendingActivityState {
+	if t == nil {
+		return apiv1.PENDING_ACTIVITY_STATE_INVALID
+	}
+	switch *t {
+	case shared.PendingActivityStateScheduled:
+		return apiv1.PENDING_ACTIVITY_STATE_SCHEDULED
+	case shared.PendingActivityStateStarted:
+		return apiv1.PENDING_ACTIVITY_STATE_STARTED
+	case shared.PendingActivityStateCancelRequested:
+		return apiv1.PENDING_ACTIVITY_STATE_CANCEL_REQUESTED
+	}
+	return apiv1.PENDING_ACTIVITY_STATE_INVALID
+}
+
+// ToPendingActivityState converts proto PendingActivityState type to thrift
+func ToPendingActivityState(p apiv1.PendingActivityState) *shared.PendingActivityState {
+	switch p {
+	case apiv1.PENDING_ACTIVITY_STATE_SCHEDULED:
+		return shared.PendingActivityStateScheduled.Ptr()
+	case apiv1.PENDING_ACTIVITY_STATE_STARTED:
+		return shared.PendingActivityStateStarted.Ptr()
+	case apiv1.PENDING_ACTIVITY_STATE_CANCEL_REQUESTED:
+		return shared.PendingActivityStateCancelRequested.Ptr()
+	}
+	return nil
+}
+
+// FromHistoryEventFilterType converts thrift HistoryEventFilterType type to proto
+func FromHistoryEventFilterType(t *shared.HistoryEventFilterType) apiv1.HistoryEventFilterType {
+	if t == nil {
+		return apiv1.HISTORY_EVENT_FILTER_TYPE_INVALID
+	}
+	switch *t {
+	case shared.HistoryEventFilterTypeAllEvent:
+		return apiv1.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT
+	case shared.HistoryEventFilterTypeCloseEvent:
+		return apiv1.HISTORY_EVENT_FILTER_TYPE_CLOSE_EVENT
+	}
+	return apiv1.HISTORY_EVENT_FILTER_TYPE_INVALID
+}
+
+// ToHistoryEventFilterType converts proto HistoryEventFilterType type to thrift
+func ToHistoryEventFilterType(p apiv1.HistoryEventFilterType) *shared.HistoryEventFilterType {
+	switch p {
+	case apiv1.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT:
+		return shared.HistoryEventFilterTypeAllEvent.Ptr()
+	case apiv1.HISTORY_EVENT_FILTER_TYPE_CLOSE_EVENT:
+		return shared.HistoryEventFilterTypeCloseEvent.Ptr()
+	}
+	return nil
+}
+
+// FromTaskListKind converts thrift TaskListKind type to proto
+func FromTaskListKind(t *shared.TaskListKind) apiv1.TaskListKind {
+	if t == nil {
+		return apiv1.TASK_LIST_KIND_INVALID
+	}
+	switch *t {
+	case shared.TaskListKindNormal:
+		return apiv1.TASK_LIST_KIND_NORMAL
+	case shared.TaskListKindSticky:
+		return apiv1.TASK_LIST_KIND_STICKY
+	}
+	return apiv1.TASK_LIST_KIND_INVALID
+}
+
+// ToTaskListKind converts proto TaskListKind type to thrift
+func ToTaskListKind(p apiv1.TaskListKind) *shared.TaskListKind {
+	switch p {
+	case apiv1.TASK_LIST_KIND_NORMAL:
+		return shared.TaskListKindNormal.Ptr()
+	case apiv1.TASK_LIST_KIND_STICKY:
+		return shared.TaskListKindSticky.Ptr()
+	}
+	return nil
+}
+
+// FromEncodingType converts thrift EncodingType type to proto
+func FromEncodingType(t *shared.EncodingType) apiv1.EncodingType {
+	if t == nil {
+		return apiv1.ENCODING_TYPE_INVALID
+	}
+	switch *t {
+	case shared.EncodingTypeThriftRW:
+		return apiv1.ENCODING_TYPE_THRIFT_RW
+	case shared.EncodingTypeJSON:
+		return apiv1.ENCODING_TYPE_JSON
+	}
+	return apiv1.ENCODING_TYPE_INVALID
+}
+
+// ToEncodingType converts proto EncodingType type to thrift
+func ToEncodingType(p apiv1.EncodingType) *shared.EncodingType {
+	switch p {
+	case apiv1.ENCODING_TYPE_THRIFT_RW:
+		return shared.EncodingTypeThriftRW.Ptr()
+	case apiv1.ENCODING_TYPE_JSON:
+		return shared.EncodingTypeJSON.Ptr()
+	}
+	return nil
+}
+
+// FromHeader converts thrift Header type to proto
+func FromHeader(t *shared.Header) *apiv1.Header {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.Header{
+		Fields: t.Fields,
+	}
+}
+
+// ToHeader converts proto Header type to thrift
+func ToHeader(p *apiv1.Header) *shared.Header {
+	if p == nil {
+		return nil
+	}
+	return &shared.Header{
+		Fields: p.Fields,
+	}
+}
+
+// FromWorkflowType converts thrift WorkflowType type to proto
+func FromWorkflowType(t *shared.WorkflowType) *apiv1.WorkflowType {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowType{
+		Name: t.GetName(),
+	}
+}
+
+// ToWorkflowType converts proto WorkflowType type to thrift
+func ToWorkflowType(p *apiv1.WorkflowType) *shared.WorkflowType {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowType{
+		Name: stringPtr(p.Name),
+	}
+}
+
+// FromActivityType converts thrift ActivityType type to proto
+func FromActivityType(t *shared.ActivityType) *apiv1.ActivityType {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityType{
+		Name: t.GetName(),
+	}
+}
+
+// ToActivityType converts proto ActivityType type to thrift
+func ToActivityType(p *apiv1.ActivityType) *shared.ActivityType {
+	if p == nil {
+		return nil
+	}
+	return &shared.ActivityType{
+		Name: stringPtr(p.Name),
+	}
+}
+
+// FromTaskList converts thrift TaskList type to proto
+func FromTaskList(t *shared.TaskList) *apiv1.TaskList {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TaskList{
+		Name: t.GetName(),
+		Kind: FromTaskListKind(t.Kind),
+	}
+}
+
+// ToTaskList converts proto TaskList type to thrift
+func ToTaskList(p *apiv1.TaskList) *shared.TaskList {
+	if p == nil {
+		return nil
+	}
+	return &shared.TaskList{
+		Name: stringPtr(p.Name),
+		Kind: ToTaskListKind(p.Kind),
+	}
+}
+
+// FromTaskListMetadata converts thrift TaskListMetadata type to proto
+func FromTaskListMetadata(t *shared.TaskListMetadata) *apiv1.TaskListMetadata {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TaskListMetadata{
+		MaxTasksPerSecond: t.GetMaxTasksPerSecond(),
+	}
+}
+
+// ToTaskListMetadata converts proto TaskListMetadata type to thrift
+func ToTaskListMetadata(p *apiv1.TaskListMetadata) *shared.TaskListMetadata {
+	if p == nil {
+		return nil
+	}
+	return &shared.TaskListMetadata{
+		MaxTasksPerSecond: float64Ptr(p.MaxTasksPerSecond),
+	}
+}
+
+// FromWorkflowExecution converts thrift WorkflowExecution type to proto
+func FromWorkflowExecution(t *shared.WorkflowExecution) *apiv1.WorkflowExecution {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecution{
+		WorkflowId: t.GetWorkflowId(),
+		RunId:      t.GetRunId(),
+	}
+}
+
+// ToWorkflowExecution converts proto WorkflowExecution type to thrift
+func ToWorkflowExecution(p *apiv1.WorkflowExecution) *shared.WorkflowExecution {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecution{
+		WorkflowId: stringPtr(p.WorkflowId),
+		RunId:      stringPtr(p.RunId),
+	}
+}
+
+// FromWorkflowExecutionInfo converts thrift WorkflowExecutionInfo type to proto
+func FromWorkflowExecutionInfo(t *shared.WorkflowExecutionInfo) *apiv1.WorkflowExecutionInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionInfo{
+		Execution:     FromWorkflowExecution(t.Execution),
+		Type:          FromWorkflowType(t.Type),
+		StartTime:     t.GetStartTime(),
+		CloseTime:     t.GetCloseTime(),
+		CloseStatus:   FromWorkflowExecutionCloseStatus(t.CloseStatus),
+		HistoryLength: t.GetHistoryLength(),
+	}
+}
+
+// ToWorkflowExecutionInfo converts proto WorkflowExecutionInfo type to thrift
+func ToWorkflowExecutionInfo(p *apiv1.WorkflowExecutionInfo) *shared.WorkflowExecutionInfo {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionInfo{
+		Execution:     ToWorkflowExecution(p.Execution),
+		Type:          ToWorkflowType(p.Type),
+		StartTime:     int64Ptr(p.StartTime),
+		CloseTime:     int64Ptr(p.CloseTime),
+		CloseStatus:   ToWorkflowExecutionCloseStatus(p.CloseStatus),
+		HistoryLength: int64Ptr(p.HistoryLength),
+	}
+}
+
+// FromWorkflowExecutionConfiguration converts thrift WorkflowExecutionConfiguration type to proto
+func FromWorkflowExecutionConfiguration(t *shared.WorkflowExecutionConfiguration) *apiv1.WorkflowExecutionConfiguration {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionConfiguration{
+		TaskList:                            FromTaskList(t.TaskList),
+		ExecutionStartToCloseTimeoutSeconds: t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      t.GetTaskStartToCloseTimeoutSeconds(),
+		ChildPolicy:                         FromChildPolicy(t.ChildPolicy),
+	}
+}
+
+// ToWorkflowExecutionConfiguration converts proto WorkflowExecutionConfiguration type to thrift
+func ToWorkflowExecutionConfiguration(p *apiv1.WorkflowExecutionConfiguration) *shared.WorkflowExecutionConfiguration {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionConfiguration{
+		TaskList:                            ToTaskList(p.TaskList),
+		ExecutionStartToCloseTimeoutSeconds: int32Ptr(p.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      int32Ptr(p.TaskStartToCloseTimeoutSeconds),
+		ChildPolicy:                         ToChildPolicy(p.ChildPolicy),
+	}
+}
+
+// FromScheduleActivityTaskDecisionAttributes converts thrift ScheduleActivityTaskDecisionAttributes type to proto
+func FromScheduleActivityTaskDecisionAttributes(t *shared.ScheduleActivityTaskDecisionAttributes) *apiv1.ScheduleActivityTaskDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ScheduleActivityTaskDecisionAttributes{
+		ActivityId:                    t.GetActivityId(),
+		ActivityType:                  FromActivityType(t.ActivityType),
+		Domain:                        t.GetDomain(),
+		TaskList:                      FromTaskList(t.TaskList),
+		Input:                         t.GetInput(),
+		ScheduleToCloseTimeoutSeconds: t.GetScheduleToCloseTimeoutSeconds(),
+		ScheduleToStartTimeoutSeconds: t.GetScheduleToStartTimeoutSeconds(),
+		StartToCloseTimeoutSeconds:    t.GetStartToCloseTimeoutSeconds(),
+		HeartbeatTimeoutSeconds:       t.GetHeartbeatTimeoutSeconds(),
+		RetryPolicy:                   FromRetryPolicy(t.RetryPolicy),
+	}
+}
+
+// ToScheduleActivityTaskDecisionAttributes converts proto ScheduleActivityTaskDecisionAttributes type to thrift
+func ToScheduleActivityTaskDecisionAttributes(p *apiv1.ScheduleActivityTaskDecisionAttributes) *shared.ScheduleActivityTaskDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ScheduleActivityTaskDecisionAttributes{
+		ActivityId:                    stringPtr(p.ActivityId),
+		ActivityType:                  ToActivityType(p.ActivityType),
+		Domain:                        stringPtr(p.Domain),
+		TaskList:                      ToTaskList(p.TaskList),
+		Input:                         p.Input,
+		ScheduleToCloseTimeoutSeconds: int32Ptr(p.ScheduleToCloseTimeoutSeconds),
+		ScheduleToStartTimeoutSeconds: int32Ptr(p.ScheduleToStartTimeoutSeconds),
+		StartToCloseTimeoutSeconds:    int32Ptr(p.StartToCloseTimeoutSeconds),
+		HeartbeatTimeoutSeconds:       int32Ptr(p.HeartbeatTimeoutSeconds),
+		RetryPolicy:                   ToRetryPolicy(p.RetryPolicy),
+	}
+}
+
+// FromRequestCancelActivityTaskDecisionAttributes converts thrift RequestCancelActivityTaskDecisionAttributes type to proto
+func FromRequestCancelActivityTaskDecisionAttributes(t *shared.RequestCancelActivityTaskDecisionAttributes) *apiv1.RequestCancelActivityTaskDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RequestCancelActivityTaskDecisionAttributes{
+		ActivityId: t.GetActivityId(),
+	}
+}
+
+// ToRequestCancelActivityTaskDecisionAttributes converts proto RequestCancelActivityTaskDecisionAttributes type to thrift
+func ToRequestCancelActivityTaskDecisionAttributes(p *apiv1.RequestCancelActivityTaskDecisionAttributes) *shared.RequestCancelActivityTaskDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.RequestCancelActivityTaskDecisionAttributes{
+		ActivityId: stringPtr(p.ActivityId),
+	}
+}
+
+// FromStartTimerDecisionAttributes converts thrift StartTimerDecisionAttributes type to proto
+func FromStartTimerDecisionAttributes(t *shared.StartTimerDecisionAttributes) *apiv1.StartTimerDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartTimerDecisionAttributes{
+		TimerId:                   t.GetTimerId(),
+		StartToFireTimeoutSeconds: t.GetStartToFireTimeoutSeconds(),
+	}
+}
+
+// ToStartTimerDecisionAttributes converts proto StartTimerDecisionAttributes type to thrift
+func ToStartTimerDecisionAttributes(p *apiv1.StartTimerDecisionAttributes) *shared.StartTimerDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.StartTimerDecisionAttributes{
+		TimerId:                   stringPtr(p.TimerId),
+		StartToFireTimeoutSeconds: int64Ptr(p.StartToFireTimeoutSeconds),
+	}
+}
+
+// FromCompleteWorkflowExecutionDecisionAttributes converts thrift CompleteWorkflowExecutionDecisionAttributes type to proto
+func FromCompleteWorkflowExecutionDecisionAttributes(t *shared.CompleteWorkflowExecutionDecisionAttributes) *apiv1.CompleteWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CompleteWorkflowExecutionDecisionAttributes{
+		Result: t.GetResult(),
+	}
+}
+
+// ToCompleteWorkflowExecutionDecisionAttributes converts proto CompleteWorkflowExecutionDecisionAttributes type to thrift
+func ToCompleteWorkflowExecutionDecisionAttributes(p *apiv1.CompleteWorkflowExecutionDecisionAttributes) *shared.CompleteWorkflowExecutionDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.CompleteWorkflowExecutionDecisionAttributes{
+		Result: p.Result,
+	}
+}
+
+// FromFailWorkflowExecutionDecisionAttributes converts thrift FailWorkflowExecutionDecisionAttributes type to proto
+func FromFailWorkflowExecutionDecisionAttributes(t *shared.FailWorkflowExecutionDecisionAttributes) *apiv1.FailWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.FailWorkflowExecutionDecisionAttributes{
+		Reason:  t.GetReason(),
+		Details: t.GetDetails(),
+	}
+}
+
+// ToFailWorkflowExecutionDecisionAttributes converts proto FailWorkflowExecutionDecisionAttributes type to thrift
+func ToFailWorkflowExecutionDecisionAttributes(p *apiv1.FailWorkflowExecutionDecisionAttributes) *shared.FailWorkflowExecutionDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.FailWorkflowExecutionDecisionAttributes{
+		Reason:  stringPtr(p.Reason),
+		Details: p.Details,
+	}
+}
+
+// FromCancelTimerDecisionAttributes converts thrift CancelTimerDecisionAttributes type to proto
+func FromCancelTimerDecisionAttributes(t *shared.CancelTimerDecisionAttributes) *apiv1.CancelTimerDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CancelTimerDecisionAttributes{
+		TimerId: t.GetTimerId(),
+	}
+}
+
+// ToCancelTimerDecisionAttributes converts proto CancelTimerDecisionAttributes type to thrift
+func ToCancelTimerDecisionAttributes(p *apiv1.CancelTimerDecisionAttributes) *shared.CancelTimerDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.CancelTimerDecisionAttributes{
+		TimerId: stringPtr(p.TimerId),
+	}
+}
+
+// FromCancelWorkflowExecutionDecisionAttributes converts thrift CancelWorkflowExecutionDecisionAttributes type to proto
+func FromCancelWorkflowExecutionDecisionAttributes(t *shared.CancelWorkflowExecutionDecisionAttributes) *apiv1.CancelWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CancelWorkflowExecutionDecisionAttributes{
+		Details: t.GetDetails(),
+	}
+}
+
+// ToCancelWorkflowExecutionDecisionAttributes converts proto CancelWorkflowExecutionDecisionAttributes type to thrift
+func ToCancelWorkflowExecutionDecisionAttributes(p *apiv1.CancelWorkflowExecutionDecisionAttributes) *shared.CancelWorkflowExecutionDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.CancelWorkflowExecutionDecisionAttributes{
+		Details: p.Details,
+	}
+}
+
+// FromRequestCancelExternalWorkflowExecutionDecisionAttributes converts thrift RequestCancelExternalWorkflowExecutionDecisionAttributes type to proto
+func FromRequestCancelExternalWorkflowExecutionDecisionAttributes(t *shared.RequestCancelExternalWorkflowExecutionDecisionAttributes) *apiv1.RequestCancelExternalWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RequestCancelExternalWorkflowExecutionDecisionAttributes{
+		Domain:            t.GetDomain(),
+		WorkflowId:        t.GetWorkflowId(),
+		RunId:             t.GetRunId(),
+		Control:           t.GetControl(),
+		ChildWorkflowOnly: t.GetChildWorkflowOnly(),
+	}
+}
+
+// ToRequestCancelExternalWorkflowExecutionDecisionAttributes converts proto RequestCancelExternalWorkflowExecutionDecisionAttributes type to thrift
+func ToRequestCancelExternalWorkflowExecutionDecisionAttributes(p *apiv1.RequestCancelExternalWorkflowExecutionDecisionAttributes) *shared.RequestCancelExternalWorkflowExecutionDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.RequestCancelExternalWorkflowExecutionDecisionAttributes{
+		Domain:            stringPtr(p.Domain),
+		WorkflowId:        stringPtr(p.WorkflowId),
+		RunId:             stringPtr(p.RunId),
+		Control:           p.Control,
+		ChildWorkflowOnly: common.BoolPtr(p.ChildWorkflowOnly),
+	}
+}
+
+// FromSignalExternalWorkflowExecutionDecisionAttributes converts thrift SignalExternalWorkflowExecutionDecisionAttributes type to proto
+func FromSignalExternalWorkflowExecutionDecisionAttributes(t *shared.SignalExternalWorkflowExecutionDecisionAttributes) *apiv1.SignalExternalWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.SignalExternalWorkflowExecutionDecisionAttributes{
+		Domain:            t.GetDomain(),
+		Execution:         FromWorkflowExecution(t.Execution),
+		SignalName:        t.GetSignalName(),
+		Input:             t.GetInput(),
+		Control:           t.GetControl(),
+		ChildWorkflowOnly: t.GetChildWorkflowOnly(),
+	}
+}
+
+// ToSignalExternalWorkflowExecutionDecisionAttributes converts proto SignalExternalWorkflowExecutionDecisionAttributes type to thrift
+func ToSignalExternalWorkflowExecutionDecisionAttributes(p *apiv1.SignalExternalWorkflowExecutionDecisionAttributes) *shared.SignalExternalWorkflowExecutionDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.SignalExternalWorkflowExecutionDecisionAttributes{
+		Domain:            stringPtr(p.Domain),
+		Execution:         ToWorkflowExecution(p.Execution),
+		SignalName:        stringPtr(p.SignalName),
+		Input:             p.Input,
+		Control:           p.Control,
+		ChildWorkflowOnly: common.BoolPtr(p.ChildWorkflowOnly),
+	}
+}
+
+// FromRecordMarkerDecisionAttributes converts thrift RecordMarkerDecisionAttributes type to proto
+func FromRecordMarkerDecisionAttributes(t *shared.RecordMarkerDecisionAttributes) *apiv1.RecordMarkerDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RecordMarkerDecisionAttributes{
+		MarkerName: t.GetMarkerName(),
+		Details:    t.GetDetails(),
+		Header:     FromHeader(t.Header),
+	}
+}
+
+// ToRecordMarkerDecisionAttributes converts proto RecordMarkerDecisionAttributes type to thrift
+func ToRecordMarkerDecisionAttributes(p *apiv1.RecordMarkerDecisionAttributes) *shared.RecordMarkerDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.RecordMarkerDecisionAttributes{
+		MarkerName: stringPtr(p.MarkerName),
+		Details:    p.Details,
+		Header:     ToHeader(p.Header),
+	}
+}
+
+// FromContinueAsNewWorkflowExecutionDecisionAttributes converts thrift ContinueAsNewWorkflowExecutionDecisionAttributes type to proto
+func FromContinueAsNewWorkflowExecutionDecisionAttributes(t *shared.ContinueAsNewWorkflowExecutionDecisionAttributes) *apiv1.ContinueAsNewWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ContinueAsNewWorkflowExecutionDecisionAttributes{
+		WorkflowType:                        FromWorkflowType(t.WorkflowType),
+		TaskList:                            FromTaskList(t.TaskList),
+		Input:                               t.GetInput(),
+		ExecutionStartToCloseTimeoutSeconds: t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      t.GetTaskStartToCloseTimeoutSeconds(),
+		BackoffStartIntervalInSeconds:       t.GetBackoffStartIntervalInSeconds(),
+		RetryPolicy:                         FromRetryPolicy(t.RetryPolicy),
+	}
+}
+
+// ToContinueAsNewWorkflowExecutionDecisionAttributes converts proto ContinueAsNewWorkflowExecutionDecisionAttributes type to thrift
+func ToContinueAsNewWorkflowExecutionDecisionAttributes(p *apiv1.ContinueAsNewWorkflowExecutionDecisionAttributes) *shared.ContinueAsNewWorkflowExecutionDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ContinueAsNewWorkflowExecutionDecisionAttributes{
+		WorkflowType:                        ToWorkflowType(p.WorkflowType),
+		TaskList:                            ToTaskList(p.TaskList),
+		Input:                               p.Input,
+		ExecutionStartToCloseTimeoutSeconds: int32Ptr(p.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      int32Ptr(p.TaskStartToCloseTimeoutSeconds),
+		BackoffStartIntervalInSeconds:       int32Ptr(p.BackoffStartIntervalInSeconds),
+		RetryPolicy:                         ToRetryPolicy(p.RetryPolicy),
+	}
+}
+
+// FromStartChildWorkflowExecutionDecisionAttributes converts thrift StartChildWorkflowExecutionDecisionAttributes type to proto
+func FromStartChildWorkflowExecutionDecisionAttributes(t *shared.StartChildWorkflowExecutionDecisionAttributes) *apiv1.StartChildWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartChildWorkflowExecutionDecisionAttributes{
+		Domain:                              t.GetDomain(),
+		WorkflowId:                          t.GetWorkflowId(),
+		WorkflowType:                        FromWorkflowType(t.WorkflowType),
+		TaskList:                            FromTaskList(t.TaskList),
+		Input:                               t.GetInput(),
+		ExecutionStartToCloseTimeoutSeconds: t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      t.GetTaskStartToCloseTimeoutSeconds(),
+		ChildPolicy:                         FromChildPolicy(t.ChildPolicy),
+		Control:                             t.GetControl(),
+		WorkflowIdReusePolicy:               FromWorkflowIDReusePolicy(t.WorkflowIdReusePolicy),
+		RetryPolicy:                         FromRetryPolicy(t.RetryPolicy),
+	}
+}
+
+// ToStartChildWorkflowExecutionDecisionAttributes converts proto StartChildWorkflowExecutionDecisionAttributes type to thrift
+func ToStartChildWorkflowExecutionDecisionAttributes(p *apiv1.StartChildWorkflowExecutionDecisionAttributes) *shared.StartChildWorkflowExecutionDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.StartChildWorkflowExecutionDecisionAttributes{
+		Domain:                              stringPtr(p.Domain),
+		WorkflowId:                          stringPtr(p.WorkflowId),
+		WorkflowType:                        ToWorkflowType(p.WorkflowType),
+		TaskList:                            ToTaskList(p.TaskList),
+		Input:                               p.Input,
+		ExecutionStartToCloseTimeoutSeconds: int32Ptr(p.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      int32Ptr(p.TaskStartToCloseTimeoutSeconds),
+		ChildPolicy:                         ToChildPolicy(p.ChildPolicy),
+		Control:                             p.Control,
+		WorkflowIdReusePolicy:               ToWorkflowIDReusePolicy(p.WorkflowIdReusePolicy),
+		RetryPolicy:                         ToRetryPolicy(p.RetryPolicy),
+	}
+}
+
+// FromDecision converts thrift Decision type to proto
+func FromDecision(t *shared.Decision) *apiv1.Decision {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.Decision{
+		DecisionType:                                             FromDecisionType(t.DecisionType),
+		ScheduleActivityTaskDecisionAttributes:                   FromScheduleActivityTaskDecisionAttributes(t.ScheduleActivityTaskDecisionAttributes),
+		StartTimerDecisionAttributes:                             FromStartTimerDecisionAttributes(t.StartTimerDecisionAttributes),
+		CompleteWorkflowExecutionDecisionAttributes:              FromCompleteWorkflowExecutionDecisionAttributes(t.CompleteWorkflowExecutionDecisionAttributes),
+		FailWorkflowExecutionDecisionAttributes:                  FromFailWorkflowExecutionDecisionAttributes(t.FailWorkflowExecutionDecisionAttributes),
+		RequestCancelActivityTaskDecisionAttributes:              FromRequestCancelActivityTaskDecisionAttributes(t.RequestCancelActivityTaskDecisionAttributes),
+		CancelTimerDecisionAttributes:                            FromCancelTimerDecisionAttributes(t.CancelTimerDecisionAttributes),
+		CancelWorkflowExecutionDecisionAttributes:                FromCancelWorkflowExecutionDecisionAttributes(t.CancelWorkflowExecutionDecisionAttributes),
+		RequestCancelExternalWorkflowExecutionDecisionAttributes: FromRequestCancelExternalWorkflowExecutionDecisionAttributes(t.RequestCancelExternalWorkflowExecutionDecisionAttributes),
+		RecordMarkerDecisionAttributes:                           FromRecordMarkerDecisionAttributes(t.RecordMarkerDecisionAttributes),
+		ContinueAsNewWorkflowExecutionDecisionAttributes:         FromContinueAsNewWorkflowExecutionDecisionAttributes(t.ContinueAsNewWorkflowExecutionDecisionAttributes),
+		StartChildWorkflowExecutionDecisionAttributes:            FromStartChildWorkflowExecutionDecisionAttributes(t.StartChildWorkflowExecutionDecisionAttributes),
+		SignalExternalWorkflowExecutionDecisionAttributes:        FromSignalExternalWorkflowExecutionDecisionAttributes(t.SignalExternalWorkflowExecutionDecisionAttributes),
+	}
+}
+
+// ToDecision converts proto Decision type to thrift
+func ToDecision(p *apiv1.Decision) *shared.Decision {
+	if p == nil {
+		return nil
+	}
+	return &shared.Decision{
+		DecisionType:                                             ToDecisionType(p.DecisionType),
+		ScheduleActivityTaskDecisionAttributes:                   ToScheduleActivityTaskDecisionAttributes(p.ScheduleActivityTaskDecisionAttributes),
+		StartTimerDecisionAttributes:                             ToStartTimerDecisionAttributes(p.StartTimerDecisionAttributes),
+		CompleteWorkflowExecutionDecisionAttributes:              ToCompleteWorkflowExecutionDecisionAttributes(p.CompleteWorkflowExecutionDecisionAttributes),
+		FailWorkflowExecutionDecisionAttributes:                  ToFailWorkflowExecutionDecisionAttributes(p.FailWorkflowExecutionDecisionAttributes),
+		RequestCancelActivityTaskDecisionAttributes:              ToRequestCancelActivityTaskDecisionAttributes(p.RequestCancelActivityTaskDecisionAttributes),
+		CancelTimerDecisionAttributes:                            ToCancelTimerDecisionAttributes(p.CancelTimerDecisionAttributes),
+		CancelWorkflowExecutionDecisionAttributes:                ToCancelWorkflowExecutionDecisionAttributes(p.CancelWorkflowExecutionDecisionAttributes),
+		RequestCancelExternalWorkflowExecutionDecisionAttributes: ToRequestCancelExternalWorkflowExecutionDecisionAttributes(p.RequestCancelExternalWorkflowExecutionDecisionAttributes),
+		RecordMarkerDecisionAttributes:                           ToRecordMarkerDecisionAttributes(p.RecordMarkerDecisionAttributes),
+		ContinueAsNewWorkflowExecutionDecisionAttributes:         ToContinueAsNewWorkflowExecutionDecisionAttributes(p.ContinueAsNewWorkflowExecutionDecisionAttributes),
+		StartChildWorkflowExecutionDecisionAttributes:            ToStartChildWorkflowExecutionDecisionAttributes(p.StartChildWorkflowExecutionDecisionAttributes),
+		SignalExternalWorkflowExecutionDecisionAttributes:        ToSignalExternalWorkflowExecutionDecisionAttributes(p.SignalExternalWorkflowExecutionDecisionAttributes),
+	}
+}
+
+// FromWorkflowExecutionStartedEventAttributes converts thrift WorkflowExecutionStartedEventAttributes type to proto
+func FromWorkflowExecutionStartedEventAttributes(t *shared.WorkflowExecutionStartedEventAttributes) *apiv1.WorkflowExecutionStartedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionStartedEventAttributes{
+		WorkflowType:                        FromWorkflowType(t.WorkflowType),
+		ParentWorkflowDomain:                t.GetParentWorkflowDomain(),
+		ParentWorkflowExecution:             FromWorkflowExecution(t.ParentWorkflowExecution),
+		ParentInitiatedEventId:              t.GetParentInitiatedEventId(),
+		TaskList:                            FromTaskList(t.TaskList),
+		Input:                               t.GetInput(),
+		ExecutionStartToCloseTimeoutSeconds: t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      t.GetTaskStartToCloseTimeoutSeconds(),
+		ChildPolicy:                         FromChildPolicy(t.ChildPolicy),
+		ContinuedExecutionRunId:             t.GetContinuedExecutionRunId(),
+		Identity:                            t.GetIdentity(),
+		RetryPolicy:                         FromRetryPolicy(t.RetryPolicy),
+		Attempt:                             t.GetAttempt(),
+		ExpirationTimestamp:                 t.GetExpirationTimestamp(),
+		FirstDecisionTaskBackoffSeconds:     t.GetFirstDecisionTaskBackoffSeconds(),
+	}
+}
+
+// ToWorkflowExecutionStartedEventAttributes converts proto WorkflowExecutionStartedEventAttributes type to thrift
+func ToWorkflowExecutionStartedEventAttributes(p *apiv1.WorkflowExecutionStartedEventAttributes) *shared.WorkflowExecutionStartedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionStartedEventAttributes{
+		WorkflowType:                        ToWorkflowType(p.WorkflowType),
+		ParentWorkflowDomain:                stringPtr(p.ParentWorkflowDomain),
+		ParentWorkflowExecution:             ToWorkflowExecution(p.ParentWorkflowExecution),
+		ParentInitiatedEventId:              int64Ptr(p.ParentInitiatedEventId),
+		TaskList:                            ToTaskList(p.TaskList),
+		Input:                               p.Input,
+		ExecutionStartToCloseTimeoutSeconds: int32Ptr(p.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      int32Ptr(p.TaskStartToCloseTimeoutSeconds),
+		ChildPolicy:                         ToChildPolicy(p.ChildPolicy),
+		ContinuedExecutionRunId:             stringPtr(p.ContinuedExecutionRunId),
+		Identity:                            stringPtr(p.Identity),
+		RetryPolicy:                         ToRetryPolicy(p.RetryPolicy),
+		Attempt:                             int32Ptr(p.Attempt),
+		ExpirationTimestamp:                 int64Ptr(p.ExpirationTimestamp),
+		FirstDecisionTaskBackoffSeconds:     int32Ptr(p.FirstDecisionTaskBackoffSeconds),
+	}
+}
+
+// FromWorkflowExecutionCompletedEventAttributes converts thrift WorkflowExecutionCompletedEventAttributes type to proto
+func FromWorkflowExecutionCompletedEventAttributes(t *shared.WorkflowExecutionCompletedEventAttributes) *apiv1.WorkflowExecutionCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionCompletedEventAttributes{
+		Result:                       t.GetResult(),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+	}
+}
+
+// ToWorkflowExecutionCompletedEventAttributes converts proto WorkflowExecutionCompletedEventAttributes type to thrift
+func ToWorkflowExecutionCompletedEventAttributes(p *apiv1.WorkflowExecutionCompletedEventAttributes) *shared.WorkflowExecutionCompletedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionCompletedEventAttributes{
+		Result:                       p.Result,
+		DecisionTaskCompletedEventId: int64Ptr(p.DecisionTaskCompletedEventId),
+	}
+}
+
+// FromWorkflowExecutionFailedEventAttributes converts thrift WorkflowExecutionFailedEventAttributes type to proto
+func FromWorkflowExecutionFailedEventAttributes(t *shared.WorkflowExecutionFailedEventAttributes) *apiv1.WorkflowExecutionFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionFailedEventAttributes{
+		Reason:                       t.GetReason(),
+		Details:                      t.GetDetails(),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+	}
+}
+
+// ToWorkflowExecutionFailedEventAttributes converts proto WorkflowExecutionFailedEventAttributes type to thrift
+func ToWorkflowExecutionFailedEventAttributes(p *apiv1.WorkflowExecutionFailedEventAttributes) *shared.WorkflowExecutionFailedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionFailedEventAttributes{
+		Reason:                       stringPtr(p.Reason),
+		Details:                      p.Details,
+		DecisionTaskCompletedEventId: int64Ptr(p.DecisionTaskCompletedEventId),
+	}
+}
+
+// FromWorkflowExecutionTimedOutEventAttributes converts thrift WorkflowExecutionTimedOutEventAttributes type to proto
+func FromWorkflowExecutionTimedOutEventAttributes(t *shared.WorkflowExecutionTimedOutEventAttributes) *apiv1.WorkflowExecutionTimedOutEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionTimedOutEventAttributes{
+		TimeoutType: FromTimeoutType(t.TimeoutType),
+	}
+}
+
+// ToWorkflowExecutionTimedOutEventAttributes converts proto WorkflowExecutionTimedOutEventAttributes type to thrift
+func ToWorkflowExecutionTimedOutEventAttributes(p *apiv1.WorkflowExecutionTimedOutEventAttributes) *shared.WorkflowExecutionTimedOutEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionTimedOutEventAttributes{
+		TimeoutType: ToTimeoutType(p.TimeoutType),
+	}
+}
+
+// FromWorkflowExecutionContinuedAsNewEventAttributes converts thrift WorkflowExecutionContinuedAsNewEventAttributes type to proto
+func FromWorkflowExecutionContinuedAsNewEventAttributes(t *shared.WorkflowExecutionContinuedAsNewEventAttributes) *apiv1.WorkflowExecutionContinuedAsNewEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionContinuedAsNewEventAttributes{
+		NewExecutionRunId:                   t.GetNewExecutionRunId(),
+		WorkflowType:                        FromWorkflowType(t.WorkflowType),
+		TaskList:                            FromTaskList(t.TaskList),
+		Input:                               t.GetInput(),
+		ExecutionStartToCloseTimeoutSeconds: t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      t.GetTaskStartToCloseTimeoutSeconds(),
+		DecisionTaskCompletedEventId:        t.GetDecisionTaskCompletedEventId(),
+		BackoffStartIntervalInSeconds:       t.GetBackoffStartIntervalInSeconds(),
+	}
+}
+
+// ToWorkflowExecutionContinuedAsNewEventAttributes converts proto WorkflowExecutionContinuedAsNewEventAttributes type to thrift
+func ToWorkflowExecutionContinuedAsNewEventAttributes(p *apiv1.WorkflowExecutionContinuedAsNewEventAttributes) *shared.WorkflowExecutionContinuedAsNewEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionContinuedAsNewEventAttributes{
+		NewExecutionRunId:                   stringPtr(p.NewExecutionRunId),
+		WorkflowType:                        ToWorkflowType(p.WorkflowType),
+		TaskList:                            ToTaskList(p.TaskList),
+		Input:                               p.Input,
+		ExecutionStartToCloseTimeoutSeconds: int32Ptr(p.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      int32Ptr(p.TaskStartToCloseTimeoutSeconds),
+		DecisionTaskCompletedEventId:        int64Ptr(p.DecisionTaskCompletedEventId),
+		BackoffStartIntervalInSeconds:       int32Ptr(p.BackoffStartIntervalInSeconds),
+	}
+}
+
+// FromDecisionTaskScheduledEventAttributes converts thrift DecisionTaskScheduledEventAttributes type to proto
+func FromDecisionTaskScheduledEventAttributes(t *shared.DecisionTaskScheduledEventAttributes) *apiv1.DecisionTaskScheduledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DecisionTaskScheduledEventAttributes{
+		TaskList:                   FromTaskList(t.TaskList),
+		StartToCloseTimeoutSeconds: t.GetStartToCloseTimeoutSeconds(),
+		Attempt:                    t.GetAttempt(),
+	}
+}
+
+// ToDecisionTaskScheduledEventAttributes converts proto DecisionTaskScheduledEventAttributes type to thrift
+func ToDecisionTaskScheduledEventAttributes(p *apiv1.DecisionTaskScheduledEventAttributes) *shared.DecisionTaskScheduledEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.DecisionTaskScheduledEventAttributes{
+		TaskList:                   ToTaskList(p.TaskList),
+		StartToCloseTimeoutSeconds: int32Ptr(p.StartToCloseTimeoutSeconds),
+		Attempt:                    int64Ptr(p.Attempt),
+	}
+}
+
+// FromDecisionTaskStartedEventAttributes converts thrift DecisionTaskStartedEventAttributes type to proto
+func FromDecisionTaskStartedEventAttributes(t *shared.DecisionTaskStartedEventAttributes) *apiv1.DecisionTaskStartedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DecisionTaskStartedEventAttributes{
+		ScheduledEventId: t.GetScheduledEventId(),
+		Identity:         t.GetIdentity(),
+		RequestId:        t.GetRequestId(),
+	}
+}
+
+// ToDecisionTaskStartedEventAttributes converts proto DecisionTaskStartedEventAttributes type to thrift
+func ToDecisionTaskStartedEventAttributes(p *apiv1.DecisionTaskStartedEventAttributes) *shared.DecisionTaskStartedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.DecisionTaskStartedEventAttributes{
+		ScheduledEventId: int64Ptr(p.ScheduledEventId),
+		Identity:         stringPtr(p.Identity),
+		RequestId:        stringPtr(p.RequestId),
+	}
+}
+
+// FromDecisionTaskCompletedEventAttributes converts thrift DecisionTaskCompletedEventAttributes type to proto
+func FromDecisionTaskCompletedEventAttributes(t *shared.DecisionTaskCompletedEventAttributes) *apiv1.DecisionTaskCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DecisionTaskCompletedEventAttributes{
+		ExecutionContext: t.GetExecutionContext(),
+		ScheduledEventId: t.GetScheduledEventId(),
+		StartedEventId:   t.GetStartedEventId(),
+		Identity:         t.GetIdentity(),
+		BinaryChecksum:   t.GetBinaryChecksum(),
+	}
+}
+
+// ToDecisionTaskCompletedEventAttributes converts proto DecisionTaskCompletedEventAttributes type to thrift
+func ToDecisionTaskCompletedEventAttributes(p *apiv1.DecisionTaskCompletedEventAttributes) *shared.DecisionTaskCompletedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.DecisionTaskCompletedEventAttributes{
+		ExecutionContext: p.ExecutionContext,
+		ScheduledEventId: int64Ptr(p.ScheduledEventId),
+		StartedEventId:   int64Ptr(p.StartedEventId),
+		Identity:         stringPtr(p.Identity),
+		BinaryChecksum:   stringPtr(p.BinaryChecksum),
+	}
+}
+
+// FromDecisionTaskTimedOutEventAttributes converts thrift DecisionTaskTimedOutEventAttributes type to proto
+func FromDecisionTaskTimedOutEventAttributes(t *shared.DecisionTaskTimedOutEventAttributes) *apiv1.DecisionTaskTimedOutEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DecisionTaskTimedOutEventAttributes{
+		ScheduledEventId: t.GetScheduledEventId(),
+		StartedEventId:   t.GetStartedEventId(),
+		TimeoutType:      FromTimeoutType(t.TimeoutType),
+	}
+}
+
+// ToDecisionTaskTimedOutEventAttributes converts proto DecisionTaskTimedOutEventAttributes type to thrift
+func ToDecisionTaskTimedOutEventAttributes(p *apiv1.DecisionTaskTimedOutEventAttributes) *shared.DecisionTaskTimedOutEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.DecisionTaskTimedOutEventAttributes{
+		ScheduledEventId: int64Ptr(p.ScheduledEventId),
+		StartedEventId:   int64Ptr(p.StartedEventId),
+		TimeoutType:      ToTimeoutType(p.TimeoutType),
+	}
+}
+
+// FromDecisionTaskFailedEventAttributes converts thrift DecisionTaskFailedEventAttributes type to proto
+func FromDecisionTaskFailedEventAttributes(t *shared.DecisionTaskFailedEventAttributes) *apiv1.DecisionTaskFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DecisionTaskFailedEventAttributes{
+		ScheduledEventId: t.GetScheduledEventId(),
+		StartedEventId:   t.GetStartedEventId(),
+		Cause:            FromDecisionTaskFailedCause(t.Cause),
+		Details:          t.GetDetails(),
+		Identity:         t.GetIdentity(),
+	}
+}
+
+// ToDecisionTaskFailedEventAttributes converts proto DecisionTaskFailedEventAttributes type to thrift
+func ToDecisionTaskFailedEventAttributes(p *apiv1.DecisionTaskFailedEventAttributes) *shared.DecisionTaskFailedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.DecisionTaskFailedEventAttributes{
+		ScheduledEventId: int64Ptr(p.ScheduledEventId),
+		StartedEventId:   int64Ptr(p.StartedEventId),
+		Cause:            ToDecisionTaskFailedCause(p.Cause),
+		Details:          p.Details,
+		Identity:         stringPtr(p.Identity),
+	}
+}
+
+// FromActivityTaskScheduledEventAttributes converts thrift ActivityTaskScheduledEventAttributes type to proto
+func FromActivityTaskScheduledEventAttributes(t *shared.ActivityTaskScheduledEventAttributes) *apiv1.ActivityTaskScheduledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskScheduledEventAttributes{
+		ActivityId:                    t.GetActivityId(),
+		ActivityType:                  FromActivityType(t.ActivityType),
+		Domain:                        t.GetDomain(),
+		TaskList:                      FromTaskList(t.TaskList),
+		Input:                         t.GetInput(),
+		ScheduleToCloseTimeoutSeconds: t.GetScheduleToCloseTimeoutSeconds(),
+		ScheduleToStartTimeoutSeconds: t.GetScheduleToStartTimeoutSeconds(),
+		StartToCloseTimeoutSeconds:    t.GetStartToCloseTimeoutSeconds(),
+		HeartbeatTimeoutSeconds:       t.GetHeartbeatTimeoutSeconds(),
+		DecisionTaskCompletedEventId:  t.GetDecisionTaskCompletedEventId(),
+		RetryPolicy:                   FromRetryPolicy(t.RetryPolicy),
+	}
+}
+
+// ToActivityTaskScheduledEventAttributes converts proto ActivityTaskScheduledEventAttributes type to thrift
+func ToActivityTaskScheduledEventAttributes(p *apiv1.ActivityTaskScheduledEventAttributes) *shared.ActivityTaskScheduledEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ActivityTaskScheduledEventAttributes{
+		ActivityId:                    stringPtr(p.ActivityId),
+		ActivityType:                  ToActivityType(p.ActivityType),
+		Domain:                        stringPtr(p.Domain),
+		TaskList:                      ToTaskList(p.TaskList),
+		Input:                         p.Input,
+		ScheduleToCloseTimeoutSeconds: int32Ptr(p.ScheduleToCloseTimeoutSeconds),
+		ScheduleToStartTimeoutSeconds: int32Ptr(p.ScheduleToStartTimeoutSeconds),
+		StartToCloseTimeoutSeconds:    int32Ptr(p.StartToCloseTimeoutSeconds),
+		HeartbeatTimeoutSeconds:       int32Ptr(p.HeartbeatTimeoutSeconds),
+		DecisionTaskCompletedEventId:  int64Ptr(p.DecisionTaskCompletedEventId),
+		RetryPolicy:                   ToRetryPolicy(p.RetryPolicy),
+	}
+}
+
+// FromActivityTaskStartedEventAttributes converts thrift ActivityTaskStartedEventAttributes type to proto
+func FromActivityTaskStartedEventAttributes(t *shared.ActivityTaskStartedEventAttributes) *apiv1.ActivityTaskStartedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskStartedEventAttributes{
+		ScheduledEventId: t.GetScheduledEventId(),
+		Identity:         t.GetIdentity(),
+		RequestId:        t.GetRequestId(),
+		Attempt:          t.GetAttempt(),
+	}
+}
+
+// ToActivityTaskStartedEventAttributes converts proto ActivityTaskStartedEventAttributes type to thrift
+func ToActivityTaskStartedEventAttributes(p *apiv1.ActivityTaskStartedEventAttributes) *shared.ActivityTaskStartedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ActivityTaskStartedEventAttributes{
+		ScheduledEventId: int64Ptr(p.ScheduledEventId),
+		Identity:         stringPtr(p.Identity),
+		RequestId:        stringPtr(p.RequestId),
+		Attempt:          int32Ptr(p.Attempt),
+	}
+}
+
+// FromActivityTaskCompletedEventAttributes converts thrift ActivityTaskCompletedEventAttributes type to proto
+func FromActivityTaskCompletedEventAttributes(t *shared.ActivityTaskCompletedEventAttributes) *apiv1.ActivityTaskCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskCompletedEventAttributes{
+		Result:           t.GetResult(),
+		ScheduledEventId: t.GetScheduledEventId(),
+		StartedEventId:   t.GetStartedEventId(),
+		Identity:         t.GetIdentity(),
+		Reason:           t.GetReason(),
+	}
+}
+
+// ToActivityTaskCompletedEventAttributes converts proto ActivityTaskCompletedEventAttributes type to thrift
+func ToActivityTaskCompletedEventAttributes(p *apiv1.ActivityTaskCompletedEventAttributes) *shared.ActivityTaskCompletedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ActivityTaskCompletedEventAttributes{
+		Result:           p.Result,
+		ScheduledEventId: int64Ptr(p.ScheduledEventId),
+		StartedEventId:   int64Ptr(p.StartedEventId),
+		Identity:         stringPtr(p.Identity),
+		Reason:           stringPtr(p.Reason),
+	}
+}
+
+// FromActivityTaskFailedEventAttributes converts thrift ActivityTaskFailedEventAttributes type to proto
+func FromActivityTaskFailedEventAttributes(t *shared.ActivityTaskFailedEventAttributes) *apiv1.ActivityTaskFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskFailedEventAttributes{
+		Reason:           t.GetReason(),
+		Details:          t.GetDetails(),
+		ScheduledEventId: t.GetScheduledEventId(),
+		StartedEventId:   t.GetStartedEventId(),
+		Identity:         t.GetIdentity(),
+	}
+}
+
+// ToActivityTaskFailedEventAttributes converts proto ActivityTaskFailedEventAttributes type to thrift
+func ToActivityTaskFailedEventAttributes(p *apiv1.ActivityTaskFailedEventAttributes) *shared.ActivityTaskFailedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ActivityTaskFailedEventAttributes{
+		Reason:           stringPtr(p.Reason),
+		Details:          p.Details,
+		ScheduledEventId: int64Ptr(p.ScheduledEventId),
+		StartedEventId:   int64Ptr(p.StartedEventId),
+		Identity:         stringPtr(p.Identity),
+	}
+}
+
+// FromActivityTaskTimedOutEventAttributes converts thrift ActivityTaskTimedOutEventAttributes type to proto
+func FromActivityTaskTimedOutEventAttributes(t *shared.ActivityTaskTimedOutEventAttributes) *apiv1.ActivityTaskTimedOutEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskTimedOutEventAttributes{
+		Details:          t.GetDetails(),
+		ScheduledEventId: t.GetScheduledEventId(),
+		StartedEventId:   t.GetStartedEventId(),
+		TimeoutType:      FromTimeoutType(t.TimeoutType),
+	}
+}
+
+// ToActivityTaskTimedOutEventAttributes converts proto ActivityTaskTimedOutEventAttributes type to thrift
+func ToActivityTaskTimedOutEventAttributes(p *apiv1.ActivityTaskTimedOutEventAttributes) *shared.ActivityTaskTimedOutEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ActivityTaskTimedOutEventAttributes{
+		Details:          p.Details,
+		ScheduledEventId: int64Ptr(p.ScheduledEventId),
+		StartedEventId:   int64Ptr(p.StartedEventId),
+		TimeoutType:      ToTimeoutType(p.TimeoutType),
+	}
+}
+
+// FromActivityTaskCancelRequestedEventAttributes converts thrift ActivityTaskCancelRequestedEventAttributes type to proto
+func FromActivityTaskCancelRequestedEventAttributes(t *shared.ActivityTaskCancelRequestedEventAttributes) *apiv1.ActivityTaskCancelRequestedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskCancelRequestedEventAttributes{
+		ActivityId:                   t.GetActivityId(),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+	}
+}
+
+// ToActivityTaskCancelRequestedEventAttributes converts proto ActivityTaskCancelRequestedEventAttributes type to thrift
+func ToActivityTaskCancelRequestedEventAttributes(p *apiv1.ActivityTaskCancelRequestedEventAttributes) *shared.ActivityTaskCancelRequestedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ActivityTaskCancelRequestedEventAttributes{
+		ActivityId:                   stringPtr(p.ActivityId),
+		DecisionTaskCompletedEventId: int64Ptr(p.DecisionTaskCompletedEventId),
+	}
+}
+
+// FromRequestCancelActivityTaskFailedEventAttributes converts thrift RequestCancelActivityTaskFailedEventAttributes type to proto
+func FromRequestCancelActivityTaskFailedEventAttributes(t *shared.RequestCancelActivityTaskFailedEventAttributes) *apiv1.RequestCancelActivityTaskFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RequestCancelActivityTaskFailedEventAttributes{
+		ActivityId:                   t.GetActivityId(),
+		Cause:                        t.GetCause(),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+	}
+}
+
+// ToRequestCancelActivityTaskFailedEventAttributes converts proto RequestCancelActivityTaskFailedEventAttributes type to thrift
+func ToRequestCancelActivityTaskFailedEventAttributes(p *apiv1.RequestCancelActivityTaskFailedEventAttributes) *shared.RequestCancelActivityTaskFailedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.RequestCancelActivityTaskFailedEventAttributes{
+		ActivityId:                   stringPtr(p.ActivityId),
+		Cause:                        stringPtr(p.Cause),
+		DecisionTaskCompletedEventId: int64Ptr(p.DecisionTaskCompletedEventId),
+	}
+}
+
+// FromActivityTaskCanceledEventAttributes converts thrift ActivityTaskCanceledEventAttributes type to proto
+func FromActivityTaskCanceledEventAttributes(t *shared.ActivityTaskCanceledEventAttributes) *apiv1.ActivityTaskCanceledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskCanceledEventAttributes{
+		Details:                      t.GetDetails(),
+		LatestCancelRequestedEventId: t.GetLatestCancelRequestedEventId(),
+		ScheduledEventId:             t.GetScheduledEventId(),
+		StartedEventId:               t.GetStartedEventId(),
+		Identity:                     t.GetIdentity(),
+	}
+}
+
+// ToActivityTaskCanceledEventAttributes converts proto ActivityTaskCanceledEventAttributes type to thrift
+func ToActivityTaskCanceledEventAttributes(p *apiv1.ActivityTaskCanceledEventAttributes) *shared.ActivityTaskCanceledEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ActivityTaskCanceledEventAttributes{
+		Details:                      p.Details,
+		LatestCancelRequestedEventId: int64Ptr(p.LatestCancelRequestedEventId),
+		ScheduledEventId:             int64Ptr(p.ScheduledEventId),
+		StartedEventId:               int64Ptr(p.StartedEventId),
+		Identity:                     stringPtr(p.Identity),
+	}
+}
+
+// FromActivityTaskRetryRequestedEventAttributes converts thrift ActivityTaskRetryRequestedEventAttributes type to proto
+func FromActivityTaskRetryRequestedEventAttributes(t *shared.ActivityTaskRetryRequestedEventAttributes) *apiv1.ActivityTaskRetryRequestedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskRetryRequestedEventAttributes{
+		ScheduledEventId: t.GetScheduledEventId(),
+		ActivityId:       t.GetActivityId(),
+		Attempt:          t.GetAttempt(),
+		Reason:           t.GetReason(),
+		Identity:         t.GetIdentity(),
+	}
+}
+
+// ToActivityTaskRetryRequestedEventAttributes converts proto ActivityTaskRetryRequestedEventAttributes type to thrift
+func ToActivityTaskRetryRequestedEventAttributes(p *apiv1.ActivityTaskRetryRequestedEventAttributes) *shared.ActivityTaskRetryRequestedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ActivityTaskRetryRequestedEventAttributes{
+		ScheduledEventId: int64Ptr(p.ScheduledEventId),
+		ActivityId:       stringPtr(p.ActivityId),
+		Attempt:          int32Ptr(p.Attempt),
+		Reason:           stringPtr(p.Reason),
+		Identity:         stringPtr(p.Identity),
+	}
+}
+
+// FromTimerStartedEventAttributes converts thrift TimerStartedEventAttributes type to proto
+func FromTimerStartedEventAttributes(t *shared.TimerStartedEventAttributes) *apiv1.TimerStartedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TimerStartedEventAttributes{
+		TimerId:                      t.GetTimerId(),
+		StartToFireTimeoutSeconds:    t.GetStartToFireTimeoutSeconds(),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+	}
+}
+
+// ToTimerStartedEventAttributes converts proto TimerStartedEventAttributes type to thrift
+func ToTimerStartedEventAttributes(p *apiv1.TimerStartedEventAttributes) *shared.TimerStartedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.TimerStartedEventAttributes{
+		TimerId:                      stringPtr(p.TimerId),
+		StartToFireTimeoutSeconds:    int64Ptr(p.StartToFireTimeoutSeconds),
+		DecisionTaskCompletedEventId: int64Ptr(p.DecisionTaskCompletedEventId),
+	}
+}
+
+// FromTimerFiredEventAttributes converts thrift TimerFiredEventAttributes type to proto
+func FromTimerFiredEventAttributes(t *shared.TimerFiredEventAttributes) *apiv1.TimerFiredEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TimerFiredEventAttributes{
+		TimerId:        t.GetTimerId(),
+		StartedEventId: t.GetStartedEventId(),
+	}
+}
+
+// ToTimerFiredEventAttributes converts proto TimerFiredEventAttributes type to thrift
+func ToTimerFiredEventAttributes(p *apiv1.TimerFiredEventAttributes) *shared.TimerFiredEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.TimerFiredEventAttributes{
+		TimerId:        stringPtr(p.TimerId),
+		StartedEventId: int64Ptr(p.StartedEventId),
+	}
+}
+
+// FromTimerCanceledEventAttributes converts thrift TimerCanceledEventAttributes type to proto
+func FromTimerCanceledEventAttributes(t *shared.TimerCanceledEventAttributes) *apiv1.TimerCanceledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TimerCanceledEventAttributes{
+		TimerId:                      t.GetTimerId(),
+		StartedEventId:               t.GetStartedEventId(),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		Identity:                     t.GetIdentity(),
+	}
+}
+
+// ToTimerCanceledEventAttributes converts proto TimerCanceledEventAttributes type to thrift
+func ToTimerCanceledEventAttributes(p *apiv1.TimerCanceledEventAttributes) *shared.TimerCanceledEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.TimerCanceledEventAttributes{
+		TimerId:                      stringPtr(p.TimerId),
+		StartedEventId:               int64Ptr(p.StartedEventId),
+		DecisionTaskCompletedEventId: int64Ptr(p.DecisionTaskCompletedEventId),
+		Identity:                     stringPtr(p.Identity),
+	}
+}
+
+// FromCancelTimerFailedEventAttributes converts thrift CancelTimerFailedEventAttributes type to proto
+func FromCancelTimerFailedEventAttributes(t *shared.CancelTimerFailedEventAttributes) *apiv1.CancelTimerFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CancelTimerFailedEventAttributes{
+		TimerId:                      t.GetTimerId(),
+		Cause:                        t.GetCause(),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		Identity:                     t.GetIdentity(),
+	}
+}
+
+// ToCancelTimerFailedEventAttributes converts proto CancelTimerFailedEventAttributes type to thrift
+func ToCancelTimerFailedEventAttributes(p *apiv1.CancelTimerFailedEventAttributes) *shared.CancelTimerFailedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.CancelTimerFailedEventAttributes{
+		TimerId:                      stringPtr(p.TimerId),
+		Cause:                        stringPtr(p.Cause),
+		DecisionTaskCompletedEventId: int64Ptr(p.DecisionTaskCompletedEventId),
+		Identity:                     stringPtr(p.Identity),
+	}
+}
+
+// FromWorkflowExecutionCancelRequestedEventAttributes converts thrift WorkflowExecutionCancelRequestedEventAttributes type to proto
+func FromWorkflowExecutionCancelRequestedEventAttributes(t *shared.WorkflowExecutionCancelRequestedEventAttributes) *apiv1.WorkflowExecutionCancelRequestedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionCancelRequestedEventAttributes{
+		Cause:                     t.GetCause(),
+		ExternalInitiatedEventId:  t.GetExternalInitiatedEventId(),
+		ExternalWorkflowExecution: FromWorkflowExecution(t.ExternalWorkflowExecution),
+		Identity:                  t.GetIdentity(),
+	}
+}
+
+// ToWorkflowExecutionCancelRequestedEventAttributes converts proto WorkflowExecutionCancelRequestedEventAttributes type to thrift
+func ToWorkflowExecutionCancelRequestedEventAttributes(p *apiv1.WorkflowExecutionCancelRequestedEventAttributes) *shared.WorkflowExecutionCancelRequestedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionCancelRequestedEventAttributes{
+		Cause:                     stringPtr(p.Cause),
+		ExternalInitiatedEventId:  int64Ptr(p.ExternalInitiatedEventId),
+		ExternalWorkflowExecution: ToWorkflowExecution(p.ExternalWorkflowExecution),
+		Identity:                  stringPtr(p.Identity),
+	}
+}
+
+// FromWorkflowExecutionCanceledEventAttributes converts thrift WorkflowExecutionCanceledEventAttributes type to proto
+func FromWorkflowExecutionCanceledEventAttributes(t *shared.WorkflowExecutionCanceledEventAttributes) *apiv1.WorkflowExecutionCanceledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionCanceledEventAttributes{
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		Details:                      t.GetDetails(),
+	}
+}
+
+// ToWorkflowExecutionCanceledEventAttributes converts proto WorkflowExecutionCanceledEventAttributes type to thrift
+func ToWorkflowExecutionCanceledEventAttributes(p *apiv1.WorkflowExecutionCanceledEventAttributes) *shared.WorkflowExecutionCanceledEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionCanceledEventAttributes{
+		DecisionTaskCompletedEventId: int64Ptr(p.DecisionTaskCompletedEventId),
+		Details:                      p.Details,
+	}
+}
+
+// FromMarkerRecordedEventAttributes converts thrift MarkerRecordedEventAttributes type to proto
+func FromMarkerRecordedEventAttributes(t *shared.MarkerRecordedEventAttributes) *apiv1.MarkerRecordedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.MarkerRecordedEventAttributes{
+		MarkerName:                   t.GetMarkerName(),
+		Details:                      t.GetDetails(),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		Header:                       FromHeader(t.Header),
+	}
+}
+
+// ToMarkerRecordedEventAttributes converts proto MarkerRecordedEventAttributes type to thrift
+func ToMarkerRecordedEventAttributes(p *apiv1.MarkerRecordedEventAttributes) *shared.MarkerRecordedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.MarkerRecordedEventAttributes{
+		MarkerName:                   stringPtr(p.MarkerName),
+		Details:                      p.Details,
+		DecisionTaskCompletedEventId: int64Ptr(p.DecisionTaskCompletedEventId),
+		Header:                       ToHeader(p.Header),
+	}
+}
+
+// FromWorkflowExecutionSignaledEventAttributes converts thrift WorkflowExecutionSignaledEventAttributes type to proto
+func FromWorkflowExecutionSignaledEventAttributes(t *shared.WorkflowExecutionSignaledEventAttributes) *apiv1.WorkflowExecutionSignaledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionSignaledEventAttributes{
+		SignalName: t.GetSignalName(),
+		Input:      t.GetInput(),
+		Identity:   t.GetIdentity(),
+	}
+}
+
+// ToWorkflowExecutionSignaledEventAttributes converts proto WorkflowExecutionSignaledEventAttributes type to thrift
+func ToWorkflowExecutionSignaledEventAttributes(p *apiv1.WorkflowExecutionSignaledEventAttributes) *shared.WorkflowExecutionSignaledEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionSignaledEventAttributes{
+		SignalName: stringPtr(p.SignalName),
+		Input:      p.Input,
+		Identity:   stringPtr(p.Identity),
+	}
+}
+
+// FromWorkflowExecutionTerminatedEventAttributes converts thrift WorkflowExecutionTerminatedEventAttributes type to proto
+func FromWorkflowExecutionTerminatedEventAttributes(t *shared.WorkflowExecutionTerminatedEventAttributes) *apiv1.WorkflowExecutionTerminatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionTerminatedEventAttributes{
+		Reason:   t.GetReason(),
+		Details:  t.GetDetails(),
+		Identity: t.GetIdentity(),
+	}
+}
+
+// ToWorkflowExecutionTerminatedEventAttributes converts proto WorkflowExecutionTerminatedEventAttributes type to thrift
+func ToWorkflowExecutionTerminatedEventAttributes(p *apiv1.WorkflowExecutionTerminatedEventAttributes) *shared.WorkflowExecutionTerminatedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionTerminatedEventAttributes{
+		Reason:   stringPtr(p.Reason),
+		Details:  p.Details,
+		Identity: stringPtr(p.Identity),
+	}
+}
+
+// FromWorkflowExecutionPausedEventAttributes converts thrift WorkflowExecutionPausedEventAttributes type to proto
+func FromWorkflowExecutionPausedEventAttributes(t *shared.WorkflowExecutionPausedEventAttributes) *apiv1.WorkflowExecutionPausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionPausedEventAttributes{
+		Reason:   t.GetReason(),
+		Identity: t.GetIdentity(),
+	}
+}
+
+// ToWorkflowExecutionPausedEventAttributes converts proto WorkflowExecutionPausedEventAttributes type to thrift
+func ToWorkflowExecutionPausedEventAttributes(p *apiv1.WorkflowExecutionPausedEventAttributes) *shared.WorkflowExecutionPausedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionPausedEventAttributes{
+		Reason:   stringPtr(p.Reason),
+		Identity: stringPtr(p.Identity),
+	}
+}
+
+// FromWorkflowExecutionResumedEventAttributes converts thrift WorkflowExecutionResumedEventAttributes type to proto
+func FromWorkflowExecutionResumedEventAttributes(t *shared.WorkflowExecutionResumedEventAttributes) *apiv1.WorkflowExecutionResumedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionResumedEventAttributes{
+		Reason:   t.GetReason(),
+		Identity: t.GetIdentity(),
+	}
+}
+
+// ToWorkflowExecutionResumedEventAttributes converts proto WorkflowExecutionResumedEventAttributes type to thrift
+func ToWorkflowExecutionResumedEventAttributes(p *apiv1.WorkflowExecutionResumedEventAttributes) *shared.WorkflowExecutionResumedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionResumedEventAttributes{
+		Reason:   stringPtr(p.Reason),
+		Identity: stringPtr(p.Identity),
+	}
+}
+
+// FromRequestCancelExternalWorkflowExecutionInitiatedEventAttributes converts thrift RequestCancelExternalWorkflowExecutionInitiatedEventAttributes type to proto
+func FromRequestCancelExternalWorkflowExecutionInitiatedEventAttributes(t *shared.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) *apiv1.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes{
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		Domain:                       t.GetDomain(),
+		WorkflowExecution:            FromWorkflowExecution(t.WorkflowExecution),
+		Control:                      t.GetControl(),
+		ChildWorkflowOnly:            t.GetChildWorkflowOnly(),
+	}
+}
+
+// ToRequestCancelExternalWorkflowExecutionInitiatedEventAttributes converts proto RequestCancelExternalWorkflowExecutionInitiatedEventAttributes type to thrift
+func ToRequestCancelExternalWorkflowExecutionInitiatedEventAttributes(p *apiv1.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) *shared.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes{
+		DecisionTaskCompletedEventId: int64Ptr(p.DecisionTaskCompletedEventId),
+		Domain:                       stringPtr(p.Domain),
+		WorkflowExecution:            ToWorkflowExecution(p.WorkflowExecution),
+		Control:                      p.Control,
+		ChildWorkflowOnly:            common.BoolPtr(p.ChildWorkflowOnly),
+	}
+}
+
+// FromRequestCancelExternalWorkflowExecutionFailedEventAttributes converts thrift RequestCancelExternalWorkflowExecutionFailedEventAttributes type to proto
+func FromRequestCancelExternalWorkflowExecutionFailedEventAttributes(t *shared.RequestCancelExternalWorkflowExecutionFailedEventAttributes) *apiv1.RequestCancelExternalWorkflowExecutionFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RequestCancelExternalWorkflowExecutionFailedEventAttributes{
+		Cause:                        FromCancelExternalWorkflowExecutionFailedCause(t.Cause),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		Domain:                       t.GetDomain(),
+		WorkflowExecution:            FromWorkflowExecution(t.WorkflowExecution),
+		InitiatedEventId:             t.GetInitiatedEventId(),
+		Control:                      t.GetControl(),
+	}
+}
+
+// ToRequestCancelExternalWorkflowExecutionFailedEventAttributes converts proto RequestCancelExternalWorkflowExecutionFailedEventAttributes type to thrift
+func ToRequestCancelExternalWorkflowExecutionFailedEventAttributes(p *apiv1.RequestCancelExternalWorkflowExecutionFailedEventAttributes) *shared.RequestCancelExternalWorkflowExecutionFailedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.RequestCancelExternalWorkflowExecutionFailedEventAttributes{
+		Cause:                        ToCancelExternalWorkflowExecutionFailedCause(p.Cause),
+		DecisionTaskCompletedEventId: int64Ptr(p.DecisionTaskCompletedEventId),
+		Domain:                       stringPtr(p.Domain),
+		WorkflowExecution:            ToWorkflowExecution(p.WorkflowExecution),
+		InitiatedEventId:             int64Ptr(p.InitiatedEventId),
+		Control:                      p.Control,
+	}
+}
+
+// FromExternalWorkflowExecutionCancelRequestedEventAttributes converts thrift ExternalWorkflowExecutionCancelRequestedEventAttributes type to proto
+func FromExternalWorkflowExecutionCancelRequestedEventAttributes(t *shared.ExternalWorkflowExecutionCancelRequestedEventAttributes) *apiv1.ExternalWorkflowExecutionCancelRequestedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ExternalWorkflowExecutionCancelRequestedEventAttributes{
+		InitiatedEventId:  t.GetInitiatedEventId(),
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+	}
+}
+
+// ToExternalWorkflowExecutionCancelRequestedEventAttributes converts proto ExternalWorkflowExecutionCancelRequestedEventAttributes type to thrift
+func ToExternalWorkflowExecutionCancelRequestedEventAttributes(p *apiv1.ExternalWorkflowExecutionCancelRequestedEventAttributes) *shared.ExternalWorkflowExecutionCancelRequestedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ExternalWorkflowExecutionCancelRequestedEventAttributes{
+		InitiatedEventId:  int64Ptr(p.InitiatedEventId),
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: ToWorkflowExecution(p.WorkflowExecution),
+	}
+}
+
+// FromSignalExternalWorkflowExecutionInitiatedEventAttributes converts thrift SignalExternalWorkflowExecutionInitiatedEventAttributes type to proto
+func FromSignalExternalWorkflowExecutionInitiatedEventAttributes(t *shared.SignalExternalWorkflowExecutionInitiatedEventAttributes) *apiv1.SignalExternalWorkflowExecutionInitiatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.SignalExternalWorkflowExecutionInitiatedEventAttributes{
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		Domain:                       t.GetDomain(),
+		WorkflowExecution:            FromWorkflowExecution(t.WorkflowExecution),
+		SignalName:                   t.GetSignalName(),
+		Input:                        t.GetInput(),
+		Control:                      t.GetControl(),
+		ChildWorkflowOnly:            t.GetChildWorkflowOnly(),
+	}
+}
+
+// ToSignalExternalWorkflowExecutionInitiatedEventAttributes converts proto SignalExternalWorkflowExecutionInitiatedEventAttributes type to thrift
+func ToSignalExternalWorkflowExecutionInitiatedEventAttributes(p *apiv1.SignalExternalWorkflowExecutionInitiatedEventAttributes) *shared.SignalExternalWorkflowExecutionInitiatedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.SignalExternalWorkflowExecutionInitiatedEventAttributes{
+		DecisionTaskCompletedEventId: int64Ptr(p.DecisionTaskCompletedEventId),
+		Domain:                       stringPtr(p.Domain),
+		WorkflowExecution:            ToWorkflowExecution(p.WorkflowExecution),
+		SignalName:                   stringPtr(p.SignalName),
+		Input:                        p.Input,
+		Control:                      p.Control,
+		ChildWorkflowOnly:            common.BoolPtr(p.ChildWorkflowOnly),
+	}
+}
+
+// FromSignalExternalWorkflowExecutionFailedEventAttributes converts thrift SignalExternalWorkflowExecutionFailedEventAttributes type to proto
+func FromSignalExternalWorkflowExecutionFailedEventAttributes(t *shared.SignalExternalWorkflowExecutionFailedEventAttributes) *apiv1.SignalExternalWorkflowExecutionFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.SignalExternalWorkflowExecutionFailedEventAttributes{
+		Cause:                        FromSignalExternalWorkflowExecutionFailedCause(t.Cause),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		Domain:                       t.GetDomain(),
+		WorkflowExecution:            FromWorkflowExecution(t.WorkflowExecution),
+		InitiatedEventId:             t.GetInitiatedEventId(),
+		Control:                      t.GetControl(),
+	}
+}
+
+// ToSignalExternalWorkflowExecutionFailedEventAttributes converts proto SignalExternalWorkflowExecutionFailedEventAttributes type to thrift
+func ToSignalExternalWorkflowExecutionFailedEventAttributes(p *apiv1.SignalExternalWorkflowExecutionFailedEventAttributes) *shared.SignalExternalWorkflowExecutionFailedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.SignalExternalWorkflowExecutionFailedEventAttributes{
+		Cause:                        ToSignalExternalWorkflowExecutionFailedCause(p.Cause),
+		DecisionTaskCompletedEventId: int64Ptr(p.DecisionTaskCompletedEventId),
+		Domain:                       stringPtr(p.Domain),
+		WorkflowExecution:            ToWorkflowExecution(p.WorkflowExecution),
+		InitiatedEventId:             int64Ptr(p.InitiatedEventId),
+		Control:                      p.Control,
+	}
+}
+
+// FromExternalWorkflowExecutionSignaledEventAttributes converts thrift ExternalWorkflowExecutionSignaledEventAttributes type to proto
+func FromExternalWorkflowExecutionSignaledEventAttributes(t *shared.ExternalWorkflowExecutionSignaledEventAttributes) *apiv1.ExternalWorkflowExecutionSignaledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ExternalWorkflowExecutionSignaledEventAttributes{
+		InitiatedEventId:  t.GetInitiatedEventId(),
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Control:           t.GetControl(),
+	}
+}
+
+// ToExternalWorkflowExecutionSignaledEventAttributes converts proto ExternalWorkflowExecutionSignaledEventAttributes type to thrift
+func ToExternalWorkflowExecutionSignaledEventAttributes(p *apiv1.ExternalWorkflowExecutionSignaledEventAttributes) *shared.ExternalWorkflowExecutionSignaledEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ExternalWorkflowExecutionSignaledEventAttributes{
+		InitiatedEventId:  int64Ptr(p.InitiatedEventId),
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: ToWorkflowExecution(p.WorkflowExecution),
+		Control:           p.Control,
+	}
+}
+
+// FromStartChildWorkflowExecutionInitiatedEventAttributes converts thrift StartChildWorkflowExecutionInitiatedEventAttributes type to proto
+func FromStartChildWorkflowExecutionInitiatedEventAttributes(t *shared.StartChildWorkflowExecutionInitiatedEventAttributes) *apiv1.StartChildWorkflowExecutionInitiatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartChildWorkflowExecutionInitiatedEventAttributes{
+		Domain:                              t.GetDomain(),
+		WorkflowId:                          t.GetWorkflowId(),
+		WorkflowType:                        FromWorkflowType(t.WorkflowType),
+		TaskList:                            FromTaskList(t.TaskList),
+		Input:                               t.GetInput(),
+		ExecutionStartToCloseTimeoutSeconds: t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      t.GetTaskStartToCloseTimeoutSeconds(),
+		ChildPolicy:                         FromChildPolicy(t.ChildPolicy),
+		Control:                             t.GetControl(),
+		DecisionTaskCompletedEventId:        t.GetDecisionTaskCompletedEventId(),
+		WorkflowIdReusePolicy:               FromWorkflowIDReusePolicy(t.WorkflowIdReusePolicy),
+		RetryPolicy:                         FromRetryPolicy(t.RetryPolicy),
+	}
+}
+
+// ToStartChildWorkflowExecutionInitiatedEventAttributes converts proto StartChildWorkflowExecutionInitiatedEventAttributes type to thrift
+func ToStartChildWorkflowExecutionInitiatedEventAttributes(p *apiv1.StartChildWorkflowExecutionInitiatedEventAttributes) *shared.StartChildWorkflowExecutionInitiatedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.StartChildWorkflowExecutionInitiatedEventAttributes{
+		Domain:                              stringPtr(p.Domain),
+		WorkflowId:                          stringPtr(p.WorkflowId),
+		WorkflowType:                        ToWorkflowType(p.WorkflowType),
+		TaskList:                            ToTaskList(p.TaskList),
+		Input:                               p.Input,
+		ExecutionStartToCloseTimeoutSeconds: int32Ptr(p.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      int32Ptr(p.TaskStartToCloseTimeoutSeconds),
+		ChildPolicy:                         ToChildPolicy(p.ChildPolicy),
+		Control:                             p.Control,
+		DecisionTaskCompletedEventId:        int64Ptr(p.DecisionTaskCompletedEventId),
+		WorkflowIdReusePolicy:               ToWorkflowIDReusePolicy(p.WorkflowIdReusePolicy),
+		RetryPolicy:                         ToRetryPolicy(p.RetryPolicy),
+	}
+}
+
+// FromStartChildWorkflowExecutionFailedEventAttributes converts thrift StartChildWorkflowExecutionFailedEventAttributes type to proto
+func FromStartChildWorkflowExecutionFailedEventAttributes(t *shared.StartChildWorkflowExecutionFailedEventAttributes) *apiv1.StartChildWorkflowExecutionFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartChildWorkflowExecutionFailedEventAttributes{
+		Domain:                       t.GetDomain(),
+		WorkflowId:                   t.GetWorkflowId(),
+		WorkflowType:                 FromWorkflowType(t.WorkflowType),
+		Cause:                        FromChildWorkflowExecutionFailedCause(t.Cause),
+		Control:                      t.GetControl(),
+		InitiatedEventId:             t.GetInitiatedEventId(),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+	}
+}
+
+// ToStartChildWorkflowExecutionFailedEventAttributes converts proto StartChildWorkflowExecutionFailedEventAttributes type to thrift
+func ToStartChildWorkflowExecutionFailedEventAttributes(p *apiv1.StartChildWorkflowExecutionFailedEventAttributes) *shared.StartChildWorkflowExecutionFailedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.StartChildWorkflowExecutionFailedEventAttributes{
+		Domain:                       stringPtr(p.Domain),
+		WorkflowId:                   stringPtr(p.WorkflowId),
+		WorkflowType:                 ToWorkflowType(p.WorkflowType),
+		Cause:                        ToChildWorkflowExecutionFailedCause(p.Cause),
+		Control:                      p.Control,
+		InitiatedEventId:             int64Ptr(p.InitiatedEventId),
+		DecisionTaskCompletedEventId: int64Ptr(p.DecisionTaskCompletedEventId),
+	}
+}
+
+// FromChildWorkflowExecutionStartedEventAttributes converts thrift ChildWorkflowExecutionStartedEventAttributes type to proto
+func FromChildWorkflowExecutionStartedEventAttributes(t *shared.ChildWorkflowExecutionStartedEventAttributes) *apiv1.ChildWorkflowExecutionStartedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ChildWorkflowExecutionStartedEventAttributes{
+		Domain:            t.GetDomain(),
+		InitiatedEventId:  t.GetInitiatedEventId(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:      FromWorkflowType(t.WorkflowType),
+	}
+}
+
+// ToChildWorkflowExecutionStartedEventAttributes converts proto ChildWorkflowExecutionStartedEventAttributes type to thrift
+func ToChildWorkflowExecutionStartedEventAttributes(p *apiv1.ChildWorkflowExecutionStartedEventAttributes) *shared.ChildWorkflowExecutionStartedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ChildWorkflowExecutionStartedEventAttributes{
+		Domain:            stringPtr(p.Domain),
+		InitiatedEventId:  int64Ptr(p.InitiatedEventId),
+		WorkflowExecution: ToWorkflowExecution(p.WorkflowExecution),
+		WorkflowType:      ToWorkflowType(p.WorkflowType),
+	}
+}
+
+// FromChildWorkflowExecutionCompletedEventAttributes converts thrift ChildWorkflowExecutionCompletedEventAttributes type to proto
+func FromChildWorkflowExecutionCompletedEventAttributes(t *shared.ChildWorkflowExecutionCompletedEventAttributes) *apiv1.ChildWorkflowExecutionCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ChildWorkflowExecutionCompletedEventAttributes{
+		Result:            t.GetResult(),
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:      FromWorkflowType(t.WorkflowType),
+		InitiatedEventId:  t.GetInitiatedEventId(),
+		StartedEventId:    t.GetStartedEventId(),
+	}
+}
+
+// ToChildWorkflowExecutionCompletedEventAttributes converts proto ChildWorkflowExecutionCompletedEventAttributes type to thrift
+func ToChildWorkflowExecutionCompletedEventAttributes(p *apiv1.ChildWorkflowExecutionCompletedEventAttributes) *shared.ChildWorkflowExecutionCompletedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ChildWorkflowExecutionCompletedEventAttributes{
+		Result:            p.Result,
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: ToWorkflowExecution(p.WorkflowExecution),
+		WorkflowType:      ToWorkflowType(p.WorkflowType),
+		InitiatedEventId:  int64Ptr(p.InitiatedEventId),
+		StartedEventId:    int64Ptr(p.StartedEventId),
+	}
+}
+
+// FromChildWorkflowExecutionFailedEventAttributes converts thrift ChildWorkflowExecutionFailedEventAttributes type to proto
+func FromChildWorkflowExecutionFailedEventAttributes(t *shared.ChildWorkflowExecutionFailedEventAttributes) *apiv1.ChildWorkflowExecutionFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ChildWorkflowExecutionFailedEventAttributes{
+		Reason:            t.GetReason(),
+		Details:           t.GetDetails(),
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:      FromWorkflowType(t.WorkflowType),
+		InitiatedEventId:  t.GetInitiatedEventId(),
+		StartedEventId:    t.GetStartedEventId(),
+	}
+}
+
+// ToChildWorkflowExecutionFailedEventAttributes converts proto ChildWorkflowExecutionFailedEventAttributes type to thrift
+func ToChildWorkflowExecutionFailedEventAttributes(p *apiv1.ChildWorkflowExecutionFailedEventAttributes) *shared.ChildWorkflowExecutionFailedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ChildWorkflowExecutionFailedEventAttributes{
+		Reason:            stringPtr(p.Reason),
+		Details:           p.Details,
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: ToWorkflowExecution(p.WorkflowExecution),
+		WorkflowType:      ToWorkflowType(p.WorkflowType),
+		InitiatedEventId:  int64Ptr(p.InitiatedEventId),
+		StartedEventId:    int64Ptr(p.StartedEventId),
+	}
+}
+
+// FromChildWorkflowExecutionCanceledEventAttributes converts thrift ChildWorkflowExecutionCanceledEventAttributes type to proto
+func FromChildWorkflowExecutionCanceledEventAttributes(t *shared.ChildWorkflowExecutionCanceledEventAttributes) *apiv1.ChildWorkflowExecutionCanceledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ChildWorkflowExecutionCanceledEventAttributes{
+		Details:           t.GetDetails(),
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:      FromWorkflowType(t.WorkflowType),
+		InitiatedEventId:  t.GetInitiatedEventId(),
+		StartedEventId:    t.GetStartedEventId(),
+	}
+}
+
+// ToChildWorkflowExecutionCanceledEventAttributes converts proto ChildWorkflowExecutionCanceledEventAttributes type to thrift
+func ToChildWorkflowExecutionCanceledEventAttributes(p *apiv1.ChildWorkflowExecutionCanceledEventAttributes) *shared.ChildWorkflowExecutionCanceledEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ChildWorkflowExecutionCanceledEventAttributes{
+		Details:           p.Details,
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: ToWorkflowExecution(p.WorkflowExecution),
+		WorkflowType:      ToWorkflowType(p.WorkflowType),
+		InitiatedEventId:  int64Ptr(p.InitiatedEventId),
+		StartedEventId:    int64Ptr(p.StartedEventId),
+	}
+}
+
+// FromChildWorkflowExecutionTimedOutEventAttributes converts thrift ChildWorkflowExecutionTimedOutEventAttributes type to proto
+func FromChildWorkflowExecutionTimedOutEventAttributes(t *shared.ChildWorkflowExecutionTimedOutEventAttributes) *apiv1.ChildWorkflowExecutionTimedOutEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ChildWorkflowExecutionTimedOutEventAttributes{
+		TimeoutType:       FromTimeoutType(t.TimeoutType),
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:      FromWorkflowType(t.WorkflowType),
+		InitiatedEventId:  t.GetInitiatedEventId(),
+		StartedEventId:    t.GetStartedEventId(),
+	}
+}
+
+// ToChildWorkflowExecutionTimedOutEventAttributes converts proto ChildWorkflowExecutionTimedOutEventAttributes type to thrift
+func ToChildWorkflowExecutionTimedOutEventAttributes(p *apiv1.ChildWorkflowExecutionTimedOutEventAttributes) *shared.ChildWorkflowExecutionTimedOutEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ChildWorkflowExecutionTimedOutEventAttributes{
+		TimeoutType:       ToTimeoutType(p.TimeoutType),
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: ToWorkflowExecution(p.WorkflowExecution),
+		WorkflowType:      ToWorkflowType(p.WorkflowType),
+		InitiatedEventId:  int64Ptr(p.InitiatedEventId),
+		StartedEventId:    int64Ptr(p.StartedEventId),
+	}
+}
+
+// FromChildWorkflowExecutionTerminatedEventAttributes converts thrift ChildWorkflowExecutionTerminatedEventAttributes type to proto
+func FromChildWorkflowExecutionTerminatedEventAttributes(t *shared.ChildWorkflowExecutionTerminatedEventAttributes) *apiv1.ChildWorkflowExecutionTerminatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ChildWorkflowExecutionTerminatedEventAttributes{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:      FromWorkflowType(t.WorkflowType),
+		InitiatedEventId:  t.GetInitiatedEventId(),
+		StartedEventId:    t.GetStartedEventId(),
+	}
+}
+
+// ToChildWorkflowExecutionTerminatedEventAttributes converts proto ChildWorkflowExecutionTerminatedEventAttributes type to thrift
+func ToChildWorkflowExecutionTerminatedEventAttributes(p *apiv1.ChildWorkflowExecutionTerminatedEventAttributes) *shared.ChildWorkflowExecutionTerminatedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ChildWorkflowExecutionTerminatedEventAttributes{
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: ToWorkflowExecution(p.WorkflowExecution),
+		WorkflowType:      ToWorkflowType(p.WorkflowType),
+		InitiatedEventId:  int64Ptr(p.InitiatedEventId),
+		StartedEventId:    int64Ptr(p.StartedEventId),
+	}
+}
+
+// FromHistoryEvent converts thrift HistoryEvent type to proto
+func FromHistoryEvent(t *shared.HistoryEvent) *apiv1.HistoryEvent {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.HistoryEvent{
+		EventId:                                 t.GetEventId(),
+		Timestamp:                               t.GetTimestamp(),
+		EventType:                               FromEventType(t.EventType),
+		Version:                                 t.GetVersion(),
+		WorkflowExecutionStartedEventAttributes: FromWorkflowExecutionStartedEventAttributes(t.WorkflowExecutionStartedEventAttributes),
+		WorkflowExecutionCompletedEventAttributes:                      FromWorkflowExecutionCompletedEventAttributes(t.WorkflowExecutionCompletedEventAttributes),
+		WorkflowExecutionFailedEventAttributes:                         FromWorkflowExecutionFailedEventAttributes(t.WorkflowExecutionFailedEventAttributes),
+		WorkflowExecutionTimedOutEventAttributes:                       FromWorkflowExecutionTimedOutEventAttributes(t.WorkflowExecutionTimedOutEventAttributes),
+		DecisionTaskScheduledEventAttributes:                           FromDecisionTaskScheduledEventAttributes(t.DecisionTaskScheduledEventAttributes),
+		DecisionTaskStartedEventAttributes:                             FromDecisionTaskStartedEventAttributes(t.DecisionTaskStartedEventAttributes),
+		DecisionTaskCompletedEventAttributes:                           FromDecisionTaskCompletedEventAttributes(t.DecisionTaskCompletedEventAttributes),
+		DecisionTaskTimedOutEventAttributes:                            FromDecisionTaskTimedOutEventAttributes(t.DecisionTaskTimedOutEventAttributes),
+		DecisionTaskFailedEventAttributes:                              FromDecisionTaskFailedEventAttributes(t.DecisionTaskFailedEventAttributes),
+		ActivityTaskScheduledEventAttributes:                           FromActivityTaskScheduledEventAttributes(t.ActivityTaskScheduledEventAttributes),
+		ActivityTaskStartedEventAttributes:                             FromActivityTaskStartedEventAttributes(t.ActivityTaskStartedEventAttributes),
+		ActivityTaskCompletedEventAttributes:                           FromActivityTaskCompletedEventAttributes(t.ActivityTaskCompletedEventAttributes),
+		ActivityTaskFailedEventAttributes:                              FromActivityTaskFailedEventAttributes(t.ActivityTaskFailedEventAttributes),
+		ActivityTaskTimedOutEventAttributes:                            FromActivityTaskTimedOutEventAttributes(t.ActivityTaskTimedOutEventAttributes),
+		TimerStartedEventAttributes:                                    FromTimerStartedEventAttributes(t.TimerStartedEventAttributes),
+		TimerFiredEventAttributes:                                      FromTimerFiredEventAttributes(t.TimerFiredEventAttributes),
+		ActivityTaskCancelRequestedEventAttributes:                     FromActivityTaskCancelRequestedEventAttributes(t.ActivityTaskCancelRequestedEventAttributes),
+		RequestCancelActivityTaskFailedEventAttributes:                 FromRequestCancelActivityTaskFailedEventAttributes(t.RequestCancelActivityTaskFailedEventAttributes),
+		ActivityTaskCanceledEventAttributes:                            FromActivityTaskCanceledEventAttributes(t.ActivityTaskCanceledEventAttributes),
+		TimerCanceledEventAttributes:                                   FromTimerCanceledEventAttributes(t.TimerCanceledEventAttributes),
+		CancelTimerFailedEventAttributes:                               FromCancelTimerFailedEventAttributes(t.CancelTimerFailedEventAttributes),
+		MarkerRecordedEventAttributes:                                  FromMarkerRecordedEventAttributes(t.MarkerRecordedEventAttributes),
+		WorkflowExecutionSignaledEventAttributes:                       FromWorkflowExecutionSignaledEventAttributes(t.WorkflowExecutionSignaledEventAttributes),
+		WorkflowExecutionTerminatedEventAttributes:                     FromWorkflowExecutionTerminatedEventAttributes(t.WorkflowExecutionTerminatedEventAttributes),
+		WorkflowExecutionCancelRequestedEventAttributes:                FromWorkflowExecutionCancelRequestedEventAttributes(t.WorkflowExecutionCancelRequestedEventAttributes),
+		WorkflowExecutionCanceledEventAttributes:                       FromWorkflowExecutionCanceledEventAttributes(t.WorkflowExecutionCanceledEventAttributes),
+		RequestCancelExternalWorkflowExecutionInitiatedEventAttributes: FromRequestCancelExternalWorkflowExecutionInitiatedEventAttributes(t.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes),
+		RequestCancelExternalWorkflowExecutionFailedEventAttributes:    FromRequestCancelExternalWorkflowExecutionFailedEventAttributes(t.RequestCancelExternalWorkflowExecutionFailedEventAttributes),
+		ExternalWorkflowExecutionCancelRequestedEventAttributes:        FromExternalWorkflowExecutionCancelRequestedEventAttributes(t.ExternalWorkflowExecutionCancelRequestedEventAttributes),
+		WorkflowExecutionContinuedAsNewEventAttributes:                 FromWorkflowExecutionContinuedAsNewEventAttributes(t.WorkflowExecutionContinuedAsNewEventAttributes),
+		StartChildWorkflowExecutionInitiatedEventAttributes:            FromStartChildWorkflowExecutionInitiatedEventAttributes(t.StartChildWorkflowExecutionInitiatedEventAttributes),
+		StartChildWorkflowExecutionFailedEventAttributes:               FromStartChildWorkflowExecutionFailedEventAttributes(t.StartChildWorkflowExecutionFailedEventAttributes),
+		ChildWorkflowExecutionStartedEventAttributes:                   FromChildWorkflowExecutionStartedEventAttributes(t.ChildWorkflowExecutionStartedEventAttributes),
+		ChildWorkflowExecutionCompletedEventAttributes:                 FromChildWorkflowExecutionCompletedEventAttributes(t.ChildWorkflowExecutionCompletedEventAttributes),
+		ChildWorkflowExecutionFailedEventAttributes:                    FromChildWorkflowExecutionFailedEventAttributes(t.ChildWorkflowExecutionFailedEventAttributes),
+		ChildWorkflowExecutionCanceledEventAttributes:                  FromChildWorkflowExecutionCanceledEventAttributes(t.ChildWorkflowExecutionCanceledEventAttributes),
+		ChildWorkflowExecutionTimedOutEventAttributes:                  FromChildWorkflowExecutionTimedOutEventAttributes(t.ChildWorkflowExecutionTimedOutEventAttributes),
+		ChildWorkflowExecutionTerminatedEventAttributes:                FromChildWorkflowExecutionTerminatedEventAttributes(t.ChildWorkflowExecutionTerminatedEventAttributes),
+		SignalExternalWorkflowExecutionInitiatedEventAttributes:        FromSignalExternalWorkflowExecutionInitiatedEventAttributes(t.SignalExternalWorkflowExecutionInitiatedEventAttributes),
+		SignalExternalWorkflowExecutionFailedEventAttributes:           FromSignalExternalWorkflowExecutionFailedEventAttributes(t.SignalExternalWorkflowExecutionFailedEventAttributes),
+		ExternalWorkflowExecutionSignaledEventAttributes:               FromExternalWorkflowExecutionSignaledEventAttributes(t.ExternalWorkflowExecutionSignaledEventAttributes),
+		WorkflowExecutionPausedEventAttributes:                         FromWorkflowExecutionPausedEventAttributes(t.WorkflowExecutionPausedEventAttributes),
+		WorkflowExecutionResumedEventAttributes:                        FromWorkflowExecutionResumedEventAttributes(t.WorkflowExecutionResumedEventAttributes),
+		ActivityTaskRetryRequestedEventAttributes:                      FromActivityTaskRetryRequestedEventAttributes(t.ActivityTaskRetryRequestedEventAttributes),
+	}
+}
+
+// ToHistoryEvent converts proto HistoryEvent type to thrift
+func ToHistoryEvent(p *apiv1.HistoryEvent) *shared.HistoryEvent {
+	if p == nil {
+		return nil
+	}
+	return &shared.HistoryEvent{
+		EventId:                                 int64Ptr(p.EventId),
+		Timestamp:                               int64Ptr(p.Timestamp),
+		EventType:                               ToEventType(p.EventType),
+		Version:                                 int64Ptr(p.Version),
+		WorkflowExecutionStartedEventAttributes: ToWorkflowExecutionStartedEventAttributes(p.WorkflowExecutionStartedEventAttributes),
+		WorkflowExecutionCompletedEventAttributes:                      ToWorkflowExecutionCompletedEventAttributes(p.WorkflowExecutionCompletedEventAttributes),
+		WorkflowExecutionFailedEventAttributes:                         ToWorkflowExecutionFailedEventAttributes(p.WorkflowExecutionFailedEventAttributes),
+		WorkflowExecutionTimedOutEventAttributes:                       ToWorkflowExecutionTimedOutEventAttributes(p.WorkflowExecutionTimedOutEventAttributes),
+		DecisionTaskScheduledEventAttributes:                           ToDecisionTaskScheduledEventAttributes(p.DecisionTaskScheduledEventAttributes),
+		DecisionTaskStartedEventAttributes:                             ToDecisionTaskStartedEventAttributes(p.DecisionTaskStartedEventAttributes),
+		DecisionTaskCompletedEventAttributes:                           ToDecisionTaskCompletedEventAttributes(p.DecisionTaskCompletedEventAttributes),
+		DecisionTaskTimedOutEventAttributes:                            ToDecisionTaskTimedOutEventAttributes(p.DecisionTaskTimedOutEventAttributes),
+		DecisionTaskFailedEventAttributes:                              ToDecisionTaskFailedEventAttributes(p.DecisionTaskFailedEventAttributes),
+		ActivityTaskScheduledEventAttributes:                           ToActivityTaskScheduledEventAttributes(p.ActivityTaskScheduledEventAttributes),
+		ActivityTaskStartedEventAttributes:                             ToActivityTaskStartedEventAttributes(p.ActivityTaskStartedEventAttributes),
+		ActivityTaskCompletedEventAttributes:                           ToActivityTaskCompletedEventAttributes(p.ActivityTaskCompletedEventAttributes),
+		ActivityTaskFailedEventAttributes:                              ToActivityTaskFailedEventAttributes(p.ActivityTaskFailedEventAttributes),
+		ActivityTaskTimedOutEventAttributes:                            ToActivityTaskTimedOutEventAttributes(p.ActivityTaskTimedOutEventAttributes),
+		TimerStartedEventAttributes:                                    ToTimerStartedEventAttributes(p.TimerStartedEventAttributes),
+		TimerFiredEventAttributes:                                      ToTimerFiredEventAttributes(p.TimerFiredEventAttributes),
+		ActivityTaskCancelRequestedEventAttributes:                     ToActivityTaskCancelRequestedEventAttributes(p.ActivityTaskCancelRequestedEventAttributes),
+		RequestCancelActivityTaskFailedEventAttributes:                 ToRequestCancelActivityTaskFailedEventAttributes(p.RequestCancelActivityTaskFailedEventAttributes),
+		ActivityTaskCanceledEventAttributes:                            ToActivityTaskCanceledEventAttributes(p.ActivityTaskCanceledEventAttributes),
+		TimerCanceledEventAttributes:                                   ToTimerCanceledEventAttributes(p.TimerCanceledEventAttributes),
+		CancelTimerFailedEventAttributes:                               ToCancelTimerFailedEventAttributes(p.CancelTimerFailedEventAttributes),
+		MarkerRecordedEventAttributes:                                  ToMarkerRecordedEventAttributes(p.MarkerRecordedEventAttributes),
+		WorkflowExecutionSignaledEventAttributes:                       ToWorkflowExecutionSignaledEventAttributes(p.WorkflowExecutionSignaledEventAttributes),
+		WorkflowExecutionTerminatedEventAttributes:                     ToWorkflowExecutionTerminatedEventAttributes(p.WorkflowExecutionTerminatedEventAttributes),
+		WorkflowExecutionCancelRequestedEventAttributes:                ToWorkflowExecutionCancelRequestedEventAttributes(p.WorkflowExecutionCancelRequestedEventAttributes),
+		WorkflowExecutionCanceledEventAttributes:                       ToWorkflowExecutionCanceledEventAttributes(p.WorkflowExecutionCanceledEventAttributes),
+		RequestCancelExternalWorkflowExecutionInitiatedEventAttributes: ToRequestCancelExternalWorkflowExecutionInitiatedEventAttributes(p.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes),
+		RequestCancelExternalWorkflowExecutionFailedEventAttributes:    ToRequestCancelExternalWorkflowExecutionFailedEventAttributes(p.RequestCancelExternalWorkflowExecutionFailedEventAttributes),
+		ExternalWorkflowExecutionCancelRequestedEventAttributes:        ToExternalWorkflowExecutionCancelRequestedEventAttributes(p.ExternalWorkflowExecutionCancelRequestedEventAttributes),
+		WorkflowExecutionContinuedAsNewEventAttributes:                 ToWorkflowExecutionContinuedAsNewEventAttributes(p.WorkflowExecutionContinuedAsNewEventAttributes),
+		StartChildWorkflowExecutionInitiatedEventAttributes:            ToStartChildWorkflowExecutionInitiatedEventAttributes(p.StartChildWorkflowExecutionInitiatedEventAttributes),
+		StartChildWorkflowExecutionFailedEventAttributes:               ToStartChildWorkflowExecutionFailedEventAttributes(p.StartChildWorkflowExecutionFailedEventAttributes),
+		ChildWorkflowExecutionStartedEventAttributes:                   ToChildWorkflowExecutionStartedEventAttributes(p.ChildWorkflowExecutionStartedEventAttributes),
+		ChildWorkflowExecutionCompletedEventAttributes:                 ToChildWorkflowExecutionCompletedEventAttributes(p.ChildWorkflowExecutionCompletedEventAttributes),
+		ChildWorkflowExecutionFailedEventAttributes:                    ToChildWorkflowExecutionFailedEventAttributes(p.ChildWorkflowExecutionFailedEventAttributes),
+		ChildWorkflowExecutionCanceledEventAttributes:                  ToChildWorkflowExecutionCanceledEventAttributes(p.ChildWorkflowExecutionCanceledEventAttributes),
+		ChildWorkflowExecutionTimedOutEventAttributes:                  ToChildWorkflowExecutionTimedOutEventAttributes(p.ChildWorkflowExecutionTimedOutEventAttributes),
+		ChildWorkflowExecutionTerminatedEventAttributes:                ToChildWorkflowExecutionTerminatedEventAttributes(p.ChildWorkflowExecutionTerminatedEventAttributes),
+		SignalExternalWorkflowExecutionInitiatedEventAttributes:        ToSignalExternalWorkflowExecutionInitiatedEventAttributes(p.SignalExternalWorkflowExecutionInitiatedEventAttributes),
+		SignalExternalWorkflowExecutionFailedEventAttributes:           ToSignalExternalWorkflowExecutionFailedEventAttributes(p.SignalExternalWorkflowExecutionFailedEventAttributes),
+		ExternalWorkflowExecutionSignaledEventAttributes:               ToExternalWorkflowExecutionSignaledEventAttributes(p.ExternalWorkflowExecutionSignaledEventAttributes),
+		WorkflowExecutionPausedEventAttributes:                         ToWorkflowExecutionPausedEventAttributes(p.WorkflowExecutionPausedEventAttributes),
+		WorkflowExecutionResumedEventAttributes:                        ToWorkflowExecutionResumedEventAttributes(p.WorkflowExecutionResumedEventAttributes),
+		ActivityTaskRetryRequestedEventAttributes:                      ToActivityTaskRetryRequestedEventAttributes(p.ActivityTaskRetryRequestedEventAttributes),
+	}
+}
+
+// FromHistory converts thrift History type to proto
+func FromHistory(t *shared.History) *apiv1.History {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.History{
+		Events: FromHistoryEventArray(t.Events),
+	}
+}
+
+// ToHistory converts proto History type to thrift
+func ToHistory(p *apiv1.History) *shared.History {
+	if p == nil {
+		return nil
+	}
+	return &shared.History{
+		Events: ToHistoryEventArray(p.Events),
+	}
+}
+
+// FromDataBlob converts thrift DataBlob type to proto
+func FromDataBlob(t *shared.DataBlob) *apiv1.DataBlob {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DataBlob{
+		EncodingType: FromEncodingType(t.EncodingType),
+		Data:         t.GetData(),
+	}
+}
+
+// ToDataBlob converts proto DataBlob type to thrift
+func ToDataBlob(p *apiv1.DataBlob) *shared.DataBlob {
+	if p == nil {
+		return nil
+	}
+	return &shared.DataBlob{
+		EncodingType: ToEncodingType(p.EncodingType),
+		Data:         p.Data,
+	}
+}
+
+// FromWorkflowExecutionFilter converts thrift WorkflowExecutionFilter type to proto
+func FromWorkflowExecutionFilter(t *shared.WorkflowExecutionFilter) *apiv1.WorkflowExecutionFilter {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionFilter{
+		WorkflowId: t.GetWorkflowId(),
+	}
+}
+
+// ToWorkflowExecutionFilter converts proto WorkflowExecutionFilter type to thrift
+func ToWorkflowExecutionFilter(p *apiv1.WorkflowExecutionFilter) *shared.WorkflowExecutionFilter {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionFilter{
+		WorkflowId: stringPtr(p.WorkflowId),
+	}
+}
+
+// FromWorkflowTypeFilter converts thrift WorkflowTypeFilter type to proto
+func FromWorkflowTypeFilter(t *shared.WorkflowTypeFilter) *apiv1.WorkflowTypeFilter {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowTypeFilter{
+		Name: t.GetName(),
+	}
+}
+
+// ToWorkflowTypeFilter converts proto WorkflowTypeFilter type to thrift
+func ToWorkflowTypeFilter(p *apiv1.WorkflowTypeFilter) *shared.WorkflowTypeFilter {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowTypeFilter{
+		Name: stringPtr(p.Name),
+	}
+}
+
+// FromStartTimeFilter converts thrift StartTimeFilter type to proto
+func FromStartTimeFilter(t *shared.StartTimeFilter) *apiv1.StartTimeFilter {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartTimeFilter{
+		EarliestTime: t.GetEarliestTime(),
+		LatestTime:   t.GetLatestTime(),
+	}
+}
+
+// ToStartTimeFilter converts proto StartTimeFilter type to thrift
+func ToStartTimeFilter(p *apiv1.StartTimeFilter) *shared.StartTimeFilter {
+	if p == nil {
+		return nil
+	}
+	return &shared.StartTimeFilter{
+		EarliestTime: int64Ptr(p.EarliestTime),
+		LatestTime:   int64Ptr(p.LatestTime),
+	}
+}
+
+// FromDomainInfo converts thrift DomainInfo type to proto
+func FromDomainInfo(t *shared.DomainInfo) *apiv1.DomainInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DomainInfo{
+		Name:        t.GetName(),
+		Status:      FromDomainStatus(t.Status),
+		Description: t.GetDescription(),
+		OwnerEmail:  t.GetOwnerEmail(),
+		Data:        t.Data,
+	}
+}
+
+// ToDomainInfo converts proto DomainInfo type to thrift
+func ToDomainInfo(p *apiv1.DomainInfo) *shared.DomainInfo {
+	if p == nil {
+		return nil
+	}
+	return &shared.DomainInfo{
+		Name:        stringPtr(p.Name),
+		Status:      ToDomainStatus(p.Status),
+		Description: stringPtr(p.Description),
+		OwnerEmail:  stringPtr(p.OwnerEmail),
+		Data:        p.Data,
+	}
+}
+
+// FromDomainConfiguration converts thrift DomainConfiguration type to proto
+func FromDomainConfiguration(t *shared.DomainConfiguration) *apiv1.DomainConfiguration {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DomainConfiguration{
+		WorkflowExecutionRetentionPeriodInDays: t.GetWorkflowExecutionRetentionPeriodInDays(),
+		EmitMetric:                             t.GetEmitMetric(),
+	}
+}
+
+// ToDomainConfiguration converts proto DomainConfiguration type to thrift
+func ToDomainConfiguration(p *apiv1.DomainConfiguration) *shared.DomainConfiguration {
+	if p == nil {
+		return nil
+	}
+	return &shared.DomainConfiguration{
+		WorkflowExecutionRetentionPeriodInDays: int32Ptr(p.WorkflowExecutionRetentionPeriodInDays),
+		EmitMetric:                             common.BoolPtr(p.EmitMetric),
+	}
+}
+
+// FromUpdateDomainInfo converts thrift UpdateDomainInfo type to proto
+func FromUpdateDomainInfo(t *shared.UpdateDomainInfo) *apiv1.UpdateDomainInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UpdateDomainInfo{
+		Description: t.GetDescription(),
+		OwnerEmail:  t.GetOwnerEmail(),
+		Data:        t.Data,
+	}
+}
+
+// ToUpdateDomainInfo converts proto UpdateDomainInfo type to thrift
+func ToUpdateDomainInfo(p *apiv1.UpdateDomainInfo) *shared.UpdateDomainInfo {
+	if p == nil {
+		return nil
+	}
+	return &shared.UpdateDomainInfo{
+		Description: stringPtr(p.Description),
+		OwnerEmail:  stringPtr(p.OwnerEmail),
+		Data:        p.Data,
+	}
+}
+
+// FromClusterReplicationConfiguration converts thrift ClusterReplicationConfiguration type to proto
+func FromClusterReplicationConfiguration(t *shared.ClusterReplicationConfiguration) *apiv1.ClusterReplicationConfiguration {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ClusterReplicationConfiguration{
+		ClusterName: t.GetClusterName(),
+	}
+}
+
+// ToClusterReplicationConfiguration converts proto ClusterReplicationConfiguration type to thrift
+func ToClusterReplicationConfiguration(p *apiv1.ClusterReplicationConfiguration) *shared.ClusterReplicationConfiguration {
+	if p == nil {
+		return nil
+	}
+	return &shared.ClusterReplicationConfiguration{
+		ClusterName: stringPtr(p.ClusterName),
+	}
+}
+
+// FromDomainReplicationConfiguration converts thrift DomainReplicationConfiguration type to proto
+func FromDomainReplicationConfiguration(t *shared.DomainReplicationConfiguration) *apiv1.DomainReplicationConfiguration {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DomainReplicationConfiguration{
+		ActiveClusterName: t.GetActiveClusterName(),
+		Clusters:          FromClusterReplicationConfigurationArray(t.Clusters),
+	}
+}
+
+// ToDomainReplicationConfiguration converts proto DomainReplicationConfiguration type to thrift
+func ToDomainReplicationConfiguration(p *apiv1.DomainReplicationConfiguration) *shared.DomainReplicationConfiguration {
+	if p == nil {
+		return nil
+	}
+	return &shared.DomainReplicationConfiguration{
+		ActiveClusterName: stringPtr(p.ActiveClusterName),
+		Clusters:          ToClusterReplicationConfigurationArray(p.Clusters),
+	}
+}
+
+// FromRegisterDomainRequest converts thrift RegisterDomainRequest type to proto
+func FromRegisterDomainRequest(t *shared.RegisterDomainRequest) *apiv1.RegisterDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RegisterDomainRequest{
+		Name:                                   t.GetName(),
+		Description:                            t.GetDescription(),
+		OwnerEmail:                             t.GetOwnerEmail(),
+		WorkflowExecutionRetentionPeriodInDays: t.GetWorkflowExecutionRetentionPeriodInDays(),
+		EmitMetric:                             t.GetEmitMetric(),
+		Clusters:                               FromClusterReplicationConfigurationArray(t.Clusters),
+		ActiveClusterName:                      t.GetActiveClusterName(),
+		Data:                                   t.Data,
+		SecurityToken:                          t.GetSecurityToken(),
+	}
+}
+
+// ToRegisterDomainRequest converts proto RegisterDomainRequest type to thrift
+func ToRegisterDomainRequest(p *apiv1.RegisterDomainRequest) *shared.RegisterDomainRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RegisterDomainRequest{
+		Name:                                   stringPtr(p.Name),
+		Description:                            stringPtr(p.Description),
+		OwnerEmail:                             stringPtr(p.OwnerEmail),
+		WorkflowExecutionRetentionPeriodInDays: int32Ptr(p.WorkflowExecutionRetentionPeriodInDays),
+		EmitMetric:                             common.BoolPtr(p.EmitMetric),
+		Clusters:                               ToClusterReplicationConfigurationArray(p.Clusters),
+		ActiveClusterName:                      stringPtr(p.ActiveClusterName),
+		Data:                                   p.Data,
+		SecurityToken:                          stringPtr(p.SecurityToken),
+	}
+}
+
+// FromListDomainsRequest converts thrift ListDomainsRequest type to proto
+func FromListDomainsRequest(t *shared.ListDomainsRequest) *apiv1.ListDomainsRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListDomainsRequest{
+		PageSize:      t.GetPageSize(),
+		NextPageToken: t.GetNextPageToken(),
+	}
+}
+
+// ToListDomainsRequest converts proto ListDomainsRequest type to thrift
+func ToListDomainsRequest(p *apiv1.ListDomainsRequest) *shared.ListDomainsRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.ListDomainsRequest{
+		PageSize:      int32Ptr(p.PageSize),
+		NextPageToken: p.NextPageToken,
+	}
+}
+
+// FromListDomainsResponse converts thrift ListDomainsResponse type to proto
+func FromListDomainsResponse(t *shared.ListDomainsResponse) *apiv1.ListDomainsResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListDomainsResponse{
+		Domains:       FromDescribeDomainResponseArray(t.Domains),
+		NextPageToken: t.GetNextPageToken(),
+	}
+}
+
+// ToListDomainsResponse converts proto ListDomainsResponse type to thrift
+func ToListDomainsResponse(p *apiv1.ListDomainsResponse) *shared.ListDomainsResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.ListDomainsResponse{
+		Domains:       ToDescribeDomainResponseArray(p.Domains),
+		NextPageToken: p.NextPageToken,
+	}
+}
+
+// FromDescribeDomainRequest converts thrift DescribeDomainRequest type to proto
+func FromDescribeDomainRequest(t *shared.DescribeDomainRequest) *apiv1.DescribeDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeDomainRequest{
+		Name: t.GetName(),
+	}
+}
+
+// ToDescribeDomainRequest converts proto DescribeDomainRequest type to thrift
+func ToDescribeDomainRequest(p *apiv1.DescribeDomainRequest) *shared.DescribeDomainRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.DescribeDomainRequest{
+		Name: stringPtr(p.Name),
+	}
+}
+
+// FromDomainFailoverInfo converts thrift DomainFailoverInfo type to proto
+func FromDomainFailoverInfo(t *shared.DomainFailoverInfo) *apiv1.DomainFailoverInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DomainFailoverInfo{
+		PendingActiveClusterName: t.GetPendingActiveClusterName(),
+		FailoverExpireTimestamp:  t.GetFailoverExpireTimestamp(),
+	}
+}
+
+// ToDomainFailoverInfo converts proto DomainFailoverInfo type to thrift
+func ToDomainFailoverInfo(p *apiv1.DomainFailoverInfo) *shared.DomainFailoverInfo {
+	if p == nil {
+		return nil
+	}
+	return &shared.DomainFailoverInfo{
+		PendingActiveClusterName: stringPtr(p.PendingActiveClusterName),
+		FailoverExpireTimestamp:  int64Ptr(p.FailoverExpireTimestamp),
+	}
+}
+
+// FromDescribeDomainResponse converts thrift DescribeDomainResponse type to proto
+func FromDescribeDomainResponse(t *shared.DescribeDomainResponse) *apiv1.DescribeDomainResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeDomainResponse{
+		DomainInfo:               FromDomainInfo(t.DomainInfo),
+		Configuration:            FromDomainConfiguration(t.Configuration),
+		ReplicationConfiguration: FromDomainReplicationConfiguration(t.ReplicationConfiguration),
+		FailoverVersion:          t.GetFailoverVersion(),
+		IsGlobalDomain:           t.GetIsGlobalDomain(),
+		FailoverInfo:             FromDomainFailoverInfo(t.FailoverInfo),
+	}
+}
+
+// ToDescribeDomainResponse converts proto DescribeDomainResponse type to thrift
+func ToDescribeDomainResponse(p *apiv1.DescribeDomainResponse) *shared.DescribeDomainResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.DescribeDomainResponse{
+		DomainInfo:               ToDomainInfo(p.DomainInfo),
+		Configuration:            ToDomainConfiguration(p.Configuration),
+		ReplicationConfiguration: ToDomainReplicationConfiguration(p.ReplicationConfiguration),
+		FailoverVersion:          int64Ptr(p.FailoverVersion),
+		IsGlobalDomain:           common.BoolPtr(p.IsGlobalDomain),
+		FailoverInfo:             ToDomainFailoverInfo(p.FailoverInfo),
+	}
+}
+
+// FromUpdateDomainRequest converts thrift UpdateDomainRequest type to proto
+func FromUpdateDomainRequest(t *shared.UpdateDomainRequest) *apiv1.UpdateDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UpdateDomainRequest{
+		Name:                     t.GetName(),
+		UpdatedInfo:              FromUpdateDomainInfo(t.UpdatedInfo),
+		Configuration:            FromDomainConfiguration(t.Configuration),
+		ReplicationConfiguration: FromDomainReplicationConfiguration(t.ReplicationConfiguration),
+		SecurityToken:            t.GetSecurityToken(),
+	}
+}
+
+// ToUpdateDomainRequest converts proto UpdateDomainRequest type to thrift
+func ToUpdateDomainRequest(p *apiv1.UpdateDomainRequest) *shared.UpdateDomainRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.UpdateDomainRequest{
+		Name:                     stringPtr(p.Name),
+		UpdatedInfo:              ToUpdateDomainInfo(p.UpdatedInfo),
+		Configuration:            ToDomainConfiguration(p.Configuration),
+		ReplicationConfiguration: ToDomainReplicationConfiguration(p.ReplicationConfiguration),
+		SecurityToken:            stringPtr(p.SecurityToken),
+	}
+}
+
+// FromUpdateDomainResponse converts thrift UpdateDomainResponse type to proto
+func FromUpdateDomainResponse(t *shared.UpdateDomainResponse) *apiv1.UpdateDomainResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UpdateDomainResponse{
+		DomainInfo:               FromDomainInfo(t.DomainInfo),
+		Configuration:            FromDomainConfiguration(t.Configuration),
+		ReplicationConfiguration: FromDomainReplicationConfiguration(t.ReplicationConfiguration),
+		FailoverVersion:          t.GetFailoverVersion(),
+		IsGlobalDomain:           t.GetIsGlobalDomain(),
+	}
+}
+
+// ToUpdateDomainResponse converts proto UpdateDomainResponse type to thrift
+func ToUpdateDomainResponse(p *apiv1.UpdateDomainResponse) *shared.UpdateDomainResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.UpdateDomainResponse{
+		DomainInfo:               ToDomainInfo(p.DomainInfo),
+		Configuration:            ToDomainConfiguration(p.Configuration),
+		ReplicationConfiguration: ToDomainReplicationConfiguration(p.ReplicationConfiguration),
+		FailoverVersion:          int64Ptr(p.FailoverVersion),
+		IsGlobalDomain:           common.BoolPtr(p.IsGlobalDomain),
+	}
+}
+
+// FromDeprecateDomainRequest converts thrift DeprecateDomainRequest type to proto
+func FromDeprecateDomainRequest(t *shared.DeprecateDomainRequest) *apiv1.DeprecateDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DeprecateDomainRequest{
+		Name:          t.GetName(),
+		SecurityToken: t.GetSecurityToken(),
+	}
+}
+
+// ToDeprecateDomainRequest converts proto DeprecateDomainRequest type to thrift
+func ToDeprecateDomainRequest(p *apiv1.DeprecateDomainRequest) *shared.DeprecateDomainRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.DeprecateDomainRequest{
+		Name:          stringPtr(p.Name),
+		SecurityToken: stringPtr(p.SecurityToken),
+	}
+}
+
+// FromStartWorkflowExecutionRequest converts thrift StartWorkflowExecutionRequest type to proto
+func FromStartWorkflowExecutionRequest(t *shared.StartWorkflowExecutionRequest) *apiv1.StartWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartWorkflowExecutionRequest{
+		Domain:                              t.GetDomain(),
+		WorkflowId:                          t.GetWorkflowId(),
+		WorkflowType:                        FromWorkflowType(t.WorkflowType),
+		TaskList:                            FromTaskList(t.TaskList),
+		Input:                               t.GetInput(),
+		ExecutionStartToCloseTimeoutSeconds: t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      t.GetTaskStartToCloseTimeoutSeconds(),
+		Identity:                            t.GetIdentity(),
+		RequestId:                           t.GetRequestId(),
+		WorkflowIdReusePolicy:               FromWorkflowIDReusePolicy(t.WorkflowIdReusePolicy),
+		ChildPolicy:                         FromChildPolicy(t.ChildPolicy),
+		RetryPolicy:                         FromRetryPolicy(t.RetryPolicy),
+		DelayStartSeconds:                   t.GetDelayStartSeconds(),
+	}
+}
+
+// ToStartWorkflowExecutionRequest converts proto StartWorkflowExecutionRequest type to thrift
+func ToStartWorkflowExecutionRequest(p *apiv1.StartWorkflowExecutionRequest) *shared.StartWorkflowExecutionRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.StartWorkflowExecutionRequest{
+		Domain:                              stringPtr(p.Domain),
+		WorkflowId:                          stringPtr(p.WorkflowId),
+		WorkflowType:                        ToWorkflowType(p.WorkflowType),
+		TaskList:                            ToTaskList(p.TaskList),
+		Input:                               p.Input,
+		ExecutionStartToCloseTimeoutSeconds: int32Ptr(p.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      int32Ptr(p.TaskStartToCloseTimeoutSeconds),
+		Identity:                            stringPtr(p.Identity),
+		RequestId:                           stringPtr(p.RequestId),
+		WorkflowIdReusePolicy:               ToWorkflowIDReusePolicy(p.WorkflowIdReusePolicy),
+		ChildPolicy:                         ToChildPolicy(p.ChildPolicy),
+		RetryPolicy:                         ToRetryPolicy(p.RetryPolicy),
+		DelayStartSeconds:                   int32Ptr(p.DelayStartSeconds),
+	}
+}
+
+// FromStartWorkflowExecutionResponse converts thrift StartWorkflowExecutionResponse type to proto
+func FromStartWorkflowExecutionResponse(t *shared.StartWorkflowExecutionResponse) *apiv1.StartWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartWorkflowExecutionResponse{
+		RunId: t.GetRunId(),
+	}
+}
+
+// ToStartWorkflowExecutionResponse converts proto StartWorkflowExecutionResponse type to thrift
+func ToStartWorkflowExecutionResponse(p *apiv1.StartWorkflowExecutionResponse) *shared.StartWorkflowExecutionResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.StartWorkflowExecutionResponse{
+		RunId: stringPtr(p.RunId),
+	}
+}
+
+// FromPollForDecisionTaskRequest converts thrift PollForDecisionTaskRequest type to proto
+func FromPollForDecisionTaskRequest(t *shared.PollForDecisionTaskRequest) *apiv1.PollForDecisionTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PollForDecisionTaskRequest{
+		Domain:   t.GetDomain(),
+		TaskList: FromTaskList(t.TaskList),
+		Identity: t.GetIdentity(),
+	}
+}
+
+// ToPollForDecisionTaskRequest converts proto PollForDecisionTaskRequest type to thrift
+func ToPollForDecisionTaskRequest(p *apiv1.PollForDecisionTaskRequest) *shared.PollForDecisionTaskRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.PollForDecisionTaskRequest{
+		Domain:   stringPtr(p.Domain),
+		TaskList: ToTaskList(p.TaskList),
+		Identity: stringPtr(p.Identity),
+	}
+}
+
+// FromPollForDecisionTaskResponse converts thrift PollForDecisionTaskResponse type to proto
+func FromPollForDecisionTaskResponse(t *shared.PollForDecisionTaskResponse) *apiv1.PollForDecisionTaskResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PollForDecisionTaskResponse{
+		TaskToken:                 t.GetTaskToken(),
+		WorkflowExecution:         FromWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:              FromWorkflowType(t.WorkflowType),
+		PreviousStartedEventId:    t.GetPreviousStartedEventId(),
+		StartedEventId:            t.GetStartedEventId(),
+		Attempt:                   t.GetAttempt(),
+		BacklogCountHint:          t.GetBacklogCountHint(),
+		History:                   FromHistory(t.History),
+		NextPageToken:             t.GetNextPageToken(),
+		Query:                     FromWorkflowQuery(t.Query),
+		WorkflowExecutionTaskList: FromTaskList(t.WorkflowExecutionTaskList),
+		RawHistory:                FromDataBlobArray(t.RawHistory),
+	}
+}
+
+// ToPollForDecisionTaskResponse converts proto PollForDecisionTaskResponse type to thrift
+func ToPollForDecisionTaskResponse(p *apiv1.PollForDecisionTaskResponse) *shared.PollForDecisionTaskResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.PollForDecisionTaskResponse{
+		TaskToken:                 p.TaskToken,
+		WorkflowExecution:         ToWorkflowExecution(p.WorkflowExecution),
+		WorkflowType:              ToWorkflowType(p.WorkflowType),
+		PreviousStartedEventId:    int64Ptr(p.PreviousStartedEventId),
+		StartedEventId:            int64Ptr(p.StartedEventId),
+		Attempt:                   int64Ptr(p.Attempt),
+		BacklogCountHint:          int64Ptr(p.BacklogCountHint),
+		History:                   ToHistory(p.History),
+		NextPageToken:             p.NextPageToken,
+		Query:                     ToWorkflowQuery(p.Query),
+		WorkflowExecutionTaskList: ToTaskList(p.WorkflowExecutionTaskList),
+		RawHistory:                ToDataBlobArray(p.RawHistory),
+	}
+}
+
+// FromStickyExecutionAttributes converts thrift StickyExecutionAttributes type to proto
+func FromStickyExecutionAttributes(t *shared.StickyExecutionAttributes) *apiv1.StickyExecutionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StickyExecutionAttributes{
+		WorkerTaskList:                FromTaskList(t.WorkerTaskList),
+		ScheduleToStartTimeoutSeconds: t.GetScheduleToStartTimeoutSeconds(),
+	}
+}
+
+// ToStickyExecutionAttributes converts proto StickyExecutionAttributes type to thrift
+func ToStickyExecutionAttributes(p *apiv1.StickyExecutionAttributes) *shared.StickyExecutionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.StickyExecutionAttributes{
+		WorkerTaskList:                ToTaskList(p.WorkerTaskList),
+		ScheduleToStartTimeoutSeconds: int32Ptr(p.ScheduleToStartTimeoutSeconds),
+	}
+}
+
+// FromRespondDecisionTaskCompletedRequest converts thrift RespondDecisionTaskCompletedRequest type to proto
+func FromRespondDecisionTaskCompletedRequest(t *shared.RespondDecisionTaskCompletedRequest) *apiv1.RespondDecisionTaskCompletedRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondDecisionTaskCompletedRequest{
+		TaskToken:                  t.GetTaskToken(),
+		Decisions:                  FromDecisionArray(t.Decisions),
+		ExecutionContext:           t.GetExecutionContext(),
+		Identity:                   t.GetIdentity(),
+		StickyAttributes:           FromStickyExecutionAttributes(t.StickyAttributes),
+		ReturnNewDecisionTask:      t.GetReturnNewDecisionTask(),
+		ForceCreateNewDecisionTask: t.GetForceCreateNewDecisionTask(),
+		BinaryChecksum:             t.GetBinaryChecksum(),
+	}
+}
+
+// ToRespondDecisionTaskCompletedRequest converts proto RespondDecisionTaskCompletedRequest type to thrift
+func ToRespondDecisionTaskCompletedRequest(p *apiv1.RespondDecisionTaskCompletedRequest) *shared.RespondDecisionTaskCompletedRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RespondDecisionTaskCompletedRequest{
+		TaskToken:                  p.TaskToken,
+		Decisions:                  ToDecisionArray(p.Decisions),
+		ExecutionContext:           p.ExecutionContext,
+		Identity:                   stringPtr(p.Identity),
+		StickyAttributes:           ToStickyExecutionAttributes(p.StickyAttributes),
+		ReturnNewDecisionTask:      common.BoolPtr(p.ReturnNewDecisionTask),
+		ForceCreateNewDecisionTask: common.BoolPtr(p.ForceCreateNewDecisionTask),
+		BinaryChecksum:             stringPtr(p.BinaryChecksum),
+	}
+}
+
+// FromRespondDecisionTaskCompletedResponse converts thrift RespondDecisionTaskCompletedResponse type to proto
+func FromRespondDecisionTaskCompletedResponse(t *shared.RespondDecisionTaskCompletedResponse) *apiv1.RespondDecisionTaskCompletedResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondDecisionTaskCompletedResponse{
+		DecisionTask: FromPollForDecisionTaskResponse(t.DecisionTask),
+	}
+}
+
+// ToRespondDecisionTaskCompletedResponse converts proto RespondDecisionTaskCompletedResponse type to thrift
+func ToRespondDecisionTaskCompletedResponse(p *apiv1.RespondDecisionTaskCompletedResponse) *shared.RespondDecisionTaskCompletedResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.RespondDecisionTaskCompletedResponse{
+		DecisionTask: ToPollForDecisionTaskResponse(p.DecisionTask),
+	}
+}
+
+// FromRespondDecisionTaskFailedRequest converts thrift RespondDecisionTaskFailedRequest type to proto
+func FromRespondDecisionTaskFailedRequest(t *shared.RespondDecisionTaskFailedRequest) *apiv1.RespondDecisionTaskFailedRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondDecisionTaskFailedRequest{
+		TaskToken: t.GetTaskToken(),
+		Cause:     FromDecisionTaskFailedCause(t.Cause),
+		Details:   t.GetDetails(),
+		Identity:  t.GetIdentity(),
+	}
+}
+
+// ToRespondDecisionTaskFailedRequest converts proto RespondDecisionTaskFailedRequest type to thrift
+func ToRespondDecisionTaskFailedRequest(p *apiv1.RespondDecisionTaskFailedRequest) *shared.RespondDecisionTaskFailedRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RespondDecisionTaskFailedRequest{
+		TaskToken: p.TaskToken,
+		Cause:     ToDecisionTaskFailedCause(p.Cause),
+		Details:   p.Details,
+		Identity:  stringPtr(p.Identity),
+	}
+}
+
+// FromPollForActivityTaskRequest converts thrift PollForActivityTaskRequest type to proto
+func FromPollForActivityTaskRequest(t *shared.PollForActivityTaskRequest) *apiv1.PollForActivityTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PollForActivityTaskRequest{
+		Domain:           t.GetDomain(),
+		TaskList:         FromTaskList(t.TaskList),
+		Identity:         t.GetIdentity(),
+		TaskListMetadata: FromTaskListMetadata(t.TaskListMetadata),
+	}
+}
+
+// ToPollForActivityTaskRequest converts proto PollForActivityTaskRequest type to thrift
+func ToPollForActivityTaskRequest(p *apiv1.PollForActivityTaskRequest) *shared.PollForActivityTaskRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.PollForActivityTaskRequest{
+		Domain:           stringPtr(p.Domain),
+		TaskList:         ToTaskList(p.TaskList),
+		Identity:         stringPtr(p.Identity),
+		TaskListMetadata: ToTaskListMetadata(p.TaskListMetadata),
+	}
+}
+
+// FromPollForActivityTaskResponse converts thrift PollForActivityTaskResponse type to proto
+func FromPollForActivityTaskResponse(t *shared.PollForActivityTaskResponse) *apiv1.PollForActivityTaskResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PollForActivityTaskResponse{
+		TaskToken:                       t.GetTaskToken(),
+		WorkflowExecution:               FromWorkflowExecution(t.WorkflowExecution),
+		ActivityId:                      t.GetActivityId(),
+		ActivityType:                    FromActivityType(t.ActivityType),
+		Input:                           t.GetInput(),
+		ScheduledTimestamp:              t.GetScheduledTimestamp(),
+		ScheduleToCloseTimeoutSeconds:   t.GetScheduleToCloseTimeoutSeconds(),
+		StartedTimestamp:                t.GetStartedTimestamp(),
+		StartToCloseTimeoutSeconds:      t.GetStartToCloseTimeoutSeconds(),
+		HeartbeatTimeoutSeconds:         t.GetHeartbeatTimeoutSeconds(),
+		Attempt:                         t.GetAttempt(),
+		ScheduledTimestampOfThisAttempt: t.GetScheduledTimestampOfThisAttempt(),
+		HeartbeatDetails:                t.GetHeartbeatDetails(),
+		WorkflowType:                    FromWorkflowType(t.WorkflowType),
+		WorkflowDomain:                  t.GetWorkflowDomain(),
+	}
+}
+
+// ToPollForActivityTaskResponse converts proto PollForActivityTaskResponse type to thrift
+func ToPollForActivityTaskResponse(p *apiv1.PollForActivityTaskResponse) *shared.PollForActivityTaskResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.PollForActivityTaskResponse{
+		TaskToken:                       p.TaskToken,
+		WorkflowExecution:               ToWorkflowExecution(p.WorkflowExecution),
+		ActivityId:                      stringPtr(p.ActivityId),
+		ActivityType:                    ToActivityType(p.ActivityType),
+		Input:                           p.Input,
+		ScheduledTimestamp:              int64Ptr(p.ScheduledTimestamp),
+		ScheduleToCloseTimeoutSeconds:   int32Ptr(p.ScheduleToCloseTimeoutSeconds),
+		StartedTimestamp:                int64Ptr(p.StartedTimestamp),
+		StartToCloseTimeoutSeconds:      int32Ptr(p.StartToCloseTimeoutSeconds),
+		HeartbeatTimeoutSeconds:         int32Ptr(p.HeartbeatTimeoutSeconds),
+		Attempt:                         int32Ptr(p.Attempt),
+		ScheduledTimestampOfThisAttempt: int64Ptr(p.ScheduledTimestampOfThisAttempt),
+		HeartbeatDetails:                p.HeartbeatDetails,
+		WorkflowType:                    ToWorkflowType(p.WorkflowType),
+		WorkflowDomain:                  stringPtr(p.WorkflowDomain),
+	}
+}
+
+// FromRecordActivityTaskHeartbeatRequest converts thrift RecordActivityTaskHeartbeatRequest type to proto
+func FromRecordActivityTaskHeartbeatRequest(t *shared.RecordActivityTaskHeartbeatRequest) *apiv1.RecordActivityTaskHeartbeatRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RecordActivityTaskHeartbeatRequest{
+		TaskToken: t.GetTaskToken(),
+		Details:   t.GetDetails(),
+		Identity:  t.GetIdentity(),
+	}
+}
+
+// ToRecordActivityTaskHeartbeatRequest converts proto RecordActivityTaskHeartbeatRequest type to thrift
+func ToRecordActivityTaskHeartbeatRequest(p *apiv1.RecordActivityTaskHeartbeatRequest) *shared.RecordActivityTaskHeartbeatRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RecordActivityTaskHeartbeatRequest{
+		TaskToken: p.TaskToken,
+		Details:   p.Details,
+		Identity:  stringPtr(p.Identity),
+	}
+}
+
+// FromRecordActivityTaskHeartbeatByIDRequest converts thrift RecordActivityTaskHeartbeatByIDRequest type to proto
+func FromRecordActivityTaskHeartbeatByIDRequest(t *shared.RecordActivityTaskHeartbeatByIDRequest) *apiv1.RecordActivityTaskHeartbeatByIDRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RecordActivityTaskHeartbeatByIDRequest{
+		Domain:     t.GetDomain(),
+		WorkflowId: t.GetWorkflowID(),
+		RunId:      t.GetRunID(),
+		ActivityId: t.GetActivityID(),
+		Details:    t.GetDetails(),
+		Identity:   t.GetIdentity(),
+	}
+}
+
+// ToRecordActivityTaskHeartbeatByIDRequest converts proto RecordActivityTaskHeartbeatByIDRequest type to thrift
+func ToRecordActivityTaskHeartbeatByIDRequest(p *apiv1.RecordActivityTaskHeartbeatByIDRequest) *shared.RecordActivityTaskHeartbeatByIDRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RecordActivityTaskHeartbeatByIDRequest{
+		Domain:     stringPtr(p.Domain),
+		WorkflowID: stringPtr(p.WorkflowId),
+		RunID:      stringPtr(p.RunId),
+		ActivityID: stringPtr(p.ActivityId),
+		Details:    p.Details,
+		Identity:   stringPtr(p.Identity),
+	}
+}
+
+// FromRecordActivityTaskHeartbeatResponse converts thrift RecordActivityTaskHeartbeatResponse type to proto
+func FromRecordActivityTaskHeartbeatResponse(t *shared.RecordActivityTaskHeartbeatResponse) *apiv1.RecordActivityTaskHeartbeatResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RecordActivityTaskHeartbeatResponse{
+		CancelRequested: t.GetCancelRequested(),
+	}
+}
+
+// ToRecordActivityTaskHeartbeatResponse converts proto RecordActivityTaskHeartbeatResponse type to thrift
+func ToRecordActivityTaskHeartbeatResponse(p *apiv1.RecordActivityTaskHeartbeatResponse) *shared.RecordActivityTaskHeartbeatResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.RecordActivityTaskHeartbeatResponse{
+		CancelRequested: common.BoolPtr(p.CancelRequested),
+	}
+}
+
+// FromRespondActivityTaskCompletedRequest converts thrift RespondActivityTaskCompletedRequest type to proto
+func FromRespondActivityTaskCompletedRequest(t *shared.RespondActivityTaskCompletedRequest) *apiv1.RespondActivityTaskCompletedRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondActivityTaskCompletedRequest{
+		TaskToken: t.GetTaskToken(),
+		Result:    t.GetResult(),
+		Identity:  t.GetIdentity(),
+	}
+}
+
+// ToRespondActivityTaskCompletedRequest converts proto RespondActivityTaskCompletedRequest type to thrift
+func ToRespondActivityTaskCompletedRequest(p *apiv1.RespondActivityTaskCompletedRequest) *shared.RespondActivityTaskCompletedRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RespondActivityTaskCompletedRequest{
+		TaskToken: p.TaskToken,
+		Result:    p.Result,
+		Identity:  stringPtr(p.Identity),
+	}
+}
+
+// FromRespondActivityTaskFailedRequest converts thrift RespondActivityTaskFailedRequest type to proto
+func FromRespondActivityTaskFailedRequest(t *shared.RespondActivityTaskFailedRequest) *apiv1.RespondActivityTaskFailedRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondActivityTaskFailedRequest{
+		TaskToken: t.GetTaskToken(),
+		Reason:    t.GetReason(),
+		Details:   t.GetDetails(),
+		Identity:  t.GetIdentity(),
+	}
+}
+
+// ToRespondActivityTaskFailedRequest converts proto RespondActivityTaskFailedRequest type to thrift
+func ToRespondActivityTaskFailedRequest(p *apiv1.RespondActivityTaskFailedRequest) *shared.RespondActivityTaskFailedRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RespondActivityTaskFailedRequest{
+		TaskToken: p.TaskToken,
+		Reason:    stringPtr(p.Reason),
+		Details:   p.Details,
+		Identity:  stringPtr(p.Identity),
+	}
+}
+
+// FromRespondActivityTaskCanceledRequest converts thrift RespondActivityTaskCanceledRequest type to proto
+func FromRespondActivityTaskCanceledRequest(t *shared.RespondActivityTaskCanceledRequest) *apiv1.RespondActivityTaskCanceledRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondActivityTaskCanceledRequest{
+		TaskToken: t.GetTaskToken(),
+		Details:   t.GetDetails(),
+		Identity:  t.GetIdentity(),
+	}
+}
+
+// ToRespondActivityTaskCanceledRequest converts proto RespondActivityTaskCanceledRequest type to thrift
+func ToRespondActivityTaskCanceledRequest(p *apiv1.RespondActivityTaskCanceledRequest) *shared.RespondActivityTaskCanceledRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RespondActivityTaskCanceledRequest{
+		TaskToken: p.TaskToken,
+		Details:   p.Details,
+		Identity:  stringPtr(p.Identity),
+	}
+}
+
+// FromRespondActivityTaskCompletedByIDRequest converts thrift RespondActivityTaskCompletedByIDRequest type to proto
+func FromRespondActivityTaskCompletedByIDRequest(t *shared.RespondActivityTaskCompletedByIDRequest) *apiv1.RespondActivityTaskCompletedByIDRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondActivityTaskCompletedByIDRequest{
+		Domain:     t.GetDomain(),
+		WorkflowId: t.GetWorkflowID(),
+		RunId:      t.GetRunID(),
+		ActivityId: t.GetActivityID(),
+		Result:     t.GetResult(),
+		Identity:   t.GetIdentity(),
+	}
+}
+
+// ToRespondActivityTaskCompletedByIDRequest converts proto RespondActivityTaskCompletedByIDRequest type to thrift
+func ToRespondActivityTaskCompletedByIDRequest(p *apiv1.RespondActivityTaskCompletedByIDRequest) *shared.RespondActivityTaskCompletedByIDRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RespondActivityTaskCompletedByIDRequest{
+		Domain:     stringPtr(p.Domain),
+		WorkflowID: stringPtr(p.WorkflowId),
+		RunID:      stringPtr(p.RunId),
+		ActivityID: stringPtr(p.ActivityId),
+		Result:     p.Result,
+		Identity:   stringPtr(p.Identity),
+	}
+}
+
+// FromRespondActivityTaskFailedByIDRequest converts thrift RespondActivityTaskFailedByIDRequest type to proto
+func FromRespondActivityTaskFailedByIDRequest(t *shared.RespondActivityTaskFailedByIDRequest) *apiv1.RespondActivityTaskFailedByIDRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondActivityTaskFailedByIDRequest{
+		Domain:     t.GetDomain(),
+		WorkflowId: t.GetWorkflowID(),
+		RunId:      t.GetRunID(),
+		ActivityId: t.GetActivityID(),
+		Reason:     t.GetReason(),
+		Details:    t.GetDetails(),
+		Identity:   t.GetIdentity(),
+	}
+}
+
+// ToRespondActivityTaskFailedByIDRequest converts proto RespondActivityTaskFailedByIDRequest type to thrift
+func ToRespondActivityTaskFailedByIDRequest(p *apiv1.RespondActivityTaskFailedByIDRequest) *shared.RespondActivityTaskFailedByIDRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RespondActivityTaskFailedByIDRequest{
+		Domain:     stringPtr(p.Domain),
+		WorkflowID: stringPtr(p.WorkflowId),
+		RunID:      stringPtr(p.RunId),
+		ActivityID: stringPtr(p.ActivityId),
+		Reason:     stringPtr(p.Reason),
+		Details:    p.Details,
+		Identity:   stringPtr(p.Identity),
+	}
+}
+
+// FromRespondActivityTaskCanceledByIDRequest converts thrift RespondActivityTaskCanceledByIDRequest type to proto
+func FromRespondActivityTaskCanceledByIDRequest(t *shared.RespondActivityTaskCanceledByIDRequest) *apiv1.RespondActivityTaskCanceledByIDRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondActivityTaskCanceledByIDRequest{
+		Domain:     t.GetDomain(),
+		WorkflowId: t.GetWorkflowID(),
+		RunId:      t.GetRunID(),
+		ActivityId: t.GetActivityID(),
+		Details:    t.GetDetails(),
+		Identity:   t.GetIdentity(),
+	}
+}
+
+// ToRespondActivityTaskCanceledByIDRequest converts proto RespondActivityTaskCanceledByIDRequest type to thrift
+func ToRespondActivityTaskCanceledByIDRequest(p *apiv1.RespondActivityTaskCanceledByIDRequest) *shared.RespondActivityTaskCanceledByIDRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RespondActivityTaskCanceledByIDRequest{
+		Domain:     stringPtr(p.Domain),
+		WorkflowID: stringPtr(p.WorkflowId),
+		RunID:      stringPtr(p.RunId),
+		ActivityID: stringPtr(p.ActivityId),
+		Details:    p.Details,
+		Identity:   stringPtr(p.Identity),
+	}
+}
+
+// FromRequestCancelWorkflowExecutionRequest converts thrift RequestCancelWorkflowExecutionRequest type to proto
+func FromRequestCancelWorkflowExecutionRequest(t *shared.RequestCancelWorkflowExecutionRequest) *apiv1.RequestCancelWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RequestCancelWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Identity:          t.GetIdentity(),
+		RequestId:         t.GetRequestId(),
+	}
+}
+
+// ToRequestCancelWorkflowExecutionRequest converts proto RequestCancelWorkflowExecutionRequest type to thrift
+func ToRequestCancelWorkflowExecutionRequest(p *apiv1.RequestCancelWorkflowExecutionRequest) *shared.RequestCancelWorkflowExecutionRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RequestCancelWorkflowExecutionRequest{
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: ToWorkflowExecution(p.WorkflowExecution),
+		Identity:          stringPtr(p.Identity),
+		RequestId:         stringPtr(p.RequestId),
+	}
+}
+
+// FromGetWorkflowExecutionHistoryRequest converts thrift GetWorkflowExecutionHistoryRequest type to proto
+func FromGetWorkflowExecutionHistoryRequest(t *shared.GetWorkflowExecutionHistoryRequest) *apiv1.GetWorkflowExecutionHistoryRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.GetWorkflowExecutionHistoryRequest{
+		Domain:                 t.GetDomain(),
+		Execution:              FromWorkflowExecution(t.Execution),
+		MaximumPageSize:        t.GetMaximumPageSize(),
+		NextPageToken:          t.GetNextPageToken(),
+		WaitForNewEvent:        t.GetWaitForNewEvent(),
+		HistoryEventFilterType: FromHistoryEventFilterType(t.HistoryEventFilterType),
+	}
+}
+
+// ToGetWorkflowExecutionHistoryRequest converts proto GetWorkflowExecutionHistoryRequest type to thrift
+func ToGetWorkflowExecutionHistoryRequest(p *apiv1.GetWorkflowExecutionHistoryRequest) *shared.GetWorkflowExecutionHistoryRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.GetWorkflowExecutionHistoryRequest{
+		Domain:                 stringPtr(p.Domain),
+		Execution:              ToWorkflowExecution(p.Execution),
+		MaximumPageSize:        int32Ptr(p.MaximumPageSize),
+		NextPageToken:          p.NextPageToken,
+		WaitForNewEvent:        common.BoolPtr(p.WaitForNewEvent),
+		HistoryEventFilterType: ToHistoryEventFilterType(p.HistoryEventFilterType),
+	}
+}
+
+// FromGetWorkflowExecutionHistoryResponse converts thrift GetWorkflowExecutionHistoryResponse type to proto
+func FromGetWorkflowExecutionHistoryResponse(t *shared.GetWorkflowExecutionHistoryResponse) *apiv1.GetWorkflowExecutionHistoryResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.GetWorkflowExecutionHistoryResponse{
+		History:       FromHistory(t.History),
+		NextPageToken: t.GetNextPageToken(),
+	}
+}
+
+// ToGetWorkflowExecutionHistoryResponse converts proto GetWorkflowExecutionHistoryResponse type to thrift
+func ToGetWorkflowExecutionHistoryResponse(p *apiv1.GetWorkflowExecutionHistoryResponse) *shared.GetWorkflowExecutionHistoryResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.GetWorkflowExecutionHistoryResponse{
+		History:       ToHistory(p.History),
+		NextPageToken: p.NextPageToken,
+	}
+}
+
+// FromGetWorkflowExecutionRawHistoryRequest converts thrift GetWorkflowExecutionRawHistoryRequest type to proto
+func FromGetWorkflowExecutionRawHistoryRequest(t *shared.GetWorkflowExecutionRawHistoryRequest) *apiv1.GetWorkflowExecutionRawHistoryRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.GetWorkflowExecutionRawHistoryRequest{
+		Domain:          t.GetDomain(),
+		Execution:       FromWorkflowExecution(t.Execution),
+		MaximumPageSize: t.GetMaximumPageSize(),
+		NextPageToken:   t.GetNextPageToken(),
+	}
+}
+
+// ToGetWorkflowExecutionRawHistoryRequest converts proto GetWorkflowExecutionRawHistoryRequest type to thrift
+func ToGetWorkflowExecutionRawHistoryRequest(p *apiv1.GetWorkflowExecutionRawHistoryRequest) *shared.GetWorkflowExecutionRawHistoryRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.GetWorkflowExecutionRawHistoryRequest{
+		Domain:          stringPtr(p.Domain),
+		Execution:       ToWorkflowExecution(p.Execution),
+		MaximumPageSize: int32Ptr(p.MaximumPageSize),
+		NextPageToken:   p.NextPageToken,
+	}
+}
+
+// FromGetWorkflowExecutionRawHistoryResponse converts thrift GetWorkflowExecutionRawHistoryResponse type to proto
+func FromGetWorkflowExecutionRawHistoryResponse(t *shared.GetWorkflowExecutionRawHistoryResponse) *apiv1.GetWorkflowExecutionRawHistoryResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.GetWorkflowExecutionRawHistoryResponse{
+		NextPageToken: t.GetNextPageToken(),
+		RawHistory:    FromDataBlobArray(t.RawHistory),
+	}
+}
+
+// ToGetWorkflowExecutionRawHistoryResponse converts proto GetWorkflowExecutionRawHistoryResponse type to thrift
+func ToGetWorkflowExecutionRawHistoryResponse(p *apiv1.GetWorkflowExecutionRawHistoryResponse) *shared.GetWorkflowExecutionRawHistoryResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.GetWorkflowExecutionRawHistoryResponse{
+		NextPageToken: p.NextPageToken,
+		RawHistory:    ToDataBlobArray(p.RawHistory),
+	}
+}
+
+// FromSignalWorkflowExecutionRequest converts thrift SignalWorkflowExecutionRequest type to proto
+func FromSignalWorkflowExecutionRequest(t *shared.SignalWorkflowExecutionRequest) *apiv1.SignalWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.SignalWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		SignalName:        t.GetSignalName(),
+		Input:             t.GetInput(),
+		Identity:          t.GetIdentity(),
+		RequestId:         t.GetRequestId(),
+		Control:           t.GetControl(),
+	}
+}
+
+// ToSignalWorkflowExecutionRequest converts proto SignalWorkflowExecutionRequest type to thrift
+func ToSignalWorkflowExecutionRequest(p *apiv1.SignalWorkflowExecutionRequest) *shared.SignalWorkflowExecutionRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.SignalWorkflowExecutionRequest{
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: ToWorkflowExecution(p.WorkflowExecution),
+		SignalName:        stringPtr(p.SignalName),
+		Input:             p.Input,
+		Identity:          stringPtr(p.Identity),
+		RequestId:         stringPtr(p.RequestId),
+		Control:           p.Control,
+	}
+}
+
+// FromSignalWithStartWorkflowExecutionRequest converts thrift SignalWithStartWorkflowExecutionRequest type to proto
+func FromSignalWithStartWorkflowExecutionRequest(t *shared.SignalWithStartWorkflowExecutionRequest) *apiv1.SignalWithStartWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.SignalWithStartWorkflowExecutionRequest{
+		Domain:                              t.GetDomain(),
+		WorkflowId:                          t.GetWorkflowId(),
+		WorkflowType:                        FromWorkflowType(t.WorkflowType),
+		TaskList:                            FromTaskList(t.TaskList),
+		Input:                               t.GetInput(),
+		ExecutionStartToCloseTimeoutSeconds: t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      t.GetTaskStartToCloseTimeoutSeconds(),
+		Identity:                            t.GetIdentity(),
+		RequestId:                           t.GetRequestId(),
+		WorkflowIdReusePolicy:               FromWorkflowIDReusePolicy(t.WorkflowIdReusePolicy),
+		SignalName:                          t.GetSignalName(),
+		SignalInput:                         t.GetSignalInput(),
+		Control:                             t.GetControl(),
+		RetryPolicy:                         FromRetryPolicy(t.RetryPolicy),
+		DelayStartSeconds:                   t.GetDelayStartSeconds(),
+	}
+}
+
+// ToSignalWithStartWorkflowExecutionRequest converts proto SignalWithStartWorkflowExecutionRequest type to thrift
+func ToSignalWithStartWorkflowExecutionRequest(p *apiv1.SignalWithStartWorkflowExecutionRequest) *shared.SignalWithStartWorkflowExecutionRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.SignalWithStartWorkflowExecutionRequest{
+		Domain:                              stringPtr(p.Domain),
+		WorkflowId:                          stringPtr(p.WorkflowId),
+		WorkflowType:                        ToWorkflowType(p.WorkflowType),
+		TaskList:                            ToTaskList(p.TaskList),
+		Input:                               p.Input,
+		ExecutionStartToCloseTimeoutSeconds: int32Ptr(p.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      int32Ptr(p.TaskStartToCloseTimeoutSeconds),
+		Identity:                            stringPtr(p.Identity),
+		RequestId:                           stringPtr(p.RequestId),
+		WorkflowIdReusePolicy:               ToWorkflowIDReusePolicy(p.WorkflowIdReusePolicy),
+		SignalName:                          stringPtr(p.SignalName),
+		SignalInput:                         p.SignalInput,
+		Control:                             p.Control,
+		RetryPolicy:                         ToRetryPolicy(p.RetryPolicy),
+		DelayStartSeconds:                   int32Ptr(p.DelayStartSeconds),
+	}
+}
+
+// FromTerminateWorkflowExecutionRequest converts thrift TerminateWorkflowExecutionRequest type to proto
+func FromTerminateWorkflowExecutionRequest(t *shared.TerminateWorkflowExecutionRequest) *apiv1.TerminateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TerminateWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.GetReason(),
+		Details:           t.GetDetails(),
+		Identity:          t.GetIdentity(),
+	}
+}
+
+// ToTerminateWorkflowExecutionRequest converts proto TerminateWorkflowExecutionRequest type to thrift
+func ToTerminateWorkflowExecutionRequest(p *apiv1.TerminateWorkflowExecutionRequest) *shared.TerminateWorkflowExecutionRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.TerminateWorkflowExecutionRequest{
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: ToWorkflowExecution(p.WorkflowExecution),
+		Reason:            stringPtr(p.Reason),
+		Details:           p.Details,
+		Identity:          stringPtr(p.Identity),
+	}
+}
+
+// FromPauseWorkflowExecutionRequest converts thrift PauseWorkflowExecutionRequest type to proto
+func FromPauseWorkflowExecutionRequest(t *shared.PauseWorkflowExecutionRequest) *apiv1.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PauseWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.GetReason(),
+		Identity:          t.GetIdentity(),
+	}
+}
+
+// ToPauseWorkflowExecutionRequest converts proto PauseWorkflowExecutionRequest type to thrift
+func ToPauseWorkflowExecutionRequest(p *apiv1.PauseWorkflowExecutionRequest) *shared.PauseWorkflowExecutionRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.PauseWorkflowExecutionRequest{
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: ToWorkflowExecution(p.WorkflowExecution),
+		Reason:            stringPtr(p.Reason),
+		Identity:          stringPtr(p.Identity),
+	}
+}
+
+// FromResumeWorkflowExecutionRequest converts thrift ResumeWorkflowExecutionRequest type to proto
+func FromResumeWorkflowExecutionRequest(t *shared.ResumeWorkflowExecutionRequest) *apiv1.ResumeWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ResumeWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.GetReason(),
+		Identity:          t.GetIdentity(),
+	}
+}
+
+// ToResumeWorkflowExecutionRequest converts proto ResumeWorkflowExecutionRequest type to thrift
+func ToResumeWorkflowExecutionRequest(p *apiv1.ResumeWorkflowExecutionRequest) *shared.ResumeWorkflowExecutionRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.ResumeWorkflowExecutionRequest{
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: ToWorkflowExecution(p.WorkflowExecution),
+		Reason:            stringPtr(p.Reason),
+		Identity:          stringPtr(p.Identity),
+	}
+}
+
+// FromRetryActivityTaskRequest converts thrift RetryActivityTaskRequest type to proto
+func FromRetryActivityTaskRequest(t *shared.RetryActivityTaskRequest) *apiv1.RetryActivityTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RetryActivityTaskRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityId:        t.GetActivityID(),
+		ResetAttempts:     t.GetResetAttempts(),
+		Reason:            t.GetReason(),
+		Identity:          t.GetIdentity(),
+	}
+}
+
+// ToRetryActivityTaskRequest converts proto RetryActivityTaskRequest type to thrift
+func ToRetryActivityTaskRequest(p *apiv1.RetryActivityTaskRequest) *shared.RetryActivityTaskRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RetryActivityTaskRequest{
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: ToWorkflowExecution(p.WorkflowExecution),
+		ActivityID:        stringPtr(p.ActivityId),
+		ResetAttempts:     common.BoolPtr(p.ResetAttempts),
+		Reason:            stringPtr(p.Reason),
+		Identity:          stringPtr(p.Identity),
+	}
+}
+
+// FromCompleteActivityTaskRequest converts thrift CompleteActivityTaskRequest type to proto
+func FromCompleteActivityTaskRequest(t *shared.CompleteActivityTaskRequest) *apiv1.CompleteActivityTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CompleteActivityTaskRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityId:        t.GetActivityID(),
+		Result:            t.GetResult(),
+		Reason:            t.GetReason(),
+		Identity:          t.GetIdentity(),
+	}
+}
+
+// ToCompleteActivityTaskRequest converts proto CompleteActivityTaskRequest type to thrift
+func ToCompleteActivityTaskRequest(p *apiv1.CompleteActivityTaskRequest) *shared.CompleteActivityTaskRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.CompleteActivityTaskRequest{
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: ToWorkflowExecution(p.WorkflowExecution),
+		ActivityID:        stringPtr(p.ActivityId),
+		Result:            p.Result,
+		Reason:            stringPtr(p.Reason),
+		Identity:          stringPtr(p.Identity),
+	}
+}
+
+// FromFailActivityTaskRequest converts thrift FailActivityTaskRequest type to proto
+func FromFailActivityTaskRequest(t *shared.FailActivityTaskRequest) *apiv1.FailActivityTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.FailActivityTaskRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityId:        t.GetActivityID(),
+		Reason:            t.GetReason(),
+		Details:           t.GetDetails(),
+		Identity:          t.GetIdentity(),
+	}
+}
+
+// ToFailActivityTaskRequest converts proto FailActivityTaskRequest type to thrift
+func ToFailActivityTaskRequest(p *apiv1.FailActivityTaskRequest) *shared.FailActivityTaskRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.FailActivityTaskRequest{
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: ToWorkflowExecution(p.WorkflowExecution),
+		ActivityID:        stringPtr(p.ActivityId),
+		Reason:            stringPtr(p.Reason),
+		Details:           p.Details,
+		Identity:          stringPtr(p.Identity),
+	}
+}
+
+// FromListOpenWorkflowExecutionsRequest converts thrift ListOpenWorkflowExecutionsRequest type to proto
+func FromListOpenWorkflowExecutionsRequest(t *shared.ListOpenWorkflowExecutionsRequest) *apiv1.ListOpenWorkflowExecutionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListOpenWorkflowExecutionsRequest{
+		Domain:          t.GetDomain(),
+		MaximumPageSize: t.GetMaximumPageSize(),
+		NextPageToken:   t.GetNextPageToken(),
+		StartTimeFilter: FromStartTimeFilter(t.StartTimeFilter),
+		ExecutionFilter: FromWorkflowExecutionFilter(t.ExecutionFilter),
+		TypeFilter:      FromWorkflowTypeFilter(t.TypeFilter),
+	}
+}
+
+// ToListOpenWorkflowExecutionsRequest converts proto ListOpenWorkflowExecutionsRequest type to thrift
+func ToListOpenWorkflowExecutionsRequest(p *apiv1.ListOpenWorkflowExecutionsRequest) *shared.ListOpenWorkflowExecutionsRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.ListOpenWorkflowExecutionsRequest{
+		Domain:          stringPtr(p.Domain),
+		MaximumPageSize: int32Ptr(p.MaximumPageSize),
+		NextPageToken:   p.NextPageToken,
+		StartTimeFilter: ToStartTimeFilter(p.StartTimeFilter),
+		ExecutionFilter: ToWorkflowExecutionFilter(p.ExecutionFilter),
+		TypeFilter:      ToWorkflowTypeFilter(p.TypeFilter),
+	}
+}
+
+// FromListOpenWorkflowExecutionsResponse converts thrift ListOpenWorkflowExecutionsResponse type to proto
+func FromListOpenWorkflowExecutionsResponse(t *shared.ListOpenWorkflowExecutionsResponse) *apiv1.ListOpenWorkflowExecutionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListOpenWorkflowExecutionsResponse{
+		Executions:    FromWorkflowExecutionInfoArray(t.Executions),
+		NextPageToken: t.GetNextPageToken(),
+	}
+}
+
+// ToListOpenWorkflowExecutionsResponse converts proto ListOpenWorkflowExecutionsResponse type to thrift
+func ToListOpenWorkflowExecutionsResponse(p *apiv1.ListOpenWorkflowExecutionsResponse) *shared.ListOpenWorkflowExecutionsResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.ListOpenWorkflowExecutionsResponse{
+		Executions:    ToWorkflowExecutionInfoArray(p.Executions),
+		NextPageToken: p.NextPageToken,
+	}
+}
+
+// FromListClosedWorkflowExecutionsRequest converts thrift ListClosedWorkflowExecutionsRequest type to proto
+func FromListClosedWorkflowExecutionsRequest(t *shared.ListClosedWorkflowExecutionsRequest) *apiv1.ListClosedWorkflowExecutionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListClosedWorkflowExecutionsRequest{
+		Domain:          t.GetDomain(),
+		MaximumPageSize: t.GetMaximumPageSize(),
+		NextPageToken:   t.GetNextPageToken(),
+		StartTimeFilter: FromStartTimeFilter(t.StartTimeFilter),
+		ExecutionFilter: FromWorkflowExecutionFilter(t.ExecutionFilter),
+		TypeFilter:      FromWorkflowTypeFilter(t.TypeFilter),
+		StatusFilter:    FromWorkflowExecutionCloseStatus(t.StatusFilter),
+	}
+}
+
+// ToListClosedWorkflowExecutionsRequest converts proto ListClosedWorkflowExecutionsRequest type to thrift
+func ToListClosedWorkflowExecutionsRequest(p *apiv1.ListClosedWorkflowExecutionsRequest) *shared.ListClosedWorkflowExecutionsRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.ListClosedWorkflowExecutionsRequest{
+		Domain:          stringPtr(p.Domain),
+		MaximumPageSize: int32Ptr(p.MaximumPageSize),
+		NextPageToken:   p.NextPageToken,
+		StartTimeFilter: ToStartTimeFilter(p.StartTimeFilter),
+		ExecutionFilter: ToWorkflowExecutionFilter(p.ExecutionFilter),
+		TypeFilter:      ToWorkflowTypeFilter(p.TypeFilter),
+		StatusFilter:    ToWorkflowExecutionCloseStatus(p.StatusFilter),
+	}
+}
+
+// FromListClosedWorkflowExecutionsResponse converts thrift ListClosedWorkflowExecutionsResponse type to proto
+func FromListClosedWorkflowExecutionsResponse(t *shared.ListClosedWorkflowExecutionsResponse) *apiv1.ListClosedWorkflowExecutionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListClosedWorkflowExecutionsResponse{
+		Executions:    FromWorkflowExecutionInfoArray(t.Executions),
+		NextPageToken: t.GetNextPageToken(),
+	}
+}
+
+// ToListClosedWorkflowExecutionsResponse converts proto ListClosedWorkflowExecutionsResponse type to thrift
+func ToListClosedWorkflowExecutionsResponse(p *apiv1.ListClosedWorkflowExecutionsResponse) *shared.ListClosedWorkflowExecutionsResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.ListClosedWorkflowExecutionsResponse{
+		Executions:    ToWorkflowExecutionInfoArray(p.Executions),
+		NextPageToken: p.NextPageToken,
+	}
+}
+
+// FromQueryWorkflowRequest converts thrift QueryWorkflowRequest type to proto
+func FromQueryWorkflowRequest(t *shared.QueryWorkflowRequest) *apiv1.QueryWorkflowRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.QueryWorkflowRequest{
+		Domain:    t.GetDomain(),
+		Execution: FromWorkflowExecution(t.Execution),
+		Query:     FromWorkflowQuery(t.Query),
+	}
+}
+
+// ToQueryWorkflowRequest converts proto QueryWorkflowRequest type to thrift
+func ToQueryWorkflowRequest(p *apiv1.QueryWorkflowRequest) *shared.QueryWorkflowRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.QueryWorkflowRequest{
+		Domain:    stringPtr(p.Domain),
+		Execution: ToWorkflowExecution(p.Execution),
+		Query:     ToWorkflowQuery(p.Query),
+	}
+}
+
+// FromQueryWorkflowResponse converts thrift QueryWorkflowResponse type to proto
+func FromQueryWorkflowResponse(t *shared.QueryWorkflowResponse) *apiv1.QueryWorkflowResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.QueryWorkflowResponse{
+		QueryResult: t.GetQueryResult(),
+	}
+}
+
+// ToQueryWorkflowResponse converts proto QueryWorkflowResponse type to thrift
+func ToQueryWorkflowResponse(p *apiv1.QueryWorkflowResponse) *shared.QueryWorkflowResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.QueryWorkflowResponse{
+		QueryResult: p.QueryResult,
+	}
+}
+
+// FromWorkflowQuery converts thrift WorkflowQuery type to proto
+func FromWorkflowQuery(t *shared.WorkflowQuery) *apiv1.WorkflowQuery {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowQuery{
+		QueryType: t.GetQueryType(),
+		QueryArgs: t.GetQueryArgs(),
+	}
+}
+
+// ToWorkflowQuery converts proto WorkflowQuery type to thrift
+func ToWorkflowQuery(p *apiv1.WorkflowQuery) *shared.WorkflowQuery {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowQuery{
+		QueryType: stringPtr(p.QueryType),
+		QueryArgs: p.QueryArgs,
+	}
+}
+
+// FromResetStickyTaskListRequest converts thrift ResetStickyTaskListRequest type to proto
+func FromResetStickyTaskListRequest(t *shared.ResetStickyTaskListRequest) *apiv1.ResetStickyTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ResetStickyTaskListRequest{
+		Domain:    t.GetDomain(),
+		Execution: FromWorkflowExecution(t.Execution),
+	}
+}
+
+// ToResetStickyTaskListRequest converts proto ResetStickyTaskListRequest type to thrift
+func ToResetStickyTaskListRequest(p *apiv1.ResetStickyTaskListRequest) *shared.ResetStickyTaskListRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.ResetStickyTaskListRequest{
+		Domain:    stringPtr(p.Domain),
+		Execution: ToWorkflowExecution(p.Execution),
+	}
+}
+
+// FromResetStickyTaskListResponse converts thrift ResetStickyTaskListResponse type to proto
+func FromResetStickyTaskListResponse(t *shared.ResetStickyTaskListResponse) *apiv1.ResetStickyTaskListResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ResetStickyTaskListResponse{}
+}
+
+// ToResetStickyTaskListResponse converts proto ResetStickyTaskListResponse type to thrift
+func ToResetStickyTaskListResponse(p *apiv1.ResetStickyTaskListResponse) *shared.ResetStickyTaskListResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.ResetStickyTaskListResponse{}
+}
+
+// FromRespondQueryTaskCompletedRequest converts thrift RespondQueryTaskCompletedRequest type to proto
+func FromRespondQueryTaskCompletedRequest(t *shared.RespondQueryTaskCompletedRequest) *apiv1.RespondQueryTaskCompletedRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondQueryTaskCompletedRequest{
+		TaskToken:     t.GetTaskToken(),
+		CompletedType: FromQueryTaskCompletedType(t.CompletedType),
+		QueryResult:   t.GetQueryResult(),
+		ErrorMessage:  t.GetErrorMessage(),
+	}
+}
+
+// ToRespondQueryTaskCompletedRequest converts proto RespondQueryTaskCompletedRequest type to thrift
+func ToRespondQueryTaskCompletedRequest(p *apiv1.RespondQueryTaskCompletedRequest) *shared.RespondQueryTaskCompletedRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RespondQueryTaskCompletedRequest{
+		TaskToken:     p.TaskToken,
+		CompletedType: ToQueryTaskCompletedType(p.CompletedType),
+		QueryResult:   p.QueryResult,
+		ErrorMessage:  stringPtr(p.ErrorMessage),
+	}
+}
+
+// FromDescribeWorkflowExecutionRequest converts thrift DescribeWorkflowExecutionRequest type to proto
+func FromDescribeWorkflowExecutionRequest(t *shared.DescribeWorkflowExecutionRequest) *apiv1.DescribeWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeWorkflowExecutionRequest{
+		Domain:    t.GetDomain(),
+		Execution: FromWorkflowExecution(t.Execution),
+	}
+}
+
+// ToDescribeWorkflowExecutionRequest converts proto DescribeWorkflowExecutionRequest type to thrift
+func ToDescribeWorkflowExecutionRequest(p *apiv1.DescribeWorkflowExecutionRequest) *shared.DescribeWorkflowExecutionRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.DescribeWorkflowExecutionRequest{
+		Domain:    stringPtr(p.Domain),
+		Execution: ToWorkflowExecution(p.Execution),
+	}
+}
+
+// FromPendingActivityInfo converts thrift PendingActivityInfo type to proto
+func FromPendingActivityInfo(t *shared.PendingActivityInfo) *apiv1.PendingActivityInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PendingActivityInfo{
+		ActivityId:             t.GetActivityID(),
+		ActivityType:           FromActivityType(t.ActivityType),
+		State:                  FromPendingActivityState(t.State),
+		HeartbeatDetails:       t.GetHeartbeatDetails(),
+		LastHeartbeatTimestamp: t.GetLastHeartbeatTimestamp(),
+	}
+}
+
+// ToPendingActivityInfo converts proto PendingActivityInfo type to thrift
+func ToPendingActivityInfo(p *apiv1.PendingActivityInfo) *shared.PendingActivityInfo {
+	if p == nil {
+		return nil
+	}
+	return &shared.PendingActivityInfo{
+		ActivityID:             stringPtr(p.ActivityId),
+		ActivityType:           ToActivityType(p.ActivityType),
+		State:                  ToPendingActivityState(p.State),
+		HeartbeatDetails:       p.HeartbeatDetails,
+		LastHeartbeatTimestamp: int64Ptr(p.LastHeartbeatTimestamp),
+	}
+}
+
+// FromDescribeWorkflowExecutionResponse converts thrift DescribeWorkflowExecutionResponse type to proto
+func FromDescribeWorkflowExecutionResponse(t *shared.DescribeWorkflowExecutionResponse) *apiv1.DescribeWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeWorkflowExecutionResponse{
+		ExecutionConfiguration: FromWorkflowExecutionConfiguration(t.ExecutionConfiguration),
+		WorkflowExecutionInfo:  FromWorkflowExecutionInfo(t.WorkflowExecutionInfo),
+		PendingActivities:      FromPendingActivityInfoArray(t.PendingActivities),
+		Paused:                 t.GetPaused(),
+	}
+}
+
+// ToDescribeWorkflowExecutionResponse converts proto DescribeWorkflowExecutionResponse type to thrift
+func ToDescribeWorkflowExecutionResponse(p *apiv1.DescribeWorkflowExecutionResponse) *shared.DescribeWorkflowExecutionResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.DescribeWorkflowExecutionResponse{
+		ExecutionConfiguration: ToWorkflowExecutionConfiguration(p.ExecutionConfiguration),
+		WorkflowExecutionInfo:  ToWorkflowExecutionInfo(p.WorkflowExecutionInfo),
+		PendingActivities:      ToPendingActivityInfoArray(p.PendingActivities),
+		Paused:                 common.BoolPtr(p.Paused),
+	}
+}
+
+// FromDescribeTaskListRequest converts thrift DescribeTaskListRequest type to proto
+func FromDescribeTaskListRequest(t *shared.DescribeTaskListRequest) *apiv1.DescribeTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeTaskListRequest{
+		Domain:       t.GetDomain(),
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+	}
+}
+
+// ToDescribeTaskListRequest converts proto DescribeTaskListRequest type to thrift
+func ToDescribeTaskListRequest(p *apiv1.DescribeTaskListRequest) *shared.DescribeTaskListRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.DescribeTaskListRequest{
+		Domain:       stringPtr(p.Domain),
+		TaskList:     ToTaskList(p.TaskList),
+		TaskListType: ToTaskListType(p.TaskListType),
+	}
+}
+
+// FromDescribeTaskListResponse converts thrift DescribeTaskListResponse type to proto
+func FromDescribeTaskListResponse(t *shared.DescribeTaskListResponse) *apiv1.DescribeTaskListResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeTaskListResponse{
+		Pollers: FromPollerInfoArray(t.Pollers),
+	}
+}
+
+// ToDescribeTaskListResponse converts proto DescribeTaskListResponse type to thrift
+func ToDescribeTaskListResponse(p *apiv1.DescribeTaskListResponse) *shared.DescribeTaskListResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.DescribeTaskListResponse{
+		Pollers: ToPollerInfoArray(p.Pollers),
+	}
+}
+
+// FromTaskListType converts thrift TaskListType type to proto
+func FromTaskListType(t *shared.TaskListType) apiv1.TaskListType {
+	if t == nil {
+		return apiv1.TASK_LIST_TYPE_INVALID
+	}
+	switch *t {
+	case shared.TaskListTypeDecision:
+		return apiv1.TASK_LIST_TYPE_DECISION
+	case shared.TaskListTypeActivity:
+		return apiv1.TASK_LIST_TYPE_ACTIVITY
+	}
+	return apiv1.TASK_LIST_TYPE_INVALID
+}
+
+// ToTaskListType converts proto TaskListType type to thrift
+func ToTaskListType(p apiv1.TaskListType) *shared.TaskListType {
+	switch p {
+	case apiv1.TASK_LIST_TYPE_DECISION:
+		return shared.TaskListTypeDecision.Ptr()
+	case apiv1.TASK_LIST_TYPE_ACTIVITY:
+		return shared.TaskListTypeActivity.Ptr()
+	}
+	return nil
+}
+
+// FromPollerInfo converts thrift PollerInfo type to proto
+func FromPollerInfo(t *shared.PollerInfo) *apiv1.PollerInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PollerInfo{
+		LastAccessTime: t.GetLastAccessTime(),
+		Identity:       t.GetIdentity(),
+	}
+}
+
+// ToPollerInfo converts proto PollerInfo type to thrift
+func ToPollerInfo(p *apiv1.PollerInfo) *shared.PollerInfo {
+	if p == nil {
+		return nil
+	}
+	return &shared.PollerInfo{
+		LastAccessTime: int64Ptr(p.LastAccessTime),
+		Identity:       stringPtr(p.Identity),
+	}
+}
+
+// FromRetryPolicy converts thrift RetryPolicy type to proto
+func FromRetryPolicy(t *shared.RetryPolicy) *apiv1.RetryPolicy {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RetryPolicy{
+		InitialIntervalInSeconds:    t.GetInitialIntervalInSeconds(),
+		BackoffCoefficient:          t.GetBackoffCoefficient(),
+		MaximumIntervalInSeconds:    t.GetMaximumIntervalInSeconds(),
+		MaximumAttempts:             t.GetMaximumAttempts(),
+		NonRetriableErrorReasons:    t.NonRetriableErrorReasons,
+		ExpirationIntervalInSeconds: t.GetExpirationIntervalInSeconds(),
+	}
+}
+
+// ToRetryPolicy converts proto RetryPolicy type to thrift
+func ToRetryPolicy(p *apiv1.RetryPolicy) *shared.RetryPolicy {
+	if p == nil {
+		return nil
+	}
+	return &shared.RetryPolicy{
+		InitialIntervalInSeconds:    int32Ptr(p.InitialIntervalInSeconds),
+		BackoffCoefficient:          float64Ptr(p.BackoffCoefficient),
+		MaximumIntervalInSeconds:    int32Ptr(p.MaximumIntervalInSeconds),
+		MaximumAttempts:             int32Ptr(p.MaximumAttempts),
+		NonRetriableErrorReasons:    p.NonRetriableErrorReasons,
+		ExpirationIntervalInSeconds: int32Ptr(p.ExpirationIntervalInSeconds),
+	}
+}
+
+// FromClusterReplicationConfigurationArray converts thrift ClusterReplicationConfiguration list to proto
+func FromClusterReplicationConfigurationArray(t []*shared.ClusterReplicationConfiguration) []*apiv1.ClusterReplicationConfiguration {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.ClusterReplicationConfiguration, len(t))
+	for i := range t {
+		v[i] = FromClusterReplicationConfiguration(t[i])
+	}
+	return v
+}
+
+// ToClusterReplicationConfigurationArray converts proto ClusterReplicationConfiguration list to thrift
+func ToClusterReplicationConfigurationArray(p []*apiv1.ClusterReplicationConfiguration) []*shared.ClusterReplicationConfiguration {
+	if p == nil {
+		return nil
+	}
+	v := make([]*shared.ClusterReplicationConfiguration, len(p))
+	for i := range p {
+		v[i] = ToClusterReplicationConfiguration(p[i])
+	}
+	return v
+}
+
+// FromDataBlobArray converts thrift DataBlob list to proto
+func FromDataBlobArray(t []*shared.DataBlob) []*apiv1.DataBlob {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.DataBlob, len(t))
+	for i := range t {
+		v[i] = FromDataBlob(t[i])
+	}
+	return v
+}
+
+// ToDataBlobArray converts proto DataBlob list to thrift
+func ToDataBlobArray(p []*apiv1.DataBlob) []*shared.DataBlob {
+	if p == nil {
+		return nil
+	}
+	v := make([]*shared.DataBlob, len(p))
+	for i := range p {
+		v[i] = ToDataBlob(p[i])
+	}
+	return v
+}
+
+// FromDecisionArray converts thrift Decision list to proto
+func FromDecisionArray(t []*shared.Decision) []*apiv1.Decision {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.Decision, len(t))
+	for i := range t {
+		v[i] = FromDecision(t[i])
+	}
+	return v
+}
+
+// ToDecisionArray converts proto Decision list to thrift
+func ToDecisionArray(p []*apiv1.Decision) []*shared.Decision {
+	if p == nil {
+		return nil
+	}
+	v := make([]*shared.Decision, len(p))
+	for i := range p {
+		v[i] = ToDecision(p[i])
+	}
+	return v
+}
+
+// FromDescribeDomainResponseArray converts thrift DescribeDomainResponse list to proto
+func FromDescribeDomainResponseArray(t []*shared.DescribeDomainResponse) []*apiv1.DescribeDomainResponse {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.DescribeDomainResponse, len(t))
+	for i := range t {
+		v[i] = FromDescribeDomainResponse(t[i])
+	}
+	return v
+}
+
+// ToDescribeDomainResponseArray converts proto DescribeDomainResponse list to thrift
+func ToDescribeDomainResponseArray(p []*apiv1.DescribeDomainResponse) []*shared.DescribeDomainResponse {
+	if p == nil {
+		return nil
+	}
+	v := make([]*shared.DescribeDomainResponse, len(p))
+	for i := range p {
+		v[i] = ToDescribeDomainResponse(p[i])
+	}
+	return v
+}
+
+// FromHistoryEventArray converts thrift HistoryEvent list to proto
+func FromHistoryEventArray(t []*shared.HistoryEvent) []*apiv1.HistoryEvent {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.HistoryEvent, len(t))
+	for i := range t {
+		v[i] = FromHistoryEvent(t[i])
+	}
+	return v
+}
+
+// ToHistoryEventArray converts proto HistoryEvent list to thrift
+func ToHistoryEventArray(p []*apiv1.HistoryEvent) []*shared.HistoryEvent {
+	if p == nil {
+		return nil
+	}
+	v := make([]*shared.HistoryEvent, len(p))
+	for i := range p {
+		v[i] = ToHistoryEvent(p[i])
+	}
+	return v
+}
+
+// FromPendingActivityInfoArray converts thrift PendingActivityInfo list to proto
+func FromPendingActivityInfoArray(t []*shared.PendingActivityInfo) []*apiv1.PendingActivityInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.PendingActivityInfo, len(t))
+	for i := range t {
+		v[i] = FromPendingActivityInfo(t[i])
+	}
+	return v
+}
+
+// ToPendingActivityInfoArray converts proto PendingActivityInfo list to thrift
+func ToPendingActivityInfoArray(p []*apiv1.PendingActivityInfo) []*shared.PendingActivityInfo {
+	if p == nil {
+		return nil
+	}
+	v := make([]*shared.PendingActivityInfo, len(p))
+	for i := range p {
+		v[i] = ToPendingActivityInfo(p[i])
+	}
+	return v
+}
+
+// FromPollerInfoArray converts thrift PollerInfo list to proto
+func FromPollerInfoArray(t []*shared.PollerInfo) []*apiv1.PollerInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.PollerInfo, len(t))
+	for i := range t {
+		v[i] = FromPollerInfo(t[i])
+	}
+	return v
+}
+
+// ToPollerInfoArray converts proto PollerInfo list to thrift
+func ToPollerInfoArray(p []*apiv1.PollerInfo) []*shared.PollerInfo {
+	if p == nil {
+		return nil
+	}
+	v := make([]*shared.PollerInfo, len(p))
+	for i := range p {
+		v[i] = ToPollerInfo(p[i])
+	}
+	return v
+}
+
+// FromWorkflowExecutionInfoArray converts thrift WorkflowExecutionInfo list to proto
+func FromWorkflowExecutionInfoArray(t []*shared.WorkflowExecutionInfo) []*apiv1.WorkflowExecutionInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.WorkflowExecutionInfo, len(t))
+	for i := range t {
+		v[i] = FromWorkflowExecutionInfo(t[i])
+	}
+	return v
+}
+
+// ToWorkflowExecutionInfoArray converts proto WorkflowExecutionInfo list to thrift
+func ToWorkflowExecutionInfoArray(p []*apiv1.WorkflowExecutionInfo) []*shared.WorkflowExecutionInfo {
+	if p == nil {
+		return nil
+	}
+	v := make([]*shared.WorkflowExecutionInfo, len(p))
+	for i := range p {
+		v[i] = ToWorkflowExecutionInfo(p[i])
+	}
+	return v
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package proto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
+	"github.com/uber/cadence/common"
+	"go.uber.org/yarpc/yarpcerrors"
+)
+
+type (
+	mapperSuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+)
+
+func TestMapperSuite(t *testing.T) {
+	suite.Run(t, new(mapperSuite))
+}
+
+func (s *mapperSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *mapperSuite) TestStartWorkflowExecutionRequest() {
+	request := &shared.StartWorkflowExecutionRequest{
+		Domain:                              common.StringPtr("test-domain"),
+		WorkflowId:                          common.StringPtr("test-workflow-id"),
+		WorkflowType:                        &shared.WorkflowType{Name: common.StringPtr("test-workflow-type")},
+		TaskList:                            &shared.TaskList{Name: common.StringPtr("test-task-list"), Kind: shared.TaskListKindNormal.Ptr()},
+		Input:                               []byte("test-input"),
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+		Identity:                            common.StringPtr("test-identity"),
+		RequestId:                           common.StringPtr("test-request-id"),
+		WorkflowIdReusePolicy:               shared.WorkflowIdReusePolicyRejectDuplicate.Ptr(),
+		RetryPolicy: &shared.RetryPolicy{
+			InitialIntervalInSeconds:    common.Int32Ptr(1),
+			BackoffCoefficient:          common.Float64Ptr(2),
+			MaximumAttempts:             common.Int32Ptr(3),
+			NonRetriableErrorReasons:    []string{"test-reason"},
+			ExpirationIntervalInSeconds: common.Int32Ptr(60),
+		},
+	}
+
+	p := FromStartWorkflowExecutionRequest(request)
+	s.Equal("test-workflow-id", p.WorkflowId)
+	s.Equal(apiv1.TASK_LIST_KIND_NORMAL, p.TaskList.Kind)
+	s.Equal(apiv1.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE, p.WorkflowIdReusePolicy)
+	s.Equal(request, ToStartWorkflowExecutionRequest(p))
+}
+
+func (s *mapperSuite) TestHistory() {
+	history := &shared.History{
+		Events: []*shared.HistoryEvent{
+			{
+				EventId:   common.Int64Ptr(1),
+				Timestamp: common.Int64Ptr(1000),
+				EventType: shared.EventTypeWorkflowExecutionStarted.Ptr(),
+				Version:   common.Int64Ptr(12),
+				WorkflowExecutionStartedEventAttributes: &shared.WorkflowExecutionStartedEventAttributes{
+					WorkflowType: &shared.WorkflowType{Name: common.StringPtr("test-workflow-type")},
+					Input:        []byte("test-input"),
+				},
+			},
+			{
+				EventId:   common.Int64Ptr(2),
+				Timestamp: common.Int64Ptr(2000),
+				EventType: shared.EventTypeActivityTaskTimedOut.Ptr(),
+				ActivityTaskTimedOutEventAttributes: &shared.ActivityTaskTimedOutEventAttributes{
+					ScheduledEventId: common.Int64Ptr(5),
+					StartedEventId:   common.Int64Ptr(6),
+					TimeoutType:      shared.TimeoutTypeHeartbeat.Ptr(),
+				},
+			},
+		},
+	}
+
+	p := FromHistory(history)
+	s.Len(p.Events, 2)
+	s.Equal(apiv1.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED, p.Events[0].EventType)
+	s.Equal(apiv1.TIMEOUT_TYPE_HEARTBEAT, p.Events[1].ActivityTaskTimedOutEventAttributes.TimeoutType)
+	s.Equal(history, ToHistory(p))
+}
+
+func (s *mapperSuite) TestZeroValues() {
+	request := ToDescribeWorkflowExecutionRequest(&apiv1.DescribeWorkflowExecutionRequest{
+		Domain:    "test-domain",
+		Execution: &apiv1.WorkflowExecution{WorkflowId: "test-workflow-id"},
+	})
+	s.Equal("test-domain", request.GetDomain())
+	s.Equal("test-workflow-id", request.Execution.GetWorkflowId())
+	s.Nil(request.Execution.RunId)
+
+	config := ToDomainConfiguration(&apiv1.DomainConfiguration{})
+	s.Nil(config.WorkflowExecutionRetentionPeriodInDays)
+	s.NotNil(config.EmitMetric)
+	s.False(config.GetEmitMetric())
+
+	s.Nil(ToTaskListKind(apiv1.TASK_LIST_KIND_INVALID))
+	s.Equal(apiv1.TASK_LIST_KIND_INVALID, FromTaskListKind(nil))
+	s.Nil(ToWorkflowExecution(nil))
+	s.Nil(FromWorkflowExecution(nil))
+}
+
+func (s *mapperSuite) TestFromError() {
+	s.Nil(FromError(nil))
+	s.Equal(yarpcerrors.CodeInvalidArgument, yarpcerrors.FromError(FromError(&shared.BadRequestError{Message: "bad"})).Code())
+	s.Equal(yarpcerrors.CodeNotFound, yarpcerrors.FromError(FromError(&shared.EntityNotExistsError{Message: "missing"})).Code())
+	s.Equal(yarpcerrors.CodeAlreadyExists, yarpcerrors.FromError(FromError(&shared.WorkflowExecutionAlreadyStartedError{Message: common.StringPtr("started")})).Code())
+	s.Equal(yarpcerrors.CodeResourceExhausted, yarpcerrors.FromError(FromError(&shared.ServiceBusyError{Message: "busy"})).Code())
+	s.Equal("busy", yarpcerrors.FromError(FromError(&shared.ServiceBusyError{Message: "busy"})).Message())
+
+	status := yarpcerrors.DeadlineExceededErrorf("timeout")
+	s.Equal(status, FromError(status))
+}
@@ -22,6 +22,7 @@ package common
 
 import (
 	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
 	"golang.org/x/net/context"
 )
 
@@ -43,6 +44,20 @@ const (
 	// ClientImplHeaderName refers to the name of the
 	// header that contains the client implementation
 	ClientImplHeaderName = "cadence-client-name"
+
+	// CallerIdentityHeaderName refers to the name of the
+	// header that contains the identity of the caller
+	CallerIdentityHeaderName = "cadence-caller-identity"
+
+	// ServicePrincipal is the caller identity of the calls
+	// made by the cadence services themselves
+	ServicePrincipal = "cadence-service"
+
+	// ServiceTokenHeaderName refers to the name of the
+	// header that contains the token shared by the cadence
+	// services, authenticating the calls made with the
+	// service principal
+	ServiceTokenHeaderName = "cadence-service-token"
 )
 
 type (
@@ -51,8 +66,28 @@ type (
 		CreateDispatcher() *yarpc.Dispatcher
 		CreateDispatcherForOutbound(callerName, serviceName, hostName string) *yarpc.Dispatcher
 	}
+
+	// ServicePrincipalOutboundMiddleware sets the service principal as the caller identity of the
+	// outbound calls made by the cadence services themselves, along with the service token
+	// authenticating it. The calls handling an inbound call are left as is, so the service
+	// principal is never granted to the inbound callers.
+	ServicePrincipalOutboundMiddleware struct {
+		Token string
+	}
 )
 
+// Call implements the yarpc unary outbound middleware
+func (m *ServicePrincipalOutboundMiddleware) Call(ctx context.Context, request *transport.Request,
+	out transport.UnaryOutbound) (*transport.Response, error) {
+	if _, ok := request.Headers.Get(CallerIdentityHeaderName); !ok && yarpc.CallFromContext(ctx) == nil {
+		request.Headers = request.Headers.With(CallerIdentityHeaderName, ServicePrincipal)
+		if m.Token != "" {
+			request.Headers = request.Headers.With(ServiceTokenHeaderName, m.Token)
+		}
+	}
+	return out.Call(ctx, request)
+}
+
 // AggregateYarpcOptions aggregate the header information from context to existing yarpc call options
 func AggregateYarpcOptions(ctx context.Context, opts ...yarpc.CallOption) []yarpc.CallOption {
 	var result []yarpc.CallOption
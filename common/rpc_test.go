@@ -0,0 +1,86 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/yarpc/api/encoding"
+	"go.uber.org/yarpc/api/transport"
+)
+
+type (
+	RPCSuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+
+	recordingOutbound struct {
+		transport.UnaryOutbound
+		request *transport.Request
+	}
+)
+
+func TestRPCSuite(t *testing.T) {
+	suite.Run(t, new(RPCSuite))
+}
+
+func (s *RPCSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *RPCSuite) TestServicePrincipalOutboundMiddleware() {
+	middleware := &ServicePrincipalOutboundMiddleware{Token: "secret"}
+	out := &recordingOutbound{}
+
+	_, err := middleware.Call(context.Background(), &transport.Request{Headers: transport.NewHeaders()}, out)
+	s.NoError(err)
+	s.assertHeader(out.request, CallerIdentityHeaderName, ServicePrincipal)
+	s.assertHeader(out.request, ServiceTokenHeaderName, "secret")
+
+	// the identity set by the caller is kept
+	headers := transport.NewHeaders().With(CallerIdentityHeaderName, "worker")
+	_, err = middleware.Call(context.Background(), &transport.Request{Headers: headers}, out)
+	s.NoError(err)
+	s.assertHeader(out.request, CallerIdentityHeaderName, "worker")
+	s.assertHeader(out.request, ServiceTokenHeaderName, "")
+
+	// the calls handling an inbound call are not made with the service principal
+	ctx, call := encoding.NewInboundCall(context.Background())
+	s.NoError(call.ReadFromRequest(&transport.Request{Headers: transport.NewHeaders()}))
+	_, err = middleware.Call(ctx, &transport.Request{Headers: transport.NewHeaders()}, out)
+	s.NoError(err)
+	s.assertHeader(out.request, CallerIdentityHeaderName, "")
+	s.assertHeader(out.request, ServiceTokenHeaderName, "")
+}
+
+func (s *RPCSuite) assertHeader(request *transport.Request, name string, expected string) {
+	value, _ := request.Headers.Get(name)
+	s.Equal(expected, value, "header: %v", name)
+}
+
+func (o *recordingOutbound) Call(ctx context.Context, request *transport.Request) (*transport.Response, error) {
+	o.request = request
+	return &transport.Response{}, nil
+}
@@ -30,6 +30,13 @@ import (
 	"github.com/uber/ringpop-go/discovery"
 )
 
+const (
+	// ReplicationConsumerTypeKafka means the replication tasks are published to kafka and consumed from there
+	ReplicationConsumerTypeKafka = "kafka"
+	// ReplicationConsumerTypeRPC means the replication tasks are pulled by the remote clusters over rpc
+	ReplicationConsumerTypeRPC = "rpc"
+)
+
 type (
 	// Config contains the configuration for a set of cadence services
 	Config struct {
@@ -45,6 +52,34 @@ type (
 		Services map[string]Service `yaml:"services"`
 		// Kafka is the config for connecting to kafka
 		Kafka messaging.KafkaConfig `yaml:"kafka"`
+		// Authorization is the config for authorizing the frontend api calls
+		Authorization Authorization `yaml:"authorization"`
+	}
+
+	// Authorization contains the config for authorizing the frontend api calls,
+	// all calls are allowed when no authorizer is configured
+	Authorization struct {
+		// RBAC is the config for the role based access control authorizer
+		RBAC *RBAC `yaml:"rbac"`
+		// TrustCallerIdentityHeader acknowledges that the caller identity is read from the
+		// cadence-caller-identity header, which is asserted by the caller itself. It must only be
+		// set when the frontend is reachable from a trusted network or through a proxy which
+		// authenticates the callers and sets the header, and is required by the RBAC authorizer
+		TrustCallerIdentityHeader bool `yaml:"trustCallerIdentityHeader"`
+		// ServiceToken is the secret shared by the cadence services of all the clusters. The calls made by
+		// the services carry the cadence-service identity along with the token in the cadence-service-token
+		// header, and only the calls carrying the token are granted the cadence-service principal, which
+		// is allowed all the apis. It is required by the RBAC authorizer
+		ServiceToken string `yaml:"serviceToken"`
+	}
+
+	// RBAC contains the config for the role based access control authorizer
+	RBAC struct {
+		// Principals is a map of caller identity to the roles granted to it, the roles
+		// are keyed by domain name and are one of reader, writer or admin. The role granted
+		// on the "*" domain applies to all domains as well as to the apis not targeting a domain.
+		// The cadence-service principal is reserved for the calls authenticated by the service token
+		Principals map[string]map[string]string `yaml:"principals"`
 	}
 
 	// Service contains the service specific config items
@@ -55,6 +90,8 @@ type (
 		Metrics Metrics `yaml:"metrics"`
 		// PProf is the PProf configuration
 		PProf PProf `yaml:"pprof"`
+		// HTTP is the json over http gateway configuration, only used by the frontend
+		HTTP HTTP `yaml:"http"`
 	}
 
 	// PProf contains the rpc config items
@@ -63,10 +100,25 @@ type (
 		Port int `yaml:"port"`
 	}
 
+	// HTTP contains the config items of the json over http gateway. The gateway does not authenticate
+	// the callers, the caller identity is read from the Cadence-Caller-Identity header set by the caller
+	// itself, so it must only be exposed to a trusted network or behind a proxy authenticating the
+	// callers and setting the header, as required by Authorization.TrustCallerIdentityHeader
+	HTTP struct {
+		// Port is the port on which the gateway will bind to, the gateway
+		// is not started when it is not set
+		Port int `yaml:"port"`
+		// BindOnLocalHost is true if localhost is the bind address
+		BindOnLocalHost bool `yaml:"bindOnLocalHost"`
+	}
+
 	// RPC contains the rpc config items
 	RPC struct {
 		// Port is the port  on which the channel will bind to
 		Port int `yaml:"port"`
+		// GRPCPort is the port on which the gRPC inbound will bind to, gRPC is
+		// not served when it is not set
+		GRPCPort int `yaml:"grpcPort"`
 		// BindOnLocalHost is true if localhost is the bind address
 		BindOnLocalHost bool `yaml:"bindOnLocalHost"`
 		// DisableLogging disables all logging for rpc
@@ -107,6 +159,9 @@ type (
 		DataStores map[string]DataStore `yaml:"datastores"`
 		// SamplingConfig is config for visibility sampling
 		SamplingConfig SamplingConfig
+		// DomainMaxQPS is the max QPS of a single domain, the requests of all the
+		// domains are also limited by the datastore MaxQPS
+		DomainMaxQPS dynamicconfig.IntPropertyFnWithDomainIDFilter `yaml:"-"`
 	}
 
 	// DataStore is the configuration for a single datastore
@@ -197,6 +252,20 @@ type (
 		CurrentClusterName string `yaml:"currentClusterName"`
 		// ClusterInitialFailoverVersions contains all cluster names to corresponding initial failover version
 		ClusterInitialFailoverVersions map[string]int64 `yaml:"clusterInitialFailoverVersion"`
+		// ReplicationConsumers contains cluster names to how the replication tasks generated by that cluster
+		// are consumed by the other clusters, clusters not listed here replicate through kafka
+		ReplicationConsumers map[string]ReplicationConsumerConfig `yaml:"replicationConsumers"`
+	}
+
+	// ReplicationConsumerConfig describes how the replication tasks generated by a cluster are consumed
+	ReplicationConsumerConfig struct {
+		// Type is the replication consumer type, either kafka or rpc
+		Type string `yaml:"type"`
+		// RPCName is the frontend service name of the cluster, used when pulling replication tasks over rpc
+		RPCName string `yaml:"rpcName"`
+		// RPCAddress is the frontend address (host:port) of the cluster, used when pulling replication tasks over rpc
+		// and, for either consumer type, when resending the history events missing on the current cluster
+		RPCAddress string `yaml:"rpcAddress"`
 	}
 
 	// Metrics contains the config items for metrics subsystem
@@ -25,7 +25,9 @@ import (
 	"net"
 
 	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common"
 	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/transport/grpc"
 	"go.uber.org/yarpc/transport/tchannel"
 )
 
@@ -34,17 +36,20 @@ type RPCFactory struct {
 	config      *RPC
 	serviceName string
 	ch          *tchannel.ChannelTransport
-	logger      bark.Logger
+	// serviceToken authenticates the outbound calls made with the service principal
+	serviceToken string
+	logger       bark.Logger
 }
 
 // NewFactory builds a new RPCFactory
-// conforming to the underlying configuration
-func (cfg *RPC) NewFactory(sName string, logger bark.Logger) *RPCFactory {
-	return newRPCFactory(cfg, sName, logger)
+// conforming to the underlying configuration, serviceToken
+// is the authorization.serviceToken shared by the services
+func (cfg *RPC) NewFactory(sName string, serviceToken string, logger bark.Logger) *RPCFactory {
+	return newRPCFactory(cfg, sName, serviceToken, logger)
 }
 
-func newRPCFactory(cfg *RPC, sName string, logger bark.Logger) *RPCFactory {
-	factory := &RPCFactory{config: cfg, serviceName: sName, logger: logger}
+func newRPCFactory(cfg *RPC, sName string, serviceToken string, logger bark.Logger) *RPCFactory {
+	factory := &RPCFactory{config: cfg, serviceName: sName, serviceToken: serviceToken, logger: logger}
 	return factory
 }
 
@@ -61,12 +66,32 @@ func (d *RPCFactory) CreateDispatcher() *yarpc.Dispatcher {
 	}
 	d.logger.Infof("Created RPC dispatcher for '%v' and listening at '%v'",
 		d.serviceName, hostAddress)
+	inbounds := yarpc.Inbounds{d.ch.NewInbound()}
+	if d.config.GRPCPort != 0 {
+		inbounds = append(inbounds, d.createGRPCInbound())
+	}
 	return yarpc.NewDispatcher(yarpc.Config{
 		Name:     d.serviceName,
-		Inbounds: yarpc.Inbounds{d.ch.NewInbound()},
+		Inbounds: inbounds,
+		OutboundMiddleware: yarpc.OutboundMiddleware{
+			Unary: &common.ServicePrincipalOutboundMiddleware{Token: d.serviceToken},
+		},
 	})
 }
 
+// createGRPCInbound creates a gRPC inbound serving the procedures registered
+// on the dispatcher next to the tchannel inbound
+func (d *RPCFactory) createGRPCInbound() *grpc.Inbound {
+	grpcAddress := fmt.Sprintf("%v:%v", d.getListenIP(), d.config.GRPCPort)
+	listener, err := net.Listen("tcp", grpcAddress)
+	if err != nil {
+		d.logger.WithField("error", err).Fatal("Failed to listen on gRPC port")
+	}
+	d.logger.Infof("Created gRPC inbound for '%v' and listening at '%v'",
+		d.serviceName, grpcAddress)
+	return grpc.NewTransport().NewInbound(listener)
+}
+
 // CreateDispatcherForOutbound creates a dispatcher for outbound connection
 func (d *RPCFactory) CreateDispatcherForOutbound(
 	callerName, serviceName, hostName string) *yarpc.Dispatcher {
@@ -78,6 +103,9 @@ func (d *RPCFactory) CreateDispatcherForOutbound(
 		Outbounds: yarpc.Outbounds{
 			serviceName: {Unary: d.ch.NewSingleOutbound(hostName)},
 		},
+		OutboundMiddleware: yarpc.OutboundMiddleware{
+			Unary: &common.ServicePrincipalOutboundMiddleware{Token: d.serviceToken},
+		},
 	})
 	if err := dispatcher.Start(); err != nil {
 		d.logger.WithField("error", err).Fatal("Failed to create outbound transport channel")
@@ -34,6 +34,11 @@ func GetIntPropertyFilteredByDomain(value int) func(domain string) int {
 	return func(domain string) int { return value }
 }
 
+// GetIntPropertyFilteredByDomainID returns values as IntPropertyFnWithDomainIDFilter
+func GetIntPropertyFilteredByDomainID(value int) func(domainID string) int {
+	return func(domainID string) int { return value }
+}
+
 // GetIntPropertyFilteredByTaskListInfo returns value as IntPropertyFnWithTaskListInfoFilters
 func GetIntPropertyFilteredByTaskListInfo(value int) func(domain string, taskList string, taskType int) int {
 	return func(domain string, taskList string, taskType int) int { return value }
@@ -49,6 +54,11 @@ func GetBoolPropertyFn(value bool) func(opts ...FilterOption) bool {
 	return func(...FilterOption) bool { return value }
 }
 
+// GetStringPropertyFn returns value as StringPropertyFn
+func GetStringPropertyFn(value string) func(opts ...FilterOption) string {
+	return func(...FilterOption) string { return value }
+}
+
 // GetDurationPropertyFn returns value as DurationPropertyFn
 func GetDurationPropertyFn(value time.Duration) func(opts ...FilterOption) time.Duration {
 	return func(...FilterOption) time.Duration { return value }
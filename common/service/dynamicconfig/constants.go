@@ -42,6 +42,7 @@ var keys = map[Key]string{
 	testGetDurationPropertyKey:                       "testGetDurationPropertyKey",
 	testGetBoolPropertyKey:                           "testGetBoolPropertyKey",
 	testGetIntPropertyFilteredByDomainKey:            "testGetIntPropertyFilteredByDomainKey",
+	testGetIntPropertyFilteredByDomainIDKey:          "testGetIntPropertyFilteredByDomainIDKey",
 	testGetDurationPropertyFilteredByDomainKey:       "testGetDurationPropertyFilteredByDomainKey",
 	testGetIntPropertyFilteredByTaskListInfoKey:      "testGetIntPropertyFilteredByTaskListInfoKey",
 	testGetDurationPropertyFilteredByTaskListInfoKey: "testGetDurationPropertyFilteredByTaskListInfoKey",
@@ -52,18 +53,42 @@ var keys = map[Key]string{
 	EnableNewKafkaClient:     "system.enableNewKafkaClient",
 	EnableVisibilitySampling: "system.enableVisibilitySampling",
 
+	// size limit settings
+	BlobSizeLimitError:               "limit.blobSize.error",
+	BlobSizeLimitWarn:                "limit.blobSize.warn",
+	HistorySizeLimitError:            "limit.historySize.error",
+	HistorySizeLimitWarn:             "limit.historySize.warn",
+	HistoryCountLimitError:           "limit.historyCount.error",
+	HistoryCountLimitWarn:            "limit.historyCount.warn",
+	PendingActivitiesLimitError:      "limit.pendingActivities.error",
+	PendingActivitiesLimitWarn:       "limit.pendingActivities.warn",
+	PendingChildExecutionsLimitError: "limit.pendingChildExecutions.error",
+	PendingChildExecutionsLimitWarn:  "limit.pendingChildExecutions.warn",
+	PendingTimersLimitError:          "limit.pendingTimers.error",
+	PendingTimersLimitWarn:           "limit.pendingTimers.warn",
+	PendingSignalsLimitError:         "limit.pendingSignals.error",
+	PendingSignalsLimitWarn:          "limit.pendingSignals.warn",
+	PendingLimitMaxDecisionAttempts:  "limit.pendingLimitMaxDecisionAttempts",
+
 	// frontend settings
-	FrontendPersistenceMaxQPS:      "frontend.persistenceMaxQPS",
-	FrontendVisibilityMaxPageSize:  "frontend.visibilityMaxPageSize",
-	FrontendVisibilityListMaxQPS:   "frontend.visibilityListMaxQPS",
-	FrontendHistoryMaxPageSize:     "frontend.historyMaxPageSize",
-	FrontendRPS:                    "frontend.rps",
-	FrontendHistoryMgrNumConns:     "frontend.historyMgrNumConns",
-	MaxDecisionStartToCloseTimeout: "frontend.maxDecisionStartToCloseTimeout",
+	FrontendPersistenceMaxQPS:             "frontend.persistenceMaxQPS",
+	FrontendPersistenceDomainMaxQPS:       "frontend.persistenceDomainMaxQPS",
+	FrontendVisibilityMaxPageSize:         "frontend.visibilityMaxPageSize",
+	FrontendVisibilityListMaxQPS:          "frontend.visibilityListMaxQPS",
+	FrontendHistoryMaxPageSize:            "frontend.historyMaxPageSize",
+	FrontendRPS:                           "frontend.rps",
+	FrontendDomainRPS:                     "frontend.domainrps",
+	FrontendDomainWorkerRPS:               "frontend.domainWorkerRps",
+	FrontendGlobalDomainRPS:               "frontend.globalDomainrps",
+	FrontendGlobalDomainWorkerRPS:         "frontend.globalDomainWorkerRps",
+	FrontendHistoryMgrNumConns:            "frontend.historyMgrNumConns",
+	MaxDecisionStartToCloseTimeout:        "frontend.maxDecisionStartToCloseTimeout",
+	FrontendGracefulFailoverCheckInterval: "frontend.gracefulFailoverCheckInterval",
 
 	// matching settings
 	MatchingRPS:                             "matching.rps",
 	MatchingPersistenceMaxQPS:               "matching.persistenceMaxQPS",
+	MatchingPersistenceDomainMaxQPS:         "matching.persistenceDomainMaxQPS",
 	MatchingMinTaskThrottlingBurstSize:      "matching.minTaskThrottlingBurstSize",
 	MatchingGetTasksBatchSize:               "matching.getTasksBatchSize",
 	MatchingLongPollExpirationInterval:      "matching.longPollExpirationInterval",
@@ -78,13 +103,18 @@ var keys = map[Key]string{
 	EnableSyncActivityHeartbeat:                           "history.enableSyncActivityHeartbeat",
 	HistoryRPS:                                            "history.rps",
 	HistoryPersistenceMaxQPS:                              "history.persistenceMaxQPS",
+	HistoryPersistenceDomainMaxQPS:                        "history.persistenceDomainMaxQPS",
 	HistoryVisibilityOpenMaxQPS:                           "history.historyVisibilityOpenMaxQPS",
 	HistoryVisibilityClosedMaxQPS:                         "history.historyVisibilityClosedMaxQPS",
 	HistoryLongPollExpirationInterval:                     "history.longPollExpirationInterval",
 	HistoryCacheInitialSize:                               "history.cacheInitialSize",
 	HistoryCacheMaxSize:                                   "history.cacheMaxSize",
+	HistoryCacheMaxSizeInBytes:                            "history.cacheMaxSizeInBytes",
 	HistoryCacheTTL:                                       "history.cacheTTL",
 	AcquireShardInterval:                                  "history.acquireShardInterval",
+	DrainedHosts:                                          "history.drainedHosts",
+	ShardDrainBatchSize:                                   "history.shardDrainBatchSize",
+	ShardOwnershipOverrides:                               "history.shardOwnershipOverrides",
 	StandbyClusterDelay:                                   "history.standbyClusterDelay",
 	TimerTaskBatchSize:                                    "history.timerTaskBatchSize",
 	TimerTaskWorkerCount:                                  "history.timerTaskWorkerCount",
@@ -126,6 +156,9 @@ var keys = map[Key]string{
 	ReplicatorProcessorMaxPollIntervalJitterCoefficient:   "history.replicatorProcessorMaxPollIntervalJitterCoefficient",
 	ReplicatorProcessorUpdateAckInterval:                  "history.replicatorProcessorUpdateAckInterval",
 	ReplicatorProcessorUpdateAckIntervalJitterCoefficient: "history.replicatorProcessorUpdateAckIntervalJitterCoefficient",
+	ReplicatorWorkflowTaskMaxEvents:                       "history.replicatorWorkflowTaskMaxEvents",
+	ReplicatorWorkflowTaskMaxBytes:                        "history.replicatorWorkflowTaskMaxBytes",
+	EnableTaskQuarantine:                                  "history.enableTaskQuarantine",
 	ExecutionMgrNumConns:                                  "history.executionMgrNumConns",
 	HistoryMgrNumConns:                                    "history.historyMgrNumConns",
 	MaximumBufferedEventsBatch:                            "history.maximumBufferedEventsBatch",
@@ -136,9 +169,16 @@ var keys = map[Key]string{
 	AdminOperationToken:                                   "history.adminOperationToken",
 	EnableEventsV2:                                        "history.enableEventsV2",
 
-	WorkerPersistenceMaxQPS:       "worker.persistenceMaxQPS",
-	WorkerReplicatorConcurrency:   "worker.replicatorConcurrency",
-	WorkerReplicationTaskMaxRetry: "worker.replicationTaskMaxRetry",
+	WorkerPersistenceMaxQPS:                      "worker.persistenceMaxQPS",
+	WorkerPersistenceDomainMaxQPS:                "worker.persistenceDomainMaxQPS",
+	WorkerReplicatorConcurrency:                  "worker.replicatorConcurrency",
+	WorkerReplicationTaskMaxRetry:                "worker.replicationTaskMaxRetry",
+	WorkerReplicationTaskFetcherPollInterval:     "worker.replicationTaskFetcherPollInterval",
+	WorkerReplicationTaskFetcherMaxBatchAttempts: "worker.replicationTaskFetcherMaxBatchAttempts",
+	WorkerConsistencyCheckerEnabled:              "worker.consistencyCheckerEnabled",
+	WorkerConsistencyCheckerInterval:             "worker.consistencyCheckerInterval",
+	WorkerConsistencyCheckerSampleSize:           "worker.consistencyCheckerSampleSize",
+	WorkerConsistencyCheckerMinIdleTime:          "worker.consistencyCheckerMinIdleTime",
 }
 
 const (
@@ -151,6 +191,7 @@ const (
 	testGetDurationPropertyKey
 	testGetBoolPropertyKey
 	testGetIntPropertyFilteredByDomainKey
+	testGetIntPropertyFilteredByDomainIDKey
 	testGetDurationPropertyFilteredByDomainKey
 	testGetIntPropertyFilteredByTaskListInfoKey
 	testGetDurationPropertyFilteredByTaskListInfoKey
@@ -163,10 +204,47 @@ const (
 	// EnableVisibilitySampling is key for enable visibility sampling
 	EnableVisibilitySampling
 
+	// key for size limit
+
+	// BlobSizeLimitError is the per event blob size limit
+	BlobSizeLimitError
+	// BlobSizeLimitWarn is the per event blob size limit for warning
+	BlobSizeLimitWarn
+	// HistorySizeLimitError is the per workflow execution history size limit
+	HistorySizeLimitError
+	// HistorySizeLimitWarn is the per workflow execution history size limit for warning
+	HistorySizeLimitWarn
+	// HistoryCountLimitError is the per workflow execution history event count limit
+	HistoryCountLimitError
+	// HistoryCountLimitWarn is the per workflow execution history event count limit for warning
+	HistoryCountLimitWarn
+	// PendingActivitiesLimitError is the per workflow execution pending activities limit
+	PendingActivitiesLimitError
+	// PendingActivitiesLimitWarn is the per workflow execution pending activities limit for warning
+	PendingActivitiesLimitWarn
+	// PendingChildExecutionsLimitError is the per workflow execution pending child executions limit
+	PendingChildExecutionsLimitError
+	// PendingChildExecutionsLimitWarn is the per workflow execution pending child executions limit for warning
+	PendingChildExecutionsLimitWarn
+	// PendingTimersLimitError is the per workflow execution pending timers limit
+	PendingTimersLimitError
+	// PendingTimersLimitWarn is the per workflow execution pending timers limit for warning
+	PendingTimersLimitWarn
+	// PendingSignalsLimitError is the per workflow execution pending external signals limit
+	PendingSignalsLimitError
+	// PendingSignalsLimitWarn is the per workflow execution pending external signals limit for warning
+	PendingSignalsLimitWarn
+	// PendingLimitMaxDecisionAttempts is the number of consecutive failed decisions after which a workflow
+	// execution over one of the pending limits is terminated
+	PendingLimitMaxDecisionAttempts
+
 	// key for frontend
 
 	// FrontendPersistenceMaxQPS is the max qps frontend host can query DB
 	FrontendPersistenceMaxQPS
+	// FrontendPersistenceDomainMaxQPS is the max qps a single domain can query DB on a frontend host,
+	// the queries of all domains are also limited by the host quota
+	FrontendPersistenceDomainMaxQPS
 	// FrontendVisibilityMaxPageSize is default max size for ListWorkflowExecutions in one page
 	FrontendVisibilityMaxPageSize
 	// FrontendVisibilityListMaxQPS is max qps frontend can list open/close workflows
@@ -175,10 +253,24 @@ const (
 	FrontendHistoryMaxPageSize
 	// FrontendRPS is workflow rate limit per second
 	FrontendRPS
+	// FrontendDomainRPS is the per domain rate limit per second of user apis, e.g. start, signal and query,
+	// on a frontend host
+	FrontendDomainRPS
+	// FrontendDomainWorkerRPS is the per domain rate limit per second of worker apis, i.e. polls and
+	// task responses, on a frontend host
+	FrontendDomainWorkerRPS
+	// FrontendGlobalDomainRPS is the per domain rate limit per second of user apis for the whole cluster,
+	// it is divided evenly between frontend hosts and takes precedence over FrontendDomainRPS when set
+	FrontendGlobalDomainRPS
+	// FrontendGlobalDomainWorkerRPS is the per domain rate limit per second of worker apis for the whole cluster,
+	// it is divided evenly between frontend hosts and takes precedence over FrontendDomainWorkerRPS when set
+	FrontendGlobalDomainWorkerRPS
 	// FrontendHistoryMgrNumConns is for persistence cluster.NumConns
 	FrontendHistoryMgrNumConns
 	// MaxDecisionStartToCloseTimeout is max decision timeout in seconds
 	MaxDecisionStartToCloseTimeout
+	// FrontendGracefulFailoverCheckInterval is the interval of checking whether domains in graceful failover can be handed over
+	FrontendGracefulFailoverCheckInterval
 
 	// key for matching
 
@@ -186,6 +278,9 @@ const (
 	MatchingRPS
 	// MatchingPersistenceMaxQPS is the max qps matching host can query DB
 	MatchingPersistenceMaxQPS
+	// MatchingPersistenceDomainMaxQPS is the max qps a single domain can query DB on a matching host,
+	// the queries of all domains are also limited by the host quota
+	MatchingPersistenceDomainMaxQPS
 	// MatchingMinTaskThrottlingBurstSize is the minimum burst size for task list throttling
 	MatchingMinTaskThrottlingBurstSize
 	// MatchingGetTasksBatchSize is the maximum batch size to fetch from the task buffer
@@ -213,6 +308,9 @@ const (
 	HistoryRPS
 	// HistoryPersistenceMaxQPS is the max qps history host can query DB
 	HistoryPersistenceMaxQPS
+	// HistoryPersistenceDomainMaxQPS is the max qps a single domain can query DB on a history host,
+	// the queries of all domains are also limited by the host quota
+	HistoryPersistenceDomainMaxQPS
 	// HistoryVisibilityOpenMaxQPS is max qps one history host can query visibility open_executions
 	HistoryVisibilityOpenMaxQPS
 	// HistoryVisibilityClosedMaxQPS is max qps one history host can query visibility closed_executions
@@ -223,10 +321,18 @@ const (
 	HistoryCacheInitialSize
 	// HistoryCacheMaxSize is max size of history cache
 	HistoryCacheMaxSize
+	// HistoryCacheMaxSizeInBytes is the estimated memory budget in bytes of the history caches of all the shards of a host, it is a soft limit and 0 means unbounded
+	HistoryCacheMaxSizeInBytes
 	// HistoryCacheTTL is TTL of history cache
 	HistoryCacheTTL
 	// AcquireShardInterval is interval that timer used to acquire shard
 	AcquireShardInterval
+	// DrainedHosts is a comma separated list of history host addresses which release their shards and stop acquiring new ones
+	DrainedHosts
+	// ShardDrainBatchSize is the max number of shards of drained hosts a history host takes over per acquire shard interval
+	ShardDrainBatchSize
+	// ShardOwnershipOverrides is a comma separated list of shardID=host:port pairs pinning shards to history hosts
+	ShardOwnershipOverrides
 	// StandbyClusterDelay is the atrificial delay added to standby cluster's view of active cluster's time
 	StandbyClusterDelay
 	// TimerTaskBatchSize is batch size for timer processor to process tasks
@@ -309,6 +415,12 @@ const (
 	ReplicatorProcessorUpdateAckInterval
 	// ReplicatorProcessorUpdateAckIntervalJitterCoefficient is the update interval jitter coefficient
 	ReplicatorProcessorUpdateAckIntervalJitterCoefficient
+	// ReplicatorWorkflowTaskMaxEvents is the max number of events in a replication task rebuilt for a workflow execution
+	ReplicatorWorkflowTaskMaxEvents
+	// ReplicatorWorkflowTaskMaxBytes is the max size in bytes of the events in a replication task rebuilt for a workflow execution
+	ReplicatorWorkflowTaskMaxBytes
+	// EnableTaskQuarantine is whether transfer and timer tasks failing after max retry count are moved to the quarantine
+	EnableTaskQuarantine
 	// ExecutionMgrNumConns is persistence connections number for ExecutionManager
 	ExecutionMgrNumConns
 	// HistoryMgrNumConns is persistence connections number for HistoryManager
@@ -319,7 +431,8 @@ const (
 	ShardUpdateMinInterval
 	// ShardSyncMinInterval is the minimal time interval which the shard info should be sync to remote
 	ShardSyncMinInterval
-	// DefaultEventEncoding is the encoding type for history events
+	// DefaultEventEncoding is the encoding type for history events, one of json, thriftrw,
+	// thriftrw-snappy or thriftrw-gzip; the latter two compress the thriftrw encoded events
 	DefaultEventEncoding
 
 	// EnableAdminProtection is whether to enable admin checking
@@ -333,10 +446,27 @@ const (
 
 	// WorkerPersistenceMaxQPS is the max qps worker host can query DB
 	WorkerPersistenceMaxQPS
+	// WorkerPersistenceDomainMaxQPS is the max qps a single domain can query DB on a worker host,
+	// the queries of all domains are also limited by the host quota
+	WorkerPersistenceDomainMaxQPS
 	// WorkerReplicatorConcurrency is the max concurrenct tasks to be processed at any given time
 	WorkerReplicatorConcurrency
 	// WorkerReplicationTaskMaxRetry is the max retry for any task
 	WorkerReplicationTaskMaxRetry
+	// WorkerReplicationTaskFetcherPollInterval is the interval between polls when replication tasks are pulled over rpc
+	WorkerReplicationTaskFetcherPollInterval
+	// WorkerReplicationTaskFetcherMaxBatchAttempts is the max number of times a batch of replication tasks pulled
+	// over rpc is fetched again because some of its tasks failed, before the batch is skipped
+	WorkerReplicationTaskFetcherMaxBatchAttempts
+	// WorkerConsistencyCheckerEnabled is whether the mutable state of global domain workflows is compared across clusters
+	WorkerConsistencyCheckerEnabled
+	// WorkerConsistencyCheckerInterval is the interval between rounds of the cross cluster consistency check
+	WorkerConsistencyCheckerInterval
+	// WorkerConsistencyCheckerSampleSize is the number of open workflows checked per global domain in each round
+	WorkerConsistencyCheckerSampleSize
+	// WorkerConsistencyCheckerMinIdleTime is how long a workflow must not be updated before it is checked,
+	// so that events still being replicated are not reported as mismatches
+	WorkerConsistencyCheckerMinIdleTime
 
 	// lastKeyForTest must be the last one in this const group for testing purpose
 	lastKeyForTest
@@ -346,7 +476,7 @@ const (
 type Filter int
 
 func (f Filter) String() string {
-	if f <= unknownFilter || f > TaskListName {
+	if f <= unknownFilter || f >= lastFilterTypeForTest {
 		return filters[unknownFilter]
 	}
 	return filters[f]
@@ -357,6 +487,7 @@ var filters = []string{
 	"domainName",
 	"taskListName",
 	"taskType",
+	"domainID",
 }
 
 const (
@@ -367,6 +498,8 @@ const (
 	TaskListName
 	// TaskType is the task type (0:Decision, 1:Activity)
 	TaskType
+	// DomainID is the domain id
+	DomainID
 
 	// lastFilterTypeForTest must be the last one in this const group for testing purpose
 	lastFilterTypeForTest
@@ -395,3 +528,10 @@ func TaskTypeFilter(taskType int) FilterOption {
 		filterMap[TaskType] = taskType
 	}
 }
+
+// DomainIDFilter filters by domain id
+func DomainIDFilter(domainID string) FilterOption {
+	return func(filterMap map[Filter]interface{}) {
+		filterMap[DomainID] = domainID
+	}
+}
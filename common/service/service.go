@@ -52,17 +52,19 @@ type (
 	// BootstrapParams holds the set of parameters
 	// needed to bootstrap a service
 	BootstrapParams struct {
-		Name              string
-		Logger            bark.Logger
-		MetricScope       tally.Scope
-		RingpopFactory    RingpopFactory
-		RPCFactory        common.RPCFactory
-		PProfInitializer  common.PProfInitializer
-		PersistenceConfig config.Persistence
-		ClusterMetadata   cluster.Metadata
-		ReplicatorConfig  config.Replicator
-		MessagingClient   messaging.Client
-		DynamicConfig     dynamicconfig.Client
+		Name                string
+		Logger              bark.Logger
+		MetricScope         tally.Scope
+		RingpopFactory      RingpopFactory
+		RPCFactory          common.RPCFactory
+		PProfInitializer    common.PProfInitializer
+		PersistenceConfig   config.Persistence
+		ClusterMetadata     cluster.Metadata
+		ReplicatorConfig    config.Replicator
+		AuthorizationConfig config.Authorization
+		HTTPConfig          config.HTTP
+		MessagingClient     messaging.Client
+		DynamicConfig       dynamicconfig.Client
 	}
 
 	// RingpopFactory provides a bootstrapped ringpop
@@ -178,7 +180,7 @@ func (h *serviceImpl) Start() {
 	h.hostInfo = hostInfo
 
 	h.clientFactory = client.NewRPCClientFactory(h.rpcFactory, h.membershipMonitor, h.metricsClient,
-		h.numberOfHistoryShards)
+		h.dynamicCollection, h.numberOfHistoryShards, h.logger)
 
 	// The service is now started up
 	h.logger.Info("service started")
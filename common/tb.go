@@ -42,6 +42,10 @@ type (
 		// tokens were acquired before timeout, false
 		// otherwise
 		Consume(count int, timeout time.Duration) bool
+		// Return gives back count tokens taken from the
+		// bucket, e.g. when the request they were taken
+		// for is rejected by another rate limiter
+		Return(count int)
 	}
 
 	// PriorityTokenBucket is the interface for rate limiter with priority
@@ -182,6 +186,20 @@ func (tb *tokenBucketImpl) Consume(count int, timeout time.Duration) bool {
 	}
 }
 
+func (tb *tokenBucketImpl) Return(count int) {
+	tb.Lock()
+	defer tb.Unlock()
+	maxTokens := tb.fillRate
+	if tb.overflowRps > 0 {
+		maxTokens++
+	}
+	// the bucket may have been refilled since the tokens were taken
+	tb.tokens += count
+	if tb.tokens > maxTokens {
+		tb.tokens = maxTokens
+	}
+}
+
 func (tb *tokenBucketImpl) refill(now int64) {
 	tb.refillOverFlow(now)
 	if tb.isRefillDue(now) {
@@ -105,6 +105,27 @@ func (s *TokenBucketSuite) TestLowRpsEnforced() {
 	s.Equal(3, attempts, "Token bucket gave out tokens too quickly")
 }
 
+func (s *TokenBucketSuite) TestReturn() {
+	ts := &mockTimeSource{currTime: time.Now()}
+	tb := NewTokenBucket(20, ts)
+
+	ok, _ := tb.TryConsume(2)
+	s.True(ok)
+	ok, _ = tb.TryConsume(1)
+	s.False(ok)
+
+	tb.Return(1)
+	ok, _ = tb.TryConsume(1)
+	s.True(ok)
+	ok, _ = tb.TryConsume(1)
+	s.False(ok)
+
+	// returned tokens do not overflow the bucket
+	tb.Return(5)
+	ok, _ = tb.TryConsume(3)
+	s.False(ok)
+}
+
 func (s *TokenBucketSuite) TestPriorityRpsEnforced() {
 	ts := &mockTimeSource{currTime: time.Now()}
 	tb := NewPriorityTokenBucket(1, 99, ts) // behavior same to tokenBucketImpl
@@ -0,0 +1,142 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package xdc
+
+import (
+	"context"
+
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/logging"
+)
+
+type (
+	// HistoryResender fetches the history events of a workflow execution missing on the current cluster
+	// from the source cluster and applies them to the current cluster
+	HistoryResender interface {
+		SendMissingHistory(ctx context.Context, domainID, workflowID, runID string, firstEventID, nextEventID int64) error
+	}
+
+	historyResenderImpl struct {
+		sourceCluster string
+		adminClient   adminserviceclient.Interface
+		historyClient history.Client
+		logger        bark.Logger
+	}
+)
+
+var _ HistoryResender = (*historyResenderImpl)(nil)
+
+// NewHistoryResender creates a new history resender, the admin client is the one of the source cluster
+// and the history client is the one of the current cluster
+func NewHistoryResender(sourceCluster string, adminClient adminserviceclient.Interface, historyClient history.Client,
+	logger bark.Logger) HistoryResender {
+	return &historyResenderImpl{
+		sourceCluster: sourceCluster,
+		adminClient:   adminClient,
+		historyClient: historyClient,
+		logger: logger.WithFields(bark.Fields{
+			logging.TagSourceCluster: sourceCluster,
+		}),
+	}
+}
+
+// NewSourceAdminClient creates the admin client of the source cluster from its replication consumer config,
+// nil is returned if the rpc address of the source cluster is not configured
+func NewSourceAdminClient(callerName, sourceCluster string, clusterMetadata cluster.Metadata,
+	rpcFactory common.RPCFactory) adminserviceclient.Interface {
+
+	consumerConfig := clusterMetadata.GetReplicationConsumerConfig(sourceCluster)
+	if consumerConfig == nil || consumerConfig.RPCAddress == "" {
+		return nil
+	}
+
+	dispatcher := rpcFactory.CreateDispatcherForOutbound(callerName, consumerConfig.RPCName, consumerConfig.RPCAddress)
+	return adminserviceclient.New(dispatcher.ClientConfig(consumerConfig.RPCName))
+}
+
+// SendMissingHistory fetches the events in [firstEventID, nextEventID) of the workflow execution from the source
+// cluster and applies them in order, a nextEventID of 0 means all the events after firstEventID
+func (r *historyResenderImpl) SendMissingHistory(ctx context.Context, domainID, workflowID, runID string,
+	firstEventID, nextEventID int64) error {
+
+	logger := r.logger.WithFields(bark.Fields{
+		logging.TagDomainID:            domainID,
+		logging.TagWorkflowExecutionID: workflowID,
+		logging.TagWorkflowRunID:       runID,
+		logging.TagFirstEventID:        firstEventID,
+		logging.TagNextEventID:         nextEventID,
+	})
+
+	response, err := r.adminClient.GetWorkflowReplicationTasks(ctx, &admin.GetWorkflowReplicationTasksRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(workflowID),
+			RunId:      common.StringPtr(runID),
+		},
+		FirstEventId: common.Int64Ptr(firstEventID),
+		NextEventId:  common.Int64Ptr(nextEventID),
+	})
+	if err != nil {
+		logger.WithField(logging.TagErr, err).Warn("Failed to fetch missing history from source cluster.")
+		return err
+	}
+
+	for _, task := range response.ReplicationTasks {
+		if task.GetTaskType() != replicator.ReplicationTaskTypeHistory || task.HistoryTaskAttributes == nil {
+			continue
+		}
+		if err := r.historyClient.ReplicateEvents(ctx, r.toReplicateEventsRequest(task.HistoryTaskAttributes)); err != nil {
+			logger.WithField(logging.TagErr, err).Warn("Failed to apply missing history from source cluster.")
+			return err
+		}
+	}
+
+	logger.Infof("Resent %v replication tasks from source cluster.", len(response.ReplicationTasks))
+	return nil
+}
+
+func (r *historyResenderImpl) toReplicateEventsRequest(attr *replicator.HistoryTaskAttributes) *h.ReplicateEventsRequest {
+	return &h.ReplicateEventsRequest{
+		SourceCluster: common.StringPtr(r.sourceCluster),
+		DomainUUID:    attr.DomainId,
+		WorkflowExecution: &shared.WorkflowExecution{
+			WorkflowId: attr.WorkflowId,
+			RunId:      attr.RunId,
+		},
+		FirstEventId:            attr.FirstEventId,
+		NextEventId:             attr.NextEventId,
+		Version:                 attr.Version,
+		ReplicationInfo:         attr.ReplicationInfo,
+		History:                 attr.History,
+		NewRunHistory:           attr.NewRunHistory,
+		ForceBufferEvents:       common.BoolPtr(false),
+		EventStoreVersion:       attr.EventStoreVersion,
+		NewRunEventStoreVersion: attr.NewRunEventStoreVersion,
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package xdc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/admin/adminservicetest"
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/mocks"
+)
+
+type (
+	historyResenderSuite struct {
+		suite.Suite
+		mockCtrl      *gomock.Controller
+		adminClient   *adminservicetest.MockClient
+		historyClient *mocks.HistoryClient
+		resender      HistoryResender
+	}
+)
+
+func TestHistoryResenderSuite(t *testing.T) {
+	s := new(historyResenderSuite)
+	suite.Run(t, s)
+}
+
+func (s *historyResenderSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+	s.adminClient = adminservicetest.NewMockClient(s.mockCtrl)
+	s.historyClient = &mocks.HistoryClient{}
+	s.resender = NewHistoryResender("active", s.adminClient, s.historyClient, bark.NewLoggerFromLogrus(logrus.New()))
+}
+
+func (s *historyResenderSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+	s.historyClient.AssertExpectations(s.T())
+}
+
+func (s *historyResenderSuite) TestSendMissingHistory() {
+	domainID := "some random domain ID"
+	workflowID := "some random workflow ID"
+	runID := "some random run ID"
+
+	newTask := func(firstEventID, nextEventID, version int64) *replicator.ReplicationTask {
+		return &replicator.ReplicationTask{
+			TaskType: replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeHistory),
+			HistoryTaskAttributes: &replicator.HistoryTaskAttributes{
+				DomainId:     common.StringPtr(domainID),
+				WorkflowId:   common.StringPtr(workflowID),
+				RunId:        common.StringPtr(runID),
+				FirstEventId: common.Int64Ptr(firstEventID),
+				NextEventId:  common.Int64Ptr(nextEventID),
+				Version:      common.Int64Ptr(version),
+				History:      &shared.History{},
+			},
+		}
+	}
+
+	s.adminClient.EXPECT().GetWorkflowReplicationTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ interface{}, request *admin.GetWorkflowReplicationTasksRequest, _ ...interface{}) (*admin.GetWorkflowReplicationTasksResponse, error) {
+			s.Equal(domainID, request.GetDomainUUID())
+			s.Equal(workflowID, request.Execution.GetWorkflowId())
+			s.Equal(runID, request.Execution.GetRunId())
+			s.Equal(int64(5), request.GetFirstEventId())
+			s.Equal(int64(20), request.GetNextEventId())
+			return &admin.GetWorkflowReplicationTasksResponse{
+				ReplicationTasks: []*replicator.ReplicationTask{newTask(5, 12, 1), newTask(12, 20, 11)},
+			}, nil
+		})
+
+	var applied []int64
+	s.historyClient.On("ReplicateEvents", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		request := args.Get(1).(*h.ReplicateEventsRequest)
+		s.Equal("active", request.GetSourceCluster())
+		s.False(request.GetForceBufferEvents())
+		applied = append(applied, request.GetFirstEventId())
+	}).Twice()
+
+	err := s.resender.SendMissingHistory(context.Background(), domainID, workflowID, runID, 5, 20)
+	s.Nil(err)
+	s.Equal([]int64{5, 12}, applied)
+}
+
+func (s *historyResenderSuite) TestSendMissingHistory_ApplyFailed() {
+	task := &replicator.ReplicationTask{
+		TaskType: replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeHistory),
+		HistoryTaskAttributes: &replicator.HistoryTaskAttributes{
+			FirstEventId: common.Int64Ptr(5),
+			NextEventId:  common.Int64Ptr(12),
+		},
+	}
+	s.adminClient.EXPECT().GetWorkflowReplicationTasks(gomock.Any(), gomock.Any()).Return(
+		&admin.GetWorkflowReplicationTasksResponse{
+			ReplicationTasks: []*replicator.ReplicationTask{task, task},
+		}, nil)
+
+	applyErr := errors.New("some random error")
+	s.historyClient.On("ReplicateEvents", mock.Anything, mock.Anything).Return(applyErr).Once()
+
+	err := s.resender.SendMissingHistory(context.Background(), "some random domain ID", "some random workflow ID",
+		"some random run ID", 5, 0)
+	s.Equal(applyErr, err)
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package xdc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// MutableStateChecker compares the mutable state of a workflow execution in the current cluster
+	// with the one in a remote cluster
+	MutableStateChecker interface {
+		CheckWorkflow(ctx context.Context, domainID, domainName string, execution *shared.WorkflowExecution) (*MutableStateCheckResult, error)
+	}
+
+	// MutableStateCheckResult is the outcome of comparing the mutable state of a workflow execution across clusters
+	MutableStateCheckResult struct {
+		RunID              string
+		Diffs              []*admin.MutableStateDiff
+		CurrentNextEventID int64
+		RemoteNextEventID  int64
+		// LastUpdatedTime is the last time the workflow execution was updated in either cluster
+		LastUpdatedTime time.Time
+	}
+
+	mutableStateCheckerImpl struct {
+		adminClient   adminserviceclient.Interface
+		historyClient history.Client
+	}
+
+	// mutableStateSummary is the part of the mutable state json compared across clusters
+	mutableStateSummary struct {
+		ExecutionInfo    *executionInfoSummary
+		ReplicationState *persistence.ReplicationState
+		ActivityInfos    map[int64]*struct{}
+		TimerInfos       map[string]*struct{}
+	}
+
+	executionInfoSummary struct {
+		RunID                string
+		State                int
+		CloseStatus          int
+		NextEventID          int64
+		LastUpdatedTimestamp time.Time
+	}
+)
+
+var _ MutableStateChecker = (*mutableStateCheckerImpl)(nil)
+
+// NewMutableStateChecker creates a new mutable state checker, the admin client is the one of the remote cluster
+// and the history client is the one of the current cluster
+func NewMutableStateChecker(adminClient adminserviceclient.Interface, historyClient history.Client) MutableStateChecker {
+	return &mutableStateCheckerImpl{
+		adminClient:   adminClient,
+		historyClient: historyClient,
+	}
+}
+
+// CheckWorkflow fetches the mutable state of the workflow execution from both clusters and returns their differences,
+// the current run of the workflow in the current cluster is checked if the run ID is not set
+func (c *mutableStateCheckerImpl) CheckWorkflow(ctx context.Context, domainID, domainName string,
+	execution *shared.WorkflowExecution) (*MutableStateCheckResult, error) {
+
+	currentResp, err := c.historyClient.DescribeMutableState(ctx, &h.DescribeMutableStateRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  execution,
+	})
+	if err != nil {
+		return nil, err
+	}
+	current, err := parseMutableState(currentResp.MutableStateInDatabase)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteResp, err := c.adminClient.DescribeWorkflowExecution(ctx, &admin.DescribeWorkflowExecutionRequest{
+		Domain: common.StringPtr(domainName),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: execution.WorkflowId,
+			RunId:      common.StringPtr(current.ExecutionInfo.RunID),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	remote, err := parseMutableState(remoteResp.MutableStateInDatabase)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &MutableStateCheckResult{
+		RunID:              current.ExecutionInfo.RunID,
+		Diffs:              compareMutableState(current, remote),
+		CurrentNextEventID: current.ExecutionInfo.NextEventID,
+		RemoteNextEventID:  remote.ExecutionInfo.NextEventID,
+		LastUpdatedTime:    current.ExecutionInfo.LastUpdatedTimestamp,
+	}
+	if remote.ExecutionInfo.LastUpdatedTimestamp.After(result.LastUpdatedTime) {
+		result.LastUpdatedTime = remote.ExecutionInfo.LastUpdatedTimestamp
+	}
+	return result, nil
+}
+
+func parseMutableState(mutableStateJSON *string) (*mutableStateSummary, error) {
+	if mutableStateJSON == nil {
+		return nil, &shared.InternalServiceError{Message: "Mutable state is missing in the describe response."}
+	}
+	summary := &mutableStateSummary{}
+	if err := json.Unmarshal([]byte(*mutableStateJSON), summary); err != nil {
+		return nil, err
+	}
+	if summary.ExecutionInfo == nil {
+		return nil, &shared.InternalServiceError{Message: "Execution info is missing in the mutable state."}
+	}
+	if summary.ReplicationState == nil {
+		summary.ReplicationState = &persistence.ReplicationState{}
+	}
+	return summary, nil
+}
+
+func compareMutableState(current, remote *mutableStateSummary) []*admin.MutableStateDiff {
+	diffs := []*admin.MutableStateDiff{}
+	compare := func(field string, currentValue, remoteValue interface{}) {
+		currentStr := fmt.Sprintf("%v", currentValue)
+		remoteStr := fmt.Sprintf("%v", remoteValue)
+		if currentStr != remoteStr {
+			diffs = append(diffs, &admin.MutableStateDiff{
+				Field:          common.StringPtr(field),
+				CurrentCluster: common.StringPtr(currentStr),
+				RemoteCluster:  common.StringPtr(remoteStr),
+			})
+		}
+	}
+
+	compare("NextEventID", current.ExecutionInfo.NextEventID, remote.ExecutionInfo.NextEventID)
+	compare("State", current.ExecutionInfo.State, remote.ExecutionInfo.State)
+	compare("CloseStatus", current.ExecutionInfo.CloseStatus, remote.ExecutionInfo.CloseStatus)
+	compare("StartVersion", current.ReplicationState.StartVersion, remote.ReplicationState.StartVersion)
+	compare("LastWriteVersion", current.ReplicationState.LastWriteVersion, remote.ReplicationState.LastWriteVersion)
+	compare("LastWriteEventID", current.ReplicationState.LastWriteEventID, remote.ReplicationState.LastWriteEventID)
+	compare("PendingActivities", pendingActivityIDs(current), pendingActivityIDs(remote))
+	compare("PendingTimers", pendingTimerIDs(current), pendingTimerIDs(remote))
+	return diffs
+}
+
+func pendingActivityIDs(summary *mutableStateSummary) []int64 {
+	ids := []int64{}
+	for scheduleID := range summary.ActivityInfos {
+		ids = append(ids, scheduleID)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func pendingTimerIDs(summary *mutableStateSummary) []string {
+	ids := []string{}
+	for timerID := range summary.TimerInfos {
+		ids = append(ids, timerID)
+	}
+	sort.Strings(ids)
+	return ids
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package xdc
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/admin/adminservicetest"
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	mutableStateCheckerSuite struct {
+		suite.Suite
+		mockCtrl      *gomock.Controller
+		adminClient   *adminservicetest.MockClient
+		historyClient *mocks.HistoryClient
+		checker       MutableStateChecker
+	}
+)
+
+func TestMutableStateCheckerSuite(t *testing.T) {
+	s := new(mutableStateCheckerSuite)
+	suite.Run(t, s)
+}
+
+func (s *mutableStateCheckerSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+	s.adminClient = adminservicetest.NewMockClient(s.mockCtrl)
+	s.historyClient = &mocks.HistoryClient{}
+	s.checker = NewMutableStateChecker(s.adminClient, s.historyClient)
+}
+
+func (s *mutableStateCheckerSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+	s.historyClient.AssertExpectations(s.T())
+}
+
+func (s *mutableStateCheckerSuite) TestCheckWorkflow() {
+	domainID := "some random domain ID"
+	domainName := "some random domain name"
+	workflowID := "some random workflow ID"
+	runID := "some random run ID"
+	currentUpdateTime := time.Now().Add(-time.Hour)
+	remoteUpdateTime := time.Now().Add(-time.Minute)
+
+	current := s.toJSON(&persistence.WorkflowMutableState{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{
+			RunID:                runID,
+			NextEventID:          12,
+			LastUpdatedTimestamp: currentUpdateTime,
+		},
+		ReplicationState: &persistence.ReplicationState{StartVersion: 1, LastWriteVersion: 11, LastWriteEventID: 11},
+		ActivityInfos: map[int64]*persistence.ActivityInfo{
+			5: {ScheduleID: 5},
+			9: {ScheduleID: 9},
+		},
+		TimerInfos: map[string]*persistence.TimerInfo{"timer": {TimerID: "timer"}},
+	})
+	remote := s.toJSON(&persistence.WorkflowMutableState{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{
+			RunID:                runID,
+			NextEventID:          10,
+			LastUpdatedTimestamp: remoteUpdateTime,
+		},
+		ReplicationState: &persistence.ReplicationState{StartVersion: 1, LastWriteVersion: 11, LastWriteEventID: 9},
+		ActivityInfos: map[int64]*persistence.ActivityInfo{
+			5: {ScheduleID: 5},
+		},
+		TimerInfos: map[string]*persistence.TimerInfo{"timer": {TimerID: "timer"}},
+	})
+
+	s.historyClient.On("DescribeMutableState", mock.Anything, &h.DescribeMutableStateRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  &shared.WorkflowExecution{WorkflowId: common.StringPtr(workflowID)},
+	}).Return(&h.DescribeMutableStateResponse{MutableStateInDatabase: common.StringPtr(current)}, nil).Once()
+	s.adminClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ interface{}, request *admin.DescribeWorkflowExecutionRequest, _ ...interface{}) (*admin.DescribeWorkflowExecutionResponse, error) {
+			s.Equal(domainName, request.GetDomain())
+			s.Equal(workflowID, request.Execution.GetWorkflowId())
+			s.Equal(runID, request.Execution.GetRunId())
+			return &admin.DescribeWorkflowExecutionResponse{MutableStateInDatabase: common.StringPtr(remote)}, nil
+		})
+
+	result, err := s.checker.CheckWorkflow(context.Background(), domainID, domainName,
+		&shared.WorkflowExecution{WorkflowId: common.StringPtr(workflowID)})
+	s.Nil(err)
+	s.Equal(runID, result.RunID)
+	s.Equal(int64(12), result.CurrentNextEventID)
+	s.Equal(int64(10), result.RemoteNextEventID)
+	s.True(remoteUpdateTime.Equal(result.LastUpdatedTime))
+	s.Equal([]*admin.MutableStateDiff{
+		{
+			Field:          common.StringPtr("NextEventID"),
+			CurrentCluster: common.StringPtr("12"),
+			RemoteCluster:  common.StringPtr("10"),
+		},
+		{
+			Field:          common.StringPtr("LastWriteEventID"),
+			CurrentCluster: common.StringPtr("11"),
+			RemoteCluster:  common.StringPtr("9"),
+		},
+		{
+			Field:          common.StringPtr("PendingActivities"),
+			CurrentCluster: common.StringPtr("[5 9]"),
+			RemoteCluster:  common.StringPtr("[5]"),
+		},
+	}, result.Diffs)
+}
+
+func (s *mutableStateCheckerSuite) TestCompareMutableState_Consistent() {
+	state := &mutableStateSummary{
+		ExecutionInfo:    &executionInfoSummary{NextEventID: 10},
+		ReplicationState: &persistence.ReplicationState{LastWriteVersion: 11, LastWriteEventID: 9},
+		TimerInfos:       map[string]*struct{}{"timer": {}},
+	}
+	s.Empty(compareMutableState(state, state))
+}
+
+func (s *mutableStateCheckerSuite) toJSON(state *persistence.WorkflowMutableState) string {
+	data, err := json.Marshal(state)
+	s.Nil(err)
+	return string(data)
+}
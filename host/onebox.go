@@ -33,6 +33,7 @@ import (
 	"github.com/uber-go/tally"
 	"github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/mocks"
@@ -297,7 +298,8 @@ func (c *cadenceImpl) startFrontend(rpHosts []string, startWG *sync.WaitGroup) {
 
 	c.frontEndService = service.New(params)
 	c.frontendHandler = frontend.NewWorkflowHandler(
-		c.frontEndService, frontend.NewConfig(dynamicconfig.NewNopCollection()), c.metadataMgr, c.historyMgr, c.historyV2Mgr, c.visibilityMgr, kafkaProducer)
+		c.frontEndService, frontend.NewConfig(dynamicconfig.NewNopCollection()), c.metadataMgr, c.historyMgr, c.historyV2Mgr, c.visibilityMgr, kafkaProducer,
+		authorization.NewNopAuthorizer())
 	err = c.frontendHandler.Start()
 	if err != nil {
 		c.logger.WithField("error", err).Fatal("Failed to start frontend")
@@ -332,7 +334,7 @@ func (c *cadenceImpl) startHistory(rpHosts []string, startWG *sync.WaitGroup) {
 		historyConfig.HistoryMgrNumConns = dynamicconfig.GetIntPropertyFn(c.numberOfHistoryShards)
 		historyConfig.ExecutionMgrNumConns = dynamicconfig.GetIntPropertyFn(c.numberOfHistoryShards)
 		handler := history.NewHandler(service, historyConfig, c.shardMgr, c.metadataMgr,
-			c.visibilityMgr, c.historyMgr, c.historyV2Mgr, c.executionMgrFactory)
+			c.visibilityMgr, c.historyMgr, c.historyV2Mgr, nil, c.executionMgrFactory)
 		handler.Start()
 		c.historyHandlers = append(c.historyHandlers, handler)
 	}
@@ -396,7 +398,7 @@ func (c *cadenceImpl) startWorker(rpHosts []string, startWG *sync.WaitGroup) {
 	workerConfig := worker.NewConfig(dynamicconfig.NewNopCollection())
 	workerConfig.ReplicatorConcurrency = dynamicconfig.GetIntPropertyFn(10)
 	c.replicator = worker.NewReplicator(c.clusterMetadata, metadataManager, historyClient,
-		workerConfig, c.messagingClient, c.logger, service.GetMetricsClient())
+		workerConfig, c.messagingClient, params.RPCFactory, nil, c.numberOfHistoryShards, c.logger, service.GetMetricsClient())
 	if err := c.replicator.Start(); err != nil {
 		c.replicator.Stop()
 		c.logger.WithField("error", err).Fatal("Fail to start replicator when start worker")
@@ -489,6 +491,9 @@ func (c *rpcFactoryImpl) CreateDispatcher() *yarpc.Dispatcher {
 	return yarpc.NewDispatcher(yarpc.Config{
 		Name:     c.serviceName,
 		Inbounds: yarpc.Inbounds{c.ch.NewInbound()},
+		OutboundMiddleware: yarpc.OutboundMiddleware{
+			Unary: &common.ServicePrincipalOutboundMiddleware{},
+		},
 		// For integration tests to generate client out of the same outbound.
 		Outbounds: yarpc.Outbounds{
 			c.serviceName: {Unary: c.ch.NewSingleOutbound(c.hostPort)},
@@ -504,6 +509,9 @@ func (c *rpcFactoryImpl) CreateDispatcherForOutbound(
 		Outbounds: yarpc.Outbounds{
 			serviceName: {Unary: c.ch.NewSingleOutbound(hostName)},
 		},
+		OutboundMiddleware: yarpc.OutboundMiddleware{
+			Unary: &common.ServicePrincipalOutboundMiddleware{},
+		},
 	})
 	if err := d.Start(); err != nil {
 		c.logger.WithField("error", err).Fatal("Failed to create outbound transport channel")
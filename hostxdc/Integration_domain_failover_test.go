@@ -126,6 +126,7 @@ func (s *testCluster) setupCluster(no int) {
 		clusterInfo.MasterClusterName,
 		clusterInfo.CurrentClusterName,
 		clusterInfo.ClusterInitialFailoverVersions,
+		clusterInfo.ReplicationConsumers,
 	)
 	s.TestBase = persistencetests.NewTestBaseWithCassandra(&options)
 	s.TestBase.Setup()
@@ -21,6 +21,8 @@
 namespace java com.uber.cadence.admin
 
 include "shared.thrift"
+include "replicator.thrift"
+include "history.thrift"
 
 /**
 * AdminService provides advanced APIs for debugging and analysis with admin privillege
@@ -46,6 +48,238 @@ service AdminService {
         2: shared.InternalServiceError  internalServiceError,
         3: shared.AccessDeniedError     accessDeniedError,
       )
+
+  /**
+  * MigrateWorkflowHistory copies the history of a workflow execution from the deprecated history
+  * tables into a new history tree and switches the workflow execution to the new event store version.
+  **/
+  MigrateWorkflowHistoryResponse MigrateWorkflowHistory(1: MigrateWorkflowHistoryRequest request)
+    throws (
+      1: shared.BadRequestError         badRequestError,
+      2: shared.InternalServiceError    internalServiceError,
+      3: shared.EntityNotExistsError    entityNotExistError,
+      4: shared.ServiceBusyError        serviceBusyError,
+      5: shared.AccessDeniedError       accessDeniedError,
+    )
+
+  /**
+  * GetReplicationMessages returns new replication tasks since the read level provided in the token.
+  * It is used by remote clusters which pull replication tasks over rpc instead of consuming them from kafka.
+  **/
+  replicator.GetReplicationMessagesResponse GetReplicationMessages(1: replicator.GetReplicationMessagesRequest request)
+    throws (
+      1: shared.BadRequestError         badRequestError,
+      2: shared.InternalServiceError    internalServiceError,
+      3: shared.LimitExceededError      limitExceededError,
+      4: shared.ServiceBusyError        serviceBusyError,
+    )
+
+  /**
+  * GracefulFailoverDomain starts a graceful failover of a global domain to another cluster. The current active
+  * cluster stops accepting new writes for the domain and hands the domain over once the target cluster has caught
+  * up on replication, or once the failover timeout expires.
+  **/
+  GracefulFailoverDomainResponse GracefulFailoverDomain(1: GracefulFailoverDomainRequest request)
+    throws (
+      1: shared.BadRequestError         badRequestError,
+      2: shared.InternalServiceError    internalServiceError,
+      3: shared.EntityNotExistsError    entityNotExistError,
+      4: shared.ServiceBusyError        serviceBusyError,
+    )
+
+  /**
+  * DeleteDomain deletes a deprecated domain which has no open workflow executions. The domain status is set to
+  * DELETED and a system workflow is started to remove the executions, histories, task lists and visibility records
+  * of the domain, and finally the domain metadata, which frees the domain name.
+  **/
+  DeleteDomainResponse DeleteDomain(1: DeleteDomainRequest request)
+    throws (
+      1: shared.BadRequestError         badRequestError,
+      2: shared.InternalServiceError    internalServiceError,
+      3: shared.EntityNotExistsError    entityNotExistError,
+      4: shared.ServiceBusyError        serviceBusyError,
+    )
+
+  /**
+  * GetReplicationStatus returns the replication status of the current cluster against each remote cluster,
+  * aggregated over all the history shards and optionally narrowed down to a domain.
+  **/
+  GetReplicationStatusResponse GetReplicationStatus(1: GetReplicationStatusRequest request)
+    throws (
+      1: shared.BadRequestError         badRequestError,
+      2: shared.InternalServiceError    internalServiceError,
+      3: shared.EntityNotExistsError    entityNotExistError,
+      4: shared.ServiceBusyError        serviceBusyError,
+    )
+
+  /**
+  * GetWorkflowExecutionRawHistory returns the history of a workflow execution as the blobs stored by persistence,
+  * without deserializing them.
+  **/
+  shared.GetWorkflowExecutionRawHistoryResponse GetWorkflowExecutionRawHistory(1: shared.GetWorkflowExecutionRawHistoryRequest request)
+    throws (
+      1: shared.BadRequestError         badRequestError,
+      2: shared.InternalServiceError    internalServiceError,
+      3: shared.EntityNotExistsError    entityNotExistError,
+      4: shared.ServiceBusyError        serviceBusyError,
+    )
+
+  /**
+  * GetWorkflowReplicationTasks returns the history replication tasks of a range of events of a workflow,
+  * it is called by the remote clusters to fetch the history events they are missing.
+  **/
+  GetWorkflowReplicationTasksResponse GetWorkflowReplicationTasks(1: GetWorkflowReplicationTasksRequest request)
+    throws (
+      1: shared.BadRequestError         badRequestError,
+      2: shared.InternalServiceError    internalServiceError,
+      3: shared.EntityNotExistsError    entityNotExistError,
+      4: shared.ServiceBusyError        serviceBusyError,
+    )
+
+  /**
+  * ResendWorkflowHistory fetches a range of history events of a workflow from the source cluster
+  * and applies them to the current cluster.
+  **/
+  void ResendWorkflowHistory(1: ResendWorkflowHistoryRequest request)
+    throws (
+      1: shared.BadRequestError         badRequestError,
+      2: shared.InternalServiceError    internalServiceError,
+      3: shared.EntityNotExistsError    entityNotExistError,
+      4: shared.ServiceBusyError        serviceBusyError,
+    )
+
+  /**
+  * CheckReplicationConsistency compares the mutable state of a workflow execution in the current cluster
+  * with the one in a remote cluster, and optionally resends the missing history to the cluster behind.
+  **/
+  CheckReplicationConsistencyResponse CheckReplicationConsistency(1: CheckReplicationConsistencyRequest request)
+    throws (
+      1: shared.BadRequestError         badRequestError,
+      2: shared.InternalServiceError    internalServiceError,
+      3: shared.EntityNotExistsError    entityNotExistError,
+      4: shared.ServiceBusyError        serviceBusyError,
+    )
+
+  /**
+  * ReadDLQMessages returns replication tasks from the replication dead letter queue of a shard,
+  * which failed to be applied after all retries.
+  **/
+  ReadDLQMessagesResponse ReadDLQMessages(1: ReadDLQMessagesRequest request)
+    throws (
+      1: shared.BadRequestError         badRequestError,
+      2: shared.InternalServiceError    internalServiceError,
+      3: shared.ServiceBusyError        serviceBusyError,
+    )
+
+  /**
+  * PurgeDLQMessages deletes replication tasks up to and including the given message id
+  * from the replication dead letter queue of a shard.
+  **/
+  void PurgeDLQMessages(1: PurgeDLQMessagesRequest request)
+    throws (
+      1: shared.BadRequestError         badRequestError,
+      2: shared.InternalServiceError    internalServiceError,
+      3: shared.ServiceBusyError        serviceBusyError,
+    )
+
+  /**
+  * MergeDLQMessages re-applies a page of replication tasks from the replication dead letter queue of a shard
+  * and deletes them from the queue once they are applied.
+  **/
+  MergeDLQMessagesResponse MergeDLQMessages(1: MergeDLQMessagesRequest request)
+    throws (
+      1: shared.BadRequestError         badRequestError,
+      2: shared.InternalServiceError    internalServiceError,
+      3: shared.ServiceBusyError        serviceBusyError,
+    )
+
+  /**
+  * DescribeShard returns the ack levels, read levels, outstanding task counts and failover levels
+  * of the transfer, timer and replication queues of a shard.
+  **/
+  history.DescribeShardResponse DescribeShard(1: history.DescribeShardRequest request)
+    throws (
+      1: shared.BadRequestError         badRequestError,
+      2: shared.InternalServiceError    internalServiceError,
+      3: shared.ServiceBusyError        serviceBusyError,
+    )
+
+  /**
+  * ListShardTasks returns the pending tasks of a queue of a shard, starting from the queue ack level.
+  **/
+  history.ListShardTasksResponse ListShardTasks(1: history.ListShardTasksRequest request)
+    throws (
+      1: shared.BadRequestError         badRequestError,
+      2: shared.InternalServiceError    internalServiceError,
+      3: shared.ServiceBusyError        serviceBusyError,
+    )
+
+  /**
+  * RemoveShardTask force completes a transfer or timer task of a shard without processing it.
+  **/
+  void RemoveShardTask(1: history.RemoveShardTaskRequest request)
+    throws (
+      1: shared.BadRequestError         badRequestError,
+      2: shared.InternalServiceError    internalServiceError,
+      3: shared.EntityNotExistsError    entityNotExistError,
+      4: shared.ServiceBusyError        serviceBusyError,
+    )
+
+  /**
+  * RescheduleShardTask processes a transfer or timer task of a shard immediately,
+  * and acknowledges it if the processing succeeds.
+  **/
+  void RescheduleShardTask(1: history.RescheduleShardTaskRequest request)
+    throws (
+      1: shared.BadRequestError         badRequestError,
+      2: shared.InternalServiceError    internalServiceError,
+      3: shared.EntityNotExistsError    entityNotExistError,
+      4: shared.ServiceBusyError        serviceBusyError,
+    )
+
+  /**
+  * ListQuarantinedTasks returns the transfer or timer tasks of a shard which were moved to the quarantine
+  * after failing with non-transient errors.
+  **/
+  history.ListQuarantinedTasksResponse ListQuarantinedTasks(1: history.ListQuarantinedTasksRequest request)
+    throws (
+      1: shared.BadRequestError         badRequestError,
+      2: shared.InternalServiceError    internalServiceError,
+      3: shared.ServiceBusyError        serviceBusyError,
+    )
+
+  /**
+  * RetryQuarantinedTask processes a quarantined task immediately, and removes it from the quarantine
+  * if the processing succeeds.
+  **/
+  void RetryQuarantinedTask(1: history.RetryQuarantinedTaskRequest request)
+    throws (
+      1: shared.BadRequestError         badRequestError,
+      2: shared.InternalServiceError    internalServiceError,
+      3: shared.EntityNotExistsError    entityNotExistError,
+      4: shared.ServiceBusyError        serviceBusyError,
+    )
+
+  /**
+  * DropQuarantinedTask removes a quarantined task without processing it.
+  **/
+  void DropQuarantinedTask(1: history.DropQuarantinedTaskRequest request)
+    throws (
+      1: shared.BadRequestError         badRequestError,
+      2: shared.InternalServiceError    internalServiceError,
+      3: shared.ServiceBusyError        serviceBusyError,
+    )
+
+  /**
+  * CloseShard closes a shard on its current owner, so that the shard is reacquired by the host
+  * which owns it according to the membership ring, the drained hosts and the shard ownership overrides.
+  **/
+  void CloseShard(1: history.CloseShardRequest request)
+    throws (
+      1: shared.BadRequestError         badRequestError,
+      2: shared.InternalServiceError    internalServiceError,
+      3: shared.ServiceBusyError        serviceBusyError,
+    )
 }
 
 struct DescribeWorkflowExecutionRequest {
@@ -58,4 +292,129 @@ struct DescribeWorkflowExecutionResponse{
   20: optional string historyAddr
   40: optional string mutableStateInCache
   50: optional string mutableStateInDatabase
-}
\ No newline at end of file
+}
+
+struct MigrateWorkflowHistoryRequest {
+  10: optional string                       domain
+  20: optional shared.WorkflowExecution     execution
+  30: optional bool                         dryRun
+}
+
+struct MigrateWorkflowHistoryResponse {
+  10: optional bool migrated
+  20: optional i64 (js.type = "Long") historyBatchCount
+  30: optional i64 (js.type = "Long") historyEventCount
+  40: optional i64 (js.type = "Long") historySize
+}
+
+struct GracefulFailoverDomainRequest {
+  10: optional string domain
+  20: optional string activeClusterName
+  30: optional i32 failoverTimeoutInSeconds
+}
+
+struct GracefulFailoverDomainResponse {
+  10: optional shared.DomainFailoverInfo failoverInfo
+}
+
+struct DeleteDomainRequest {
+  10: optional string domain
+}
+
+struct DeleteDomainResponse {
+  // the system workflow deleting the domain, it can be queried for the deletion progress
+  10: optional string workflowId
+  20: optional string runId
+}
+
+struct GetReplicationStatusRequest {
+  10: optional string domain
+}
+
+struct ClusterReplicationStatus {
+  // replication tasks of the current cluster not yet processed by the remote cluster, the count is a
+  // lower bound if some of the shards have more pending tasks than scanned for the status
+  10: optional i64 (js.type = "Long") pendingTaskCount
+}
+
+struct GetReplicationStatusResponse {
+  10: optional string currentClusterName
+  20: optional map<string, ClusterReplicationStatus> clusters
+  // shards not reporting their status, e.g. during shard movement
+  30: optional list<i32> missingShardIDs
+}
+
+struct GetWorkflowReplicationTasksRequest {
+  10: optional string                   domainUUID
+  20: optional shared.WorkflowExecution execution
+  30: optional i64 (js.type = "Long")    firstEventId
+  40: optional i64 (js.type = "Long")    nextEventId
+}
+
+struct GetWorkflowReplicationTasksResponse {
+  10: optional list<replicator.ReplicationTask> replicationTasks
+}
+
+struct ResendWorkflowHistoryRequest {
+  10: optional string                   domain
+  20: optional shared.WorkflowExecution execution
+  30: optional string                   sourceCluster
+  40: optional i64 (js.type = "Long")    firstEventId
+  50: optional i64 (js.type = "Long")    nextEventId
+}
+
+struct CheckReplicationConsistencyRequest {
+  10: optional string                   domain
+  20: optional shared.WorkflowExecution execution
+  30: optional string                   remoteCluster
+  // resend the missing history events to the cluster behind if the next event IDs do not match
+  40: optional bool                     resendOnMismatch
+}
+
+struct MutableStateDiff {
+  10: optional string field
+  20: optional string currentCluster
+  30: optional string remoteCluster
+}
+
+struct CheckReplicationConsistencyResponse {
+  10: optional list<MutableStateDiff> diffs
+  20: optional bool                   resent
+}
+
+struct ReplicationDLQMessage {
+  10: optional i64 (js.type = "Long") messageId
+  20: optional i64 (js.type = "Long") createdTime
+  30: optional replicator.ReplicationTask replicationTask
+}
+
+struct ReadDLQMessagesRequest {
+  10: optional string sourceCluster
+  20: optional i32 shardID
+  30: optional i64 (js.type = "Long") inclusiveEndMessageID
+  40: optional i32 maximumPageSize
+  50: optional binary nextPageToken
+}
+
+struct ReadDLQMessagesResponse {
+  10: optional list<ReplicationDLQMessage> messages
+  20: optional binary nextPageToken
+}
+
+struct PurgeDLQMessagesRequest {
+  10: optional string sourceCluster
+  20: optional i32 shardID
+  30: optional i64 (js.type = "Long") inclusiveEndMessageID
+}
+
+struct MergeDLQMessagesRequest {
+  10: optional string sourceCluster
+  20: optional i32 shardID
+  30: optional i64 (js.type = "Long") inclusiveEndMessageID
+  40: optional i32 maximumPageSize
+  50: optional binary nextPageToken
+}
+
+struct MergeDLQMessagesResponse {
+  10: optional binary nextPageToken
+}
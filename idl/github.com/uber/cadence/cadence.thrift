@@ -122,6 +122,18 @@ service WorkflowService {
       4: shared.ServiceBusyError serviceBusyError,
     )
 
+  /**
+  * Returns the raw history of specified workflow execution as the blobs stored by persistence, without
+  * deserializing them.  It fails with 'EntityNotExistError' if speficied workflow execution in unknown to the service.
+  **/
+  shared.GetWorkflowExecutionRawHistoryResponse GetWorkflowExecutionRawHistory(1: shared.GetWorkflowExecutionRawHistoryRequest getRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
   /**
   * PollForDecisionTask is called by application worker to process DecisionTask from a specific taskList.  A
   * DecisionTask is dispatched to callers for active workflow executions, with pending decisions.
@@ -391,6 +403,81 @@ service WorkflowService {
       6: shared.LimitExceededError limitExceededError,
     )
 
+  /**
+  * PauseWorkflowExecution pauses a running workflow execution by recording WorkflowExecutionPaused event in the
+  * history. No decision or activity tasks are dispatched for a paused workflow execution, while timers keep firing and
+  * signals keep being recorded. It fails with 'BadRequestError' if the workflow execution is already paused.
+  **/
+  void PauseWorkflowExecution(1: shared.PauseWorkflowExecutionRequest pauseRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+    )
+
+  /**
+  * ResumeWorkflowExecution resumes a paused workflow execution by recording WorkflowExecutionResumed event in the
+  * history and dispatching the decision and activity tasks held while it was paused. It fails with 'BadRequestError'
+  * if the workflow execution is not paused.
+  **/
+  void ResumeWorkflowExecution(1: shared.ResumeWorkflowExecutionRequest resumeRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+    )
+
+  /**
+  * RetryActivityTask is an operator API to dispatch a pending activity immediately instead of waiting for its retry
+  * backoff to expire, optionally resetting its attempt counter. It records an 'ActivityTaskRetryRequested' event in the
+  * history and fails with 'BadRequestError' if the activity task is already started.
+  **/
+  void RetryActivityTask(1: shared.RetryActivityTaskRequest retryRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+    )
+
+  /**
+  * CompleteActivityTask is an operator API to complete a pending activity on behalf of the worker. It will result in
+  * a new 'ActivityTaskCompleted' event recording the operator identity and reason being written to the workflow
+  * history and a new DecisionTask created for the workflow instance.
+  **/
+  void CompleteActivityTask(1: shared.CompleteActivityTaskRequest completeRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+    )
+
+  /**
+  * FailActivityTask is an operator API to fail a pending activity on behalf of the worker. It will result in a new
+  * 'ActivityTaskFailed' event being written to the workflow history and a new DecisionTask created for the workflow
+  * instance, the activity is not retried even if it has a retry policy.
+  **/
+  void FailActivityTask(1: shared.FailActivityTaskRequest failRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+    )
+
   /**
   * ListOpenWorkflowExecutions is a visibility API to list the open executions in a specific domain.
   **/
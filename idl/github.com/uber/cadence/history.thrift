@@ -19,6 +19,7 @@
 // THE SOFTWARE.
 
 include "shared.thrift"
+include "replicator.thrift"
 
 namespace java com.uber.cadence.history
 
@@ -56,6 +57,29 @@ struct DescribeMutableStateResponse{
   40: optional string mutableStateInDatabase
 }
 
+struct MigrateWorkflowHistoryRequest {
+  10: optional string domainUUID
+  20: optional shared.WorkflowExecution execution
+  30: optional bool dryRun
+}
+
+struct MigrateWorkflowHistoryResponse {
+  10: optional bool migrated
+  20: optional i64 (js.type = "Long") historyBatchCount
+  30: optional i64 (js.type = "Long") historyEventCount
+  40: optional i64 (js.type = "Long") historySize
+}
+
+struct DeleteWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.WorkflowExecution execution
+}
+
+struct DeleteWorkflowExecutionResponse {
+  10: optional string taskList
+  20: optional string stickyTaskList
+}
+
 struct GetMutableStateRequest {
   10: optional string domainUUID
   20: optional shared.WorkflowExecution execution
@@ -187,6 +211,31 @@ struct TerminateWorkflowExecutionRequest {
   20: optional shared.TerminateWorkflowExecutionRequest terminateRequest
 }
 
+struct PauseWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.PauseWorkflowExecutionRequest pauseRequest
+}
+
+struct ResumeWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.ResumeWorkflowExecutionRequest resumeRequest
+}
+
+struct RetryActivityTaskRequest {
+  10: optional string domainUUID
+  20: optional shared.RetryActivityTaskRequest retryRequest
+}
+
+struct CompleteActivityTaskRequest {
+  10: optional string domainUUID
+  20: optional shared.CompleteActivityTaskRequest completeRequest
+}
+
+struct FailActivityTaskRequest {
+  10: optional string domainUUID
+  20: optional shared.FailActivityTaskRequest failRequest
+}
+
 struct RequestCancelWorkflowExecutionRequest {
   10: optional string domainUUID
   20: optional shared.RequestCancelWorkflowExecutionRequest cancelRequest
@@ -220,11 +269,6 @@ struct RecordChildExecutionCompletedRequest {
   50: optional shared.HistoryEvent completionEvent
 }
 
-struct ReplicationInfo {
-  10: optional i64 (js.type = "Long") version
-  20: optional i64 (js.type = "Long") lastEventId
-}
-
 struct ReplicateEventsRequest {
   10: optional string sourceCluster
   20: optional string domainUUID
@@ -232,7 +276,7 @@ struct ReplicateEventsRequest {
   40: optional i64 (js.type = "Long") firstEventId
   50: optional i64 (js.type = "Long") nextEventId
   60: optional i64 (js.type = "Long") version
-  70: optional map<string, ReplicationInfo> replicationInfo
+  70: optional map<string, shared.ReplicationInfo> replicationInfo
   80: optional shared.History history
   90: optional shared.History newRunHistory
   100: optional bool forceBufferEvents
@@ -260,10 +304,175 @@ struct SyncActivityRequest {
   110: optional i32 attempt
 }
 
+struct GetReplicationStatusRequest {
+  10: optional list<i32> shardIDs
+  // only count the pending replication tasks of this domain if set
+  20: optional string domainUUID
+}
+
+struct ShardClusterReplicationStatus {
+  // last replication task of the shard processed by the remote cluster
+  10: optional i64 (js.type = "Long") ackLevel
+  // replication tasks of the shard not yet processed by the remote cluster
+  20: optional i64 (js.type = "Long") pendingTaskCount
+}
+
+struct ShardReplicationStatus {
+  10: optional i32 shardID
+  20: optional i64 (js.type = "Long") replicationAckLevel
+  30: optional i64 (js.type = "Long") maxReadLevel
+  40: optional i64 (js.type = "Long") pendingTaskCount
+  50: optional map<string, ShardClusterReplicationStatus> clusters
+  // true if the shard has more replication tasks than scanned for the status, the pending task counts
+  // are then lower bounds
+  60: optional bool pendingTaskCountTruncated
+}
+
+struct GetWorkflowReplicationTasksRequest {
+  10: optional string domainUUID
+  20: optional shared.WorkflowExecution execution
+  30: optional i64 (js.type = "Long") firstEventId
+  40: optional i64 (js.type = "Long") nextEventId
+}
+
+struct GetWorkflowReplicationTasksResponse {
+  10: optional list<replicator.ReplicationTask> replicationTasks
+}
+
+struct GetReplicationStatusResponse {
+  10: optional list<ShardReplicationStatus> shards
+}
+
 /**
 * HistoryService provides API to start a new long running workflow instance, as well as query and update the history
 * of workflow instances already created.
 **/
+enum ShardQueueType {
+  Transfer,
+  Timer,
+  Replication,
+}
+
+struct ShardQueueState {
+  10: optional ShardQueueType queueType
+  20: optional string clusterName
+  // task ID for the transfer and replication queues, Unix Nano visibility time for the timer queue
+  30: optional i64 (js.type = "Long") ackLevel
+  40: optional i64 (js.type = "Long") readLevel
+  50: optional i32 outstandingTasks
+}
+
+struct ShardFailoverLevel {
+  10: optional ShardQueueType queueType
+  20: optional string failoverID
+  30: optional list<string> domainIDs
+  // Unix Nano
+  40: optional i64 (js.type = "Long") startTime
+  50: optional i64 (js.type = "Long") minLevel
+  60: optional i64 (js.type = "Long") currentLevel
+  70: optional i64 (js.type = "Long") maxLevel
+}
+
+struct DescribeShardRequest {
+  10: optional i32 shardID
+}
+
+struct DescribeShardResponse {
+  10: optional i32 shardID
+  20: optional string address
+  40: optional i64 (js.type = "Long") transferMaxReadLevel
+  50: optional list<ShardQueueState> queues
+  60: optional list<ShardFailoverLevel> failoverLevels
+}
+
+struct ShardTask {
+  10: optional i64 (js.type = "Long") taskID
+  20: optional i32 taskType
+  30: optional string domainID
+  40: optional string workflowID
+  50: optional string runID
+  // Unix Nano
+  60: optional i64 (js.type = "Long") visibilityTimestamp
+  70: optional i64 (js.type = "Long") version
+  80: optional i64 (js.type = "Long") eventID
+}
+
+struct ListShardTasksRequest {
+  10: optional i32 shardID
+  20: optional ShardQueueType queueType
+  30: optional i32 pageSize
+  40: optional binary nextPageToken
+}
+
+struct ListShardTasksResponse {
+  10: optional list<ShardTask> tasks
+  20: optional binary nextPageToken
+}
+
+struct RemoveShardTaskRequest {
+  10: optional i32 shardID
+  20: optional ShardQueueType queueType
+  30: optional i64 (js.type = "Long") taskID
+  // Unix Nano, only needed by the timer queue
+  40: optional i64 (js.type = "Long") visibilityTimestamp
+}
+
+struct RescheduleShardTaskRequest {
+  10: optional i32 shardID
+  20: optional ShardQueueType queueType
+  30: optional i64 (js.type = "Long") taskID
+  // Unix Nano, only needed by the timer queue
+  40: optional i64 (js.type = "Long") visibilityTimestamp
+}
+
+struct QuarantinedTask {
+  10: optional ShardQueueType queueType
+  20: optional i64 (js.type = "Long") taskID
+  30: optional i32 taskType
+  40: optional string domainID
+  50: optional string workflowID
+  60: optional string runID
+  // Unix Nano, only set for timer tasks
+  70: optional i64 (js.type = "Long") visibilityTimestamp
+  80: optional i32 attempt
+  // Unix Nano
+  90: optional i64 (js.type = "Long") quarantinedTimestamp
+  100: optional string error
+  110: optional string stackTrace
+}
+
+struct ListQuarantinedTasksRequest {
+  10: optional i32 shardID
+  20: optional ShardQueueType queueType
+  30: optional i32 pageSize
+  40: optional binary nextPageToken
+}
+
+struct ListQuarantinedTasksResponse {
+  10: optional list<QuarantinedTask> tasks
+  20: optional binary nextPageToken
+}
+
+struct RetryQuarantinedTaskRequest {
+  10: optional i32 shardID
+  20: optional ShardQueueType queueType
+  30: optional i64 (js.type = "Long") taskID
+  // Unix Nano, only needed by the timer queue
+  40: optional i64 (js.type = "Long") visibilityTimestamp
+}
+
+struct DropQuarantinedTaskRequest {
+  10: optional i32 shardID
+  20: optional ShardQueueType queueType
+  30: optional i64 (js.type = "Long") taskID
+  // Unix Nano, only needed by the timer queue
+  40: optional i64 (js.type = "Long") visibilityTimestamp
+}
+
+struct CloseShardRequest {
+  10: optional i32 shardID
+}
+
 service HistoryService {
   /**
   * StartWorkflowExecution starts a new long running workflow instance.  It will create the instance with
@@ -519,6 +728,81 @@ service HistoryService {
       7: shared.ServiceBusyError serviceBusyError,
     )
 
+  /**
+  * PauseWorkflowExecution pauses a running workflow execution by recording WorkflowExecutionPaused event in the
+  * history, decision and activity tasks of a paused workflow execution are held by the transfer queue.
+  **/
+  void PauseWorkflowExecution(1: PauseWorkflowExecutionRequest pauseRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * ResumeWorkflowExecution resumes a paused workflow execution by recording WorkflowExecutionResumed event in the
+  * history and scheduling the decision and activity tasks held while it was paused.
+  **/
+  void ResumeWorkflowExecution(1: ResumeWorkflowExecutionRequest resumeRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * RetryActivityTask records ActivityTaskRetryRequested event in the history and dispatches the pending activity
+  * immediately, optionally resetting its attempt counter.
+  **/
+  void RetryActivityTask(1: RetryActivityTaskRequest retryRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * CompleteActivityTask completes a pending activity on behalf of an operator by recording ActivityTaskCompleted event
+  * in the history.
+  **/
+  void CompleteActivityTask(1: CompleteActivityTaskRequest completeRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * FailActivityTask fails a pending activity on behalf of an operator by recording ActivityTaskFailed event in the
+  * history, the activity is not retried.
+  **/
+  void FailActivityTask(1: FailActivityTaskRequest failRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ServiceBusyError serviceBusyError,
+    )
+
   /**
   * RequestCancelWorkflowExecution is called by application worker when it wants to request cancellation of a workflow instance.
   * It will result in a new 'WorkflowExecutionCancelRequested' event being written to the workflow history and a new DecisionTask
@@ -630,6 +914,73 @@ service HistoryService {
       6: shared.LimitExceededError limitExceededError,
     )
 
+  /**
+  * MigrateWorkflowHistory copies the history of a workflow execution from the deprecated history
+  * tables into a new history tree and switches the workflow execution to the new event store version.
+  **/
+  MigrateWorkflowHistoryResponse MigrateWorkflowHistory(1: MigrateWorkflowHistoryRequest migrateRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.LimitExceededError limitExceededError,
+      6: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * DeleteWorkflowExecution deletes a closed workflow execution, with its history and its current execution row
+  * if it is the current run of the workflow id. It returns the task lists the execution used, the execution
+  * does not exist anymore once it succeeds.
+  **/
+  DeleteWorkflowExecutionResponse DeleteWorkflowExecution(1: DeleteWorkflowExecutionRequest deleteRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.LimitExceededError limitExceededError,
+      6: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * GetReplicationMessages returns the replication tasks of the given shards after the last retrieved message id,
+  * it is used by remote clusters which pull replication tasks instead of consuming them from kafka.
+  **/
+  replicator.GetReplicationMessagesResponse GetReplicationMessages(1: replicator.GetReplicationMessagesRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+      4: shared.LimitExceededError limitExceededError,
+      5: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * GetReplicationStatus returns the replication ack level and the number of replication tasks
+  * not yet processed by the remote clusters for the given shards.
+  **/
+  GetReplicationStatusResponse GetReplicationStatus(1: GetReplicationStatusRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * GetWorkflowReplicationTasks rebuilds the history replication tasks of a range of events of a workflow,
+  * it is used by the remote clusters to fetch the history events they are missing.
+  **/
+  GetWorkflowReplicationTasksResponse GetWorkflowReplicationTasks(1: GetWorkflowReplicationTasksRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.ServiceBusyError serviceBusyError,
+    )
+
   /**
   * DescribeHistoryHost returns information about the internal states of a history host
   **/
@@ -639,4 +990,99 @@ service HistoryService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.AccessDeniedError accessDeniedError,
     )
+  /**
+  * DescribeShard returns the ack levels, read levels, outstanding task counts and failover levels
+  * of the transfer, timer and replication queues of a shard owned by this host.
+  **/
+  DescribeShardResponse DescribeShard(1: DescribeShardRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * ListShardTasks returns the pending tasks of a queue of a shard, starting from the queue ack level.
+  **/
+  ListShardTasksResponse ListShardTasks(1: ListShardTasksRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * RemoveShardTask force completes a transfer or timer task of a shard without processing it.
+  **/
+  void RemoveShardTask(1: RemoveShardTaskRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * RescheduleShardTask processes a transfer or timer task of a shard immediately,
+  * and acknowledges it if the processing succeeds.
+  **/
+  void RescheduleShardTask(1: RescheduleShardTaskRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * ListQuarantinedTasks returns the transfer or timer tasks of a shard which were moved to the quarantine
+  * after failing with non-transient errors.
+  **/
+  ListQuarantinedTasksResponse ListQuarantinedTasks(1: ListQuarantinedTasksRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * RetryQuarantinedTask processes a quarantined task immediately, and removes it from the quarantine
+  * if the processing succeeds.
+  **/
+  void RetryQuarantinedTask(1: RetryQuarantinedTaskRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * DropQuarantinedTask removes a quarantined task without processing it.
+  **/
+  void DropQuarantinedTask(1: DropQuarantinedTaskRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * CloseShard closes a shard on its current owner, so that the shard is reacquired by the host
+  * which owns it according to the membership ring, the drained hosts and the shard ownership overrides.
+  **/
+  void CloseShard(1: CloseShardRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
 }
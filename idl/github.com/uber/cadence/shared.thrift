@@ -71,6 +71,11 @@ exception AccessDeniedError {
 
 exception RetryTaskError {
   1: required string message
+  // set when history events of the workflow are missing, starting from nextEventId
+  2: optional string domainId
+  3: optional string workflowId
+  4: optional string runId
+  5: optional i64 nextEventId
 }
 
 enum WorkflowIdReusePolicy {
@@ -89,6 +94,12 @@ enum WorkflowIdReusePolicy {
    * do not allow start a workflow execution using the same workflow ID at all
    */
   RejectDuplicate,
+  /*
+   * terminate the current running workflow execution using the same workflow ID
+   * and start the new workflow execution in the same transaction,
+   * when workflow not running, same as AllowDuplicate
+   */
+  TerminateIfRunning,
 }
 
 enum DomainStatus {
@@ -165,6 +176,9 @@ enum EventType {
   SignalExternalWorkflowExecutionInitiated,
   SignalExternalWorkflowExecutionFailed,
   ExternalWorkflowExecutionSignaled,
+  WorkflowExecutionPaused,
+  WorkflowExecutionResumed,
+  ActivityTaskRetryRequested,
 }
 
 enum DecisionTaskFailedCause {
@@ -187,6 +201,7 @@ enum DecisionTaskFailedCause {
   FORCE_CLOSE_DECISION,
   FAILOVER_CLOSE_DECISION,
   BAD_SIGNAL_INPUT_SIZE,
+  WORKFLOW_LIMIT_EXCEEDED,
 }
 
 enum CancelExternalWorkflowExecutionFailedCause {
@@ -237,6 +252,11 @@ enum TaskListKind {
   STICKY,
 }
 
+enum EncodingType {
+  ThriftRW,
+  JSON,
+}
+
 struct Header {
     10: optional map<string, binary> fields
 }
@@ -401,6 +421,7 @@ struct WorkflowExecutionStartedEventAttributes {
   70: optional RetryPolicy retryPolicy
   80: optional i32 attempt
   90: optional i64 (js.type = "Long") expirationTimestamp
+  100: optional i32 firstDecisionTaskBackoffSeconds
 }
 
 struct WorkflowExecutionCompletedEventAttributes {
@@ -489,6 +510,7 @@ struct ActivityTaskCompletedEventAttributes {
   20: optional i64 (js.type = "Long") scheduledEventId
   30: optional i64 (js.type = "Long") startedEventId
   40: optional string identity
+  50: optional string reason
 }
 
 struct ActivityTaskFailedEventAttributes {
@@ -525,6 +547,14 @@ struct ActivityTaskCanceledEventAttributes {
   50: optional string identity
 }
 
+struct ActivityTaskRetryRequestedEventAttributes {
+  10: optional i64 (js.type = "Long") scheduledEventId
+  20: optional string activityId
+  30: optional i32 attempt
+  40: optional string reason
+  50: optional string identity
+}
+
 struct TimerStartedEventAttributes {
   10: optional string timerId
   20: optional i64 (js.type = "Long") startToFireTimeoutSeconds
@@ -581,6 +611,16 @@ struct WorkflowExecutionTerminatedEventAttributes {
   30: optional string identity
 }
 
+struct WorkflowExecutionPausedEventAttributes {
+  10: optional string reason
+  20: optional string identity
+}
+
+struct WorkflowExecutionResumedEventAttributes {
+  10: optional string reason
+  20: optional string identity
+}
+
 struct RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {
   10: optional i64 (js.type = "Long") decisionTaskCompletedEventId
   20: optional string domain
@@ -753,12 +793,20 @@ struct HistoryEvent {
   420: optional SignalExternalWorkflowExecutionInitiatedEventAttributes signalExternalWorkflowExecutionInitiatedEventAttributes
   430: optional SignalExternalWorkflowExecutionFailedEventAttributes signalExternalWorkflowExecutionFailedEventAttributes
   440: optional ExternalWorkflowExecutionSignaledEventAttributes externalWorkflowExecutionSignaledEventAttributes
+  450: optional WorkflowExecutionPausedEventAttributes workflowExecutionPausedEventAttributes
+  460: optional WorkflowExecutionResumedEventAttributes workflowExecutionResumedEventAttributes
+  470: optional ActivityTaskRetryRequestedEventAttributes activityTaskRetryRequestedEventAttributes
 }
 
 struct History {
   10: optional list<HistoryEvent> events
 }
 
+struct DataBlob {
+  10: optional EncodingType EncodingType
+  20: optional binary Data
+}
+
 struct WorkflowExecutionFilter {
   10: optional string workflowId
 }
@@ -829,12 +877,20 @@ struct DescribeDomainRequest {
   10: optional string name
 }
 
+struct DomainFailoverInfo {
+  // target cluster of the graceful failover in progress
+  10: optional string pendingActiveClusterName
+  // time by which the domain is handed over to the target cluster even if it has not caught up on replication
+  20: optional i64 (js.type = "Long") failoverExpireTimestamp
+}
+
 struct DescribeDomainResponse {
   10: optional DomainInfo domainInfo
   20: optional DomainConfiguration configuration
   30: optional DomainReplicationConfiguration replicationConfiguration
   40: optional i64 (js.type = "Long") failoverVersion
   50: optional bool isGlobalDomain
+  60: optional DomainFailoverInfo failoverInfo
 }
 
 struct UpdateDomainRequest {
@@ -871,6 +927,7 @@ struct StartWorkflowExecutionRequest {
   100: optional WorkflowIdReusePolicy workflowIdReusePolicy
   110: optional ChildPolicy childPolicy
   120: optional RetryPolicy retryPolicy
+  130: optional i32 delayStartSeconds
 }
 
 struct StartWorkflowExecutionResponse {
@@ -895,6 +952,8 @@ struct PollForDecisionTaskResponse {
   70: optional binary nextPageToken
   80: optional WorkflowQuery query
   90: optional TaskList WorkflowExecutionTaskList
+  // set instead of history for clients supporting raw history, nextPageToken then has to be used with GetWorkflowExecutionRawHistory
+  100: optional list<DataBlob> rawHistory
 }
 
 struct StickyExecutionAttributes {
@@ -1036,6 +1095,18 @@ struct GetWorkflowExecutionHistoryResponse {
   20: optional binary nextPageToken
 }
 
+struct GetWorkflowExecutionRawHistoryRequest {
+  10: optional string domain
+  20: optional WorkflowExecution execution
+  30: optional i32 maximumPageSize
+  40: optional binary nextPageToken
+}
+
+struct GetWorkflowExecutionRawHistoryResponse {
+  10: optional binary nextPageToken
+  20: optional list<DataBlob> rawHistory
+}
+
 struct SignalWorkflowExecutionRequest {
   10: optional string domain
   20: optional WorkflowExecution workflowExecution
@@ -1061,6 +1132,7 @@ struct SignalWithStartWorkflowExecutionRequest {
   120: optional binary signalInput
   130: optional binary control
   140: optional RetryPolicy retryPolicy
+  150: optional i32 delayStartSeconds
 }
 
 struct TerminateWorkflowExecutionRequest {
@@ -1071,6 +1143,47 @@ struct TerminateWorkflowExecutionRequest {
   50: optional string identity
 }
 
+struct PauseWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional WorkflowExecution workflowExecution
+  30: optional string reason
+  40: optional string identity
+}
+
+struct ResumeWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional WorkflowExecution workflowExecution
+  30: optional string reason
+  40: optional string identity
+}
+
+struct RetryActivityTaskRequest {
+  10: optional string domain
+  20: optional WorkflowExecution workflowExecution
+  30: optional string activityID
+  40: optional bool resetAttempts
+  50: optional string reason
+  60: optional string identity
+}
+
+struct CompleteActivityTaskRequest {
+  10: optional string domain
+  20: optional WorkflowExecution workflowExecution
+  30: optional string activityID
+  40: optional binary result
+  50: optional string reason
+  60: optional string identity
+}
+
+struct FailActivityTaskRequest {
+  10: optional string domain
+  20: optional WorkflowExecution workflowExecution
+  30: optional string activityID
+  40: optional string reason
+  50: optional binary details
+  60: optional string identity
+}
+
 struct ListOpenWorkflowExecutionsRequest {
   10: optional string domain
   20: optional i32 maximumPageSize
@@ -1149,6 +1262,7 @@ struct DescribeWorkflowExecutionResponse {
   10: optional WorkflowExecutionConfiguration executionConfiguration
   20: optional WorkflowExecutionInfo workflowExecutionInfo
   30: optional list<PendingActivityInfo> pendingActivities
+  40: optional bool paused
 }
 
 struct DescribeTaskListRequest {
@@ -1174,6 +1288,7 @@ struct DescribeHistoryHostResponse{
   30: optional DomainCacheInfo      domainCache
   40: optional string               shardControllerStatus
   50: optional string               address
+  60: optional bool                 draining
 }
 
 struct DomainCacheInfo{
@@ -1238,3 +1353,8 @@ struct HistoryBranch{
   20: optional string branchID
   30: optional list<HistoryBranchRange>  ancestors
 }
+
+struct ReplicationInfo {
+  10: optional i64 (js.type = "Long") version
+  20: optional i64 (js.type = "Long") lastEventId
+}
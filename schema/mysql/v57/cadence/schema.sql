@@ -17,8 +17,12 @@ CREATE TABLE domains(
   is_global_domain TINYINT(1) NOT NULL,
 /* domain_replication_config */
   active_cluster_name VARCHAR(255) NOT NULL,
-  clusters BLOB
+  clusters BLOB,
 /* end domain_replication_config */
+/* graceful failover */
+  pending_active_cluster_name VARCHAR(255) NOT NULL DEFAULT '',
+  failover_end_time BIGINT NOT NULL DEFAULT 0
+/* end graceful failover */
 ) DEFAULT CHARACTER SET utf8 COLLATE utf8_unicode_ci;
 
 CREATE TABLE domain_metadata (
@@ -38,6 +42,7 @@ CREATE TABLE shards (
 	timer_ack_level TIMESTAMP(3) NOT NULL DEFAULT '1970-01-01 00:00:01.000',
 	cluster_transfer_ack_level BLOB NOT NULL,
 	cluster_timer_ack_level BLOB NOT NULL,
+	cluster_replication_level BLOB,
 	domain_notification_version BIGINT NOT NULL,
 	PRIMARY KEY (shard_id)
 );
@@ -106,6 +111,9 @@ CREATE TABLE executions(
 	client_library_version VARCHAR(255) NOT NULL, -- 3.
 	client_feature_version VARCHAR(255) NOT NULL, -- 4.
 	client_impl VARCHAR(255) NOT NULL, -- 5.
+	paused TINYINT(1) NOT NULL DEFAULT 0,
+	has_decision_backoff TINYINT(1) NOT NULL DEFAULT 0,
+	has_events_v1_history TINYINT(1) NOT NULL DEFAULT 0,
 	PRIMARY KEY (shard_id, domain_id, workflow_id, run_id)
 );
 
@@ -22,40 +22,73 @@ package frontend
 
 import (
 	"context"
-
+	"encoding/json"
+	"fmt"
 	"strconv"
+	"time"
 
+	"github.com/pborman/uuid"
+	"github.com/uber-common/bark"
 	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
 	"github.com/uber/cadence/.gen/go/admin/adminserviceserver"
 	hist "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
 	gen "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/codec"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/xdc"
 )
 
 var _ adminserviceserver.Interface = (*AdminHandler)(nil)
 
+const (
+	// the deletion workflow retries its failed steps, a workflow timing out can be restarted by deleting the domain again
+	domainDeletionWorkflowTimeoutInSeconds = 7 * 24 * 3600
+	domainDeletionDecisionTimeoutInSeconds = 60
+)
+
 type (
 	// AdminHandler - Thrift handler inteface for admin service
 	AdminHandler struct {
 		numberOfHistoryShards int
 		service.Service
-		history     history.Client
-		domainCache cache.DomainCache
+		history        history.Client
+		domainCache    cache.DomainCache
+		metadataMgr    persistence.MetadataManager
+		historyV2Mgr   persistence.HistoryV2Manager
+		visibilityMgr  persistence.VisibilityManager
+		replicationDLQ persistence.ReplicationDLQManager
+		rpcFactory     common.RPCFactory
+		msgEncoder     codec.BinaryEncoder
+		authorizer     authorization.Authorizer
 	}
 )
 
 // NewAdminHandler creates a thrift handler for the cadence admin service
 func NewAdminHandler(
-	sVice service.Service, numberOfHistoryShards int, metadataMgr persistence.MetadataManager) *AdminHandler {
+	sVice service.Service, numberOfHistoryShards int, metadataMgr persistence.MetadataManager,
+	historyV2Mgr persistence.HistoryV2Manager, visibilityMgr persistence.VisibilityManager,
+	replicationDLQ persistence.ReplicationDLQManager, rpcFactory common.RPCFactory,
+	authorizer authorization.Authorizer) *AdminHandler {
 	handler := &AdminHandler{
 		numberOfHistoryShards: numberOfHistoryShards,
 		Service:               sVice,
 		domainCache:           cache.NewDomainCache(metadataMgr, sVice.GetClusterMetadata(), sVice.GetMetricsClient(), sVice.GetLogger()),
+		metadataMgr:           metadataMgr,
+		historyV2Mgr:          historyV2Mgr,
+		visibilityMgr:         visibilityMgr,
+		replicationDLQ:        replicationDLQ,
+		rpcFactory:            rpcFactory,
+		msgEncoder:            codec.NewThriftRWEncoder(),
+		authorizer:            authorizer,
 	}
 	return handler
 }
@@ -81,6 +114,10 @@ func (adh *AdminHandler) Stop() {
 
 // DescribeWorkflowExecution returns information about the specified workflow execution.
 func (adh *AdminHandler) DescribeWorkflowExecution(ctx context.Context, request *admin.DescribeWorkflowExecutionRequest) (*admin.DescribeWorkflowExecutionResponse, error) {
+	if err := adh.authorize(ctx, "DescribeWorkflowExecution", request.GetDomain()); err != nil {
+		return nil, err
+	}
+
 	if request == nil {
 		return nil, adh.error(errRequestNotSet)
 	}
@@ -90,7 +127,7 @@ func (adh *AdminHandler) DescribeWorkflowExecution(ctx context.Context, request
 	}
 
 	shardID := common.WorkflowIDToHistoryShard(*request.Execution.WorkflowId, adh.numberOfHistoryShards)
-	shardIDstr := string(shardID)
+	shardIDstr := membership.ShardKey(shardID)
 	shardIDForOutput := strconv.Itoa(shardID)
 
 	historyHost, err := adh.GetMembershipMonitor().Lookup(common.HistoryServiceName, shardIDstr)
@@ -118,6 +155,10 @@ func (adh *AdminHandler) DescribeWorkflowExecution(ctx context.Context, request
 
 // DescribeHistoryHost returns information about the internal states of a history host
 func (adh *AdminHandler) DescribeHistoryHost(ctx context.Context, request *gen.DescribeHistoryHostRequest) (*gen.DescribeHistoryHostResponse, error) {
+	if err := adh.authorize(ctx, "DescribeHistoryHost", ""); err != nil {
+		return nil, err
+	}
+
 	if request == nil || (request.ShardIdForHost == nil && request.ExecutionForHost == nil && request.HostAddress == nil) {
 		return nil, adh.error(errRequestNotSet)
 	}
@@ -132,6 +173,955 @@ func (adh *AdminHandler) DescribeHistoryHost(ctx context.Context, request *gen.D
 	return resp, err
 }
 
+// GetWorkflowExecutionRawHistory returns the history of a workflow execution as the blobs stored by persistence
+func (adh *AdminHandler) GetWorkflowExecutionRawHistory(ctx context.Context,
+	request *gen.GetWorkflowExecutionRawHistoryRequest) (*gen.GetWorkflowExecutionRawHistoryResponse, error) {
+	if err := adh.authorize(ctx, "GetWorkflowExecutionRawHistory", request.GetDomain()); err != nil {
+		return nil, err
+	}
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet)
+	}
+
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet)
+	}
+
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err)
+	}
+
+	if request.GetMaximumPageSize() <= 0 {
+		return nil, adh.error(errInvalidPageSize)
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err)
+	}
+
+	resp, err := getWorkflowExecutionRawHistory(ctx, adh.history, adh.historyV2Mgr, domainID, request)
+	if err != nil {
+		return nil, adh.error(err)
+	}
+	return resp, nil
+}
+
+// MigrateWorkflowHistory copies the history of a workflow execution into the events v2 store
+func (adh *AdminHandler) MigrateWorkflowHistory(ctx context.Context, request *admin.MigrateWorkflowHistoryRequest) (*admin.MigrateWorkflowHistoryResponse, error) {
+	if err := adh.authorize(ctx, "MigrateWorkflowHistory", request.GetDomain()); err != nil {
+		return nil, err
+	}
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet)
+	}
+
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet)
+	}
+
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err)
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err)
+	}
+
+	resp, err := adh.history.MigrateWorkflowHistory(ctx, &hist.MigrateWorkflowHistoryRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  request.Execution,
+		DryRun:     request.DryRun,
+	})
+	if err != nil {
+		return nil, adh.error(err)
+	}
+	return &admin.MigrateWorkflowHistoryResponse{
+		Migrated:          resp.Migrated,
+		HistoryBatchCount: resp.HistoryBatchCount,
+		HistoryEventCount: resp.HistoryEventCount,
+		HistorySize:       resp.HistorySize,
+	}, nil
+}
+
+// GetWorkflowReplicationTasks returns the history replication tasks of a range of events of a workflow execution,
+// it is used by remote clusters to fetch the history events they are missing
+func (adh *AdminHandler) GetWorkflowReplicationTasks(ctx context.Context, request *admin.GetWorkflowReplicationTasksRequest) (*admin.GetWorkflowReplicationTasksResponse, error) {
+	if err := adh.authorize(ctx, "GetWorkflowReplicationTasks", ""); err != nil {
+		return nil, err
+	}
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet)
+	}
+
+	if request.GetDomainUUID() == "" {
+		return nil, adh.error(errDomainNotSet)
+	}
+
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err)
+	}
+
+	resp, err := adh.history.GetWorkflowReplicationTasks(ctx, &hist.GetWorkflowReplicationTasksRequest{
+		DomainUUID:   request.DomainUUID,
+		Execution:    request.Execution,
+		FirstEventId: request.FirstEventId,
+		NextEventId:  request.NextEventId,
+	})
+	if err != nil {
+		return nil, adh.error(err)
+	}
+	return &admin.GetWorkflowReplicationTasksResponse{
+		ReplicationTasks: resp.ReplicationTasks,
+	}, nil
+}
+
+// ResendWorkflowHistory fetches a range of history events of a workflow execution from the source cluster
+// and applies them to the current cluster
+func (adh *AdminHandler) ResendWorkflowHistory(ctx context.Context, request *admin.ResendWorkflowHistoryRequest) error {
+	if err := adh.authorize(ctx, "ResendWorkflowHistory", request.GetDomain()); err != nil {
+		return err
+	}
+
+	if request == nil {
+		return adh.error(errRequestNotSet)
+	}
+
+	if request.GetDomain() == "" {
+		return adh.error(errDomainNotSet)
+	}
+
+	if err := validateExecution(request.Execution); err != nil {
+		return adh.error(err)
+	}
+
+	sourceCluster := request.GetSourceCluster()
+	if err := adh.validateRemoteCluster(sourceCluster); err != nil {
+		return adh.error(err)
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return adh.error(err)
+	}
+
+	adminClient, err := adh.getRemoteAdminClient(sourceCluster)
+	if err != nil {
+		return adh.error(err)
+	}
+
+	resender := xdc.NewHistoryResender(sourceCluster, adminClient, adh.history, adh.GetLogger())
+	err = resender.SendMissingHistory(ctx, domainID, request.Execution.GetWorkflowId(), request.Execution.GetRunId(),
+		request.GetFirstEventId(), request.GetNextEventId())
+	if err != nil {
+		return adh.error(err)
+	}
+	return nil
+}
+
+// CheckReplicationConsistency compares the mutable state of a workflow execution in the current cluster with the one
+// in a remote cluster, and resends the missing history events to the cluster behind if requested
+func (adh *AdminHandler) CheckReplicationConsistency(ctx context.Context, request *admin.CheckReplicationConsistencyRequest) (*admin.CheckReplicationConsistencyResponse, error) {
+	if err := adh.authorize(ctx, "CheckReplicationConsistency", request.GetDomain()); err != nil {
+		return nil, err
+	}
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet)
+	}
+
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet)
+	}
+
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err)
+	}
+
+	remoteCluster := request.GetRemoteCluster()
+	if err := adh.validateRemoteCluster(remoteCluster); err != nil {
+		return nil, adh.error(err)
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err)
+	}
+
+	adminClient, err := adh.getRemoteAdminClient(remoteCluster)
+	if err != nil {
+		return nil, adh.error(err)
+	}
+
+	result, err := xdc.NewMutableStateChecker(adminClient, adh.history).CheckWorkflow(ctx, domainID, request.GetDomain(),
+		request.Execution)
+	if err != nil {
+		return nil, adh.error(err)
+	}
+
+	resp := &admin.CheckReplicationConsistencyResponse{
+		Diffs:  result.Diffs,
+		Resent: common.BoolPtr(false),
+	}
+	if !request.GetResendOnMismatch() || result.CurrentNextEventID == result.RemoteNextEventID {
+		return resp, nil
+	}
+
+	workflowID := request.Execution.GetWorkflowId()
+	if result.CurrentNextEventID < result.RemoteNextEventID {
+		resender := xdc.NewHistoryResender(remoteCluster, adminClient, adh.history, adh.GetLogger())
+		err = resender.SendMissingHistory(ctx, domainID, workflowID, result.RunID, result.CurrentNextEventID,
+			result.RemoteNextEventID)
+	} else {
+		err = adminClient.ResendWorkflowHistory(ctx, &admin.ResendWorkflowHistoryRequest{
+			Domain: request.Domain,
+			Execution: &gen.WorkflowExecution{
+				WorkflowId: common.StringPtr(workflowID),
+				RunId:      common.StringPtr(result.RunID),
+			},
+			SourceCluster: common.StringPtr(adh.GetClusterMetadata().GetCurrentClusterName()),
+			FirstEventId:  common.Int64Ptr(result.RemoteNextEventID),
+			NextEventId:   common.Int64Ptr(result.CurrentNextEventID),
+		})
+	}
+	if err != nil {
+		return nil, adh.error(err)
+	}
+	resp.Resent = common.BoolPtr(true)
+	return resp, nil
+}
+
+// GetReplicationMessages returns the replication tasks of the given shards, it is used by remote clusters
+// pulling the replication tasks over rpc
+func (adh *AdminHandler) GetReplicationMessages(ctx context.Context, request *replicator.GetReplicationMessagesRequest) (*replicator.GetReplicationMessagesResponse, error) {
+	if err := adh.authorize(ctx, "GetReplicationMessages", ""); err != nil {
+		return nil, err
+	}
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet)
+	}
+
+	if request.GetClusterName() == "" {
+		return nil, adh.error(errClusterNameNotSet)
+	}
+
+	resp, err := adh.history.GetReplicationMessages(ctx, request)
+	if err != nil {
+		return nil, adh.error(err)
+	}
+	return resp, nil
+}
+
+// ReadDLQMessages returns a page of replication tasks from the replication DLQ of a shard
+func (adh *AdminHandler) ReadDLQMessages(ctx context.Context, request *admin.ReadDLQMessagesRequest) (*admin.ReadDLQMessagesResponse, error) {
+	if err := adh.authorize(ctx, "ReadDLQMessages", ""); err != nil {
+		return nil, err
+	}
+
+	if err := adh.validateDLQRequest(request.GetSourceCluster(), request.ShardID); err != nil {
+		return nil, adh.error(err)
+	}
+	if request.GetMaximumPageSize() <= 0 {
+		return nil, adh.error(errInvalidPageSize)
+	}
+
+	tasks, token, err := adh.readDLQTasks(request.GetSourceCluster(), request.GetShardID(),
+		request.GetInclusiveEndMessageID(), request.GetMaximumPageSize(), request.NextPageToken)
+	if err != nil {
+		return nil, adh.error(err)
+	}
+
+	messages := make([]*admin.ReplicationDLQMessage, 0, len(tasks))
+	for _, task := range tasks {
+		replicationTask, err := adh.deserializeDLQTask(task)
+		if err != nil {
+			return nil, adh.error(err)
+		}
+		messages = append(messages, &admin.ReplicationDLQMessage{
+			MessageId:       common.Int64Ptr(task.TaskID),
+			CreatedTime:     common.Int64Ptr(task.CreatedTime.UnixNano()),
+			ReplicationTask: replicationTask,
+		})
+	}
+
+	return &admin.ReadDLQMessagesResponse{
+		Messages:      messages,
+		NextPageToken: token,
+	}, nil
+}
+
+// PurgeDLQMessages deletes the replication tasks up to the given message id from the replication DLQ of a shard
+func (adh *AdminHandler) PurgeDLQMessages(ctx context.Context, request *admin.PurgeDLQMessagesRequest) error {
+	if err := adh.authorize(ctx, "PurgeDLQMessages", ""); err != nil {
+		return err
+	}
+
+	if err := adh.validateDLQRequest(request.GetSourceCluster(), request.ShardID); err != nil {
+		return adh.error(err)
+	}
+
+	err := adh.replicationDLQ.RangeDeleteReplicationTaskFromDLQ(&persistence.RangeDeleteReplicationTaskFromDLQRequest{
+		SourceCluster:        request.GetSourceCluster(),
+		ShardID:              int(request.GetShardID()),
+		ExclusiveBeginTaskID: -1,
+		InclusiveEndTaskID:   request.GetInclusiveEndMessageID(),
+	})
+	if err != nil {
+		return adh.error(err)
+	}
+	return nil
+}
+
+// MergeDLQMessages re-applies a page of replication tasks from the replication DLQ of a shard, the tasks applied
+// are deleted from the DLQ
+func (adh *AdminHandler) MergeDLQMessages(ctx context.Context, request *admin.MergeDLQMessagesRequest) (*admin.MergeDLQMessagesResponse, error) {
+	if err := adh.authorize(ctx, "MergeDLQMessages", ""); err != nil {
+		return nil, err
+	}
+
+	if err := adh.validateDLQRequest(request.GetSourceCluster(), request.ShardID); err != nil {
+		return nil, adh.error(err)
+	}
+	if request.GetMaximumPageSize() <= 0 {
+		return nil, adh.error(errInvalidPageSize)
+	}
+
+	tasks, token, err := adh.readDLQTasks(request.GetSourceCluster(), request.GetShardID(),
+		request.GetInclusiveEndMessageID(), request.GetMaximumPageSize(), request.NextPageToken)
+	if err != nil {
+		return nil, adh.error(err)
+	}
+
+	lastAppliedTaskID := int64(-1)
+	for _, task := range tasks {
+		replicationTask, err := adh.deserializeDLQTask(task)
+		if err == nil {
+			err = adh.applyDLQTask(ctx, request.GetSourceCluster(), replicationTask)
+		}
+		if err != nil {
+			// still delete the tasks already applied, so they are not applied again by the next merge
+			if lastAppliedTaskID != -1 {
+				adh.deleteDLQTasks(request.GetSourceCluster(), request.GetShardID(), lastAppliedTaskID)
+			}
+			return nil, adh.error(err)
+		}
+		lastAppliedTaskID = task.TaskID
+	}
+
+	if lastAppliedTaskID != -1 {
+		if err := adh.deleteDLQTasks(request.GetSourceCluster(), request.GetShardID(), lastAppliedTaskID); err != nil {
+			return nil, adh.error(err)
+		}
+	}
+
+	return &admin.MergeDLQMessagesResponse{
+		NextPageToken: token,
+	}, nil
+}
+
+// GracefulFailoverDomain starts a graceful failover of a global domain away from the current cluster.
+// Writes to the domain are rejected by the current cluster right away, and the domain is handed over to
+// the target cluster once all its replication tasks are processed, or once the failover timeout is reached.
+func (adh *AdminHandler) GracefulFailoverDomain(ctx context.Context, request *admin.GracefulFailoverDomainRequest) (*admin.GracefulFailoverDomainResponse, error) {
+	if err := adh.authorize(ctx, "GracefulFailoverDomain", request.GetDomain()); err != nil {
+		return nil, err
+	}
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet)
+	}
+	if request.GetActiveClusterName() == "" {
+		return nil, adh.error(errClusterNameNotSet)
+	}
+	if request.GetFailoverTimeoutInSeconds() <= 0 {
+		return nil, adh.error(errInvalidFailoverTimeout)
+	}
+
+	// must get the metadata (notificationVersion) first
+	// this version can be regarded as the lock on the v2 domain table
+	metadata, err := adh.metadataMgr.GetMetadata()
+	if err != nil {
+		return nil, adh.error(err)
+	}
+	getResponse, err := adh.metadataMgr.GetDomain(&persistence.GetDomainRequest{Name: request.GetDomain()})
+	if err != nil {
+		return nil, adh.error(err)
+	}
+
+	currentClusterName := adh.GetClusterMetadata().GetCurrentClusterName()
+	if !getResponse.IsGlobalDomain {
+		return nil, adh.error(errGracefulFailoverLocalDomain)
+	}
+	if getResponse.TableVersion != persistence.DomainTableVersionV2 {
+		return nil, adh.error(errGracefulFailoverDomainTableV1)
+	}
+	if getResponse.ReplicationConfig.ActiveClusterName != currentClusterName {
+		return nil, adh.error(errGracefulFailoverNotActive)
+	}
+	if request.GetActiveClusterName() == currentClusterName {
+		return nil, adh.error(errGracefulFailoverToCurrentCluster)
+	}
+	targetInClusters := false
+	for _, cluster := range getResponse.ReplicationConfig.Clusters {
+		if cluster.ClusterName == request.GetActiveClusterName() {
+			targetInClusters = true
+			break
+		}
+	}
+	if !targetInClusters {
+		return nil, adh.error(errActiveClusterNotInClusters)
+	}
+
+	failoverEndTime := time.Now().Add(time.Duration(request.GetFailoverTimeoutInSeconds()) * time.Second).UnixNano()
+	err = adh.metadataMgr.UpdateDomain(&persistence.UpdateDomainRequest{
+		Info:                        getResponse.Info,
+		Config:                      getResponse.Config,
+		ReplicationConfig:           getResponse.ReplicationConfig,
+		ConfigVersion:               getResponse.ConfigVersion,
+		FailoverVersion:             getResponse.FailoverVersion,
+		FailoverNotificationVersion: getResponse.FailoverNotificationVersion,
+		PendingActiveClusterName:    request.GetActiveClusterName(),
+		FailoverEndTime:             failoverEndTime,
+		NotificationVersion:         metadata.NotificationVersion,
+		TableVersion:                persistence.DomainTableVersionV2,
+	})
+	if err != nil {
+		return nil, adh.error(err)
+	}
+
+	return &admin.GracefulFailoverDomainResponse{
+		FailoverInfo: createDomainFailoverInfo(request.GetActiveClusterName(), failoverEndTime),
+	}, nil
+}
+
+// DeleteDomain deletes a deprecated domain with no open workflow executions. The domain status is set to DELETED,
+// so no new workflow can be started in it, and the deletion system workflow is started to remove the domain data.
+// Deleting a domain already in the DELETED status restarts the deletion workflow if it is not running anymore.
+func (adh *AdminHandler) DeleteDomain(ctx context.Context, request *admin.DeleteDomainRequest) (*admin.DeleteDomainResponse, error) {
+	if err := adh.authorize(ctx, "DeleteDomain", request.GetDomain()); err != nil {
+		return nil, err
+	}
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet)
+	}
+	if request.GetDomain() == common.SystemDomainName {
+		return nil, adh.error(errDeleteSystemDomain)
+	}
+
+	// must get the metadata (notificationVersion) first
+	// this version can be regarded as the lock on the v2 domain table
+	metadata, err := adh.metadataMgr.GetMetadata()
+	if err != nil {
+		return nil, adh.error(err)
+	}
+	getResponse, err := adh.metadataMgr.GetDomain(&persistence.GetDomainRequest{Name: request.GetDomain()})
+	if err != nil {
+		return nil, adh.error(err)
+	}
+	if getResponse.IsGlobalDomain {
+		return nil, adh.error(errDeleteGlobalDomain)
+	}
+
+	switch getResponse.Info.Status {
+	case persistence.DomainStatusDeprecated:
+		openResponse, err := adh.visibilityMgr.ListOpenWorkflowExecutions(&persistence.ListWorkflowExecutionsRequest{
+			DomainUUID:        getResponse.Info.ID,
+			Domain:            getResponse.Info.Name,
+			EarliestStartTime: 0,
+			LatestStartTime:   time.Now().UnixNano(),
+			PageSize:          1,
+		})
+		if err != nil {
+			return nil, adh.error(err)
+		}
+		if len(openResponse.Executions) > 0 {
+			return nil, adh.error(errDomainHasOpenExecutions)
+		}
+		if err := adh.markDomainDeleted(getResponse, metadata.NotificationVersion); err != nil {
+			return nil, adh.error(err)
+		}
+	case persistence.DomainStatusDeleted:
+		// the data removal is restarted below, in case the previous deletion workflow did not complete
+	default:
+		return nil, adh.error(errDomainNotDeprecated)
+	}
+
+	response, err := adh.startDomainDeletionWorkflow(ctx, getResponse.Info)
+	if err != nil {
+		return nil, adh.error(err)
+	}
+	return response, nil
+}
+
+func (adh *AdminHandler) markDomainDeleted(getResponse *persistence.GetDomainResponse, notificationVersion int64) error {
+	getResponse.Info.Status = persistence.DomainStatusDeleted
+	updateReq := &persistence.UpdateDomainRequest{
+		Info:                     getResponse.Info,
+		Config:                   getResponse.Config,
+		ReplicationConfig:        getResponse.ReplicationConfig,
+		ConfigVersion:            getResponse.ConfigVersion + 1,
+		FailoverVersion:          getResponse.FailoverVersion,
+		PendingActiveClusterName: getResponse.PendingActiveClusterName,
+		FailoverEndTime:          getResponse.FailoverEndTime,
+	}
+
+	switch getResponse.TableVersion {
+	case persistence.DomainTableVersionV1:
+		updateReq.NotificationVersion = getResponse.NotificationVersion
+		updateReq.TableVersion = persistence.DomainTableVersionV1
+	case persistence.DomainTableVersionV2:
+		updateReq.FailoverNotificationVersion = getResponse.FailoverNotificationVersion
+		updateReq.NotificationVersion = notificationVersion
+		updateReq.TableVersion = persistence.DomainTableVersionV2
+	default:
+		return &gen.InternalServiceError{Message: "domain table version is not set"}
+	}
+	return adh.metadataMgr.UpdateDomain(updateReq)
+}
+
+func (adh *AdminHandler) startDomainDeletionWorkflow(ctx context.Context,
+	info *persistence.DomainInfo) (*admin.DeleteDomainResponse, error) {
+
+	systemDomainID, err := adh.domainCache.GetDomainID(common.SystemDomainName)
+	if err != nil {
+		return nil, err
+	}
+	input, err := json.Marshal(&common.DomainDeletionParams{
+		DomainID:   info.ID,
+		DomainName: info.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	workflowID := common.DomainDeletionWorkflowID(info.Name)
+	startRequest := &gen.StartWorkflowExecutionRequest{
+		Domain:                              common.StringPtr(common.SystemDomainName),
+		WorkflowId:                          common.StringPtr(workflowID),
+		WorkflowType:                        &gen.WorkflowType{Name: common.StringPtr(common.DomainDeletionWorkflowTypeName)},
+		TaskList:                            &gen.TaskList{Name: common.StringPtr(common.SystemTaskListName)},
+		Input:                               input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(domainDeletionWorkflowTimeoutInSeconds),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(domainDeletionDecisionTimeoutInSeconds),
+		Identity:                            common.StringPtr(common.FrontendServiceName),
+		RequestId:                           common.StringPtr(uuid.New()),
+		WorkflowIdReusePolicy:               gen.WorkflowIdReusePolicyAllowDuplicate.Ptr(),
+	}
+	resp, err := adh.history.StartWorkflowExecution(ctx, common.CreateHistoryStartWorkflowRequest(systemDomainID, startRequest))
+	if err != nil {
+		if alreadyStarted, ok := err.(*gen.WorkflowExecutionAlreadyStartedError); ok {
+			// the domain is already being deleted
+			return &admin.DeleteDomainResponse{
+				WorkflowId: common.StringPtr(workflowID),
+				RunId:      alreadyStarted.RunId,
+			}, nil
+		}
+		return nil, err
+	}
+
+	return &admin.DeleteDomainResponse{
+		WorkflowId: common.StringPtr(workflowID),
+		RunId:      resp.RunId,
+	}, nil
+}
+
+// GetReplicationStatus returns the replication status of the current cluster against each remote cluster
+func (adh *AdminHandler) GetReplicationStatus(ctx context.Context, request *admin.GetReplicationStatusRequest) (*admin.GetReplicationStatusResponse, error) {
+	if err := adh.authorize(ctx, "GetReplicationStatus", request.GetDomain()); err != nil {
+		return nil, err
+	}
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet)
+	}
+
+	domainID := ""
+	if request.GetDomain() != "" {
+		var err error
+		domainID, err = adh.domainCache.GetDomainID(request.GetDomain())
+		if err != nil {
+			return nil, adh.error(err)
+		}
+	}
+
+	shardIDs := make([]int32, adh.numberOfHistoryShards)
+	for i := range shardIDs {
+		shardIDs[i] = int32(i)
+	}
+	resp, err := adh.history.GetReplicationStatus(ctx, &hist.GetReplicationStatusRequest{
+		ShardIDs:   shardIDs,
+		DomainUUID: common.StringPtr(domainID),
+	})
+	if err != nil {
+		return nil, adh.error(err)
+	}
+
+	response := aggregateReplicationStatus(resp.Shards, adh.numberOfHistoryShards)
+	response.CurrentClusterName = common.StringPtr(adh.GetClusterMetadata().GetCurrentClusterName())
+	return response, nil
+}
+
+func (adh *AdminHandler) validateRemoteCluster(clusterName string) error {
+	if clusterName == "" {
+		return errClusterNameNotSet
+	}
+	if clusterName == adh.GetClusterMetadata().GetCurrentClusterName() {
+		return errInvalidRemoteCluster
+	}
+	if _, ok := adh.GetClusterMetadata().GetAllClusterFailoverVersions()[clusterName]; !ok {
+		return errInvalidRemoteCluster
+	}
+	return nil
+}
+
+func (adh *AdminHandler) getRemoteAdminClient(clusterName string) (adminserviceclient.Interface, error) {
+	adminClient := xdc.NewSourceAdminClient(common.FrontendServiceName, clusterName, adh.GetClusterMetadata(), adh.rpcFactory)
+	if adminClient == nil {
+		return nil, &gen.BadRequestError{
+			Message: fmt.Sprintf("RPC address of cluster %v is not configured.", clusterName),
+		}
+	}
+	return adminClient, nil
+}
+
+// DescribeShard returns the states of the transfer, timer and replication queues of a shard
+func (adh *AdminHandler) DescribeShard(ctx context.Context, request *hist.DescribeShardRequest) (*hist.DescribeShardResponse, error) {
+	if err := adh.authorize(ctx, "DescribeShard", ""); err != nil {
+		return nil, err
+	}
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet)
+	}
+	if err := adh.validateShardID(request.ShardID); err != nil {
+		return nil, adh.error(err)
+	}
+
+	resp, err := adh.history.DescribeShard(ctx, request)
+	if err != nil {
+		return nil, adh.error(err)
+	}
+	return resp, nil
+}
+
+// ListShardTasks returns the pending tasks of a queue of a shard
+func (adh *AdminHandler) ListShardTasks(ctx context.Context, request *hist.ListShardTasksRequest) (*hist.ListShardTasksResponse, error) {
+	if err := adh.authorize(ctx, "ListShardTasks", ""); err != nil {
+		return nil, err
+	}
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet)
+	}
+	if err := adh.validateShardID(request.ShardID); err != nil {
+		return nil, adh.error(err)
+	}
+	if request.QueueType == nil {
+		return nil, adh.error(errQueueTypeNotSet)
+	}
+	if request.GetPageSize() < 0 {
+		return nil, adh.error(errInvalidPageSize)
+	}
+
+	resp, err := adh.history.ListShardTasks(ctx, request)
+	if err != nil {
+		return nil, adh.error(err)
+	}
+	return resp, nil
+}
+
+// RemoveShardTask force completes a transfer or timer task of a shard without processing it
+func (adh *AdminHandler) RemoveShardTask(ctx context.Context, request *hist.RemoveShardTaskRequest) error {
+	if err := adh.authorize(ctx, "RemoveShardTask", ""); err != nil {
+		return err
+	}
+
+	if request == nil {
+		return adh.error(errRequestNotSet)
+	}
+	if err := adh.validateShardID(request.ShardID); err != nil {
+		return adh.error(err)
+	}
+	if request.QueueType == nil {
+		return adh.error(errQueueTypeNotSet)
+	}
+	if request.TaskID == nil {
+		return adh.error(errTaskIDNotSet)
+	}
+
+	if err := adh.history.RemoveShardTask(ctx, request); err != nil {
+		return adh.error(err)
+	}
+	return nil
+}
+
+// RescheduleShardTask processes a transfer or timer task of a shard immediately
+func (adh *AdminHandler) RescheduleShardTask(ctx context.Context, request *hist.RescheduleShardTaskRequest) error {
+	if err := adh.authorize(ctx, "RescheduleShardTask", ""); err != nil {
+		return err
+	}
+
+	if request == nil {
+		return adh.error(errRequestNotSet)
+	}
+	if err := adh.validateShardID(request.ShardID); err != nil {
+		return adh.error(err)
+	}
+	if request.QueueType == nil {
+		return adh.error(errQueueTypeNotSet)
+	}
+	if request.TaskID == nil {
+		return adh.error(errTaskIDNotSet)
+	}
+
+	if err := adh.history.RescheduleShardTask(ctx, request); err != nil {
+		return adh.error(err)
+	}
+	return nil
+}
+
+// ListQuarantinedTasks returns the transfer or timer tasks of a shard which were moved to the quarantine
+func (adh *AdminHandler) ListQuarantinedTasks(ctx context.Context,
+	request *hist.ListQuarantinedTasksRequest) (*hist.ListQuarantinedTasksResponse, error) {
+	if err := adh.authorize(ctx, "ListQuarantinedTasks", ""); err != nil {
+		return nil, err
+	}
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet)
+	}
+	if err := adh.validateShardID(request.ShardID); err != nil {
+		return nil, adh.error(err)
+	}
+	if request.QueueType == nil {
+		return nil, adh.error(errQueueTypeNotSet)
+	}
+	if request.GetPageSize() < 0 {
+		return nil, adh.error(errInvalidPageSize)
+	}
+
+	resp, err := adh.history.ListQuarantinedTasks(ctx, request)
+	if err != nil {
+		return nil, adh.error(err)
+	}
+	return resp, nil
+}
+
+// RetryQuarantinedTask processes a quarantined task of a shard, and removes it from the quarantine if the processing succeeds
+func (adh *AdminHandler) RetryQuarantinedTask(ctx context.Context, request *hist.RetryQuarantinedTaskRequest) error {
+	if err := adh.authorize(ctx, "RetryQuarantinedTask", ""); err != nil {
+		return err
+	}
+
+	if request == nil {
+		return adh.error(errRequestNotSet)
+	}
+	if err := adh.validateShardID(request.ShardID); err != nil {
+		return adh.error(err)
+	}
+	if request.QueueType == nil {
+		return adh.error(errQueueTypeNotSet)
+	}
+	if request.TaskID == nil {
+		return adh.error(errTaskIDNotSet)
+	}
+
+	if err := adh.history.RetryQuarantinedTask(ctx, request); err != nil {
+		return adh.error(err)
+	}
+	return nil
+}
+
+// DropQuarantinedTask removes a quarantined task of a shard without processing it
+func (adh *AdminHandler) DropQuarantinedTask(ctx context.Context, request *hist.DropQuarantinedTaskRequest) error {
+	if err := adh.authorize(ctx, "DropQuarantinedTask", ""); err != nil {
+		return err
+	}
+
+	if request == nil {
+		return adh.error(errRequestNotSet)
+	}
+	if err := adh.validateShardID(request.ShardID); err != nil {
+		return adh.error(err)
+	}
+	if request.QueueType == nil {
+		return adh.error(errQueueTypeNotSet)
+	}
+	if request.TaskID == nil {
+		return adh.error(errTaskIDNotSet)
+	}
+
+	if err := adh.history.DropQuarantinedTask(ctx, request); err != nil {
+		return adh.error(err)
+	}
+	return nil
+}
+
+// CloseShard closes a shard on its current owner, so that it is reacquired by the host which owns it
+// according to the membership ring, the drained hosts and the shard ownership overrides
+func (adh *AdminHandler) CloseShard(ctx context.Context, request *hist.CloseShardRequest) error {
+	if err := adh.authorize(ctx, "CloseShard", ""); err != nil {
+		return err
+	}
+
+	if request == nil {
+		return adh.error(errRequestNotSet)
+	}
+	if err := adh.validateShardID(request.ShardID); err != nil {
+		return adh.error(err)
+	}
+
+	if err := adh.history.CloseShard(ctx, request); err != nil {
+		return adh.error(err)
+	}
+	return nil
+}
+
+// authorize checks that the caller of an inbound api call is allowed to call the admin api,
+// the domain name is empty for the apis not targeting a single domain
+func (adh *AdminHandler) authorize(ctx context.Context, apiName string, domainName string) error {
+	if !authorization.IsInboundCall(ctx) {
+		return nil
+	}
+	result, err := adh.authorizer.Authorize(ctx, &authorization.Attributes{
+		Actor:      authorization.GetCallerIdentity(ctx),
+		APIName:    apiName,
+		DomainName: domainName,
+	})
+	if err != nil {
+		return adh.error(err)
+	}
+	if result.Decision != authorization.DecisionAllow {
+		return errNoPermission
+	}
+	return nil
+}
+
+func (adh *AdminHandler) validateShardID(shardID *int32) error {
+	if shardID == nil {
+		return errShardIDNotSet
+	}
+	if *shardID < 0 || *shardID >= int32(adh.numberOfHistoryShards) {
+		return &gen.BadRequestError{Message: fmt.Sprintf("ShardID must be in the range [0, %v).", adh.numberOfHistoryShards)}
+	}
+	return nil
+}
+
+func (adh *AdminHandler) validateDLQRequest(sourceCluster string, shardID *int32) error {
+	if adh.replicationDLQ == nil {
+		return errReplicationDLQNotSupported
+	}
+	if sourceCluster == "" {
+		return errClusterNameNotSet
+	}
+	if shardID == nil {
+		return errShardIDNotSet
+	}
+	return nil
+}
+
+func (adh *AdminHandler) readDLQTasks(sourceCluster string, shardID int32, inclusiveEndMessageID int64,
+	pageSize int32, token []byte) ([]*persistence.ReplicationDLQTaskInfo, []byte, error) {
+	response, err := adh.replicationDLQ.GetReplicationTasksFromDLQ(&persistence.GetReplicationTasksFromDLQRequest{
+		SourceCluster: sourceCluster,
+		ShardID:       int(shardID),
+		ReadLevel:     -1,
+		MaxReadLevel:  inclusiveEndMessageID,
+		BatchSize:     int(pageSize),
+		NextPageToken: token,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return response.Tasks, response.NextPageToken, nil
+}
+
+func (adh *AdminHandler) deleteDLQTasks(sourceCluster string, shardID int32, inclusiveEndTaskID int64) error {
+	err := adh.replicationDLQ.RangeDeleteReplicationTaskFromDLQ(&persistence.RangeDeleteReplicationTaskFromDLQRequest{
+		SourceCluster:        sourceCluster,
+		ShardID:              int(shardID),
+		ExclusiveBeginTaskID: -1,
+		InclusiveEndTaskID:   inclusiveEndTaskID,
+	})
+	if err != nil {
+		adh.GetLogger().WithFields(bark.Fields{
+			logging.TagErr:           err,
+			logging.TagSourceCluster: sourceCluster,
+		}).Error("Failed to delete merged replication tasks from DLQ.")
+	}
+	return err
+}
+
+func (adh *AdminHandler) deserializeDLQTask(task *persistence.ReplicationDLQTaskInfo) (*replicator.ReplicationTask, error) {
+	if task.Encoding != common.EncodingTypeThriftRW {
+		return nil, &gen.InternalServiceError{
+			Message: fmt.Sprintf("unsupported replication DLQ task encoding: %v", task.Encoding),
+		}
+	}
+	replicationTask := &replicator.ReplicationTask{}
+	if err := adh.msgEncoder.Decode(task.Data, replicationTask); err != nil {
+		return nil, &gen.InternalServiceError{
+			Message: fmt.Sprintf("failed to decode replication DLQ task %v: %v", task.TaskID, err),
+		}
+	}
+	return replicationTask, nil
+}
+
+func (adh *AdminHandler) applyDLQTask(ctx context.Context, sourceCluster string, task *replicator.ReplicationTask) error {
+	switch task.GetTaskType() {
+	case replicator.ReplicationTaskTypeHistory:
+		attr := task.HistoryTaskAttributes
+		return adh.history.ReplicateEvents(ctx, &hist.ReplicateEventsRequest{
+			SourceCluster: common.StringPtr(sourceCluster),
+			DomainUUID:    attr.DomainId,
+			WorkflowExecution: &gen.WorkflowExecution{
+				WorkflowId: attr.WorkflowId,
+				RunId:      attr.RunId,
+			},
+			FirstEventId:            attr.FirstEventId,
+			NextEventId:             attr.NextEventId,
+			Version:                 attr.Version,
+			ReplicationInfo:         attr.ReplicationInfo,
+			History:                 attr.History,
+			NewRunHistory:           attr.NewRunHistory,
+			ForceBufferEvents:       common.BoolPtr(false),
+			EventStoreVersion:       attr.EventStoreVersion,
+			NewRunEventStoreVersion: attr.NewRunEventStoreVersion,
+		})
+	case replicator.ReplicationTaskTypeSyncActivity:
+		attr := task.SyncActicvityTaskAttributes
+		return adh.history.SyncActivity(ctx, &hist.SyncActivityRequest{
+			DomainId:          attr.DomainId,
+			WorkflowId:        attr.WorkflowId,
+			RunId:             attr.RunId,
+			Version:           attr.Version,
+			ScheduledId:       attr.ScheduledId,
+			ScheduledTime:     attr.ScheduledTime,
+			StartedId:         attr.StartedId,
+			StartedTime:       attr.StartedTime,
+			LastHeartbeatTime: attr.LastHeartbeatTime,
+			Details:           attr.Details,
+			Attempt:           attr.Attempt,
+		})
+	default:
+		return &gen.BadRequestError{
+			Message: fmt.Sprintf("unsupported replication DLQ task type: %v", task.GetTaskType()),
+		}
+	}
+}
+
 func (adh *AdminHandler) error(err error) error {
 	switch err.(type) {
 	case *gen.InternalServiceError:
@@ -148,3 +1138,36 @@ func (adh *AdminHandler) error(err error) error {
 		return &gen.InternalServiceError{Message: err.Error()}
 	}
 }
+
+// aggregateReplicationStatus sums up the replication tasks of all the shards pending for each remote cluster
+func aggregateReplicationStatus(shards []*hist.ShardReplicationStatus,
+	numberOfHistoryShards int) *admin.GetReplicationStatusResponse {
+
+	reported := make(map[int32]bool)
+	clusters := make(map[string]*admin.ClusterReplicationStatus)
+	for _, shard := range shards {
+		reported[shard.GetShardID()] = true
+		for clusterName, clusterStatus := range shard.Clusters {
+			status, ok := clusters[clusterName]
+			if !ok {
+				status = &admin.ClusterReplicationStatus{
+					PendingTaskCount: common.Int64Ptr(0),
+				}
+				clusters[clusterName] = status
+			}
+			status.PendingTaskCount = common.Int64Ptr(status.GetPendingTaskCount() + clusterStatus.GetPendingTaskCount())
+		}
+	}
+
+	missingShardIDs := []int32{}
+	for shardID := int32(0); shardID < int32(numberOfHistoryShards); shardID++ {
+		if !reported[shardID] {
+			missingShardIDs = append(missingShardIDs, shardID)
+		}
+	}
+
+	return &admin.GetReplicationStatusResponse{
+		Clusters:        clusters,
+		MissingShardIDs: missingShardIDs,
+	}
+}
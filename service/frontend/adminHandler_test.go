@@ -0,0 +1,92 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/uber/cadence/.gen/go/admin"
+	hist "github.com/uber/cadence/.gen/go/history"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestAggregateReplicationStatus(t *testing.T) {
+	shardStatus := func(shardID int32, pendingTaskCount int64) *hist.ShardReplicationStatus {
+		return &hist.ShardReplicationStatus{
+			ShardID: common.Int32Ptr(shardID),
+			Clusters: map[string]*hist.ShardClusterReplicationStatus{
+				"standby": {
+					PendingTaskCount: common.Int64Ptr(pendingTaskCount),
+				},
+			},
+		}
+	}
+
+	response := aggregateReplicationStatus([]*hist.ShardReplicationStatus{
+		shardStatus(0, 3),
+		shardStatus(2, 4),
+	}, 3)
+
+	status := response.Clusters["standby"]
+	assert.Equal(t, int64(7), status.GetPendingTaskCount())
+	assert.Equal(t, []int32{1}, response.MissingShardIDs)
+}
+
+func TestDeleteDomainValidation(t *testing.T) {
+	metadataMgr := &mocks.MetadataManager{}
+	visibilityMgr := &mocks.VisibilityManager{}
+	adh := &AdminHandler{metadataMgr: metadataMgr, visibilityMgr: visibilityMgr}
+	domain := func(name string, status int, isGlobal bool) *persistence.GetDomainResponse {
+		return &persistence.GetDomainResponse{
+			Info:           &persistence.DomainInfo{ID: name + "-id", Name: name, Status: status},
+			IsGlobalDomain: isGlobal,
+		}
+	}
+
+	metadataMgr.On("GetMetadata").Return(&persistence.GetMetadataResponse{NotificationVersion: 1}, nil)
+	metadataMgr.On("GetDomain", &persistence.GetDomainRequest{Name: "registered"}).
+		Return(domain("registered", persistence.DomainStatusRegistered, false), nil)
+	metadataMgr.On("GetDomain", &persistence.GetDomainRequest{Name: "global"}).
+		Return(domain("global", persistence.DomainStatusDeprecated, true), nil)
+	metadataMgr.On("GetDomain", &persistence.GetDomainRequest{Name: "running"}).
+		Return(domain("running", persistence.DomainStatusDeprecated, false), nil)
+	visibilityMgr.On("ListOpenWorkflowExecutions", mock.Anything).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*gen.WorkflowExecutionInfo{{}},
+	}, nil)
+
+	_, err := adh.DeleteDomain(context.Background(), &admin.DeleteDomainRequest{})
+	assert.Equal(t, errDomainNotSet, err)
+	_, err = adh.DeleteDomain(context.Background(), &admin.DeleteDomainRequest{Domain: common.StringPtr(common.SystemDomainName)})
+	assert.Equal(t, errDeleteSystemDomain, err)
+	_, err = adh.DeleteDomain(context.Background(), &admin.DeleteDomainRequest{Domain: common.StringPtr("registered")})
+	assert.Equal(t, errDomainNotDeprecated, err)
+	_, err = adh.DeleteDomain(context.Background(), &admin.DeleteDomainRequest{Domain: common.StringPtr("global")})
+	assert.Equal(t, errDeleteGlobalDomain, err)
+	_, err = adh.DeleteDomain(context.Background(), &admin.DeleteDomainRequest{Domain: common.StringPtr("running")})
+	assert.Equal(t, errDomainHasOpenExecutions, err)
+	metadataMgr.AssertNotCalled(t, "UpdateDomain", mock.Anything)
+}
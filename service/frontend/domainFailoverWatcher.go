@@ -0,0 +1,220 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber-common/bark"
+	hist "github.com/uber/cadence/.gen/go/history"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+)
+
+const (
+	domainFailoverWatcherListPageSize = 100
+	domainFailoverWatcherRPCTimeout   = 10 * time.Second
+)
+
+type (
+	updateDomainFn func(ctx context.Context, request *gen.UpdateDomainRequest) (*gen.UpdateDomainResponse, error)
+
+	// domainFailoverWatcher hands the domains in graceful failover over to their pending active cluster,
+	// once the replication tasks of the domain are processed by all the remote clusters, or once
+	// the failover timeout is reached
+	domainFailoverWatcher struct {
+		service.Service
+		status                int32
+		config                *Config
+		numberOfHistoryShards int
+		metadataMgr           persistence.MetadataManager
+		history               history.Client
+		updateDomain          updateDomainFn
+		logger                bark.Logger
+		shutdownCh            chan struct{}
+	}
+)
+
+func newDomainFailoverWatcher(sVice service.Service, config *Config, numberOfHistoryShards int,
+	metadataMgr persistence.MetadataManager, updateDomain updateDomainFn) *domainFailoverWatcher {
+	return &domainFailoverWatcher{
+		Service:               sVice,
+		status:                common.DaemonStatusInitialized,
+		config:                config,
+		numberOfHistoryShards: numberOfHistoryShards,
+		metadataMgr:           metadataMgr,
+		updateDomain:          updateDomain,
+		logger:                sVice.GetLogger().WithField(logging.TagWorkflowComponent, logging.TagValueDomainFailoverWatcherComponent),
+		shutdownCh:            make(chan struct{}),
+	}
+}
+
+// Start starts the background check of domains in graceful failover
+func (w *domainFailoverWatcher) Start() {
+	if !atomic.CompareAndSwapInt32(&w.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	historyClient, err := w.GetClientFactory().NewHistoryClient()
+	if err != nil {
+		w.logger.Errorf("Failed to create history client, graceful domain failover is disabled: %v", err)
+		return
+	}
+	w.history = historyClient
+
+	go w.watchLoop()
+	w.logger.Info("Domain failover watcher started.")
+}
+
+// Stop stops the background check of domains in graceful failover
+func (w *domainFailoverWatcher) Stop() {
+	if !atomic.CompareAndSwapInt32(&w.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(w.shutdownCh)
+	w.logger.Info("Domain failover watcher stopped.")
+}
+
+func (w *domainFailoverWatcher) watchLoop() {
+	timer := time.NewTimer(w.config.GracefulFailoverCheckInterval())
+	defer timer.Stop()
+	for {
+		select {
+		case <-w.shutdownCh:
+			return
+		case <-timer.C:
+			if err := w.checkDomains(); err != nil {
+				w.logger.Errorf("Error checking domains in graceful failover: %v", err)
+			}
+			timer.Reset(w.config.GracefulFailoverCheckInterval())
+		}
+	}
+}
+
+func (w *domainFailoverWatcher) checkDomains() error {
+	currentClusterName := w.GetClusterMetadata().GetCurrentClusterName()
+	request := &persistence.ListDomainsRequest{PageSize: domainFailoverWatcherListPageSize}
+	for {
+		response, err := w.metadataMgr.ListDomains(request)
+		if err != nil {
+			return err
+		}
+		for _, domain := range response.Domains {
+			if !domain.IsGlobalDomain || domain.PendingActiveClusterName == "" ||
+				domain.ReplicationConfig.ActiveClusterName != currentClusterName {
+				continue
+			}
+			w.checkDomain(domain)
+		}
+		if len(response.NextPageToken) == 0 {
+			return nil
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+}
+
+func (w *domainFailoverWatcher) checkDomain(domain *persistence.GetDomainResponse) {
+	logger := w.logger.WithFields(bark.Fields{
+		logging.TagDomainID:             domain.Info.ID,
+		logging.TagPendingActiveCluster: domain.PendingActiveClusterName,
+	})
+
+	if time.Now().UnixNano() < domain.FailoverEndTime {
+		ctx, cancel := context.WithTimeout(context.Background(), domainFailoverWatcherRPCTimeout)
+		drained, err := w.isReplicationDrained(ctx, domain.Info.ID)
+		cancel()
+		if err != nil {
+			logger.Warnf("Failed to get replication status of domain in graceful failover: %v", err)
+			return
+		}
+		if !drained {
+			return
+		}
+	} else {
+		logger.Warn("Graceful failover timed out before all replication tasks are processed, forcing handover.")
+	}
+
+	if err := w.handover(domain.Info.Name, domain.PendingActiveClusterName); err != nil {
+		logger.Errorf("Failed to hand domain over to pending active cluster: %v", err)
+		return
+	}
+	logger.Info("Graceful failover completed.")
+}
+
+// isReplicationDrained returns true only if every shard reports no pending replication task of the domain
+func (w *domainFailoverWatcher) isReplicationDrained(ctx context.Context, domainID string) (bool, error) {
+	shardIDs := make([]int32, w.numberOfHistoryShards)
+	for i := range shardIDs {
+		shardIDs[i] = int32(i)
+	}
+
+	response, err := w.history.GetReplicationStatus(ctx, &hist.GetReplicationStatusRequest{
+		ShardIDs:   shardIDs,
+		DomainUUID: common.StringPtr(domainID),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return isReplicationDrained(response.Shards, w.numberOfHistoryShards), nil
+}
+
+func (w *domainFailoverWatcher) handover(domainName string, pendingActiveClusterName string) error {
+	// the domain may be failed over, or the graceful failover may be completed by another frontend host
+	// after it is listed, so double check before doing the failover
+	getResponse, err := w.metadataMgr.GetDomain(&persistence.GetDomainRequest{Name: domainName})
+	if err != nil {
+		return err
+	}
+	if getResponse.PendingActiveClusterName != pendingActiveClusterName ||
+		getResponse.ReplicationConfig.ActiveClusterName != w.GetClusterMetadata().GetCurrentClusterName() {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), domainFailoverWatcherRPCTimeout)
+	defer cancel()
+	_, err = w.updateDomain(ctx, &gen.UpdateDomainRequest{
+		Name: common.StringPtr(domainName),
+		ReplicationConfiguration: &gen.DomainReplicationConfiguration{
+			ActiveClusterName: common.StringPtr(pendingActiveClusterName),
+		},
+		SecurityToken: common.StringPtr(w.config.AdminOperationToken()),
+	})
+	return err
+}
+
+func isReplicationDrained(shards []*hist.ShardReplicationStatus, numberOfHistoryShards int) bool {
+	reported := make(map[int32]bool)
+	for _, shard := range shards {
+		if shard.GetPendingTaskCount() != 0 || shard.GetPendingTaskCountTruncated() {
+			return false
+		}
+		reported[shard.GetShardID()] = true
+	}
+	// shards not reported, e.g. during shard movement, are not known to be drained
+	return len(reported) == numberOfHistoryShards
+}
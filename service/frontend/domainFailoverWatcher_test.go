@@ -0,0 +1,58 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	hist "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/common"
+)
+
+func TestIsReplicationDrained(t *testing.T) {
+	status := func(shardID int32, pendingTaskCount int64) *hist.ShardReplicationStatus {
+		return &hist.ShardReplicationStatus{
+			ShardID:          common.Int32Ptr(shardID),
+			PendingTaskCount: common.Int64Ptr(pendingTaskCount),
+		}
+	}
+
+	assert.True(t, isReplicationDrained([]*hist.ShardReplicationStatus{status(0, 0), status(1, 0)}, 2))
+	// pending replication tasks on one shard
+	assert.False(t, isReplicationDrained([]*hist.ShardReplicationStatus{status(0, 0), status(1, 3)}, 2))
+	// shard 1 not reported
+	assert.False(t, isReplicationDrained([]*hist.ShardReplicationStatus{status(0, 0)}, 2))
+	assert.False(t, isReplicationDrained([]*hist.ShardReplicationStatus{status(0, 0), status(0, 0)}, 2))
+	assert.False(t, isReplicationDrained(nil, 2))
+	// the shard has more tasks than scanned, so the tasks of the domain may not be drained
+	truncated := status(1, 0)
+	truncated.PendingTaskCountTruncated = common.BoolPtr(true)
+	assert.False(t, isReplicationDrained([]*hist.ShardReplicationStatus{status(0, 0), truncated}, 2))
+}
+
+func TestCreateDomainFailoverInfo(t *testing.T) {
+	assert.Nil(t, createDomainFailoverInfo("", 0))
+
+	info := createDomainFailoverInfo("standby", 123)
+	assert.Equal(t, "standby", info.GetPendingActiveClusterName())
+	assert.Equal(t, int64(123), info.GetFailoverExpireTimestamp())
+}
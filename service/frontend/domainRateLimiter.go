@@ -0,0 +1,214 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
+)
+
+const (
+	// apiTypeUser is the type of the apis called by users, e.g. start, signal and query
+	apiTypeUser apiType = iota
+	// apiTypeWorker is the type of the apis called by workers, i.e. polls and task responses
+	apiTypeWorker
+)
+
+const (
+	// hostLimitEnforced rejects the request once the quota of the frontend host is exceeded
+	hostLimitEnforced hostLimit = iota
+	// hostLimitCounted counts the request in the quota of the frontend host, but accepts it even if the quota is exceeded
+	hostLimitCounted
+	// hostLimitNone leaves the quota of the frontend host untouched
+	hostLimitNone
+)
+
+const (
+	frontendHostCountRefreshInterval = 10 * time.Second
+)
+
+type (
+	// apiType classifies the frontend apis so that user and worker apis of a domain get separate quotas
+	apiType int
+
+	// hostLimit is how a request is accounted against the quota shared by all the requests served by the frontend host
+	hostLimit int
+
+	// domainRateLimiter throttles the requests of every domain by its own quota for the type of api called,
+	// on top of the quota shared by all the requests served by the frontend host
+	domainRateLimiter struct {
+		sync.RWMutex
+		config        *Config
+		numHosts      func() int
+		timeSource    common.TimeSource
+		hostBucket    *rpsTokenBucket
+		domainBuckets map[domainBucketKey]*rpsTokenBucket
+
+		hostCount            int
+		hostCountRefreshTime time.Time
+	}
+
+	domainBucketKey struct {
+		domainName string
+		apiType    apiType
+	}
+
+	rpsTokenBucket struct {
+		rps         int
+		rateLimiter common.TokenBucket
+	}
+)
+
+// newDomainRateLimiter creates a domain aware rate limiter, numHosts returns the number of
+// frontend hosts in the cluster, which is used to split the cluster wide domain quotas
+func newDomainRateLimiter(config *Config, numHosts func() int) *domainRateLimiter {
+	return &domainRateLimiter{
+		config:        config,
+		numHosts:      numHosts,
+		timeSource:    common.NewRealTimeSource(),
+		domainBuckets: make(map[domainBucketKey]*rpsTokenBucket),
+	}
+}
+
+// Allow returns true if a request of the given api type can be served for the domain,
+// otherwise it returns false along with the type of the limit which is exceeded
+func (r *domainRateLimiter) Allow(domainName string, apiType apiType, hostLimit hostLimit) (bool, string) {
+	// the host quota is checked first, a request rejected by the domain quota gives its host token back
+	// so that a throttled domain does not use up the quota of the other domains
+	var hostBucket *rpsTokenBucket
+	switch hostLimit {
+	case hostLimitEnforced:
+		hostBucket = r.getHostBucket()
+		if ok, _ := hostBucket.rateLimiter.TryConsume(1); !ok {
+			return false, metrics.HostRPSLimitTypeTagValue
+		}
+	case hostLimitCounted:
+		hostBucket = r.getHostBucket()
+		if ok, _ := hostBucket.rateLimiter.TryConsume(1); !ok {
+			hostBucket = nil
+		}
+	}
+
+	if domainName != "" {
+		if rps := r.getDomainRPS(domainName, apiType); rps > 0 {
+			bucket := r.getDomainBucket(domainBucketKey{domainName: domainName, apiType: apiType}, rps)
+			if ok, _ := bucket.rateLimiter.TryConsume(1); !ok {
+				if hostBucket != nil {
+					hostBucket.rateLimiter.Return(1)
+				}
+				if apiType == apiTypeWorker {
+					return false, metrics.DomainWorkerRPSLimitTypeTagValue
+				}
+				return false, metrics.DomainRPSLimitTypeTagValue
+			}
+		}
+	}
+	return true, ""
+}
+
+// getDomainRPS returns the quota of the domain on this host, the cluster wide quota when set takes
+// precedence over the host quota; a quota of 0 means the domain is only limited by the host quota
+func (r *domainRateLimiter) getDomainRPS(domainName string, apiType apiType) int {
+	var globalRPS, hostRPS int
+	if apiType == apiTypeWorker {
+		globalRPS = r.config.GlobalDomainWorkerRPS(domainName)
+		hostRPS = r.config.DomainWorkerRPS(domainName)
+	} else {
+		globalRPS = r.config.GlobalDomainRPS(domainName)
+		hostRPS = r.config.DomainRPS(domainName)
+	}
+	if globalRPS <= 0 {
+		return hostRPS
+	}
+
+	rps := globalRPS / r.getHostCount()
+	if rps < 1 {
+		rps = 1
+	}
+	return rps
+}
+
+// getHostCount returns the number of frontend hosts, the number is only refreshed periodically
+// as looking up the members of the ring is too expensive to be done on every request
+func (r *domainRateLimiter) getHostCount() int {
+	now := r.timeSource.Now()
+
+	r.RLock()
+	hostCount, refreshTime := r.hostCount, r.hostCountRefreshTime
+	r.RUnlock()
+	if hostCount > 0 && now.Before(refreshTime) {
+		return hostCount
+	}
+
+	hostCount = r.numHosts()
+	if hostCount < 1 {
+		hostCount = 1
+	}
+	r.Lock()
+	r.hostCount = hostCount
+	r.hostCountRefreshTime = now.Add(frontendHostCountRefreshInterval)
+	r.Unlock()
+	return hostCount
+}
+
+// getHostBucket returns the token bucket shared by all requests, the bucket is
+// recreated whenever the configured quota of the host changes
+func (r *domainRateLimiter) getHostBucket() *rpsTokenBucket {
+	rps := r.config.RPS()
+
+	r.RLock()
+	bucket := r.hostBucket
+	r.RUnlock()
+	if bucket != nil && bucket.rps == rps {
+		return bucket
+	}
+
+	r.Lock()
+	defer r.Unlock()
+	if r.hostBucket != nil && r.hostBucket.rps == rps { // read again to ensure no duplicate create
+		return r.hostBucket
+	}
+	r.hostBucket = &rpsTokenBucket{rps: rps, rateLimiter: common.NewTokenBucket(rps, r.timeSource)}
+	return r.hostBucket
+}
+
+// getDomainBucket returns the token bucket of the domain for an api type, the bucket is
+// recreated whenever the quota of the domain changes
+func (r *domainRateLimiter) getDomainBucket(key domainBucketKey, rps int) *rpsTokenBucket {
+	r.RLock()
+	bucket, ok := r.domainBuckets[key]
+	r.RUnlock()
+	if ok && bucket.rps == rps {
+		return bucket
+	}
+
+	r.Lock()
+	defer r.Unlock()
+	if bucket, ok = r.domainBuckets[key]; ok && bucket.rps == rps { // read again to ensure no duplicate create
+		return bucket
+	}
+	bucket = &rpsTokenBucket{rps: rps, rateLimiter: common.NewTokenBucket(rps, r.timeSource)}
+	r.domainBuckets[key] = bucket
+	return bucket
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	domainRateLimiterSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+
+		timeSource      *frozenTimeSource
+		hostRPS         int
+		domainRPS       map[string]int
+		domainWorkerRPS map[string]int
+		globalDomainRPS map[string]int
+		numHosts        int
+	}
+
+	// frozenTimeSource never advances so token buckets are never refilled
+	frozenTimeSource struct {
+		now time.Time
+	}
+)
+
+func (ts *frozenTimeSource) Now() time.Time {
+	return ts.now
+}
+
+func TestDomainRateLimiterSuite(t *testing.T) {
+	s := new(domainRateLimiterSuite)
+	suite.Run(t, s)
+}
+
+func (s *domainRateLimiterSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.timeSource = &frozenTimeSource{now: time.Now()}
+	s.hostRPS = 1000
+	s.domainRPS = make(map[string]int)
+	s.domainWorkerRPS = make(map[string]int)
+	s.globalDomainRPS = make(map[string]int)
+	s.numHosts = 1
+}
+
+// newRateLimiter creates a limiter whose buckets hold rps/10 tokens, i.e. one refill interval worth of tokens
+func (s *domainRateLimiterSuite) newRateLimiter() *domainRateLimiter {
+	config := &Config{
+		RPS:                   func(...dynamicconfig.FilterOption) int { return s.hostRPS },
+		DomainRPS:             func(domain string) int { return s.domainRPS[domain] },
+		DomainWorkerRPS:       func(domain string) int { return s.domainWorkerRPS[domain] },
+		GlobalDomainRPS:       func(domain string) int { return s.globalDomainRPS[domain] },
+		GlobalDomainWorkerRPS: func(domain string) int { return 0 },
+	}
+	limiter := newDomainRateLimiter(config, func() int { return s.numHosts })
+	limiter.timeSource = s.timeSource
+	return limiter
+}
+
+func (s *domainRateLimiterSuite) TestAllow_HostLimit() {
+	s.hostRPS = 20
+	limiter := s.newRateLimiter()
+
+	s.allowed(limiter.Allow("domain-1", apiTypeUser, hostLimitEnforced))
+	s.allowed(limiter.Allow("domain-2", apiTypeWorker, hostLimitEnforced))
+	ok, limitType := limiter.Allow("domain-1", apiTypeUser, hostLimitEnforced)
+	s.False(ok)
+	s.Equal(metrics.HostRPSLimitTypeTagValue, limitType)
+}
+
+func (s *domainRateLimiterSuite) TestAllow_HostLimitCounted() {
+	s.hostRPS = 10
+	limiter := s.newRateLimiter()
+
+	// counted requests use up the host quota but are never rejected by it
+	s.allowed(limiter.Allow("domain-1", apiTypeWorker, hostLimitCounted))
+	s.allowed(limiter.Allow("domain-1", apiTypeWorker, hostLimitCounted))
+	ok, limitType := limiter.Allow("domain-1", apiTypeWorker, hostLimitEnforced)
+	s.False(ok)
+	s.Equal(metrics.HostRPSLimitTypeTagValue, limitType)
+	s.allowed(limiter.Allow("domain-1", apiTypeUser, hostLimitNone))
+}
+
+func (s *domainRateLimiterSuite) TestAllow_DomainRejectReturnsHostToken() {
+	s.hostRPS = 30
+	s.domainRPS["domain-1"] = 10
+	limiter := s.newRateLimiter()
+
+	s.allowed(limiter.Allow("domain-1", apiTypeUser, hostLimitEnforced))
+	for i := 0; i < 10; i++ {
+		ok, limitType := limiter.Allow("domain-1", apiTypeUser, hostLimitEnforced)
+		s.False(ok)
+		s.Equal(metrics.DomainRPSLimitTypeTagValue, limitType)
+	}
+
+	// the requests rejected by the domain quota did not use up the host quota
+	s.allowed(limiter.Allow("domain-2", apiTypeUser, hostLimitEnforced))
+	s.allowed(limiter.Allow("domain-2", apiTypeUser, hostLimitEnforced))
+	ok, limitType := limiter.Allow("domain-2", apiTypeUser, hostLimitEnforced)
+	s.False(ok)
+	s.Equal(metrics.HostRPSLimitTypeTagValue, limitType)
+}
+
+func (s *domainRateLimiterSuite) TestAllow_DomainLimitIsolatesDomains() {
+	s.domainRPS["domain-1"] = 20
+	limiter := s.newRateLimiter()
+
+	s.allowed(limiter.Allow("domain-1", apiTypeUser, hostLimitEnforced))
+	s.allowed(limiter.Allow("domain-1", apiTypeUser, hostLimitEnforced))
+	ok, limitType := limiter.Allow("domain-1", apiTypeUser, hostLimitEnforced)
+	s.False(ok)
+	s.Equal(metrics.DomainRPSLimitTypeTagValue, limitType)
+
+	// other domains and the worker apis of the throttled domain are not affected
+	for i := 0; i < 10; i++ {
+		s.allowed(limiter.Allow("domain-2", apiTypeUser, hostLimitEnforced))
+		s.allowed(limiter.Allow("domain-1", apiTypeWorker, hostLimitEnforced))
+	}
+}
+
+func (s *domainRateLimiterSuite) TestAllow_DomainWorkerLimit() {
+	s.domainWorkerRPS["domain-1"] = 10
+	limiter := s.newRateLimiter()
+
+	s.allowed(limiter.Allow("domain-1", apiTypeWorker, hostLimitEnforced))
+	ok, limitType := limiter.Allow("domain-1", apiTypeWorker, hostLimitEnforced)
+	s.False(ok)
+	s.Equal(metrics.DomainWorkerRPSLimitTypeTagValue, limitType)
+	s.allowed(limiter.Allow("domain-1", apiTypeUser, hostLimitEnforced))
+}
+
+func (s *domainRateLimiterSuite) TestAllow_DomainLimitChange() {
+	s.domainRPS["domain-1"] = 10
+	limiter := s.newRateLimiter()
+
+	s.allowed(limiter.Allow("domain-1", apiTypeUser, hostLimitEnforced))
+	ok, _ := limiter.Allow("domain-1", apiTypeUser, hostLimitEnforced)
+	s.False(ok)
+
+	s.domainRPS["domain-1"] = 20
+	s.allowed(limiter.Allow("domain-1", apiTypeUser, hostLimitEnforced))
+	s.allowed(limiter.Allow("domain-1", apiTypeUser, hostLimitEnforced))
+	ok, _ = limiter.Allow("domain-1", apiTypeUser, hostLimitEnforced)
+	s.False(ok)
+
+	s.domainRPS["domain-1"] = 0
+	s.allowed(limiter.Allow("domain-1", apiTypeUser, hostLimitEnforced))
+}
+
+func (s *domainRateLimiterSuite) TestAllow_GlobalDomainLimitSplitByHosts() {
+	s.domainRPS["domain-1"] = 100
+	s.globalDomainRPS["domain-1"] = 40
+	s.numHosts = 2
+	limiter := s.newRateLimiter()
+
+	// the global limit takes precedence, each of the 2 hosts gets 20 rps
+	s.Equal(20, limiter.getDomainRPS("domain-1", apiTypeUser))
+	s.allowed(limiter.Allow("domain-1", apiTypeUser, hostLimitEnforced))
+	s.allowed(limiter.Allow("domain-1", apiTypeUser, hostLimitEnforced))
+	ok, limitType := limiter.Allow("domain-1", apiTypeUser, hostLimitEnforced)
+	s.False(ok)
+	s.Equal(metrics.DomainRPSLimitTypeTagValue, limitType)
+
+	// the host count is cached until the next refresh
+	s.numHosts = 4
+	s.Equal(20, limiter.getDomainRPS("domain-1", apiTypeUser))
+	s.timeSource.now = s.timeSource.now.Add(frontendHostCountRefreshInterval)
+	s.Equal(10, limiter.getDomainRPS("domain-1", apiTypeUser))
+
+	// every host is given at least 1 rps
+	s.numHosts = 100
+	s.timeSource.now = s.timeSource.now.Add(frontendHostCountRefreshInterval)
+	s.Equal(1, limiter.getDomainRPS("domain-1", apiTypeUser))
+}
+
+func (s *domainRateLimiterSuite) allowed(ok bool, limitType string) {
+	s.True(ok)
+	s.Empty(limitType)
+}
@@ -0,0 +1,350 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+
+	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
+	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
+	"github.com/uber/cadence/common/proto"
+)
+
+var _ apiv1.WorkflowServiceYARPCServer = (*GRPCHandler)(nil)
+
+type (
+	// GRPCHandler serves the protobuf WorkflowService API. Requests are translated
+	// to the thrift types and handled by the same handler serving the thrift API.
+	GRPCHandler struct {
+		handler workflowserviceserver.Interface
+	}
+)
+
+// NewGRPCHandler creates a gRPC handler on top of the thrift WorkflowService handler
+func NewGRPCHandler(handler workflowserviceserver.Interface) *GRPCHandler {
+	return &GRPCHandler{handler: handler}
+}
+
+// RegisterDomain delegates to the thrift RegisterDomain handler
+func (g *GRPCHandler) RegisterDomain(ctx context.Context, request *apiv1.RegisterDomainRequest) (*apiv1.RegisterDomainResponse, error) {
+	if err := g.handler.RegisterDomain(ctx, proto.ToRegisterDomainRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.RegisterDomainResponse{}, nil
+}
+
+// DescribeDomain delegates to the thrift DescribeDomain handler
+func (g *GRPCHandler) DescribeDomain(ctx context.Context, request *apiv1.DescribeDomainRequest) (*apiv1.DescribeDomainResponse, error) {
+	response, err := g.handler.DescribeDomain(ctx, proto.ToDescribeDomainRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromDescribeDomainResponse(response), nil
+}
+
+// ListDomains delegates to the thrift ListDomains handler
+func (g *GRPCHandler) ListDomains(ctx context.Context, request *apiv1.ListDomainsRequest) (*apiv1.ListDomainsResponse, error) {
+	response, err := g.handler.ListDomains(ctx, proto.ToListDomainsRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromListDomainsResponse(response), nil
+}
+
+// UpdateDomain delegates to the thrift UpdateDomain handler
+func (g *GRPCHandler) UpdateDomain(ctx context.Context, request *apiv1.UpdateDomainRequest) (*apiv1.UpdateDomainResponse, error) {
+	response, err := g.handler.UpdateDomain(ctx, proto.ToUpdateDomainRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromUpdateDomainResponse(response), nil
+}
+
+// DeprecateDomain delegates to the thrift DeprecateDomain handler
+func (g *GRPCHandler) DeprecateDomain(ctx context.Context, request *apiv1.DeprecateDomainRequest) (*apiv1.DeprecateDomainResponse, error) {
+	if err := g.handler.DeprecateDomain(ctx, proto.ToDeprecateDomainRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.DeprecateDomainResponse{}, nil
+}
+
+// StartWorkflowExecution delegates to the thrift StartWorkflowExecution handler
+func (g *GRPCHandler) StartWorkflowExecution(ctx context.Context, request *apiv1.StartWorkflowExecutionRequest) (*apiv1.StartWorkflowExecutionResponse, error) {
+	response, err := g.handler.StartWorkflowExecution(ctx, proto.ToStartWorkflowExecutionRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromStartWorkflowExecutionResponse(response), nil
+}
+
+// GetWorkflowExecutionHistory delegates to the thrift GetWorkflowExecutionHistory handler
+func (g *GRPCHandler) GetWorkflowExecutionHistory(ctx context.Context, request *apiv1.GetWorkflowExecutionHistoryRequest) (*apiv1.GetWorkflowExecutionHistoryResponse, error) {
+	response, err := g.handler.GetWorkflowExecutionHistory(ctx, proto.ToGetWorkflowExecutionHistoryRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromGetWorkflowExecutionHistoryResponse(response), nil
+}
+
+// GetWorkflowExecutionRawHistory delegates to the thrift GetWorkflowExecutionRawHistory handler
+func (g *GRPCHandler) GetWorkflowExecutionRawHistory(ctx context.Context, request *apiv1.GetWorkflowExecutionRawHistoryRequest) (*apiv1.GetWorkflowExecutionRawHistoryResponse, error) {
+	response, err := g.handler.GetWorkflowExecutionRawHistory(ctx, proto.ToGetWorkflowExecutionRawHistoryRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromGetWorkflowExecutionRawHistoryResponse(response), nil
+}
+
+// PollForDecisionTask delegates to the thrift PollForDecisionTask handler
+func (g *GRPCHandler) PollForDecisionTask(ctx context.Context, request *apiv1.PollForDecisionTaskRequest) (*apiv1.PollForDecisionTaskResponse, error) {
+	response, err := g.handler.PollForDecisionTask(ctx, proto.ToPollForDecisionTaskRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromPollForDecisionTaskResponse(response), nil
+}
+
+// RespondDecisionTaskCompleted delegates to the thrift RespondDecisionTaskCompleted handler
+func (g *GRPCHandler) RespondDecisionTaskCompleted(ctx context.Context, request *apiv1.RespondDecisionTaskCompletedRequest) (*apiv1.RespondDecisionTaskCompletedResponse, error) {
+	response, err := g.handler.RespondDecisionTaskCompleted(ctx, proto.ToRespondDecisionTaskCompletedRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromRespondDecisionTaskCompletedResponse(response), nil
+}
+
+// RespondDecisionTaskFailed delegates to the thrift RespondDecisionTaskFailed handler
+func (g *GRPCHandler) RespondDecisionTaskFailed(ctx context.Context, request *apiv1.RespondDecisionTaskFailedRequest) (*apiv1.RespondDecisionTaskFailedResponse, error) {
+	if err := g.handler.RespondDecisionTaskFailed(ctx, proto.ToRespondDecisionTaskFailedRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.RespondDecisionTaskFailedResponse{}, nil
+}
+
+// PollForActivityTask delegates to the thrift PollForActivityTask handler
+func (g *GRPCHandler) PollForActivityTask(ctx context.Context, request *apiv1.PollForActivityTaskRequest) (*apiv1.PollForActivityTaskResponse, error) {
+	response, err := g.handler.PollForActivityTask(ctx, proto.ToPollForActivityTaskRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromPollForActivityTaskResponse(response), nil
+}
+
+// RecordActivityTaskHeartbeat delegates to the thrift RecordActivityTaskHeartbeat handler
+func (g *GRPCHandler) RecordActivityTaskHeartbeat(ctx context.Context, request *apiv1.RecordActivityTaskHeartbeatRequest) (*apiv1.RecordActivityTaskHeartbeatResponse, error) {
+	response, err := g.handler.RecordActivityTaskHeartbeat(ctx, proto.ToRecordActivityTaskHeartbeatRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromRecordActivityTaskHeartbeatResponse(response), nil
+}
+
+// RecordActivityTaskHeartbeatByID delegates to the thrift RecordActivityTaskHeartbeatByID handler
+func (g *GRPCHandler) RecordActivityTaskHeartbeatByID(ctx context.Context, request *apiv1.RecordActivityTaskHeartbeatByIDRequest) (*apiv1.RecordActivityTaskHeartbeatResponse, error) {
+	response, err := g.handler.RecordActivityTaskHeartbeatByID(ctx, proto.ToRecordActivityTaskHeartbeatByIDRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromRecordActivityTaskHeartbeatResponse(response), nil
+}
+
+// RespondActivityTaskCompleted delegates to the thrift RespondActivityTaskCompleted handler
+func (g *GRPCHandler) RespondActivityTaskCompleted(ctx context.Context, request *apiv1.RespondActivityTaskCompletedRequest) (*apiv1.RespondActivityTaskCompletedResponse, error) {
+	if err := g.handler.RespondActivityTaskCompleted(ctx, proto.ToRespondActivityTaskCompletedRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.RespondActivityTaskCompletedResponse{}, nil
+}
+
+// RespondActivityTaskCompletedByID delegates to the thrift RespondActivityTaskCompletedByID handler
+func (g *GRPCHandler) RespondActivityTaskCompletedByID(ctx context.Context, request *apiv1.RespondActivityTaskCompletedByIDRequest) (*apiv1.RespondActivityTaskCompletedByIDResponse, error) {
+	if err := g.handler.RespondActivityTaskCompletedByID(ctx, proto.ToRespondActivityTaskCompletedByIDRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.RespondActivityTaskCompletedByIDResponse{}, nil
+}
+
+// RespondActivityTaskFailed delegates to the thrift RespondActivityTaskFailed handler
+func (g *GRPCHandler) RespondActivityTaskFailed(ctx context.Context, request *apiv1.RespondActivityTaskFailedRequest) (*apiv1.RespondActivityTaskFailedResponse, error) {
+	if err := g.handler.RespondActivityTaskFailed(ctx, proto.ToRespondActivityTaskFailedRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.RespondActivityTaskFailedResponse{}, nil
+}
+
+// RespondActivityTaskFailedByID delegates to the thrift RespondActivityTaskFailedByID handler
+func (g *GRPCHandler) RespondActivityTaskFailedByID(ctx context.Context, request *apiv1.RespondActivityTaskFailedByIDRequest) (*apiv1.RespondActivityTaskFailedByIDResponse, error) {
+	if err := g.handler.RespondActivityTaskFailedByID(ctx, proto.ToRespondActivityTaskFailedByIDRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.RespondActivityTaskFailedByIDResponse{}, nil
+}
+
+// RespondActivityTaskCanceled delegates to the thrift RespondActivityTaskCanceled handler
+func (g *GRPCHandler) RespondActivityTaskCanceled(ctx context.Context, request *apiv1.RespondActivityTaskCanceledRequest) (*apiv1.RespondActivityTaskCanceledResponse, error) {
+	if err := g.handler.RespondActivityTaskCanceled(ctx, proto.ToRespondActivityTaskCanceledRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.RespondActivityTaskCanceledResponse{}, nil
+}
+
+// RespondActivityTaskCanceledByID delegates to the thrift RespondActivityTaskCanceledByID handler
+func (g *GRPCHandler) RespondActivityTaskCanceledByID(ctx context.Context, request *apiv1.RespondActivityTaskCanceledByIDRequest) (*apiv1.RespondActivityTaskCanceledByIDResponse, error) {
+	if err := g.handler.RespondActivityTaskCanceledByID(ctx, proto.ToRespondActivityTaskCanceledByIDRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.RespondActivityTaskCanceledByIDResponse{}, nil
+}
+
+// RequestCancelWorkflowExecution delegates to the thrift RequestCancelWorkflowExecution handler
+func (g *GRPCHandler) RequestCancelWorkflowExecution(ctx context.Context, request *apiv1.RequestCancelWorkflowExecutionRequest) (*apiv1.RequestCancelWorkflowExecutionResponse, error) {
+	if err := g.handler.RequestCancelWorkflowExecution(ctx, proto.ToRequestCancelWorkflowExecutionRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.RequestCancelWorkflowExecutionResponse{}, nil
+}
+
+// SignalWorkflowExecution delegates to the thrift SignalWorkflowExecution handler
+func (g *GRPCHandler) SignalWorkflowExecution(ctx context.Context, request *apiv1.SignalWorkflowExecutionRequest) (*apiv1.SignalWorkflowExecutionResponse, error) {
+	if err := g.handler.SignalWorkflowExecution(ctx, proto.ToSignalWorkflowExecutionRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.SignalWorkflowExecutionResponse{}, nil
+}
+
+// SignalWithStartWorkflowExecution delegates to the thrift SignalWithStartWorkflowExecution handler
+func (g *GRPCHandler) SignalWithStartWorkflowExecution(ctx context.Context, request *apiv1.SignalWithStartWorkflowExecutionRequest) (*apiv1.StartWorkflowExecutionResponse, error) {
+	response, err := g.handler.SignalWithStartWorkflowExecution(ctx, proto.ToSignalWithStartWorkflowExecutionRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromStartWorkflowExecutionResponse(response), nil
+}
+
+// TerminateWorkflowExecution delegates to the thrift TerminateWorkflowExecution handler
+func (g *GRPCHandler) TerminateWorkflowExecution(ctx context.Context, request *apiv1.TerminateWorkflowExecutionRequest) (*apiv1.TerminateWorkflowExecutionResponse, error) {
+	if err := g.handler.TerminateWorkflowExecution(ctx, proto.ToTerminateWorkflowExecutionRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.TerminateWorkflowExecutionResponse{}, nil
+}
+
+// PauseWorkflowExecution delegates to the thrift PauseWorkflowExecution handler
+func (g *GRPCHandler) PauseWorkflowExecution(ctx context.Context, request *apiv1.PauseWorkflowExecutionRequest) (*apiv1.PauseWorkflowExecutionResponse, error) {
+	if err := g.handler.PauseWorkflowExecution(ctx, proto.ToPauseWorkflowExecutionRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.PauseWorkflowExecutionResponse{}, nil
+}
+
+// ResumeWorkflowExecution delegates to the thrift ResumeWorkflowExecution handler
+func (g *GRPCHandler) ResumeWorkflowExecution(ctx context.Context, request *apiv1.ResumeWorkflowExecutionRequest) (*apiv1.ResumeWorkflowExecutionResponse, error) {
+	if err := g.handler.ResumeWorkflowExecution(ctx, proto.ToResumeWorkflowExecutionRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.ResumeWorkflowExecutionResponse{}, nil
+}
+
+// RetryActivityTask delegates to the thrift RetryActivityTask handler
+func (g *GRPCHandler) RetryActivityTask(ctx context.Context, request *apiv1.RetryActivityTaskRequest) (*apiv1.RetryActivityTaskResponse, error) {
+	if err := g.handler.RetryActivityTask(ctx, proto.ToRetryActivityTaskRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.RetryActivityTaskResponse{}, nil
+}
+
+// CompleteActivityTask delegates to the thrift CompleteActivityTask handler
+func (g *GRPCHandler) CompleteActivityTask(ctx context.Context, request *apiv1.CompleteActivityTaskRequest) (*apiv1.CompleteActivityTaskResponse, error) {
+	if err := g.handler.CompleteActivityTask(ctx, proto.ToCompleteActivityTaskRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.CompleteActivityTaskResponse{}, nil
+}
+
+// FailActivityTask delegates to the thrift FailActivityTask handler
+func (g *GRPCHandler) FailActivityTask(ctx context.Context, request *apiv1.FailActivityTaskRequest) (*apiv1.FailActivityTaskResponse, error) {
+	if err := g.handler.FailActivityTask(ctx, proto.ToFailActivityTaskRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.FailActivityTaskResponse{}, nil
+}
+
+// ListOpenWorkflowExecutions delegates to the thrift ListOpenWorkflowExecutions handler
+func (g *GRPCHandler) ListOpenWorkflowExecutions(ctx context.Context, request *apiv1.ListOpenWorkflowExecutionsRequest) (*apiv1.ListOpenWorkflowExecutionsResponse, error) {
+	response, err := g.handler.ListOpenWorkflowExecutions(ctx, proto.ToListOpenWorkflowExecutionsRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromListOpenWorkflowExecutionsResponse(response), nil
+}
+
+// ListClosedWorkflowExecutions delegates to the thrift ListClosedWorkflowExecutions handler
+func (g *GRPCHandler) ListClosedWorkflowExecutions(ctx context.Context, request *apiv1.ListClosedWorkflowExecutionsRequest) (*apiv1.ListClosedWorkflowExecutionsResponse, error) {
+	response, err := g.handler.ListClosedWorkflowExecutions(ctx, proto.ToListClosedWorkflowExecutionsRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromListClosedWorkflowExecutionsResponse(response), nil
+}
+
+// RespondQueryTaskCompleted delegates to the thrift RespondQueryTaskCompleted handler
+func (g *GRPCHandler) RespondQueryTaskCompleted(ctx context.Context, request *apiv1.RespondQueryTaskCompletedRequest) (*apiv1.RespondQueryTaskCompletedResponse, error) {
+	if err := g.handler.RespondQueryTaskCompleted(ctx, proto.ToRespondQueryTaskCompletedRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.RespondQueryTaskCompletedResponse{}, nil
+}
+
+// ResetStickyTaskList delegates to the thrift ResetStickyTaskList handler
+func (g *GRPCHandler) ResetStickyTaskList(ctx context.Context, request *apiv1.ResetStickyTaskListRequest) (*apiv1.ResetStickyTaskListResponse, error) {
+	response, err := g.handler.ResetStickyTaskList(ctx, proto.ToResetStickyTaskListRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromResetStickyTaskListResponse(response), nil
+}
+
+// QueryWorkflow delegates to the thrift QueryWorkflow handler
+func (g *GRPCHandler) QueryWorkflow(ctx context.Context, request *apiv1.QueryWorkflowRequest) (*apiv1.QueryWorkflowResponse, error) {
+	response, err := g.handler.QueryWorkflow(ctx, proto.ToQueryWorkflowRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromQueryWorkflowResponse(response), nil
+}
+
+// DescribeWorkflowExecution delegates to the thrift DescribeWorkflowExecution handler
+func (g *GRPCHandler) DescribeWorkflowExecution(ctx context.Context, request *apiv1.DescribeWorkflowExecutionRequest) (*apiv1.DescribeWorkflowExecutionResponse, error) {
+	response, err := g.handler.DescribeWorkflowExecution(ctx, proto.ToDescribeWorkflowExecutionRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromDescribeWorkflowExecutionResponse(response), nil
+}
+
+// DescribeTaskList delegates to the thrift DescribeTaskList handler
+func (g *GRPCHandler) DescribeTaskList(ctx context.Context, request *apiv1.DescribeTaskListRequest) (*apiv1.DescribeTaskListResponse, error) {
+	response, err := g.handler.DescribeTaskList(ctx, proto.ToDescribeTaskListRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromDescribeTaskListResponse(response), nil
+}
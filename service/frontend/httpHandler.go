@@ -0,0 +1,384 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/service/config"
+	"go.uber.org/yarpc/api/encoding"
+	"go.uber.org/yarpc/api/transport"
+)
+
+const (
+	// httpAPIPathPrefix is the path prefix of the apis, followed by the api name, e.g. /api/v1/StartWorkflowExecution
+	httpAPIPathPrefix = "/api/v1/"
+	// httpHeaderPrefix is the prefix of the http headers passed on to the handler as yarpc headers,
+	// e.g. Cadence-Caller-Identity is passed on as the cadence-caller-identity header. The headers
+	// are set by the caller and not authenticated by the gateway, the caller identity is only trusted
+	// by the authorizer when authorization.trustCallerIdentityHeader is set, see config.HTTP. The
+	// service token header is dropped, the service principal is never granted to the gateway callers
+	httpHeaderPrefix = "cadence-"
+	// httpCallerName is the caller name of the calls made through the gateway
+	httpCallerName = "cadence-http-gateway"
+	// httpEncoding is the encoding of the calls made through the gateway
+	httpEncoding    = "json"
+	httpStopTimeout = 10 * time.Second
+	// httpReadHeaderTimeout and httpReadTimeout bound the time a client can take to send a request
+	httpReadHeaderTimeout = 10 * time.Second
+	httpReadTimeout       = 30 * time.Second
+	// httpWriteTimeout bounds the time taken to handle a call and write the response, it is above the
+	// long poll interval of GetWorkflowExecutionHistory calls waiting for new events
+	httpWriteTimeout = time.Minute
+	// httpMaxRequestBodySize is the max size of a request body, it leaves room for the base64 encoding
+	// of the payloads, which are limited to 2MB by default
+	httpMaxRequestBodySize = 8 * 1024 * 1024
+)
+
+type (
+	// HTTPHandler serves the WorkflowService apis used by clients as json over http, for the callers
+	// without a thrift client. The json request and response bodies are the thrift types, the requests
+	// are handled by the same handler serving the thrift api so they are authorized and rate limited
+	// the same way. The gateway does not authenticate the callers, see config.HTTP.
+	HTTPHandler struct {
+		handler   workflowserviceserver.Interface
+		config    *config.HTTP
+		logger    bark.Logger
+		server    *http.Server
+		endpoints map[string]httpEndpoint
+	}
+
+	// httpEndpoint handles a single api, the request is decoded into the thrift type by the decode function
+	httpEndpoint func(ctx context.Context, decode func(request interface{}) error) (interface{}, error)
+
+	// httpErrorResponse is the body of the response to a failed call, carrying the thrift error
+	httpErrorResponse struct {
+		Type  string      `json:"type"`
+		Error interface{} `json:"error"`
+	}
+)
+
+var (
+	errHTTPMethodNotAllowed = &gen.BadRequestError{Message: "Only POST is supported."}
+	errHTTPAPINotFound      = &gen.EntityNotExistsError{Message: "Unknown api."}
+
+	sharedTypesPkgPath = reflect.TypeOf(gen.BadRequestError{}).PkgPath()
+)
+
+// NewHTTPHandler creates a json over http gateway on top of the thrift WorkflowService handler
+func NewHTTPHandler(handler workflowserviceserver.Interface, config *config.HTTP, logger bark.Logger) *HTTPHandler {
+	h := &HTTPHandler{
+		handler: handler,
+		config:  config,
+		logger:  logger.WithField(logging.TagWorkflowComponent, logging.TagValueHTTPGatewayComponent),
+	}
+	h.endpoints = h.newEndpoints()
+	h.server = &http.Server{
+		Handler:           h,
+		ReadHeaderTimeout: httpReadHeaderTimeout,
+		ReadTimeout:       httpReadTimeout,
+		WriteTimeout:      httpWriteTimeout,
+	}
+	return h
+}
+
+// Start starts serving the apis on the configured port
+func (h *HTTPHandler) Start() {
+	address, err := h.config.ListenAddress()
+	if err != nil {
+		h.logger.WithField(logging.TagErr, err).Fatal("Failed to get the http gateway listen address")
+	}
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		h.logger.WithField(logging.TagErr, err).Fatal("Failed to listen on http gateway port")
+	}
+	go func() {
+		if err := h.server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			h.logger.WithField(logging.TagErr, err).Error("Http gateway stopped serving")
+		}
+	}()
+	h.logger.Infof("Http gateway listening at '%v'", address)
+}
+
+// Stop stops the gateway, waiting for the calls in progress to complete
+func (h *HTTPHandler) Stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), httpStopTimeout)
+	defer cancel()
+	if err := h.server.Shutdown(ctx); err != nil {
+		h.logger.WithField(logging.TagErr, err).Warn("Failed to stop the http gateway gracefully")
+	}
+}
+
+// ServeHTTP handles a call to one of the apis
+func (h *HTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, errHTTPMethodNotAllowed)
+		return
+	}
+	apiName := strings.TrimPrefix(r.URL.Path, httpAPIPathPrefix)
+	endpoint, ok := h.endpoints[apiName]
+	if !ok || !strings.HasPrefix(r.URL.Path, httpAPIPathPrefix) {
+		h.writeError(w, http.StatusNotFound, errHTTPAPINotFound)
+		return
+	}
+
+	ctx, err := h.newInboundCallContext(r, apiName)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, &gen.BadRequestError{Message: err.Error()})
+		return
+	}
+	body := http.MaxBytesReader(w, r.Body, httpMaxRequestBodySize)
+	decode := func(request interface{}) error {
+		if err := json.NewDecoder(body).Decode(request); err != nil {
+			return &gen.BadRequestError{Message: fmt.Sprintf("Failed to decode request: %v", err)}
+		}
+		return nil
+	}
+
+	response, err := endpoint(ctx, decode)
+	if err != nil {
+		h.writeError(w, httpStatus(err), err)
+		return
+	}
+	if response == nil || reflect.ValueOf(response).IsNil() {
+		response = struct{}{}
+	}
+	h.writeJSON(w, http.StatusOK, response)
+}
+
+// newInboundCallContext creates the context of a yarpc inbound call carrying the cadence headers of
+// the http request, so that the call is handled the same way as the ones made through yarpc
+func (h *HTTPHandler) newInboundCallContext(r *http.Request, apiName string) (context.Context, error) {
+	headers := transport.NewHeaders()
+	for name, values := range r.Header {
+		name = strings.ToLower(name)
+		if strings.HasPrefix(name, httpHeaderPrefix) && name != common.ServiceTokenHeaderName && len(values) > 0 {
+			headers = headers.With(name, values[0])
+		}
+	}
+	ctx, call := encoding.NewInboundCall(r.Context())
+	err := call.ReadFromRequest(&transport.Request{
+		Caller:    httpCallerName,
+		Service:   common.FrontendServiceName,
+		Encoding:  httpEncoding,
+		Procedure: "WorkflowService::" + apiName,
+		Headers:   headers,
+	})
+	return ctx, err
+}
+
+func (h *HTTPHandler) writeError(w http.ResponseWriter, status int, err error) {
+	// the thrift errors are returned as is, any other error is wrapped in an InternalServiceError
+	errType := "InternalServiceError"
+	var body interface{} = &gen.InternalServiceError{Message: err.Error()}
+	if t := reflect.TypeOf(err); t.Kind() == reflect.Ptr && t.Elem().PkgPath() == sharedTypesPkgPath {
+		errType, body = t.Elem().Name(), err
+	}
+	h.writeJSON(w, status, &httpErrorResponse{Type: errType, Error: body})
+}
+
+func (h *HTTPHandler) writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		h.logger.WithField(logging.TagErr, err).Warn("Failed to write http gateway response")
+	}
+}
+
+// httpStatus returns the http status code matching the thrift error returned by the handler
+func httpStatus(err error) int {
+	// authorization failures are bad requests to thrift clients
+	if err == errNoPermission {
+		return http.StatusForbidden
+	}
+	switch err.(type) {
+	case *gen.BadRequestError, *gen.QueryFailedError:
+		return http.StatusBadRequest
+	case *gen.AccessDeniedError:
+		return http.StatusForbidden
+	case *gen.EntityNotExistsError:
+		return http.StatusNotFound
+	case *gen.DomainAlreadyExistsError, *gen.WorkflowExecutionAlreadyStartedError, *gen.CancellationAlreadyRequestedError:
+		return http.StatusConflict
+	case *gen.DomainNotActiveError:
+		return http.StatusPreconditionFailed
+	case *gen.ServiceBusyError, *gen.LimitExceededError:
+		return http.StatusTooManyRequests
+	}
+	return http.StatusInternalServerError
+}
+
+func (h *HTTPHandler) newEndpoints() map[string]httpEndpoint {
+	return map[string]httpEndpoint{
+		"RegisterDomain": func(ctx context.Context, decode func(interface{}) error) (interface{}, error) {
+			request := &gen.RegisterDomainRequest{}
+			if err := decode(request); err != nil {
+				return nil, err
+			}
+			return nil, h.handler.RegisterDomain(ctx, request)
+		},
+		"DescribeDomain": func(ctx context.Context, decode func(interface{}) error) (interface{}, error) {
+			request := &gen.DescribeDomainRequest{}
+			if err := decode(request); err != nil {
+				return nil, err
+			}
+			return h.handler.DescribeDomain(ctx, request)
+		},
+		"ListDomains": func(ctx context.Context, decode func(interface{}) error) (interface{}, error) {
+			request := &gen.ListDomainsRequest{}
+			if err := decode(request); err != nil {
+				return nil, err
+			}
+			return h.handler.ListDomains(ctx, request)
+		},
+		"UpdateDomain": func(ctx context.Context, decode func(interface{}) error) (interface{}, error) {
+			request := &gen.UpdateDomainRequest{}
+			if err := decode(request); err != nil {
+				return nil, err
+			}
+			return h.handler.UpdateDomain(ctx, request)
+		},
+		"DeprecateDomain": func(ctx context.Context, decode func(interface{}) error) (interface{}, error) {
+			request := &gen.DeprecateDomainRequest{}
+			if err := decode(request); err != nil {
+				return nil, err
+			}
+			return nil, h.handler.DeprecateDomain(ctx, request)
+		},
+		"StartWorkflowExecution": func(ctx context.Context, decode func(interface{}) error) (interface{}, error) {
+			request := &gen.StartWorkflowExecutionRequest{}
+			if err := decode(request); err != nil {
+				return nil, err
+			}
+			return h.handler.StartWorkflowExecution(ctx, request)
+		},
+		"SignalWorkflowExecution": func(ctx context.Context, decode func(interface{}) error) (interface{}, error) {
+			request := &gen.SignalWorkflowExecutionRequest{}
+			if err := decode(request); err != nil {
+				return nil, err
+			}
+			return nil, h.handler.SignalWorkflowExecution(ctx, request)
+		},
+		"SignalWithStartWorkflowExecution": func(ctx context.Context, decode func(interface{}) error) (interface{}, error) {
+			request := &gen.SignalWithStartWorkflowExecutionRequest{}
+			if err := decode(request); err != nil {
+				return nil, err
+			}
+			return h.handler.SignalWithStartWorkflowExecution(ctx, request)
+		},
+		"QueryWorkflow": func(ctx context.Context, decode func(interface{}) error) (interface{}, error) {
+			request := &gen.QueryWorkflowRequest{}
+			if err := decode(request); err != nil {
+				return nil, err
+			}
+			return h.handler.QueryWorkflow(ctx, request)
+		},
+		"DescribeWorkflowExecution": func(ctx context.Context, decode func(interface{}) error) (interface{}, error) {
+			request := &gen.DescribeWorkflowExecutionRequest{}
+			if err := decode(request); err != nil {
+				return nil, err
+			}
+			return h.handler.DescribeWorkflowExecution(ctx, request)
+		},
+		"ListOpenWorkflowExecutions": func(ctx context.Context, decode func(interface{}) error) (interface{}, error) {
+			request := &gen.ListOpenWorkflowExecutionsRequest{}
+			if err := decode(request); err != nil {
+				return nil, err
+			}
+			return h.handler.ListOpenWorkflowExecutions(ctx, request)
+		},
+		"ListClosedWorkflowExecutions": func(ctx context.Context, decode func(interface{}) error) (interface{}, error) {
+			request := &gen.ListClosedWorkflowExecutionsRequest{}
+			if err := decode(request); err != nil {
+				return nil, err
+			}
+			return h.handler.ListClosedWorkflowExecutions(ctx, request)
+		},
+		"GetWorkflowExecutionHistory": func(ctx context.Context, decode func(interface{}) error) (interface{}, error) {
+			request := &gen.GetWorkflowExecutionHistoryRequest{}
+			if err := decode(request); err != nil {
+				return nil, err
+			}
+			return h.handler.GetWorkflowExecutionHistory(ctx, request)
+		},
+		"TerminateWorkflowExecution": func(ctx context.Context, decode func(interface{}) error) (interface{}, error) {
+			request := &gen.TerminateWorkflowExecutionRequest{}
+			if err := decode(request); err != nil {
+				return nil, err
+			}
+			return nil, h.handler.TerminateWorkflowExecution(ctx, request)
+		},
+		"PauseWorkflowExecution": func(ctx context.Context, decode func(interface{}) error) (interface{}, error) {
+			request := &gen.PauseWorkflowExecutionRequest{}
+			if err := decode(request); err != nil {
+				return nil, err
+			}
+			return nil, h.handler.PauseWorkflowExecution(ctx, request)
+		},
+		"ResumeWorkflowExecution": func(ctx context.Context, decode func(interface{}) error) (interface{}, error) {
+			request := &gen.ResumeWorkflowExecutionRequest{}
+			if err := decode(request); err != nil {
+				return nil, err
+			}
+			return nil, h.handler.ResumeWorkflowExecution(ctx, request)
+		},
+		"RetryActivityTask": func(ctx context.Context, decode func(interface{}) error) (interface{}, error) {
+			request := &gen.RetryActivityTaskRequest{}
+			if err := decode(request); err != nil {
+				return nil, err
+			}
+			return nil, h.handler.RetryActivityTask(ctx, request)
+		},
+		"CompleteActivityTask": func(ctx context.Context, decode func(interface{}) error) (interface{}, error) {
+			request := &gen.CompleteActivityTaskRequest{}
+			if err := decode(request); err != nil {
+				return nil, err
+			}
+			return nil, h.handler.CompleteActivityTask(ctx, request)
+		},
+		"FailActivityTask": func(ctx context.Context, decode func(interface{}) error) (interface{}, error) {
+			request := &gen.FailActivityTaskRequest{}
+			if err := decode(request); err != nil {
+				return nil, err
+			}
+			return nil, h.handler.FailActivityTask(ctx, request)
+		},
+		"RequestCancelWorkflowExecution": func(ctx context.Context, decode func(interface{}) error) (interface{}, error) {
+			request := &gen.RequestCancelWorkflowExecutionRequest{}
+			if err := decode(request); err != nil {
+				return nil, err
+			}
+			return nil, h.handler.RequestCancelWorkflowExecution(ctx, request)
+		},
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/service/config"
+	"go.uber.org/yarpc"
+)
+
+type (
+	httpHandlerSuite struct {
+		suite.Suite
+		*require.Assertions
+		handler     *fakeWorkflowServiceHandler
+		httpHandler *HTTPHandler
+	}
+
+	// fakeWorkflowServiceHandler implements the apis used by the tests, calling any other api panics
+	fakeWorkflowServiceHandler struct {
+		workflowserviceserver.Interface
+		identity     string
+		serviceToken string
+		request      interface{}
+		err          error
+	}
+)
+
+func TestHTTPHandlerSuite(t *testing.T) {
+	suite.Run(t, new(httpHandlerSuite))
+}
+
+func (s *httpHandlerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.handler = &fakeWorkflowServiceHandler{}
+	s.httpHandler = NewHTTPHandler(s.handler, &config.HTTP{}, bark.NewLoggerFromLogrus(logrus.New()))
+}
+
+func (s *httpHandlerSuite) TestStartWorkflowExecution() {
+	body := `{"domain": "test-domain", "workflowId": "wid", "workflowType": {"name": "wtype"},
+		"taskList": {"name": "tl", "kind": "NORMAL"}, "input": "aW5wdXQ=", "executionStartToCloseTimeoutSeconds": 60}`
+	response := s.call("StartWorkflowExecution", body, map[string]string{"Cadence-Caller-Identity": "test-identity"})
+	s.Equal(http.StatusOK, response.Code)
+	s.Equal("application/json", response.Header().Get("Content-Type"))
+
+	request := s.handler.request.(*gen.StartWorkflowExecutionRequest)
+	s.Equal("test-domain", request.GetDomain())
+	s.Equal("wid", request.GetWorkflowId())
+	s.Equal("wtype", request.WorkflowType.GetName())
+	s.Equal(gen.TaskListKindNormal, request.TaskList.GetKind())
+	s.Equal([]byte("input"), request.Input)
+	s.Equal(int32(60), request.GetExecutionStartToCloseTimeoutSeconds())
+	s.Equal("test-identity", s.handler.identity)
+
+	result := &gen.StartWorkflowExecutionResponse{}
+	s.NoError(json.Unmarshal(response.Body.Bytes(), result))
+	s.Equal("rid", result.GetRunId())
+}
+
+func (s *httpHandlerSuite) TestVoidResponse() {
+	response := s.call("SignalWorkflowExecution", `{"domain": "test-domain", "signalName": "signal"}`, nil)
+	s.Equal(http.StatusOK, response.Code)
+	s.JSONEq("{}", response.Body.String())
+	s.Equal("signal", s.handler.request.(*gen.SignalWorkflowExecutionRequest).GetSignalName())
+	s.Equal("", s.handler.identity)
+}
+
+func (s *httpHandlerSuite) TestServiceTokenDropped() {
+	response := s.call("SignalWorkflowExecution", `{"domain": "test-domain", "signalName": "signal"}`, map[string]string{
+		"Cadence-Caller-Identity": common.ServicePrincipal,
+		"Cadence-Service-Token":   "secret",
+	})
+	s.Equal(http.StatusOK, response.Code)
+	s.Equal(common.ServicePrincipal, s.handler.identity)
+	s.Empty(s.handler.serviceToken)
+}
+
+func (s *httpHandlerSuite) TestErrors() {
+	s.handler.err = &gen.EntityNotExistsError{Message: "domain not found"}
+	response := s.call("DescribeDomain", `{"name": "test-domain"}`, nil)
+	s.Equal(http.StatusNotFound, response.Code)
+	s.JSONEq(`{"type": "EntityNotExistsError", "error": {"message": "domain not found"}}`, response.Body.String())
+
+	s.handler.err = &gen.ServiceBusyError{Message: "busy"}
+	response = s.call("DescribeDomain", `{"name": "test-domain"}`, nil)
+	s.Equal(http.StatusTooManyRequests, response.Code)
+	s.JSONEq(`{"type": "ServiceBusyError", "error": {"message": "busy"}}`, response.Body.String())
+
+	s.handler.err = errNoPermission
+	response = s.call("DescribeDomain", `{"name": "test-domain"}`, nil)
+	s.Equal(http.StatusForbidden, response.Code)
+	s.JSONEq(`{"type": "BadRequestError", "error": {"message": "No permission to do this operation."}}`, response.Body.String())
+
+	s.handler.err = context.DeadlineExceeded
+	response = s.call("DescribeDomain", `{"name": "test-domain"}`, nil)
+	s.Equal(http.StatusInternalServerError, response.Code)
+	s.JSONEq(`{"type": "InternalServiceError", "error": {"message": "context deadline exceeded"}}`, response.Body.String())
+}
+
+func (s *httpHandlerSuite) TestInvalidCalls() {
+	response := s.call("DescribeDomain", `{"name": `, nil)
+	s.Equal(http.StatusBadRequest, response.Code)
+	s.Nil(s.handler.request)
+
+	response = s.call("PollForDecisionTask", `{}`, nil)
+	s.Equal(http.StatusNotFound, response.Code)
+
+	request := httptest.NewRequest(http.MethodGet, httpAPIPathPrefix+"DescribeDomain", nil)
+	recorder := httptest.NewRecorder()
+	s.httpHandler.ServeHTTP(recorder, request)
+	s.Equal(http.StatusMethodNotAllowed, recorder.Code)
+	s.Nil(s.handler.request)
+}
+
+func (s *httpHandlerSuite) TestRequestBodyTooLarge() {
+	body := `{"domain": "test-domain", "signalName": "` + strings.Repeat("a", httpMaxRequestBodySize) + `"}`
+	response := s.call("SignalWorkflowExecution", body, nil)
+	s.Equal(http.StatusBadRequest, response.Code)
+	s.Contains(response.Body.String(), "request body too large")
+	s.Nil(s.handler.request)
+}
+
+func (s *httpHandlerSuite) call(apiName string, body string, headers map[string]string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(http.MethodPost, httpAPIPathPrefix+apiName, strings.NewReader(body))
+	for name, value := range headers {
+		request.Header.Set(name, value)
+	}
+	recorder := httptest.NewRecorder()
+	s.httpHandler.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func (h *fakeWorkflowServiceHandler) record(ctx context.Context, request interface{}) {
+	h.identity = authorization.GetCallerIdentity(ctx)
+	h.serviceToken = yarpc.CallFromContext(ctx).Header(common.ServiceTokenHeaderName)
+	h.request = request
+}
+
+func (h *fakeWorkflowServiceHandler) StartWorkflowExecution(
+	ctx context.Context,
+	request *gen.StartWorkflowExecutionRequest,
+) (*gen.StartWorkflowExecutionResponse, error) {
+	h.record(ctx, request)
+	if h.err != nil {
+		return nil, h.err
+	}
+	return &gen.StartWorkflowExecutionResponse{RunId: common.StringPtr("rid")}, nil
+}
+
+func (h *fakeWorkflowServiceHandler) SignalWorkflowExecution(
+	ctx context.Context,
+	request *gen.SignalWorkflowExecutionRequest,
+) error {
+	h.record(ctx, request)
+	return h.err
+}
+
+func (h *fakeWorkflowServiceHandler) DescribeDomain(
+	ctx context.Context,
+	request *gen.DescribeDomainRequest,
+) (*gen.DescribeDomainResponse, error) {
+	h.record(ctx, request)
+	if h.err != nil {
+		return nil, h.err
+	}
+	return &gen.DescribeDomainResponse{}, nil
+}
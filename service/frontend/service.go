@@ -21,7 +21,10 @@
 package frontend
 
 import (
+	"time"
+
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/mocks"
 	persistencefactory "github.com/uber/cadence/common/persistence/persistence-factory"
@@ -32,35 +35,55 @@ import (
 // Config represents configuration for cadence-frontend service
 type Config struct {
 	PersistenceMaxQPS        dynamicconfig.IntPropertyFn
+	PersistenceDomainMaxQPS  dynamicconfig.IntPropertyFnWithDomainIDFilter
 	VisibilityMaxPageSize    dynamicconfig.IntPropertyFnWithDomainFilter
 	EnableVisibilitySampling dynamicconfig.BoolPropertyFn
 	VisibilityListMaxQPS     dynamicconfig.IntPropertyFnWithDomainFilter
 	HistoryMaxPageSize       dynamicconfig.IntPropertyFnWithDomainFilter
 	RPS                      dynamicconfig.IntPropertyFn
+	DomainRPS                dynamicconfig.IntPropertyFnWithDomainFilter
+	DomainWorkerRPS          dynamicconfig.IntPropertyFnWithDomainFilter
+	GlobalDomainRPS          dynamicconfig.IntPropertyFnWithDomainFilter
+	GlobalDomainWorkerRPS    dynamicconfig.IntPropertyFnWithDomainFilter
 
 	// Persistence settings
 	HistoryMgrNumConns dynamicconfig.IntPropertyFn
 
 	MaxDecisionStartToCloseTimeout dynamicconfig.IntPropertyFnWithDomainFilter
 
+	// size limit system protection
+	BlobSizeLimitError dynamicconfig.IntPropertyFnWithDomainFilter
+	BlobSizeLimitWarn  dynamicconfig.IntPropertyFnWithDomainFilter
+
 	// security protection settings
 	EnableAdminProtection dynamicconfig.BoolPropertyFn
 	AdminOperationToken   dynamicconfig.StringPropertyFn
+
+	// graceful domain failover settings
+	GracefulFailoverCheckInterval dynamicconfig.DurationPropertyFn
 }
 
 // NewConfig returns new service config with default values
 func NewConfig(dc *dynamicconfig.Collection) *Config {
 	return &Config{
 		PersistenceMaxQPS:              dc.GetIntProperty(dynamicconfig.FrontendPersistenceMaxQPS, 2000),
+		PersistenceDomainMaxQPS:        dc.GetIntPropertyFilteredByDomainID(dynamicconfig.FrontendPersistenceDomainMaxQPS, 0),
 		VisibilityMaxPageSize:          dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendVisibilityMaxPageSize, 1000),
 		EnableVisibilitySampling:       dc.GetBoolProperty(dynamicconfig.EnableVisibilitySampling, true),
 		VisibilityListMaxQPS:           dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendVisibilityListMaxQPS, 1),
 		HistoryMaxPageSize:             dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendHistoryMaxPageSize, 1000),
 		RPS:                            dc.GetIntProperty(dynamicconfig.FrontendRPS, 1200),
+		DomainRPS:                      dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendDomainRPS, 0),
+		DomainWorkerRPS:                dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendDomainWorkerRPS, 0),
+		GlobalDomainRPS:                dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendGlobalDomainRPS, 0),
+		GlobalDomainWorkerRPS:          dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendGlobalDomainWorkerRPS, 0),
 		HistoryMgrNumConns:             dc.GetIntProperty(dynamicconfig.FrontendHistoryMgrNumConns, 10),
 		MaxDecisionStartToCloseTimeout: dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaxDecisionStartToCloseTimeout, 600),
+		BlobSizeLimitError:             dc.GetIntPropertyFilteredByDomain(dynamicconfig.BlobSizeLimitError, 2*1024*1024),
+		BlobSizeLimitWarn:              dc.GetIntPropertyFilteredByDomain(dynamicconfig.BlobSizeLimitWarn, 256*1024),
 		EnableAdminProtection:          dc.GetBoolProperty(dynamicconfig.EnableAdminProtection, false),
 		AdminOperationToken:            dc.GetStringProperty(dynamicconfig.AdminOperationToken, "CadenceTeamONLY"),
+		GracefulFailoverCheckInterval:  dc.GetDurationProperty(dynamicconfig.FrontendGracefulFailoverCheckInterval, 10*time.Second),
 	}
 }
 
@@ -94,6 +117,7 @@ func (s *Service) Start() {
 	pConfig := params.PersistenceConfig
 	pConfig.HistoryMaxConns = s.config.HistoryMgrNumConns()
 	pConfig.SetMaxQPS(pConfig.DefaultStore, s.config.PersistenceMaxQPS())
+	pConfig.DomainMaxQPS = s.config.PersistenceDomainMaxQPS
 	pConfig.SamplingConfig.VisibilityListMaxQPS = s.config.VisibilityListMaxQPS
 	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), base.GetMetricsClient(), log)
 
@@ -115,6 +139,10 @@ func (s *Service) Start() {
 	if err != nil {
 		log.Warnf("Creating Cassandra historyV2 manager persistence failed: %v", err)
 	}
+	replicationDLQ, err := pFactory.NewReplicationDLQManager()
+	if err != nil {
+		log.Warnf("Creating replication DLQ manager persistence failed: %v", err)
+	}
 
 	// TODO when global domain is enabled, uncomment the line below and remove the line after
 	var kafkaProducer messaging.Producer
@@ -127,16 +155,35 @@ func (s *Service) Start() {
 		kafkaProducer = &mocks.KafkaProducer{}
 	}
 
-	wfHandler := NewWorkflowHandler(base, s.config, metadata, history, historyV2, visibility, kafkaProducer)
+	authorizer, err := authorization.NewAuthorizer(&params.AuthorizationConfig)
+	if err != nil {
+		log.Fatalf("Creating authorizer failed: %v", err)
+	}
+
+	wfHandler := NewWorkflowHandler(base, s.config, metadata, history, historyV2, visibility, kafkaProducer, authorizer)
 	wfHandler.Start()
 
-	adminHandler := NewAdminHandler(base, pConfig.NumHistoryShards, metadata)
+	var httpHandler *HTTPHandler
+	if params.HTTPConfig.Port != 0 {
+		httpHandler = NewHTTPHandler(wfHandler, &params.HTTPConfig, log)
+		httpHandler.Start()
+	}
+
+	adminHandler := NewAdminHandler(base, pConfig.NumHistoryShards, metadata, historyV2, visibility, replicationDLQ,
+		params.RPCFactory, authorizer)
 	adminHandler.Start()
 
+	failoverWatcher := newDomainFailoverWatcher(base, s.config, pConfig.NumHistoryShards, metadata, wfHandler.UpdateDomain)
+	failoverWatcher.Start()
+
 	log.Infof("%v started", common.FrontendServiceName)
 
 	<-s.stopC
 
+	if httpHandler != nil {
+		httpHandler.Stop()
+	}
+	failoverWatcher.Stop()
 	base.Stop()
 }
 
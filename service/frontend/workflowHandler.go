@@ -38,17 +38,21 @@ import (
 	m "github.com/uber/cadence/.gen/go/matching"
 	"github.com/uber/cadence/.gen/go/replicator"
 	gen "github.com/uber/cadence/.gen/go/shared"
+	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
+	"github.com/uber/cadence/common/limits"
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"go.uber.org/yarpc"
 	"go.uber.org/yarpc/yarpcerrors"
 )
 
@@ -68,9 +72,10 @@ type (
 		tokenSerializer   common.TaskTokenSerializer
 		metricsClient     metrics.Client
 		startWG           sync.WaitGroup
-		rateLimiter       common.TokenBucket
+		rateLimiter       *domainRateLimiter
 		config            *Config
 		domainReplicator  DomainReplicator
+		authorizer        authorization.Authorizer
 		service.Service
 	}
 
@@ -81,6 +86,7 @@ type (
 		IsWorkflowRunning bool
 		PersistenceToken  []byte
 		TransientDecision *gen.TransientDecisionInfo
+		BranchToken       []byte
 	}
 )
 
@@ -102,6 +108,17 @@ var (
 	errQueryTypeNotSet            = &gen.BadRequestError{Message: "QueryType is not set on request."}
 	errRequestNotSet              = &gen.BadRequestError{Message: "Request is nil."}
 	errNoPermission               = &gen.BadRequestError{Message: "No permission to do this operation."}
+	errBlobSizeExceedsLimit       = &gen.BadRequestError{Message: "Blob data size exceeds limit."}
+	errClusterNameNotSet          = &gen.BadRequestError{Message: "Cluster name not set on request."}
+	errShardIDNotSet              = &gen.BadRequestError{Message: "ShardID not set on request."}
+	errInvalidPageSize            = &gen.BadRequestError{Message: "Invalid PageSize."}
+	errReplicationDLQNotSupported = &gen.BadRequestError{Message: "Replication DLQ is not supported by the persistence store."}
+	errInvalidFailoverTimeout     = &gen.BadRequestError{Message: "Invalid FailoverTimeoutInSeconds."}
+	errInvalidRemoteCluster       = &gen.BadRequestError{Message: "Invalid cluster name, it must be a remote cluster."}
+	errQueueTypeNotSet            = &gen.BadRequestError{Message: "QueueType not set on request."}
+	errTaskIDNotSet               = &gen.BadRequestError{Message: "TaskID not set on request."}
+	errInvalidDelayStartSeconds   = &gen.BadRequestError{Message: "A valid DelayStartSeconds is not set on request."}
+	errRawHistoryNotSupported     = &gen.BadRequestError{Message: "Raw history is only supported for workflows using the events v2 store."}
 
 	// err indicating that this cluster is not the master, so cannot do domain registration or update
 	errNotMasterCluster                = &gen.BadRequestError{Message: "Cluster is not master cluster, cannot do domain registration or domain update."}
@@ -110,13 +127,24 @@ var (
 	errActiveClusterNotInClusters      = &gen.BadRequestError{Message: "Active cluster is not contained in all clusters."}
 	errCannotDoDomainFailoverAndUpdate = &gen.BadRequestError{Message: "Cannot set active cluster to current cluster when other parameters are set."}
 
+	errGracefulFailoverLocalDomain      = &gen.BadRequestError{Message: "Cannot do graceful failover on a local domain."}
+	errGracefulFailoverDomainTableV1    = &gen.BadRequestError{Message: "Cannot do graceful failover on a domain not migrated to the v2 domain table."}
+	errGracefulFailoverNotActive        = &gen.BadRequestError{Message: "Domain is not active in current cluster, graceful failover must be started from the active cluster."}
+	errGracefulFailoverToCurrentCluster = &gen.BadRequestError{Message: "Domain is already active in the target cluster."}
+
+	errDeleteSystemDomain      = &gen.BadRequestError{Message: "Cannot delete the cadence system domain."}
+	errDeleteGlobalDomain      = &gen.BadRequestError{Message: "Cannot delete a global domain."}
+	errDomainNotDeprecated     = &gen.BadRequestError{Message: "Domain must be deprecated before it can be deleted."}
+	errDomainHasOpenExecutions = &gen.BadRequestError{Message: "Domain has open workflow executions, they must be closed before the domain can be deleted."}
+	errDomainDeleted           = &gen.BadRequestError{Message: "Domain is deleted."}
+
 	frontendServiceRetryPolicy = common.CreateFrontendServiceRetryPolicy()
 )
 
 // NewWorkflowHandler creates a thrift handler for the cadence service
 func NewWorkflowHandler(sVice service.Service, config *Config, metadataMgr persistence.MetadataManager,
 	historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager, visibilityMgr persistence.VisibilityManager,
-	kafkaProducer messaging.Producer) *WorkflowHandler {
+	kafkaProducer messaging.Producer, authorizer authorization.Authorizer) *WorkflowHandler {
 	handler := &WorkflowHandler{
 		Service:          sVice,
 		config:           config,
@@ -126,9 +154,10 @@ func NewWorkflowHandler(sVice service.Service, config *Config, metadataMgr persi
 		visibitiltyMgr:   visibilityMgr,
 		tokenSerializer:  common.NewJSONTaskTokenSerializer(),
 		domainCache:      cache.NewDomainCache(metadataMgr, sVice.GetClusterMetadata(), sVice.GetMetricsClient(), sVice.GetLogger()),
-		rateLimiter:      common.NewTokenBucket(config.RPS(), common.NewRealTimeSource()),
 		domainReplicator: NewDomainReplicator(kafkaProducer, sVice.GetLogger()),
+		authorizer:       authorizer,
 	}
+	handler.rateLimiter = newDomainRateLimiter(config, handler.frontendHostCount)
 	// prevent us from trying to serve requests before handler's Start() is complete
 	handler.startWG.Add(1)
 	return handler
@@ -137,6 +166,7 @@ func NewWorkflowHandler(sVice service.Service, config *Config, metadataMgr persi
 // Start starts the handler
 func (wh *WorkflowHandler) Start() error {
 	wh.Service.GetDispatcher().Register(workflowserviceserver.New(wh))
+	wh.Service.GetDispatcher().Register(apiv1.BuildWorkflowServiceYARPCProcedures(NewGRPCHandler(wh)))
 	wh.Service.GetDispatcher().Register(metaserver.New(wh))
 	wh.Service.Start()
 	wh.domainCache.Start()
@@ -186,6 +216,69 @@ func (wh *WorkflowHandler) checkPermission(securityToken *string, scope int) err
 	return nil
 }
 
+// authorize checks that the caller of an inbound api call is allowed to call the api on the domain,
+// the calls made by the frontend itself, e.g. by the domain failover watcher, are always allowed
+func (wh *WorkflowHandler) authorize(ctx context.Context, apiName string, domainName string, scope int) error {
+	if !authorization.IsInboundCall(ctx) {
+		return nil
+	}
+	result, err := wh.authorizer.Authorize(ctx, &authorization.Attributes{
+		Actor:      authorization.GetCallerIdentity(ctx),
+		APIName:    apiName,
+		DomainName: domainName,
+	})
+	if err != nil {
+		return wh.error(err, scope)
+	}
+	if result.Decision != authorization.DecisionAllow {
+		wh.metricsClient.IncCounter(scope, metrics.CadenceErrUnauthorizedCounter)
+		return errNoPermission
+	}
+	return nil
+}
+
+// authorizeByDomainID is the same as authorize for the apis which only know the domain ID, e.g. from a task token
+func (wh *WorkflowHandler) authorizeByDomainID(ctx context.Context, apiName string, domainID string, scope int) error {
+	domainEntry, err := wh.domainCache.GetDomainByID(domainID)
+	if err != nil {
+		return wh.error(err, scope)
+	}
+	return wh.authorize(ctx, apiName, domainEntry.GetInfo().Name, scope)
+}
+
+// checkRateLimit rejects the request with a service busy error once the domain is over the rate limit for the
+// type of api called, hostLimit decides whether the request is also rejected once the frontend host is over its limit
+func (wh *WorkflowHandler) checkRateLimit(domainName string, apiType apiType, hostLimit hostLimit, scope int) error {
+	if ok, limitType := wh.rateLimiter.Allow(domainName, apiType, hostLimit); !ok {
+		wh.metricsClient.Tagged(map[string]string{
+			metrics.DomainTagName:    domainName,
+			metrics.LimitTypeTagName: limitType,
+		}).IncCounter(scope, metrics.LimitErrorCounter)
+		return wh.error(createServiceBusyError(), scope)
+	}
+	return nil
+}
+
+// checkRateLimitByDomainID is the same as checkRateLimit for the apis which only know the domain ID, e.g. from a task token
+func (wh *WorkflowHandler) checkRateLimitByDomainID(domainID string, apiType apiType, hostLimit hostLimit, scope int) error {
+	domainEntry, err := wh.domainCache.GetDomainByID(domainID)
+	if err != nil {
+		return wh.error(err, scope)
+	}
+	return wh.checkRateLimit(domainEntry.GetInfo().Name, apiType, hostLimit, scope)
+}
+
+// frontendHostCount returns the number of frontend hosts in the cluster, which the cluster wide
+// domain rate limits are divided by
+func (wh *WorkflowHandler) frontendHostCount() int {
+	resolver, err := wh.GetMembershipMonitor().GetResolver(common.FrontendServiceName)
+	if err != nil {
+		wh.GetLogger().Warnf("Failed to get frontend membership resolver, error: %v", err)
+		return 1
+	}
+	return len(resolver.Members())
+}
+
 // RegisterDomain creates a new domain which can be used as a container for all resources.  Domain is a top level
 // entity within Cadence, used as a container for all resources like workflow executions, tasklists, etc.  Domain
 // acts as a sandbox and provides isolation for all resources within the domain.  All resources belongs to exactly one
@@ -199,6 +292,10 @@ func (wh *WorkflowHandler) RegisterDomain(ctx context.Context, registerRequest *
 		return wh.error(errRequestNotSet, scope)
 	}
 
+	if err := wh.authorize(ctx, "RegisterDomain", registerRequest.GetName(), scope); err != nil {
+		return err
+	}
+
 	if err := wh.checkPermission(registerRequest.SecurityToken, scope); err != nil {
 		return err
 	}
@@ -311,6 +408,10 @@ func (wh *WorkflowHandler) ListDomains(ctx context.Context,
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
+	if err := wh.authorize(ctx, "ListDomains", "", scope); err != nil {
+		return nil, err
+	}
+
 	pageSize := 100
 	if listRequest.GetPageSize() != 0 {
 		pageSize = int(listRequest.GetPageSize())
@@ -355,6 +456,10 @@ func (wh *WorkflowHandler) DescribeDomain(ctx context.Context,
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
+	if err := wh.authorize(ctx, "DescribeDomain", describeRequest.GetName(), scope); err != nil {
+		return nil, err
+	}
+
 	if describeRequest.GetName() == "" {
 		return nil, wh.error(errDomainNotSet, scope)
 	}
@@ -371,6 +476,7 @@ func (wh *WorkflowHandler) DescribeDomain(ctx context.Context,
 	}
 	response.DomainInfo, response.Configuration, response.ReplicationConfiguration = createDomainResponse(
 		resp.Info, resp.Config, resp.ReplicationConfig)
+	response.FailoverInfo = createDomainFailoverInfo(resp.PendingActiveClusterName, resp.FailoverEndTime)
 
 	return response, nil
 }
@@ -387,6 +493,10 @@ func (wh *WorkflowHandler) UpdateDomain(ctx context.Context,
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
+	if err := wh.authorize(ctx, "UpdateDomain", updateRequest.GetName(), scope); err != nil {
+		return nil, err
+	}
+
 	if err := wh.checkPermission(updateRequest.SecurityToken, scope); err != nil {
 		return nil, err
 	}
@@ -421,6 +531,8 @@ func (wh *WorkflowHandler) UpdateDomain(ctx context.Context,
 	configVersion := getResponse.ConfigVersion
 	failoverVersion := getResponse.FailoverVersion
 	failoverNotificationVersion := getResponse.FailoverNotificationVersion
+	pendingActiveClusterName := getResponse.PendingActiveClusterName
+	failoverEndTime := getResponse.FailoverEndTime
 
 	// whether active cluster is changed
 	activeClusterChanged := false
@@ -543,6 +655,9 @@ func (wh *WorkflowHandler) UpdateDomain(ctx context.Context,
 		if activeClusterChanged {
 			failoverVersion = clusterMetadata.GetNextFailoverVersion(replicationConfig.ActiveClusterName, failoverVersion)
 			failoverNotificationVersion = notificationVersion
+			// a failover always completes, or overrides, the pending graceful failover
+			pendingActiveClusterName = ""
+			failoverEndTime = 0
 		}
 
 		updateReq := &persistence.UpdateDomainRequest{
@@ -552,6 +667,8 @@ func (wh *WorkflowHandler) UpdateDomain(ctx context.Context,
 			ConfigVersion:               configVersion,
 			FailoverVersion:             failoverVersion,
 			FailoverNotificationVersion: failoverNotificationVersion,
+			PendingActiveClusterName:    pendingActiveClusterName,
+			FailoverEndTime:             failoverEndTime,
 		}
 
 		switch getResponse.TableVersion {
@@ -614,6 +731,10 @@ func (wh *WorkflowHandler) DeprecateDomain(ctx context.Context, deprecateRequest
 		return wh.error(errRequestNotSet, scope)
 	}
 
+	if err := wh.authorize(ctx, "DeprecateDomain", deprecateRequest.GetName(), scope); err != nil {
+		return err
+	}
+
 	if err := wh.checkPermission(deprecateRequest.SecurityToken, scope); err != nil {
 		return err
 	}
@@ -641,15 +762,20 @@ func (wh *WorkflowHandler) DeprecateDomain(ctx context.Context, deprecateRequest
 	if err != nil {
 		return wh.error(err, scope)
 	}
+	if getResponse.Info.Status == persistence.DomainStatusDeleted {
+		return wh.error(errDomainDeleted, scope)
+	}
 
 	getResponse.ConfigVersion = getResponse.ConfigVersion + 1
 	getResponse.Info.Status = persistence.DomainStatusDeprecated
 	updateReq := &persistence.UpdateDomainRequest{
-		Info:              getResponse.Info,
-		Config:            getResponse.Config,
-		ReplicationConfig: getResponse.ReplicationConfig,
-		ConfigVersion:     getResponse.ConfigVersion,
-		FailoverVersion:   getResponse.FailoverVersion,
+		Info:                     getResponse.Info,
+		Config:                   getResponse.Config,
+		ReplicationConfig:        getResponse.ReplicationConfig,
+		ConfigVersion:            getResponse.ConfigVersion,
+		FailoverVersion:          getResponse.FailoverVersion,
+		PendingActiveClusterName: getResponse.PendingActiveClusterName,
+		FailoverEndTime:          getResponse.FailoverEndTime,
 	}
 
 	switch getResponse.TableVersion {
@@ -687,8 +813,12 @@ func (wh *WorkflowHandler) PollForActivityTask(
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+	if err := wh.authorize(ctx, "PollForActivityTask", pollRequest.GetDomain(), scope); err != nil {
+		return nil, err
+	}
+
+	if err := wh.checkRateLimit(pollRequest.GetDomain(), apiTypeWorker, hostLimitEnforced, scope); err != nil {
+		return nil, err
 	}
 
 	wh.Service.GetLogger().Debug("Received PollForActivityTask")
@@ -743,8 +873,12 @@ func (wh *WorkflowHandler) PollForDecisionTask(
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+	if err := wh.authorize(ctx, "PollForDecisionTask", pollRequest.GetDomain(), scope); err != nil {
+		return nil, err
+	}
+
+	if err := wh.checkRateLimit(pollRequest.GetDomain(), apiTypeWorker, hostLimitEnforced, scope); err != nil {
+		return nil, err
 	}
 
 	wh.Service.GetLogger().Debug("Received PollForDecisionTask")
@@ -839,9 +973,6 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeat(
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.rateLimiter.TryConsume(1)
-
 	wh.Service.GetLogger().Debug("Received RecordActivityTaskHeartbeat")
 	if heartbeatRequest.TaskToken == nil {
 		return nil, wh.error(errTaskTokenNotSet, scope)
@@ -853,6 +984,21 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeat(
 	if taskToken.DomainID == "" {
 		return nil, wh.error(errDomainNotSet, scope)
 	}
+	domainEntry, err := wh.domainCache.GetDomainByID(taskToken.DomainID)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if err := wh.authorize(ctx, "RecordActivityTaskHeartbeat", domainEntry.GetInfo().Name, scope); err != nil {
+		return nil, err
+	}
+	if err := wh.checkRateLimit(domainEntry.GetInfo().Name, apiTypeWorker, hostLimitCounted, scope); err != nil {
+		return nil, err
+	}
+	if err := wh.checkBlobSizeLimit(domainEntry.GetInfo().Name, taskToken.WorkflowID, taskToken.RunID,
+		heartbeatRequest.Details, scope); err != nil {
+		return nil, wh.error(err, scope)
+	}
 
 	resp, err := wh.history.RecordActivityTaskHeartbeat(ctx, &h.RecordActivityTaskHeartbeatRequest{
 		DomainUUID:       common.StringPtr(taskToken.DomainID),
@@ -877,8 +1023,13 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeatByID(
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.rateLimiter.TryConsume(1)
+	if err := wh.authorize(ctx, "RecordActivityTaskHeartbeatByID", heartbeatRequest.GetDomain(), scope); err != nil {
+		return nil, err
+	}
+
+	if err := wh.checkRateLimit(heartbeatRequest.GetDomain(), apiTypeWorker, hostLimitCounted, scope); err != nil {
+		return nil, err
+	}
 
 	wh.Service.GetLogger().Debug("Received RecordActivityTaskHeartbeatByID")
 	domainID, err := wh.domainCache.GetDomainID(heartbeatRequest.GetDomain())
@@ -898,6 +1049,9 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeatByID(
 	if activityID == "" {
 		return nil, wh.error(errActivityIDNotSet, scope)
 	}
+	if err := wh.checkBlobSizeLimit(heartbeatRequest.GetDomain(), workflowID, runID, heartbeatRequest.Details, scope); err != nil {
+		return nil, wh.error(err, scope)
+	}
 
 	taskToken := &common.TaskToken{
 		DomainID:   domainID,
@@ -940,9 +1094,6 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(
 		return wh.error(errRequestNotSet, scope)
 	}
 
-	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.rateLimiter.TryConsume(1)
-
 	if completeRequest.TaskToken == nil {
 		return wh.error(errTaskTokenNotSet, scope)
 	}
@@ -953,6 +1104,21 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(
 	if taskToken.DomainID == "" {
 		return wh.error(errDomainNotSet, scope)
 	}
+	domainEntry, err := wh.domainCache.GetDomainByID(taskToken.DomainID)
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	if err := wh.authorize(ctx, "RespondActivityTaskCompleted", domainEntry.GetInfo().Name, scope); err != nil {
+		return err
+	}
+	if err := wh.checkRateLimit(domainEntry.GetInfo().Name, apiTypeWorker, hostLimitCounted, scope); err != nil {
+		return err
+	}
+	if err := wh.checkBlobSizeLimit(domainEntry.GetInfo().Name, taskToken.WorkflowID, taskToken.RunID,
+		completeRequest.Result, scope); err != nil {
+		return wh.error(err, scope)
+	}
 
 	err = wh.history.RespondActivityTaskCompleted(ctx, &h.RespondActivityTaskCompletedRequest{
 		DomainUUID:      common.StringPtr(taskToken.DomainID),
@@ -977,8 +1143,13 @@ func (wh *WorkflowHandler) RespondActivityTaskCompletedByID(
 		return wh.error(errRequestNotSet, scope)
 	}
 
-	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.rateLimiter.TryConsume(1)
+	if err := wh.authorize(ctx, "RespondActivityTaskCompletedByID", completeRequest.GetDomain(), scope); err != nil {
+		return err
+	}
+
+	if err := wh.checkRateLimit(completeRequest.GetDomain(), apiTypeWorker, hostLimitCounted, scope); err != nil {
+		return err
+	}
 
 	domainID, err := wh.domainCache.GetDomainID(completeRequest.GetDomain())
 	if err != nil {
@@ -997,6 +1168,9 @@ func (wh *WorkflowHandler) RespondActivityTaskCompletedByID(
 	if activityID == "" {
 		return wh.error(errActivityIDNotSet, scope)
 	}
+	if err := wh.checkBlobSizeLimit(completeRequest.GetDomain(), workflowID, runID, completeRequest.Result, scope); err != nil {
+		return wh.error(err, scope)
+	}
 
 	taskToken := &common.TaskToken{
 		DomainID:   domainID,
@@ -1039,9 +1213,6 @@ func (wh *WorkflowHandler) RespondActivityTaskFailed(
 		return wh.error(errRequestNotSet, scope)
 	}
 
-	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.rateLimiter.TryConsume(1)
-
 	if failedRequest.TaskToken == nil {
 		return wh.error(errTaskTokenNotSet, scope)
 	}
@@ -1052,6 +1223,21 @@ func (wh *WorkflowHandler) RespondActivityTaskFailed(
 	if taskToken.DomainID == "" {
 		return wh.error(errDomainNotSet, scope)
 	}
+	domainEntry, err := wh.domainCache.GetDomainByID(taskToken.DomainID)
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	if err := wh.authorize(ctx, "RespondActivityTaskFailed", domainEntry.GetInfo().Name, scope); err != nil {
+		return err
+	}
+	if err := wh.checkRateLimit(domainEntry.GetInfo().Name, apiTypeWorker, hostLimitCounted, scope); err != nil {
+		return err
+	}
+	if err := wh.checkBlobSizeLimit(domainEntry.GetInfo().Name, taskToken.WorkflowID, taskToken.RunID,
+		failedRequest.Details, scope); err != nil {
+		return wh.error(err, scope)
+	}
 
 	err = wh.history.RespondActivityTaskFailed(ctx, &h.RespondActivityTaskFailedRequest{
 		DomainUUID:    common.StringPtr(taskToken.DomainID),
@@ -1076,8 +1262,13 @@ func (wh *WorkflowHandler) RespondActivityTaskFailedByID(
 		return wh.error(errRequestNotSet, scope)
 	}
 
-	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.rateLimiter.TryConsume(1)
+	if err := wh.authorize(ctx, "RespondActivityTaskFailedByID", failedRequest.GetDomain(), scope); err != nil {
+		return err
+	}
+
+	if err := wh.checkRateLimit(failedRequest.GetDomain(), apiTypeWorker, hostLimitCounted, scope); err != nil {
+		return err
+	}
 
 	domainID, err := wh.domainCache.GetDomainID(failedRequest.GetDomain())
 	if err != nil {
@@ -1096,6 +1287,9 @@ func (wh *WorkflowHandler) RespondActivityTaskFailedByID(
 	if activityID == "" {
 		return wh.error(errActivityIDNotSet, scope)
 	}
+	if err := wh.checkBlobSizeLimit(failedRequest.GetDomain(), workflowID, runID, failedRequest.Details, scope); err != nil {
+		return wh.error(err, scope)
+	}
 
 	taskToken := &common.TaskToken{
 		DomainID:   domainID,
@@ -1139,9 +1333,6 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceled(
 		return wh.error(errRequestNotSet, scope)
 	}
 
-	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.rateLimiter.TryConsume(1)
-
 	if cancelRequest.TaskToken == nil {
 		return wh.error(errTaskTokenNotSet, scope)
 	}
@@ -1152,6 +1343,21 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceled(
 	if taskToken.DomainID == "" {
 		return wh.error(errDomainNotSet, scope)
 	}
+	domainEntry, err := wh.domainCache.GetDomainByID(taskToken.DomainID)
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	if err := wh.authorize(ctx, "RespondActivityTaskCanceled", domainEntry.GetInfo().Name, scope); err != nil {
+		return err
+	}
+	if err := wh.checkRateLimit(domainEntry.GetInfo().Name, apiTypeWorker, hostLimitCounted, scope); err != nil {
+		return err
+	}
+	if err := wh.checkBlobSizeLimit(domainEntry.GetInfo().Name, taskToken.WorkflowID, taskToken.RunID,
+		cancelRequest.Details, scope); err != nil {
+		return wh.error(err, scope)
+	}
 
 	err = wh.history.RespondActivityTaskCanceled(ctx, &h.RespondActivityTaskCanceledRequest{
 		DomainUUID:    common.StringPtr(taskToken.DomainID),
@@ -1176,8 +1382,13 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceledByID(
 		return wh.error(errRequestNotSet, scope)
 	}
 
-	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.rateLimiter.TryConsume(1)
+	if err := wh.authorize(ctx, "RespondActivityTaskCanceledByID", cancelRequest.GetDomain(), scope); err != nil {
+		return err
+	}
+
+	if err := wh.checkRateLimit(cancelRequest.GetDomain(), apiTypeWorker, hostLimitCounted, scope); err != nil {
+		return err
+	}
 
 	domainID, err := wh.domainCache.GetDomainID(cancelRequest.GetDomain())
 	if err != nil {
@@ -1196,6 +1407,9 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceledByID(
 	if activityID == "" {
 		return wh.error(errActivityIDNotSet, scope)
 	}
+	if err := wh.checkBlobSizeLimit(cancelRequest.GetDomain(), workflowID, runID, cancelRequest.Details, scope); err != nil {
+		return wh.error(err, scope)
+	}
 
 	taskToken := &common.TaskToken{
 		DomainID:   domainID,
@@ -1238,9 +1452,6 @@ func (wh *WorkflowHandler) RespondDecisionTaskCompleted(
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.rateLimiter.TryConsume(1)
-
 	if completeRequest.TaskToken == nil {
 		return nil, wh.error(errTaskTokenNotSet, scope)
 	}
@@ -1252,6 +1463,13 @@ func (wh *WorkflowHandler) RespondDecisionTaskCompleted(
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.authorizeByDomainID(ctx, "RespondDecisionTaskCompleted", taskToken.DomainID, scope); err != nil {
+		return nil, err
+	}
+	if err := wh.checkRateLimitByDomainID(taskToken.DomainID, apiTypeWorker, hostLimitCounted, scope); err != nil {
+		return nil, err
+	}
+
 	histResp, err := wh.history.RespondDecisionTaskCompleted(ctx, &h.RespondDecisionTaskCompletedRequest{
 		DomainUUID:      common.StringPtr(taskToken.DomainID),
 		CompleteRequest: completeRequest},
@@ -1303,9 +1521,6 @@ func (wh *WorkflowHandler) RespondDecisionTaskFailed(
 		return wh.error(errRequestNotSet, scope)
 	}
 
-	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.rateLimiter.TryConsume(1)
-
 	if failedRequest.TaskToken == nil {
 		return wh.error(errTaskTokenNotSet, scope)
 	}
@@ -1317,6 +1532,13 @@ func (wh *WorkflowHandler) RespondDecisionTaskFailed(
 		return wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.authorizeByDomainID(ctx, "RespondDecisionTaskFailed", taskToken.DomainID, scope); err != nil {
+		return err
+	}
+	if err := wh.checkRateLimitByDomainID(taskToken.DomainID, apiTypeWorker, hostLimitCounted, scope); err != nil {
+		return err
+	}
+
 	err = wh.history.RespondDecisionTaskFailed(ctx, &h.RespondDecisionTaskFailedRequest{
 		DomainUUID:    common.StringPtr(taskToken.DomainID),
 		FailedRequest: failedRequest,
@@ -1340,9 +1562,6 @@ func (wh *WorkflowHandler) RespondQueryTaskCompleted(
 		return wh.error(errRequestNotSet, scope)
 	}
 
-	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.rateLimiter.TryConsume(1)
-
 	if completeRequest.TaskToken == nil {
 		return wh.error(errTaskTokenNotSet, scope)
 	}
@@ -1354,6 +1573,13 @@ func (wh *WorkflowHandler) RespondQueryTaskCompleted(
 		return wh.error(errInvalidTaskToken, scope)
 	}
 
+	if err := wh.authorizeByDomainID(ctx, "RespondQueryTaskCompleted", queryTaskToken.DomainID, scope); err != nil {
+		return err
+	}
+	if err := wh.checkRateLimitByDomainID(queryTaskToken.DomainID, apiTypeWorker, hostLimitCounted, scope); err != nil {
+		return err
+	}
+
 	matchingRequest := &m.RespondQueryTaskCompletedRequest{
 		DomainUUID:       common.StringPtr(queryTaskToken.DomainID),
 		TaskList:         &gen.TaskList{Name: common.StringPtr(queryTaskToken.TaskList)},
@@ -1381,8 +1607,12 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+	if err := wh.authorize(ctx, "StartWorkflowExecution", startRequest.GetDomain(), scope); err != nil {
+		return nil, err
+	}
+
+	if err := wh.checkRateLimit(startRequest.GetDomain(), apiTypeUser, hostLimitEnforced, scope); err != nil {
+		return nil, err
 	}
 
 	if startRequest.GetDomain() == "" {
@@ -1419,6 +1649,10 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 			Message: "A valid TaskStartToCloseTimeoutSeconds is not set on request."}, scope)
 	}
 
+	if startRequest.GetDelayStartSeconds() < 0 {
+		return nil, wh.error(errInvalidDelayStartSeconds, scope)
+	}
+
 	maxDecisionTimeout := int32(wh.config.MaxDecisionStartToCloseTimeout(startRequest.GetDomain()))
 	// TODO: remove this assignment and logging in future, so that frontend will just return bad request for large decision timeout
 	if startRequest.GetTaskStartToCloseTimeoutSeconds() > startRequest.GetExecutionStartToCloseTimeoutSeconds() {
@@ -1446,8 +1680,12 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 	}
 
 	domainName := startRequest.GetDomain()
+	if err := wh.checkBlobSizeLimit(domainName, startRequest.GetWorkflowId(), "", startRequest.Input, scope); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	wh.Service.GetLogger().Debugf("Start workflow execution request domain: %v", domainName)
-	domainID, err := wh.domainCache.GetDomainID(domainName)
+	domainID, err := wh.getDomainIDForNewWorkflow(domainName)
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -1475,8 +1713,12 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+	if err := wh.authorize(ctx, "GetWorkflowExecutionHistory", getRequest.GetDomain(), scope); err != nil {
+		return nil, err
+	}
+
+	if err := wh.checkRateLimit(getRequest.GetDomain(), apiTypeUser, hostLimitEnforced, scope); err != nil {
+		return nil, err
 	}
 
 	if getRequest.GetDomain() == "" {
@@ -1627,6 +1869,52 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 	return createGetWorkflowExecutionHistoryResponse(history, nextToken), nil
 }
 
+// GetWorkflowExecutionRawHistory - retrieves the history of workflow execution as the blobs stored by persistence,
+// the events are not deserialized by frontend
+func (wh *WorkflowHandler) GetWorkflowExecutionRawHistory(
+	ctx context.Context,
+	getRequest *gen.GetWorkflowExecutionRawHistoryRequest) (*gen.GetWorkflowExecutionRawHistoryResponse, error) {
+
+	scope := metrics.FrontendGetWorkflowExecutionRawHistoryScope
+	sw := wh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if getRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if err := wh.authorize(ctx, "GetWorkflowExecutionRawHistory", getRequest.GetDomain(), scope); err != nil {
+		return nil, err
+	}
+
+	if err := wh.checkRateLimit(getRequest.GetDomain(), apiTypeUser, hostLimitEnforced, scope); err != nil {
+		return nil, err
+	}
+
+	if getRequest.GetDomain() == "" {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	if err := wh.validateExecutionAndEmitMetrics(getRequest.Execution, scope); err != nil {
+		return nil, err
+	}
+
+	if getRequest.GetMaximumPageSize() <= 0 {
+		getRequest.MaximumPageSize = common.Int32Ptr(int32(wh.config.HistoryMaxPageSize(getRequest.GetDomain())))
+	}
+
+	domainID, err := wh.domainCache.GetDomainID(getRequest.GetDomain())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	resp, err := getWorkflowExecutionRawHistory(ctx, wh.history, wh.historyV2Mgr, domainID, getRequest)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+	return resp, nil
+}
+
 // SignalWorkflowExecution is used to send a signal event to running workflow execution.  This results in
 // WorkflowExecutionSignaled event recorded in the history and a decision task being created for the execution.
 func (wh *WorkflowHandler) SignalWorkflowExecution(ctx context.Context,
@@ -1640,8 +1928,12 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(ctx context.Context,
 		return wh.error(errRequestNotSet, scope)
 	}
 
-	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
-		return wh.error(createServiceBusyError(), scope)
+	if err := wh.authorize(ctx, "SignalWorkflowExecution", signalRequest.GetDomain(), scope); err != nil {
+		return err
+	}
+
+	if err := wh.checkRateLimit(signalRequest.GetDomain(), apiTypeUser, hostLimitEnforced, scope); err != nil {
+		return err
 	}
 
 	if signalRequest.GetDomain() == "" {
@@ -1656,6 +1948,11 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(ctx context.Context,
 		return wh.error(&gen.BadRequestError{Message: "SignalName is not set on request."}, scope)
 	}
 
+	if err := wh.checkBlobSizeLimit(signalRequest.GetDomain(), signalRequest.WorkflowExecution.GetWorkflowId(),
+		signalRequest.WorkflowExecution.GetRunId(), signalRequest.Input, scope); err != nil {
+		return wh.error(err, scope)
+	}
+
 	domainID, err := wh.domainCache.GetDomainID(signalRequest.GetDomain())
 	if err != nil {
 		return wh.error(err, scope)
@@ -1688,8 +1985,12 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+	if err := wh.authorize(ctx, "SignalWithStartWorkflowExecution", signalWithStartRequest.GetDomain(), scope); err != nil {
+		return nil, err
+	}
+
+	if err := wh.checkRateLimit(signalWithStartRequest.GetDomain(), apiTypeUser, hostLimitEnforced, scope); err != nil {
+		return nil, err
 	}
 
 	if signalWithStartRequest.GetDomain() == "" {
@@ -1722,6 +2023,10 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 			Message: "A valid TaskStartToCloseTimeoutSeconds is not set on request."}, scope)
 	}
 
+	if signalWithStartRequest.GetDelayStartSeconds() < 0 {
+		return nil, wh.error(errInvalidDelayStartSeconds, scope)
+	}
+
 	if err := common.ValidateRetryPolicy(signalWithStartRequest.RetryPolicy); err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -1752,7 +2057,16 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 			Message: fmt.Sprintf("TaskStartToCloseTimeoutSeconds is larger than ExecutionStartToCloseTimeout or MaxDecisionStartToCloseTimeout (%ds).", maxDecisionTimeout)}, scope)
 	}
 
-	domainID, err := wh.domainCache.GetDomainID(signalWithStartRequest.GetDomain())
+	if err := wh.checkBlobSizeLimit(signalWithStartRequest.GetDomain(), signalWithStartRequest.GetWorkflowId(), "",
+		signalWithStartRequest.Input, scope); err != nil {
+		return nil, wh.error(err, scope)
+	}
+	if err := wh.checkBlobSizeLimit(signalWithStartRequest.GetDomain(), signalWithStartRequest.GetWorkflowId(), "",
+		signalWithStartRequest.SignalInput, scope); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	domainID, err := wh.getDomainIDForNewWorkflow(signalWithStartRequest.GetDomain())
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -1788,8 +2102,12 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(ctx context.Context,
 		return wh.error(errRequestNotSet, scope)
 	}
 
-	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
-		return wh.error(createServiceBusyError(), scope)
+	if err := wh.authorize(ctx, "TerminateWorkflowExecution", terminateRequest.GetDomain(), scope); err != nil {
+		return err
+	}
+
+	if err := wh.checkRateLimit(terminateRequest.GetDomain(), apiTypeUser, hostLimitEnforced, scope); err != nil {
+		return err
 	}
 
 	if terminateRequest.GetDomain() == "" {
@@ -1816,6 +2134,254 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(ctx context.Context,
 	return nil
 }
 
+// PauseWorkflowExecution pauses a running workflow execution by recording WorkflowExecutionPaused event in the
+// history. Decision and activity tasks are held until the execution is resumed, while timers and signals are still
+// recorded.
+func (wh *WorkflowHandler) PauseWorkflowExecution(ctx context.Context,
+	pauseRequest *gen.PauseWorkflowExecutionRequest) error {
+
+	scope := metrics.FrontendPauseWorkflowExecutionScope
+	sw := wh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if pauseRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if err := wh.authorize(ctx, "PauseWorkflowExecution", pauseRequest.GetDomain(), scope); err != nil {
+		return err
+	}
+
+	if err := wh.checkRateLimit(pauseRequest.GetDomain(), apiTypeUser, hostLimitEnforced, scope); err != nil {
+		return err
+	}
+
+	if pauseRequest.GetDomain() == "" {
+		return wh.error(errDomainNotSet, scope)
+	}
+
+	if err := wh.validateExecutionAndEmitMetrics(pauseRequest.WorkflowExecution, scope); err != nil {
+		return err
+	}
+
+	domainID, err := wh.domainCache.GetDomainID(pauseRequest.GetDomain())
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	err = wh.history.PauseWorkflowExecution(ctx, &h.PauseWorkflowExecutionRequest{
+		DomainUUID:   common.StringPtr(domainID),
+		PauseRequest: pauseRequest,
+	})
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
+// ResumeWorkflowExecution resumes a paused workflow execution by recording WorkflowExecutionResumed event in
+// the history and dispatching the decision and activity tasks which were held.
+func (wh *WorkflowHandler) ResumeWorkflowExecution(ctx context.Context,
+	resumeRequest *gen.ResumeWorkflowExecutionRequest) error {
+
+	scope := metrics.FrontendResumeWorkflowExecutionScope
+	sw := wh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if resumeRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if err := wh.authorize(ctx, "ResumeWorkflowExecution", resumeRequest.GetDomain(), scope); err != nil {
+		return err
+	}
+
+	if err := wh.checkRateLimit(resumeRequest.GetDomain(), apiTypeUser, hostLimitEnforced, scope); err != nil {
+		return err
+	}
+
+	if resumeRequest.GetDomain() == "" {
+		return wh.error(errDomainNotSet, scope)
+	}
+
+	if err := wh.validateExecutionAndEmitMetrics(resumeRequest.WorkflowExecution, scope); err != nil {
+		return err
+	}
+
+	domainID, err := wh.domainCache.GetDomainID(resumeRequest.GetDomain())
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	err = wh.history.ResumeWorkflowExecution(ctx, &h.ResumeWorkflowExecutionRequest{
+		DomainUUID:    common.StringPtr(domainID),
+		ResumeRequest: resumeRequest,
+	})
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
+// RetryActivityTask dispatches a pending activity immediately instead of waiting for its retry backoff, optionally
+// resetting its attempt counter. It records an ActivityTaskRetryRequested event in the history.
+func (wh *WorkflowHandler) RetryActivityTask(ctx context.Context,
+	retryRequest *gen.RetryActivityTaskRequest) error {
+
+	scope := metrics.FrontendRetryActivityTaskScope
+	sw := wh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if retryRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if err := wh.authorize(ctx, "RetryActivityTask", retryRequest.GetDomain(), scope); err != nil {
+		return err
+	}
+
+	if err := wh.checkRateLimit(retryRequest.GetDomain(), apiTypeUser, hostLimitEnforced, scope); err != nil {
+		return err
+	}
+
+	if retryRequest.GetDomain() == "" {
+		return wh.error(errDomainNotSet, scope)
+	}
+
+	if err := wh.validateExecutionAndEmitMetrics(retryRequest.WorkflowExecution, scope); err != nil {
+		return err
+	}
+
+	if retryRequest.GetActivityID() == "" {
+		return wh.error(errActivityIDNotSet, scope)
+	}
+
+	domainID, err := wh.domainCache.GetDomainID(retryRequest.GetDomain())
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	err = wh.history.RetryActivityTask(ctx, &h.RetryActivityTaskRequest{
+		DomainUUID:   common.StringPtr(domainID),
+		RetryRequest: retryRequest,
+	})
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
+// CompleteActivityTask completes a pending activity on behalf of an operator by recording ActivityTaskCompleted event
+// in the history.
+func (wh *WorkflowHandler) CompleteActivityTask(ctx context.Context,
+	completeRequest *gen.CompleteActivityTaskRequest) error {
+
+	scope := metrics.FrontendCompleteActivityTaskScope
+	sw := wh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if completeRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if err := wh.authorize(ctx, "CompleteActivityTask", completeRequest.GetDomain(), scope); err != nil {
+		return err
+	}
+
+	if err := wh.checkRateLimit(completeRequest.GetDomain(), apiTypeUser, hostLimitEnforced, scope); err != nil {
+		return err
+	}
+
+	if completeRequest.GetDomain() == "" {
+		return wh.error(errDomainNotSet, scope)
+	}
+
+	if err := wh.validateExecutionAndEmitMetrics(completeRequest.WorkflowExecution, scope); err != nil {
+		return err
+	}
+
+	if completeRequest.GetActivityID() == "" {
+		return wh.error(errActivityIDNotSet, scope)
+	}
+
+	if err := wh.checkBlobSizeLimit(completeRequest.GetDomain(), completeRequest.WorkflowExecution.GetWorkflowId(),
+		completeRequest.WorkflowExecution.GetRunId(), completeRequest.Result, scope); err != nil {
+		return wh.error(err, scope)
+	}
+
+	domainID, err := wh.domainCache.GetDomainID(completeRequest.GetDomain())
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	err = wh.history.CompleteActivityTask(ctx, &h.CompleteActivityTaskRequest{
+		DomainUUID:      common.StringPtr(domainID),
+		CompleteRequest: completeRequest,
+	})
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
+// FailActivityTask fails a pending activity on behalf of an operator by recording ActivityTaskFailed event in the
+// history, the activity is not retried.
+func (wh *WorkflowHandler) FailActivityTask(ctx context.Context,
+	failRequest *gen.FailActivityTaskRequest) error {
+
+	scope := metrics.FrontendFailActivityTaskScope
+	sw := wh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if failRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if err := wh.authorize(ctx, "FailActivityTask", failRequest.GetDomain(), scope); err != nil {
+		return err
+	}
+
+	if err := wh.checkRateLimit(failRequest.GetDomain(), apiTypeUser, hostLimitEnforced, scope); err != nil {
+		return err
+	}
+
+	if failRequest.GetDomain() == "" {
+		return wh.error(errDomainNotSet, scope)
+	}
+
+	if err := wh.validateExecutionAndEmitMetrics(failRequest.WorkflowExecution, scope); err != nil {
+		return err
+	}
+
+	if failRequest.GetActivityID() == "" {
+		return wh.error(errActivityIDNotSet, scope)
+	}
+
+	if err := wh.checkBlobSizeLimit(failRequest.GetDomain(), failRequest.WorkflowExecution.GetWorkflowId(),
+		failRequest.WorkflowExecution.GetRunId(), failRequest.Details, scope); err != nil {
+		return wh.error(err, scope)
+	}
+
+	domainID, err := wh.domainCache.GetDomainID(failRequest.GetDomain())
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	err = wh.history.FailActivityTask(ctx, &h.FailActivityTaskRequest{
+		DomainUUID:  common.StringPtr(domainID),
+		FailRequest: failRequest,
+	})
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
 // RequestCancelWorkflowExecution - requests to cancel a workflow execution
 func (wh *WorkflowHandler) RequestCancelWorkflowExecution(
 	ctx context.Context,
@@ -1829,8 +2395,12 @@ func (wh *WorkflowHandler) RequestCancelWorkflowExecution(
 		return wh.error(errRequestNotSet, scope)
 	}
 
-	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
-		return wh.error(createServiceBusyError(), scope)
+	if err := wh.authorize(ctx, "RequestCancelWorkflowExecution", cancelRequest.GetDomain(), scope); err != nil {
+		return err
+	}
+
+	if err := wh.checkRateLimit(cancelRequest.GetDomain(), apiTypeUser, hostLimitEnforced, scope); err != nil {
+		return err
 	}
 
 	if cancelRequest.GetDomain() == "" {
@@ -1869,8 +2439,12 @@ func (wh *WorkflowHandler) ListOpenWorkflowExecutions(ctx context.Context,
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+	if err := wh.authorize(ctx, "ListOpenWorkflowExecutions", listRequest.GetDomain(), scope); err != nil {
+		return nil, err
+	}
+
+	if err := wh.checkRateLimit(listRequest.GetDomain(), apiTypeUser, hostLimitEnforced, scope); err != nil {
+		return nil, err
 	}
 
 	if listRequest.GetDomain() == "" {
@@ -1953,8 +2527,12 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(ctx context.Context,
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+	if err := wh.authorize(ctx, "ListClosedWorkflowExecutions", listRequest.GetDomain(), scope); err != nil {
+		return nil, err
+	}
+
+	if err := wh.checkRateLimit(listRequest.GetDomain(), apiTypeUser, hostLimitEnforced, scope); err != nil {
+		return nil, err
 	}
 
 	if listRequest.GetDomain() == "" {
@@ -2052,6 +2630,10 @@ func (wh *WorkflowHandler) ResetStickyTaskList(ctx context.Context, resetRequest
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
+	if err := wh.authorize(ctx, "ResetStickyTaskList", resetRequest.GetDomain(), scope); err != nil {
+		return nil, err
+	}
+
 	if resetRequest.GetDomain() == "" {
 		return nil, wh.error(errDomainNotSet, scope)
 	}
@@ -2087,6 +2669,14 @@ func (wh *WorkflowHandler) QueryWorkflow(ctx context.Context,
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
+	if err := wh.authorize(ctx, "QueryWorkflow", queryRequest.GetDomain(), scope); err != nil {
+		return nil, err
+	}
+
+	if err := wh.checkRateLimit(queryRequest.GetDomain(), apiTypeUser, hostLimitNone, scope); err != nil {
+		return nil, err
+	}
+
 	if queryRequest.GetDomain() == "" {
 		return nil, wh.error(errDomainNotSet, scope)
 	}
@@ -2185,8 +2775,12 @@ func (wh *WorkflowHandler) DescribeWorkflowExecution(ctx context.Context, reques
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+	if err := wh.authorize(ctx, "DescribeWorkflowExecution", request.GetDomain(), scope); err != nil {
+		return nil, err
+	}
+
+	if err := wh.checkRateLimit(request.GetDomain(), apiTypeUser, hostLimitEnforced, scope); err != nil {
+		return nil, err
 	}
 
 	if request.GetDomain() == "" {
@@ -2225,8 +2819,12 @@ func (wh *WorkflowHandler) DescribeTaskList(ctx context.Context, request *gen.De
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+	if err := wh.authorize(ctx, "DescribeTaskList", request.GetDomain(), scope); err != nil {
+		return nil, err
+	}
+
+	if err := wh.checkRateLimit(request.GetDomain(), apiTypeUser, hostLimitEnforced, scope); err != nil {
+		return nil, err
 	}
 
 	if request.GetDomain() == "" {
@@ -2325,6 +2923,117 @@ func (wh *WorkflowHandler) getHistory(scope int, domainID string, execution gen.
 	return executionHistory, nextPageToken, nil
 }
 
+// getRawHistory reads a page of history from the events v2 store without deserializing it,
+// the transient decision events are serialized into an extra blob once the last page is reached
+func getRawHistory(historyV2Mgr persistence.HistoryV2Manager, firstEventID, nextEventID int64, pageSize int32,
+	nextPageToken []byte, transientDecision *gen.TransientDecisionInfo, branchToken []byte) ([]*gen.DataBlob, []byte, error) {
+
+	response, err := historyV2Mgr.ReadRawHistoryBranch(&persistence.ReadHistoryBranchRequest{
+		BranchToken:   branchToken,
+		MinEventID:    firstEventID,
+		MaxEventID:    nextEventID,
+		PageSize:      int(pageSize),
+		NextPageToken: nextPageToken,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rawHistory := make([]*gen.DataBlob, 0, len(response.HistoryEventBlobs)+1)
+	for _, blob := range response.HistoryEventBlobs {
+		rawBlob, err := blob.ToThrift()
+		if err != nil {
+			return nil, nil, err
+		}
+		rawHistory = append(rawHistory, rawBlob)
+	}
+
+	if len(response.NextPageToken) == 0 && transientDecision != nil {
+		blob, err := persistence.NewHistorySerializer().SerializeBatchEvents(
+			[]*gen.HistoryEvent{transientDecision.ScheduledEvent, transientDecision.StartedEvent}, common.EncodingTypeThriftRW)
+		if err != nil {
+			return nil, nil, err
+		}
+		rawBlob, err := blob.ToThrift()
+		if err != nil {
+			return nil, nil, err
+		}
+		rawHistory = append(rawHistory, rawBlob)
+	}
+	return rawHistory, response.NextPageToken, nil
+}
+
+// getWorkflowExecutionRawHistory serves a GetWorkflowExecutionRawHistory request for both the workflow and the
+// admin handlers, the request is expected to be validated already
+func getWorkflowExecutionRawHistory(ctx context.Context, historyClient history.Client,
+	historyV2Mgr persistence.HistoryV2Manager, domainID string,
+	request *gen.GetWorkflowExecutionRawHistoryRequest) (*gen.GetWorkflowExecutionRawHistoryResponse, error) {
+
+	execution := request.Execution
+	token := &getHistoryContinuationToken{}
+	if request.NextPageToken != nil {
+		var err error
+		token, err = deserializeHistoryToken(request.NextPageToken)
+		if err != nil {
+			return nil, errInvalidNextPageToken
+		}
+		if execution.RunId != nil && execution.GetRunId() != token.RunID {
+			return nil, errNextPageTokenRunIDMismatch
+		}
+	}
+
+	if len(token.BranchToken) == 0 {
+		// first page, or a page token handed out by PollForDecisionTask
+		queryExecution := &gen.WorkflowExecution{
+			WorkflowId: execution.WorkflowId,
+			RunId:      execution.RunId,
+		}
+		if request.NextPageToken != nil {
+			queryExecution.RunId = common.StringPtr(token.RunID)
+		}
+		response, err := historyClient.GetMutableState(ctx, &h.GetMutableStateRequest{
+			DomainUUID: common.StringPtr(domainID),
+			Execution:  queryExecution,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if response.GetEventStoreVersion() != persistence.EventStoreVersionV2 {
+			return nil, errRawHistoryNotSupported
+		}
+
+		token.BranchToken = response.BranchToken
+		if request.NextPageToken == nil {
+			token.RunID = response.Execution.GetRunId()
+			token.FirstEventID = common.FirstEventID
+			token.NextEventID = response.GetNextEventId()
+		}
+	}
+
+	rawHistory := []*gen.DataBlob{}
+	if token.FirstEventID < token.NextEventID {
+		var err error
+		rawHistory, token.PersistenceToken, err = getRawHistory(historyV2Mgr, token.FirstEventID, token.NextEventID,
+			request.GetMaximumPageSize(), token.PersistenceToken, token.TransientDecision, token.BranchToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(token.PersistenceToken) == 0 {
+		// meaning, there is no more history to be returned
+		token = nil
+	}
+	nextToken, err := serializeHistoryToken(token)
+	if err != nil {
+		return nil, err
+	}
+	return &gen.GetWorkflowExecutionRawHistoryResponse{
+		RawHistory:    rawHistory,
+		NextPageToken: nextToken,
+	}, nil
+}
+
 func (wh *WorkflowHandler) getLoggerForTask(taskToken []byte) bark.Logger {
 	logger := wh.Service.GetLogger()
 	task, err := wh.tokenSerializer.Deserialize(taskToken)
@@ -2415,6 +3124,35 @@ func (wh *WorkflowHandler) validateExecutionAndEmitMetrics(w *gen.WorkflowExecut
 	return nil
 }
 
+// getDomainIDForNewWorkflow returns the ID of the domain a new workflow execution is started in,
+// deleted domains do not accept new workflow executions
+func (wh *WorkflowHandler) getDomainIDForNewWorkflow(domainName string) (string, error) {
+	domainEntry, err := wh.domainCache.GetDomain(domainName)
+	if err != nil {
+		return "", err
+	}
+	if domainEntry.GetInfo().Status == persistence.DomainStatusDeleted {
+		return "", errDomainDeleted
+	}
+	return domainEntry.GetInfo().ID, nil
+}
+
+// checkBlobSizeLimit logs and emits metrics for a binary payload over the warn limit of the domain,
+// and rejects the payload once it is over the error limit
+func (wh *WorkflowHandler) checkBlobSizeLimit(domainName, workflowID, runID string, blob []byte, scope int) error {
+	logger := wh.GetLogger().WithFields(bark.Fields{
+		logging.TagDomainName:          domainName,
+		logging.TagWorkflowExecutionID: workflowID,
+		logging.TagWorkflowRunID:       runID,
+	})
+	checker := limits.NewChecker(domainName, wh.metricsClient, scope, logger)
+	if checker.CheckLimit(metrics.BlobSizeLimitTypeTagValue, len(blob),
+		wh.config.BlobSizeLimitWarn(domainName), wh.config.BlobSizeLimitError(domainName)) {
+		return errBlobSizeExceedsLimit
+	}
+	return nil
+}
+
 func validateExecution(w *gen.WorkflowExecution) error {
 	if w == nil {
 		return errExecutionNotSet
@@ -2476,6 +3214,17 @@ func createDomainResponse(info *persistence.DomainInfo, config *persistence.Doma
 	return infoResult, configResult, replicationConfigResult
 }
 
+func createDomainFailoverInfo(pendingActiveClusterName string, failoverEndTime int64) *gen.DomainFailoverInfo {
+	if pendingActiveClusterName == "" {
+		return nil
+	}
+
+	return &gen.DomainFailoverInfo{
+		PendingActiveClusterName: common.StringPtr(pendingActiveClusterName),
+		FailoverExpireTimestamp:  common.Int64Ptr(failoverEndTime),
+	}
+}
+
 func (wh *WorkflowHandler) createPollForDecisionTaskResponse(ctx context.Context, scope int, domainID string,
 	matchingResp *m.PollForDecisionTaskResponse, useEventsV2 bool, branchToken []byte) (*gen.PollForDecisionTaskResponse, error) {
 
@@ -2485,6 +3234,7 @@ func (wh *WorkflowHandler) createPollForDecisionTaskResponse(ctx context.Context
 	}
 
 	var history *gen.History
+	var rawHistory []*gen.DataBlob
 	var continuation []byte
 	var err error
 
@@ -2513,27 +3263,54 @@ func (wh *WorkflowHandler) createPollForDecisionTaskResponse(ctx context.Context
 		if dErr != nil {
 			return nil, dErr
 		}
-		history, persistenceToken, err = wh.getHistory(
-			scope,
-			domainID,
-			*matchingResp.WorkflowExecution,
-			firstEventID,
-			nextEventID,
-			int32(wh.config.HistoryMaxPageSize(domain.GetInfo().Name)),
-			nil,
-			matchingResp.DecisionInfo, useEventsV2, branchToken)
+		pageSize := int32(wh.config.HistoryMaxPageSize(domain.GetInfo().Name))
+
+		// clients which are able to deserialize the history themselves get the raw history,
+		// saving the frontend from deserializing and then serializing the events again
+		call := yarpc.CallFromContext(ctx)
+		clientFeature := client.NewFeatureImpl(
+			call.Header(common.LibraryVersionHeaderName),
+			call.Header(common.FeatureVersionHeaderName),
+			call.Header(common.ClientImplHeaderName),
+		)
+		sendRawHistory := useEventsV2 && clientFeature.SupportRawHistory()
+
+		if sendRawHistory {
+			rawHistory, persistenceToken, err = getRawHistory(
+				wh.historyV2Mgr,
+				firstEventID,
+				nextEventID,
+				pageSize,
+				nil,
+				matchingResp.DecisionInfo, branchToken)
+		} else {
+			history, persistenceToken, err = wh.getHistory(
+				scope,
+				domainID,
+				*matchingResp.WorkflowExecution,
+				firstEventID,
+				nextEventID,
+				pageSize,
+				nil,
+				matchingResp.DecisionInfo, useEventsV2, branchToken)
+		}
 		if err != nil {
 			return nil, err
 		}
 
 		if len(persistenceToken) != 0 {
-			continuation, err = serializeHistoryToken(&getHistoryContinuationToken{
+			token := &getHistoryContinuationToken{
 				RunID:             matchingResp.WorkflowExecution.GetRunId(),
 				FirstEventID:      firstEventID,
 				NextEventID:       nextEventID,
 				PersistenceToken:  persistenceToken,
 				TransientDecision: matchingResp.DecisionInfo,
-			})
+			}
+			if sendRawHistory {
+				// the rest of the raw history has to be read with GetWorkflowExecutionRawHistory
+				token.BranchToken = branchToken
+			}
+			continuation, err = serializeHistoryToken(token)
 			if err != nil {
 				return nil, err
 			}
@@ -2550,6 +3327,7 @@ func (wh *WorkflowHandler) createPollForDecisionTaskResponse(ctx context.Context
 		BacklogCountHint:          matchingResp.BacklogCountHint,
 		Attempt:                   matchingResp.Attempt,
 		History:                   history,
+		RawHistory:                rawHistory,
 		NextPageToken:             continuation,
 		WorkflowExecutionTaskList: matchingResp.WorkflowExecutionTaskList,
 	}
@@ -21,9 +21,26 @@
 package frontend
 
 import (
+	"context"
 	"testing"
 
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+	h "github.com/uber/cadence/.gen/go/history"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"go.uber.org/yarpc/api/encoding"
+	"go.uber.org/yarpc/api/transport"
 )
 
 func TestMergeDomainData_Overriding(t *testing.T) {
@@ -92,3 +109,128 @@ func TestMergeDomainData_Nil(t *testing.T) {
 		"k1": "v2",
 	}, out)
 }
+
+func TestCheckBlobSizeLimit(t *testing.T) {
+	metricsClient := metrics.NewClient(tally.NoopScope, metrics.Frontend)
+	config := NewConfig(dynamicconfig.NewNopCollection())
+	config.BlobSizeLimitWarn = func(domain string) int { return 10 }
+	config.BlobSizeLimitError = func(domain string) int { return 20 }
+	wh := &WorkflowHandler{
+		config:        config,
+		metricsClient: metricsClient,
+		Service:       service.NewTestService(nil, nil, metricsClient, bark.NewLoggerFromLogrus(logrus.New())),
+	}
+	scope := metrics.FrontendSignalWorkflowExecutionScope
+
+	assert.Nil(t, wh.checkBlobSizeLimit("domain", "wid", "rid", nil, scope))
+	assert.Nil(t, wh.checkBlobSizeLimit("domain", "wid", "rid", make([]byte, 10), scope))
+	assert.Nil(t, wh.checkBlobSizeLimit("domain", "wid", "rid", make([]byte, 20), scope))
+	assert.Equal(t, errBlobSizeExceedsLimit, wh.checkBlobSizeLimit("domain", "wid", "rid", make([]byte, 21), scope))
+}
+
+func TestAuthorize(t *testing.T) {
+	metricsClient := metrics.NewClient(tally.NoopScope, metrics.Frontend)
+	authorizer, err := authorization.NewRBACAuthorizer(&config.RBAC{
+		Principals: map[string]map[string]string{
+			"worker": {"samples": "writer"},
+		},
+	}, "secret")
+	assert.Nil(t, err)
+	wh := &WorkflowHandler{
+		metricsClient: metricsClient,
+		authorizer:    authorizer,
+		Service:       service.NewTestService(nil, nil, metricsClient, bark.NewLoggerFromLogrus(logrus.New())),
+	}
+	scope := metrics.FrontendStartWorkflowExecutionScope
+
+	newInboundContext := func(identity string) context.Context {
+		ctx, call := encoding.NewInboundCall(context.Background())
+		headers := transport.NewHeaders()
+		if identity != "" {
+			headers = headers.With(authorization.CallerIdentityHeaderName, identity)
+		}
+		assert.Nil(t, call.ReadFromRequest(&transport.Request{Headers: headers}))
+		return ctx
+	}
+
+	assert.Nil(t, wh.authorize(newInboundContext("worker"), "StartWorkflowExecution", "samples", scope))
+	assert.Equal(t, errNoPermission, wh.authorize(newInboundContext("worker"), "StartWorkflowExecution", "other", scope))
+	assert.Equal(t, errNoPermission, wh.authorize(newInboundContext("worker"), "UpdateDomain", "samples", scope))
+	assert.Equal(t, errNoPermission, wh.authorize(newInboundContext(""), "StartWorkflowExecution", "samples", scope))
+	// calls made by the frontend itself are not authorized
+	assert.Nil(t, wh.authorize(context.Background(), "UpdateDomain", "samples", scope))
+}
+
+func TestGetWorkflowExecutionRawHistory(t *testing.T) {
+	historyClient := &mocks.HistoryClient{}
+	historyV2Mgr := &mocks.HistoryV2Manager{}
+	execution := &gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("wid"),
+		RunId:      common.StringPtr("rid"),
+	}
+	branchToken := []byte("branch token")
+	historyClient.On("GetMutableState", mock.Anything, mock.Anything).Return(&h.GetMutableStateResponse{
+		Execution:         execution,
+		NextEventId:       common.Int64Ptr(5),
+		BranchToken:       branchToken,
+		EventStoreVersion: common.Int32Ptr(persistence.EventStoreVersionV2),
+	}, nil).Once()
+
+	blob := persistence.NewDataBlob([]byte("events"), common.EncodingTypeThriftRW)
+	readRequest := &persistence.ReadHistoryBranchRequest{
+		BranchToken: branchToken,
+		MinEventID:  common.FirstEventID,
+		MaxEventID:  5,
+		PageSize:    10,
+	}
+	historyV2Mgr.On("ReadRawHistoryBranch", readRequest).Return(&persistence.ReadRawHistoryBranchResponse{
+		HistoryEventBlobs: []*persistence.DataBlob{blob},
+		NextPageToken:     []byte("store token"),
+	}, nil).Once()
+
+	request := &gen.GetWorkflowExecutionRawHistoryRequest{
+		Domain:          common.StringPtr("domain"),
+		Execution:       execution,
+		MaximumPageSize: common.Int32Ptr(10),
+	}
+	resp, err := getWorkflowExecutionRawHistory(context.Background(), historyClient, historyV2Mgr, "domain-id", request)
+	assert.Nil(t, err)
+	assert.Equal(t, []*gen.DataBlob{{EncodingType: gen.EncodingTypeThriftRW.Ptr(), Data: blob.Data}}, resp.RawHistory)
+	assert.NotEmpty(t, resp.NextPageToken)
+
+	// the next page is read with the branch token kept in the page token, without loading the mutable state again
+	nextReadRequest := *readRequest
+	nextReadRequest.NextPageToken = []byte("store token")
+	historyV2Mgr.On("ReadRawHistoryBranch", &nextReadRequest).Return(&persistence.ReadRawHistoryBranchResponse{
+		HistoryEventBlobs: []*persistence.DataBlob{blob},
+	}, nil).Once()
+
+	request.NextPageToken = resp.NextPageToken
+	resp, err = getWorkflowExecutionRawHistory(context.Background(), historyClient, historyV2Mgr, "domain-id", request)
+	assert.Nil(t, err)
+	assert.Len(t, resp.RawHistory, 1)
+	assert.Nil(t, resp.NextPageToken)
+
+	historyClient.AssertExpectations(t)
+	historyV2Mgr.AssertExpectations(t)
+}
+
+func TestGetWorkflowExecutionRawHistory_EventsV1(t *testing.T) {
+	historyClient := &mocks.HistoryClient{}
+	execution := &gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("wid"),
+		RunId:      common.StringPtr("rid"),
+	}
+	historyClient.On("GetMutableState", mock.Anything, mock.Anything).Return(&h.GetMutableStateResponse{
+		Execution:   execution,
+		NextEventId: common.Int64Ptr(5),
+	}, nil).Once()
+
+	request := &gen.GetWorkflowExecutionRawHistoryRequest{
+		Domain:          common.StringPtr("domain"),
+		Execution:       execution,
+		MaximumPageSize: common.Int32Ptr(10),
+	}
+	_, err := getWorkflowExecutionRawHistory(context.Background(), historyClient, &mocks.HistoryV2Manager{}, "domain-id", request)
+	assert.Equal(t, errRawHistoryNotSupported, err)
+}
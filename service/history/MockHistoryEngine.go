@@ -25,6 +25,7 @@ import (
 
 	"github.com/stretchr/testify/mock"
 	gohistory "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 )
 
@@ -89,6 +90,75 @@ func (_m *MockHistoryEngine) DescribeMutableState(ctx context.Context, request *
 	return r0, r1
 }
 
+// MigrateWorkflowHistory is mock implementation for MigrateWorkflowHistory of HistoryEngine
+func (_m *MockHistoryEngine) MigrateWorkflowHistory(ctx context.Context, request *gohistory.MigrateWorkflowHistoryRequest) (*gohistory.MigrateWorkflowHistoryResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *gohistory.MigrateWorkflowHistoryResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.MigrateWorkflowHistoryRequest) *gohistory.MigrateWorkflowHistoryResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gohistory.MigrateWorkflowHistoryResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.MigrateWorkflowHistoryRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWorkflowReplicationTasks is mock implementation for GetWorkflowReplicationTasks of HistoryEngine
+func (_m *MockHistoryEngine) GetWorkflowReplicationTasks(ctx context.Context, request *gohistory.GetWorkflowReplicationTasksRequest) (*gohistory.GetWorkflowReplicationTasksResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *gohistory.GetWorkflowReplicationTasksResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.GetWorkflowReplicationTasksRequest) *gohistory.GetWorkflowReplicationTasksResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gohistory.GetWorkflowReplicationTasksResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.GetWorkflowReplicationTasksRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteWorkflowExecution is mock implementation for DeleteWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) DeleteWorkflowExecution(ctx context.Context, request *gohistory.DeleteWorkflowExecutionRequest) (*gohistory.DeleteWorkflowExecutionResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *gohistory.DeleteWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.DeleteWorkflowExecutionRequest) *gohistory.DeleteWorkflowExecutionResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gohistory.DeleteWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.DeleteWorkflowExecutionRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMutableState is mock implementation for GetMutableState of HistoryEngine
 func (_m *MockHistoryEngine) GetMutableState(ctx context.Context, request *gohistory.GetMutableStateRequest) (*gohistory.GetMutableStateResponse, error) {
 	ret := _m.Called(ctx, request)
@@ -386,6 +456,76 @@ func (_m *MockHistoryEngine) TerminateWorkflowExecution(ctx context.Context, req
 	return r0
 }
 
+// PauseWorkflowExecution is mock implementation for PauseWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) PauseWorkflowExecution(ctx context.Context, request *gohistory.PauseWorkflowExecutionRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.PauseWorkflowExecutionRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResumeWorkflowExecution is mock implementation for ResumeWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) ResumeWorkflowExecution(ctx context.Context, request *gohistory.ResumeWorkflowExecutionRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.ResumeWorkflowExecutionRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RetryActivityTask is mock implementation for RetryActivityTask of HistoryEngine
+func (_m *MockHistoryEngine) RetryActivityTask(ctx context.Context, request *gohistory.RetryActivityTaskRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.RetryActivityTaskRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CompleteActivityTask is mock implementation for CompleteActivityTask of HistoryEngine
+func (_m *MockHistoryEngine) CompleteActivityTask(ctx context.Context, request *gohistory.CompleteActivityTaskRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.CompleteActivityTaskRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FailActivityTask is mock implementation for FailActivityTask of HistoryEngine
+func (_m *MockHistoryEngine) FailActivityTask(ctx context.Context, request *gohistory.FailActivityTaskRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.FailActivityTaskRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ScheduleDecisionTask is mock implementation for ScheduleDecisionTask of HistoryEngine
 func (_m *MockHistoryEngine) ScheduleDecisionTask(ctx context.Context, request *gohistory.ScheduleDecisionTaskRequest) error {
 	ret := _m.Called(request)
@@ -456,4 +596,176 @@ func (_m *MockHistoryEngine) SyncActivity(ctx context.Context, request *gohistor
 	return r0
 }
 
+// GetReplicationMessages is mock implementation for GetReplicationMessages of HistoryEngine
+func (_m *MockHistoryEngine) GetReplicationMessages(ctx context.Context, pollingCluster string, lastReadMessageID int64,
+	lastProcessedMessageID int64) (*replicator.ReplicationMessages, error) {
+	ret := _m.Called(pollingCluster, lastReadMessageID, lastProcessedMessageID)
+
+	var r0 *replicator.ReplicationMessages
+	if rf, ok := ret.Get(0).(func(string, int64, int64) *replicator.ReplicationMessages); ok {
+		r0 = rf(pollingCluster, lastReadMessageID, lastProcessedMessageID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*replicator.ReplicationMessages)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int64, int64) error); ok {
+		r1 = rf(pollingCluster, lastReadMessageID, lastProcessedMessageID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReplicationStatus is mock implementation for GetReplicationStatus of HistoryEngine
+func (_m *MockHistoryEngine) GetReplicationStatus(ctx context.Context, domainID string) (*gohistory.ShardReplicationStatus, error) {
+	ret := _m.Called(domainID)
+
+	var r0 *gohistory.ShardReplicationStatus
+	if rf, ok := ret.Get(0).(func(string) *gohistory.ShardReplicationStatus); ok {
+		r0 = rf(domainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gohistory.ShardReplicationStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(domainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeShard is mock implementation for DescribeShard of HistoryEngine
+func (_m *MockHistoryEngine) DescribeShard(ctx context.Context) (*gohistory.DescribeShardResponse, error) {
+	ret := _m.Called()
+
+	var r0 *gohistory.DescribeShardResponse
+	if rf, ok := ret.Get(0).(func() *gohistory.DescribeShardResponse); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gohistory.DescribeShardResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListShardTasks is mock implementation for ListShardTasks of HistoryEngine
+func (_m *MockHistoryEngine) ListShardTasks(ctx context.Context, request *gohistory.ListShardTasksRequest) (*gohistory.ListShardTasksResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *gohistory.ListShardTasksResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.ListShardTasksRequest) *gohistory.ListShardTasksResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gohistory.ListShardTasksResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.ListShardTasksRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveShardTask is mock implementation for RemoveShardTask of HistoryEngine
+func (_m *MockHistoryEngine) RemoveShardTask(ctx context.Context, request *gohistory.RemoveShardTaskRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.RemoveShardTaskRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RescheduleShardTask is mock implementation for RescheduleShardTask of HistoryEngine
+func (_m *MockHistoryEngine) RescheduleShardTask(ctx context.Context, request *gohistory.RescheduleShardTaskRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.RescheduleShardTaskRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListQuarantinedTasks is mock implementation for ListQuarantinedTasks of HistoryEngine
+func (_m *MockHistoryEngine) ListQuarantinedTasks(ctx context.Context, request *gohistory.ListQuarantinedTasksRequest) (*gohistory.ListQuarantinedTasksResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *gohistory.ListQuarantinedTasksResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.ListQuarantinedTasksRequest) *gohistory.ListQuarantinedTasksResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gohistory.ListQuarantinedTasksResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.ListQuarantinedTasksRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RetryQuarantinedTask is mock implementation for RetryQuarantinedTask of HistoryEngine
+func (_m *MockHistoryEngine) RetryQuarantinedTask(ctx context.Context, request *gohistory.RetryQuarantinedTaskRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.RetryQuarantinedTaskRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DropQuarantinedTask is mock implementation for DropQuarantinedTask of HistoryEngine
+func (_m *MockHistoryEngine) DropQuarantinedTask(ctx context.Context, request *gohistory.DropQuarantinedTaskRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.DropQuarantinedTaskRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 var _ Engine = (*MockHistoryEngine)(nil)
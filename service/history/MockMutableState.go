@@ -117,6 +117,22 @@ func (_m *mockMutableState) AddActivityTaskFailedEvent(_a0 int64, _a1 int64, _a2
 	return r0
 }
 
+// AddActivityTaskRetryRequestedEvent provides a mock function with given fields: _a0, _a1
+func (_m *mockMutableState) AddActivityTaskRetryRequestedEvent(_a0 int64, _a1 *shared.RetryActivityTaskRequest) *shared.HistoryEvent {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *shared.HistoryEvent
+	if rf, ok := ret.Get(0).(func(int64, *shared.RetryActivityTaskRequest) *shared.HistoryEvent); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.HistoryEvent)
+		}
+	}
+
+	return r0
+}
+
 // AddActivityTaskScheduledEvent provides a mock function with given fields: _a0, _a1
 func (_m *mockMutableState) AddActivityTaskScheduledEvent(_a0 int64, _a1 *shared.ScheduleActivityTaskDecisionAttributes) (*shared.HistoryEvent, *persistence.ActivityInfo) {
 	ret := _m.Called(_a0, _a1)
@@ -752,6 +768,38 @@ func (_m *mockMutableState) AddWorkflowExecutionCanceledEvent(_a0 int64, _a1 *sh
 	return r0
 }
 
+// AddWorkflowExecutionPausedEvent provides a mock function with given fields: _a0
+func (_m *mockMutableState) AddWorkflowExecutionPausedEvent(_a0 *shared.PauseWorkflowExecutionRequest) *shared.HistoryEvent {
+	ret := _m.Called(_a0)
+
+	var r0 *shared.HistoryEvent
+	if rf, ok := ret.Get(0).(func(*shared.PauseWorkflowExecutionRequest) *shared.HistoryEvent); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.HistoryEvent)
+		}
+	}
+
+	return r0
+}
+
+// AddWorkflowExecutionResumedEvent provides a mock function with given fields: _a0
+func (_m *mockMutableState) AddWorkflowExecutionResumedEvent(_a0 *shared.ResumeWorkflowExecutionRequest) *shared.HistoryEvent {
+	ret := _m.Called(_a0)
+
+	var r0 *shared.HistoryEvent
+	if rf, ok := ret.Get(0).(func(*shared.ResumeWorkflowExecutionRequest) *shared.HistoryEvent); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.HistoryEvent)
+		}
+	}
+
+	return r0
+}
+
 // AddWorkflowExecutionSignaled provides a mock function with given fields: _a0
 func (_m *mockMutableState) AddWorkflowExecutionSignaled(_a0 *shared.SignalWorkflowExecutionRequest) *shared.HistoryEvent {
 	ret := _m.Called(_a0)
@@ -1039,6 +1087,20 @@ func (_m *mockMutableState) GetHistorySize() int64 {
 	return r0
 }
 
+// GetMutableStateSize provides a mock function with given fields:
+func (_m *mockMutableState) GetMutableStateSize() int64 {
+	ret := _m.Called()
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	return r0
+}
+
 // GetActivityByActivityID provides a mock function with given fields: _a0
 func (_m *mockMutableState) GetActivityByActivityID(_a0 string) (*persistence.ActivityInfo, bool) {
 	ret := _m.Called(_a0)
@@ -1471,6 +1533,22 @@ func (_m *mockMutableState) GetPendingDecision(_a0 int64) (*decisionInfo, bool)
 	return r0, r1
 }
 
+// GetPendingSignalInfos provides a mock function with given fields:
+func (_m *mockMutableState) GetPendingSignalInfos() map[int64]*persistence.SignalInfo {
+	ret := _m.Called()
+
+	var r0 map[int64]*persistence.SignalInfo
+	if rf, ok := ret.Get(0).(func() map[int64]*persistence.SignalInfo); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64]*persistence.SignalInfo)
+		}
+	}
+
+	return r0
+}
+
 // GetPendingTimerInfos provides a mock function with given fields:
 func (_m *mockMutableState) GetPendingTimerInfos() map[string]*persistence.TimerInfo {
 	ret := _m.Called()
@@ -1709,6 +1787,20 @@ func (_m *mockMutableState) HasPendingDecisionTask() bool {
 	return r0
 }
 
+// HasPendingDecisionBackoff provides a mock function with given fields:
+func (_m *mockMutableState) HasPendingDecisionBackoff() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // IncrementHistorySize provides a mock function with given fields: appendSize
 func (_m *mockMutableState) IncrementHistorySize(appendSize int) {
 	_m.Called(appendSize)
@@ -1749,6 +1841,20 @@ func (_m *mockMutableState) IsSignalRequested(requestID string) bool {
 	return r0
 }
 
+// IsPaused provides a mock function with given fields:
+func (_m *mockMutableState) IsPaused() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // IsStickyTaskListEnabled provides a mock function with given fields:
 func (_m *mockMutableState) IsStickyTaskListEnabled() bool {
 	ret := _m.Called()
@@ -1843,6 +1949,11 @@ func (_m *mockMutableState) ReplicateActivityTaskFailedEvent(_a0 *shared.History
 	return r0
 }
 
+// ReplicateActivityTaskRetryRequestedEvent provides a mock function with given fields: _a0
+func (_m *mockMutableState) ReplicateActivityTaskRetryRequestedEvent(_a0 *shared.HistoryEvent) {
+	_m.Called(_a0)
+}
+
 // ReplicateActivityTaskScheduledEvent provides a mock function with given fields: _a0
 func (_m *mockMutableState) ReplicateActivityTaskScheduledEvent(_a0 *shared.HistoryEvent) *persistence.ActivityInfo {
 	ret := _m.Called(_a0)
@@ -2104,6 +2215,16 @@ func (_m *mockMutableState) ReplicateWorkflowExecutionFailedEvent(_a0 *shared.Hi
 	_m.Called(_a0)
 }
 
+// ReplicateWorkflowExecutionPausedEvent provides a mock function with given fields: _a0
+func (_m *mockMutableState) ReplicateWorkflowExecutionPausedEvent(_a0 *shared.HistoryEvent) {
+	_m.Called(_a0)
+}
+
+// ReplicateWorkflowExecutionResumedEvent provides a mock function with given fields: _a0
+func (_m *mockMutableState) ReplicateWorkflowExecutionResumedEvent(_a0 *shared.HistoryEvent) {
+	_m.Called(_a0)
+}
+
 // ReplicateWorkflowExecutionStartedEvent provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
 func (_m *mockMutableState) ReplicateWorkflowExecutionStartedEvent(_a0 string, _a1 *string, _a2 shared.WorkflowExecution, _a3 string, _a4 *shared.WorkflowExecutionStartedEventAttributes) {
 	_m.Called(_a0, _a1, _a2, _a3, _a4)
@@ -2140,6 +2261,11 @@ func (_m *mockMutableState) SetHistoryBuilder(hBuilder *historyBuilder) {
 	_m.Called(hBuilder)
 }
 
+// SetNewRun provides a mock function with given fields: _a0
+func (_m *mockMutableState) SetNewRun(_a0 *persistence.CreateWorkflowExecutionRequest) {
+	_m.Called(_a0)
+}
+
 // SetNewRunSize provides a mock function with given fields: size
 func (_m *mockMutableState) SetNewRunSize(size int) {
 	_m.Called(size)
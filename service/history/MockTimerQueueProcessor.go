@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/mock"
+	h "github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/common/persistence"
 )
 
@@ -51,3 +52,36 @@ func (_m *MockTimerQueueProcessor) FailoverDomain(domainID string) {
 func (_m *MockTimerQueueProcessor) NotifyNewTimers(clusterName string, currentTime time.Time, timerTask []persistence.Task) {
 	_m.Called(clusterName, currentTime, timerTask)
 }
+
+// GetQueueStates is mock implementation for GetQueueStates of Processor
+func (_m *MockTimerQueueProcessor) GetQueueStates() []*h.ShardQueueState {
+	ret := _m.Called()
+
+	var r0 []*h.ShardQueueState
+	if rf, ok := ret.Get(0).(func() []*h.ShardQueueState); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*h.ShardQueueState)
+		}
+	}
+	return r0
+}
+
+// CompleteTimer is mock implementation for CompleteTimer of Processor
+func (_m *MockTimerQueueProcessor) CompleteTimer(timerTask *persistence.TimerTaskInfo) {
+	_m.Called(timerTask)
+}
+
+// RescheduleTimer is mock implementation for RescheduleTimer of Processor
+func (_m *MockTimerQueueProcessor) RescheduleTimer(timerTask *persistence.TimerTaskInfo) error {
+	ret := _m.Called(timerTask)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.TimerTaskInfo) error); ok {
+		r0 = rf(timerTask)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
@@ -22,6 +22,7 @@ package history
 
 import (
 	"github.com/stretchr/testify/mock"
+	h "github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/common/persistence"
 )
 
@@ -49,3 +50,36 @@ func (_m *MockTransferQueueProcessor) FailoverDomain(domainID string) {
 func (_m *MockTransferQueueProcessor) NotifyNewTask(clusterName string, transferTask []persistence.Task) {
 	_m.Called(clusterName, transferTask)
 }
+
+// GetQueueStates is mock implementation for GetQueueStates of Processor
+func (_m *MockTransferQueueProcessor) GetQueueStates() []*h.ShardQueueState {
+	ret := _m.Called()
+
+	var r0 []*h.ShardQueueState
+	if rf, ok := ret.Get(0).(func() []*h.ShardQueueState); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*h.ShardQueueState)
+		}
+	}
+	return r0
+}
+
+// CompleteTask is mock implementation for CompleteTask of Processor
+func (_m *MockTransferQueueProcessor) CompleteTask(taskID int64) {
+	_m.Called(taskID)
+}
+
+// RescheduleTask is mock implementation for RescheduleTask of Processor
+func (_m *MockTransferQueueProcessor) RescheduleTask(task *persistence.TransferTaskInfo) error {
+	ret := _m.Called(task)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.TransferTaskInfo) error); ok {
+		r0 = rf(task)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
@@ -298,7 +298,7 @@ func (s *conflictResolverSuite) TestReset() {
 		},
 		nil,
 	)
-	s.mockDomainCache.On("GetDomainByID", mock.Anything).Return(cache.NewDomainCacheEntryWithInfo(&persistence.DomainInfo{}), nil)
+	s.mockDomainCache.On("GetDomainByID", mock.Anything).Return(cache.NewDomainCacheEntryWithInfoAndConfig(&persistence.DomainInfo{}, &persistence.DomainConfig{Retention: 1}), nil)
 
 	_, err := s.conflictResolver.reset(prevRunID, createRequestID, nextEventID-1, executionInfo)
 	s.Nil(err)
@@ -32,6 +32,7 @@ import (
 	"github.com/uber/cadence/.gen/go/health/metaserver"
 	hist "github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/history/historyserviceserver"
+	r "github.com/uber/cadence/.gen/go/replicator"
 	gen "github.com/uber/cadence/.gen/go/shared"
 	hc "github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
@@ -42,6 +43,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/config"
 	"go.uber.org/yarpc/yarpcerrors"
 )
 
@@ -54,6 +56,7 @@ type (
 		visibilityMgr         persistence.VisibilityManager
 		historyMgr            persistence.HistoryManager
 		historyV2Mgr          persistence.HistoryV2Manager
+		quarantineMgr         persistence.QuarantineManager
 		executionMgrFactory   persistence.ExecutionManagerFactory
 		domainCache           cache.DomainCache
 		historyServiceClient  hc.Client
@@ -83,6 +86,9 @@ var (
 	errSourceClusterNotSet     = &gen.BadRequestError{Message: "Source Cluster not set on request."}
 	errShardIDNotSet           = &gen.BadRequestError{Message: "Shard ID not set on request."}
 	errTimestampNotSet         = &gen.BadRequestError{Message: "Timestamp not set on request."}
+	errClusterNameNotSet       = &gen.BadRequestError{Message: "Cluster name not set on request."}
+	errQueueTypeNotSet         = &gen.BadRequestError{Message: "Queue type not set on request."}
+	errTaskIDNotSet            = &gen.BadRequestError{Message: "Task ID not set on request."}
 	errHistoryHostThrottle     = &gen.ServiceBusyError{Message: "History host rps exceeded"}
 )
 
@@ -90,7 +96,7 @@ var (
 func NewHandler(sVice service.Service, config *Config, shardManager persistence.ShardManager,
 	metadataMgr persistence.MetadataManager, visibilityMgr persistence.VisibilityManager,
 	historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager,
-	executionMgrFactory persistence.ExecutionManagerFactory) *Handler {
+	quarantineMgr persistence.QuarantineManager, executionMgrFactory persistence.ExecutionManagerFactory) *Handler {
 	handler := &Handler{
 		Service:             sVice,
 		config:              config,
@@ -98,6 +104,7 @@ func NewHandler(sVice service.Service, config *Config, shardManager persistence.
 		metadataMgr:         metadataMgr,
 		historyMgr:          historyMgr,
 		historyV2Mgr:        historyV2Mgr,
+		quarantineMgr:       quarantineMgr,
 		visibilityMgr:       visibilityMgr,
 		executionMgrFactory: executionMgrFactory,
 		tokenSerializer:     common.NewJSONTaskTokenSerializer(),
@@ -135,7 +142,10 @@ func (h *Handler) Start() error {
 	h.hServiceResolver = hServiceResolver
 
 	// TODO when global domain is enabled, uncomment the line below and remove the line after
-	if h.GetClusterMetadata().IsGlobalDomainEnabled() {
+	// replication tasks are pulled by the remote clusters when the rpc replication consumer is configured
+	currentClusterName := h.GetClusterMetadata().GetCurrentClusterName()
+	if h.GetClusterMetadata().IsGlobalDomainEnabled() &&
+		h.GetClusterMetadata().GetReplicationConsumerConfig(currentClusterName).Type != config.ReplicationConsumerTypeRPC {
 		var err error
 		h.publisher, err = h.GetMessagingClient().NewProducer(h.GetClusterMetadata().GetCurrentClusterName())
 		if err != nil {
@@ -146,7 +156,7 @@ func (h *Handler) Start() error {
 	h.domainCache = cache.NewDomainCache(h.metadataMgr, h.GetClusterMetadata(), h.GetMetricsClient(), h.GetLogger())
 	h.domainCache.Start()
 	h.controller = newShardController(h.Service, h.GetHostInfo(), hServiceResolver, h.shardManager, h.historyMgr, h.historyV2Mgr,
-		h.domainCache, h.executionMgrFactory, h, h.config, h.GetLogger(), h.GetMetricsClient())
+		h.quarantineMgr, h.domainCache, h.executionMgrFactory, h, h.config, h.GetLogger(), h.GetMetricsClient())
 	h.metricsClient = h.GetMetricsClient()
 	h.historyEventNotifier = newHistoryEventNotifier(h.GetMetricsClient(), h.config.GetShardID)
 	// events notifier must starts before controller
@@ -163,6 +173,9 @@ func (h *Handler) Stop() {
 	h.shardManager.Close()
 	h.historyMgr.Close()
 	h.historyV2Mgr.Close()
+	if h.quarantineMgr != nil {
+		h.quarantineMgr.Close()
+	}
 	h.executionMgrFactory.Close()
 	h.metadataMgr.Close()
 	h.visibilityMgr.Close()
@@ -613,6 +626,7 @@ func (h *Handler) DescribeHistoryHost(ctx context.Context,
 		},
 		ShardControllerStatus: &status,
 		Address:               common.StringPtr(h.GetHostInfo().GetAddress()),
+		Draining:              common.BoolPtr(h.controller.isDraining()),
 	}
 	return resp, nil
 }
@@ -646,6 +660,115 @@ func (h *Handler) DescribeMutableState(ctx context.Context,
 	return resp, nil
 }
 
+// MigrateWorkflowHistory - copies the workflow execution history from the deprecated history tables into a history tree
+func (h *Handler) MigrateWorkflowHistory(ctx context.Context,
+	migrateRequest *hist.MigrateWorkflowHistoryRequest) (*hist.MigrateWorkflowHistoryResponse, error) {
+	h.startWG.Wait()
+
+	scope := metrics.HistoryMigrateWorkflowHistoryScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := migrateRequest.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := migrateRequest.Execution
+	workflowID := workflowExecution.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, domainID, workflowID)
+	}
+
+	resp, err2 := engine.MigrateWorkflowHistory(ctx, migrateRequest)
+	if err2 != nil {
+		return nil, h.error(err2, scope, domainID, workflowID)
+	}
+	return resp, nil
+}
+
+// GetWorkflowReplicationTasks - returns the replication tasks carrying the requested event range of a workflow execution
+func (h *Handler) GetWorkflowReplicationTasks(ctx context.Context,
+	request *hist.GetWorkflowReplicationTasksRequest) (*hist.GetWorkflowReplicationTasksResponse, error) {
+	h.startWG.Wait()
+
+	scope := metrics.HistoryGetWorkflowReplicationTasksScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	if request.Execution == nil {
+		return nil, h.error(errWorkflowExecutionNotSet, scope, domainID, "")
+	}
+
+	workflowExecution := request.Execution
+	workflowID := workflowExecution.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, domainID, workflowID)
+	}
+
+	resp, err2 := engine.GetWorkflowReplicationTasks(ctx, request)
+	if err2 != nil {
+		return nil, h.error(err2, scope, domainID, workflowID)
+	}
+	return resp, nil
+}
+
+// DeleteWorkflowExecution - deletes a closed workflow execution, with its history
+func (h *Handler) DeleteWorkflowExecution(ctx context.Context,
+	deleteRequest *hist.DeleteWorkflowExecutionRequest) (*hist.DeleteWorkflowExecutionResponse, error) {
+	h.startWG.Wait()
+
+	scope := metrics.HistoryDeleteWorkflowExecutionScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := deleteRequest.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := deleteRequest.Execution
+	if workflowExecution == nil {
+		return nil, h.error(errWorkflowExecutionNotSet, scope, domainID, "")
+	}
+	workflowID := workflowExecution.GetWorkflowId()
+	if uuid.Parse(workflowExecution.GetRunId()) == nil {
+		return nil, h.error(errRunIDNotValid, scope, domainID, workflowID)
+	}
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, domainID, workflowID)
+	}
+
+	resp, err2 := engine.DeleteWorkflowExecution(ctx, deleteRequest)
+	if err2 != nil {
+		return nil, h.error(err2, scope, domainID, workflowID)
+	}
+	return resp, nil
+}
+
 // GetMutableState - returns the id of the next event in the execution's history
 func (h *Handler) GetMutableState(ctx context.Context,
 	getRequest *hist.GetMutableStateRequest) (*hist.GetMutableStateResponse, error) {
@@ -894,6 +1017,179 @@ func (h *Handler) TerminateWorkflowExecution(ctx context.Context,
 	return nil
 }
 
+// PauseWorkflowExecution records a WorkflowExecutionPaused event in the history and holds
+// decision and activity tasks of the execution until it is resumed.
+func (h *Handler) PauseWorkflowExecution(ctx context.Context,
+	wrappedRequest *hist.PauseWorkflowExecutionRequest) error {
+	h.startWG.Wait()
+
+	scope := metrics.HistoryPauseWorkflowExecutionScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.PauseRequest.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.PauseWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
+// ResumeWorkflowExecution records a WorkflowExecutionResumed event in the history and
+// dispatches the decision and activity tasks which were held while the execution was paused.
+func (h *Handler) ResumeWorkflowExecution(ctx context.Context,
+	wrappedRequest *hist.ResumeWorkflowExecutionRequest) error {
+	h.startWG.Wait()
+
+	scope := metrics.HistoryResumeWorkflowExecutionScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.ResumeRequest.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.ResumeWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
+// RetryActivityTask records an ActivityTaskRetryRequested event in the history and dispatches the
+// pending activity immediately instead of waiting for its retry backoff.
+func (h *Handler) RetryActivityTask(ctx context.Context,
+	wrappedRequest *hist.RetryActivityTaskRequest) error {
+	h.startWG.Wait()
+
+	scope := metrics.HistoryRetryActivityTaskScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.RetryRequest.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.RetryActivityTask(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
+// CompleteActivityTask completes a pending activity on behalf of an operator.
+func (h *Handler) CompleteActivityTask(ctx context.Context,
+	wrappedRequest *hist.CompleteActivityTaskRequest) error {
+	h.startWG.Wait()
+
+	scope := metrics.HistoryCompleteActivityTaskScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.CompleteRequest.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.CompleteActivityTask(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
+// FailActivityTask fails a pending activity on behalf of an operator, the activity is not retried.
+func (h *Handler) FailActivityTask(ctx context.Context,
+	wrappedRequest *hist.FailActivityTaskRequest) error {
+	h.startWG.Wait()
+
+	scope := metrics.HistoryFailActivityTaskScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.FailRequest.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.FailActivityTask(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
 // ScheduleDecisionTask is used for creating a decision task for already started workflow execution.  This is mainly
 // used by transfer queue processor during the processing of StartChildWorkflowExecution task, where it first starts
 // child execution without creating the decision task and then calls this API after updating the mutable state of
@@ -1122,6 +1418,346 @@ func (h *Handler) SyncActivity(ctx context.Context, syncActivityRequest *hist.Sy
 	return nil
 }
 
+// GetReplicationMessages is called by remote clusters to pull the replication tasks of the given shards
+func (h *Handler) GetReplicationMessages(ctx context.Context, request *r.GetReplicationMessagesRequest) (*r.GetReplicationMessagesResponse, error) {
+	h.startWG.Wait()
+
+	scope := metrics.HistoryGetReplicationMessagesScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, "", "")
+	}
+
+	if request.ClusterName == nil {
+		return nil, h.error(errClusterNameNotSet, scope, "", "")
+	}
+
+	messagesByShard := make(map[int32]*r.ReplicationMessages)
+	for _, token := range request.Tokens {
+		engine, err := h.controller.getEngineForShard(int(token.GetShardID()))
+		if err != nil {
+			// the shard is not owned by this host anymore, the remote cluster will retry on the new owner
+			h.GetLogger().WithFields(bark.Fields{
+				logging.TagErr:            err,
+				logging.TagHistoryShardID: token.GetShardID(),
+			}).Warn("History engine not found for shard.")
+			continue
+		}
+
+		messages, err := engine.GetReplicationMessages(ctx, request.GetClusterName(),
+			token.GetLastRetrievedMessageId(), token.GetLastProcessedMessageId())
+		if err != nil {
+			return nil, h.error(err, scope, "", "")
+		}
+		messagesByShard[token.GetShardID()] = messages
+	}
+
+	return &r.GetReplicationMessagesResponse{MessagesByShard: messagesByShard}, nil
+}
+
+// GetReplicationStatus returns the replication status of the given shards owned by this host
+func (h *Handler) GetReplicationStatus(ctx context.Context, request *hist.GetReplicationStatusRequest) (*hist.GetReplicationStatusResponse, error) {
+	h.startWG.Wait()
+
+	scope := metrics.HistoryGetReplicationStatusScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, "", "")
+	}
+
+	response := &hist.GetReplicationStatusResponse{}
+	for _, shardID := range request.ShardIDs {
+		engine, err := h.controller.getEngineForShard(int(shardID))
+		if err != nil {
+			// the shard is not owned by this host anymore, it is left out of the response
+			h.GetLogger().WithFields(bark.Fields{
+				logging.TagErr:            err,
+				logging.TagHistoryShardID: shardID,
+			}).Warn("History engine not found for shard.")
+			continue
+		}
+
+		status, err := engine.GetReplicationStatus(ctx, request.GetDomainUUID())
+		if err != nil {
+			return nil, h.error(err, scope, request.GetDomainUUID(), "")
+		}
+		response.Shards = append(response.Shards, status)
+	}
+
+	return response, nil
+}
+
+// DescribeShard returns the states of the task queues of a shard owned by this host
+func (h *Handler) DescribeShard(ctx context.Context, request *hist.DescribeShardRequest) (*hist.DescribeShardResponse, error) {
+	h.startWG.Wait()
+
+	scope := metrics.HistoryDescribeShardScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, "", "")
+	}
+
+	if request.ShardID == nil {
+		return nil, h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardID()))
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	resp, err := engine.DescribeShard(ctx)
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+	resp.Address = common.StringPtr(h.GetHostInfo().GetAddress())
+	return resp, nil
+}
+
+// ListShardTasks returns the pending tasks of a queue of a shard owned by this host
+func (h *Handler) ListShardTasks(ctx context.Context, request *hist.ListShardTasksRequest) (*hist.ListShardTasksResponse, error) {
+	h.startWG.Wait()
+
+	scope := metrics.HistoryListShardTasksScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, "", "")
+	}
+
+	if request.ShardID == nil {
+		return nil, h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	if request.QueueType == nil {
+		return nil, h.error(errQueueTypeNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardID()))
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	resp, err := engine.ListShardTasks(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+	return resp, nil
+}
+
+// RemoveShardTask force completes a transfer or timer task of a shard owned by this host
+func (h *Handler) RemoveShardTask(ctx context.Context, request *hist.RemoveShardTaskRequest) error {
+	h.startWG.Wait()
+
+	scope := metrics.HistoryRemoveShardTaskScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return h.error(errHistoryHostThrottle, scope, "", "")
+	}
+
+	if request.ShardID == nil {
+		return h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	if request.QueueType == nil {
+		return h.error(errQueueTypeNotSet, scope, "", "")
+	}
+
+	if request.TaskID == nil {
+		return h.error(errTaskIDNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardID()))
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+
+	if err := engine.RemoveShardTask(ctx, request); err != nil {
+		return h.error(err, scope, "", "")
+	}
+	return nil
+}
+
+// RescheduleShardTask processes a transfer or timer task of a shard owned by this host immediately
+func (h *Handler) RescheduleShardTask(ctx context.Context, request *hist.RescheduleShardTaskRequest) error {
+	h.startWG.Wait()
+
+	scope := metrics.HistoryRescheduleShardTaskScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return h.error(errHistoryHostThrottle, scope, "", "")
+	}
+
+	if request.ShardID == nil {
+		return h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	if request.QueueType == nil {
+		return h.error(errQueueTypeNotSet, scope, "", "")
+	}
+
+	if request.TaskID == nil {
+		return h.error(errTaskIDNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardID()))
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+
+	if err := engine.RescheduleShardTask(ctx, request); err != nil {
+		return h.error(err, scope, "", "")
+	}
+	return nil
+}
+
+// ListQuarantinedTasks returns the quarantined tasks of a queue of a shard owned by this host
+func (h *Handler) ListQuarantinedTasks(ctx context.Context,
+	request *hist.ListQuarantinedTasksRequest) (*hist.ListQuarantinedTasksResponse, error) {
+	h.startWG.Wait()
+
+	scope := metrics.HistoryListQuarantinedTasksScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, "", "")
+	}
+
+	if request.ShardID == nil {
+		return nil, h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	if request.QueueType == nil {
+		return nil, h.error(errQueueTypeNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardID()))
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	resp, err := engine.ListQuarantinedTasks(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+	return resp, nil
+}
+
+// RetryQuarantinedTask processes a quarantined task of a shard owned by this host, and removes it from the quarantine
+func (h *Handler) RetryQuarantinedTask(ctx context.Context, request *hist.RetryQuarantinedTaskRequest) error {
+	h.startWG.Wait()
+
+	scope := metrics.HistoryRetryQuarantinedTaskScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return h.error(errHistoryHostThrottle, scope, "", "")
+	}
+
+	if request.ShardID == nil {
+		return h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	if request.QueueType == nil {
+		return h.error(errQueueTypeNotSet, scope, "", "")
+	}
+
+	if request.TaskID == nil {
+		return h.error(errTaskIDNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardID()))
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+
+	if err := engine.RetryQuarantinedTask(ctx, request); err != nil {
+		return h.error(err, scope, "", "")
+	}
+	return nil
+}
+
+// DropQuarantinedTask removes a quarantined task of a shard owned by this host without processing it
+func (h *Handler) DropQuarantinedTask(ctx context.Context, request *hist.DropQuarantinedTaskRequest) error {
+	h.startWG.Wait()
+
+	scope := metrics.HistoryDropQuarantinedTaskScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return h.error(errHistoryHostThrottle, scope, "", "")
+	}
+
+	if request.ShardID == nil {
+		return h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	if request.QueueType == nil {
+		return h.error(errQueueTypeNotSet, scope, "", "")
+	}
+
+	if request.TaskID == nil {
+		return h.error(errTaskIDNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardID()))
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+
+	if err := engine.DropQuarantinedTask(ctx, request); err != nil {
+		return h.error(err, scope, "", "")
+	}
+	return nil
+}
+
+// CloseShard closes a shard owned by this host, so that it is reacquired by its owner as determined
+// by the membership ring, the drained hosts and the shard ownership overrides
+func (h *Handler) CloseShard(ctx context.Context, request *hist.CloseShardRequest) error {
+	h.startWG.Wait()
+
+	scope := metrics.HistoryCloseShardScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return h.error(errHistoryHostThrottle, scope, "", "")
+	}
+
+	if request.ShardID == nil {
+		return h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	if err := h.controller.closeShard(int(request.GetShardID())); err != nil {
+		return h.error(err, scope, "", "")
+	}
+	return nil
+}
+
 // convertError is a helper method to convert ShardOwnershipLostError from persistence layer returned by various
 // HistoryEngine API calls to ShardOwnershipLost error return by HistoryService for client to be redirected to the
 // correct shard.
@@ -1129,7 +1765,7 @@ func (h *Handler) convertError(err error) error {
 	switch err.(type) {
 	case *persistence.ShardOwnershipLostError:
 		shardID := err.(*persistence.ShardOwnershipLostError).ShardID
-		info, err := h.hServiceResolver.Lookup(string(shardID))
+		info, _, err := h.controller.shardOwnership.Lookup(shardID)
 		if err == nil {
 			return createShardOwnershipLostError(h.GetHostInfo().GetAddress(), info.GetAddress())
 		}
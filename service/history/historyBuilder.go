@@ -154,6 +154,13 @@ func (b *historyBuilder) AddActivityTaskFailedEvent(scheduleEventID, startedEven
 	return b.addEventToHistory(event)
 }
 
+func (b *historyBuilder) AddActivityTaskRetryRequestedEvent(scheduleEventID int64, activityID string, attempt int32,
+	request *workflow.RetryActivityTaskRequest) *workflow.HistoryEvent {
+	event := b.newActivityTaskRetryRequestedEvent(scheduleEventID, activityID, attempt, request)
+
+	return b.addEventToHistory(event)
+}
+
 func (b *historyBuilder) AddActivityTaskTimedOutEvent(scheduleEventID, startedEventID int64,
 	timeoutType workflow.TimeoutType, lastHeartBeatDetails []byte) *workflow.HistoryEvent {
 	event := b.newActivityTaskTimedOutEvent(scheduleEventID, startedEventID, timeoutType, lastHeartBeatDetails)
@@ -188,6 +195,20 @@ func (b *historyBuilder) AddWorkflowExecutionTerminatedEvent(
 	return b.addEventToHistory(event)
 }
 
+func (b *historyBuilder) AddWorkflowExecutionPausedEvent(
+	request *workflow.PauseWorkflowExecutionRequest) *workflow.HistoryEvent {
+	event := b.newWorkflowExecutionPausedEvent(request)
+
+	return b.addEventToHistory(event)
+}
+
+func (b *historyBuilder) AddWorkflowExecutionResumedEvent(
+	request *workflow.ResumeWorkflowExecutionRequest) *workflow.HistoryEvent {
+	event := b.newWorkflowExecutionResumedEvent(request)
+
+	return b.addEventToHistory(event)
+}
+
 func (b *historyBuilder) AddContinuedAsNewEvent(decisionCompletedEventID int64, newRunID string,
 	attributes *workflow.ContinueAsNewWorkflowExecutionDecisionAttributes) *workflow.HistoryEvent {
 	event := b.newWorkflowExecutionContinuedAsNewEvent(decisionCompletedEventID, newRunID, attributes)
@@ -462,6 +483,9 @@ func (b *historyBuilder) newWorkflowExecutionStartedEvent(
 	attributes.RetryPolicy = request.RetryPolicy
 	attributes.Attempt = common.Int32Ptr(startRequest.GetAttempt())
 	attributes.ExpirationTimestamp = startRequest.ExpirationTimestamp
+	if request.GetDelayStartSeconds() > 0 {
+		attributes.FirstDecisionTaskBackoffSeconds = common.Int32Ptr(request.GetDelayStartSeconds())
+	}
 
 	parentInfo := startRequest.ParentExecutionInfo
 	if parentInfo != nil {
@@ -586,6 +610,20 @@ func (b *historyBuilder) newActivityTaskCompletedEvent(scheduleEventID, startedE
 	return historyEvent
 }
 
+func (b *historyBuilder) newActivityTaskRetryRequestedEvent(scheduleEventID int64, activityID string, attempt int32,
+	request *workflow.RetryActivityTaskRequest) *workflow.HistoryEvent {
+	historyEvent := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeActivityTaskRetryRequested)
+	attributes := &workflow.ActivityTaskRetryRequestedEventAttributes{}
+	attributes.ScheduledEventId = common.Int64Ptr(scheduleEventID)
+	attributes.ActivityId = common.StringPtr(activityID)
+	attributes.Attempt = common.Int32Ptr(attempt)
+	attributes.Reason = common.StringPtr(common.StringDefault(request.Reason))
+	attributes.Identity = common.StringPtr(common.StringDefault(request.Identity))
+	historyEvent.ActivityTaskRetryRequestedEventAttributes = attributes
+
+	return historyEvent
+}
+
 func (b *historyBuilder) newActivityTaskTimedOutEvent(scheduleEventID, startedEventID int64,
 	timeoutType workflow.TimeoutType, lastHeartBeatDetails []byte) *workflow.HistoryEvent {
 	historyEvent := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeActivityTaskTimedOut)
@@ -669,6 +707,28 @@ func (b *historyBuilder) newWorkflowExecutionTerminatedEvent(
 	return historyEvent
 }
 
+func (b *historyBuilder) newWorkflowExecutionPausedEvent(
+	request *workflow.PauseWorkflowExecutionRequest) *workflow.HistoryEvent {
+	historyEvent := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeWorkflowExecutionPaused)
+	attributes := &workflow.WorkflowExecutionPausedEventAttributes{}
+	attributes.Reason = common.StringPtr(common.StringDefault(request.Reason))
+	attributes.Identity = common.StringPtr(common.StringDefault(request.Identity))
+	historyEvent.WorkflowExecutionPausedEventAttributes = attributes
+
+	return historyEvent
+}
+
+func (b *historyBuilder) newWorkflowExecutionResumedEvent(
+	request *workflow.ResumeWorkflowExecutionRequest) *workflow.HistoryEvent {
+	historyEvent := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeWorkflowExecutionResumed)
+	attributes := &workflow.WorkflowExecutionResumedEventAttributes{}
+	attributes.Reason = common.StringPtr(common.StringDefault(request.Reason))
+	attributes.Identity = common.StringPtr(common.StringDefault(request.Identity))
+	historyEvent.WorkflowExecutionResumedEventAttributes = attributes
+
+	return historyEvent
+}
+
 func (b *historyBuilder) newMarkerRecordedEventAttributes(decisionTaskCompletedEventID int64,
 	request *workflow.RecordMarkerDecisionAttributes) *workflow.HistoryEvent {
 	historyEvent := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeMarkerRecorded)
@@ -61,6 +61,9 @@ func newHistoryCache(shard ShardContext) *historyCache {
 	opts.InitialCapacity = config.HistoryCacheInitialSize()
 	opts.TTL = config.HistoryCacheTTL()
 	opts.Pin = true
+	opts.SizeBudget = config.historyCacheSizeBudget
+	opts.MetricsClient = shard.GetMetricsClient()
+	opts.MetricsScope = metrics.HistoryCacheScope
 
 	return &historyCache{
 		Cache:            cache.New(config.HistoryCacheMaxSize(), opts),
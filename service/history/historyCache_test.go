@@ -36,6 +36,7 @@ import (
 	"github.com/uber-go/tally"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
@@ -135,6 +136,42 @@ func (s *historyCacheSuite) TestHistoryCacheBasic() {
 	release(nil)
 }
 
+func (s *historyCacheSuite) TestHistoryCacheSizeBudget() {
+	s.mockShard.GetConfig().historyCacheSizeBudget = cache.NewSizeBudget(dynamicconfig.GetIntPropertyFn(100))
+	s.cache = newHistoryCache(s.mockShard)
+
+	domainID := "test_domain_id"
+	execution1 := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	mockMS1 := &mockMutableState{}
+	context, release, err := s.cache.getOrCreateWorkflowExecution(domainID, execution1)
+	s.Nil(err)
+	context.msBuilder = mockMS1
+	context.cacheSize = 80
+	release(nil)
+	s.Equal(int64(80), s.mockShard.GetConfig().historyCacheSizeBudget.UsedBytes())
+
+	execution2 := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	context, release, err = s.cache.getOrCreateWorkflowExecution(domainID, execution2)
+	s.Nil(err)
+	context.msBuilder = &mockMutableState{}
+	context.cacheSize = 80
+	release(nil)
+	s.Equal(int64(80), s.mockShard.GetConfig().historyCacheSizeBudget.UsedBytes())
+
+	// the first execution is evicted to stay within the budget
+	context, release, err = s.cache.getOrCreateWorkflowExecution(domainID, execution1)
+	s.Nil(err)
+	s.Nil(context.msBuilder)
+	release(nil)
+	s.Equal(2, s.cache.Size())
+}
+
 func (s *historyCacheSuite) TestHistoryCachePinning() {
 	s.mockShard.GetConfig().HistoryCacheMaxSize = dynamicconfig.GetIntPropertyFn(2)
 	domainID := "test_domain_id"
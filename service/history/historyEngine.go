@@ -33,10 +33,12 @@ import (
 	"github.com/pborman/uuid"
 	"github.com/uber-common/bark"
 	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	hc "github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
 	ce "github.com/uber/cadence/common/errors"
@@ -47,12 +49,19 @@ import (
 )
 
 const (
-	signalInputWarnSizeLimit                 = 256 * 1024
-	signalInputErrSizeLimit                  = 2 * 1024 * 1024
 	conditionalRetryCount                    = 5
 	activityCancelationMsgActivityIDUnknown  = "ACTIVITY_ID_UNKNOWN"
 	activityCancelationMsgActivityNotStarted = "ACTIVITY_ID_NOT_STARTED"
 	timerCancelationMsgTimerIDUnknown        = "TIMER_ID_UNKNOWN"
+	workflowIDReuseTerminateReason           = "TerminateIfRunning Policy"
+	workflowIDReuseTerminateDetails          = "Terminated by new RunID: %v"
+	// historyMigrationDrainInterval and historyMigrationDrainTimeout are the interval and the max time the
+	// migration of a workflow history waits for the pending replication tasks of the execution
+	historyMigrationDrainInterval = 100 * time.Millisecond
+	historyMigrationDrainTimeout  = 10 * time.Second
+	// historyMigrationMaxScanTasks is the max number of pending replication tasks scanned for the ones of
+	// the migrated execution, the tasks are considered pending if the shard is further behind
+	historyMigrationMaxScanTasks = 10000
 )
 
 type (
@@ -65,7 +74,7 @@ type (
 		txProcessor          transferQueueProcessor
 		timerProcessor       timerQueueProcessor
 		replicator           *historyReplicator
-		replicatorProcessor  queueProcessor
+		replicatorProcessor  replicatorQueueProcessor
 		historyEventNotifier historyEventNotifier
 		tokenSerializer      common.TaskTokenSerializer
 		historyCache         *historyCache
@@ -108,10 +117,31 @@ var (
 	ErrWorkflowParent = &workflow.EntityNotExistsError{Message: "Workflow parent does not match."}
 	// ErrDeserializingToken is the error to indicate task token is invalid
 	ErrDeserializingToken = &workflow.BadRequestError{Message: "Error deserializing task token."}
-	// ErrSignalOverSize is the error to indicate signal input size is > 256K
-	ErrSignalOverSize = &workflow.BadRequestError{Message: "Signal input size is over 256K."}
+	// ErrSignalOverSize is the error to indicate signal input size is over the blob size limit
+	ErrSignalOverSize = &workflow.BadRequestError{Message: "Signal input size is over the limit."}
+	// ErrWorkflowPaused is the error to indicate tasks of the workflow execution are held until it is resumed
+	ErrWorkflowPaused = &workflow.EntityNotExistsError{Message: "Workflow execution is paused."}
+	// ErrWorkflowAlreadyPaused is the error to indicate workflow execution is already paused
+	ErrWorkflowAlreadyPaused = &workflow.BadRequestError{Message: "Workflow execution is already paused."}
+	// ErrWorkflowNotPaused is the error to indicate workflow execution is not paused
+	ErrWorkflowNotPaused = &workflow.BadRequestError{Message: "Workflow execution is not paused."}
+	// ErrActivityTaskAlreadyStarted is the error to indicate activity task is already picked up by a worker
+	ErrActivityTaskAlreadyStarted = &workflow.BadRequestError{Message: "Activity task is already started."}
 	// ErrCancellationAlreadyRequested is the error indicating cancellation for target workflow is already requested
 	ErrCancellationAlreadyRequested = &workflow.CancellationAlreadyRequestedError{Message: "Cancellation already requested for this workflow execution."}
+	// ErrShardTaskNotFound is the error indicating the transfer / timer task to remove or reschedule does not exist
+	ErrShardTaskNotFound = &workflow.EntityNotExistsError{Message: "Task not found."}
+	// ErrTimerTaskNotDue is the error indicating the timer task to reschedule is not due yet
+	ErrTimerTaskNotDue = &workflow.BadRequestError{Message: "Timer task is not due yet."}
+	// ErrDomainDeleted is the error indicating new workflow executions are not started in a deleted domain
+	ErrDomainDeleted = &workflow.BadRequestError{Message: "Domain is deleted."}
+	// ErrWorkflowNotClosed is the error indicating a running workflow execution cannot be deleted
+	ErrWorkflowNotClosed = &workflow.BadRequestError{Message: "Workflow execution is not closed."}
+	// ErrDomainNotDeleted is the error indicating the workflow executions are only deleted along with their domain
+	ErrDomainNotDeleted = &workflow.BadRequestError{Message: "Domain is not deleted."}
+	// ErrHistoryMigrationTasksPending is the error indicating the history of a workflow execution is not migrated
+	// while replication tasks reading it are pending
+	ErrHistoryMigrationTasksPending = &workflow.ServiceBusyError{Message: "Replication tasks of the workflow execution are pending, retry the migration later."}
 	// ErrBufferedEventsLimitExceeded is the error indicating limit reached for maximum number of buffered events
 	ErrBufferedEventsLimitExceeded = &workflow.LimitExceededError{Message: "Exceeded workflow execution limit for buffered events"}
 	// FailedWorkflowCloseState is a set of failed workflow close states, used for start workflow policy
@@ -159,8 +189,9 @@ func NewEngineWithShardContext(shard ShardContext, visibilityMgr persistence.Vis
 	historyEngImpl.txProcessor = txProcessor
 	shardWrapper.txProcessor = txProcessor
 
-	// Only start the replicator processor if valid publisher is passed in
-	if publisher != nil {
+	// Only create the replicator processor for global domains, replication tasks are either published
+	// to kafka by the processor or pulled by the remote clusters when no publisher is passed in
+	if publisher != nil || shard.GetService().GetClusterMetadata().IsGlobalDomainEnabled() {
 		replicatorProcessor := newReplicatorQueueProcessor(shard, historyEngImpl.historyCache, publisher, executionManager, historyManager, historyV2Manager, logger)
 		historyEngImpl.replicatorProcessor = replicatorProcessor
 		shardWrapper.replcatorProcessor = replicatorProcessor
@@ -276,7 +307,8 @@ func (e *historyEngineImpl) createMutableState(clusterMetadata cluster.Metadata,
 	return msBuilder
 }
 
-func (e *historyEngineImpl) generateFirstDecisionTask(domainID string, msBuilder mutableState, parentInfo *h.ParentExecutionInfo, taskListName string) ([]persistence.Task, *decisionInfo, error) {
+func (e *historyEngineImpl) generateFirstDecisionTask(domainID string, msBuilder mutableState, parentInfo *h.ParentExecutionInfo,
+	taskListName string, backoffSeconds int32) ([]persistence.Task, *decisionInfo, error) {
 	di := &decisionInfo{
 		TaskList:        taskListName,
 		Version:         common.EmptyVersion,
@@ -285,7 +317,10 @@ func (e *historyEngineImpl) generateFirstDecisionTask(domainID string, msBuilder
 		DecisionTimeout: int32(0),
 	}
 	var transferTasks []persistence.Task
-	if parentInfo == nil {
+	if backoffSeconds > 0 {
+		// first decision task will be created by the backoff timer, same as the first decision of a retry
+		di.Version = msBuilder.GetCurrentVersion()
+	} else if parentInfo == nil {
 		// DecisionTask is only created when it is not a Child Workflow Execution
 		di = msBuilder.AddDecisionTaskScheduledEvent()
 		if di == nil {
@@ -299,6 +334,22 @@ func (e *historyEngineImpl) generateFirstDecisionTask(domainID string, msBuilder
 	return transferTasks, di, nil
 }
 
+// generateFirstTimerTasks generates the workflow timeout task, which also covers the backoff of the first decision,
+// along with the backoff timer task which schedules the first decision task when it is delayed
+func (e *historyEngineImpl) generateFirstTimerTasks(workflowTimeoutSeconds int32, backoffSeconds int32) []persistence.Task {
+	now := e.shard.GetTimeSource().Now()
+	backoff := time.Duration(backoffSeconds) * time.Second
+	timerTasks := []persistence.Task{&persistence.WorkflowTimeoutTask{
+		VisibilityTimestamp: now.Add(backoff + time.Duration(workflowTimeoutSeconds)*time.Second),
+	}}
+	if backoffSeconds > 0 {
+		timerTasks = append(timerTasks, &persistence.WorkflowRetryTimerTask{
+			VisibilityTimestamp: now.Add(backoff),
+		})
+	}
+	return timerTasks
+}
+
 func (e *historyEngineImpl) appendFirstBatchHistoryEvents(msBuilder mutableState, domainID string, execution workflow.WorkflowExecution) (historySize int, err error) {
 	events := msBuilder.GetHistoryBuilder().GetHistory().Events
 	startedEvent := events[0]
@@ -364,6 +415,15 @@ func generateFirstReplicationTask(msBuilder mutableState, clusterMetadata cluste
 func (e *historyEngineImpl) createWorkflow(startRequest *h.StartWorkflowExecutionRequest, msBuilder mutableState, createMode int, prevRunID string, prevLastWriteVersion int64,
 	firstDecisionTask *decisionInfo, transferTasks, timerTasks, replicationTasks []persistence.Task, clusterMetadata cluster.Metadata) (err error) {
 
+	createRequest := newCreateWorkflowRequest(startRequest, msBuilder, createMode, prevRunID, prevLastWriteVersion,
+		firstDecisionTask, transferTasks, timerTasks, replicationTasks)
+	_, err = e.shard.CreateWorkflowExecution(createRequest)
+	return err
+}
+
+func newCreateWorkflowRequest(startRequest *h.StartWorkflowExecutionRequest, msBuilder mutableState, createMode int, prevRunID string, prevLastWriteVersion int64,
+	firstDecisionTask *decisionInfo, transferTasks, timerTasks, replicationTasks []persistence.Task) *persistence.CreateWorkflowExecutionRequest {
+
 	request := startRequest.StartRequest
 	currExeInfo := msBuilder.GetExecutionInfo()
 	execution := workflow.WorkflowExecution{
@@ -402,6 +462,7 @@ func (e *historyEngineImpl) createWorkflow(startRequest *h.StartWorkflowExecutio
 		DecisionScheduleID:          firstDecisionTask.ScheduleID,
 		DecisionStartedID:           firstDecisionTask.StartedID,
 		DecisionStartToCloseTimeout: firstDecisionTask.DecisionTimeout,
+		HasDecisionBackoff:          msBuilder.GetExecutionInfo().HasDecisionBackoff,
 		TimerTasks:                  timerTasks,
 		PreviousRunID:               prevRunID,
 		PreviousLastWriteVersion:    prevLastWriteVersion,
@@ -424,19 +485,27 @@ func (e *historyEngineImpl) createWorkflow(startRequest *h.StartWorkflowExecutio
 		createRequest.MaximumAttempts = request.RetryPolicy.GetMaximumAttempts()
 		createRequest.NonRetriableErrors = request.RetryPolicy.NonRetriableErrorReasons
 	}
-
-	_, err = e.shard.CreateWorkflowExecution(createRequest)
-	return err
+	return createRequest
 }
 
 // StartWorkflowExecution starts a workflow execution
 func (e *historyEngineImpl) StartWorkflowExecution(ctx context.Context, startRequest *h.StartWorkflowExecutionRequest) (
 	resp *workflow.StartWorkflowExecutionResponse, retError error) {
-	domainEntry, retError := e.getActiveDomainEntry(startRequest.DomainUUID)
+	var domainEntry *cache.DomainCacheEntry
+	if startRequest.ParentExecutionInfo != nil {
+		// child workflow started by the transfer queue of its parent
+		domainEntry, retError = e.getActiveDomainEntry(startRequest.DomainUUID)
+	} else {
+		domainEntry, retError = e.getActiveDomainEntryForExternalWrite(startRequest.DomainUUID)
+	}
 	if retError != nil {
 		return
 	}
 	domainID := domainEntry.GetInfo().ID
+	// the frontend checks it as well, the child workflows are started in their domain by the transfer queue
+	if domainEntry.GetInfo().Status == persistence.DomainStatusDeleted {
+		return nil, ErrDomainDeleted
+	}
 
 	request := startRequest.StartRequest
 	retError = validateStartWorkflowExecutionRequest(request)
@@ -466,16 +535,14 @@ func (e *historyEngineImpl) StartWorkflowExecution(ctx context.Context, startReq
 	}
 
 	taskList := request.TaskList.GetName()
-	// Generate first decision task event if not child WF
-	transferTasks, firstDecisionTask, retError := e.generateFirstDecisionTask(domainID, msBuilder, startRequest.ParentExecutionInfo, taskList)
+	// Generate first decision task event if not child WF and not delayed
+	transferTasks, firstDecisionTask, retError := e.generateFirstDecisionTask(domainID, msBuilder, startRequest.ParentExecutionInfo,
+		taskList, request.GetDelayStartSeconds())
 	if retError != nil {
 		return
 	}
-	// Generate first timer task : WF timeout task
-	duration := time.Duration(*request.ExecutionStartToCloseTimeoutSeconds) * time.Second
-	timerTasks := []persistence.Task{&persistence.WorkflowTimeoutTask{
-		VisibilityTimestamp: e.shard.GetTimeSource().Now().Add(duration),
-	}}
+	// Generate first timer tasks : WF timeout task and the backoff timer of a delayed start
+	timerTasks := e.generateFirstTimerTasks(request.GetExecutionStartToCloseTimeoutSeconds(), request.GetDelayStartSeconds())
 	// generate first replication task
 	replicationTasks := generateFirstReplicationTask(msBuilder, clusterMetadata, domainEntry)
 	// set versions and timestamp for timer and transfer tasks
@@ -521,15 +588,28 @@ func (e *historyEngineImpl) StartWorkflowExecution(ctx context.Context, startReq
 				return
 			}
 
-			// create as ID reuse
-			createMode = persistence.CreateWorkflowModeWorkflowIDReuse
 			prevRunID = t.RunID
 			prevLastWriteVersion = t.LastWriteVersion
-			retError = e.applyWorkflowIDReusePolicyHelper(t.StartRequestID, prevRunID, t.State, t.CloseStatus, domainID, execution, startRequest.StartRequest.GetWorkflowIdReusePolicy())
-			if retError != nil {
-				return
+			if t.State != persistence.WorkflowStateCompleted &&
+				request.GetWorkflowIdReusePolicy() == workflow.WorkflowIdReusePolicyTerminateIfRunning {
+				// terminate the running execution and create the new run in the same transaction
+				createMode = persistence.CreateWorkflowModeContinueAsNew
+				createRequest := newCreateWorkflowRequest(startRequest, msBuilder, createMode, prevRunID, prevLastWriteVersion,
+					firstDecisionTask, transferTasks, timerTasks, replicationTasks)
+				prevExecution := workflow.WorkflowExecution{
+					WorkflowId: request.WorkflowId,
+					RunId:      common.StringPtr(prevRunID),
+				}
+				retError = e.terminateAndStartWorkflow(ctx, domainID, prevExecution, createRequest)
+			} else {
+				// create as ID reuse
+				createMode = persistence.CreateWorkflowModeWorkflowIDReuse
+				retError = e.applyWorkflowIDReusePolicyHelper(t.StartRequestID, prevRunID, t.State, t.CloseStatus, domainID, execution, request.GetWorkflowIdReusePolicy())
+				if retError != nil {
+					return
+				}
+				retError = e.createWorkflow(startRequest, msBuilder, createMode, prevRunID, prevLastWriteVersion, firstDecisionTask, transferTasks, timerTasks, replicationTasks, clusterMetadata)
 			}
-			retError = e.createWorkflow(startRequest, msBuilder, createMode, prevRunID, prevLastWriteVersion, firstDecisionTask, transferTasks, timerTasks, replicationTasks, clusterMetadata)
 		}
 	}
 
@@ -543,6 +623,72 @@ func (e *historyEngineImpl) StartWorkflowExecution(ctx context.Context, startReq
 	return
 }
 
+// terminateAndStartWorkflow terminates the running execution and creates the new run of the workflow in the same
+// transaction, in case the execution is already closed the new run is created by reusing the workflow ID
+func (e *historyEngineImpl) terminateAndStartWorkflow(ctx context.Context, domainID string, prevExecution workflow.WorkflowExecution,
+	newRun *persistence.CreateWorkflowExecutionRequest) (retError error) {
+
+	context, release, retError := e.historyCache.getOrCreateWorkflowExecutionWithTimeout(ctx, domainID, prevExecution)
+	if retError != nil {
+		return
+	}
+	defer func() { release(retError) }()
+
+	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
+		msBuilder, err := context.loadWorkflowExecution()
+		if err != nil {
+			return err
+		}
+		if !msBuilder.IsWorkflowExecutionRunning() {
+			newRun.CreateWorkflowMode = persistence.CreateWorkflowModeWorkflowIDReuse
+			newRun.PreviousLastWriteVersion = msBuilder.GetLastWriteVersion()
+			_, err = e.shard.CreateWorkflowExecution(newRun)
+			return err
+		}
+
+		err = e.terminateWorkflowForNewRun(context, msBuilder, domainID, newRun)
+		if err == ErrConflict {
+			continue
+		}
+		return err
+	}
+	return ErrMaxAttemptsExceeded
+}
+
+// terminateWorkflowForNewRun terminates the running execution of the context with a reason referencing the new run,
+// the new run is created along with the update of the terminated execution, which conditionally moves the
+// current execution record from the terminated execution to the new run
+func (e *historyEngineImpl) terminateWorkflowForNewRun(context *workflowExecutionContext, msBuilder mutableState,
+	domainID string, newRun *persistence.CreateWorkflowExecutionRequest) error {
+
+	if msBuilder.AddWorkflowExecutionTerminatedEvent(&workflow.TerminateWorkflowExecutionRequest{
+		Reason:   common.StringPtr(workflowIDReuseTerminateReason),
+		Details:  []byte(fmt.Sprintf(workflowIDReuseTerminateDetails, newRun.Execution.GetRunId())),
+		Identity: common.StringPtr(identityHistoryService),
+	}) == nil {
+		return &workflow.InternalServiceError{Message: "Unable to terminate workflow execution."}
+	}
+	newRun.PreviousRunID = msBuilder.GetExecutionInfo().RunID
+	msBuilder.SetNewRun(newRun)
+
+	tBuilder := e.getTimerBuilder(&context.workflowExecution)
+	closeTask, cleanupTask, err := e.getDeleteWorkflowTasks(domainID, msBuilder.GetExecutionInfo().WorkflowID, tBuilder)
+	if err != nil {
+		return err
+	}
+	transactionID, err := e.shard.GetNextTransferTaskID()
+	if err != nil {
+		return err
+	}
+
+	timerTasks := []persistence.Task{cleanupTask}
+	if err := context.updateWorkflowExecution([]persistence.Task{closeTask}, timerTasks, transactionID); err != nil {
+		return err
+	}
+	e.timerProcessor.NotifyNewTimers(e.currentClusterName, e.shard.GetCurrentTime(e.currentClusterName), timerTasks)
+	return nil
+}
+
 // GetMutableState retrieves the mutable state of the workflow execution
 func (e *historyEngineImpl) GetMutableState(ctx context.Context,
 	request *h.GetMutableStateRequest) (*h.GetMutableStateResponse, error) {
@@ -681,6 +827,283 @@ func (e *historyEngineImpl) DescribeMutableState(ctx context.Context,
 	return
 }
 
+// MigrateWorkflowHistory copies the history of a workflow execution from the deprecated history tables
+// into a new history tree and switches the mutable state over to events v2. The mutable state is only
+// switched once the pending replication tasks of the execution are processed. The old history is left in
+// place so any reader or next page token still pointing at it keeps working, it is deleted along with the
+// events v2 history by retention.
+func (e *historyEngineImpl) MigrateWorkflowHistory(ctx context.Context,
+	request *h.MigrateWorkflowHistoryRequest) (retResp *h.MigrateWorkflowHistoryResponse, retError error) {
+
+	domainID, err := validateDomainUUID(request.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.Execution.WorkflowId,
+		RunId:      request.Execution.RunId,
+	}
+
+	context, release, err0 := e.historyCache.getOrCreateWorkflowExecutionWithTimeout(ctx, domainID, execution)
+	if err0 != nil {
+		return nil, err0
+	}
+	defer func() { release(retError) }()
+
+	msBuilder, err1 := context.loadWorkflowExecution()
+	if err1 != nil {
+		return nil, err1
+	}
+
+	retResp = &h.MigrateWorkflowHistoryResponse{
+		Migrated: common.BoolPtr(false),
+	}
+	if msBuilder.GetEventStoreVersion() == persistence.EventStoreVersionV2 {
+		return retResp, nil
+	}
+
+	executionInfo := msBuilder.GetExecutionInfo()
+	nextEventID := executionInfo.NextEventID
+	lastFirstEventID := executionInfo.LastFirstEventID
+
+	var branchToken []byte
+	if !request.GetDryRun() {
+		// the replication tasks read the history from the events store version they are created with, the
+		// execution is locked so all its replication tasks are below the current max read level
+		domainEntry, err := e.shard.GetDomainCache().GetDomainByID(domainID)
+		if err != nil {
+			return nil, err
+		}
+		if domainEntry.CanReplicateEvent() {
+			if err := e.waitForReplicationTasks(ctx, domainID, context.workflowExecution, e.shard.GetTransferMaxReadLevel()); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := msBuilder.SetHistoryTree(context.workflowExecution.GetRunId()); err != nil {
+			return nil, err
+		}
+		branchToken = msBuilder.GetCurrentBranch()
+	}
+
+	// the copied tree is dropped unless the execution may refer to it, the mutable state switched to the tree
+	// in memory is cleared along with the release of the execution on error
+	keepHistoryTree := false
+	defer func() {
+		if retError != nil && branchToken != nil && !keepHistoryTree {
+			e.deleteEvents(domainID, context.workflowExecution, true, branchToken)
+		}
+	}()
+
+	// the history is copied page by page, so it is never held in memory as a whole
+	var batchCount int64
+	var eventCount int64
+	var historySize int64
+	var copiedSize int64
+	var token []byte
+	for {
+		response, err := e.historyMgr.GetWorkflowExecutionHistoryByBatch(&persistence.GetWorkflowExecutionHistoryRequest{
+			DomainID:      domainID,
+			Execution:     context.workflowExecution,
+			FirstEventID:  common.FirstEventID,
+			NextEventID:   nextEventID,
+			PageSize:      defaultHistoryPageSize,
+			NextPageToken: token,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, batch := range response.History {
+			batchCount++
+			eventCount += int64(len(batch.Events))
+			if branchToken == nil {
+				continue
+			}
+
+			transactionID, err := e.shard.GetNextTransferTaskID()
+			if err != nil {
+				return nil, err
+			}
+			size, err := e.shard.AppendHistoryV2Events(&persistence.AppendHistoryNodesRequest{
+				IsNewBranch:   batchCount == 1,
+				BranchToken:   branchToken,
+				Events:        batch.Events,
+				TransactionID: transactionID,
+			}, domainID)
+			if err != nil {
+				return nil, err
+			}
+			copiedSize += int64(size)
+		}
+		historySize += int64(response.Size)
+		token = response.NextPageToken
+		if len(token) == 0 {
+			break
+		}
+	}
+
+	retResp.HistoryBatchCount = common.Int64Ptr(batchCount)
+	retResp.HistoryEventCount = common.Int64Ptr(eventCount)
+	retResp.HistorySize = common.Int64Ptr(historySize)
+
+	if request.GetDryRun() {
+		e.metricsClient.IncCounter(metrics.HistoryMigrateWorkflowHistoryScope, metrics.HistoryMigrationDryRunCounter)
+		return retResp, nil
+	}
+
+	executionInfo.SetNextEventID(nextEventID)
+	executionInfo.SetLastFirstEventID(lastFirstEventID)
+	executionInfo.SetHistorySize(copiedSize)
+	executionInfo.HasEventsV1History = true
+
+	transactionID, err2 := e.shard.GetNextTransferTaskID()
+	if err2 != nil {
+		return nil, err2
+	}
+	// the update is conditioned on the next event ID loaded above, a conflict means the execution still refers
+	// to the events v1 history, while on any other error the update may have been applied
+	if err := context.updateWorkflowExecution(nil, nil, transactionID); err != nil {
+		keepHistoryTree = err != ErrConflict
+		return nil, err
+	}
+	keepHistoryTree = true
+
+	e.metricsClient.IncCounter(metrics.HistoryMigrateWorkflowHistoryScope, metrics.HistoryMigrationCounter)
+	e.metricsClient.RecordTimer(metrics.HistoryMigrateWorkflowHistoryScope, metrics.HistoryMigrationSize, time.Duration(copiedSize))
+	e.metricsClient.RecordTimer(metrics.HistoryMigrateWorkflowHistoryScope, metrics.HistoryMigrationEventCount, time.Duration(eventCount))
+
+	retResp.Migrated = common.BoolPtr(true)
+	retResp.HistorySize = common.Int64Ptr(copiedSize)
+	return retResp, nil
+}
+
+// waitForReplicationTasks waits until no replication task of the execution up to the given task ID is pending
+func (e *historyEngineImpl) waitForReplicationTasks(ctx context.Context, domainID string,
+	execution workflow.WorkflowExecution, maxTaskID int64) error {
+
+	timer := time.NewTimer(historyMigrationDrainTimeout)
+	defer timer.Stop()
+	ticker := time.NewTicker(historyMigrationDrainInterval)
+	defer ticker.Stop()
+	for {
+		pending, err := e.hasPendingReplicationTasks(domainID, execution, maxTaskID)
+		if err != nil || !pending {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ErrHistoryMigrationTasksPending
+		case <-timer.C:
+			return ErrHistoryMigrationTasksPending
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *historyEngineImpl) hasPendingReplicationTasks(domainID string, execution workflow.WorkflowExecution,
+	maxTaskID int64) (bool, error) {
+
+	request := &persistence.GetReplicationTasksRequest{
+		ReadLevel:    e.shard.GetReplicatorAckLevel(),
+		MaxReadLevel: maxTaskID,
+		BatchSize:    defaultHistoryPageSize,
+	}
+	scanned := 0
+	for request.ReadLevel < request.MaxReadLevel {
+		response, err := e.executionManager.GetReplicationTasks(request)
+		if err != nil {
+			return false, err
+		}
+		for _, task := range response.Tasks {
+			if task.DomainID == domainID && task.WorkflowID == execution.GetWorkflowId() && task.RunID == execution.GetRunId() {
+				return true, nil
+			}
+		}
+		scanned += len(response.Tasks)
+		if len(response.NextPageToken) == 0 {
+			return false, nil
+		}
+		if scanned >= historyMigrationMaxScanTasks {
+			return true, nil
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+	return false, nil
+}
+
+// DeleteWorkflowExecution deletes a closed workflow execution with its history, and its current execution row
+// if it is the current run of the workflow id. The mutable state is cleared from the cache, so the deleted run
+// is not served from it anymore.
+func (e *historyEngineImpl) DeleteWorkflowExecution(ctx context.Context,
+	request *h.DeleteWorkflowExecutionRequest) (retResp *h.DeleteWorkflowExecutionResponse, retError error) {
+
+	domainID, err := validateDomainUUID(request.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+	domainEntry, err := e.shard.GetDomainCache().GetDomainByID(domainID)
+	if err != nil {
+		return nil, err
+	}
+	if domainEntry.GetInfo().Status != persistence.DomainStatusDeleted {
+		return nil, ErrDomainNotDeleted
+	}
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.Execution.WorkflowId,
+		RunId:      request.Execution.RunId,
+	}
+
+	context, release, err0 := e.historyCache.getOrCreateWorkflowExecutionWithTimeout(ctx, domainID, execution)
+	if err0 != nil {
+		return nil, err0
+	}
+	defer func() { release(retError) }()
+
+	msBuilder, err1 := context.loadWorkflowExecution()
+	if err1 != nil {
+		return nil, err1
+	}
+	if msBuilder.IsWorkflowExecutionRunning() {
+		return nil, ErrWorkflowNotClosed
+	}
+
+	// the history is deleted first, so a failed attempt can still find the branch from the mutable state
+	executionInfo := msBuilder.GetExecutionInfo()
+	if err := deleteWorkflowHistory(e.historyMgr, e.historyV2Mgr, domainID, context.workflowExecution, msBuilder); err != nil {
+		return nil, err
+	}
+
+	op := func() error {
+		return e.executionManager.DeleteCurrentWorkflowExecution(&persistence.DeleteCurrentWorkflowExecutionRequest{
+			DomainID:   domainID,
+			WorkflowID: executionInfo.WorkflowID,
+			RunID:      executionInfo.RunID,
+		})
+	}
+	if err := backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError); err != nil {
+		return nil, err
+	}
+
+	op = func() error {
+		return e.executionManager.DeleteWorkflowExecution(&persistence.DeleteWorkflowExecutionRequest{
+			DomainID:   domainID,
+			WorkflowID: executionInfo.WorkflowID,
+			RunID:      executionInfo.RunID,
+		})
+	}
+	if err := backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError); err != nil {
+		return nil, err
+	}
+	context.clear()
+
+	return &h.DeleteWorkflowExecutionResponse{
+		TaskList:       common.StringPtr(executionInfo.TaskList),
+		StickyTaskList: common.StringPtr(executionInfo.StickyTaskList),
+	}, nil
+}
+
 func (e *historyEngineImpl) toMutableStateJSON(msb mutableState) (*string, error) {
 	ms := msb.CopyToPersistence()
 
@@ -755,6 +1178,7 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(ctx context.Context,
 			StartTime:     common.Int64Ptr(executionInfo.StartTimestamp.UnixNano()),
 			HistoryLength: common.Int64Ptr(msBuilder.GetNextEventID() - common.FirstEventID),
 		},
+		Paused: common.BoolPtr(executionInfo.Paused),
 	}
 	if executionInfo.State == persistence.WorkflowStateCompleted {
 		// for closed workflow
@@ -854,6 +1278,11 @@ Update_History_Loop:
 			return nil, &h.EventAlreadyStartedError{Message: "Decision task already started."}
 		}
 
+		if msBuilder.IsPaused() {
+			// Drop the task, it is dispatched again when the workflow is resumed
+			return nil, ErrWorkflowPaused
+		}
+
 		_, di = msBuilder.AddDecisionTaskStartedEvent(scheduleID, requestID, request.PollRequest)
 		if di == nil {
 			// Unable to add DecisionTaskStarted event to history
@@ -958,6 +1387,11 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(ctx context.Context,
 				return nil, &h.EventAlreadyStartedError{Message: "Activity task already started."}
 			}
 
+			if msBuilder.IsPaused() {
+				// Drop the task, it is dispatched again when the workflow is resumed
+				return nil, ErrWorkflowPaused
+			}
+
 			msBuilder.AddActivityTaskStartedEvent(ai, scheduleID, requestID, request.PollRequest.GetIdentity())
 
 			response.StartedTimestamp = common.Int64Ptr(ai.StartedTime.UnixNano())
@@ -1076,6 +1510,9 @@ Update_History_Loop:
 		executionInfo.ClientFeatureVersion = clientFeatureVersion
 		executionInfo.ClientImpl = clientImpl
 
+		sizeChecker := newWorkflowSizeChecker(domainID, domainEntry.GetInfo().Name, &workflowExecution, e.config,
+			e.metricsClient, metrics.HistoryRespondDecisionTaskCompletedScope, e.logger)
+
 	Process_Decision_Loop:
 		for _, d := range request.Decisions {
 			switch *d.DecisionType {
@@ -1094,7 +1531,8 @@ Update_History_Loop:
 					targetDomainID = domainEntry.GetInfo().ID
 				}
 
-				if err = validateActivityScheduleAttributes(attributes, executionInfo.WorkflowTimeout); err != nil {
+				if err = validateActivityScheduleAttributes(attributes, executionInfo.WorkflowTimeout); err != nil ||
+					sizeChecker.blobSizeLimitExceeded(len(attributes.Input)) {
 					failDecision = true
 					failCause = workflow.DecisionTaskFailedCauseBadScheduleActivityAttributes
 					break Process_Decision_Loop
@@ -1125,7 +1563,8 @@ Update_History_Loop:
 					continue Process_Decision_Loop
 				}
 				attributes := d.CompleteWorkflowExecutionDecisionAttributes
-				if err = validateCompleteWorkflowExecutionAttributes(attributes); err != nil {
+				if err = validateCompleteWorkflowExecutionAttributes(attributes); err != nil ||
+					sizeChecker.blobSizeLimitExceeded(len(attributes.Result)) {
 					failDecision = true
 					failCause = workflow.DecisionTaskFailedCauseBadCompleteWorkflowExecutionAttributes
 					break Process_Decision_Loop
@@ -1152,13 +1591,18 @@ Update_History_Loop:
 				}
 
 				failedAttributes := d.FailWorkflowExecutionDecisionAttributes
-				if err = validateFailWorkflowExecutionAttributes(failedAttributes); err != nil {
+				if err = validateFailWorkflowExecutionAttributes(failedAttributes); err != nil ||
+					sizeChecker.blobSizeLimitExceeded(len(failedAttributes.Details)) {
 					failDecision = true
 					failCause = workflow.DecisionTaskFailedCauseBadFailWorkflowExecutionAttributes
 					break Process_Decision_Loop
 				}
 
 				retryBackoffInterval := msBuilder.GetRetryBackoffDuration(failedAttributes.GetReason())
+				if domainEntry.GetInfo().Status == persistence.DomainStatusDeleted {
+					// a deleted domain does not accept new runs, the workflow execution fails instead
+					retryBackoffInterval = common.NoRetryBackoff
+				}
 				if retryBackoffInterval == common.NoRetryBackoff {
 					// no retry
 					if evt := msBuilder.AddFailWorkflowEvent(completedID, failedAttributes); evt == nil {
@@ -1207,7 +1651,8 @@ Update_History_Loop:
 					continue Process_Decision_Loop
 				}
 				attributes := d.CancelWorkflowExecutionDecisionAttributes
-				if err = validateCancelWorkflowExecutionAttributes(attributes); err != nil {
+				if err = validateCancelWorkflowExecutionAttributes(attributes); err != nil ||
+					sizeChecker.blobSizeLimitExceeded(len(attributes.Details)) {
 					failDecision = true
 					failCause = workflow.DecisionTaskFailedCauseBadCancelWorkflowExecutionAttributes
 					break Process_Decision_Loop
@@ -1278,7 +1723,8 @@ Update_History_Loop:
 				e.metricsClient.IncCounter(metrics.HistoryRespondDecisionTaskCompletedScope,
 					metrics.DecisionTypeRecordMarkerCounter)
 				attributes := d.RecordMarkerDecisionAttributes
-				if err = validateRecordMarkerAttributes(attributes); err != nil {
+				if err = validateRecordMarkerAttributes(attributes); err != nil ||
+					sizeChecker.blobSizeLimitExceeded(len(attributes.Details)) {
 					failDecision = true
 					failCause = workflow.DecisionTaskFailedCauseBadRecordMarkerAttributes
 					break Process_Decision_Loop
@@ -1332,8 +1778,8 @@ Update_History_Loop:
 					failCause = workflow.DecisionTaskFailedCauseBadSignalWorkflowExecutionAttributes
 					break Process_Decision_Loop
 				}
-				if err = validateSignalInput(domainID, &workflowExecution, attributes.Input, e.metricsClient,
-					metrics.HistoryRespondDecisionTaskCompletedScope, e.logger); err != nil {
+				if err = e.validateSignalInput(domainEntry, &workflowExecution, attributes.Input,
+					metrics.HistoryRespondDecisionTaskCompletedScope); err != nil {
 					failDecision = true
 					failCause = workflow.DecisionTaskFailedCauseBadSignalInputSize
 					break Process_Decision_Loop
@@ -1383,7 +1829,9 @@ Update_History_Loop:
 					continue Process_Decision_Loop
 				}
 				attributes := d.ContinueAsNewWorkflowExecutionDecisionAttributes
-				if err = validateContinueAsNewWorkflowExecutionAttributes(executionInfo, attributes); err != nil {
+				if err = validateContinueAsNewWorkflowExecutionAttributes(executionInfo, attributes); err != nil ||
+					sizeChecker.blobSizeLimitExceeded(len(attributes.Input)) ||
+					domainEntry.GetInfo().Status == persistence.DomainStatusDeleted {
 					failDecision = true
 					failCause = workflow.DecisionTaskFailedCauseBadContinueAsNewAttributes
 					break Process_Decision_Loop
@@ -1413,20 +1861,28 @@ Update_History_Loop:
 					metrics.DecisionTypeChildWorkflowCounter)
 				targetDomainID := domainID
 				attributes := d.StartChildWorkflowExecutionDecisionAttributes
-				if err = validateStartChildExecutionAttributes(executionInfo, attributes); err != nil {
+				if err = validateStartChildExecutionAttributes(executionInfo, attributes); err != nil ||
+					sizeChecker.blobSizeLimitExceeded(len(attributes.Input)) {
 					failDecision = true
 					failCause = workflow.DecisionTaskFailedCauseBadStartChildExecutionAttributes
 					break Process_Decision_Loop
 				}
 
 				// First check if we need to use a different target domain to schedule child execution
+				targetDomainEntry := domainEntry
 				if attributes.Domain != nil {
 					// TODO: Error handling for DecisionType_StartChildWorkflowExecution failed when domain lookup fails
-					domainEntry, err := e.shard.GetDomainCache().GetDomain(*attributes.Domain)
+					targetDomainEntry, err = e.shard.GetDomainCache().GetDomain(*attributes.Domain)
 					if err != nil {
 						return nil, &workflow.InternalServiceError{Message: "Unable to schedule child execution across domain."}
 					}
-					targetDomainID = domainEntry.GetInfo().ID
+					targetDomainID = targetDomainEntry.GetInfo().ID
+				}
+				// a deleted domain does not accept new workflow executions
+				if targetDomainEntry.GetInfo().Status == persistence.DomainStatusDeleted {
+					failDecision = true
+					failCause = workflow.DecisionTaskFailedCauseBadStartChildExecutionAttributes
+					break Process_Decision_Loop
 				}
 
 				requestID := uuid.New()
@@ -1446,6 +1902,28 @@ Update_History_Loop:
 			return nil, err
 		}
 
+		// a decision which closes the workflow execution is always allowed, otherwise the decision
+		// is failed once the workflow execution grows beyond the limits of its domain
+		terminateReason := ""
+		if !failDecision && !isComplete {
+			historyLimitExceeded := sizeChecker.historyLimitExceeded(msBuilder)
+			pendingLimitExceeded := sizeChecker.pendingLimitExceeded(msBuilder)
+			if historyLimitExceeded || pendingLimitExceeded {
+				failDecision = true
+				failCause = workflow.DecisionTaskFailedCauseWorkflowLimitExceeded
+				// the history will not shrink, so the workflow execution can never make progress again.
+				// pending activities, child executions, timers and signals drain on their own, so a decision
+				// over the pending limit is failed and retried until it fits, the retries are transient
+				// decision attempts and do not grow the history. The workflow execution is terminated once
+				// its decisions keep failing for too many attempts.
+				if historyLimitExceeded {
+					terminateReason = workflowLimitExceededReason
+				} else if di.Attempt+1 >= int64(e.config.PendingLimitMaxDecisionAttempts(domainEntry.GetInfo().Name)) {
+					terminateReason = workflowPendingLimitExceededReason
+				}
+			}
+		}
+
 		if failDecision {
 			e.metricsClient.IncCounter(metrics.HistoryRespondDecisionTaskCompletedScope, metrics.FailedDecisionsCounter)
 			logging.LogDecisionFailedEvent(e.logger, domainID, token.WorkflowID, token.RunID, failCause)
@@ -1458,6 +1936,19 @@ Update_History_Loop:
 			isComplete = false
 			hasUnhandledEvents = true
 			continueAsNewBuilder = nil
+			// the tasks generated by the decisions refer to events which are discarded along with the reload
+			transferTasks = []persistence.Task{}
+			timerTasks = []persistence.Task{}
+
+			if terminateReason != "" {
+				if msBuilder.AddWorkflowExecutionTerminatedEvent(&workflow.TerminateWorkflowExecutionRequest{
+					Reason:   common.StringPtr(terminateReason),
+					Identity: common.StringPtr(identityHistoryService),
+				}) == nil {
+					return nil, &workflow.InternalServiceError{Message: "Unable to terminate workflow execution."}
+				}
+				isComplete = true
+			}
 		}
 
 		if tt := tBuilder.GetUserTimerTaskIfNeeded(msBuilder); tt != nil {
@@ -1859,14 +2350,21 @@ func (e *historyEngineImpl) RecordActivityTaskHeartbeat(ctx context.Context,
 func (e *historyEngineImpl) RequestCancelWorkflowExecution(ctx context.Context,
 	req *h.RequestCancelWorkflowExecutionRequest) error {
 
-	domainEntry, err := e.getActiveDomainEntry(req.DomainUUID)
+	request := req.CancelRequest
+	parentExecution := req.ExternalWorkflowExecution
+	var domainEntry *cache.DomainCacheEntry
+	var err error
+	if parentExecution != nil {
+		// cancellation requested by the transfer queue of another workflow execution
+		domainEntry, err = e.getActiveDomainEntry(req.DomainUUID)
+	} else {
+		domainEntry, err = e.getActiveDomainEntryForExternalWrite(req.DomainUUID)
+	}
 	if err != nil {
 		return err
 	}
 	domainID := domainEntry.GetInfo().ID
 
-	request := req.CancelRequest
-	parentExecution := req.ExternalWorkflowExecution
 	childWorkflowOnly := req.GetChildWorkflowOnly()
 	execution := workflow.WorkflowExecution{
 		WorkflowId: request.WorkflowExecution.WorkflowId,
@@ -1913,22 +2411,29 @@ func (e *historyEngineImpl) RequestCancelWorkflowExecution(ctx context.Context,
 
 func (e *historyEngineImpl) SignalWorkflowExecution(ctx context.Context, signalRequest *h.SignalWorkflowExecutionRequest) error {
 
-	domainEntry, err := e.getActiveDomainEntry(signalRequest.DomainUUID)
+	request := signalRequest.SignalRequest
+	parentExecution := signalRequest.ExternalWorkflowExecution
+	var domainEntry *cache.DomainCacheEntry
+	var err error
+	if parentExecution != nil {
+		// signal sent by the transfer queue of another workflow execution
+		domainEntry, err = e.getActiveDomainEntry(signalRequest.DomainUUID)
+	} else {
+		domainEntry, err = e.getActiveDomainEntryForExternalWrite(signalRequest.DomainUUID)
+	}
 	if err != nil {
 		return err
 	}
 	domainID := domainEntry.GetInfo().ID
 
-	request := signalRequest.SignalRequest
-	parentExecution := signalRequest.ExternalWorkflowExecution
 	childWorkflowOnly := signalRequest.GetChildWorkflowOnly()
 	execution := workflow.WorkflowExecution{
 		WorkflowId: request.WorkflowExecution.WorkflowId,
 		RunId:      request.WorkflowExecution.RunId,
 	}
 
-	if err := validateSignalInput(domainID, &execution, request.GetInput(), e.metricsClient,
-		metrics.HistorySignalWorkflowExecutionScope, e.logger); err != nil {
+	if err := e.validateSignalInput(domainEntry, &execution, request.GetInput(),
+		metrics.HistorySignalWorkflowExecutionScope); err != nil {
 		return err
 	}
 
@@ -1967,7 +2472,7 @@ func (e *historyEngineImpl) SignalWorkflowExecution(ctx context.Context, signalR
 func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx context.Context, signalWithStartRequest *h.SignalWithStartWorkflowExecutionRequest) (
 	retResp *workflow.StartWorkflowExecutionResponse, retError error) {
 
-	domainEntry, retError := e.getActiveDomainEntry(signalWithStartRequest.DomainUUID)
+	domainEntry, retError := e.getActiveDomainEntryForExternalWrite(signalWithStartRequest.DomainUUID)
 	if retError != nil {
 		return
 	}
@@ -1978,8 +2483,8 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx context.Context
 		WorkflowId: sRequest.WorkflowId,
 	}
 
-	if retError = validateSignalInput(domainID, &execution, sRequest.GetSignalInput(), e.metricsClient,
-		metrics.HistorySignalWithStartWorkflowExecutionScope, e.logger); retError != nil {
+	if retError = e.validateSignalInput(domainEntry, &execution, sRequest.GetSignalInput(),
+		metrics.HistorySignalWithStartWorkflowExecutionScope); retError != nil {
 		return
 	}
 
@@ -2005,6 +2510,11 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx context.Context
 				prevMutableState = msBuilder
 				break
 			}
+			// workflow is running but will be terminated, the new run is started then signaled
+			if sRequest.GetWorkflowIdReusePolicy() == workflow.WorkflowIdReusePolicyTerminateIfRunning {
+				prevMutableState = msBuilder
+				break
+			}
 			executionInfo := msBuilder.GetExecutionInfo()
 
 			if msBuilder.AddWorkflowExecutionSignaled(getSignalRequest(sRequest)) == nil {
@@ -2013,8 +2523,9 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx context.Context
 
 			var transferTasks []persistence.Task
 			var timerTasks []persistence.Task
-			// Create a transfer task to schedule a decision task
-			if !msBuilder.HasPendingDecisionTask() {
+			// Create a transfer task to schedule a decision task, unless the first decision is still backing off
+			// in which case the signal is delivered along with the first decision
+			if !msBuilder.HasPendingDecisionTask() && !msBuilder.HasPendingDecisionBackoff() {
 				di := msBuilder.AddDecisionTaskScheduledEvent()
 				if di == nil {
 					return nil, &workflow.InternalServiceError{Message: "Failed to add decision scheduled event."}
@@ -2059,7 +2570,10 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx context.Context
 		// workflow not exist, will create workflow then signal
 	}
 
-	// Start workflow and signal
+	// Start workflow and signal, the running executions of a deleted domain can still be signaled
+	if domainEntry.GetInfo().Status == persistence.DomainStatusDeleted {
+		return nil, ErrDomainDeleted
+	}
 	startRequest := getStartRequest(domainID, sRequest)
 	request := startRequest.StartRequest
 	retError = validateStartWorkflowExecutionRequest(request)
@@ -2089,14 +2603,17 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx context.Context
 				clusterMetadata.ClusterNameForFailoverVersion(prevMutableState.GetLastWriteVersion()),
 			)
 		}
-		policy := workflow.WorkflowIdReusePolicyAllowDuplicate
-		if request.WorkflowIdReusePolicy != nil {
-			policy = *request.WorkflowIdReusePolicy
-		}
+		// a running workflow is only left here to be terminated under the TerminateIfRunning policy
+		if !prevMutableState.IsWorkflowExecutionRunning() {
+			policy := workflow.WorkflowIdReusePolicyAllowDuplicate
+			if request.WorkflowIdReusePolicy != nil {
+				policy = *request.WorkflowIdReusePolicy
+			}
 
-		retError = e.applyWorkflowIDReusePolicyForSigWithStart(prevMutableState.GetExecutionInfo(), domainID, execution, policy)
-		if retError != nil {
-			return
+			retError = e.applyWorkflowIDReusePolicyForSigWithStart(prevMutableState.GetExecutionInfo(), domainID, execution, policy)
+			if retError != nil {
+				return
+			}
 		}
 	}
 
@@ -2111,19 +2628,14 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx context.Context
 	if msBuilder.AddWorkflowExecutionSignaled(getSignalRequest(sRequest)) == nil {
 		return nil, &workflow.InternalServiceError{Message: "Failed to add workflow execution signaled event."}
 	}
-	// first decision task
-	firstDecisionTask := msBuilder.AddDecisionTaskScheduledEvent()
-	if firstDecisionTask == nil {
-		return nil, &workflow.InternalServiceError{Message: "Failed to add decision scheduled event."}
+	// first decision task, the signal is delivered with the first decision once the start delay is over
+	transferTasks, firstDecisionTask, retError := e.generateFirstDecisionTask(domainID, msBuilder, nil, taskList,
+		request.GetDelayStartSeconds())
+	if retError != nil {
+		return
 	}
-	transferTasks := []persistence.Task{&persistence.DecisionTask{
-		DomainID: domainID, TaskList: taskList, ScheduleID: firstDecisionTask.ScheduleID,
-	}}
-	// first timer task
-	duration := time.Duration(*request.ExecutionStartToCloseTimeoutSeconds) * time.Second
-	timerTasks := []persistence.Task{&persistence.WorkflowTimeoutTask{
-		VisibilityTimestamp: e.shard.GetTimeSource().Now().Add(duration),
-	}}
+	// first timer tasks
+	timerTasks := e.generateFirstTimerTasks(request.GetExecutionStartToCloseTimeoutSeconds(), request.GetDelayStartSeconds())
 	// first replication task
 	replicationTasks := generateFirstReplicationTask(msBuilder, clusterMetadata, domainEntry)
 	// set versions and timestamp for timer and transfer tasks
@@ -2144,7 +2656,14 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx context.Context
 	msBuilder.IncrementHistorySize(historySize)
 	fullfillExecutionInfo(msBuilder, domainID, taskList, execution, startedEvent.GetEventId())
 
-	if prevMutableState != nil {
+	if prevMutableState != nil && prevMutableState.IsWorkflowExecutionRunning() {
+		createMode := persistence.CreateWorkflowModeContinueAsNew
+		prevRunID := prevMutableState.GetExecutionInfo().RunID
+		lastWriteVersion := prevMutableState.GetLastWriteVersion()
+		createRequest := newCreateWorkflowRequest(startRequest, msBuilder, createMode, prevRunID, lastWriteVersion,
+			firstDecisionTask, transferTasks, timerTasks, replicationTasks)
+		retError = e.terminateWorkflowForNewRun(context, prevMutableState, domainID, createRequest)
+	} else if prevMutableState != nil {
 		createMode := persistence.CreateWorkflowModeWorkflowIDReuse
 		prevRunID := prevMutableState.GetExecutionInfo().RunID
 		lastWriteVersion := prevMutableState.GetLastWriteVersion()
@@ -2202,7 +2721,7 @@ func (e *historyEngineImpl) RemoveSignalMutableState(ctx context.Context, reques
 
 func (e *historyEngineImpl) TerminateWorkflowExecution(ctx context.Context, terminateRequest *h.TerminateWorkflowExecutionRequest) error {
 
-	domainEntry, err := e.getActiveDomainEntry(terminateRequest.DomainUUID)
+	domainEntry, err := e.getActiveDomainEntryForExternalWrite(terminateRequest.DomainUUID)
 	if err != nil {
 		return err
 	}
@@ -2228,27 +2747,300 @@ func (e *historyEngineImpl) TerminateWorkflowExecution(ctx context.Context, term
 		})
 }
 
-// ScheduleDecisionTask schedules a decision if no outstanding decision found
-func (e *historyEngineImpl) ScheduleDecisionTask(ctx context.Context, scheduleRequest *h.ScheduleDecisionTaskRequest) error {
+func (e *historyEngineImpl) PauseWorkflowExecution(ctx context.Context, pauseRequest *h.PauseWorkflowExecutionRequest) error {
 
-	domainEntry, err := e.getActiveDomainEntry(scheduleRequest.DomainUUID)
+	domainEntry, err := e.getActiveDomainEntryForExternalWrite(pauseRequest.DomainUUID)
 	if err != nil {
 		return err
 	}
 	domainID := domainEntry.GetInfo().ID
 
+	request := pauseRequest.PauseRequest
 	execution := workflow.WorkflowExecution{
-		WorkflowId: scheduleRequest.WorkflowExecution.WorkflowId,
-		RunId:      scheduleRequest.WorkflowExecution.RunId,
+		WorkflowId: request.WorkflowExecution.WorkflowId,
+		RunId:      request.WorkflowExecution.RunId,
 	}
 
-	return e.updateWorkflowExecution(ctx, domainID, execution, false, true,
+	return e.updateWorkflowExecution(ctx, domainID, execution, false, false,
 		func(msBuilder mutableState, tBuilder *timerBuilder) ([]persistence.Task, error) {
 			if !msBuilder.IsWorkflowExecutionRunning() {
 				return nil, ErrWorkflowCompleted
 			}
 
-			// Noop
+			if msBuilder.IsPaused() {
+				return nil, ErrWorkflowAlreadyPaused
+			}
+
+			if msBuilder.AddWorkflowExecutionPausedEvent(request) == nil {
+				return nil, &workflow.InternalServiceError{Message: "Unable to pause workflow execution."}
+			}
+
+			return nil, nil
+		})
+}
+
+func (e *historyEngineImpl) ResumeWorkflowExecution(ctx context.Context, resumeRequest *h.ResumeWorkflowExecutionRequest) error {
+
+	domainEntry, err := e.getActiveDomainEntryForExternalWrite(resumeRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := resumeRequest.ResumeRequest
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.WorkflowExecution.WorkflowId,
+		RunId:      request.WorkflowExecution.RunId,
+	}
+
+	return e.updateWorkflowExecutionWithAction(ctx, domainID, execution,
+		func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
+			if !msBuilder.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+
+			if !msBuilder.IsPaused() {
+				return nil, ErrWorkflowNotPaused
+			}
+
+			if msBuilder.AddWorkflowExecutionResumedEvent(request) == nil {
+				return nil, &workflow.InternalServiceError{Message: "Unable to resume workflow execution."}
+			}
+
+			transferTasks, err := e.getHeldTransferTasks(domainID, msBuilder)
+			if err != nil {
+				return nil, err
+			}
+
+			return &updateWorkflowAction{transferTasks: transferTasks}, nil
+		})
+}
+
+// getHeldTransferTasks creates the transfer tasks for the decision and activities which are scheduled but not yet
+// started, those tasks were dropped by the transfer queue processor while the workflow execution was paused
+func (e *historyEngineImpl) getHeldTransferTasks(domainID string, msBuilder mutableState) ([]persistence.Task, error) {
+	var transferTasks []persistence.Task
+	if msBuilder.HasPendingDecisionTask() && !msBuilder.HasInFlightDecisionTask() {
+		di, _ := msBuilder.GetPendingDecision(msBuilder.GetExecutionInfo().DecisionScheduleID)
+		transferTasks = append(transferTasks, &persistence.DecisionTask{
+			DomainID:   domainID,
+			TaskList:   di.TaskList,
+			ScheduleID: di.ScheduleID,
+		})
+	}
+
+	now := e.shard.GetTimeSource().Now()
+	for _, ai := range msBuilder.GetPendingActivityInfos() {
+		// activities waiting for a retry timer are dispatched by the timer queue processor
+		if ai.StartedID != common.EmptyEventID || ai.ScheduledTime.After(now) {
+			continue
+		}
+
+		activityTask, err := e.getActivityTransferTask(domainID, msBuilder, ai)
+		if err != nil {
+			return nil, err
+		}
+		transferTasks = append(transferTasks, activityTask)
+	}
+
+	return transferTasks, nil
+}
+
+// getActivityTransferTask creates the transfer task to dispatch the activity to the domain it was scheduled in
+func (e *historyEngineImpl) getActivityTransferTask(domainID string, msBuilder mutableState,
+	ai *persistence.ActivityInfo) (persistence.Task, error) {
+	targetDomainID := domainID
+	scheduledEvent, ok := msBuilder.GetActivityScheduledEvent(ai.ScheduleID)
+	if !ok {
+		return nil, &workflow.InternalServiceError{Message: "Unable to get activity scheduled event."}
+	}
+	if scheduledEvent.ActivityTaskScheduledEventAttributes.Domain != nil {
+		domainEntry, err := e.shard.GetDomainCache().GetDomain(scheduledEvent.ActivityTaskScheduledEventAttributes.GetDomain())
+		if err != nil {
+			return nil, &workflow.InternalServiceError{Message: "Unable to re-schedule activity across domain."}
+		}
+		targetDomainID = domainEntry.GetInfo().ID
+	}
+
+	return &persistence.ActivityTask{
+		DomainID:   targetDomainID,
+		TaskList:   ai.TaskList,
+		ScheduleID: ai.ScheduleID,
+	}, nil
+}
+
+// RetryActivityTask dispatches a pending activity right away instead of waiting for its retry timer
+func (e *historyEngineImpl) RetryActivityTask(ctx context.Context, retryRequest *h.RetryActivityTaskRequest) error {
+
+	domainEntry, err := e.getActiveDomainEntryForExternalWrite(retryRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := retryRequest.RetryRequest
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.WorkflowExecution.WorkflowId,
+		RunId:      request.WorkflowExecution.RunId,
+	}
+
+	return e.updateWorkflowExecutionWithAction(ctx, domainID, execution,
+		func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
+			if !msBuilder.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+
+			scheduleID, err := getScheduleID(request.GetActivityID(), msBuilder)
+			if err != nil {
+				return nil, err
+			}
+			ai, isRunning := msBuilder.GetActivityInfo(scheduleID)
+			if !isRunning {
+				return nil, ErrActivityTaskNotFound
+			}
+			if ai.StartedID != common.EmptyEventID {
+				return nil, ErrActivityTaskAlreadyStarted
+			}
+
+			if msBuilder.AddActivityTaskRetryRequestedEvent(scheduleID, request) == nil {
+				return nil, &workflow.InternalServiceError{Message: "Unable to add ActivityTaskRetryRequested event to history."}
+			}
+
+			// the retry timer of the activity is ignored once the activity is started,
+			// a paused workflow execution holds the activity task until it is resumed
+			activityTask, err := e.getActivityTransferTask(domainID, msBuilder, ai)
+			if err != nil {
+				return nil, err
+			}
+			postActions := &updateWorkflowAction{
+				transferTasks: []persistence.Task{activityTask},
+			}
+			if tt := tBuilder.GetActivityTimerTaskIfNeeded(msBuilder); tt != nil {
+				postActions.timerTasks = append(postActions.timerTasks, tt)
+			}
+
+			return postActions, nil
+		})
+}
+
+// CompleteActivityTask completes a pending activity on behalf of an operator
+func (e *historyEngineImpl) CompleteActivityTask(ctx context.Context, completeRequest *h.CompleteActivityTaskRequest) error {
+
+	domainEntry, err := e.getActiveDomainEntryForExternalWrite(completeRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := completeRequest.CompleteRequest
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.WorkflowExecution.WorkflowId,
+		RunId:      request.WorkflowExecution.RunId,
+	}
+
+	return e.updateWorkflowExecution(ctx, domainID, execution, false, true,
+		func(msBuilder mutableState, tBuilder *timerBuilder) ([]persistence.Task, error) {
+			if !msBuilder.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+
+			ai, err := e.startActivityForOperator(msBuilder, request.GetActivityID(), request.GetIdentity())
+			if err != nil {
+				return nil, err
+			}
+
+			event := msBuilder.AddActivityTaskCompletedEvent(ai.ScheduleID, ai.StartedID, &workflow.RespondActivityTaskCompletedRequest{
+				Result:   request.Result,
+				Identity: request.Identity,
+			})
+			if event == nil {
+				return nil, &workflow.InternalServiceError{Message: "Unable to add ActivityTaskCompleted event to history."}
+			}
+			event.ActivityTaskCompletedEventAttributes.Reason = common.StringPtr(request.GetReason())
+
+			return nil, nil
+		})
+}
+
+// FailActivityTask fails a pending activity on behalf of an operator, the retry policy of the activity is not applied
+func (e *historyEngineImpl) FailActivityTask(ctx context.Context, failRequest *h.FailActivityTaskRequest) error {
+
+	domainEntry, err := e.getActiveDomainEntryForExternalWrite(failRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := failRequest.FailRequest
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.WorkflowExecution.WorkflowId,
+		RunId:      request.WorkflowExecution.RunId,
+	}
+
+	return e.updateWorkflowExecution(ctx, domainID, execution, false, true,
+		func(msBuilder mutableState, tBuilder *timerBuilder) ([]persistence.Task, error) {
+			if !msBuilder.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+
+			ai, err := e.startActivityForOperator(msBuilder, request.GetActivityID(), request.GetIdentity())
+			if err != nil {
+				return nil, err
+			}
+
+			if msBuilder.AddActivityTaskFailedEvent(ai.ScheduleID, ai.StartedID, &workflow.RespondActivityTaskFailedRequest{
+				Reason:   request.Reason,
+				Details:  request.Details,
+				Identity: request.Identity,
+			}) == nil {
+				return nil, &workflow.InternalServiceError{Message: "Unable to add ActivityTaskFailed event to history."}
+			}
+
+			return nil, nil
+		})
+}
+
+// startActivityForOperator looks up the pending activity by its activityID, an activity which is not picked up by
+// a worker yet is started on behalf of the operator so it can be closed
+func (e *historyEngineImpl) startActivityForOperator(msBuilder mutableState, activityID string,
+	identity string) (*persistence.ActivityInfo, error) {
+	scheduleID, err := getScheduleID(activityID, msBuilder)
+	if err != nil {
+		return nil, err
+	}
+	ai, isRunning := msBuilder.GetActivityInfo(scheduleID)
+	if !isRunning {
+		return nil, ErrActivityTaskNotFound
+	}
+
+	if ai.StartedID == common.EmptyEventID {
+		msBuilder.AddActivityTaskStartedEvent(ai, scheduleID, uuid.New(), identity)
+	}
+
+	return ai, nil
+}
+
+// ScheduleDecisionTask schedules a decision if no outstanding decision found
+func (e *historyEngineImpl) ScheduleDecisionTask(ctx context.Context, scheduleRequest *h.ScheduleDecisionTaskRequest) error {
+
+	domainEntry, err := e.getActiveDomainEntry(scheduleRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: scheduleRequest.WorkflowExecution.WorkflowId,
+		RunId:      scheduleRequest.WorkflowExecution.RunId,
+	}
+
+	return e.updateWorkflowExecution(ctx, domainID, execution, false, true,
+		func(msBuilder mutableState, tBuilder *timerBuilder) ([]persistence.Task, error) {
+			if !msBuilder.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+
+			// Noop
 
 			return nil, nil
 		})
@@ -2328,6 +3120,435 @@ func (e *historyEngineImpl) SyncActivity(ctx context.Context, request *h.SyncAct
 	return e.replicator.SyncActivity(ctx, request)
 }
 
+// GetReplicationMessages acknowledges the replication tasks processed by the polling cluster and
+// returns the replication tasks after the last retrieved one
+func (e *historyEngineImpl) GetReplicationMessages(ctx context.Context, pollingCluster string,
+	lastReadMessageID int64, lastProcessedMessageID int64) (*replicator.ReplicationMessages, error) {
+
+	if e.replicatorProcessor == nil {
+		return nil, &workflow.BadRequestError{Message: "Replication is not enabled on current cluster."}
+	}
+
+	if err := e.replicatorProcessor.ackTasks(pollingCluster, lastProcessedMessageID); err != nil {
+		e.logger.WithFields(bark.Fields{
+			logging.TagErr:           err,
+			logging.TagSourceCluster: pollingCluster,
+		}).Warn("Failed to ack replication tasks.")
+	}
+
+	return e.replicatorProcessor.getTasks(lastReadMessageID)
+}
+
+// GetReplicationStatus returns the replication status of the shard, only the pending replication tasks
+// of the given domain are counted if domainID is not empty
+func (e *historyEngineImpl) GetReplicationStatus(ctx context.Context, domainID string) (*h.ShardReplicationStatus, error) {
+	if e.replicatorProcessor == nil {
+		return nil, &workflow.BadRequestError{Message: "Replication is not enabled on current cluster."}
+	}
+
+	return e.replicatorProcessor.getStatus(domainID)
+}
+
+// DescribeShard returns the ack levels, read levels and outstanding task counts of the queues of the shard,
+// together with the pending failover levels
+func (e *historyEngineImpl) DescribeShard(ctx context.Context) (*h.DescribeShardResponse, error) {
+	queues := append(e.txProcessor.GetQueueStates(), e.timerProcessor.GetQueueStates()...)
+	if e.replicatorProcessor != nil {
+		queues = append(queues, e.replicatorProcessor.getQueueState(h.ShardQueueTypeReplication))
+	}
+
+	var failoverLevels []*h.ShardFailoverLevel
+	for failoverID, level := range e.shard.GetAllTransferFailoverLevels() {
+		failoverLevels = append(failoverLevels, &h.ShardFailoverLevel{
+			QueueType:    h.ShardQueueTypeTransfer.Ptr(),
+			FailoverID:   common.StringPtr(failoverID),
+			DomainIDs:    level.DomainIDs,
+			StartTime:    common.Int64Ptr(level.StartTime.UnixNano()),
+			MinLevel:     common.Int64Ptr(level.MinLevel),
+			CurrentLevel: common.Int64Ptr(level.CurrentLevel),
+			MaxLevel:     common.Int64Ptr(level.MaxLevel),
+		})
+	}
+	for failoverID, level := range e.shard.GetAllTimerFailoverLevels() {
+		failoverLevels = append(failoverLevels, &h.ShardFailoverLevel{
+			QueueType:    h.ShardQueueTypeTimer.Ptr(),
+			FailoverID:   common.StringPtr(failoverID),
+			DomainIDs:    level.DomainIDs,
+			StartTime:    common.Int64Ptr(level.StartTime.UnixNano()),
+			MinLevel:     common.Int64Ptr(level.MinLevel.UnixNano()),
+			CurrentLevel: common.Int64Ptr(level.CurrentLevel.UnixNano()),
+			MaxLevel:     common.Int64Ptr(level.MaxLevel.UnixNano()),
+		})
+	}
+
+	return &h.DescribeShardResponse{
+		ShardID:              common.Int32Ptr(int32(e.shard.GetShardID())),
+		TransferMaxReadLevel: common.Int64Ptr(e.shard.GetTransferMaxReadLevel()),
+		Queues:               queues,
+		FailoverLevels:       failoverLevels,
+	}, nil
+}
+
+// ListShardTasks returns a page of the tasks of a queue of the shard, starting from the persisted ack level
+func (e *historyEngineImpl) ListShardTasks(ctx context.Context,
+	request *h.ListShardTasksRequest) (*h.ListShardTasksResponse, error) {
+
+	pageSize := int(request.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = e.config.TransferTaskBatchSize()
+	}
+
+	var tasks []*h.ShardTask
+	var nextPageToken []byte
+	switch request.GetQueueType() {
+	case h.ShardQueueTypeTransfer:
+		response, err := e.executionManager.GetTransferTasks(&persistence.GetTransferTasksRequest{
+			ReadLevel:     e.shard.GetTransferAckLevel(),
+			MaxReadLevel:  e.shard.GetTransferMaxReadLevel(),
+			BatchSize:     pageSize,
+			NextPageToken: request.NextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, task := range response.Tasks {
+			tasks = append(tasks, &h.ShardTask{
+				TaskID:              common.Int64Ptr(task.TaskID),
+				TaskType:            common.Int32Ptr(int32(task.TaskType)),
+				DomainID:            common.StringPtr(task.DomainID),
+				WorkflowID:          common.StringPtr(task.WorkflowID),
+				RunID:               common.StringPtr(task.RunID),
+				VisibilityTimestamp: common.Int64Ptr(task.VisibilityTimestamp.UnixNano()),
+				Version:             common.Int64Ptr(task.Version),
+				EventID:             common.Int64Ptr(task.ScheduleID),
+			})
+		}
+		nextPageToken = response.NextPageToken
+
+	case h.ShardQueueTypeTimer:
+		response, err := e.executionManager.GetTimerIndexTasks(&persistence.GetTimerIndexTasksRequest{
+			MinTimestamp:  e.shard.GetTimerAckLevel(),
+			MaxTimestamp:  maximumTime,
+			BatchSize:     pageSize,
+			NextPageToken: request.NextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, task := range response.Timers {
+			tasks = append(tasks, &h.ShardTask{
+				TaskID:              common.Int64Ptr(task.TaskID),
+				TaskType:            common.Int32Ptr(int32(task.TaskType)),
+				DomainID:            common.StringPtr(task.DomainID),
+				WorkflowID:          common.StringPtr(task.WorkflowID),
+				RunID:               common.StringPtr(task.RunID),
+				VisibilityTimestamp: common.Int64Ptr(task.VisibilityTimestamp.UnixNano()),
+				Version:             common.Int64Ptr(task.Version),
+				EventID:             common.Int64Ptr(task.EventID),
+			})
+		}
+		nextPageToken = response.NextPageToken
+
+	case h.ShardQueueTypeReplication:
+		response, err := e.executionManager.GetReplicationTasks(&persistence.GetReplicationTasksRequest{
+			ReadLevel:     e.shard.GetReplicatorAckLevel(),
+			MaxReadLevel:  e.shard.GetTransferMaxReadLevel(),
+			BatchSize:     pageSize,
+			NextPageToken: request.NextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, task := range response.Tasks {
+			tasks = append(tasks, &h.ShardTask{
+				TaskID:     common.Int64Ptr(task.TaskID),
+				TaskType:   common.Int32Ptr(int32(task.TaskType)),
+				DomainID:   common.StringPtr(task.DomainID),
+				WorkflowID: common.StringPtr(task.WorkflowID),
+				RunID:      common.StringPtr(task.RunID),
+				Version:    common.Int64Ptr(task.Version),
+				EventID:    common.Int64Ptr(task.FirstEventID),
+			})
+		}
+		nextPageToken = response.NextPageToken
+
+	default:
+		return nil, &workflow.BadRequestError{Message: fmt.Sprintf("Unknown queue type: %v.", request.GetQueueType())}
+	}
+
+	return &h.ListShardTasksResponse{
+		Tasks:         tasks,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// RemoveShardTask deletes a transfer or timer task of the shard and acks it in the queue processors,
+// so the ack level can move past a task which can never be processed
+func (e *historyEngineImpl) RemoveShardTask(ctx context.Context, request *h.RemoveShardTaskRequest) error {
+	switch request.GetQueueType() {
+	case h.ShardQueueTypeTransfer:
+		task, err := e.getTransferTask(request.GetTaskID())
+		if err != nil {
+			return err
+		}
+		if err := e.executionManager.CompleteTransferTask(&persistence.CompleteTransferTaskRequest{
+			TaskID: task.TaskID,
+		}); err != nil {
+			return err
+		}
+		e.txProcessor.CompleteTask(task.TaskID)
+
+	case h.ShardQueueTypeTimer:
+		task, err := e.getTimerTask(request.VisibilityTimestamp, request.GetTaskID())
+		if err != nil {
+			return err
+		}
+		if err := e.executionManager.CompleteTimerTask(&persistence.CompleteTimerTaskRequest{
+			VisibilityTimestamp: task.VisibilityTimestamp,
+			TaskID:              task.TaskID,
+		}); err != nil {
+			return err
+		}
+		e.timerProcessor.CompleteTimer(task)
+
+	default:
+		return &workflow.BadRequestError{Message: "Only transfer and timer tasks can be removed."}
+	}
+
+	e.logger.WithFields(bark.Fields{
+		logging.TagHistoryShardID: e.shard.GetShardID(),
+		logging.TagTaskID:         request.GetTaskID(),
+	}).Warnf("%v task is removed by operator.", request.GetQueueType())
+	return nil
+}
+
+// RescheduleShardTask processes a transfer or timer task of the shard right away, and acks it if the processing succeeds
+func (e *historyEngineImpl) RescheduleShardTask(ctx context.Context, request *h.RescheduleShardTaskRequest) error {
+	switch request.GetQueueType() {
+	case h.ShardQueueTypeTransfer:
+		task, err := e.getTransferTask(request.GetTaskID())
+		if err != nil {
+			return err
+		}
+		return e.txProcessor.RescheduleTask(task)
+
+	case h.ShardQueueTypeTimer:
+		task, err := e.getTimerTask(request.VisibilityTimestamp, request.GetTaskID())
+		if err != nil {
+			return err
+		}
+		return e.timerProcessor.RescheduleTimer(task)
+
+	default:
+		return &workflow.BadRequestError{Message: "Only transfer and timer tasks can be rescheduled."}
+	}
+}
+
+func (e *historyEngineImpl) getTransferTask(taskID int64) (*persistence.TransferTaskInfo, error) {
+	// the read level is exclusive and the max read level is inclusive
+	response, err := e.executionManager.GetTransferTasks(&persistence.GetTransferTasksRequest{
+		ReadLevel:    taskID - 1,
+		MaxReadLevel: taskID,
+		BatchSize:    1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Tasks) == 0 || response.Tasks[0].TaskID != taskID {
+		return nil, ErrShardTaskNotFound
+	}
+	return response.Tasks[0], nil
+}
+
+func (e *historyEngineImpl) getTimerTask(visibilityTimestamp *int64, taskID int64) (*persistence.TimerTaskInfo, error) {
+	if visibilityTimestamp == nil {
+		return nil, &workflow.BadRequestError{Message: "Visibility timestamp is required to locate a timer task."}
+	}
+
+	// timers are persisted with millisecond precision
+	minTimestamp := time.Unix(0, *visibilityTimestamp)
+	request := &persistence.GetTimerIndexTasksRequest{
+		MinTimestamp: minTimestamp,
+		MaxTimestamp: minTimestamp.Add(time.Millisecond),
+		BatchSize:    e.config.TimerTaskBatchSize(),
+	}
+	for {
+		response, err := e.executionManager.GetTimerIndexTasks(request)
+		if err != nil {
+			return nil, err
+		}
+		for _, task := range response.Timers {
+			if task.TaskID == taskID {
+				return task, nil
+			}
+		}
+		if len(response.NextPageToken) == 0 {
+			return nil, ErrShardTaskNotFound
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+}
+
+// ListQuarantinedTasks returns the transfer or timer tasks of the shard which were moved to the quarantine
+func (e *historyEngineImpl) ListQuarantinedTasks(ctx context.Context,
+	request *h.ListQuarantinedTasksRequest) (*h.ListQuarantinedTasksResponse, error) {
+
+	quarantineMgr := e.shard.GetQuarantineManager()
+	if quarantineMgr == nil {
+		return nil, errQuarantineNotSupported
+	}
+	queueType, err := getQuarantineQueueType(request.GetQueueType())
+	if err != nil {
+		return nil, err
+	}
+	pageSize := int(request.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = e.config.TransferTaskBatchSize()
+	}
+
+	response, err := quarantineMgr.GetQuarantinedTasks(&persistence.GetQuarantinedTasksRequest{
+		ShardID:       e.shard.GetShardID(),
+		QueueType:     queueType,
+		BatchSize:     pageSize,
+		NextPageToken: request.NextPageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var tasks []*h.QuarantinedTask
+	for _, task := range response.Tasks {
+		quarantinedTask := &h.QuarantinedTask{
+			QueueType:            request.QueueType,
+			TaskID:               common.Int64Ptr(task.TaskID),
+			TaskType:             common.Int32Ptr(int32(task.TaskType)),
+			DomainID:             common.StringPtr(task.DomainID),
+			WorkflowID:           common.StringPtr(task.WorkflowID),
+			RunID:                common.StringPtr(task.RunID),
+			Attempt:              common.Int32Ptr(int32(task.Attempt)),
+			QuarantinedTimestamp: common.Int64Ptr(task.CreatedTime.UnixNano()),
+			Error:                common.StringPtr(task.Error),
+			StackTrace:           common.StringPtr(task.StackTrace),
+		}
+		if queueType == persistence.QuarantineQueueTypeTimer {
+			quarantinedTask.VisibilityTimestamp = common.Int64Ptr(task.VisibilityTimestamp.UnixNano())
+		}
+		tasks = append(tasks, quarantinedTask)
+	}
+
+	return &h.ListQuarantinedTasksResponse{
+		Tasks:         tasks,
+		NextPageToken: response.NextPageToken,
+	}, nil
+}
+
+// RetryQuarantinedTask processes a quarantined task of the shard right away, and removes it from the quarantine
+// if the processing succeeds
+func (e *historyEngineImpl) RetryQuarantinedTask(ctx context.Context, request *h.RetryQuarantinedTaskRequest) error {
+	quarantineMgr := e.shard.GetQuarantineManager()
+	if quarantineMgr == nil {
+		return errQuarantineNotSupported
+	}
+	queueType, err := getQuarantineQueueType(request.GetQueueType())
+	if err != nil {
+		return err
+	}
+	visibilityTimestamp, err := getQuarantinedTaskTimestamp(queueType, request.VisibilityTimestamp)
+	if err != nil {
+		return err
+	}
+
+	response, err := quarantineMgr.GetQuarantinedTask(&persistence.GetQuarantinedTaskRequest{
+		ShardID:             e.shard.GetShardID(),
+		QueueType:           queueType,
+		TaskID:              request.GetTaskID(),
+		VisibilityTimestamp: visibilityTimestamp,
+	})
+	if err != nil {
+		return err
+	}
+	task, err := deserializeQuarantinedTask(response.TaskInfo)
+	if err != nil {
+		return err
+	}
+
+	switch task := task.(type) {
+	case *persistence.TransferTaskInfo:
+		err = e.txProcessor.RescheduleTask(task)
+	case *persistence.TimerTaskInfo:
+		err = e.timerProcessor.RescheduleTimer(task)
+	}
+	if err != nil {
+		return err
+	}
+
+	return quarantineMgr.DeleteQuarantinedTask(&persistence.DeleteQuarantinedTaskRequest{
+		ShardID:             e.shard.GetShardID(),
+		QueueType:           queueType,
+		TaskID:              request.GetTaskID(),
+		VisibilityTimestamp: visibilityTimestamp,
+	})
+}
+
+// DropQuarantinedTask removes a quarantined task of the shard without processing it
+func (e *historyEngineImpl) DropQuarantinedTask(ctx context.Context, request *h.DropQuarantinedTaskRequest) error {
+	quarantineMgr := e.shard.GetQuarantineManager()
+	if quarantineMgr == nil {
+		return errQuarantineNotSupported
+	}
+	queueType, err := getQuarantineQueueType(request.GetQueueType())
+	if err != nil {
+		return err
+	}
+	visibilityTimestamp, err := getQuarantinedTaskTimestamp(queueType, request.VisibilityTimestamp)
+	if err != nil {
+		return err
+	}
+
+	if err := quarantineMgr.DeleteQuarantinedTask(&persistence.DeleteQuarantinedTaskRequest{
+		ShardID:             e.shard.GetShardID(),
+		QueueType:           queueType,
+		TaskID:              request.GetTaskID(),
+		VisibilityTimestamp: visibilityTimestamp,
+	}); err != nil {
+		return err
+	}
+
+	e.logger.WithFields(bark.Fields{
+		logging.TagHistoryShardID: e.shard.GetShardID(),
+		logging.TagTaskID:         request.GetTaskID(),
+	}).Warnf("Quarantined %v task is dropped by operator.", request.GetQueueType())
+	return nil
+}
+
+// GetWorkflowReplicationTasks returns the history replication tasks of the given event range of a workflow execution,
+// it is called by the standby clusters to resend the history events they are missing
+func (e *historyEngineImpl) GetWorkflowReplicationTasks(ctx context.Context,
+	request *h.GetWorkflowReplicationTasksRequest) (*h.GetWorkflowReplicationTasksResponse, error) {
+
+	if e.replicatorProcessor == nil {
+		return nil, &workflow.BadRequestError{Message: "Replication is not enabled on current cluster."}
+	}
+
+	domainID, err := validateDomainUUID(request.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.Execution.WorkflowId,
+		RunId:      request.Execution.RunId,
+	}
+	tasks, err := e.replicatorProcessor.getWorkflowTasks(ctx, domainID, execution, request.GetFirstEventId(), request.GetNextEventId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &h.GetWorkflowReplicationTasksResponse{
+		ReplicationTasks: tasks,
+	}, nil
+}
+
 type updateWorkflowAction struct {
 	deleteWorkflow bool
 	createDecision bool
@@ -2376,8 +3597,9 @@ Update_History_Loop:
 		}
 
 		if postActions.createDecision {
-			// Create a transfer task to schedule a decision task
-			if !msBuilder.HasPendingDecisionTask() {
+			// Create a transfer task to schedule a decision task, unless the first decision is still backing off
+			// in which case the events are delivered along with the first decision
+			if !msBuilder.HasPendingDecisionTask() && !msBuilder.HasPendingDecisionBackoff() {
 				di := msBuilder.AddDecisionTaskScheduledEvent()
 				if di == nil {
 					return &workflow.InternalServiceError{Message: "Failed to add decision scheduled event."}
@@ -2831,28 +4053,25 @@ func validateStartWorkflowExecutionRequest(request *workflow.StartWorkflowExecut
 	if request.TaskList == nil || request.TaskList.Name == nil || request.TaskList.GetName() == "" {
 		return &workflow.BadRequestError{Message: "Missing Tasklist."}
 	}
+	if request.GetDelayStartSeconds() < 0 {
+		return &workflow.BadRequestError{Message: "Invalid DelayStartSeconds."}
+	}
 	return common.ValidateRetryPolicy(request.RetryPolicy)
 }
 
-func validateSignalInput(domainID string, execution *workflow.WorkflowExecution, signalInput []byte, metricsClient metrics.Client, scope int, logger bark.Logger) error {
+func (e *historyEngineImpl) validateSignalInput(domainEntry *cache.DomainCacheEntry, execution *workflow.WorkflowExecution,
+	signalInput []byte, scope int) error {
 	size := len(signalInput)
-	metricsClient.RecordTimer(
+	e.metricsClient.RecordTimer(
 		scope,
 		metrics.SignalSizeTimer,
 		time.Duration(size),
 	)
 
-	if size > signalInputWarnSizeLimit {
-		logger.WithFields(bark.Fields{
-			logging.TagDomainID:            domainID,
-			logging.TagWorkflowExecutionID: execution.GetWorkflowId(),
-			logging.TagWorkflowRunID:       execution.GetRunId(),
-			logging.TagSize:                size,
-		}).Warn("Large signal size encountered.")
-
-		if size > signalInputErrSizeLimit {
-			return ErrSignalOverSize
-		}
+	sizeChecker := newWorkflowSizeChecker(domainEntry.GetInfo().ID, domainEntry.GetInfo().Name, execution, e.config,
+		e.metricsClient, scope, e.logger)
+	if sizeChecker.blobSizeLimitExceeded(size) {
+		return ErrSignalOverSize
 	}
 	return nil
 }
@@ -2870,6 +4089,21 @@ func (e *historyEngineImpl) getActiveDomainEntry(domainUUID *string) (*cache.Dom
 	return getActiveDomainEntryFromShard(e.shard, domainUUID)
 }
 
+// getActiveDomainEntryForExternalWrite is the same as getActiveDomainEntry for the writes which are not part of the
+// progress of existing workflow executions, e.g. starting or signaling a workflow execution from outside. Those writes
+// are rejected while the domain is draining for a graceful failover, workers and the workflow executions themselves
+// can still respond to and create the tasks already dispatched so that the domain can drain.
+func (e *historyEngineImpl) getActiveDomainEntryForExternalWrite(domainUUID *string) (*cache.DomainCacheEntry, error) {
+	domainEntry, err := getActiveDomainEntryFromShard(e.shard, domainUUID)
+	if err != nil {
+		return nil, err
+	}
+	if err = domainEntry.GetDomainPendingFailoverErr(); err != nil {
+		return nil, err
+	}
+	return domainEntry, nil
+}
+
 func getActiveDomainEntryFromShard(shard ShardContext, domainUUID *string) (*cache.DomainCacheEntry, error) {
 	domainID, err := validateDomainUUID(domainUUID)
 	if err != nil {
@@ -2926,12 +4160,42 @@ func getStartRequest(domainID string,
 		RequestId:                           request.RequestId,
 		WorkflowIdReusePolicy:               request.WorkflowIdReusePolicy,
 		RetryPolicy:                         request.RetryPolicy,
+		DelayStartSeconds:                   request.DelayStartSeconds,
 	}
 
 	startRequest := common.CreateHistoryStartWorkflowRequest(domainID, req)
 	return startRequest
 }
 
+// deleteWorkflowHistory deletes the history of a workflow execution, along with the events v1 history left in
+// place by the migration of the execution to events v2
+func deleteWorkflowHistory(historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager,
+	domainID string, execution workflow.WorkflowExecution, msBuilder mutableState) error {
+
+	deleteV1 := func() error {
+		return historyMgr.DeleteWorkflowExecutionHistory(&persistence.DeleteWorkflowExecutionHistoryRequest{
+			DomainID:  domainID,
+			Execution: execution,
+		})
+	}
+	if msBuilder.GetEventStoreVersion() != persistence.EventStoreVersionV2 {
+		return backoff.Retry(deleteV1, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
+	}
+
+	deleteV2 := func() error {
+		return historyV2Mgr.DeleteHistoryBranch(&persistence.DeleteHistoryBranchRequest{
+			BranchToken: msBuilder.GetCurrentBranch(),
+		})
+	}
+	if err := backoff.Retry(deleteV2, persistenceOperationRetryPolicy, common.IsPersistenceTransientError); err != nil {
+		return err
+	}
+	if msBuilder.GetExecutionInfo().HasEventsV1History {
+		return backoff.Retry(deleteV1, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
+	}
+	return nil
+}
+
 func getWorkflowStartedEvent(historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager, eventStoreVersion int32, branchToken []byte, logger bark.Logger, domainID, workflowID, runID string) (*workflow.HistoryEvent, error) {
 	var events []*workflow.HistoryEvent
 	if eventStoreVersion == persistence.EventStoreVersionV2 {
@@ -3021,7 +4285,7 @@ func (e *historyEngineImpl) applyWorkflowIDReusePolicyHelper(prevStartRequestID,
 			msg := "Workflow execution already finished successfully. WorkflowId: %v, RunId: %v. Workflow ID reuse policy: allow duplicate workflow ID if last run failed."
 			return getWorkflowAlreadyStartedError(msg, prevStartRequestID, execution.GetWorkflowId(), prevRunID)
 		}
-	case workflow.WorkflowIdReusePolicyAllowDuplicate:
+	case workflow.WorkflowIdReusePolicyAllowDuplicate, workflow.WorkflowIdReusePolicyTerminateIfRunning:
 		// as long as workflow not running, so this case has no check
 	case workflow.WorkflowIdReusePolicyRejectDuplicate:
 		msg := "Workflow execution already finished. WorkflowId: %v, RunId: %v. Workflow ID reuse policy: reject duplicate workflow ID."
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/persistence"
@@ -53,6 +54,9 @@ type (
 			error)
 		GetMutableState(ctx context.Context, request *h.GetMutableStateRequest) (*h.GetMutableStateResponse, error)
 		DescribeMutableState(ctx context.Context, request *h.DescribeMutableStateRequest) (*h.DescribeMutableStateResponse, error)
+		MigrateWorkflowHistory(ctx context.Context, request *h.MigrateWorkflowHistoryRequest) (*h.MigrateWorkflowHistoryResponse, error)
+		DeleteWorkflowExecution(ctx context.Context, request *h.DeleteWorkflowExecutionRequest) (*h.DeleteWorkflowExecutionResponse, error)
+		GetWorkflowReplicationTasks(ctx context.Context, request *h.GetWorkflowReplicationTasksRequest) (*h.GetWorkflowReplicationTasksResponse, error)
 		ResetStickyTaskList(ctx context.Context, resetRequest *h.ResetStickyTaskListRequest) (*h.ResetStickyTaskListResponse, error)
 		DescribeWorkflowExecution(ctx context.Context,
 			request *h.DescribeWorkflowExecutionRequest) (*workflow.DescribeWorkflowExecutionResponse, error)
@@ -70,11 +74,26 @@ type (
 			*workflow.StartWorkflowExecutionResponse, error)
 		RemoveSignalMutableState(ctx context.Context, request *h.RemoveSignalMutableStateRequest) error
 		TerminateWorkflowExecution(ctx context.Context, request *h.TerminateWorkflowExecutionRequest) error
+		PauseWorkflowExecution(ctx context.Context, request *h.PauseWorkflowExecutionRequest) error
+		ResumeWorkflowExecution(ctx context.Context, request *h.ResumeWorkflowExecutionRequest) error
+		RetryActivityTask(ctx context.Context, request *h.RetryActivityTaskRequest) error
+		CompleteActivityTask(ctx context.Context, request *h.CompleteActivityTaskRequest) error
+		FailActivityTask(ctx context.Context, request *h.FailActivityTaskRequest) error
 		ScheduleDecisionTask(ctx context.Context, request *h.ScheduleDecisionTaskRequest) error
 		RecordChildExecutionCompleted(ctx context.Context, request *h.RecordChildExecutionCompletedRequest) error
 		ReplicateEvents(ctx context.Context, request *h.ReplicateEventsRequest) error
 		SyncShardStatus(ctx context.Context, request *h.SyncShardStatusRequest) error
 		SyncActivity(ctx context.Context, request *h.SyncActivityRequest) error
+		GetReplicationMessages(ctx context.Context, pollingCluster string, lastReadMessageID int64,
+			lastProcessedMessageID int64) (*replicator.ReplicationMessages, error)
+		GetReplicationStatus(ctx context.Context, domainID string) (*h.ShardReplicationStatus, error)
+		DescribeShard(ctx context.Context) (*h.DescribeShardResponse, error)
+		ListShardTasks(ctx context.Context, request *h.ListShardTasksRequest) (*h.ListShardTasksResponse, error)
+		RemoveShardTask(ctx context.Context, request *h.RemoveShardTaskRequest) error
+		RescheduleShardTask(ctx context.Context, request *h.RescheduleShardTaskRequest) error
+		ListQuarantinedTasks(ctx context.Context, request *h.ListQuarantinedTasksRequest) (*h.ListQuarantinedTasksResponse, error)
+		RetryQuarantinedTask(ctx context.Context, request *h.RetryQuarantinedTaskRequest) error
+		DropQuarantinedTask(ctx context.Context, request *h.DropQuarantinedTaskRequest) error
 	}
 
 	// EngineFactory is used to create an instance of sharded history engine
@@ -87,12 +106,23 @@ type (
 		notifyNewTask()
 	}
 
+	replicatorQueueProcessor interface {
+		queueProcessor
+		getTasks(readLevel int64) (*replicator.ReplicationMessages, error)
+		ackTasks(clusterName string, lastProcessedTaskID int64) error
+		getStatus(domainID string) (*h.ShardReplicationStatus, error)
+		getQueueState(queueType h.ShardQueueType) *h.ShardQueueState
+		getWorkflowTasks(ctx context.Context, domainID string, execution workflow.WorkflowExecution, firstEventID, nextEventID int64) ([]*replicator.ReplicationTask, error)
+	}
+
 	queueAckMgr interface {
 		getFinishedChan() <-chan struct{}
 		readQueueTasks() ([]queueTaskInfo, bool, error)
 		completeQueueTask(taskID int64)
 		getQueueAckLevel() int64
 		getQueueReadLevel() int64
+		getQueueOutstandingTaskCount() int
+		isQueueTaskOutstanding(taskID int64) bool
 		updateQueueAckLevel()
 	}
 
@@ -114,6 +144,9 @@ type (
 		common.Daemon
 		FailoverDomain(domainID string)
 		NotifyNewTask(clusterName string, transferTasks []persistence.Task)
+		GetQueueStates() []*h.ShardQueueState
+		CompleteTask(taskID int64)
+		RescheduleTask(task *persistence.TransferTaskInfo) error
 	}
 
 	// TODO the timer queue processor and the one below, timer processor
@@ -123,6 +156,9 @@ type (
 		common.Daemon
 		FailoverDomain(domainID string)
 		NotifyNewTimers(clusterName string, currentTime time.Time, timerTask []persistence.Task)
+		GetQueueStates() []*h.ShardQueueState
+		CompleteTimer(timerTask *persistence.TimerTaskInfo)
+		RescheduleTimer(timerTask *persistence.TimerTaskInfo) error
 	}
 
 	timerProcessor interface {
@@ -137,6 +173,8 @@ type (
 		completeTimerTask(timerTask *persistence.TimerTaskInfo)
 		getAckLevel() TimerSequenceID
 		getReadLevel() TimerSequenceID
+		getOutstandingTaskCount() int
+		isTimerTaskOutstanding(timerTask *persistence.TimerTaskInfo) bool
 		updateAckLevel()
 	}
 
@@ -62,6 +62,7 @@ type (
 		mockVisibilityMgr   *mocks.VisibilityManager
 		mockExecutionMgr    *mocks.ExecutionManager
 		mockHistoryMgr      *mocks.HistoryManager
+		mockHistoryV2Mgr    *mocks.HistoryV2Manager
 		mockShardManager    *mocks.ShardManager
 		mockClusterMetadata *mocks.ClusterMetadata
 		mockProducer        *mocks.KafkaProducer
@@ -106,6 +107,7 @@ func (s *engineSuite) SetupTest() {
 	s.mockVisibilityMgr = &mocks.VisibilityManager{}
 	s.mockExecutionMgr = &mocks.ExecutionManager{}
 	s.mockHistoryMgr = &mocks.HistoryManager{}
+	s.mockHistoryV2Mgr = &mocks.HistoryV2Manager{}
 	s.mockShardManager = &mocks.ShardManager{}
 	s.mockClusterMetadata = &mocks.ClusterMetadata{}
 	s.mockProducer = &mocks.KafkaProducer{}
@@ -129,6 +131,7 @@ func (s *engineSuite) SetupTest() {
 		transferSequenceNumber:    1,
 		executionManager:          s.mockExecutionMgr,
 		historyMgr:                s.mockHistoryMgr,
+		historyV2Mgr:              s.mockHistoryV2Mgr,
 		domainCache:               domainCache,
 		shardManager:              s.mockShardManager,
 		maxTransferSequenceNumber: 100000,
@@ -154,6 +157,7 @@ func (s *engineSuite) SetupTest() {
 		shard:                shardContextWrapper,
 		executionManager:     s.mockExecutionMgr,
 		historyMgr:           s.mockHistoryMgr,
+		historyV2Mgr:         s.mockHistoryV2Mgr,
 		historyCache:         historyCache,
 		logger:               s.logger,
 		metricsClient:        metrics.NewClient(tally.NoopScope, metrics.History),
@@ -173,6 +177,7 @@ func (s *engineSuite) TearDownTest() {
 	s.mockMatchingClient.AssertExpectations(s.T())
 	s.mockExecutionMgr.AssertExpectations(s.T())
 	s.mockHistoryMgr.AssertExpectations(s.T())
+	s.mockHistoryV2Mgr.AssertExpectations(s.T())
 	s.mockShardManager.AssertExpectations(s.T())
 	s.mockVisibilityMgr.AssertExpectations(s.T())
 	s.mockClusterMetadata.AssertExpectations(s.T())
@@ -4043,6 +4048,135 @@ func (s *engineSuite) TestStarTimer_DuplicateTimerID() {
 	s.Equal(int64(1), di3.Attempt)
 }
 
+func (s *engineSuite) TestRespondDecisionTaskCompleted_PendingLimitExceeded() {
+	s.mockHistoryEngine.config.PendingTimersLimitWarn = func(domain string) int { return 0 }
+	s.mockHistoryEngine.config.PendingTimersLimitError = func(domain string) int { return 0 }
+
+	decisions := []*workflow.Decision{{
+		DecisionType: common.DecisionTypePtr(workflow.DecisionTypeStartTimer),
+		StartTimerDecisionAttributes: &workflow.StartTimerDecisionAttributes{
+			TimerId:                   common.StringPtr("t1"),
+			StartToFireTimeoutSeconds: common.Int64Ptr(1),
+		},
+	}}
+	_, failedCause, executionBuilder := s.respondDecisionTaskCompletedOverLimit(decisions, true)
+
+	s.NotNil(failedCause)
+	s.Equal(workflow.DecisionTaskFailedCauseWorkflowLimitExceeded, *failedCause)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.GetExecutionInfo().State)
+	s.Equal(0, len(executionBuilder.GetPendingTimerInfos()))
+	s.True(executionBuilder.HasPendingDecisionTask())
+}
+
+func (s *engineSuite) TestRespondDecisionTaskCompleted_PendingLimitExceeded_Terminate() {
+	s.mockHistoryEngine.config.PendingTimersLimitWarn = func(domain string) int { return 0 }
+	s.mockHistoryEngine.config.PendingTimersLimitError = func(domain string) int { return 0 }
+	s.mockHistoryEngine.config.PendingLimitMaxDecisionAttempts = func(domain string) int { return 1 }
+
+	decisions := []*workflow.Decision{{
+		DecisionType: common.DecisionTypePtr(workflow.DecisionTypeStartTimer),
+		StartTimerDecisionAttributes: &workflow.StartTimerDecisionAttributes{
+			TimerId:                   common.StringPtr("t1"),
+			StartToFireTimeoutSeconds: common.Int64Ptr(1),
+		},
+	}}
+	_, _, executionBuilder := s.respondDecisionTaskCompletedOverLimit(decisions, true)
+
+	s.Equal(persistence.WorkflowStateCompleted, executionBuilder.GetExecutionInfo().State)
+	s.Equal(persistence.WorkflowCloseStatusTerminated, executionBuilder.GetExecutionInfo().CloseStatus)
+	s.Equal(0, len(executionBuilder.GetPendingTimerInfos()))
+	s.False(executionBuilder.HasPendingDecisionTask())
+}
+
+func (s *engineSuite) TestRespondDecisionTaskCompleted_PendingActivitiesLimitExceeded() {
+	tl := "testTaskList"
+	s.mockHistoryEngine.config.PendingActivitiesLimitWarn = func(domain string) int { return 0 }
+	s.mockHistoryEngine.config.PendingActivitiesLimitError = func(domain string) int { return 0 }
+
+	decisions := []*workflow.Decision{{
+		DecisionType: common.DecisionTypePtr(workflow.DecisionTypeScheduleActivityTask),
+		ScheduleActivityTaskDecisionAttributes: &workflow.ScheduleActivityTaskDecisionAttributes{
+			ActivityId:                    common.StringPtr("activity1"),
+			ActivityType:                  &workflow.ActivityType{Name: common.StringPtr("activity_type1")},
+			TaskList:                      &workflow.TaskList{Name: &tl},
+			Input:                         []byte("input"),
+			ScheduleToCloseTimeoutSeconds: common.Int32Ptr(100),
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(10),
+			StartToCloseTimeoutSeconds:    common.Int32Ptr(50),
+			HeartbeatTimeoutSeconds:       common.Int32Ptr(5),
+		},
+	}}
+	updateRequest, failedCause, executionBuilder := s.respondDecisionTaskCompletedOverLimit(decisions, true)
+
+	s.NotNil(failedCause)
+	s.Equal(workflow.DecisionTaskFailedCauseWorkflowLimitExceeded, *failedCause)
+	s.Equal(0, len(executionBuilder.GetPendingActivityInfos()))
+	// the activity task of the discarded decision must not be persisted
+	s.Equal(1, len(updateRequest.TransferTasks))
+	s.IsType(&persistence.DecisionTask{}, updateRequest.TransferTasks[0])
+}
+
+func (s *engineSuite) TestRespondDecisionTaskCompleted_BlobSizeLimitExceeded() {
+	tl := "testTaskList"
+	s.mockHistoryEngine.config.BlobSizeLimitWarn = func(domain string) int { return 1 }
+	s.mockHistoryEngine.config.BlobSizeLimitError = func(domain string) int { return 2 }
+
+	decisions := []*workflow.Decision{{
+		DecisionType: common.DecisionTypePtr(workflow.DecisionTypeScheduleActivityTask),
+		ScheduleActivityTaskDecisionAttributes: &workflow.ScheduleActivityTaskDecisionAttributes{
+			ActivityId:                    common.StringPtr("activity1"),
+			ActivityType:                  &workflow.ActivityType{Name: common.StringPtr("activity_type1")},
+			TaskList:                      &workflow.TaskList{Name: &tl},
+			Input:                         []byte("input"),
+			ScheduleToCloseTimeoutSeconds: common.Int32Ptr(100),
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(10),
+			StartToCloseTimeoutSeconds:    common.Int32Ptr(50),
+			HeartbeatTimeoutSeconds:       common.Int32Ptr(5),
+		},
+	}}
+	_, failedCause, executionBuilder := s.respondDecisionTaskCompletedOverLimit(decisions, true)
+
+	s.NotNil(failedCause)
+	s.Equal(workflow.DecisionTaskFailedCauseBadScheduleActivityAttributes, *failedCause)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.GetExecutionInfo().State)
+	s.Equal(0, len(executionBuilder.GetPendingActivityInfos()))
+	s.True(executionBuilder.HasPendingDecisionTask())
+}
+
+func (s *engineSuite) TestRespondDecisionTaskCompleted_HistoryLimitExceeded() {
+	s.mockHistoryEngine.config.HistoryCountLimitWarn = func(domain string) int { return 1 }
+	s.mockHistoryEngine.config.HistoryCountLimitError = func(domain string) int { return 2 }
+
+	decisions := []*workflow.Decision{{
+		DecisionType: common.DecisionTypePtr(workflow.DecisionTypeStartTimer),
+		StartTimerDecisionAttributes: &workflow.StartTimerDecisionAttributes{
+			TimerId:                   common.StringPtr("t1"),
+			StartToFireTimeoutSeconds: common.Int64Ptr(1),
+		},
+	}}
+	_, _, executionBuilder := s.respondDecisionTaskCompletedOverLimit(decisions, true)
+
+	s.Equal(persistence.WorkflowStateCompleted, executionBuilder.GetExecutionInfo().State)
+	s.Equal(persistence.WorkflowCloseStatusTerminated, executionBuilder.GetExecutionInfo().CloseStatus)
+	s.False(executionBuilder.HasPendingDecisionTask())
+}
+
+func (s *engineSuite) TestRespondDecisionTaskCompleted_HistoryLimitExceeded_CompleteWorkflow() {
+	s.mockHistoryEngine.config.HistoryCountLimitWarn = func(domain string) int { return 1 }
+	s.mockHistoryEngine.config.HistoryCountLimitError = func(domain string) int { return 2 }
+
+	decisions := []*workflow.Decision{{
+		DecisionType: common.DecisionTypePtr(workflow.DecisionTypeCompleteWorkflowExecution),
+		CompleteWorkflowExecutionDecisionAttributes: &workflow.CompleteWorkflowExecutionDecisionAttributes{
+			Result: []byte("success"),
+		},
+	}}
+	_, _, executionBuilder := s.respondDecisionTaskCompletedOverLimit(decisions, false)
+
+	s.Equal(persistence.WorkflowStateCompleted, executionBuilder.GetExecutionInfo().State)
+	s.Equal(persistence.WorkflowCloseStatusCompleted, executionBuilder.GetExecutionInfo().CloseStatus)
+}
+
 func (s *engineSuite) TestUserTimer_RespondDecisionTaskCompleted() {
 	domainID := validDomainID
 	we := workflow.WorkflowExecution{
@@ -4233,6 +4367,113 @@ func (s *engineSuite) TestSignalWorkflowExecution() {
 	s.Nil(err)
 }
 
+func (s *engineSuite) TestSignalWorkflowExecution_PendingFailover() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	signalRequest := &history.SignalWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		SignalRequest: &workflow.SignalWorkflowExecutionRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			Identity:          common.StringPtr("testIdentity"),
+			SignalName:        common.StringPtr("my signal name"),
+			Input:             []byte("test input"),
+		},
+	}
+
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestAlternativeClusterName},
+				},
+			},
+			IsGlobalDomain:           true,
+			PendingActiveClusterName: cluster.TestAlternativeClusterName,
+			TableVersion:             persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+
+	// new signals are rejected with a retryable error while the domain drains
+	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.IsType(&workflow.ServiceBusyError{}, err)
+
+	// signals sent by other workflow executions of the domain are still accepted
+	msBuilder := newMutableStateBuilder(s.mockClusterMetadata.GetCurrentClusterName(), s.config, bark.NewLoggerFromLogrus(log.New()))
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = validDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(&p.AppendHistoryEventsResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	signalRequest.ExternalWorkflowExecution = &workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("parent wId"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	err = s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.Nil(err)
+}
+
+// Test signal does not schedule a decision while the first decision is backing off
+func (s *engineSuite) TestSignalWorkflowExecution_FirstDecisionBackoff() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	signalRequest := &history.SignalWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		SignalRequest: &workflow.SignalWorkflowExecutionRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			Identity:          common.StringPtr("testIdentity"),
+			SignalName:        common.StringPtr("my signal name"),
+			Input:             []byte("test input"),
+		},
+	}
+
+	msBuilder := newMutableStateBuilder(s.mockClusterMetadata.GetCurrentClusterName(), s.config, bark.NewLoggerFromLogrus(log.New()))
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = validDomainID
+	// the first decision is not scheduled until the backoff timer fires
+	ms.ExecutionInfo.DecisionScheduleID = common.EmptyEventID
+	ms.ExecutionInfo.HasDecisionBackoff = true
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(&p.AppendHistoryEventsResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		return len(request.TransferTasks) == 0 && request.ExecutionInfo.DecisionScheduleID == common.EmptyEventID
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.Nil(err)
+}
+
 // Test signal decision by adding request ID
 func (s *engineSuite) TestSignalWorkflowExecution_DuplicateRequest() {
 	signalRequest := &history.SignalWorkflowExecutionRequest{}
@@ -4338,29 +4579,26 @@ func (s *engineSuite) TestSignalWorkflowExecution_Failed() {
 	s.EqualError(err, "EntityNotExistsError{Message: Workflow execution already completed.}")
 }
 
-func (s *engineSuite) TestRemoveSignalMutableState() {
-	removeRequest := &history.RemoveSignalMutableStateRequest{}
-	err := s.mockHistoryEngine.RemoveSignalMutableState(context.Background(), removeRequest)
-	s.EqualError(err, "BadRequestError{Message: Missing domain UUID.}")
-
+func (s *engineSuite) TestPauseWorkflowExecution() {
 	domainID := validDomainID
-	requestID := uuid.New()
-	removeRequest = &history.RemoveSignalMutableStateRequest{
-		DomainUUID: common.StringPtr(domainID),
-		WorkflowExecution: &workflow.WorkflowExecution{
-			WorkflowId: common.StringPtr("wId"),
-			RunId:      common.StringPtr(validRunID),
-		},
-		RequestId: common.StringPtr(requestID),
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
 	}
+	tl := "testTaskList"
+	identity := "testIdentity"
 
 	msBuilder := newMutableStateBuilder(s.mockClusterMetadata.GetCurrentClusterName(), s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
 	ms := createMutableState(msBuilder)
-	ms.ExecutionInfo.DomainID = validDomainID
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(&p.AppendHistoryEventsResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		return len(request.TransferTasks) == 0 && request.ExecutionInfo.Paused
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
 
 	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
 		&persistence.GetDomainResponse{
@@ -4376,36 +4614,940 @@ func (s *engineSuite) TestRemoveSignalMutableState() {
 		},
 		nil,
 	)
-	err = s.mockHistoryEngine.RemoveSignalMutableState(context.Background(), removeRequest)
+	err := s.mockHistoryEngine.PauseWorkflowExecution(context.Background(), &history.PauseWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		PauseRequest: &workflow.PauseWorkflowExecutionRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			Reason:            common.StringPtr("maintenance"),
+			Identity:          common.StringPtr(identity),
+		},
+	})
 	s.Nil(err)
+	executionBuilder := s.getBuilder(domainID, we)
+	s.True(executionBuilder.IsPaused())
+	s.Equal(int64(4), executionBuilder.GetExecutionInfo().NextEventID)
+	s.True(executionBuilder.HasPendingDecisionTask())
 }
 
-func (s *engineSuite) TestValidateSignalExternalWorkflowExecutionAttributes() {
-	var attributes *workflow.SignalExternalWorkflowExecutionDecisionAttributes
-	err := validateSignalExternalWorkflowExecutionAttributes(attributes)
-	s.EqualError(err, "BadRequestError{Message: SignalExternalWorkflowExecutionDecisionAttributes is not set on decision.}")
+func (s *engineSuite) TestPauseWorkflowExecution_AlreadyPaused() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
 
-	attributes = &workflow.SignalExternalWorkflowExecutionDecisionAttributes{}
-	err = validateSignalExternalWorkflowExecutionAttributes(attributes)
-	s.EqualError(err, "BadRequestError{Message: Execution is nil on decision.}")
+	msBuilder := newMutableStateBuilder(s.mockClusterMetadata.GetCurrentClusterName(), s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.Paused = true
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
 
-	attributes.Execution = &workflow.WorkflowExecution{}
-	attributes.Execution.WorkflowId = common.StringPtr("workflow-id")
-	err = validateSignalExternalWorkflowExecutionAttributes(attributes)
-	s.EqualError(err, "BadRequestError{Message: SignalName is not set on decision.}")
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 
-	attributes.Execution.RunId = common.StringPtr("run-id")
-	err = validateSignalExternalWorkflowExecutionAttributes(attributes)
-	s.EqualError(err, "BadRequestError{Message: Invalid RunId set on decision.}")
-	attributes.Execution.RunId = common.StringPtr(validRunID)
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+	err := s.mockHistoryEngine.PauseWorkflowExecution(context.Background(), &history.PauseWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		PauseRequest: &workflow.PauseWorkflowExecutionRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			Identity:          common.StringPtr(identity),
+		},
+	})
+	s.Equal(ErrWorkflowAlreadyPaused, err)
+}
 
-	attributes.SignalName = common.StringPtr("my signal name")
-	err = validateSignalExternalWorkflowExecutionAttributes(attributes)
-	s.EqualError(err, "BadRequestError{Message: Input is not set on decision.}")
+func (s *engineSuite) TestResumeWorkflowExecution() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
 
-	attributes.Input = []byte("test input")
-	err = validateSignalExternalWorkflowExecutionAttributes(attributes)
-	s.Nil(err)
+	msBuilder := newMutableStateBuilder(s.mockClusterMetadata.GetCurrentClusterName(), s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.Paused = true
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(&p.AppendHistoryEventsResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		if request.ExecutionInfo.Paused || len(request.TransferTasks) != 1 {
+			return false
+		}
+		// the decision held while paused is dispatched again
+		task, ok := request.TransferTasks[0].(*persistence.DecisionTask)
+		return ok && task.ScheduleID == di.ScheduleID
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+	err := s.mockHistoryEngine.ResumeWorkflowExecution(context.Background(), &history.ResumeWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		ResumeRequest: &workflow.ResumeWorkflowExecutionRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			Reason:            common.StringPtr("maintenance done"),
+			Identity:          common.StringPtr(identity),
+		},
+	})
+	s.Nil(err)
+	executionBuilder := s.getBuilder(domainID, we)
+	s.False(executionBuilder.IsPaused())
+	s.Equal(int64(4), executionBuilder.GetExecutionInfo().NextEventID)
+}
+
+func (s *engineSuite) TestResumeWorkflowExecution_NotPaused() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(s.mockClusterMetadata.GetCurrentClusterName(), s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+	err := s.mockHistoryEngine.ResumeWorkflowExecution(context.Background(), &history.ResumeWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		ResumeRequest: &workflow.ResumeWorkflowExecutionRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			Identity:          common.StringPtr(identity),
+		},
+	})
+	s.Equal(ErrWorkflowNotPaused, err)
+}
+
+func (s *engineSuite) TestRetryActivityTask() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	activityID := "activity1_id"
+
+	msBuilder := newMutableStateBuilder(s.mockClusterMetadata.GetCurrentClusterName(), s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		*decisionStartedEvent.EventId, nil, identity)
+	activityScheduledEvent, ai := addActivityTaskScheduledEvent(msBuilder, *decisionCompletedEvent.EventId, activityID,
+		"activity_type1", tl, []byte("input1"), 100, 10, 5)
+	ai.Attempt = 3
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(&p.AppendHistoryEventsResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		if len(request.UpsertActivityInfos) != 1 || request.UpsertActivityInfos[0].Attempt != 0 ||
+			len(request.TransferTasks) != 1 {
+			return false
+		}
+		task, ok := request.TransferTasks[0].(*persistence.ActivityTask)
+		return ok && task.ScheduleID == *activityScheduledEvent.EventId
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+	err := s.mockHistoryEngine.RetryActivityTask(context.Background(), &history.RetryActivityTaskRequest{
+		DomainUUID: common.StringPtr(domainID),
+		RetryRequest: &workflow.RetryActivityTaskRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			ActivityID:        common.StringPtr(activityID),
+			ResetAttempts:     common.BoolPtr(true),
+			Reason:            common.StringPtr("downstream recovered"),
+			Identity:          common.StringPtr(identity),
+		},
+	})
+	s.Nil(err)
+}
+
+func (s *engineSuite) TestRetryActivityTask_AlreadyStarted() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	activityID := "activity1_id"
+
+	msBuilder := newMutableStateBuilder(s.mockClusterMetadata.GetCurrentClusterName(), s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		*decisionStartedEvent.EventId, nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, *decisionCompletedEvent.EventId, activityID,
+		"activity_type1", tl, []byte("input1"), 100, 10, 5)
+	addActivityTaskStartedEvent(msBuilder, *activityScheduledEvent.EventId, tl, identity)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+	err := s.mockHistoryEngine.RetryActivityTask(context.Background(), &history.RetryActivityTaskRequest{
+		DomainUUID: common.StringPtr(domainID),
+		RetryRequest: &workflow.RetryActivityTaskRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			ActivityID:        common.StringPtr(activityID),
+			Identity:          common.StringPtr(identity),
+		},
+	})
+	s.Equal(ErrActivityTaskAlreadyStarted, err)
+}
+
+func (s *engineSuite) TestCompleteActivityTask_NotStarted() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	activityID := "activity1_id"
+
+	msBuilder := newMutableStateBuilder(s.mockClusterMetadata.GetCurrentClusterName(), s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		*decisionStartedEvent.EventId, nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, *decisionCompletedEvent.EventId, activityID,
+		"activity_type1", tl, []byte("input1"), 100, 10, 5)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(&p.AppendHistoryEventsResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+	err := s.mockHistoryEngine.CompleteActivityTask(context.Background(), &history.CompleteActivityTaskRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.CompleteActivityTaskRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			ActivityID:        common.StringPtr(activityID),
+			Result:            []byte("result"),
+			Reason:            common.StringPtr("manual fix"),
+			Identity:          common.StringPtr(identity),
+		},
+	})
+	s.Nil(err)
+
+	executionBuilder := s.getBuilder(domainID, we)
+	_, isRunning := executionBuilder.GetActivityInfo(*activityScheduledEvent.EventId)
+	s.False(isRunning)
+	s.True(executionBuilder.HasPendingDecisionTask())
+}
+
+func (s *engineSuite) TestFailActivityTask_NotStarted() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	activityID := "activity1_id"
+
+	msBuilder := newMutableStateBuilder(s.mockClusterMetadata.GetCurrentClusterName(), s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		*decisionStartedEvent.EventId, nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, *decisionCompletedEvent.EventId, activityID,
+		"activity_type1", tl, []byte("input1"), 100, 10, 5)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(&p.AppendHistoryEventsResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+	err := s.mockHistoryEngine.FailActivityTask(context.Background(), &history.FailActivityTaskRequest{
+		DomainUUID: common.StringPtr(domainID),
+		FailRequest: &workflow.FailActivityTaskRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			ActivityID:        common.StringPtr(activityID),
+			Reason:            common.StringPtr("bad input"),
+			Details:           []byte("details"),
+			Identity:          common.StringPtr(identity),
+		},
+	})
+	s.Nil(err)
+
+	executionBuilder := s.getBuilder(domainID, we)
+	_, isRunning := executionBuilder.GetActivityInfo(*activityScheduledEvent.EventId)
+	s.False(isRunning)
+	s.True(executionBuilder.HasPendingDecisionTask())
+}
+
+func (s *engineSuite) TestRemoveSignalMutableState() {
+	removeRequest := &history.RemoveSignalMutableStateRequest{}
+	err := s.mockHistoryEngine.RemoveSignalMutableState(context.Background(), removeRequest)
+	s.EqualError(err, "BadRequestError{Message: Missing domain UUID.}")
+
+	domainID := validDomainID
+	requestID := uuid.New()
+	removeRequest = &history.RemoveSignalMutableStateRequest{
+		DomainUUID: common.StringPtr(domainID),
+		WorkflowExecution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr("wId"),
+			RunId:      common.StringPtr(validRunID),
+		},
+		RequestId: common.StringPtr(requestID),
+	}
+
+	msBuilder := newMutableStateBuilder(s.mockClusterMetadata.GetCurrentClusterName(), s.config, bark.NewLoggerFromLogrus(log.New()))
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = validDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+	err = s.mockHistoryEngine.RemoveSignalMutableState(context.Background(), removeRequest)
+	s.Nil(err)
+}
+
+func (s *engineSuite) TestValidateSignalExternalWorkflowExecutionAttributes() {
+	var attributes *workflow.SignalExternalWorkflowExecutionDecisionAttributes
+	err := validateSignalExternalWorkflowExecutionAttributes(attributes)
+	s.EqualError(err, "BadRequestError{Message: SignalExternalWorkflowExecutionDecisionAttributes is not set on decision.}")
+
+	attributes = &workflow.SignalExternalWorkflowExecutionDecisionAttributes{}
+	err = validateSignalExternalWorkflowExecutionAttributes(attributes)
+	s.EqualError(err, "BadRequestError{Message: Execution is nil on decision.}")
+
+	attributes.Execution = &workflow.WorkflowExecution{}
+	attributes.Execution.WorkflowId = common.StringPtr("workflow-id")
+	err = validateSignalExternalWorkflowExecutionAttributes(attributes)
+	s.EqualError(err, "BadRequestError{Message: SignalName is not set on decision.}")
+
+	attributes.Execution.RunId = common.StringPtr("run-id")
+	err = validateSignalExternalWorkflowExecutionAttributes(attributes)
+	s.EqualError(err, "BadRequestError{Message: Invalid RunId set on decision.}")
+	attributes.Execution.RunId = common.StringPtr(validRunID)
+
+	attributes.SignalName = common.StringPtr("my signal name")
+	err = validateSignalExternalWorkflowExecutionAttributes(attributes)
+	s.EqualError(err, "BadRequestError{Message: Input is not set on decision.}")
+
+	attributes.Input = []byte("test input")
+	err = validateSignalExternalWorkflowExecutionAttributes(attributes)
+	s.Nil(err)
+}
+
+func (s *engineSuite) TestMigrateWorkflowHistory() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(s.mockClusterMetadata.GetCurrentClusterName(), s.config, bark.NewLoggerFromLogrus(log.New()))
+	startedEvent := addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	ms := createMutableState(msBuilder)
+	s.Equal(int64(3), ms.ExecutionInfo.NextEventID)
+
+	batches := []*workflow.History{
+		{Events: []*workflow.HistoryEvent{startedEvent}},
+		{Events: []*workflow.HistoryEvent{{EventId: common.Int64Ptr(di.ScheduleID)}}},
+	}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistoryByBatch", mock.Anything).Return(&persistence.GetWorkflowExecutionHistoryByBatchResponse{
+		History: batches,
+		Size:    100,
+	}, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.MatchedBy(func(request *persistence.AppendHistoryNodesRequest) bool {
+		return request.IsNewBranch && len(request.Events) == 1 && request.Events[0].GetEventId() == common.FirstEventID
+	})).Return(&persistence.AppendHistoryNodesResponse{Size: 30}, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.MatchedBy(func(request *persistence.AppendHistoryNodesRequest) bool {
+		return !request.IsNewBranch && len(request.Events) == 1 && request.Events[0].GetEventId() == di.ScheduleID
+	})).Return(&persistence.AppendHistoryNodesResponse{Size: 20}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		info := request.ExecutionInfo
+		branch := info.HistoryBranches[info.CurrentResetVersion]
+		return request.Condition == int64(3) &&
+			info.EventStoreVersion == persistence.EventStoreVersionV2 &&
+			info.NextEventID == int64(3) && branch.NextEventID == int64(3) &&
+			info.HistorySize == int64(50) && branch.HistorySize == int64(50) &&
+			info.HasEventsV1History
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+
+	resp, err := s.mockHistoryEngine.MigrateWorkflowHistory(context.Background(), &history.MigrateWorkflowHistoryRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  &we,
+	})
+	s.Nil(err)
+	s.True(resp.GetMigrated())
+	s.Equal(int64(2), resp.GetHistoryBatchCount())
+	s.Equal(int64(2), resp.GetHistoryEventCount())
+	s.Equal(int64(50), resp.GetHistorySize())
+
+	// already migrated, the cached mutable state is on events v2
+	resp, err = s.mockHistoryEngine.MigrateWorkflowHistory(context.Background(), &history.MigrateWorkflowHistoryRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  &we,
+	})
+	s.Nil(err)
+	s.False(resp.GetMigrated())
+}
+
+func (s *engineSuite) TestMigrateWorkflowHistory_ReplicationTasksPending() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+
+	msBuilder := newMutableStateBuilder(s.mockClusterMetadata.GetCurrentClusterName(), s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskList", []byte("input"), 100, 100, "testIdentity")
+	ms := createMutableState(msBuilder)
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestAlternativeClusterName},
+				},
+			},
+			IsGlobalDomain: true,
+			TableVersion:   persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+	// the replication task of the execution is never processed, so the history is not copied
+	s.mockHistoryEngine.shard.(*shardContextWrapper).ShardContext.(*shardContextImpl).transferMaxReadLevel = 100
+	s.mockExecutionMgr.On("GetReplicationTasks", mock.Anything).Return(&persistence.GetReplicationTasksResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{
+			{TaskID: 1, DomainID: domainID, WorkflowID: we.GetWorkflowId(), RunID: we.GetRunId()},
+		},
+	}, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*historyMigrationDrainInterval)
+	defer cancel()
+	_, err := s.mockHistoryEngine.MigrateWorkflowHistory(ctx, &history.MigrateWorkflowHistoryRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  &we,
+	})
+	s.Equal(ErrHistoryMigrationTasksPending, err)
+}
+
+func (s *engineSuite) TestDeleteWorkflowExecution() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+
+	msBuilder := newMutableStateBuilder(s.mockClusterMetadata.GetCurrentClusterName(), s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskList", []byte("input"), 100, 100, "testIdentity")
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.State = persistence.WorkflowStateCompleted
+	ms.ExecutionInfo.StickyTaskList = "stickyTaskList"
+
+	s.mockDomainStatus(domainID, persistence.DomainStatusDeleted)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+	s.mockHistoryMgr.On("DeleteWorkflowExecutionHistory", &persistence.DeleteWorkflowExecutionHistoryRequest{
+		DomainID:  domainID,
+		Execution: we,
+	}).Return(nil).Once()
+	s.mockExecutionMgr.On("DeleteCurrentWorkflowExecution", &persistence.DeleteCurrentWorkflowExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+	}).Return(nil).Once()
+	s.mockExecutionMgr.On("DeleteWorkflowExecution", &persistence.DeleteWorkflowExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+	}).Return(nil).Once()
+
+	resp, err := s.mockHistoryEngine.DeleteWorkflowExecution(context.Background(), &history.DeleteWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  &we,
+	})
+	s.Nil(err)
+	s.Equal("testTaskList", resp.GetTaskList())
+	s.Equal("stickyTaskList", resp.GetStickyTaskList())
+
+	// the deleted execution is not served from the cache anymore
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil, &workflow.EntityNotExistsError{}).Once()
+	_, err = s.mockHistoryEngine.DeleteWorkflowExecution(context.Background(), &history.DeleteWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  &we,
+	})
+	s.IsType(&workflow.EntityNotExistsError{}, err)
+}
+
+func (s *engineSuite) TestDeleteWorkflowExecution_MigratedHistory() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+
+	msBuilder := newMutableStateBuilder(s.mockClusterMetadata.GetCurrentClusterName(), s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskList", []byte("input"), 100, 100, "testIdentity")
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.State = persistence.WorkflowStateCompleted
+	ms.ExecutionInfo.EventStoreVersion = persistence.EventStoreVersionV2
+	ms.ExecutionInfo.HistoryBranches = map[int32]*persistence.HistoryBranch{0: {BranchToken: []byte("branch")}}
+	ms.ExecutionInfo.HasEventsV1History = true
+
+	s.mockDomainStatus(domainID, persistence.DomainStatusDeleted)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+	s.mockHistoryV2Mgr.On("DeleteHistoryBranch", &persistence.DeleteHistoryBranchRequest{
+		BranchToken: []byte("branch"),
+	}).Return(nil).Once()
+	// the events v1 history left in place by the migration is deleted along with the history tree
+	s.mockHistoryMgr.On("DeleteWorkflowExecutionHistory", &persistence.DeleteWorkflowExecutionHistoryRequest{
+		DomainID:  domainID,
+		Execution: we,
+	}).Return(nil).Once()
+	s.mockExecutionMgr.On("DeleteCurrentWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("DeleteWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	_, err := s.mockHistoryEngine.DeleteWorkflowExecution(context.Background(), &history.DeleteWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  &we,
+	})
+	s.Nil(err)
+}
+
+func (s *engineSuite) TestDeleteWorkflowExecution_Running() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+
+	msBuilder := newMutableStateBuilder(s.mockClusterMetadata.GetCurrentClusterName(), s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskList", []byte("input"), 100, 100, "testIdentity")
+	ms := createMutableState(msBuilder)
+
+	s.mockDomainStatus(domainID, persistence.DomainStatusDeleted)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+
+	_, err := s.mockHistoryEngine.DeleteWorkflowExecution(context.Background(), &history.DeleteWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  &we,
+	})
+	s.Equal(ErrWorkflowNotClosed, err)
+}
+
+func (s *engineSuite) TestDeleteWorkflowExecution_DomainNotDeleted() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+
+	s.mockDomainStatus(domainID, persistence.DomainStatusDeprecated)
+
+	_, err := s.mockHistoryEngine.DeleteWorkflowExecution(context.Background(), &history.DeleteWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  &we,
+	})
+	s.Equal(ErrDomainNotDeleted, err)
+}
+
+func (s *engineSuite) TestMigrateWorkflowHistory_DryRun() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(s.mockClusterMetadata.GetCurrentClusterName(), s.config, bark.NewLoggerFromLogrus(log.New()))
+	startedEvent := addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	ms := createMutableState(msBuilder)
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistoryByBatch", mock.MatchedBy(func(request *persistence.GetWorkflowExecutionHistoryRequest) bool {
+		return len(request.NextPageToken) == 0
+	})).Return(&persistence.GetWorkflowExecutionHistoryByBatchResponse{
+		History:       []*workflow.History{{Events: []*workflow.HistoryEvent{startedEvent}}},
+		NextPageToken: []byte("token"),
+		Size:          30,
+	}, nil).Once()
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistoryByBatch", mock.MatchedBy(func(request *persistence.GetWorkflowExecutionHistoryRequest) bool {
+		return string(request.NextPageToken) == "token"
+	})).Return(&persistence.GetWorkflowExecutionHistoryByBatchResponse{
+		History: []*workflow.History{{Events: []*workflow.HistoryEvent{{EventId: common.Int64Ptr(2)}}}},
+		Size:    20,
+	}, nil).Once()
+
+	resp, err := s.mockHistoryEngine.MigrateWorkflowHistory(context.Background(), &history.MigrateWorkflowHistoryRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  &we,
+		DryRun:     common.BoolPtr(true),
+	})
+	s.Nil(err)
+	s.False(resp.GetMigrated())
+	s.Equal(int64(2), resp.GetHistoryBatchCount())
+	s.Equal(int64(2), resp.GetHistoryEventCount())
+	s.Equal(int64(50), resp.GetHistorySize())
+}
+
+func (s *engineSuite) TestMigrateWorkflowHistory_Conflict() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(s.mockClusterMetadata.GetCurrentClusterName(), s.config, bark.NewLoggerFromLogrus(log.New()))
+	startedEvent := addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	ms := createMutableState(msBuilder)
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistoryByBatch", mock.Anything).Return(&persistence.GetWorkflowExecutionHistoryByBatchResponse{
+		History: []*workflow.History{{Events: []*workflow.HistoryEvent{startedEvent}}},
+		Size:    30,
+	}, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&persistence.AppendHistoryNodesResponse{Size: 30}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil, &persistence.ConditionFailedError{}).Once()
+	// the execution still refers to the events v1 history, so the copied tree is dropped
+	s.mockHistoryV2Mgr.On("DeleteHistoryBranch", mock.Anything).Return(nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+
+	_, err := s.mockHistoryEngine.MigrateWorkflowHistory(context.Background(), &history.MigrateWorkflowHistoryRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  &we,
+	})
+	s.Equal(ErrConflict, err)
+}
+
+func (s *engineSuite) TestMigrateWorkflowHistory_AppendFailed() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(s.mockClusterMetadata.GetCurrentClusterName(), s.config, bark.NewLoggerFromLogrus(log.New()))
+	startedEvent := addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	ms := createMutableState(msBuilder)
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistoryByBatch", mock.Anything).Return(&persistence.GetWorkflowExecutionHistoryByBatchResponse{
+		History: []*workflow.History{
+			{Events: []*workflow.HistoryEvent{startedEvent}},
+			{Events: []*workflow.HistoryEvent{{EventId: common.Int64Ptr(2)}}},
+		},
+		Size: 50,
+	}, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.MatchedBy(func(request *persistence.AppendHistoryNodesRequest) bool {
+		return request.IsNewBranch
+	})).Return(&persistence.AppendHistoryNodesResponse{Size: 30}, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.MatchedBy(func(request *persistence.AppendHistoryNodesRequest) bool {
+		return !request.IsNewBranch
+	})).Return(nil, &workflow.InternalServiceError{Message: "append failed"}).Once()
+	s.mockHistoryV2Mgr.On("DeleteHistoryBranch", mock.Anything).Return(nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+
+	_, err := s.mockHistoryEngine.MigrateWorkflowHistory(context.Background(), &history.MigrateWorkflowHistoryRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  &we,
+	})
+	s.NotNil(err)
+}
+
+// respondDecisionTaskCompletedOverLimit completes the first decision of a new workflow execution with the given
+// decisions, the mutable state is reloaded once when the decision is expected to be failed
+func (s *engineSuite) TestRescheduleShardTask_TimerNotDue() {
+	visibilityTimestamp := time.Now().Add(time.Hour)
+	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything).Return(&persistence.GetTimerIndexTasksResponse{
+		Timers: []*persistence.TimerTaskInfo{{
+			DomainID:            validDomainID,
+			WorkflowID:          "wId",
+			RunID:               validRunID,
+			VisibilityTimestamp: visibilityTimestamp,
+			TaskID:              123,
+			TaskType:            persistence.TaskTypeWorkflowTimeout,
+		}},
+	}, nil).Once()
+
+	err := s.mockHistoryEngine.RescheduleShardTask(context.Background(), &history.RescheduleShardTaskRequest{
+		ShardID:             common.Int32Ptr(1),
+		QueueType:           history.ShardQueueTypeTimer.Ptr(),
+		TaskID:              common.Int64Ptr(123),
+		VisibilityTimestamp: common.Int64Ptr(visibilityTimestamp.UnixNano()),
+	})
+	s.Equal(ErrTimerTaskNotDue, err)
+}
+
+func (s *engineSuite) respondDecisionTaskCompletedOverLimit(decisions []*workflow.Decision,
+	expectDecisionFailed bool) (*persistence.UpdateWorkflowExecutionRequest, *workflow.DecisionTaskFailedCause, mutableState) {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: *we.WorkflowId,
+		RunID:      *we.RunId,
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(s.mockClusterMetadata.GetCurrentClusterName(), s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: createMutableState(msBuilder)}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	if expectDecisionFailed {
+		// the mutable state is reloaded to fail the decision
+		gwmsResponse2 := &persistence.GetWorkflowExecutionResponse{State: createMutableState(msBuilder)}
+		s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse2, nil).Once()
+	}
+
+	var failedCause *workflow.DecisionTaskFailedCause
+	var updateRequest *persistence.UpdateWorkflowExecutionRequest
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(&p.AppendHistoryEventsResponse{Size: 0}, nil).Run(func(arguments mock.Arguments) {
+		req := arguments.Get(0).(*persistence.AppendHistoryEventsRequest)
+		for _, event := range req.Events {
+			if event.GetEventType() == workflow.EventTypeDecisionTaskFailed {
+				failedCause = event.DecisionTaskFailedEventAttributes.Cause
+			}
+		}
+	}).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Run(func(arguments mock.Arguments) {
+		updateRequest = arguments.Get(0).(*persistence.UpdateWorkflowExecutionRequest)
+	}).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+	_, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(context.Background(), &history.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken:        taskToken,
+			Decisions:        decisions,
+			ExecutionContext: []byte("context"),
+			Identity:         &identity,
+		},
+	})
+	s.Nil(err)
+
+	return updateRequest, failedCause, s.getBuilder(domainID, we)
+}
+
+// mockDomainStatus makes the domain cache load the domain with the given status
+func (s *engineSuite) mockDomainStatus(domainID string, status int) {
+	s.mockMetadataMgr.On("GetDomain", &persistence.GetDomainRequest{ID: domainID}).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID, Status: status},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
 }
 
 func (s *engineSuite) getBuilder(domainID string, we workflow.WorkflowExecution) mutableState {
@@ -4724,6 +5866,8 @@ func copyWorkflowExecutionInfo(sourceInfo *persistence.WorkflowExecutionInfo) *p
 		DecisionStartedID:            sourceInfo.DecisionStartedID,
 		DecisionRequestID:            sourceInfo.DecisionRequestID,
 		DecisionTimeout:              sourceInfo.DecisionTimeout,
+		Paused:                       sourceInfo.Paused,
+		HasEventsV1History:           sourceInfo.HasEventsV1History,
 		EventStoreVersion:            sourceInfo.EventStoreVersion,
 		CurrentResetVersion:          sourceInfo.CurrentResetVersion,
 		HistoryBranches:              sourceInfo.HistoryBranches,
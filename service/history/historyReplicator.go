@@ -296,7 +296,7 @@ func (r *historyReplicator) ApplyEvents(ctx context.Context, request *h.Replicat
 		return err
 	}
 	defer func() {
-		if retError == ErrRetryBufferEvents {
+		if isRetryBufferEventsError(retError) {
 			release(nil)
 		} else {
 			release(retError)
@@ -365,6 +365,7 @@ func (r *historyReplicator) ApplyOtherEventsMissingMutableState(ctx context.Cont
 	}
 	currentRunID := currentMutableState.GetExecutionInfo().RunID
 	currentLastWriteVersion := currentMutableState.GetLastWriteVersion()
+	currentNextEventID := currentMutableState.GetNextEventID()
 	currentRelease(nil)
 
 	// we can also use the start version
@@ -382,7 +383,9 @@ func (r *historyReplicator) ApplyOtherEventsMissingMutableState(ctx context.Cont
 	if err != nil {
 		return err
 	}
-	return ErrRetryEntityNotExists
+	// the incoming run is likely continued as new from the current run, tell which events of the
+	// current run are missing so that the replicator can ask the source cluster to resend them
+	return newRetryEntityNotExistsError(domainID, workflowID, currentRunID, currentNextEventID)
 }
 
 func (r *historyReplicator) ApplyOtherEventsVersionChecking(ctx context.Context, context *workflowExecutionContext,
@@ -511,7 +514,8 @@ func (r *historyReplicator) ApplyOtherEvents(ctx context.Context, context *workf
 			msBuilder.GetNextEventID(), firstEventID)
 
 		if !request.GetForceBufferEvents() {
-			return ErrRetryBufferEvents
+			// let the caller know where the gap starts, so the missing events can be resent by the source cluster
+			return newRetryBufferEventsError(context.domainID, context.workflowExecution, msBuilder.GetNextEventID())
 		}
 
 		r.metricsClient.RecordTimer(
@@ -778,6 +782,7 @@ func (r *historyReplicator) replicateWorkflowStarted(ctx context.Context, contex
 			DecisionScheduleID:          decisionScheduleID,
 			DecisionStartedID:           decisionStartID,
 			DecisionStartToCloseTimeout: decisionTimeout,
+			HasDecisionBackoff:          executionInfo.HasDecisionBackoff,
 			TimerTasks:                  timerTasks,
 			PreviousRunID:               prevRunID,
 			PreviousLastWriteVersion:    prevLastWriteVersion,
@@ -1013,7 +1018,7 @@ func (r *historyReplicator) terminateWorkflow(ctx context.Context, domainID stri
 	return r.ApplyReplicationTask(ctx, context, msBuilder, req, logger)
 }
 
-func (r *historyReplicator) getLatestCheckpoint(replicationInfoRemote map[string]*h.ReplicationInfo,
+func (r *historyReplicator) getLatestCheckpoint(replicationInfoRemote map[string]*shared.ReplicationInfo,
 	replicationInfoLocal map[string]*persistence.ReplicationInfo) (int64, int64) {
 
 	// this only applies to 2 data center case
@@ -1085,6 +1090,35 @@ func (r *historyReplicator) notify(clusterName string, now time.Time, transferTa
 	r.historyEngine.timerProcessor.NotifyNewTimers(clusterName, now, timerTasks)
 }
 
+// newRetryBufferEventsError returns a retry error for out of order events, carrying the ID of
+// the first missing event of the workflow execution
+func newRetryBufferEventsError(domainID string, execution shared.WorkflowExecution, nextEventID int64) error {
+	return &shared.RetryTaskError{
+		Message:     ErrRetryBufferEvents.Message,
+		DomainId:    common.StringPtr(domainID),
+		WorkflowId:  common.StringPtr(execution.GetWorkflowId()),
+		RunId:       common.StringPtr(execution.GetRunId()),
+		NextEventId: common.Int64Ptr(nextEventID),
+	}
+}
+
+// newRetryEntityNotExistsError returns a retry error for events of a missing workflow run, carrying the ID of
+// the first missing event of the current run of the workflow
+func newRetryEntityNotExistsError(domainID, workflowID, currentRunID string, currentNextEventID int64) error {
+	return &shared.RetryTaskError{
+		Message:     ErrRetryEntityNotExists.Message,
+		DomainId:    common.StringPtr(domainID),
+		WorkflowId:  common.StringPtr(workflowID),
+		RunId:       common.StringPtr(currentRunID),
+		NextEventId: common.Int64Ptr(currentNextEventID),
+	}
+}
+
+func isRetryBufferEventsError(err error) bool {
+	retryErr, ok := err.(*shared.RetryTaskError)
+	return ok && retryErr.Message == ErrRetryBufferEvents.Message
+}
+
 func logError(logger bark.Logger, msg string, err error) {
 	logger.WithFields(bark.Fields{
 		logging.TagErr: err,
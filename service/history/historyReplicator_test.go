@@ -711,14 +711,20 @@ func (s *historyReplicatorSuite) TestApplyOtherEventsMissingMutableState_Incomin
 		},
 	}).Return(&persistence.GetWorkflowExecutionResponse{
 		State: &persistence.WorkflowMutableState{
-			ExecutionInfo:    &persistence.WorkflowExecutionInfo{RunID: currentRunID},
+			ExecutionInfo:    &persistence.WorkflowExecutionInfo{RunID: currentRunID, NextEventID: 12},
 			ReplicationState: &persistence.ReplicationState{LastWriteVersion: currentVersion},
 		},
 	}, nil)
 
 	err := s.historyReplicator.ApplyOtherEventsMissingMutableState(ctx.Background(), domainID, workflowID, version,
 		s.logger)
-	s.Equal(ErrRetryEntityNotExists, err)
+	s.Equal(&shared.RetryTaskError{
+		Message:     ErrRetryEntityNotExists.Message,
+		DomainId:    common.StringPtr(domainID),
+		WorkflowId:  common.StringPtr(workflowID),
+		RunId:       common.StringPtr(currentRunID),
+		NextEventId: common.Int64Ptr(12),
+	}, err)
 }
 
 func (s *historyReplicatorSuite) TestApplyOtherEventsMissingMutableState_IncomingLessThanCurrent() {
@@ -912,7 +918,7 @@ func (s *historyReplicatorSuite) TestApplyOtherEventsVersionChecking_IncomingGre
 
 	request := &h.ReplicateEventsRequest{
 		Version:         common.Int64Ptr(incomingVersion),
-		ReplicationInfo: map[string]*h.ReplicationInfo{},
+		ReplicationInfo: map[string]*shared.ReplicationInfo{},
 		History: &shared.History{Events: []*shared.HistoryEvent{
 			&shared.HistoryEvent{Timestamp: common.Int64Ptr(time.Now().UnixNano())},
 		}},
@@ -972,8 +978,8 @@ func (s *historyReplicatorSuite) TestApplyOtherEventsVersionChecking_IncomingGre
 
 	request := &h.ReplicateEventsRequest{
 		Version: common.Int64Ptr(incomingVersion),
-		ReplicationInfo: map[string]*h.ReplicationInfo{
-			prevActiveCluster: &h.ReplicationInfo{
+		ReplicationInfo: map[string]*shared.ReplicationInfo{
+			prevActiveCluster: &shared.ReplicationInfo{
 				Version:     common.Int64Ptr(incomingReplicationInfoLastWriteVersion),
 				LastEventId: common.Int64Ptr(incomingReplicationInfoLastEventID),
 			},
@@ -1034,8 +1040,8 @@ func (s *historyReplicatorSuite) TestApplyOtherEventsVersionChecking_IncomingGre
 
 	request := &h.ReplicateEventsRequest{
 		Version: common.Int64Ptr(incomingVersion),
-		ReplicationInfo: map[string]*h.ReplicationInfo{
-			prevActiveCluster: &h.ReplicationInfo{
+		ReplicationInfo: map[string]*shared.ReplicationInfo{
+			prevActiveCluster: &shared.ReplicationInfo{
 				Version:     common.Int64Ptr(incomingReplicationInfoLastWriteVersion),
 				LastEventId: common.Int64Ptr(incomingReplicationInfoLastEventID),
 			},
@@ -1079,8 +1085,8 @@ func (s *historyReplicatorSuite) TestApplyOtherEventsVersionChecking_IncomingGre
 
 	request := &h.ReplicateEventsRequest{
 		Version: common.Int64Ptr(incomingVersion),
-		ReplicationInfo: map[string]*h.ReplicationInfo{
-			prevActiveCluster: &h.ReplicationInfo{
+		ReplicationInfo: map[string]*shared.ReplicationInfo{
+			prevActiveCluster: &shared.ReplicationInfo{
 				Version:     common.Int64Ptr(incomingReplicationInfoLastWriteVersion),
 				LastEventId: common.Int64Ptr(incomingReplicationInfoLastEventID),
 			},
@@ -1135,8 +1141,8 @@ func (s *historyReplicatorSuite) TestApplyOtherEventsVersionChecking_IncomingGre
 
 	request := &h.ReplicateEventsRequest{
 		Version: common.Int64Ptr(incomingVersion),
-		ReplicationInfo: map[string]*h.ReplicationInfo{
-			prevActiveCluster: &h.ReplicationInfo{
+		ReplicationInfo: map[string]*shared.ReplicationInfo{
+			prevActiveCluster: &shared.ReplicationInfo{
 				Version:     common.Int64Ptr(incomingReplicationInfoLastWriteVersion),
 				LastEventId: common.Int64Ptr(incomingReplicationInfoLastEventID),
 			},
@@ -1184,8 +1190,8 @@ func (s *historyReplicatorSuite) TestApplyOtherEventsVersionChecking_IncomingGre
 
 	request := &h.ReplicateEventsRequest{
 		Version: common.Int64Ptr(incomingVersion),
-		ReplicationInfo: map[string]*h.ReplicationInfo{
-			prevActiveCluster: &h.ReplicationInfo{
+		ReplicationInfo: map[string]*shared.ReplicationInfo{
+			prevActiveCluster: &shared.ReplicationInfo{
 				Version:     common.Int64Ptr(incomingReplicationInfoLastWriteVersion),
 				LastEventId: common.Int64Ptr(incomingReplicationInfoLastEventID),
 			},
@@ -1230,8 +1236,8 @@ func (s *historyReplicatorSuite) TestApplyOtherEventsVersionChecking_IncomingGre
 
 	request := &h.ReplicateEventsRequest{
 		Version: common.Int64Ptr(incomingVersion),
-		ReplicationInfo: map[string]*h.ReplicationInfo{
-			prevActiveCluster: &h.ReplicationInfo{
+		ReplicationInfo: map[string]*shared.ReplicationInfo{
+			prevActiveCluster: &shared.ReplicationInfo{
 				Version:     common.Int64Ptr(incomingReplicationInfoLastWriteVersion),
 				LastEventId: common.Int64Ptr(incomingReplicationInfoLastEventID),
 			},
@@ -1330,7 +1336,13 @@ func (s *historyReplicatorSuite) TestApplyOtherEvents_IncomingGreaterThanCurrent
 	msBuilder.On("IsWorkflowExecutionRunning").Return(true)
 
 	err := s.historyReplicator.ApplyOtherEvents(ctx.Background(), context, msBuilder, request, s.logger)
-	s.Equal(ErrRetryBufferEvents, err)
+	retryErr, ok := err.(*shared.RetryTaskError)
+	s.True(ok)
+	s.Equal(ErrRetryBufferEvents.Message, retryErr.Message)
+	s.Equal(domainID, retryErr.GetDomainId())
+	s.Equal(workflowID, retryErr.GetWorkflowId())
+	s.Equal(runID, retryErr.GetRunId())
+	s.Equal(currentNextEventID, retryErr.GetNextEventId())
 }
 
 func (s *historyReplicatorSuite) TestApplyOtherEvents_IncomingGreaterThanCurrent_ForceBuffer_NoExistingBuffer() {
@@ -1403,6 +1415,7 @@ func (s *historyReplicatorSuite) TestApplyOtherEvents_IncomingGreaterThanCurrent
 
 	// these does not matter, but will be used by ms builder change notification
 	msBuilder.On("GetLastFirstEventID").Return(currentNextEventID - 4)
+	msBuilder.On("GetMutableStateSize").Return(int64(0))
 	msBuilder.On("IsWorkflowExecutionRunning").Return(true)
 
 	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(input *persistence.UpdateWorkflowExecutionRequest) bool {
@@ -1518,6 +1531,7 @@ func (s *historyReplicatorSuite) TestApplyOtherEvents_IncomingGreaterThanCurrent
 
 	// these does not matter, but will be used by ms builder change notification
 	msBuilder.On("GetLastFirstEventID").Return(currentNextEventID - 4)
+	msBuilder.On("GetMutableStateSize").Return(int64(0))
 	msBuilder.On("IsWorkflowExecutionRunning").Return(false)
 
 	err := s.historyReplicator.ApplyOtherEvents(ctx.Background(), context, msBuilder, request, s.logger)
@@ -1599,6 +1613,7 @@ func (s *historyReplicatorSuite) TestApplyOtherEvents_IncomingGreaterThanCurrent
 
 	// these does not matter, but will be used by ms builder change notification
 	msBuilder.On("GetLastFirstEventID").Return(currentNextEventID - 4)
+	msBuilder.On("GetMutableStateSize").Return(int64(0))
 	msBuilder.On("IsWorkflowExecutionRunning").Return(true)
 
 	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(input *persistence.UpdateWorkflowExecutionRequest) bool {
@@ -71,6 +71,7 @@ type (
 		transferSequenceNumber    int64
 		historyMgr                persistence.HistoryManager
 		historyV2Mgr              persistence.HistoryV2Manager
+		quarantineMgr             persistence.QuarantineManager
 		executionMgr              persistence.ExecutionManager
 		domainCache               cache.DomainCache
 		config                    *Config
@@ -155,6 +156,11 @@ func (s *TestShardContext) GetHistoryV2Manager() persistence.HistoryV2Manager {
 	return s.historyV2Mgr
 }
 
+// GetQuarantineManager test implementation
+func (s *TestShardContext) GetQuarantineManager() persistence.QuarantineManager {
+	return s.quarantineMgr
+}
+
 // GetDomainCache test implementation
 func (s *TestShardContext) GetDomainCache() cache.DomainCache {
 	return s.domainCache
@@ -221,6 +227,30 @@ func (s *TestShardContext) UpdateReplicatorAckLevel(ackLevel int64) error {
 	return nil
 }
 
+// GetAllClusterReplicationLevels test implementation
+func (s *TestShardContext) GetAllClusterReplicationLevels() map[string]int64 {
+	s.RLock()
+	defer s.RUnlock()
+
+	ret := map[string]int64{}
+	for k, v := range s.shardInfo.ClusterReplicationLevel {
+		ret[k] = v
+	}
+	return ret
+}
+
+// UpdateClusterReplicationLevel test implementation
+func (s *TestShardContext) UpdateClusterReplicationLevel(cluster string, lastProcessedTaskID int64) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.shardInfo.ClusterReplicationLevel == nil {
+		s.shardInfo.ClusterReplicationLevel = make(map[string]int64)
+	}
+	s.shardInfo.ClusterReplicationLevel[cluster] = lastProcessedTaskID
+	return nil
+}
+
 // GetTimerAckLevel test implementation
 func (s *TestShardContext) GetTimerAckLevel() time.Time {
 	s.RLock()
@@ -48,6 +48,7 @@ type (
 		AddActivityTaskCanceledEvent(int64, int64, int64, []uint8, string) *workflow.HistoryEvent
 		AddActivityTaskCompletedEvent(int64, int64, *workflow.RespondActivityTaskCompletedRequest) *workflow.HistoryEvent
 		AddActivityTaskFailedEvent(int64, int64, *workflow.RespondActivityTaskFailedRequest) *workflow.HistoryEvent
+		AddActivityTaskRetryRequestedEvent(int64, *workflow.RetryActivityTaskRequest) *workflow.HistoryEvent
 		AddActivityTaskScheduledEvent(int64, *workflow.ScheduleActivityTaskDecisionAttributes) (*workflow.HistoryEvent, *persistence.ActivityInfo)
 		AddActivityTaskStartedEvent(*persistence.ActivityInfo, int64, string, string) *workflow.HistoryEvent
 		AddActivityTaskTimedOutEvent(int64, int64, workflow.TimeoutType, []uint8) *workflow.HistoryEvent
@@ -84,6 +85,8 @@ type (
 		AddTimerStartedEvent(int64, *workflow.StartTimerDecisionAttributes) (*workflow.HistoryEvent, *persistence.TimerInfo)
 		AddWorkflowExecutionCancelRequestedEvent(string, *h.RequestCancelWorkflowExecutionRequest) *workflow.HistoryEvent
 		AddWorkflowExecutionCanceledEvent(int64, *workflow.CancelWorkflowExecutionDecisionAttributes) *workflow.HistoryEvent
+		AddWorkflowExecutionPausedEvent(*workflow.PauseWorkflowExecutionRequest) *workflow.HistoryEvent
+		AddWorkflowExecutionResumedEvent(*workflow.ResumeWorkflowExecutionRequest) *workflow.HistoryEvent
 		AddWorkflowExecutionSignaled(*workflow.SignalWorkflowExecutionRequest) *workflow.HistoryEvent
 		AddWorkflowExecutionStartedEvent(workflow.WorkflowExecution, *h.StartWorkflowExecutionRequest) *workflow.HistoryEvent
 		AddWorkflowExecutionTerminatedEvent(*workflow.TerminateWorkflowExecutionRequest) *workflow.HistoryEvent
@@ -128,11 +131,13 @@ type (
 		GetLastFirstEventID() int64
 		GetLastUpdatedTimestamp() int64
 		GetLastWriteVersion() int64
+		GetMutableStateSize() int64
 		GetNextEventID() int64
 		GetPendingDecision(int64) (*decisionInfo, bool)
 		GetPendingActivityInfos() map[int64]*persistence.ActivityInfo
 		GetPendingTimerInfos() map[string]*persistence.TimerInfo
 		GetPendingChildExecutionInfos() map[int64]*persistence.ChildExecutionInfo
+		GetPendingSignalInfos() map[int64]*persistence.SignalInfo
 		GetReplicationState() *persistence.ReplicationState
 		GetRequestCancelInfo(int64) (*persistence.RequestCancelInfo, bool)
 		GetRetryBackoffDuration(errReason string) time.Duration
@@ -146,8 +151,10 @@ type (
 		HasInFlightDecisionTask() bool
 		HasParentExecution() bool
 		HasPendingDecisionTask() bool
+		HasPendingDecisionBackoff() bool
 		IncrementHistorySize(int)
 		IsCancelRequested() (bool, string)
+		IsPaused() bool
 		IsSignalRequested(requestID string) bool
 		IsStickyTaskListEnabled() bool
 		IsWorkflowExecutionRunning() bool
@@ -157,6 +164,7 @@ type (
 		ReplicateActivityTaskCanceledEvent(*workflow.HistoryEvent) error
 		ReplicateActivityTaskCompletedEvent(*workflow.HistoryEvent) error
 		ReplicateActivityTaskFailedEvent(*workflow.HistoryEvent) error
+		ReplicateActivityTaskRetryRequestedEvent(*workflow.HistoryEvent)
 		ReplicateActivityTaskScheduledEvent(*workflow.HistoryEvent) *persistence.ActivityInfo
 		ReplicateActivityTaskStartedEvent(*workflow.HistoryEvent)
 		ReplicateActivityTaskTimedOutEvent(*workflow.HistoryEvent) error
@@ -188,12 +196,15 @@ type (
 		ReplicateWorkflowExecutionCompletedEvent(*workflow.HistoryEvent)
 		ReplicateWorkflowExecutionContinuedAsNewEvent(string, string, *workflow.HistoryEvent, *workflow.HistoryEvent, *decisionInfo, mutableState)
 		ReplicateWorkflowExecutionFailedEvent(*workflow.HistoryEvent)
+		ReplicateWorkflowExecutionPausedEvent(*workflow.HistoryEvent)
+		ReplicateWorkflowExecutionResumedEvent(*workflow.HistoryEvent)
 		ReplicateWorkflowExecutionStartedEvent(string, *string, workflow.WorkflowExecution, string, *workflow.WorkflowExecutionStartedEventAttributes)
 		ReplicateWorkflowExecutionTerminatedEvent(*workflow.HistoryEvent)
 		ReplicateWorkflowExecutionTimedoutEvent(*workflow.HistoryEvent)
 		ResetSnapshot(string) *persistence.ResetMutableStateRequest
 		SetHistoryBuilder(hBuilder *historyBuilder)
 		SetHistoryTree(treeID string) error
+		SetNewRun(*persistence.CreateWorkflowExecutionRequest)
 		SetNewRunSize(size int)
 		UpdateActivity(*persistence.ActivityInfo) error
 		UpdateActivityProgress(ai *persistence.ActivityInfo, request *workflow.RecordActivityTaskHeartbeatRequest)
@@ -212,6 +212,46 @@ func (e *mutableStateBuilder) GetHistorySize() int64 {
 	return e.executionInfo.HistorySize
 }
 
+// GetMutableStateSize returns the estimated size in bytes of the mutable state, it counts the identifiers and
+// payloads of the same rows as the persistence stats of a loaded mutable state
+func (e *mutableStateBuilder) GetMutableStateSize() int64 {
+	size := len(e.executionInfo.WorkflowID) + len(e.executionInfo.TaskList) + len(e.executionInfo.WorkflowTypeName) +
+		len(e.executionInfo.ParentWorkflowID)
+	for _, ai := range e.pendingActivityInfoIDs {
+		size += len(ai.ActivityID) + len(ai.Details) + historyEventSize(ai.ScheduledEvent) + historyEventSize(ai.StartedEvent)
+	}
+	for _, ti := range e.pendingTimerInfoIDs {
+		size += len(ti.TimerID)
+	}
+	for _, ci := range e.pendingChildExecutionInfoIDs {
+		size += historyEventSize(ci.InitiatedEvent) + historyEventSize(ci.StartedEvent)
+	}
+	for _, si := range e.pendingSignalInfoIDs {
+		size += len(si.SignalName) + len(si.Input) + len(si.Control)
+	}
+	for _, event := range e.bufferedEvents {
+		size += historyEventSize(event)
+	}
+	for _, event := range e.updateBufferedEvents {
+		size += historyEventSize(event)
+	}
+	for _, task := range e.bufferedReplicationTasks {
+		for _, event := range task.History {
+			size += historyEventSize(event)
+		}
+		for _, event := range task.NewRunHistory {
+			size += historyEventSize(event)
+		}
+	}
+	return int64(size)
+}
+
+// SetNewRun sets the run to be created in the same transaction as the update of this execution,
+// the current execution record is moved from this execution to the new run
+func (e *mutableStateBuilder) SetNewRun(newRun *persistence.CreateWorkflowExecutionRequest) {
+	e.continueAsNew = newRun
+}
+
 func (e *mutableStateBuilder) SetNewRunSize(size int) {
 	if e.continueAsNew != nil {
 		e.continueAsNew.HistorySize = int64(size)
@@ -575,6 +615,45 @@ func convertUpdateSignalInfos(inputs map[*persistence.SignalInfo]struct{}) []*pe
 	return outputs
 }
 
+// historyEventSize estimates the size of an event kept in the mutable state by its payload
+func historyEventSize(event *workflow.HistoryEvent) int {
+	if event == nil {
+		return 0
+	}
+
+	switch event.GetEventType() {
+	case workflow.EventTypeActivityTaskScheduled:
+		attributes := event.ActivityTaskScheduledEventAttributes
+		return len(attributes.GetActivityId()) + len(attributes.Input)
+	case workflow.EventTypeActivityTaskStarted:
+		return len(event.ActivityTaskStartedEventAttributes.GetIdentity())
+	case workflow.EventTypeActivityTaskCompleted:
+		return len(event.ActivityTaskCompletedEventAttributes.Result)
+	case workflow.EventTypeActivityTaskFailed:
+		return len(event.ActivityTaskFailedEventAttributes.Details)
+	case workflow.EventTypeActivityTaskTimedOut:
+		return len(event.ActivityTaskTimedOutEventAttributes.Details)
+	case workflow.EventTypeActivityTaskCanceled:
+		return len(event.ActivityTaskCanceledEventAttributes.Details)
+	case workflow.EventTypeStartChildWorkflowExecutionInitiated:
+		attributes := event.StartChildWorkflowExecutionInitiatedEventAttributes
+		return len(attributes.GetWorkflowId()) + len(attributes.Input) + len(attributes.Control)
+	case workflow.EventTypeChildWorkflowExecutionCompleted:
+		return len(event.ChildWorkflowExecutionCompletedEventAttributes.Result)
+	case workflow.EventTypeChildWorkflowExecutionFailed:
+		return len(event.ChildWorkflowExecutionFailedEventAttributes.Details)
+	case workflow.EventTypeChildWorkflowExecutionCanceled:
+		return len(event.ChildWorkflowExecutionCanceledEventAttributes.Details)
+	case workflow.EventTypeWorkflowExecutionSignaled:
+		attributes := event.WorkflowExecutionSignaledEventAttributes
+		return len(attributes.GetSignalName()) + len(attributes.Input)
+	case workflow.EventTypeMarkerRecorded:
+		return len(event.MarkerRecordedEventAttributes.Details)
+	default:
+		return 0
+	}
+}
+
 func convertSignalRequestedIDs(inputs map[string]struct{}) []string {
 	outputs := []string{}
 	for item := range inputs {
@@ -1005,10 +1084,21 @@ func (e *mutableStateBuilder) GetPendingChildExecutionInfos() map[int64]*persist
 	return e.pendingChildExecutionInfoIDs
 }
 
+func (e *mutableStateBuilder) GetPendingSignalInfos() map[int64]*persistence.SignalInfo {
+	return e.pendingSignalInfoIDs
+}
+
 func (e *mutableStateBuilder) HasPendingDecisionTask() bool {
 	return e.executionInfo.DecisionScheduleID != common.EmptyEventID
 }
 
+// HasPendingDecisionBackoff returns true if the first decision task of the workflow is not scheduled yet
+// because it is waiting for the backoff timer of a delayed start, a retry or a cron run
+func (e *mutableStateBuilder) HasPendingDecisionBackoff() bool {
+	return e.executionInfo.HasDecisionBackoff && !e.HasPendingDecisionTask() &&
+		e.executionInfo.LastProcessedEvent == common.EmptyEventID
+}
+
 func (e *mutableStateBuilder) HasInFlightDecisionTask() bool {
 	return e.executionInfo.DecisionStartedID > 0
 }
@@ -1121,6 +1211,10 @@ func (e *mutableStateBuilder) IsCancelRequested() (bool, string) {
 	return false, ""
 }
 
+func (e *mutableStateBuilder) IsPaused() bool {
+	return e.executionInfo.Paused
+}
+
 func (e *mutableStateBuilder) IsSignalRequested(requestID string) bool {
 	if _, ok := e.pendingSignalRequestedIDs[requestID]; ok {
 		return true
@@ -1177,6 +1271,8 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(d
 		ExecutionStartToCloseTimeoutSeconds: attributes.ExecutionStartToCloseTimeoutSeconds,
 		Input:                               attributes.Input,
 		RetryPolicy:                         attributes.RetryPolicy,
+		// the first decision of a retry goes through the same backoff as a delayed start
+		DelayStartSeconds: attributes.BackoffStartIntervalInSeconds,
 	}
 
 	req := &h.StartWorkflowExecutionRequest{
@@ -1245,6 +1341,7 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionStartedEvent(domainID st
 	e.executionInfo.DecisionStartedID = common.EmptyEventID
 	e.executionInfo.DecisionRequestID = emptyUUID
 	e.executionInfo.DecisionTimeout = 0
+	e.executionInfo.HasDecisionBackoff = event.GetFirstDecisionTaskBackoffSeconds() > 0
 
 	if parentDomainID != nil {
 		e.executionInfo.ParentDomainID = *parentDomainID
@@ -1686,6 +1783,38 @@ func (e *mutableStateBuilder) ReplicateActivityTaskFailedEvent(event *workflow.H
 	return e.DeleteActivity(scheduleID)
 }
 
+func (e *mutableStateBuilder) AddActivityTaskRetryRequestedEvent(scheduleEventID int64,
+	request *workflow.RetryActivityTaskRequest) *workflow.HistoryEvent {
+	ai, ok := e.GetActivityInfo(scheduleEventID)
+	if !ok || ai.StartedID != common.EmptyEventID {
+		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionActivityTaskRetryRequested, e.GetNextEventID(), fmt.Sprintf(
+			"{ScheduleID: %v, Exist: %v}", scheduleEventID, ok))
+		return nil
+	}
+
+	attempt := ai.Attempt
+	if request.GetResetAttempts() {
+		attempt = 0
+	}
+	event := e.hBuilder.AddActivityTaskRetryRequestedEvent(scheduleEventID, ai.ActivityID, attempt, request)
+	e.ReplicateActivityTaskRetryRequestedEvent(event)
+
+	return event
+}
+
+func (e *mutableStateBuilder) ReplicateActivityTaskRetryRequestedEvent(event *workflow.HistoryEvent) {
+	attributes := event.ActivityTaskRetryRequestedEventAttributes
+	scheduleID := attributes.GetScheduledEventId()
+	ai, _ := e.GetActivityInfo(scheduleID)
+
+	// the activity is dispatched again right away, so the activity timers are recreated from the new schedule time
+	ai.Version = event.GetVersion()
+	ai.Attempt = attributes.GetAttempt()
+	ai.ScheduledTime = time.Unix(0, event.GetTimestamp())
+	ai.TimerTaskStatus = TimerTaskStatusNone
+	e.updateActivityInfos[ai] = struct{}{}
+}
+
 func (e *mutableStateBuilder) AddActivityTaskTimedOutEvent(scheduleEventID, startedEventID int64,
 	timeoutType workflow.TimeoutType, lastHeartBeatDetails []byte) *workflow.HistoryEvent {
 	if ai, ok := e.GetActivityInfo(scheduleEventID); !ok || ai.StartedID != startedEventID ||
@@ -2160,6 +2289,42 @@ func (e *mutableStateBuilder) AddWorkflowExecutionSignaled(
 	return e.hBuilder.AddWorkflowExecutionSignaledEvent(request)
 }
 
+func (e *mutableStateBuilder) AddWorkflowExecutionPausedEvent(
+	request *workflow.PauseWorkflowExecutionRequest) *workflow.HistoryEvent {
+	if e.executionInfo.State == persistence.WorkflowStateCompleted || e.executionInfo.Paused {
+		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionWorkflowPaused, e.GetNextEventID(), fmt.Sprintf(
+			"{State: %v, Paused: %v}", e.executionInfo.State, e.executionInfo.Paused))
+		return nil
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionPausedEvent(request)
+	e.ReplicateWorkflowExecutionPausedEvent(event)
+
+	return event
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionPausedEvent(event *workflow.HistoryEvent) {
+	e.executionInfo.Paused = true
+}
+
+func (e *mutableStateBuilder) AddWorkflowExecutionResumedEvent(
+	request *workflow.ResumeWorkflowExecutionRequest) *workflow.HistoryEvent {
+	if e.executionInfo.State == persistence.WorkflowStateCompleted || !e.executionInfo.Paused {
+		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionWorkflowResumed, e.GetNextEventID(), fmt.Sprintf(
+			"{State: %v, Paused: %v}", e.executionInfo.State, e.executionInfo.Paused))
+		return nil
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionResumedEvent(request)
+	e.ReplicateWorkflowExecutionResumedEvent(event)
+
+	return event
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionResumedEvent(event *workflow.HistoryEvent) {
+	e.executionInfo.Paused = false
+}
+
 func (e *mutableStateBuilder) AddContinueAsNewEvent(decisionCompletedEventID int64, domainEntry *cache.DomainCacheEntry,
 	parentDomainName string, attributes *workflow.ContinueAsNewWorkflowExecutionDecisionAttributes) (*workflow.HistoryEvent, mutableState,
 	error) {
@@ -2258,6 +2423,7 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionContinuedAsNewEvent(sour
 		ExecutionContext:     nil,
 		NextEventID:          newStateBuilder.GetNextEventID(),
 		LastProcessedEvent:   common.EmptyEventID,
+		HasDecisionBackoff:   newExecutionInfo.HasDecisionBackoff,
 		CreateWorkflowMode:   persistence.CreateWorkflowModeContinueAsNew,
 		PreviousRunID:        prevRunID,
 		ReplicationState:     newStateBuilder.GetReplicationState(),
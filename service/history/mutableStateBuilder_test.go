@@ -239,3 +239,50 @@ func (s *mutableStateSuite) TestReorderEvents() {
 	s.Equal(int64(5), s.msBuilder.hBuilder.history[1].ActivityTaskCompletedEventAttributes.GetScheduledEventId())
 
 }
+
+func (s *mutableStateSuite) TestGetMutableStateSize() {
+	info := &persistence.WorkflowExecutionInfo{
+		DomainID:         validDomainID,
+		WorkflowID:       "wId",
+		RunID:            validRunID,
+		TaskList:         "testTaskList",
+		WorkflowTypeName: "wType",
+		NextEventID:      int64(8),
+	}
+	activityInfos := map[int64]*persistence.ActivityInfo{
+		5: &persistence.ActivityInfo{
+			ScheduleID: int64(5),
+			StartedID:  common.EmptyEventID,
+			ActivityID: "activity_id",
+			ScheduledEvent: &workflow.HistoryEvent{
+				EventId:   common.Int64Ptr(5),
+				EventType: workflow.EventTypeActivityTaskScheduled.Ptr(),
+				ActivityTaskScheduledEventAttributes: &workflow.ActivityTaskScheduledEventAttributes{
+					ActivityId: common.StringPtr("activity_id"),
+					Input:      []byte("activity_input"),
+				},
+			},
+		},
+	}
+	signalInfos := map[int64]*persistence.SignalInfo{
+		6: &persistence.SignalInfo{
+			InitiatedID: int64(6),
+			SignalName:  "signal_name",
+			Input:       []byte("signal_input"),
+		},
+	}
+	s.msBuilder.Load(&persistence.WorkflowMutableState{
+		ExecutionInfo: info,
+		ActivityInfos: activityInfos,
+		SignalInfos:   signalInfos,
+	})
+
+	executionInfoSize := len("wId") + len("testTaskList") + len("wType")
+	activityInfoSize := len("activity_id") + len("activity_id") + len("activity_input")
+	signalInfoSize := len("signal_name") + len("signal_input")
+	s.Equal(int64(executionInfoSize+activityInfoSize+signalInfoSize), s.msBuilder.GetMutableStateSize())
+
+	s.Nil(s.msBuilder.DeleteActivity(5))
+	s.msBuilder.DeletePendingSignal(6)
+	s.Equal(int64(executionInfoSize), s.msBuilder.GetMutableStateSize())
+}
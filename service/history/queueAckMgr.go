@@ -156,6 +156,28 @@ func (a *queueAckMgrImpl) getQueueReadLevel() int64 {
 	return a.readLevel
 }
 
+// getQueueOutstandingTaskCount returns the number of loaded tasks which are not acked yet
+func (a *queueAckMgrImpl) getQueueOutstandingTaskCount() int {
+	a.RLock()
+	defer a.RUnlock()
+
+	count := 0
+	for _, acked := range a.outstandingTasks {
+		if !acked {
+			count++
+		}
+	}
+	return count
+}
+
+func (a *queueAckMgrImpl) isQueueTaskOutstanding(taskID int64) bool {
+	a.RLock()
+	defer a.RUnlock()
+
+	acked, ok := a.outstandingTasks[taskID]
+	return ok && !acked
+}
+
 func (a *queueAckMgrImpl) getFinishedChan() <-chan struct{} {
 	return a.finishedChan
 }
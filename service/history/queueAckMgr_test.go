@@ -231,6 +231,49 @@ func (s *queueAckMgrSuite) TestReadCompleteTimerTasks() {
 	s.Equal(map[int64]bool{taskID: true}, s.queueAckMgr.outstandingTasks)
 }
 
+func (s *queueAckMgrSuite) TestOutstandingTasks() {
+	readLevel := s.queueAckMgr.readLevel
+
+	moreInput := false
+	taskID1 := int64(59)
+	taskID2 := int64(60)
+	tasksInput := []queueTaskInfo{
+		&p.TransferTaskInfo{
+			DomainID:   "some random domain ID",
+			WorkflowID: "some random workflow ID",
+			RunID:      uuid.New(),
+			TaskID:     taskID1,
+			TaskList:   "some random tasklist",
+			TaskType:   1,
+			ScheduleID: 28,
+		},
+		&p.TransferTaskInfo{
+			DomainID:   "some random domain ID",
+			WorkflowID: "some random workflow ID",
+			RunID:      uuid.New(),
+			TaskID:     taskID2,
+			TaskList:   "some random tasklist",
+			TaskType:   1,
+			ScheduleID: 29,
+		},
+	}
+
+	s.False(s.queueAckMgr.isQueueTaskOutstanding(taskID1))
+	s.Equal(0, s.queueAckMgr.getQueueOutstandingTaskCount())
+
+	s.mockProcessor.On("readTasks", readLevel).Return(tasksInput, moreInput, nil).Once()
+	_, _, err := s.queueAckMgr.readQueueTasks()
+	s.Nil(err)
+	s.True(s.queueAckMgr.isQueueTaskOutstanding(taskID1))
+	s.True(s.queueAckMgr.isQueueTaskOutstanding(taskID2))
+	s.Equal(2, s.queueAckMgr.getQueueOutstandingTaskCount())
+
+	s.queueAckMgr.completeQueueTask(taskID1)
+	s.False(s.queueAckMgr.isQueueTaskOutstanding(taskID1))
+	s.True(s.queueAckMgr.isQueueTaskOutstanding(taskID2))
+	s.Equal(1, s.queueAckMgr.getQueueOutstandingTaskCount())
+}
+
 func (s *queueAckMgrSuite) TestReadCompleteUpdateTimerTasks() {
 	readLevel := s.queueAckMgr.readLevel
 	// when the ack manager is first initialized, read == ack level
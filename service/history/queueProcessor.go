@@ -27,6 +27,7 @@ import (
 	"time"
 
 	"github.com/uber-common/bark"
+	h "github.com/uber/cadence/.gen/go/history"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
@@ -295,10 +296,19 @@ func (p *queueProcessorBase) processTaskAndAck(notificationChan <-chan struct{},
 				return
 			}
 
+			if !p.ackMgr.isQueueTaskOutstanding(task.GetTaskID()) {
+				// the task is force completed by an operator while being retried
+				return
+			}
+
 			attempt++
 
 			if attempt >= p.options.MaxRetryCount() {
 				p.metricsClient.RecordTimer(scope, metrics.TaskAttemptTimer, time.Duration(attempt))
+				if quarantineTask(p.shard, task, attempt, err, scope, logger) {
+					p.ackTaskOnce(task, scope)
+					return
+				}
 				switch task.(type) {
 				case *persistence.TransferTaskInfo:
 					logging.LogCriticalErrorEvent(logger, "Critical error processing transfer task, retrying.", err)
@@ -384,6 +394,16 @@ func (p *queueProcessorBase) ackTaskOnce(task queueTaskInfo, scope int) {
 	)
 }
 
+func (p *queueProcessorBase) getQueueState(queueType h.ShardQueueType) *h.ShardQueueState {
+	return &h.ShardQueueState{
+		QueueType:        queueType.Ptr(),
+		ClusterName:      common.StringPtr(p.clusterName),
+		AckLevel:         common.Int64Ptr(p.ackMgr.getQueueAckLevel()),
+		ReadLevel:        common.Int64Ptr(p.ackMgr.getQueueReadLevel()),
+		OutstandingTasks: common.Int32Ptr(int32(p.ackMgr.getQueueOutstandingTaskCount())),
+	}
+}
+
 func (p *queueProcessorBase) initializeLoggerForTask(task queueTaskInfo) bark.Logger {
 	logger := p.logger.WithFields(bark.Fields{
 		logging.TagHistoryShardID: p.shard.GetShardID(),
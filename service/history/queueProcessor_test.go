@@ -21,6 +21,7 @@
 package history
 
 import (
+	"context"
 	"errors"
 	"os"
 	"testing"
@@ -29,9 +30,11 @@ import (
 	workflow "github.com/uber/cadence/.gen/go/shared"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-common/bark"
 	"github.com/uber-go/tally"
+	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/messaging"
@@ -53,6 +56,7 @@ type (
 		mockMessagingClient messaging.Client
 		mockProcessor       *MockProcessor
 		mockQueueAckMgr     *MockQueueAckMgr
+		mockQuarantineMgr   *mocks.QuarantineManager
 
 		scope            int
 		notificationChan chan struct{}
@@ -79,6 +83,7 @@ func (s *queueProcessorSuite) TearDownSuite() {
 	s.mockClusterMetadata.AssertExpectations(s.T())
 	s.mockProcessor.AssertExpectations(s.T())
 	s.mockQueueAckMgr.AssertExpectations(s.T())
+	s.mockQuarantineMgr.AssertExpectations(s.T())
 }
 
 func (s *queueProcessorSuite) SetupTest() {
@@ -90,6 +95,7 @@ func (s *queueProcessorSuite) SetupTest() {
 	s.logger = bark.NewLoggerFromLogrus(log2)
 	s.mockProcessor = &MockProcessor{}
 	s.mockQueueAckMgr = &MockQueueAckMgr{}
+	s.mockQuarantineMgr = &mocks.QuarantineManager{}
 	s.mockMetadataMgr = &mocks.MetadataManager{}
 	s.mockClusterMetadata = &mocks.ClusterMetadata{}
 	s.mockService = service.NewTestService(s.mockClusterMetadata, nil, metricsClient, s.logger)
@@ -104,6 +110,7 @@ func (s *queueProcessorSuite) SetupTest() {
 		domainCache:               cache.NewDomainCache(s.mockMetadataMgr, s.mockClusterMetadata, metricsClient, s.logger),
 		metricsClient:             metricsClient,
 		standbyClusterCurrentTime: make(map[string]time.Time),
+		quarantineMgr:             s.mockQuarantineMgr,
 	}
 
 	s.scope = 0
@@ -146,6 +153,65 @@ func (s *queueProcessorSuite) TestProcessTaskAndAck_ErrNoErr() {
 	s.queueProcessor.processTaskAndAck(s.notificationChan, task)
 }
 
+func (s *queueProcessorSuite) TestProcessTaskAndAck_Quarantined() {
+	err := &workflow.BadRequestError{Message: "some poison err"}
+	task := &persistence.TransferTaskInfo{
+		TaskID:     12345,
+		DomainID:   "some random domain ID",
+		WorkflowID: "some random workflow ID",
+		RunID:      "some random run ID",
+		TaskType:   persistence.TransferTaskTypeDecisionTask,
+	}
+	s.setupQuarantineRetry()
+	s.mockProcessor.On("process", task).Return(s.scope, err)
+	s.mockQueueAckMgr.On("isQueueTaskOutstanding", task.GetTaskID()).Return(true).Once()
+	s.mockQuarantineMgr.On("PutQuarantinedTask", mock.MatchedBy(func(request *persistence.PutQuarantinedTaskRequest) bool {
+		taskInfo := request.TaskInfo
+		return taskInfo.QueueType == persistence.QuarantineQueueTypeTransfer &&
+			taskInfo.TaskID == task.TaskID &&
+			taskInfo.WorkflowID == task.WorkflowID &&
+			taskInfo.Attempt == 1 &&
+			taskInfo.Error == err.Error() &&
+			taskInfo.StackTrace != ""
+	})).Return(nil).Once()
+	s.mockQueueAckMgr.On("completeQueueTask", task.GetTaskID()).Once()
+	s.queueProcessor.processTaskAndAck(s.notificationChan, task)
+}
+
+func (s *queueProcessorSuite) TestProcessTaskAndAck_TransientErrNotQuarantined() {
+	err := &workflow.LimitExceededError{}
+	task := &persistence.TransferTaskInfo{TaskID: 12345}
+	s.setupQuarantineRetry()
+	s.mockProcessor.On("process", task).Return(s.scope, err).Twice()
+	s.mockProcessor.On("process", task).Return(s.scope, nil).Once()
+	s.mockQueueAckMgr.On("isQueueTaskOutstanding", task.GetTaskID()).Return(true).Once()
+	s.mockQueueAckMgr.On("completeQueueTask", task.GetTaskID()).Once()
+	s.queueProcessor.processTaskAndAck(s.notificationChan, task)
+}
+
+func (s *queueProcessorSuite) TestProcessTaskAndAck_UnknownErrNotQuarantined() {
+	err := context.DeadlineExceeded
+	task := &persistence.TransferTaskInfo{TaskID: 12345}
+	s.setupQuarantineRetry()
+	s.mockProcessor.On("process", task).Return(s.scope, err).Twice()
+	s.mockProcessor.On("process", task).Return(s.scope, nil).Once()
+	s.mockQueueAckMgr.On("isQueueTaskOutstanding", task.GetTaskID()).Return(true).Once()
+	s.mockQueueAckMgr.On("completeQueueTask", task.GetTaskID()).Once()
+	s.queueProcessor.processTaskAndAck(s.notificationChan, task)
+}
+
+func (s *queueProcessorSuite) TestProcessTaskAndAck_QuarantineDisabled() {
+	err := &workflow.BadRequestError{Message: "some poison err"}
+	task := &persistence.TransferTaskInfo{TaskID: 12345}
+	s.setupQuarantineRetry()
+	s.mockShard.GetConfig().EnableTaskQuarantine = dynamicconfig.GetBoolPropertyFn(false)
+	s.mockProcessor.On("process", task).Return(s.scope, err).Twice()
+	s.mockProcessor.On("process", task).Return(s.scope, nil).Once()
+	s.mockQueueAckMgr.On("isQueueTaskOutstanding", task.GetTaskID()).Return(true).Once()
+	s.mockQueueAckMgr.On("completeQueueTask", task.GetTaskID()).Once()
+	s.queueProcessor.processTaskAndAck(s.notificationChan, task)
+}
+
 func (s *queueProcessorSuite) TestHandleTaskError_EntiryNotExists() {
 	err := &workflow.EntityNotExistsError{}
 	s.Nil(s.queueProcessor.handleTaskError(s.scope, time.Now(), s.notificationChan, err, s.logger))
@@ -196,3 +262,11 @@ func (s *queueProcessorSuite) TestHandleTaskError_RandomErr() {
 	err := errors.New("random error")
 	s.Equal(err, s.queueProcessor.handleTaskError(s.scope, time.Now(), s.notificationChan, err, s.logger))
 }
+
+func (s *queueProcessorSuite) setupQuarantineRetry() {
+	retryPolicy := backoff.NewExponentialRetryPolicy(time.Millisecond)
+	retryPolicy.SetMaximumAttempts(1)
+	s.queueProcessor.retryPolicy = retryPolicy
+	s.queueProcessor.options.MaxRetryCount = dynamicconfig.GetIntPropertyFn(1)
+	s.mockShard.GetConfig().EnableTaskQuarantine = dynamicconfig.GetBoolPropertyFn(true)
+}
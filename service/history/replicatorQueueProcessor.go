@@ -21,6 +21,7 @@
 package history
 
 import (
+	"context"
 	"errors"
 	"sync"
 	"time"
@@ -44,6 +45,7 @@ type (
 		executionMgr        persistence.ExecutionManager
 		historyMgr          persistence.HistoryManager
 		historyV2Mgr        persistence.HistoryV2Manager
+		historySerializer   persistence.HistorySerializer
 		replicator          messaging.Producer
 		metricsClient       metrics.Client
 		options             *QueueProcessorOptions
@@ -59,11 +61,17 @@ type (
 var (
 	errUnknownReplicationTask = errors.New("Unknown replication task")
 	errHistoryNotFoundTask    = errors.New("History not found")
+	errReplicationTasksPushed = &shared.BadRequestError{Message: "Replication tasks of current cluster are published to kafka."}
 	defaultHistoryPageSize    = 1000
 )
 
+const (
+	// replicationStatusMaxScanTasks bounds the replication tasks read for the replication status of a shard
+	replicationStatusMaxScanTasks = 10000
+)
+
 func newReplicatorQueueProcessor(shard ShardContext, historyCache *historyCache, replicator messaging.Producer,
-	executionMgr persistence.ExecutionManager, historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager, logger bark.Logger) queueProcessor {
+	executionMgr persistence.ExecutionManager, historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager, logger bark.Logger) replicatorQueueProcessor {
 
 	currentClusterNamer := shard.GetService().GetClusterMetadata().GetCurrentClusterName()
 
@@ -92,6 +100,7 @@ func newReplicatorQueueProcessor(shard ShardContext, historyCache *historyCache,
 		executionMgr:        executionMgr,
 		historyMgr:          historyMgr,
 		historyV2Mgr:        historyV2Mgr,
+		historySerializer:   persistence.NewHistorySerializer(),
 		replicator:          replicator,
 		metricsClient:       shard.GetMetricsClient(),
 		options:             options,
@@ -106,6 +115,14 @@ func newReplicatorQueueProcessor(shard ShardContext, historyCache *historyCache,
 	return processor
 }
 
+func (p *replicatorQueueProcessorImpl) Start() {
+	if p.replicator == nil {
+		// replication tasks are pulled by the remote clusters, see getTasks
+		return
+	}
+	p.queueProcessorBase.Start()
+}
+
 func (p *replicatorQueueProcessorImpl) process(qTask queueTaskInfo) (int, error) {
 	task, ok := qTask.(*persistence.ReplicationTaskInfo)
 	if !ok {
@@ -138,7 +155,16 @@ func (p *replicatorQueueProcessorImpl) queueShutdown() error {
 	return nil
 }
 
-func (p *replicatorQueueProcessorImpl) processSyncActivityTask(task *persistence.ReplicationTaskInfo) (retError error) {
+func (p *replicatorQueueProcessorImpl) processSyncActivityTask(task *persistence.ReplicationTaskInfo) error {
+	replicationTask, err := p.generateSyncActivityTask(task)
+	if err != nil || replicationTask == nil {
+		return err
+	}
+
+	return p.replicator.Publish(replicationTask)
+}
+
+func (p *replicatorQueueProcessorImpl) generateSyncActivityTask(task *persistence.ReplicationTaskInfo) (retTask *replicator.ReplicationTask, retError error) {
 	domainID := task.DomainID
 	execution := shared.WorkflowExecution{
 		WorkflowId: common.StringPtr(task.WorkflowID),
@@ -146,25 +172,25 @@ func (p *replicatorQueueProcessorImpl) processSyncActivityTask(task *persistence
 	}
 	context, release, err := p.historyCache.getOrCreateWorkflowExecution(domainID, execution)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer func() { release(retError) }()
 
 	msBuilder, err := context.loadWorkflowExecution()
 	if err != nil {
 		if _, ok := err.(*shared.EntityNotExistsError); ok {
-			return nil
+			return nil, nil
 		}
-		return err
+		return nil, err
 	}
 	if !msBuilder.IsWorkflowExecutionRunning() {
 		// workflow already finished, no need to process the timer
-		return nil
+		return nil, nil
 	}
 
 	activityInfo, ok := msBuilder.GetActivityInfo(task.ScheduledID)
 	if !ok {
-		return nil
+		return nil, nil
 	}
 
 	// int64 can only represent several hundred years of time
@@ -177,7 +203,7 @@ func (p *replicatorQueueProcessorImpl) processSyncActivityTask(task *persistence
 		heartbeatTime = activityInfo.StartedTime.UnixNano()
 	}
 
-	replicationTask := &replicator.ReplicationTask{
+	return &replicator.ReplicationTask{
 		TaskType: replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeSyncActivity),
 		SyncActicvityTaskAttributes: &replicator.SyncActicvityTaskAttributes{
 			DomainId:          common.StringPtr(task.DomainID),
@@ -192,16 +218,42 @@ func (p *replicatorQueueProcessorImpl) processSyncActivityTask(task *persistence
 			Details:           activityInfo.Details,
 			Attempt:           common.Int32Ptr(activityInfo.Attempt),
 		},
+	}, nil
+}
+
+func (p *replicatorQueueProcessorImpl) processHistoryReplicationTask(task *persistence.ReplicationTaskInfo) error {
+	replicationTask, err := p.generateHistoryReplicationTask(task)
+	if err != nil {
+		return err
 	}
 
-	return p.replicator.Publish(replicationTask)
+	err = p.replicator.Publish(replicationTask)
+	if err == nil {
+		p.Lock()
+		p.lastShardSyncTimestamp = common.NewRealTimeSource().Now()
+		p.Unlock()
+		p.emitReplicationTaskLag(replicationTask)
+	}
+	return err
 }
 
-func (p *replicatorQueueProcessorImpl) processHistoryReplicationTask(task *persistence.ReplicationTaskInfo) error {
+// emitReplicationTaskLag records how long the events of a history replication task waited before being sent out
+func (p *replicatorQueueProcessorImpl) emitReplicationTaskLag(replicationTask *replicator.ReplicationTask) {
+	if replicationTask.HistoryTaskAttributes == nil || replicationTask.HistoryTaskAttributes.History == nil {
+		return
+	}
+	events := replicationTask.HistoryTaskAttributes.History.Events
+	if len(events) == 0 {
+		return
+	}
+	lastEventTime := time.Unix(0, events[len(events)-1].GetTimestamp())
+	p.metricsClient.RecordTimer(metrics.ReplicatorTaskHistoryScope, metrics.ReplicationTaskLagTimer, time.Since(lastEventTime))
+}
 
+func (p *replicatorQueueProcessorImpl) generateHistoryReplicationTask(task *persistence.ReplicationTaskInfo) (*replicator.ReplicationTask, error) {
 	domainEntry, err := p.shard.GetDomainCache().GetDomainByID(task.DomainID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	targetClusters := []string{}
 	for _, cluster := range domainEntry.GetReplicationConfig().Clusters {
@@ -210,7 +262,7 @@ func (p *replicatorQueueProcessorImpl) processHistoryReplicationTask(task *persi
 
 	history, err := p.getHistory(task.DomainID, task.WorkflowID, task.RunID, task.FirstEventID, task.NextEventID, task.EventStoreVersion, task.BranchToken)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Check if this is replication task for ContinueAsNew event, then retrieve the history for new execution
@@ -222,12 +274,12 @@ func (p *replicatorQueueProcessorImpl) processHistoryReplicationTask(task *persi
 			newRunID := lastEvent.WorkflowExecutionContinuedAsNewEventAttributes.GetNewExecutionRunId()
 			newRunHistory, err = p.getHistory(task.DomainID, task.WorkflowID, newRunID, common.FirstEventID, int64(3), task.NewRunEventStoreVersion, task.NewRunBranchToken)
 			if err != nil {
-				return err
+				return nil, err
 			}
 		}
 	}
 
-	replicationTask := &replicator.ReplicationTask{
+	return &replicator.ReplicationTask{
 		TaskType: replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeHistory),
 		HistoryTaskAttributes: &replicator.HistoryTaskAttributes{
 			TargetClusters:          targetClusters,
@@ -243,15 +295,7 @@ func (p *replicatorQueueProcessorImpl) processHistoryReplicationTask(task *persi
 			EventStoreVersion:       common.Int32Ptr(task.EventStoreVersion),
 			NewRunEventStoreVersion: common.Int32Ptr(task.NewRunEventStoreVersion),
 		},
-	}
-
-	err = p.replicator.Publish(replicationTask)
-	if err == nil {
-		p.Lock()
-		p.lastShardSyncTimestamp = common.NewRealTimeSource().Now()
-		p.Unlock()
-	}
-	return err
+	}, nil
 }
 
 func (p *replicatorQueueProcessorImpl) readTasks(readLevel int64) ([]queueTaskInfo, bool, error) {
@@ -303,6 +347,355 @@ func (p *replicatorQueueProcessorImpl) updateAckLevel(ackLevel int64) error {
 	return err
 }
 
+// getTasks returns the replication tasks after the given read level, it is used by the remote clusters
+// pulling the replication tasks over rpc instead of consuming them from kafka
+func (p *replicatorQueueProcessorImpl) getTasks(readLevel int64) (*replicator.ReplicationMessages, error) {
+	if p.replicator != nil {
+		return nil, errReplicationTasksPushed
+	}
+
+	// tasks before the ack level are already processed by all the remote clusters
+	if ackLevel := p.shard.GetReplicatorAckLevel(); readLevel < ackLevel {
+		readLevel = ackLevel
+	}
+
+	response, err := p.executionMgr.GetReplicationTasks(&persistence.GetReplicationTasksRequest{
+		ReadLevel:    readLevel,
+		MaxReadLevel: p.shard.GetTransferMaxReadLevel(),
+		BatchSize:    p.options.BatchSize(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	replicationTasks := []*replicator.ReplicationTask{}
+	for _, task := range response.Tasks {
+		replicationTask, err := p.toReplicationTask(task)
+		if err != nil {
+			if _, ok := err.(*shared.EntityNotExistsError); !ok {
+				return nil, err
+			}
+			// the workflow is already deleted, there is nothing left to replicate
+			p.logger.WithFields(bark.Fields{
+				logging.TagTaskID:              task.GetTaskID(),
+				logging.TagWorkflowExecutionID: task.WorkflowID,
+				logging.TagWorkflowRunID:       task.RunID,
+			}).Warn("Skipping replication task, history not found.")
+		} else if replicationTask != nil {
+			p.emitReplicationTaskLag(replicationTask)
+			replicationTasks = append(replicationTasks, replicationTask)
+		}
+		readLevel = task.GetTaskID()
+	}
+
+	return &replicator.ReplicationMessages{
+		ReplicationTasks:       replicationTasks,
+		LastRetrievedMessageId: common.Int64Ptr(readLevel),
+		HasMore:                common.BoolPtr(len(response.NextPageToken) != 0),
+		SyncShardStatus: &replicator.SyncShardStatus{
+			Timestamp: common.Int64Ptr(common.NewRealTimeSource().Now().UnixNano()),
+		},
+	}, nil
+}
+
+// ackTasks records the last task processed by the remote cluster in the shard info, replication tasks are
+// deleted once they are processed by all the remote clusters pulling them. A remote cluster is only known
+// to pull the replication tasks after its first poll, so clusters consuming from kafka or not replicating
+// at all never hold back the deletion of the tasks.
+func (p *replicatorQueueProcessorImpl) ackTasks(clusterName string, lastProcessedTaskID int64) error {
+	if p.replicator != nil {
+		return errReplicationTasksPushed
+	}
+
+	p.Lock()
+	clusterLevels := p.shard.GetAllClusterReplicationLevels()
+	if clusterLevel, ok := clusterLevels[clusterName]; !ok || lastProcessedTaskID > clusterLevel {
+		if err := p.shard.UpdateClusterReplicationLevel(clusterName, lastProcessedTaskID); err != nil {
+			p.Unlock()
+			return err
+		}
+		clusterLevels[clusterName] = lastProcessedTaskID
+	}
+	p.Unlock()
+
+	ackLevel := clusterLevels[clusterName]
+	for cluster := range p.shard.GetService().GetClusterMetadata().GetAllClusterFailoverVersions() {
+		if cluster == p.currentClusterNamer {
+			continue
+		}
+		// clusters not in the replication levels are not pulling the replication tasks
+		if clusterLevel, ok := clusterLevels[cluster]; ok && clusterLevel < ackLevel {
+			ackLevel = clusterLevel
+		}
+	}
+
+	currentAckLevel := p.shard.GetReplicatorAckLevel()
+	if ackLevel <= currentAckLevel {
+		return nil
+	}
+
+	response, err := p.executionMgr.GetReplicationTasks(&persistence.GetReplicationTasksRequest{
+		ReadLevel:    currentAckLevel,
+		MaxReadLevel: ackLevel,
+		BatchSize:    p.options.BatchSize(),
+	})
+	if err != nil {
+		return err
+	}
+	for _, task := range response.Tasks {
+		if err := p.executionMgr.CompleteReplicationTask(&persistence.CompleteReplicationTaskRequest{TaskID: task.GetTaskID()}); err != nil {
+			return err
+		}
+		currentAckLevel = task.GetTaskID()
+	}
+	if len(response.NextPageToken) == 0 {
+		currentAckLevel = ackLevel
+	}
+	return p.shard.UpdateReplicatorAckLevel(currentAckLevel)
+}
+
+// getStatus returns the replication status of the shard against each remote cluster, only the replication tasks
+// of the given domain are counted as pending if domainID is not empty. At most replicationStatusMaxScanTasks tasks
+// are scanned, so the pending task counts are lower bounds if the shard is far behind.
+func (p *replicatorQueueProcessorImpl) getStatus(domainID string) (*h.ShardReplicationStatus, error) {
+	ackLevel := p.shard.GetReplicatorAckLevel()
+	maxReadLevel := p.shard.GetTransferMaxReadLevel()
+
+	clusterLevels := p.shard.GetAllClusterReplicationLevels()
+	clusterAckLevels := make(map[string]int64)
+	for cluster := range p.shard.GetService().GetClusterMetadata().GetAllClusterFailoverVersions() {
+		if cluster == p.currentClusterNamer {
+			continue
+		}
+		clusterAckLevel, ok := clusterLevels[cluster]
+		if p.replicator != nil || !ok || clusterAckLevel < ackLevel {
+			// tasks pushed to kafka are tracked by the shard ack level only
+			clusterAckLevel = ackLevel
+		}
+		clusterAckLevels[cluster] = clusterAckLevel
+	}
+
+	pendingTaskCount := int64(0)
+	clusterPendingTaskCounts := make(map[string]int64)
+	truncated := false
+	request := &persistence.GetReplicationTasksRequest{
+		ReadLevel:    ackLevel,
+		MaxReadLevel: maxReadLevel,
+		BatchSize:    p.options.BatchSize(),
+	}
+	// task IDs are shared with the transfer tasks, so the pending tasks can only be counted by reading them
+	scanned := 0
+	hasMore := ackLevel < maxReadLevel
+	for hasMore {
+		response, err := p.executionMgr.GetReplicationTasks(request)
+		if err != nil {
+			return nil, err
+		}
+		scanned += len(response.Tasks)
+		for _, task := range response.Tasks {
+			if domainID != "" && task.DomainID != domainID {
+				continue
+			}
+			pendingTaskCount++
+			for cluster, clusterAckLevel := range clusterAckLevels {
+				if task.GetTaskID() > clusterAckLevel {
+					clusterPendingTaskCounts[cluster]++
+				}
+			}
+		}
+		request.NextPageToken = response.NextPageToken
+		hasMore = len(response.NextPageToken) != 0
+		if hasMore && scanned >= replicationStatusMaxScanTasks {
+			truncated = true
+			break
+		}
+	}
+
+	clusters := make(map[string]*h.ShardClusterReplicationStatus)
+	for cluster, clusterAckLevel := range clusterAckLevels {
+		clusters[cluster] = &h.ShardClusterReplicationStatus{
+			AckLevel:         common.Int64Ptr(clusterAckLevel),
+			PendingTaskCount: common.Int64Ptr(clusterPendingTaskCounts[cluster]),
+		}
+	}
+
+	return &h.ShardReplicationStatus{
+		ShardID:                   common.Int32Ptr(int32(p.shard.GetShardID())),
+		ReplicationAckLevel:       common.Int64Ptr(ackLevel),
+		MaxReadLevel:              common.Int64Ptr(maxReadLevel),
+		PendingTaskCount:          common.Int64Ptr(pendingTaskCount),
+		Clusters:                  clusters,
+		PendingTaskCountTruncated: common.BoolPtr(truncated),
+	}, nil
+}
+
+// getWorkflowTasks rebuilds the history replication tasks of the given event range of a workflow execution from its
+// history, one task per run of consecutive events written with the same version, split further so that no task
+// exceeds the configured number of events or bytes
+func (p *replicatorQueueProcessorImpl) getWorkflowTasks(ctx context.Context, domainID string, execution shared.WorkflowExecution,
+	firstEventID, nextEventID int64) (retTasks []*replicator.ReplicationTask, retError error) {
+
+	domainEntry, err := p.shard.GetDomainCache().GetDomainByID(domainID)
+	if err != nil {
+		return nil, err
+	}
+	targetClusters := []string{}
+	for _, cluster := range domainEntry.GetReplicationConfig().Clusters {
+		targetClusters = append(targetClusters, cluster.ClusterName)
+	}
+
+	context, release, err := p.historyCache.getOrCreateWorkflowExecutionWithTimeout(ctx, domainID, execution)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { release(retError) }()
+
+	msBuilder, err := context.loadWorkflowExecution()
+	if err != nil {
+		return nil, err
+	}
+	if msBuilder.GetReplicationState() == nil {
+		return nil, &shared.BadRequestError{Message: "Workflow execution is not replicated."}
+	}
+	if firstEventID < common.FirstEventID {
+		firstEventID = common.FirstEventID
+	}
+	if nextEventID <= 0 || nextEventID > msBuilder.GetNextEventID() {
+		nextEventID = msBuilder.GetNextEventID()
+	}
+	if firstEventID >= nextEventID {
+		return []*replicator.ReplicationTask{}, nil
+	}
+
+	runID := msBuilder.GetExecutionInfo().RunID
+	eventStoreVersion := msBuilder.GetEventStoreVersion()
+	history, err := p.getHistory(domainID, execution.GetWorkflowId(), runID, firstEventID, nextEventID,
+		eventStoreVersion, msBuilder.GetCurrentBranch())
+	if err != nil {
+		return nil, err
+	}
+
+	// the replication info of a task is the last event written by each remote cluster as of the task,
+	// start from what was known before the requested range and move it forward chunk by chunk
+	clusterMetadata := p.shard.GetService().GetClusterMetadata()
+	replicationInfo := make(map[string]*persistence.ReplicationInfo)
+	for cluster, info := range msBuilder.GetReplicationState().LastReplicationInfo {
+		if info.LastEventID < firstEventID {
+			replicationInfo[cluster] = info
+		}
+	}
+
+	tasks := []*replicator.ReplicationTask{}
+	events := history.Events
+	for len(events) > 0 {
+		version := events[0].GetVersion()
+		size, err := p.getWorkflowTaskSize(events)
+		if err != nil {
+			return nil, err
+		}
+		chunk := events[:size]
+		events = events[size:]
+
+		lastEvent := chunk[len(chunk)-1]
+		if cluster := clusterMetadata.ClusterNameForFailoverVersion(version); cluster != p.currentClusterNamer {
+			replicationInfo[cluster] = &persistence.ReplicationInfo{
+				Version:     version,
+				LastEventID: lastEvent.GetEventId(),
+			}
+		}
+
+		var newRunHistory *shared.History
+		newRunEventStoreVersion := int32(0)
+		if lastEvent.GetEventType() == shared.EventTypeWorkflowExecutionContinuedAsNew {
+			newExecution := shared.WorkflowExecution{
+				WorkflowId: execution.WorkflowId,
+				RunId:      lastEvent.WorkflowExecutionContinuedAsNewEventAttributes.NewExecutionRunId,
+			}
+			var newRunBranchToken []byte
+			newRunEventStoreVersion, newRunBranchToken, err = p.getHistoryBranch(ctx, domainID, newExecution)
+			if err != nil {
+				return nil, err
+			}
+			newRunHistory, err = p.getHistory(domainID, newExecution.GetWorkflowId(), newExecution.GetRunId(),
+				common.FirstEventID, int64(3), newRunEventStoreVersion, newRunBranchToken)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		tasks = append(tasks, &replicator.ReplicationTask{
+			TaskType: replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeHistory),
+			HistoryTaskAttributes: &replicator.HistoryTaskAttributes{
+				TargetClusters:          targetClusters,
+				DomainId:                common.StringPtr(domainID),
+				WorkflowId:              common.StringPtr(execution.GetWorkflowId()),
+				RunId:                   common.StringPtr(runID),
+				FirstEventId:            common.Int64Ptr(chunk[0].GetEventId()),
+				NextEventId:             common.Int64Ptr(lastEvent.GetEventId() + 1),
+				Version:                 common.Int64Ptr(version),
+				ReplicationInfo:         convertLastReplicationInfo(replicationInfo),
+				History:                 &shared.History{Events: chunk},
+				NewRunHistory:           newRunHistory,
+				EventStoreVersion:       common.Int32Ptr(eventStoreVersion),
+				NewRunEventStoreVersion: common.Int32Ptr(newRunEventStoreVersion),
+			},
+		})
+	}
+
+	return tasks, nil
+}
+
+// getWorkflowTaskSize returns the number of leading events that go into one replication task, the events must have
+// the same version and stay within the configured number of events and bytes, the first event is always taken
+func (p *replicatorQueueProcessorImpl) getWorkflowTaskSize(events []*shared.HistoryEvent) (int, error) {
+	maxEvents := p.shard.GetConfig().ReplicatorWorkflowTaskMaxEvents()
+	maxBytes := p.shard.GetConfig().ReplicatorWorkflowTaskMaxBytes()
+
+	version := events[0].GetVersion()
+	size := 0
+	bytes := 0
+	for size < len(events) && events[size].GetVersion() == version && (size == 0 || size < maxEvents) {
+		blob, err := p.historySerializer.SerializeEvent(events[size], common.EncodingTypeThriftRW)
+		if err != nil {
+			return 0, err
+		}
+		if size > 0 && bytes+len(blob.Data) > maxBytes {
+			break
+		}
+		bytes += len(blob.Data)
+		size++
+	}
+	return size, nil
+}
+
+// getHistoryBranch returns the event store version and the current branch token of a workflow execution
+func (p *replicatorQueueProcessorImpl) getHistoryBranch(ctx context.Context, domainID string,
+	execution shared.WorkflowExecution) (retEventStoreVersion int32, retBranchToken []byte, retError error) {
+
+	context, release, err := p.historyCache.getOrCreateWorkflowExecutionWithTimeout(ctx, domainID, execution)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer func() { release(retError) }()
+
+	msBuilder, err := context.loadWorkflowExecution()
+	if err != nil {
+		return 0, nil, err
+	}
+	return msBuilder.GetEventStoreVersion(), msBuilder.GetCurrentBranch(), nil
+}
+
+func (p *replicatorQueueProcessorImpl) toReplicationTask(task *persistence.ReplicationTaskInfo) (*replicator.ReplicationTask, error) {
+	switch task.TaskType {
+	case persistence.ReplicationTaskTypeSyncActivity:
+		return p.generateSyncActivityTask(task)
+	case persistence.ReplicationTaskTypeHistory:
+		return p.generateHistoryReplicationTask(task)
+	default:
+		return nil, errUnknownReplicationTask
+	}
+}
+
 func (p *replicatorQueueProcessorImpl) getHistory(domainID, workflowID, runID string, firstEventID,
 	nextEventID int64, eventStoreVersion int32, branchToken []byte) (*shared.History, error) {
 
@@ -369,10 +762,10 @@ func (p *replicatorQueueProcessorImpl) getHistory(domainID, workflowID, runID st
 	return executionHistory, nil
 }
 
-func convertLastReplicationInfo(info map[string]*persistence.ReplicationInfo) map[string]*h.ReplicationInfo {
-	replicationInfoMap := make(map[string]*h.ReplicationInfo)
+func convertLastReplicationInfo(info map[string]*persistence.ReplicationInfo) map[string]*shared.ReplicationInfo {
+	replicationInfoMap := make(map[string]*shared.ReplicationInfo)
 	for k, v := range info {
-		replicationInfoMap[k] = &h.ReplicationInfo{
+		replicationInfoMap[k] = &shared.ReplicationInfo{
 			Version:     common.Int64Ptr(v.Version),
 			LastEventId: common.Int64Ptr(v.LastEventID),
 		}
@@ -21,12 +21,14 @@
 package history
 
 import (
+	ctx "context"
 	"os"
 	"testing"
 	"time"
 
 	"github.com/pborman/uuid"
 	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-common/bark"
 	"github.com/uber-go/tally"
@@ -40,6 +42,7 @@ import (
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 type (
@@ -339,3 +342,319 @@ func (s *replicatorQueueProcessorSuite) TestSyncActivity_ActivityRunning() {
 	_, err := s.replicatorQueueProcessor.process(task)
 	s.Nil(err)
 }
+
+func (s *replicatorQueueProcessorSuite) TestGetTasks_PublishedToKafka() {
+	_, err := s.replicatorQueueProcessor.getTasks(0)
+	s.Equal(errReplicationTasksPushed, err)
+
+	err = s.replicatorQueueProcessor.ackTasks(cluster.TestAlternativeClusterName, 0)
+	s.Equal(errReplicationTasksPushed, err)
+}
+
+func (s *replicatorQueueProcessorSuite) TestGetTasks_WorkflowMissing() {
+	s.replicatorQueueProcessor.replicator = nil
+	shard := s.mockShard.(*shardContextImpl)
+	shard.shardInfo.ReplicationAckLevel = 10
+	shard.transferMaxReadLevel = 100
+
+	runID := uuid.New()
+	task := &persistence.ReplicationTaskInfo{
+		TaskType:    persistence.ReplicationTaskTypeSyncActivity,
+		TaskID:      int64(20),
+		DomainID:    validDomainID,
+		WorkflowID:  "some random workflow ID",
+		RunID:       runID,
+		ScheduledID: int64(144),
+	}
+	s.mockExecutionMgr.On("GetReplicationTasks", &persistence.GetReplicationTasksRequest{
+		ReadLevel:    10,
+		MaxReadLevel: 100,
+		BatchSize:    s.replicatorQueueProcessor.options.BatchSize(),
+	}).Return(&persistence.GetReplicationTasksResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{task},
+	}, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", &persistence.GetWorkflowExecutionRequest{
+		DomainID: validDomainID,
+		Execution: shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(task.WorkflowID),
+			RunId:      common.StringPtr(runID),
+		},
+	}).Return(nil, &shared.EntityNotExistsError{}).Once()
+
+	messages, err := s.replicatorQueueProcessor.getTasks(5)
+	s.Nil(err)
+	s.Empty(messages.ReplicationTasks)
+	s.Equal(int64(20), messages.GetLastRetrievedMessageId())
+	s.False(messages.GetHasMore())
+	s.NotNil(messages.SyncShardStatus)
+}
+
+func (s *replicatorQueueProcessorSuite) TestAckTasks() {
+	s.replicatorQueueProcessor.replicator = nil
+	shard := s.mockShard.(*shardContextImpl)
+	shard.shardInfo.ReplicationAckLevel = 10
+	shard.lastUpdated = time.Now()
+	s.mockClusterMetadata.On("GetAllClusterFailoverVersions").Return(cluster.TestAllClusterFailoverVersions)
+
+	s.mockExecutionMgr.On("GetReplicationTasks", &persistence.GetReplicationTasksRequest{
+		ReadLevel:    10,
+		MaxReadLevel: 20,
+		BatchSize:    s.replicatorQueueProcessor.options.BatchSize(),
+	}).Return(&persistence.GetReplicationTasksResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{{TaskID: 15}, {TaskID: 20}},
+	}, nil).Once()
+	s.mockExecutionMgr.On("CompleteReplicationTask", &persistence.CompleteReplicationTaskRequest{TaskID: 15}).Return(nil).Once()
+	s.mockExecutionMgr.On("CompleteReplicationTask", &persistence.CompleteReplicationTaskRequest{TaskID: 20}).Return(nil).Once()
+
+	err := s.replicatorQueueProcessor.ackTasks(cluster.TestAlternativeClusterName, 20)
+	s.Nil(err)
+	s.Equal(int64(20), shard.GetReplicatorAckLevel())
+	s.Equal(int64(20), shard.GetAllClusterReplicationLevels()[cluster.TestAlternativeClusterName])
+
+	// ack level older than the current one is ignored
+	err = s.replicatorQueueProcessor.ackTasks(cluster.TestAlternativeClusterName, 15)
+	s.Nil(err)
+	s.Equal(int64(20), shard.GetReplicatorAckLevel())
+}
+
+func (s *replicatorQueueProcessorSuite) TestAckTasks_WaitForPullingClusters() {
+	s.replicatorQueueProcessor.replicator = nil
+	shard := s.mockShard.(*shardContextImpl)
+	shard.shardInfo.ReplicationAckLevel = 10
+	shard.shardInfo.ClusterReplicationLevel = map[string]int64{"other": 12}
+	shard.lastUpdated = time.Now()
+	s.mockClusterMetadata.On("GetAllClusterFailoverVersions").Return(map[string]int64{
+		cluster.TestCurrentClusterName:     cluster.TestCurrentClusterInitialFailoverVersion,
+		cluster.TestAlternativeClusterName: cluster.TestAlternativeClusterInitialFailoverVersion,
+		"other":                            int64(2),
+		"kafka":                            int64(3),
+	})
+
+	s.mockExecutionMgr.On("GetReplicationTasks", &persistence.GetReplicationTasksRequest{
+		ReadLevel:    10,
+		MaxReadLevel: 12,
+		BatchSize:    s.replicatorQueueProcessor.options.BatchSize(),
+	}).Return(&persistence.GetReplicationTasksResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{{TaskID: 11}},
+	}, nil).Once()
+	s.mockExecutionMgr.On("CompleteReplicationTask", &persistence.CompleteReplicationTaskRequest{TaskID: 11}).Return(nil).Once()
+
+	// the cluster which never polled is not waited for
+	err := s.replicatorQueueProcessor.ackTasks(cluster.TestAlternativeClusterName, 20)
+	s.Nil(err)
+	s.Equal(int64(12), shard.GetReplicatorAckLevel())
+	s.Equal(map[string]int64{
+		cluster.TestAlternativeClusterName: 20,
+		"other":                            12,
+	}, shard.GetAllClusterReplicationLevels())
+}
+
+func (s *replicatorQueueProcessorSuite) TestAckTasks_FirstPoll() {
+	s.replicatorQueueProcessor.replicator = nil
+	shard := s.mockShard.(*shardContextImpl)
+	shard.shardInfo.ReplicationAckLevel = 10
+	shard.shardInfo.ClusterReplicationLevel = map[string]int64{"other": 12}
+	shard.lastUpdated = time.Now()
+	s.mockClusterMetadata.On("GetAllClusterFailoverVersions").Return(map[string]int64{
+		cluster.TestCurrentClusterName:     cluster.TestCurrentClusterInitialFailoverVersion,
+		cluster.TestAlternativeClusterName: cluster.TestAlternativeClusterInitialFailoverVersion,
+		"other":                            int64(2),
+	})
+
+	// the first poll of a cluster holds back the deletion of the tasks it has not processed yet
+	err := s.replicatorQueueProcessor.ackTasks(cluster.TestAlternativeClusterName, -1)
+	s.Nil(err)
+	s.Equal(int64(10), shard.GetReplicatorAckLevel())
+	s.Equal(int64(-1), shard.GetAllClusterReplicationLevels()[cluster.TestAlternativeClusterName])
+}
+
+func (s *replicatorQueueProcessorSuite) TestGetStatus() {
+	s.replicatorQueueProcessor.replicator = nil
+	shard := s.mockShard.(*shardContextImpl)
+	shard.shardInfo.ClusterReplicationLevel = map[string]int64{cluster.TestAlternativeClusterName: 15}
+	shard.shardInfo.ReplicationAckLevel = 10
+	shard.transferMaxReadLevel = 100
+	s.mockClusterMetadata.On("GetAllClusterFailoverVersions").Return(cluster.TestAllClusterFailoverVersions)
+
+	s.mockExecutionMgr.On("GetReplicationTasks", &persistence.GetReplicationTasksRequest{
+		ReadLevel:    10,
+		MaxReadLevel: 100,
+		BatchSize:    s.replicatorQueueProcessor.options.BatchSize(),
+	}).Return(&persistence.GetReplicationTasksResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{
+			{TaskID: 12, DomainID: validDomainID},
+			{TaskID: 16, DomainID: validDomainID},
+			{TaskID: 18, DomainID: uuid.New()},
+		},
+	}, nil).Once()
+
+	status, err := s.replicatorQueueProcessor.getStatus(validDomainID)
+	s.Nil(err)
+	s.Equal(int64(10), status.GetReplicationAckLevel())
+	s.Equal(int64(100), status.GetMaxReadLevel())
+	s.Equal(int64(2), status.GetPendingTaskCount())
+	s.Equal(1, len(status.Clusters))
+	clusterStatus := status.Clusters[cluster.TestAlternativeClusterName]
+	s.Equal(int64(15), clusterStatus.GetAckLevel())
+	s.Equal(int64(1), clusterStatus.GetPendingTaskCount())
+	s.False(status.GetPendingTaskCountTruncated())
+}
+
+func (s *replicatorQueueProcessorSuite) TestGetStatus_Truncated() {
+	s.replicatorQueueProcessor.replicator = nil
+	shard := s.mockShard.(*shardContextImpl)
+	shard.shardInfo.ReplicationAckLevel = 10
+	shard.transferMaxReadLevel = 100000
+	s.mockClusterMetadata.On("GetAllClusterFailoverVersions").Return(cluster.TestAllClusterFailoverVersions)
+
+	var tasks []*persistence.ReplicationTaskInfo
+	for i := 0; i < replicationStatusMaxScanTasks; i++ {
+		tasks = append(tasks, &persistence.ReplicationTaskInfo{TaskID: int64(11 + i), DomainID: uuid.New()})
+	}
+	s.mockExecutionMgr.On("GetReplicationTasks", mock.Anything).Return(&persistence.GetReplicationTasksResponse{
+		Tasks:         tasks,
+		NextPageToken: []byte("next page"),
+	}, nil).Once()
+
+	status, err := s.replicatorQueueProcessor.getStatus(validDomainID)
+	s.Nil(err)
+	s.Equal(int64(0), status.GetPendingTaskCount())
+	s.True(status.GetPendingTaskCountTruncated())
+}
+
+func (s *replicatorQueueProcessorSuite) TestGetStatus_NoTasks() {
+	s.replicatorQueueProcessor.replicator = nil
+	shard := s.mockShard.(*shardContextImpl)
+	shard.shardInfo.ReplicationAckLevel = 100
+	shard.transferMaxReadLevel = 100
+	s.mockClusterMetadata.On("GetAllClusterFailoverVersions").Return(cluster.TestAllClusterFailoverVersions)
+
+	status, err := s.replicatorQueueProcessor.getStatus("")
+	s.Nil(err)
+	s.Equal(int64(0), status.GetPendingTaskCount())
+	s.False(status.GetPendingTaskCountTruncated())
+	s.mockExecutionMgr.AssertNotCalled(s.T(), "GetReplicationTasks", mock.Anything)
+}
+
+func (s *replicatorQueueProcessorSuite) TestGetWorkflowTasks() {
+	domainName := "some random domain name"
+	domainID := validDomainID
+	workflowID := "some random workflow ID"
+	runID := uuid.New()
+	branchToken := []byte("some random branch token")
+	activeVersion := cluster.TestCurrentClusterInitialFailoverVersion
+	standbyVersion := cluster.TestAlternativeClusterInitialFailoverVersion + cluster.TestFailoverVersionIncrement
+	execution := shared.WorkflowExecution{
+		WorkflowId: common.StringPtr(workflowID),
+		RunId:      common.StringPtr(runID),
+	}
+
+	context, release, _ := s.replicatorQueueProcessor.historyCache.getOrCreateWorkflowExecution(domainID, execution)
+	msBuilder := &mockMutableState{}
+	context.msBuilder = msBuilder
+	release(nil)
+
+	msBuilder.On("IsWorkflowExecutionRunning").Return(false)
+	msBuilder.On("GetReplicationState").Return(&persistence.ReplicationState{
+		CurrentVersion:   standbyVersion,
+		LastWriteVersion: standbyVersion,
+		LastWriteEventID: 8,
+		LastReplicationInfo: map[string]*persistence.ReplicationInfo{
+			cluster.TestAlternativeClusterName: {Version: standbyVersion, LastEventID: 8},
+		},
+	})
+	msBuilder.On("GetNextEventID").Return(int64(9))
+	msBuilder.On("GetExecutionInfo").Return(&persistence.WorkflowExecutionInfo{RunID: runID})
+	msBuilder.On("GetEventStoreVersion").Return(int32(persistence.EventStoreVersionV2))
+	msBuilder.On("GetCurrentBranch").Return(branchToken)
+	s.mockMetadataMgr.On("GetDomain", &persistence.GetDomainRequest{ID: domainID}).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID, Name: domainName},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					{ClusterName: cluster.TestCurrentClusterName},
+					{ClusterName: cluster.TestAlternativeClusterName},
+				},
+			},
+			FailoverVersion: activeVersion,
+			IsGlobalDomain:  true,
+			TableVersion:    persistence.DomainTableVersionV1,
+		}, nil,
+	).Once()
+	s.mockClusterMetadata.On("ClusterNameForFailoverVersion", activeVersion).Return(cluster.TestCurrentClusterName)
+	s.mockClusterMetadata.On("ClusterNameForFailoverVersion", standbyVersion).Return(cluster.TestAlternativeClusterName)
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", &persistence.ReadHistoryBranchRequest{
+		BranchToken: branchToken,
+		MinEventID:  5,
+		MaxEventID:  9,
+		PageSize:    defaultHistoryPageSize,
+	}).Return(&persistence.ReadHistoryBranchResponse{
+		History: []*shared.HistoryEvent{
+			{EventId: common.Int64Ptr(5), Version: common.Int64Ptr(activeVersion)},
+			{EventId: common.Int64Ptr(6), Version: common.Int64Ptr(activeVersion)},
+			{EventId: common.Int64Ptr(7), Version: common.Int64Ptr(standbyVersion)},
+			{EventId: common.Int64Ptr(8), Version: common.Int64Ptr(standbyVersion)},
+		},
+	}, nil).Once()
+
+	// the next event ID is capped by the next event ID of the workflow
+	tasks, err := s.replicatorQueueProcessor.getWorkflowTasks(ctx.Background(), domainID, execution, 5, 100)
+	s.Nil(err)
+	s.Equal(2, len(tasks))
+
+	first := tasks[0].HistoryTaskAttributes
+	s.Equal(int64(5), first.GetFirstEventId())
+	s.Equal(int64(7), first.GetNextEventId())
+	s.Equal(activeVersion, first.GetVersion())
+	s.Equal(2, len(first.History.Events))
+	s.Equal(2, len(first.TargetClusters))
+	s.Equal(0, len(first.ReplicationInfo))
+
+	second := tasks[1].HistoryTaskAttributes
+	s.Equal(int64(7), second.GetFirstEventId())
+	s.Equal(int64(9), second.GetNextEventId())
+	s.Equal(standbyVersion, second.GetVersion())
+	s.Equal(2, len(second.History.Events))
+	s.Equal(map[string]*shared.ReplicationInfo{
+		cluster.TestAlternativeClusterName: {
+			Version:     common.Int64Ptr(standbyVersion),
+			LastEventId: common.Int64Ptr(8),
+		},
+	}, second.ReplicationInfo)
+}
+
+func (s *replicatorQueueProcessorSuite) TestGetWorkflowTaskSize() {
+	version := int64(100)
+	events := []*shared.HistoryEvent{}
+	for eventID := int64(1); eventID <= 5; eventID++ {
+		events = append(events, &shared.HistoryEvent{EventId: common.Int64Ptr(eventID), Version: common.Int64Ptr(version)})
+	}
+	events = append(events, &shared.HistoryEvent{EventId: common.Int64Ptr(6), Version: common.Int64Ptr(version + 1)})
+	config := s.mockShard.GetConfig()
+
+	size, err := s.replicatorQueueProcessor.getWorkflowTaskSize(events)
+	s.Nil(err)
+	s.Equal(5, size)
+
+	config.ReplicatorWorkflowTaskMaxEvents = dynamicconfig.GetIntPropertyFn(2)
+	size, err = s.replicatorQueueProcessor.getWorkflowTaskSize(events)
+	s.Nil(err)
+	s.Equal(2, size)
+
+	config.ReplicatorWorkflowTaskMaxEvents = dynamicconfig.GetIntPropertyFn(1000)
+	blob, err := persistence.NewHistorySerializer().SerializeEvent(events[0], common.EncodingTypeThriftRW)
+	s.Nil(err)
+	config.ReplicatorWorkflowTaskMaxBytes = dynamicconfig.GetIntPropertyFn(3*len(blob.Data) - 1)
+	size, err = s.replicatorQueueProcessor.getWorkflowTaskSize(events)
+	s.Nil(err)
+	s.Equal(2, size)
+
+	// the first event is always taken, even when it alone exceeds the limits
+	config.ReplicatorWorkflowTaskMaxEvents = dynamicconfig.GetIntPropertyFn(0)
+	config.ReplicatorWorkflowTaskMaxBytes = dynamicconfig.GetIntPropertyFn(0)
+	size, err = s.replicatorQueueProcessor.getWorkflowTaskSize(events)
+	s.Nil(err)
+	s.Equal(1, size)
+}
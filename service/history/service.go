@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/metrics"
 	persistencefactory "github.com/uber/cadence/common/persistence/persistence-factory"
 	"github.com/uber/cadence/common/service"
@@ -37,19 +38,27 @@ type Config struct {
 	EnableSyncActivityHeartbeat dynamicconfig.BoolPropertyFn
 	RPS                         dynamicconfig.IntPropertyFn
 	PersistenceMaxQPS           dynamicconfig.IntPropertyFn
+	PersistenceDomainMaxQPS     dynamicconfig.IntPropertyFnWithDomainIDFilter
 	EnableVisibilitySampling    dynamicconfig.BoolPropertyFn
 	VisibilityOpenMaxQPS        dynamicconfig.IntPropertyFnWithDomainFilter
 	VisibilityClosedMaxQPS      dynamicconfig.IntPropertyFnWithDomainFilter
 
 	// HistoryCache settings
 	// Change of these configs require shard restart
-	HistoryCacheInitialSize dynamicconfig.IntPropertyFn
-	HistoryCacheMaxSize     dynamicconfig.IntPropertyFn
-	HistoryCacheTTL         dynamicconfig.DurationPropertyFn
+	HistoryCacheInitialSize    dynamicconfig.IntPropertyFn
+	HistoryCacheMaxSize        dynamicconfig.IntPropertyFn
+	HistoryCacheMaxSizeInBytes dynamicconfig.IntPropertyFn
+	HistoryCacheTTL            dynamicconfig.DurationPropertyFn
 
 	// ShardController settings
 	RangeSizeBits        uint
 	AcquireShardInterval dynamicconfig.DurationPropertyFn
+	// DrainedHosts is a comma separated list of host addresses which give away their shards
+	DrainedHosts dynamicconfig.StringPropertyFn
+	// ShardDrainBatchSize is the max number of shards of drained hosts taken over per acquire shard interval
+	ShardDrainBatchSize dynamicconfig.IntPropertyFn
+	// ShardOwnershipOverrides is a comma separated list of shardID=host:port pairs which pin shards to hosts
+	ShardOwnershipOverrides dynamicconfig.StringPropertyFn
 
 	// the artificial delay added to standby cluster's view of active cluster's time
 	StandbyClusterDelay dynamicconfig.DurationPropertyFn
@@ -96,6 +105,12 @@ type Config struct {
 	ReplicatorProcessorMaxPollIntervalJitterCoefficient   dynamicconfig.FloatPropertyFn
 	ReplicatorProcessorUpdateAckInterval                  dynamicconfig.DurationPropertyFn
 	ReplicatorProcessorUpdateAckIntervalJitterCoefficient dynamicconfig.FloatPropertyFn
+	ReplicatorWorkflowTaskMaxEvents                       dynamicconfig.IntPropertyFn
+	ReplicatorWorkflowTaskMaxBytes                        dynamicconfig.IntPropertyFn
+
+	// EnableTaskQuarantine is whether transfer and timer tasks which keep failing with non-transient errors
+	// are moved to the shard quarantine after max retry count, so the ack level can move forward
+	EnableTaskQuarantine dynamicconfig.BoolPropertyFn
 
 	// Persistence settings
 	ExecutionMgrNumConns dynamicconfig.IntPropertyFn
@@ -117,23 +132,48 @@ type Config struct {
 	EventEncodingType dynamicconfig.StringPropertyFnWithDomainFilter
 	// whether or not using eventsV2
 	EnableEventsV2 dynamicconfig.BoolPropertyFnWithDomainFilter
+
+	// size limit system protection
+	BlobSizeLimitError               dynamicconfig.IntPropertyFnWithDomainFilter
+	BlobSizeLimitWarn                dynamicconfig.IntPropertyFnWithDomainFilter
+	HistorySizeLimitError            dynamicconfig.IntPropertyFnWithDomainFilter
+	HistorySizeLimitWarn             dynamicconfig.IntPropertyFnWithDomainFilter
+	HistoryCountLimitError           dynamicconfig.IntPropertyFnWithDomainFilter
+	HistoryCountLimitWarn            dynamicconfig.IntPropertyFnWithDomainFilter
+	PendingActivitiesLimitError      dynamicconfig.IntPropertyFnWithDomainFilter
+	PendingActivitiesLimitWarn       dynamicconfig.IntPropertyFnWithDomainFilter
+	PendingChildExecutionsLimitError dynamicconfig.IntPropertyFnWithDomainFilter
+	PendingChildExecutionsLimitWarn  dynamicconfig.IntPropertyFnWithDomainFilter
+	PendingTimersLimitError          dynamicconfig.IntPropertyFnWithDomainFilter
+	PendingTimersLimitWarn           dynamicconfig.IntPropertyFnWithDomainFilter
+	PendingSignalsLimitError         dynamicconfig.IntPropertyFnWithDomainFilter
+	PendingSignalsLimitWarn          dynamicconfig.IntPropertyFnWithDomainFilter
+	PendingLimitMaxDecisionAttempts  dynamicconfig.IntPropertyFnWithDomainFilter
+
+	// historyCacheSizeBudget is shared by the history caches of all the shards of the host, it is a soft limit
+	historyCacheSizeBudget *cache.SizeBudget
 }
 
 // NewConfig returns new service config with default values
 func NewConfig(dc *dynamicconfig.Collection, numberOfShards int) *Config {
-	return &Config{
+	config := &Config{
 		NumberOfShards:                                        numberOfShards,
 		EnableSyncActivityHeartbeat:                           dc.GetBoolProperty(dynamicconfig.EnableSyncActivityHeartbeat, false),
 		RPS:                                                   dc.GetIntProperty(dynamicconfig.HistoryRPS, 3000),
 		PersistenceMaxQPS:                                     dc.GetIntProperty(dynamicconfig.HistoryPersistenceMaxQPS, 9000),
+		PersistenceDomainMaxQPS:                               dc.GetIntPropertyFilteredByDomainID(dynamicconfig.HistoryPersistenceDomainMaxQPS, 0),
 		EnableVisibilitySampling:                              dc.GetBoolProperty(dynamicconfig.EnableVisibilitySampling, true),
 		VisibilityOpenMaxQPS:                                  dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryVisibilityOpenMaxQPS, 300),
 		VisibilityClosedMaxQPS:                                dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryVisibilityClosedMaxQPS, 300),
 		HistoryCacheInitialSize:                               dc.GetIntProperty(dynamicconfig.HistoryCacheInitialSize, 128),
 		HistoryCacheMaxSize:                                   dc.GetIntProperty(dynamicconfig.HistoryCacheMaxSize, 512),
+		HistoryCacheMaxSizeInBytes:                            dc.GetIntProperty(dynamicconfig.HistoryCacheMaxSizeInBytes, 0),
 		HistoryCacheTTL:                                       dc.GetDurationProperty(dynamicconfig.HistoryCacheTTL, time.Hour),
 		RangeSizeBits:                                         20, // 20 bits for sequencer, 2^20 sequence number for any range
 		AcquireShardInterval:                                  dc.GetDurationProperty(dynamicconfig.AcquireShardInterval, time.Minute),
+		DrainedHosts:                                          dc.GetStringProperty(dynamicconfig.DrainedHosts, ""),
+		ShardDrainBatchSize:                                   dc.GetIntProperty(dynamicconfig.ShardDrainBatchSize, 10),
+		ShardOwnershipOverrides:                               dc.GetStringProperty(dynamicconfig.ShardOwnershipOverrides, ""),
 		StandbyClusterDelay:                                   dc.GetDurationProperty(dynamicconfig.AcquireShardInterval, 5*time.Minute),
 		TimerTaskBatchSize:                                    dc.GetIntProperty(dynamicconfig.TimerTaskBatchSize, 100),
 		TimerTaskWorkerCount:                                  dc.GetIntProperty(dynamicconfig.TimerTaskWorkerCount, 10),
@@ -172,6 +212,9 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int) *Config {
 		ReplicatorProcessorMaxPollIntervalJitterCoefficient:   dc.GetFloat64Property(dynamicconfig.ReplicatorProcessorMaxPollIntervalJitterCoefficient, 0.15),
 		ReplicatorProcessorUpdateAckInterval:                  dc.GetDurationProperty(dynamicconfig.ReplicatorProcessorUpdateAckInterval, 5*time.Second),
 		ReplicatorProcessorUpdateAckIntervalJitterCoefficient: dc.GetFloat64Property(dynamicconfig.ReplicatorProcessorUpdateAckIntervalJitterCoefficient, 0.15),
+		ReplicatorWorkflowTaskMaxEvents:                       dc.GetIntProperty(dynamicconfig.ReplicatorWorkflowTaskMaxEvents, 1000),
+		ReplicatorWorkflowTaskMaxBytes:                        dc.GetIntProperty(dynamicconfig.ReplicatorWorkflowTaskMaxBytes, 1024*1024),
+		EnableTaskQuarantine:                                  dc.GetBoolProperty(dynamicconfig.EnableTaskQuarantine, false),
 		ExecutionMgrNumConns:                                  dc.GetIntProperty(dynamicconfig.ExecutionMgrNumConns, 50),
 		HistoryMgrNumConns:                                    dc.GetIntProperty(dynamicconfig.HistoryMgrNumConns, 50),
 		MaximumBufferedEventsBatch:                            dc.GetIntProperty(dynamicconfig.MaximumBufferedEventsBatch, 100),
@@ -182,7 +225,25 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int) *Config {
 		LongPollExpirationInterval: dc.GetDurationPropertyFilteredByDomain(dynamicconfig.HistoryLongPollExpirationInterval, time.Second*20),
 		EventEncodingType:          dc.GetStringPropertyFnWithDomainFilter(dynamicconfig.DefaultEventEncoding, string(common.EncodingTypeJSON)),
 		EnableEventsV2:             dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.EnableEventsV2, false),
+
+		BlobSizeLimitError:               dc.GetIntPropertyFilteredByDomain(dynamicconfig.BlobSizeLimitError, 2*1024*1024),
+		BlobSizeLimitWarn:                dc.GetIntPropertyFilteredByDomain(dynamicconfig.BlobSizeLimitWarn, 256*1024),
+		HistorySizeLimitError:            dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistorySizeLimitError, 200*1024*1024),
+		HistorySizeLimitWarn:             dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistorySizeLimitWarn, 50*1024*1024),
+		HistoryCountLimitError:           dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryCountLimitError, 200*1024),
+		HistoryCountLimitWarn:            dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryCountLimitWarn, 50*1024),
+		PendingActivitiesLimitError:      dc.GetIntPropertyFilteredByDomain(dynamicconfig.PendingActivitiesLimitError, 50000),
+		PendingActivitiesLimitWarn:       dc.GetIntPropertyFilteredByDomain(dynamicconfig.PendingActivitiesLimitWarn, 10000),
+		PendingChildExecutionsLimitError: dc.GetIntPropertyFilteredByDomain(dynamicconfig.PendingChildExecutionsLimitError, 50000),
+		PendingChildExecutionsLimitWarn:  dc.GetIntPropertyFilteredByDomain(dynamicconfig.PendingChildExecutionsLimitWarn, 10000),
+		PendingTimersLimitError:          dc.GetIntPropertyFilteredByDomain(dynamicconfig.PendingTimersLimitError, 50000),
+		PendingTimersLimitWarn:           dc.GetIntPropertyFilteredByDomain(dynamicconfig.PendingTimersLimitWarn, 10000),
+		PendingSignalsLimitError:         dc.GetIntPropertyFilteredByDomain(dynamicconfig.PendingSignalsLimitError, 50000),
+		PendingSignalsLimitWarn:          dc.GetIntPropertyFilteredByDomain(dynamicconfig.PendingSignalsLimitWarn, 10000),
+		PendingLimitMaxDecisionAttempts:  dc.GetIntPropertyFilteredByDomain(dynamicconfig.PendingLimitMaxDecisionAttempts, 10),
 	}
+	config.historyCacheSizeBudget = cache.NewSizeBudget(config.HistoryCacheMaxSizeInBytes)
+	return config
 }
 
 // GetShardID return the corresponding shard ID for a given workflow ID
@@ -226,6 +287,7 @@ func (s *Service) Start() {
 	pConfig := params.PersistenceConfig
 	pConfig.HistoryMaxConns = s.config.HistoryMgrNumConns()
 	pConfig.SetMaxQPS(pConfig.DefaultStore, s.config.PersistenceMaxQPS())
+	pConfig.DomainMaxQPS = s.config.PersistenceDomainMaxQPS
 	pConfig.SamplingConfig.VisibilityOpenMaxQPS = s.config.VisibilityOpenMaxQPS
 	pConfig.SamplingConfig.VisibilityClosedMaxQPS = s.config.VisibilityClosedMaxQPS
 	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), s.metricsClient, log)
@@ -256,6 +318,12 @@ func (s *Service) Start() {
 		log.Warnf("Creating Cassandra historyV2 manager persistence failed: %v, cannot use eventsV2 features", err)
 	}
 
+	quarantine, err := pFactory.NewQuarantineManager()
+	if err != nil {
+		// TODO change this to Fatalf when SQL also support task quarantine
+		log.Warnf("Creating task quarantine manager persistence failed: %v, poison tasks will not be quarantined", err)
+	}
+
 	handler := NewHandler(base,
 		s.config,
 		shardMgr,
@@ -263,6 +331,7 @@ func (s *Service) Start() {
 		visibility,
 		history,
 		historyV2,
+		quarantine,
 		pFactory)
 
 	handler.Start()
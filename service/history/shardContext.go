@@ -45,6 +45,7 @@ type (
 		GetExecutionManager() persistence.ExecutionManager
 		GetHistoryManager() persistence.HistoryManager
 		GetHistoryV2Manager() persistence.HistoryV2Manager
+		GetQuarantineManager() persistence.QuarantineManager
 		GetDomainCache() cache.DomainCache
 		GetNextTransferTaskID() (int64, error)
 		GetTransferMaxReadLevel() int64
@@ -54,6 +55,8 @@ type (
 		UpdateTransferClusterAckLevel(cluster string, ackLevel int64) error
 		GetReplicatorAckLevel() int64
 		UpdateReplicatorAckLevel(ackLevel int64) error
+		GetAllClusterReplicationLevels() map[string]int64
+		UpdateClusterReplicationLevel(cluster string, lastProcessedTaskID int64) error
 		GetTimerAckLevel() time.Time
 		UpdateTimerAckLevel(ackLevel time.Time) error
 		GetTimerClusterAckLevel(cluster string) time.Time
@@ -92,6 +95,7 @@ type (
 		shardManager     persistence.ShardManager
 		historyMgr       persistence.HistoryManager
 		historyV2Mgr     persistence.HistoryV2Manager
+		quarantineMgr    persistence.QuarantineManager
 		executionManager persistence.ExecutionManager
 		domainCache      cache.DomainCache
 		closeCh          chan<- int
@@ -140,6 +144,10 @@ func (s *shardContextImpl) GetHistoryV2Manager() persistence.HistoryV2Manager {
 	return s.historyV2Mgr
 }
 
+func (s *shardContextImpl) GetQuarantineManager() persistence.QuarantineManager {
+	return s.quarantineMgr
+}
+
 func (s *shardContextImpl) GetDomainCache() cache.DomainCache {
 	return s.domainCache
 }
@@ -210,6 +218,29 @@ func (s *shardContextImpl) UpdateReplicatorAckLevel(ackLevel int64) error {
 	return s.updateShardInfoLocked()
 }
 
+func (s *shardContextImpl) GetAllClusterReplicationLevels() map[string]int64 {
+	s.RLock()
+	defer s.RUnlock()
+
+	ret := map[string]int64{}
+	for k, v := range s.shardInfo.ClusterReplicationLevel {
+		ret[k] = v
+	}
+	return ret
+}
+
+func (s *shardContextImpl) UpdateClusterReplicationLevel(cluster string, lastProcessedTaskID int64) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.shardInfo.ClusterReplicationLevel == nil {
+		s.shardInfo.ClusterReplicationLevel = make(map[string]int64)
+	}
+	s.shardInfo.ClusterReplicationLevel[cluster] = lastProcessedTaskID
+	s.shardInfo.StolenSinceRenew = 0
+	return s.updateShardInfoLocked()
+}
+
 func (s *shardContextImpl) GetTimerAckLevel() time.Time {
 	s.RLock()
 	defer s.RUnlock()
@@ -495,6 +526,13 @@ func (s *shardContextImpl) UpdateWorkflowExecution(request *persistence.UpdateWo
 	if err != nil {
 		return nil, err
 	}
+	if request.ContinueAsNew != nil {
+		err = s.allocateTimerIDsLocked(request.ContinueAsNew.TimerTasks, request.ContinueAsNew.DomainID,
+			request.ContinueAsNew.Execution.GetWorkflowId())
+		if err != nil {
+			return nil, err
+		}
+	}
 
 Update_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
@@ -829,6 +867,12 @@ func (s *shardContextImpl) emitShardInfoMetricsLogsLocked() {
 	s.metricsClient.RecordTimer(metrics.ShardInfoScope, metrics.ShardInfoTimerDiffTimer, diffTimerLevel)
 
 	s.metricsClient.RecordTimer(metrics.ShardInfoScope, metrics.ShardInfoReplicationLagTimer, time.Duration(replicationLag))
+	// the shard time of a remote cluster only moves forward when its replication tasks are received,
+	// so how far it falls behind is the replication lag from that cluster in wall clock time
+	for cluster, remoteTime := range s.standbyClusterCurrentTime {
+		s.metricsClient.Tagged(map[string]string{metrics.SourceClusterTagName: cluster}).RecordTimer(
+			metrics.ShardInfoScope, metrics.ShardInfoReplicationRemoteLagTimer, time.Since(remoteTime))
+	}
 	s.metricsClient.RecordTimer(metrics.ShardInfoScope, metrics.ShardInfoTransferLagTimer, time.Duration(transferLag))
 	s.metricsClient.RecordTimer(metrics.ShardInfoScope, metrics.ShardInfoTimerLagTimer, timerLag)
 
@@ -972,6 +1016,7 @@ func acquireShard(shardItem *historyShardsItem, closeCh chan<- int) (ShardContex
 		shardManager:              shardItem.shardMgr,
 		historyMgr:                shardItem.historyMgr,
 		historyV2Mgr:              shardItem.historyV2Mgr,
+		quarantineMgr:             shardItem.quarantineMgr,
 		executionManager:          shardItem.executionMgr,
 		domainCache:               shardItem.domainCache,
 		shardInfo:                 updatedShardInfo,
@@ -1010,6 +1055,10 @@ func copyShardInfo(shardInfo *persistence.ShardInfo) *persistence.ShardInfo {
 	for k, v := range shardInfo.ClusterTimerAckLevel {
 		clusterTimerAckLevel[k] = v
 	}
+	clusterReplicationLevel := make(map[string]int64)
+	for k, v := range shardInfo.ClusterReplicationLevel {
+		clusterReplicationLevel[k] = v
+	}
 	shardInfoCopy := &persistence.ShardInfo{
 		ShardID:                   shardInfo.ShardID,
 		Owner:                     shardInfo.Owner,
@@ -1022,6 +1071,7 @@ func copyShardInfo(shardInfo *persistence.ShardInfo) *persistence.ShardInfo {
 		TimerFailoverLevels:       timerFailoverLevels,
 		ClusterTransferAckLevel:   clusterTransferAckLevel,
 		ClusterTimerAckLevel:      clusterTimerAckLevel,
+		ClusterReplicationLevel:   clusterReplicationLevel,
 		DomainNotificationVersion: shardInfo.DomainNotificationVersion,
 	}
 
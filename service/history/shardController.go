@@ -51,6 +51,7 @@ type (
 		shardMgr            persistence.ShardManager
 		historyMgr          persistence.HistoryManager
 		historyV2Mgr        persistence.HistoryV2Manager
+		quarantineMgr       persistence.QuarantineManager
 		executionMgrFactory persistence.ExecutionManagerFactory
 		domainCache         cache.DomainCache
 		engineFactory       EngineFactory
@@ -62,6 +63,7 @@ type (
 		logger              bark.Logger
 		config              *Config
 		metricsClient       metrics.Client
+		shardOwnership      *membership.ShardOwnership
 
 		sync.RWMutex
 		historyShards map[int]*historyShardsItem
@@ -78,6 +80,7 @@ type (
 		shardMgr      persistence.ShardManager
 		historyMgr    persistence.HistoryManager
 		historyV2Mgr  persistence.HistoryV2Manager
+		quarantineMgr persistence.QuarantineManager
 		executionMgr  persistence.ExecutionManager
 		domainCache   cache.DomainCache
 		engineFactory EngineFactory
@@ -96,7 +99,8 @@ const (
 )
 
 func newShardController(svc service.Service, host *membership.HostInfo, resolver membership.ServiceResolver,
-	shardMgr persistence.ShardManager, historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager, domainCache cache.DomainCache,
+	shardMgr persistence.ShardManager, historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager,
+	quarantineMgr persistence.QuarantineManager, domainCache cache.DomainCache,
 	executionMgrFactory persistence.ExecutionManagerFactory, factory EngineFactory,
 	config *Config, logger bark.Logger, metricsClient metrics.Client) *shardController {
 	logger = logger.WithFields(bark.Fields{
@@ -110,6 +114,7 @@ func newShardController(svc service.Service, host *membership.HostInfo, resolver
 		shardMgr:            shardMgr,
 		historyMgr:          historyMgr,
 		historyV2Mgr:        historyV2Mgr,
+		quarantineMgr:       quarantineMgr,
 		executionMgrFactory: executionMgrFactory,
 		domainCache:         domainCache,
 		engineFactory:       factory,
@@ -119,12 +124,13 @@ func newShardController(svc service.Service, host *membership.HostInfo, resolver
 		logger:              logger,
 		config:              config,
 		metricsClient:       metricsClient,
+		shardOwnership:      membership.NewShardOwnership(resolver, config.DrainedHosts, config.ShardOwnershipOverrides, logger),
 	}
 }
 
 func newHistoryShardsItem(shardID int, svc service.Service, shardMgr persistence.ShardManager,
-	historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager, domainCache cache.DomainCache,
-	executionMgrFactory persistence.ExecutionManagerFactory, factory EngineFactory, host *membership.HostInfo,
+	historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager, quarantineMgr persistence.QuarantineManager,
+	domainCache cache.DomainCache, executionMgrFactory persistence.ExecutionManagerFactory, factory EngineFactory, host *membership.HostInfo,
 	config *Config, logger bark.Logger, metricsClient metrics.Client) (*historyShardsItem, error) {
 
 	executionMgr, err := executionMgrFactory.NewExecutionManager(shardID)
@@ -139,6 +145,7 @@ func newHistoryShardsItem(shardID int, svc service.Service, shardMgr persistence
 		shardMgr:      shardMgr,
 		historyMgr:    historyMgr,
 		historyV2Mgr:  historyV2Mgr,
+		quarantineMgr: quarantineMgr,
 		executionMgr:  executionMgr,
 		domainCache:   domainCache,
 		engineFactory: factory,
@@ -236,14 +243,14 @@ func (c *shardController) getOrCreateHistoryShardItem(shardID int) (*historyShar
 	if c.isStopping {
 		return nil, fmt.Errorf("shardController for host '%v' shutting down", c.host.Identity())
 	}
-	info, err := c.hServiceResolver.Lookup(string(shardID))
+	info, _, err := c.shardOwnership.Lookup(shardID)
 	if err != nil {
 		return nil, err
 	}
 
 	if info.Identity() == c.host.Identity() {
-		shardItem, err := newHistoryShardsItem(shardID, c.service, c.shardMgr, c.historyMgr, c.historyV2Mgr, c.domainCache,
-			c.executionMgrFactory, c.engineFactory, c.host, c.config, c.logger, c.metricsClient)
+		shardItem, err := newHistoryShardsItem(shardID, c.service, c.shardMgr, c.historyMgr, c.historyV2Mgr, c.quarantineMgr,
+			c.domainCache, c.executionMgrFactory, c.engineFactory, c.host, c.config, c.logger, c.metricsClient)
 		if err != nil {
 			return nil, err
 		}
@@ -322,15 +329,23 @@ func (c *shardController) acquireShards() {
 	sw := c.metricsClient.StartTimer(metrics.HistoryShardControllerScope, metrics.AcquireShardsLatency)
 	defer sw.Stop()
 
+	takenOverShards := 0
 AcquireLoop:
 	for shardID := 0; shardID < c.config.NumberOfShards; shardID++ {
-		info, err := c.hServiceResolver.Lookup(string(shardID))
+		info, drainedHost, err := c.shardOwnership.Lookup(shardID)
 		if err != nil {
 			logging.LogOperationFailedEvent(c.logger, fmt.Sprintf("Error looking up host for shardID: %v", shardID), err)
 			continue AcquireLoop
 		}
 
 		if info.Identity() == c.host.Identity() {
+			if drainedHost != "" && !c.hasShard(shardID) {
+				// take over the shards of drained hosts a few at a time
+				if takenOverShards >= c.config.ShardDrainBatchSize() {
+					continue AcquireLoop
+				}
+				takenOverShards++
+			}
 			_, err1 := c.getEngineForShard(shardID)
 			if err1 != nil {
 				c.metricsClient.IncCounter(metrics.HistoryShardControllerScope, metrics.GetEngineForShardErrorCounter)
@@ -338,7 +353,8 @@ AcquireLoop:
 					err1)
 				continue AcquireLoop
 			}
-		} else {
+		} else if drainedHost != c.host.Identity() {
+			// a drained host keeps serving its shards until the new owners take them over
 			c.removeEngineForShard(shardID)
 		}
 	}
@@ -346,6 +362,38 @@ AcquireLoop:
 	c.metricsClient.UpdateGauge(metrics.HistoryShardControllerScope, metrics.NumShardsGauge, float64(c.numShards()))
 }
 
+// closeShard closes a shard owned by this host, so that it is reacquired by its owner as determined
+// by the membership ring, the drained hosts and the shard ownership overrides
+func (c *shardController) closeShard(shardID int) error {
+	if !c.hasShard(shardID) {
+		info, _, err := c.shardOwnership.Lookup(shardID)
+		if err != nil {
+			return err
+		}
+		if info.Identity() != c.host.Identity() {
+			return createShardOwnershipLostError(c.host.Identity(), info.GetAddress())
+		}
+		return nil
+	}
+
+	c.metricsClient.IncCounter(metrics.HistoryShardControllerScope, metrics.ShardClosedCounter)
+	logging.LogShardClosedEvent(c.logger, c.host.Identity(), shardID)
+	c.removeEngineForShard(shardID)
+	return nil
+}
+
+// isDraining returns whether this host is drained and gives away its shards
+func (c *shardController) isDraining() bool {
+	return c.shardOwnership.IsDrained(c.host.Identity())
+}
+
+func (c *shardController) hasShard(shardID int) bool {
+	c.RLock()
+	defer c.RUnlock()
+	_, ok := c.historyShards[shardID]
+	return ok
+}
+
 func (c *shardController) doShutdown() {
 	logging.LogShardControllerShuttingDownEvent(c.logger, c.host.Identity())
 	c.Lock()
@@ -35,6 +35,7 @@ import (
 	"time"
 
 	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/membership"
@@ -43,6 +44,7 @@ import (
 	mmocks "github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 type (
@@ -54,6 +56,7 @@ type (
 		mockExecutionMgrFactory *mmocks.ExecutionManagerFactory
 		mockHistoryMgr          *mmocks.HistoryManager
 		mockHistoryV2Mgr        *mmocks.HistoryV2Manager
+		mockQuarantineMgr       *mmocks.QuarantineManager
 		mockMetadaraMgr         *mmocks.MetadataManager
 		mockServiceResolver     *mmocks.ServiceResolver
 		mockMessaging           *mmocks.KafkaProducer
@@ -63,6 +66,7 @@ type (
 		mockService             service.Service
 		domainCache             cache.DomainCache
 		config                  *Config
+		drainedHosts            string
 		logger                  bark.Logger
 		metricsClient           metrics.Client
 	}
@@ -76,12 +80,17 @@ func TestShardControllerSuite(t *testing.T) {
 func (s *shardControllerSuite) SetupTest() {
 	s.logger = bark.NewLoggerFromLogrus(log.New())
 	s.config = NewDynamicConfigForTest()
+	s.drainedHosts = ""
+	s.config.DrainedHosts = func(opts ...dynamicconfig.FilterOption) string {
+		return s.drainedHosts
+	}
 	s.metricsClient = metrics.NewClient(tally.NoopScope, metrics.History)
 	s.hostInfo = membership.NewHostInfo("shardController-host-test", nil)
 	s.mockShardManager = &mmocks.ShardManager{}
 	s.mockExecutionMgrFactory = &mmocks.ExecutionManagerFactory{}
 	s.mockHistoryMgr = &mmocks.HistoryManager{}
 	s.mockHistoryV2Mgr = &mmocks.HistoryV2Manager{}
+	s.mockQuarantineMgr = &mmocks.QuarantineManager{}
 	s.mockMetadaraMgr = &mmocks.MetadataManager{}
 	s.mockServiceResolver = &mmocks.ServiceResolver{}
 	s.mockEngineFactory = &MockHistoryEngineFactory{}
@@ -91,7 +100,7 @@ func (s *shardControllerSuite) SetupTest() {
 	s.mockService = service.NewTestService(s.mockClusterMetadata, s.mockMessagingClient, s.metricsClient, s.logger)
 	s.domainCache = cache.NewDomainCache(s.mockMetadaraMgr, s.mockClusterMetadata, s.metricsClient, s.logger)
 	s.controller = newShardController(s.mockService, s.hostInfo, s.mockServiceResolver, s.mockShardManager,
-		s.mockHistoryMgr, s.mockHistoryV2Mgr, s.domainCache, s.mockExecutionMgrFactory, s.mockEngineFactory, s.config, s.logger, s.metricsClient)
+		s.mockHistoryMgr, s.mockHistoryV2Mgr, s.mockQuarantineMgr, s.domainCache, s.mockExecutionMgrFactory, s.mockEngineFactory, s.config, s.logger, s.metricsClient)
 }
 
 func (s *shardControllerSuite) TearDownTest() {
@@ -122,7 +131,7 @@ func (s *shardControllerSuite) TestAcquireShardSuccess() {
 			s.mockExecutionMgrFactory.On("NewExecutionManager", mock.Anything).Return(mockExecutionMgr, nil).Once()
 			mockEngine := &MockHistoryEngine{}
 			mockEngine.On("Start").Return().Once()
-			s.mockServiceResolver.On("Lookup", string(shardID)).Return(s.hostInfo, nil).Twice()
+			s.mockServiceResolver.On("Lookup", membership.ShardKey(shardID)).Return(s.hostInfo, nil).Twice()
 			s.mockEngineFactory.On("CreateEngine", mock.Anything).Return(mockEngine).Once()
 			s.mockShardManager.On("GetShard", &persistence.GetShardRequest{ShardID: shardID}).Return(
 				&persistence.GetShardResponse{
@@ -160,14 +169,15 @@ func (s *shardControllerSuite) TestAcquireShardSuccess() {
 						cluster.TestCurrentClusterName:     currentClusterTimerAck,
 						cluster.TestAlternativeClusterName: alternativeClusterTimerAck,
 					},
-					TransferFailoverLevels: map[string]persistence.TransferFailoverLevel{},
-					TimerFailoverLevels:    map[string]persistence.TimerFailoverLevel{},
+					ClusterReplicationLevel: map[string]int64{},
+					TransferFailoverLevels:  map[string]persistence.TransferFailoverLevel{},
+					TimerFailoverLevels:     map[string]persistence.TimerFailoverLevel{},
 				},
 				PreviousRangeID: 5,
 			}).Return(nil).Once()
 		} else {
 			ownerHost := fmt.Sprintf("test-acquire-shard-host-%v", hostID)
-			s.mockServiceResolver.On("Lookup", string(shardID)).Return(membership.NewHostInfo(ownerHost, nil), nil).Once()
+			s.mockServiceResolver.On("Lookup", membership.ShardKey(shardID)).Return(membership.NewHostInfo(ownerHost, nil), nil).Once()
 		}
 	}
 
@@ -187,12 +197,12 @@ func (s *shardControllerSuite) TestAcquireShardLookupFailure() {
 	numShards := 2
 	s.config.NumberOfShards = numShards
 	for shardID := 0; shardID < numShards; shardID++ {
-		s.mockServiceResolver.On("Lookup", string(shardID)).Return(nil, errors.New("ring failure")).Once()
+		s.mockServiceResolver.On("Lookup", membership.ShardKey(shardID)).Return(nil, errors.New("ring failure")).Once()
 	}
 
 	s.controller.acquireShards()
 	for shardID := 0; shardID < numShards; shardID++ {
-		s.mockServiceResolver.On("Lookup", string(shardID)).Return(nil, errors.New("ring failure")).Once()
+		s.mockServiceResolver.On("Lookup", membership.ShardKey(shardID)).Return(nil, errors.New("ring failure")).Once()
 		s.Nil(s.controller.getEngineForShard(shardID))
 	}
 }
@@ -212,7 +222,7 @@ func (s *shardControllerSuite) TestAcquireShardRenewSuccess() {
 		s.mockExecutionMgrFactory.On("NewExecutionManager", mock.Anything).Return(mockExecutionMgr, nil).Once()
 		mockEngine := &MockHistoryEngine{}
 		mockEngine.On("Start").Return().Once()
-		s.mockServiceResolver.On("Lookup", string(shardID)).Return(s.hostInfo, nil).Twice()
+		s.mockServiceResolver.On("Lookup", membership.ShardKey(shardID)).Return(s.hostInfo, nil).Twice()
 		s.mockEngineFactory.On("CreateEngine", mock.Anything).Return(mockEngine).Once()
 		s.mockShardManager.On("GetShard", &persistence.GetShardRequest{ShardID: shardID}).Return(
 			&persistence.GetShardResponse{
@@ -250,8 +260,9 @@ func (s *shardControllerSuite) TestAcquireShardRenewSuccess() {
 					cluster.TestCurrentClusterName:     currentClusterTimerAck,
 					cluster.TestAlternativeClusterName: alternativeClusterTimerAck,
 				},
-				TransferFailoverLevels: map[string]persistence.TransferFailoverLevel{},
-				TimerFailoverLevels:    map[string]persistence.TimerFailoverLevel{},
+				ClusterReplicationLevel: map[string]int64{},
+				TransferFailoverLevels:  map[string]persistence.TransferFailoverLevel{},
+				TimerFailoverLevels:     map[string]persistence.TimerFailoverLevel{},
 			},
 			PreviousRangeID: 5,
 		}).Return(nil).Once()
@@ -263,7 +274,7 @@ func (s *shardControllerSuite) TestAcquireShardRenewSuccess() {
 	s.controller.acquireShards()
 
 	for shardID := 0; shardID < numShards; shardID++ {
-		s.mockServiceResolver.On("Lookup", string(shardID)).Return(s.hostInfo, nil).Once()
+		s.mockServiceResolver.On("Lookup", membership.ShardKey(shardID)).Return(s.hostInfo, nil).Once()
 	}
 	s.controller.acquireShards()
 
@@ -287,7 +298,7 @@ func (s *shardControllerSuite) TestAcquireShardRenewLookupFailed() {
 		s.mockExecutionMgrFactory.On("NewExecutionManager", mock.Anything).Return(mockExecutionMgr, nil).Once()
 		mockEngine := &MockHistoryEngine{}
 		mockEngine.On("Start").Return().Once()
-		s.mockServiceResolver.On("Lookup", string(shardID)).Return(s.hostInfo, nil).Twice()
+		s.mockServiceResolver.On("Lookup", membership.ShardKey(shardID)).Return(s.hostInfo, nil).Twice()
 		s.mockEngineFactory.On("CreateEngine", mock.Anything).Return(mockEngine).Once()
 		s.mockShardManager.On("GetShard", &persistence.GetShardRequest{ShardID: shardID}).Return(
 			&persistence.GetShardResponse{
@@ -325,8 +336,9 @@ func (s *shardControllerSuite) TestAcquireShardRenewLookupFailed() {
 					cluster.TestCurrentClusterName:     currentClusterTimerAck,
 					cluster.TestAlternativeClusterName: alternativeClusterTimerAck,
 				},
-				TransferFailoverLevels: map[string]persistence.TransferFailoverLevel{},
-				TimerFailoverLevels:    map[string]persistence.TimerFailoverLevel{},
+				ClusterReplicationLevel: map[string]int64{},
+				TransferFailoverLevels:  map[string]persistence.TransferFailoverLevel{},
+				TimerFailoverLevels:     map[string]persistence.TimerFailoverLevel{},
 			},
 			PreviousRangeID: 5,
 		}).Return(nil).Once()
@@ -338,7 +350,7 @@ func (s *shardControllerSuite) TestAcquireShardRenewLookupFailed() {
 	s.controller.acquireShards()
 
 	for shardID := 0; shardID < numShards; shardID++ {
-		s.mockServiceResolver.On("Lookup", string(shardID)).Return(nil, errors.New("ring failure")).Once()
+		s.mockServiceResolver.On("Lookup", membership.ShardKey(shardID)).Return(nil, errors.New("ring failure")).Once()
 	}
 	s.controller.acquireShards()
 
@@ -351,7 +363,7 @@ func (s *shardControllerSuite) TestHistoryEngineClosed() {
 	numShards := 4
 	s.config.NumberOfShards = numShards
 	s.controller = newShardController(s.mockService, s.hostInfo, s.mockServiceResolver, s.mockShardManager, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.domainCache, s.mockExecutionMgrFactory, s.mockEngineFactory, s.config, s.logger, s.metricsClient)
+		s.mockQuarantineMgr, s.domainCache, s.mockExecutionMgrFactory, s.mockEngineFactory, s.config, s.logger, s.metricsClient)
 	historyEngines := make(map[int]*MockHistoryEngine)
 	for shardID := 0; shardID < numShards; shardID++ {
 		mockEngine := &MockHistoryEngine{}
@@ -386,7 +398,7 @@ func (s *shardControllerSuite) TestHistoryEngineClosed() {
 	for shardID := 0; shardID < 2; shardID++ {
 		mockEngine := historyEngines[shardID]
 		mockEngine.On("Stop").Return().Once()
-		s.mockServiceResolver.On("Lookup", string(shardID)).Return(differentHostInfo, nil)
+		s.mockServiceResolver.On("Lookup", membership.ShardKey(shardID)).Return(differentHostInfo, nil)
 		s.controller.shardClosedCh <- shardID
 	}
 
@@ -431,7 +443,7 @@ func (s *shardControllerSuite) TestHistoryEngineClosed() {
 	for shardID := 2; shardID < numShards; shardID++ {
 		mockEngine := historyEngines[shardID]
 		mockEngine.On("Stop").Return().Once()
-		s.mockServiceResolver.On("Lookup", string(shardID)).Return(s.hostInfo, nil)
+		s.mockServiceResolver.On("Lookup", membership.ShardKey(shardID)).Return(s.hostInfo, nil)
 	}
 	s.controller.Stop()
 
@@ -444,7 +456,7 @@ func (s *shardControllerSuite) TestRingUpdated() {
 	numShards := 4
 	s.config.NumberOfShards = numShards
 	s.controller = newShardController(s.mockService, s.hostInfo, s.mockServiceResolver, s.mockShardManager, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.domainCache, s.mockExecutionMgrFactory, s.mockEngineFactory, s.config, s.logger, s.metricsClient)
+		s.mockQuarantineMgr, s.domainCache, s.mockExecutionMgrFactory, s.mockEngineFactory, s.config, s.logger, s.metricsClient)
 	historyEngines := make(map[int]*MockHistoryEngine)
 	for shardID := 0; shardID < numShards; shardID++ {
 		mockEngine := &MockHistoryEngine{}
@@ -463,10 +475,10 @@ func (s *shardControllerSuite) TestRingUpdated() {
 	for shardID := 0; shardID < 2; shardID++ {
 		mockEngine := historyEngines[shardID]
 		mockEngine.On("Stop").Return().Once()
-		s.mockServiceResolver.On("Lookup", string(shardID)).Return(differentHostInfo, nil)
+		s.mockServiceResolver.On("Lookup", membership.ShardKey(shardID)).Return(differentHostInfo, nil)
 	}
-	s.mockServiceResolver.On("Lookup", string(2)).Return(s.hostInfo, nil)
-	s.mockServiceResolver.On("Lookup", string(3)).Return(s.hostInfo, nil)
+	s.mockServiceResolver.On("Lookup", membership.ShardKey(2)).Return(s.hostInfo, nil)
+	s.mockServiceResolver.On("Lookup", membership.ShardKey(3)).Return(s.hostInfo, nil)
 	s.controller.membershipUpdateCh <- &membership.ChangedEvent{}
 
 	var workerWG sync.WaitGroup
@@ -511,7 +523,7 @@ func (s *shardControllerSuite) TestRingUpdated() {
 	for shardID := 2; shardID < numShards; shardID++ {
 		mockEngine := historyEngines[shardID]
 		mockEngine.On("Stop").Return().Once()
-		s.mockServiceResolver.On("Lookup", string(shardID)).Return(s.hostInfo, nil)
+		s.mockServiceResolver.On("Lookup", membership.ShardKey(shardID)).Return(s.hostInfo, nil)
 	}
 	s.controller.Stop()
 
@@ -524,7 +536,7 @@ func (s *shardControllerSuite) TestShardControllerClosed() {
 	numShards := 4
 	s.config.NumberOfShards = numShards
 	s.controller = newShardController(s.mockService, s.hostInfo, s.mockServiceResolver, s.mockShardManager, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.domainCache, s.mockExecutionMgrFactory, s.mockEngineFactory, s.config, s.logger, s.metricsClient)
+		s.mockQuarantineMgr, s.domainCache, s.mockExecutionMgrFactory, s.mockEngineFactory, s.config, s.logger, s.metricsClient)
 	historyEngines := make(map[int]*MockHistoryEngine)
 	for shardID := 0; shardID < numShards; shardID++ {
 		mockEngine := &MockHistoryEngine{}
@@ -564,15 +576,88 @@ func (s *shardControllerSuite) TestShardControllerClosed() {
 	for shardID := 0; shardID < numShards; shardID++ {
 		mockEngine := historyEngines[shardID]
 		mockEngine.On("Stop").Return().Once()
-		s.mockServiceResolver.On("Lookup", string(shardID)).Return(s.hostInfo, nil)
+		s.mockServiceResolver.On("Lookup", membership.ShardKey(shardID)).Return(s.hostInfo, nil)
 	}
 	s.controller.Stop()
 	workerWG.Wait()
 }
 
+func (s *shardControllerSuite) TestAcquireShardTakeOverFromDrainedHost() {
+	numShards := 3
+	s.config.NumberOfShards = numShards
+	s.config.ShardDrainBatchSize = dynamicconfig.GetIntPropertyFn(2)
+	drainedHost := membership.NewHostInfo("shardController-drained-host-test", nil)
+	s.drainedHosts = drainedHost.Identity()
+	s.mockServiceResolver.On("Members").Return([]*membership.HostInfo{s.hostInfo, drainedHost})
+
+	// only the first 2 shards of the drained host are taken over in one round
+	for shardID := 0; shardID < 2; shardID++ {
+		s.mockServiceResolver.On("Lookup", membership.ShardKey(shardID)).Return(drainedHost, nil).Twice()
+		s.mockServiceResolver.On("Lookup", membership.ShardKey(shardID)+"_1").Return(s.hostInfo, nil).Twice()
+		s.setupMocksForCreateEngine(shardID, &MockHistoryEngine{}, 5, 6)
+	}
+	s.mockServiceResolver.On("Lookup", membership.ShardKey(2)).Return(drainedHost, nil).Once()
+	s.mockServiceResolver.On("Lookup", membership.ShardKey(2)+"_1").Return(s.hostInfo, nil).Once()
+
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return(cluster.TestCurrentClusterName)
+	s.mockClusterMetadata.On("GetAllClusterFailoverVersions").Return(cluster.TestAllClusterFailoverVersions)
+	s.controller.acquireShards()
+	s.Equal(2, s.controller.numShards())
+	s.True(s.controller.hasShard(0))
+	s.True(s.controller.hasShard(1))
+	s.False(s.controller.hasShard(2))
+}
+
+func (s *shardControllerSuite) TestDrainedHostKeepsShardsUntilClosed() {
+	s.config.NumberOfShards = 1
+	mockEngine := &MockHistoryEngine{}
+	s.setupMocksForAcquireShard(0, mockEngine, 5, 6)
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return(cluster.TestCurrentClusterName)
+	s.mockClusterMetadata.On("GetAllClusterFailoverVersions").Return(cluster.TestAllClusterFailoverVersions)
+	s.controller.acquireShards()
+	s.True(s.controller.hasShard(0))
+
+	newOwner := membership.NewHostInfo("shardController-new-owner-test", nil)
+	s.drainedHosts = s.hostInfo.Identity()
+	s.mockServiceResolver.On("Members").Return([]*membership.HostInfo{s.hostInfo, newOwner})
+	s.mockServiceResolver.On("Lookup", membership.ShardKey(0)).Return(s.hostInfo, nil)
+	s.mockServiceResolver.On("Lookup", membership.ShardKey(0)+"_1").Return(newOwner, nil)
+	s.True(s.controller.isDraining())
+
+	// the drained host keeps serving the shard until it is taken over or closed
+	s.controller.acquireShards()
+	s.True(s.controller.hasShard(0))
+
+	mockEngine.On("Stop").Return().Once()
+	s.NoError(s.controller.closeShard(0))
+	s.False(s.controller.hasShard(0))
+
+	// the shard is not reacquired by the drained host
+	_, err := s.controller.getEngineForShard(0)
+	s.IsType(&history.ShardOwnershipLostError{}, err)
+	s.Equal(newOwner.GetAddress(), err.(*history.ShardOwnershipLostError).GetOwner())
+	mockEngine.AssertExpectations(s.T())
+}
+
+func (s *shardControllerSuite) TestCloseShardNotOwned() {
+	owner := membership.NewHostInfo("shardController-owner-test", nil)
+	s.mockServiceResolver.On("Lookup", membership.ShardKey(3)).Return(owner, nil).Once()
+
+	err := s.controller.closeShard(3)
+	s.IsType(&history.ShardOwnershipLostError{}, err)
+	s.Equal(owner.GetAddress(), err.(*history.ShardOwnershipLostError).GetOwner())
+}
+
 func (s *shardControllerSuite) setupMocksForAcquireShard(shardID int, mockEngine *MockHistoryEngine, currentRangeID,
 	newRangeID int64) {
 
+	s.mockServiceResolver.On("Lookup", membership.ShardKey(shardID)).Return(s.hostInfo, nil).Twice()
+	s.setupMocksForCreateEngine(shardID, mockEngine, currentRangeID, newRangeID)
+}
+
+func (s *shardControllerSuite) setupMocksForCreateEngine(shardID int, mockEngine *MockHistoryEngine, currentRangeID,
+	newRangeID int64) {
+
 	replicationAck := int64(201)
 	currentClusterTransferAck := int64(210)
 	alternativeClusterTransferAck := int64(320)
@@ -583,7 +668,6 @@ func (s *shardControllerSuite) setupMocksForAcquireShard(shardID int, mockEngine
 	mockExecutionMgr.On("Close").Return()
 	s.mockExecutionMgrFactory.On("NewExecutionManager", shardID).Return(mockExecutionMgr, nil).Once()
 	mockEngine.On("Start").Return().Once()
-	s.mockServiceResolver.On("Lookup", string(shardID)).Return(s.hostInfo, nil).Twice()
 	s.mockEngineFactory.On("CreateEngine", mock.Anything).Return(mockEngine).Once()
 	s.mockShardManager.On("GetShard", &persistence.GetShardRequest{ShardID: shardID}).Return(
 		&persistence.GetShardResponse{
@@ -621,8 +705,9 @@ func (s *shardControllerSuite) setupMocksForAcquireShard(shardID int, mockEngine
 				cluster.TestCurrentClusterName:     currentClusterTimerAck,
 				cluster.TestAlternativeClusterName: alternativeClusterTimerAck,
 			},
-			TransferFailoverLevels: map[string]persistence.TransferFailoverLevel{},
-			TimerFailoverLevels:    map[string]persistence.TimerFailoverLevel{},
+			ClusterReplicationLevel: map[string]int64{},
+			TransferFailoverLevels:  map[string]persistence.TransferFailoverLevel{},
+			TimerFailoverLevels:     map[string]persistence.TimerFailoverLevel{},
 		},
 		PreviousRangeID: currentRangeID,
 	}).Return(nil).Once()
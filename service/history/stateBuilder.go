@@ -110,6 +110,9 @@ func (b *stateBuilderImpl) applyEvents(domainID, requestID string, execution sha
 			b.msBuilder.ReplicateWorkflowExecutionStartedEvent(domainID, parentDomainID, execution, requestID, attributes)
 
 			b.timerTasks = append(b.timerTasks, b.scheduleWorkflowTimerTask(event, b.msBuilder))
+			if attributes.GetFirstDecisionTaskBackoffSeconds() > 0 {
+				b.timerTasks = append(b.timerTasks, b.scheduleWorkflowBackoffTimerTask(event))
+			}
 			if eventStoreVersion == persistence.EventStoreVersionV2 {
 				b.msBuilder.SetHistoryTree(*execution.RunId)
 			}
@@ -202,6 +205,15 @@ func (b *stateBuilderImpl) applyEvents(domainID, requestID string, execution sha
 				b.timerTasks = append(b.timerTasks, timerTask)
 			}
 
+		case shared.EventTypeActivityTaskRetryRequested:
+			b.msBuilder.ReplicateActivityTaskRetryRequestedEvent(event)
+
+			b.transferTasks = append(b.transferTasks, b.scheduleActivityTransferTask(domainID, b.getTaskList(b.msBuilder),
+				event.ActivityTaskRetryRequestedEventAttributes.GetScheduledEventId()))
+			if timerTask := b.scheduleActivityTimerTask(event, b.msBuilder); timerTask != nil {
+				b.timerTasks = append(b.timerTasks, timerTask)
+			}
+
 		case shared.EventTypeActivityTaskCancelRequested:
 			b.msBuilder.ReplicateActivityTaskCancelRequestedEvent(event)
 
@@ -330,6 +342,13 @@ func (b *stateBuilderImpl) applyEvents(domainID, requestID string, execution sha
 		case shared.EventTypeWorkflowExecutionCancelRequested:
 			b.msBuilder.ReplicateWorkflowExecutionCancelRequestedEvent(event)
 
+		case shared.EventTypeWorkflowExecutionPaused:
+			b.msBuilder.ReplicateWorkflowExecutionPausedEvent(event)
+
+		case shared.EventTypeWorkflowExecutionResumed:
+			b.msBuilder.ReplicateWorkflowExecutionResumedEvent(event)
+			b.transferTasks = append(b.transferTasks, b.scheduleHeldTransferTasks(event, domainID, b.msBuilder)...)
+
 		case shared.EventTypeWorkflowExecutionCompleted:
 			b.msBuilder.ReplicateWorkflowExecutionCompletedEvent(event)
 			b.transferTasks = append(b.transferTasks, b.scheduleDeleteHistoryTransferTask())
@@ -474,6 +493,24 @@ func (b *stateBuilderImpl) scheduleActivityTransferTask(domainID string, tasklis
 	}
 }
 
+func (b *stateBuilderImpl) scheduleHeldTransferTasks(event *shared.HistoryEvent, domainID string,
+	msBuilder mutableState) []persistence.Task {
+	var transferTasks []persistence.Task
+	if msBuilder.HasPendingDecisionTask() && !msBuilder.HasInFlightDecisionTask() {
+		transferTasks = append(transferTasks, b.scheduleDecisionTransferTask(domainID, b.getTaskList(msBuilder),
+			msBuilder.GetExecutionInfo().DecisionScheduleID))
+	}
+
+	now := time.Unix(0, event.GetTimestamp())
+	for _, ai := range msBuilder.GetPendingActivityInfos() {
+		if ai.StartedID == common.EmptyEventID && !ai.ScheduledTime.After(now) {
+			transferTasks = append(transferTasks, b.scheduleActivityTransferTask(domainID, b.getTaskList(msBuilder),
+				ai.ScheduleID))
+		}
+	}
+	return transferTasks
+}
+
 func (b *stateBuilderImpl) scheduleStartChildWorkflowTransferTask(domainID string, workflowID string,
 	initiatedID int64) persistence.Task {
 	return &persistence.StartChildExecutionTask{
@@ -536,9 +573,19 @@ func (b *stateBuilderImpl) scheduleWorkflowTimerTask(event *shared.HistoryEvent,
 	msBuilder mutableState) persistence.Task {
 	now := time.Unix(0, event.GetTimestamp())
 	timeout := now.Add(time.Duration(msBuilder.GetExecutionInfo().WorkflowTimeout) * time.Second)
+	if attributes := event.WorkflowExecutionStartedEventAttributes; attributes != nil {
+		// workflow timeout starts counting after the backoff of the first decision
+		timeout = timeout.Add(time.Duration(attributes.GetFirstDecisionTaskBackoffSeconds()) * time.Second)
+	}
 	return &persistence.WorkflowTimeoutTask{VisibilityTimestamp: timeout}
 }
 
+func (b *stateBuilderImpl) scheduleWorkflowBackoffTimerTask(event *shared.HistoryEvent) persistence.Task {
+	now := time.Unix(0, event.GetTimestamp())
+	backoff := time.Duration(event.WorkflowExecutionStartedEventAttributes.GetFirstDecisionTaskBackoffSeconds()) * time.Second
+	return &persistence.WorkflowRetryTimerTask{VisibilityTimestamp: now.Add(backoff)}
+}
+
 func (b *stateBuilderImpl) scheduleDeleteHistoryTimerTask(event *shared.HistoryEvent, domainID, workflowID string) (persistence.Task, error) {
 	var retentionInDays int32
 	domainEntry, err := b.shard.GetDomainCache().GetDomainByID(domainID)
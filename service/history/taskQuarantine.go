@@ -0,0 +1,171 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"encoding/json"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"github.com/uber-common/bark"
+	h "github.com/uber/cadence/.gen/go/history"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+var (
+	// quarantinedTransferTaskTimestamp is used as the visibility timestamp of all quarantined transfer tasks,
+	// transfer tasks are identified by the task ID only
+	quarantinedTransferTaskTimestamp = time.Unix(0, 0)
+
+	errQuarantineNotSupported = &workflow.BadRequestError{Message: "Task quarantine is not supported by the persistence store."}
+)
+
+// isTaskErrorTransient returns false only if the error of processing a task is known to come back on every retry,
+// any other error, including rpc and timeout errors, may go away by retrying and such tasks are never quarantined
+func isTaskErrorTransient(err error) bool {
+	switch err.(type) {
+	case *workflow.BadRequestError,
+		*persistence.InvalidPersistenceRequestError,
+		*persistence.HistorySerializationError,
+		*persistence.HistoryDeserializationError,
+		*persistence.UnknownEncodingTypeError:
+		return false
+	}
+
+	return true
+}
+
+// quarantineTask moves a transfer or timer task which keeps failing with a non-transient error to the
+// quarantine of the shard, so the task can be acked and the ack level can move forward.
+// Returns true if the task is quarantined.
+func quarantineTask(shard ShardContext, task queueTaskInfo, attempt int, taskErr error, scope int,
+	logger bark.Logger) bool {
+
+	if !shard.GetConfig().EnableTaskQuarantine() || isTaskErrorTransient(taskErr) {
+		return false
+	}
+	quarantineMgr := shard.GetQuarantineManager()
+	if quarantineMgr == nil {
+		return false
+	}
+
+	taskInfo := &persistence.QuarantinedTaskInfo{
+		ShardID:     shard.GetShardID(),
+		TaskID:      task.GetTaskID(),
+		TaskType:    task.GetTaskType(),
+		Attempt:     attempt,
+		CreatedTime: time.Now(),
+		Error:       taskErr.Error(),
+		StackTrace:  string(debug.Stack()),
+		Encoding:    common.EncodingTypeJSON,
+	}
+	switch task := task.(type) {
+	case *persistence.TransferTaskInfo:
+		taskInfo.QueueType = persistence.QuarantineQueueTypeTransfer
+		taskInfo.VisibilityTimestamp = quarantinedTransferTaskTimestamp
+		taskInfo.DomainID = task.DomainID
+		taskInfo.WorkflowID = task.WorkflowID
+		taskInfo.RunID = task.RunID
+	case *persistence.TimerTaskInfo:
+		taskInfo.QueueType = persistence.QuarantineQueueTypeTimer
+		taskInfo.VisibilityTimestamp = task.VisibilityTimestamp
+		taskInfo.DomainID = task.DomainID
+		taskInfo.WorkflowID = task.WorkflowID
+		taskInfo.RunID = task.RunID
+	default:
+		// replication tasks are never quarantined
+		return false
+	}
+
+	metricsClient := shard.GetMetricsClient()
+	data, err := json.Marshal(task)
+	if err == nil {
+		taskInfo.Data = data
+		err = quarantineMgr.PutQuarantinedTask(&persistence.PutQuarantinedTaskRequest{TaskInfo: taskInfo})
+	}
+	if err != nil {
+		metricsClient.IncCounter(scope, metrics.TaskQuarantineFailedCounter)
+		logger.WithFields(bark.Fields{
+			logging.TagErr: err,
+		}).Error("Fail to quarantine task.")
+		return false
+	}
+
+	metricsClient.IncCounter(scope, metrics.TaskQuarantinedCounter)
+	logger.WithFields(bark.Fields{
+		logging.TagAttempt: attempt,
+		logging.TagErr:     taskErr,
+	}).Warn("Task quarantined after max retry count.")
+	return true
+}
+
+// deserializeQuarantinedTask restores the transfer or timer task kept by the quarantine
+func deserializeQuarantinedTask(taskInfo *persistence.QuarantinedTaskInfo) (queueTaskInfo, error) {
+	if taskInfo.Encoding != common.EncodingTypeJSON {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("Unsupported quarantined task encoding: %v", taskInfo.Encoding),
+		}
+	}
+
+	var task queueTaskInfo
+	switch taskInfo.QueueType {
+	case persistence.QuarantineQueueTypeTransfer:
+		task = &persistence.TransferTaskInfo{}
+	case persistence.QuarantineQueueTypeTimer:
+		task = &persistence.TimerTaskInfo{}
+	default:
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("Unknown quarantined task queue type: %v", taskInfo.QueueType),
+		}
+	}
+	if err := json.Unmarshal(taskInfo.Data, task); err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("Unable to deserialize quarantined task: %v", err),
+		}
+	}
+	return task, nil
+}
+
+func getQuarantineQueueType(queueType h.ShardQueueType) (int, error) {
+	switch queueType {
+	case h.ShardQueueTypeTransfer:
+		return persistence.QuarantineQueueTypeTransfer, nil
+	case h.ShardQueueTypeTimer:
+		return persistence.QuarantineQueueTypeTimer, nil
+	default:
+		return 0, &workflow.BadRequestError{Message: "Only transfer and timer tasks can be quarantined."}
+	}
+}
+
+func getQuarantinedTaskTimestamp(queueType int, visibilityTimestamp *int64) (time.Time, error) {
+	if queueType == persistence.QuarantineQueueTypeTransfer {
+		return quarantinedTransferTaskTimestamp, nil
+	}
+	if visibilityTimestamp == nil {
+		return time.Time{}, &workflow.BadRequestError{Message: "Visibility timestamp is required to locate a timer task."}
+	}
+	return time.Unix(0, *visibilityTimestamp), nil
+}
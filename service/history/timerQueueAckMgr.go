@@ -283,6 +283,29 @@ func (t *timerQueueAckMgrImpl) getAckLevel() TimerSequenceID {
 	return t.ackLevel
 }
 
+// getOutstandingTaskCount returns the number of loaded timer tasks which are not acked yet
+func (t *timerQueueAckMgrImpl) getOutstandingTaskCount() int {
+	t.Lock()
+	defer t.Unlock()
+
+	count := 0
+	for _, acked := range t.outstandingTasks {
+		if !acked {
+			count++
+		}
+	}
+	return count
+}
+
+func (t *timerQueueAckMgrImpl) isTimerTaskOutstanding(timerTask *persistence.TimerTaskInfo) bool {
+	timerSequenceID := TimerSequenceID{VisibilityTimestamp: timerTask.VisibilityTimestamp, TaskID: timerTask.TaskID}
+	t.Lock()
+	defer t.Unlock()
+
+	acked, ok := t.outstandingTasks[timerSequenceID]
+	return ok && !acked
+}
+
 func (t *timerQueueAckMgrImpl) updateAckLevel() {
 	t.metricsClient.IncCounter(t.scope, metrics.AckLevelUpdateCounter)
 
@@ -589,12 +589,21 @@ func (t *timerQueueActiveProcessorImpl) processActivityRetryTimer(task *persiste
 		if !running || task.ScheduleAttempt < int64(ai.Attempt) {
 			return nil
 		}
+		if ai.StartedID != common.EmptyEventID {
+			// activity is already dispatched and started, e.g. retried by an operator before the backoff expired
+			return nil
+		}
 		ok, err := verifyTaskVersion(t.shard, t.logger, task.DomainID, ai.Version, task.Version, task)
 		if err != nil {
 			return err
 		} else if !ok {
 			return nil
 		}
+		if msBuilder.IsPaused() {
+			// activity task is generated when the workflow is resumed
+			t.metricsClient.IncCounter(metrics.TimerActiveTaskActivityRetryTimerScope, metrics.TaskHeldWhilePausedCounter)
+			return nil
+		}
 
 		domainID := task.DomainID
 		targetDomainID := domainID
@@ -669,6 +678,16 @@ Update_History_Loop:
 		}
 
 		retryBackoffInterval := msBuilder.GetRetryBackoffDuration(getTimeoutErrorReason(workflow.TimeoutTypeStartToClose))
+		if retryBackoffInterval != common.NoRetryBackoff {
+			// a deleted domain does not accept new runs, the workflow execution times out instead
+			domainEntry, err := t.shard.GetDomainCache().GetDomainByID(domainID)
+			if err != nil {
+				return err
+			}
+			if domainEntry.GetInfo().Status == persistence.DomainStatusDeleted {
+				retryBackoffInterval = common.NoRetryBackoff
+			}
+		}
 		if retryBackoffInterval == common.NoRetryBackoff {
 			if e := msBuilder.AddTimeoutWorkflowEvent(); e == nil {
 				// If we failed to add the event that means the workflow is already completed.
@@ -27,6 +27,8 @@ import (
 	"time"
 
 	"github.com/uber-common/bark"
+	h "github.com/uber/cadence/.gen/go/history"
+	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/metrics"
@@ -156,6 +158,53 @@ func (t *timerQueueProcessorImpl) FailoverDomain(domainID string) {
 	updateShardAckLevel(TimerSequenceID{VisibilityTimestamp: minLevel})
 }
 
+func (t *timerQueueProcessorImpl) GetQueueStates() []*h.ShardQueueState {
+	states := []*h.ShardQueueState{t.activeTimerProcessor.timerQueueProcessorBase.getQueueState(t.currentClusterName)}
+	if t.isGlobalDomainEnabled {
+		for clusterName, standbyTimerProcessor := range t.standbyTimerProcessors {
+			states = append(states, standbyTimerProcessor.timerQueueProcessorBase.getQueueState(clusterName))
+		}
+	}
+	return states
+}
+
+// CompleteTimer acks a timer task in the active and standby processors without processing it,
+// the worker retrying the task, if any, gives up on its next attempt.
+func (t *timerQueueProcessorImpl) CompleteTimer(timerTask *persistence.TimerTaskInfo) {
+	// timers which are not loaded yet are not acked, or the ack level would jump over the timers before them
+	if t.activeTimerProcessor.timerQueueAckMgr.isTimerTaskOutstanding(timerTask) {
+		t.activeTimerProcessor.timerQueueAckMgr.completeTimerTask(timerTask)
+	}
+	for _, standbyTimerProcessor := range t.standbyTimerProcessors {
+		if standbyTimerProcessor.timerQueueAckMgr.isTimerTaskOutstanding(timerTask) {
+			standbyTimerProcessor.timerQueueAckMgr.completeTimerTask(timerTask)
+		}
+	}
+}
+
+// RescheduleTimer processes a timer task with the active processor right away,
+// and acks it if the processing succeeds. Timers not due yet are refused, the
+// processor fires the timers it is given without checking their visibility time.
+func (t *timerQueueProcessorImpl) RescheduleTimer(timerTask *persistence.TimerTaskInfo) error {
+	if timerTask.VisibilityTimestamp.After(t.shard.GetCurrentTime(t.currentClusterName)) {
+		return ErrTimerTaskNotDue
+	}
+
+	_, err := t.activeTimerProcessor.process(timerTask)
+	if _, ok := err.(*workflow.EntityNotExistsError); ok {
+		// the workflow is gone, same as the queue processor, treat the task as processed
+		err = nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if t.activeTimerProcessor.timerQueueAckMgr.isTimerTaskOutstanding(timerTask) {
+		t.activeTimerProcessor.timerQueueAckMgr.completeTimerTask(timerTask)
+	}
+	return nil
+}
+
 func (t *timerQueueProcessorImpl) getTimerFiredCount(clusterName string) uint64 {
 	if clusterName == t.currentClusterName {
 		return t.activeTimerProcessor.getTimerFiredCount()
@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"github.com/uber-common/bark"
+	h "github.com/uber/cadence/.gen/go/history"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
@@ -417,10 +418,19 @@ func (t *timerQueueProcessorBase) processTaskAndAck(notificationChan <-chan stru
 				return
 			}
 
+			if !t.timerQueueAckMgr.isTimerTaskOutstanding(task) {
+				// the task is force completed by an operator while being retried
+				return
+			}
+
 			attempt++
 
 			if attempt >= t.config.TimerTaskMaxRetryCount() {
 				t.metricsClient.RecordTimer(scope, metrics.TaskAttemptTimer, time.Duration(attempt))
+				if quarantineTask(t.shard, task, attempt, err, scope, logger) {
+					t.ackTaskOnce(task, scope)
+					return
+				}
 				logging.LogCriticalErrorEvent(logger, "Critical error processing timer task, retrying.", err)
 			}
 		}
@@ -502,6 +512,16 @@ func (t *timerQueueProcessorBase) ackTaskOnce(task *persistence.TimerTaskInfo, s
 	atomic.AddUint64(&t.timerFiredCount, 1)
 }
 
+func (t *timerQueueProcessorBase) getQueueState(clusterName string) *h.ShardQueueState {
+	return &h.ShardQueueState{
+		QueueType:        h.ShardQueueTypeTimer.Ptr(),
+		ClusterName:      common.StringPtr(clusterName),
+		AckLevel:         common.Int64Ptr(t.timerQueueAckMgr.getAckLevel().VisibilityTimestamp.UnixNano()),
+		ReadLevel:        common.Int64Ptr(t.timerQueueAckMgr.getReadLevel().VisibilityTimestamp.UnixNano()),
+		OutstandingTasks: common.Int32Ptr(int32(t.timerQueueAckMgr.getOutstandingTaskCount())),
+	}
+}
+
 func (t *timerQueueProcessorBase) initializeLoggerForTask(task *persistence.TimerTaskInfo) bark.Logger {
 	logger := t.logger.WithFields(bark.Fields{
 		logging.TagHistoryShardID:      t.shard.GetShardID(),
@@ -564,20 +584,7 @@ func (t *timerQueueProcessorBase) processDeleteHistoryEvent(task *persistence.Ti
 	}
 
 	domainID, workflowExecution := t.getDomainIDAndWorkflowExecution(task)
-	op = func() error {
-		if msBuilder.GetEventStoreVersion() == persistence.EventStoreVersionV2 {
-			return t.historyService.historyV2Mgr.DeleteHistoryBranch(&persistence.DeleteHistoryBranchRequest{
-				BranchToken: msBuilder.GetCurrentBranch(),
-			})
-		}
-		return t.historyService.historyMgr.DeleteWorkflowExecutionHistory(
-			&persistence.DeleteWorkflowExecutionHistoryRequest{
-				DomainID:  domainID,
-				Execution: workflowExecution,
-			})
-	}
-
-	return backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
+	return deleteWorkflowHistory(t.historyService.historyMgr, t.historyService.historyV2Mgr, domainID, workflowExecution, msBuilder)
 }
 
 func (t *timerQueueProcessorBase) getTimerTaskType(taskType int) string {
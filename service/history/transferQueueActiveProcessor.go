@@ -252,6 +252,11 @@ func (t *transferQueueActiveProcessorImpl) processActivityTask(task *persistence
 	} else if !ok {
 		return nil
 	}
+	if msBuilder.IsPaused() {
+		// activity task is generated again when the workflow is resumed
+		t.metricsClient.IncCounter(metrics.TransferActiveTaskActivityScope, metrics.TaskHeldWhilePausedCounter)
+		return nil
+	}
 
 	timeout := common.MinInt32(ai.ScheduleToStartTimeout, common.MaxTaskTimeout)
 	// release the context lock since we no longer need mutable state builder and
@@ -309,6 +314,7 @@ func (t *transferQueueActiveProcessorImpl) processDecisionTask(task *persistence
 		tasklist.Kind = common.TaskListKindPtr(workflow.TaskListKindSticky)
 		decisionTimeout = executionInfo.StickyScheduleToStartTimeout
 	}
+	paused := msBuilder.IsPaused()
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
@@ -318,6 +324,11 @@ func (t *transferQueueActiveProcessorImpl) processDecisionTask(task *persistence
 			return err
 		}
 	}
+	if paused {
+		// decision task is generated again when the workflow is resumed
+		t.metricsClient.IncCounter(metrics.TransferActiveTaskDecisionScope, metrics.TaskHeldWhilePausedCounter)
+		return nil
+	}
 
 	err = t.pushDecision(task, tasklist, decisionTimeout)
 	if err != nil {
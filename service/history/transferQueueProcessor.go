@@ -27,6 +27,8 @@ import (
 	"time"
 
 	"github.com/uber-common/bark"
+	h "github.com/uber/cadence/.gen/go/history"
+	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common/logging"
@@ -169,6 +171,41 @@ func (t *transferQueueProcessorImpl) FailoverDomain(domainID string) {
 	updateShardAckLevel(minLevel)
 }
 
+func (t *transferQueueProcessorImpl) GetQueueStates() []*h.ShardQueueState {
+	states := []*h.ShardQueueState{t.activeTaskProcessor.getQueueState(h.ShardQueueTypeTransfer)}
+	if t.isGlobalDomainEnabled {
+		for _, standbyTaskProcessor := range t.standbyTaskProcessors {
+			states = append(states, standbyTaskProcessor.getQueueState(h.ShardQueueTypeTransfer))
+		}
+	}
+	return states
+}
+
+// CompleteTask acks a transfer task in the active and standby processors without processing it,
+// the worker retrying the task, if any, gives up on its next attempt.
+func (t *transferQueueProcessorImpl) CompleteTask(taskID int64) {
+	t.activeTaskProcessor.completeQueueTask(taskID)
+	for _, standbyTaskProcessor := range t.standbyTaskProcessors {
+		standbyTaskProcessor.completeQueueTask(taskID)
+	}
+}
+
+// RescheduleTask processes a transfer task with the active processor right away,
+// and acks it if the processing succeeds.
+func (t *transferQueueProcessorImpl) RescheduleTask(task *persistence.TransferTaskInfo) error {
+	_, err := t.activeTaskProcessor.process(task)
+	if _, ok := err.(*workflow.EntityNotExistsError); ok {
+		// the workflow is gone, same as the queue processor, treat the task as processed
+		err = nil
+	}
+	if err != nil {
+		return err
+	}
+
+	t.activeTaskProcessor.completeQueueTask(task.TaskID)
+	return nil
+}
+
 func (t *transferQueueProcessorImpl) completeTransferLoop() {
 	timer := time.NewTimer(t.config.TransferProcessorCompleteTransferInterval())
 	defer timer.Stop()
@@ -162,6 +162,10 @@ func (t *transferQueueStandbyProcessorImpl) processActivityTask(transferTask *pe
 		} else if !ok {
 			return nil
 		}
+		if msBuilder.IsPaused() {
+			// activity task is generated again when the resumed event is replicated
+			return nil
+		}
 
 		now := t.shard.GetCurrentTime(t.clusterName)
 		pushToMatching := now.Sub(transferTask.GetVisibilityTimestamp()) > t.shard.GetConfig().StandbyClusterDelay()
@@ -228,6 +232,10 @@ func (t *transferQueueStandbyProcessorImpl) processDecisionTask(transferTask *pe
 		if markWorkflowAsOpen {
 			err = t.recordWorkflowStarted(transferTask.DomainID, execution, wfTypeName, startTimestamp.UnixNano(), workflowTimeout)
 		}
+		if msBuilder.IsPaused() {
+			// decision task is generated again when the resumed event is replicated
+			return err
+		}
 
 		now := t.shard.GetCurrentTime(t.clusterName)
 		pushToMatching := now.Sub(transferTask.GetVisibilityTimestamp()) > t.shard.GetConfig().StandbyClusterDelay()
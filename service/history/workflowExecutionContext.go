@@ -22,6 +22,7 @@ package history
 
 import (
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/uber-common/bark"
@@ -55,6 +56,9 @@ type (
 		updateCondition       int64
 		deleteTimerTask       persistence.Task
 		createReplicationTask bool
+
+		// estimated size in bytes of the mutable state, used to bound the history cache by memory footprint
+		cacheSize int64
 	}
 )
 
@@ -118,6 +122,7 @@ func (c *workflowExecutionContext) loadWorkflowExecutionInternal() error {
 	}
 
 	c.msBuilder = msBuilder
+	atomic.StoreInt64(&c.cacheSize, msBuilder.GetMutableStateSize())
 	// finally emit execution and session stats
 	c.emitWorkflowExecutionStats(response.MutableStateStats, c.msBuilder.GetHistorySize())
 	return nil
@@ -424,6 +429,9 @@ func (c *workflowExecutionContext) updateHelperWithNewRun(transferTasks []persis
 		c.msBuilder.IsWorkflowExecutionRunning(),
 	))
 
+	// the estimate is recomputed, so that deleted rows are no longer counted
+	atomic.StoreInt64(&c.cacheSize, c.msBuilder.GetMutableStateSize())
+
 	// finally emit session stats
 	if resp != nil {
 		c.emitSessionUpdateStats(resp.MutableStateUpdateSessionStats)
@@ -566,6 +574,12 @@ func (c *workflowExecutionContext) updateWorkflowExecutionWithRetry(
 func (c *workflowExecutionContext) clear() {
 	c.metricsClient.IncCounter(metrics.WorkflowContextScope, metrics.WorkflowContextCleared)
 	c.msBuilder = nil
+	atomic.StoreInt64(&c.cacheSize, 0)
+}
+
+// CacheSize returns the estimated size in bytes of the mutable state loaded by this context
+func (c *workflowExecutionContext) CacheSize() int64 {
+	return atomic.LoadInt64(&c.cacheSize)
 }
 
 // scheduleNewDecision is helper method which has the logic for scheduling new decision for a workflow execution.
@@ -0,0 +1,91 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"github.com/uber-common/bark"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/limits"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+)
+
+const (
+	workflowLimitExceededReason = "Workflow history size or event count exceeds limit."
+	// workflowPendingLimitExceededReason is the termination reason of a workflow execution whose decisions
+	// keep failing because it is over one of the pending limits
+	workflowPendingLimitExceededReason = "Workflow pending activities, child executions, timers or signals exceed limit."
+)
+
+type (
+	// workflowSizeChecker checks a workflow execution against the size and count limits of its domain.
+	// Crossing a warn limit is only logged and counted, crossing an error limit is reported to the caller.
+	workflowSizeChecker struct {
+		domainName string
+		config     *Config
+		checker    *limits.Checker
+	}
+)
+
+func newWorkflowSizeChecker(domainID string, domainName string, execution *workflow.WorkflowExecution, config *Config,
+	metricsClient metrics.Client, scope int, logger bark.Logger) *workflowSizeChecker {
+	logger = logger.WithFields(bark.Fields{
+		logging.TagDomainID:            domainID,
+		logging.TagWorkflowExecutionID: execution.GetWorkflowId(),
+		logging.TagWorkflowRunID:       execution.GetRunId(),
+	})
+	return &workflowSizeChecker{
+		domainName: domainName,
+		config:     config,
+		checker:    limits.NewChecker(domainName, metricsClient, scope, logger),
+	}
+}
+
+// blobSizeLimitExceeded returns true if the given payload size is over the blob size error limit
+func (c *workflowSizeChecker) blobSizeLimitExceeded(size int) bool {
+	return c.checker.CheckLimit(metrics.BlobSizeLimitTypeTagValue, size,
+		c.config.BlobSizeLimitWarn(c.domainName), c.config.BlobSizeLimitError(c.domainName))
+}
+
+// historyLimitExceeded returns true if the history size or event count is over the error limit,
+// the workflow execution cannot make progress any more and should be terminated
+func (c *workflowSizeChecker) historyLimitExceeded(msBuilder mutableState) bool {
+	sizeExceeded := c.checker.CheckLimit(metrics.HistorySizeLimitTypeTagValue, int(msBuilder.GetHistorySize()),
+		c.config.HistorySizeLimitWarn(c.domainName), c.config.HistorySizeLimitError(c.domainName))
+	countExceeded := c.checker.CheckLimit(metrics.HistoryCountLimitTypeTagValue, int(msBuilder.GetNextEventID()-common.FirstEventID),
+		c.config.HistoryCountLimitWarn(c.domainName), c.config.HistoryCountLimitError(c.domainName))
+	return sizeExceeded || countExceeded
+}
+
+// pendingLimitExceeded returns true if the number of pending activities, child executions, timers or
+// external signals is over the error limit
+func (c *workflowSizeChecker) pendingLimitExceeded(msBuilder mutableState) bool {
+	activitiesExceeded := c.checker.CheckLimit(metrics.PendingActivitiesLimitTypeTagValue, len(msBuilder.GetPendingActivityInfos()),
+		c.config.PendingActivitiesLimitWarn(c.domainName), c.config.PendingActivitiesLimitError(c.domainName))
+	childrenExceeded := c.checker.CheckLimit(metrics.PendingChildExecutionsLimitTypeTagValue, len(msBuilder.GetPendingChildExecutionInfos()),
+		c.config.PendingChildExecutionsLimitWarn(c.domainName), c.config.PendingChildExecutionsLimitError(c.domainName))
+	timersExceeded := c.checker.CheckLimit(metrics.PendingTimersLimitTypeTagValue, len(msBuilder.GetPendingTimerInfos()),
+		c.config.PendingTimersLimitWarn(c.domainName), c.config.PendingTimersLimitError(c.domainName))
+	signalsExceeded := c.checker.CheckLimit(metrics.PendingSignalsLimitTypeTagValue, len(msBuilder.GetPendingSignalInfos()),
+		c.config.PendingSignalsLimitWarn(c.domainName), c.config.PendingSignalsLimitError(c.domainName))
+	return activitiesExceeded || childrenExceeded || timersExceeded || signalsExceeded
+}
@@ -32,9 +32,10 @@ import (
 
 // Config represents configuration for cadence-matching service
 type Config struct {
-	PersistenceMaxQPS dynamicconfig.IntPropertyFn
-	EnableSyncMatch   dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
-	RPS               dynamicconfig.IntPropertyFn
+	PersistenceMaxQPS       dynamicconfig.IntPropertyFn
+	PersistenceDomainMaxQPS dynamicconfig.IntPropertyFnWithDomainIDFilter
+	EnableSyncMatch         dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+	RPS                     dynamicconfig.IntPropertyFn
 
 	// taskListManager configuration
 	RangeSize                 int64
@@ -55,6 +56,7 @@ type Config struct {
 func NewConfig(dc *dynamicconfig.Collection) *Config {
 	return &Config{
 		PersistenceMaxQPS:               dc.GetIntProperty(dynamicconfig.MatchingPersistenceMaxQPS, 3000),
+		PersistenceDomainMaxQPS:         dc.GetIntPropertyFilteredByDomainID(dynamicconfig.MatchingPersistenceDomainMaxQPS, 0),
 		EnableSyncMatch:                 dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableSyncMatch, true),
 		RPS:                             dc.GetIntProperty(dynamicconfig.MatchingRPS, 1200),
 		RangeSize:                       100000,
@@ -98,6 +100,7 @@ func (s *Service) Start() {
 
 	pConfig := params.PersistenceConfig
 	pConfig.SetMaxQPS(pConfig.DefaultStore, s.config.PersistenceMaxQPS())
+	pConfig.DomainMaxQPS = s.config.PersistenceDomainMaxQPS
 	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), base.GetMetricsClient(), log)
 
 	taskPersistence, err := pFactory.NewTaskManager()
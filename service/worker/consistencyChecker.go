@@ -0,0 +1,248 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package worker
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/xdc"
+	s "go.uber.org/cadence/.gen/go/shared"
+)
+
+const (
+	consistencyCheckerCallerName   = "cadence-consistency-checker"
+	consistencyCheckerListPageSize = 100
+	consistencyCheckerRPCTimeout   = 10 * time.Second
+)
+
+type (
+	// consistencyChecker periodically samples open workflows of global domains and compares their mutable state
+	// with the one in the other clusters of the domain, mismatches are reported as metrics and logs
+	consistencyChecker struct {
+		status         int32
+		currentCluster string
+		config         *Config
+		metadataMgr    persistence.MetadataManager
+		frontendClient frontend.Client
+		checkers       map[string]xdc.MutableStateChecker
+		cursors        map[string]*consistencyCheckerCursor // by domain ID, only accessed by the check loop
+		logger         bark.Logger
+		metricsClient  metrics.Client
+		shutdownCh     chan struct{}
+	}
+
+	// consistencyCheckerCursor is where the next sample of the open workflows of a domain starts, each round
+	// continues from the page after the previous sample so that all open workflows are eventually checked
+	consistencyCheckerCursor struct {
+		latestTime    int64
+		nextPageToken []byte
+	}
+)
+
+func newConsistencyChecker(clusterMetadata cluster.Metadata, config *Config, metadataMgr persistence.MetadataManager,
+	historyClient history.Client, frontendClient frontend.Client, rpcFactory common.RPCFactory, logger bark.Logger,
+	metricsClient metrics.Client) *consistencyChecker {
+
+	currentCluster := clusterMetadata.GetCurrentClusterName()
+	checkers := make(map[string]xdc.MutableStateChecker)
+	for clusterName := range clusterMetadata.GetAllClusterFailoverVersions() {
+		if clusterName == currentCluster {
+			continue
+		}
+		adminClient := xdc.NewSourceAdminClient(consistencyCheckerCallerName, clusterName, clusterMetadata, rpcFactory)
+		if adminClient != nil {
+			checkers[clusterName] = xdc.NewMutableStateChecker(adminClient, historyClient)
+		}
+	}
+
+	return &consistencyChecker{
+		status:         common.DaemonStatusInitialized,
+		currentCluster: currentCluster,
+		config:         config,
+		metadataMgr:    metadataMgr,
+		frontendClient: frontendClient,
+		checkers:       checkers,
+		cursors:        make(map[string]*consistencyCheckerCursor),
+		logger:         logger.WithField(logging.TagWorkflowComponent, logging.TagValueConsistencyCheckerComponent),
+		metricsClient:  metricsClient,
+		shutdownCh:     make(chan struct{}),
+	}
+}
+
+// Start starts the background consistency check
+func (c *consistencyChecker) Start() {
+	if !atomic.CompareAndSwapInt32(&c.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	go c.checkLoop()
+	c.logger.Info("Consistency checker started.")
+}
+
+// Stop stops the background consistency check
+func (c *consistencyChecker) Stop() {
+	if !atomic.CompareAndSwapInt32(&c.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(c.shutdownCh)
+	c.logger.Info("Consistency checker stopped.")
+}
+
+func (c *consistencyChecker) checkLoop() {
+	timer := time.NewTimer(c.config.ConsistencyCheckerInterval())
+	defer timer.Stop()
+	for {
+		select {
+		case <-c.shutdownCh:
+			return
+		case <-timer.C:
+			if c.config.ConsistencyCheckerEnabled() && len(c.checkers) > 0 {
+				if err := c.checkDomains(); err != nil {
+					c.logger.Errorf("Error checking consistency of global domains: %v", err)
+				}
+			}
+			timer.Reset(c.config.ConsistencyCheckerInterval())
+		}
+	}
+}
+
+func (c *consistencyChecker) checkDomains() error {
+	checked := make(map[string]struct{})
+	request := &persistence.ListDomainsRequest{PageSize: consistencyCheckerListPageSize}
+	for {
+		response, err := c.metadataMgr.ListDomains(request)
+		if err != nil {
+			return err
+		}
+		for _, domain := range response.Domains {
+			if !domain.IsGlobalDomain || len(domain.ReplicationConfig.Clusters) < 2 {
+				continue
+			}
+			c.cursors[domain.Info.ID] = c.checkDomain(domain, c.cursors[domain.Info.ID])
+			checked[domain.Info.ID] = struct{}{}
+		}
+		if len(response.NextPageToken) == 0 {
+			// drop the cursors of the domains which are no longer checked
+			for domainID := range c.cursors {
+				if _, ok := checked[domainID]; !ok {
+					delete(c.cursors, domainID)
+				}
+			}
+			return nil
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+}
+
+// checkDomain checks a sample of the open workflows of the domain starting at the given cursor and returns the
+// cursor of the next sample, a nil cursor starts over from the most recently started workflows
+func (c *consistencyChecker) checkDomain(domain *persistence.GetDomainResponse,
+	cursor *consistencyCheckerCursor) *consistencyCheckerCursor {
+
+	logger := c.logger.WithField(logging.TagDomainID, domain.Info.ID)
+
+	// the page token is only valid for the same query, so the time filter is kept until the cursor wraps around
+	if cursor == nil {
+		cursor = &consistencyCheckerCursor{latestTime: time.Now().UnixNano()}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), consistencyCheckerRPCTimeout)
+	response, err := c.frontendClient.ListOpenWorkflowExecutions(ctx, &s.ListOpenWorkflowExecutionsRequest{
+		Domain:          common.StringPtr(domain.Info.Name),
+		MaximumPageSize: common.Int32Ptr(int32(c.config.ConsistencyCheckerSampleSize())),
+		NextPageToken:   cursor.nextPageToken,
+		StartTimeFilter: &s.StartTimeFilter{
+			EarliestTime: common.Int64Ptr(0),
+			LatestTime:   common.Int64Ptr(cursor.latestTime),
+		},
+	})
+	cancel()
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.ConsistencyCheckerScope, metrics.ConsistencyCheckerFailures)
+		logger.Warnf("Failed to sample open workflows of domain: %v", err)
+		// an invalid page token would fail every round, start over
+		return nil
+	}
+
+	for _, info := range response.Executions {
+		for _, clusterConfig := range domain.ReplicationConfig.Clusters {
+			checker, ok := c.checkers[clusterConfig.ClusterName]
+			if !ok {
+				continue
+			}
+			execution := &shared.WorkflowExecution{
+				WorkflowId: info.Execution.WorkflowId,
+				RunId:      info.Execution.RunId,
+			}
+			c.checkWorkflow(checker, clusterConfig.ClusterName, domain, execution, logger)
+		}
+	}
+
+	if len(response.NextPageToken) == 0 {
+		return nil
+	}
+	return &consistencyCheckerCursor{latestTime: cursor.latestTime, nextPageToken: response.NextPageToken}
+}
+
+func (c *consistencyChecker) checkWorkflow(checker xdc.MutableStateChecker, remoteCluster string,
+	domain *persistence.GetDomainResponse, execution *shared.WorkflowExecution, logger bark.Logger) {
+
+	logger = logger.WithFields(bark.Fields{
+		logging.TagRemoteCluster:       remoteCluster,
+		logging.TagWorkflowExecutionID: execution.GetWorkflowId(),
+		logging.TagWorkflowRunID:       execution.GetRunId(),
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), consistencyCheckerRPCTimeout)
+	result, err := checker.CheckWorkflow(ctx, domain.Info.ID, domain.Info.Name, execution)
+	cancel()
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.ConsistencyCheckerScope, metrics.ConsistencyCheckerFailures)
+		logger.Warnf("Failed to check consistency of workflow: %v", err)
+		return
+	}
+
+	// events of recently updated workflows may still be on their way to the remote cluster
+	if time.Since(result.LastUpdatedTime) < c.config.ConsistencyCheckerMinIdleTime() {
+		return
+	}
+
+	c.metricsClient.IncCounter(metrics.ConsistencyCheckerScope, metrics.ConsistencyCheckerWorkflowsChecked)
+	if len(result.Diffs) == 0 {
+		return
+	}
+
+	c.metricsClient.IncCounter(metrics.ConsistencyCheckerScope, metrics.ConsistencyCheckerMismatches)
+	for _, diff := range result.Diffs {
+		logger.Warnf("Mutable state mismatch on %v, current cluster: %v, remote cluster: %v.",
+			diff.GetField(), diff.GetCurrentCluster(), diff.GetRemoteCluster())
+	}
+}
@@ -0,0 +1,352 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/uber-common/bark"
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+)
+
+const (
+	domainDeletionDeleteExecutionsActivityName = "cadence-sys-domain-deletion-delete-executions-activity"
+	domainDeletionDeleteVisibilityActivityName = "cadence-sys-domain-deletion-delete-visibility-activity"
+	domainDeletionDeleteMetadataActivityName   = "cadence-sys-domain-deletion-delete-metadata-activity"
+
+	// the frontend hosts stop starting workflows in the domain once their domain cache picks up the DELETED status,
+	// the workflow waits for it before listing the executions to delete
+	domainDeletionStartDelay         = time.Minute
+	domainDeletionPageSize           = 100
+	domainDeletionActivityTimeout    = 10 * time.Minute
+	domainDeletionHeartbeatTimeout   = time.Minute
+	domainDeletionRetryBackoff       = time.Minute
+	domainDeletionMaxActivitiesInRun = 500
+	// the workflow gives up after about an hour if the domain still has open executions, the deletion
+	// can be restarted by deleting the domain again once they are closed
+	domainDeletionMaxOpenExecutionsAttempts = 60
+)
+
+type (
+	domainDeleterContextKey struct{}
+
+	// domainDeleter implements the activities of the domain deletion workflow, it is passed to the activities
+	// through the background activity context of the system worker
+	domainDeleter struct {
+		metadataMgr   persistence.MetadataManager
+		visibilityMgr persistence.VisibilityManager
+		taskMgr       persistence.TaskManager
+		historyClient history.Client
+		logger        bark.Logger
+		metricsClient metrics.Client
+	}
+
+	domainDeletionExecutionsRequest struct {
+		DomainID        string
+		DomainName      string
+		LatestStartTime int64
+		NextPageToken   []byte
+	}
+
+	domainDeletionExecutionsResult struct {
+		ExecutionsDeleted int64
+		TaskListsDeleted  int64
+		NextPageToken     []byte
+	}
+)
+
+// errDomainHasOpenExecutions is a custom error so that the workflow can tell it apart from the other activity failures
+var errDomainHasOpenExecutions = cadence.NewCustomError("domain has open workflow executions, they must be closed before it can be deleted")
+
+func init() {
+	workflow.RegisterWithOptions(domainDeletionWorkflow,
+		workflow.RegisterOptions{Name: common.DomainDeletionWorkflowTypeName})
+	activity.RegisterWithOptions(domainDeletionDeleteExecutionsActivity,
+		activity.RegisterOptions{Name: domainDeletionDeleteExecutionsActivityName})
+	activity.RegisterWithOptions(domainDeletionDeleteVisibilityActivity,
+		activity.RegisterOptions{Name: domainDeletionDeleteVisibilityActivityName})
+	activity.RegisterWithOptions(domainDeletionDeleteMetadataActivity,
+		activity.RegisterOptions{Name: domainDeletionDeleteMetadataActivityName})
+}
+
+// domainDeletionWorkflow removes the data of a deleted domain. The closed executions are deleted page by page,
+// with their history and task lists, then the visibility records and at last the domain metadata, which frees
+// the domain name. A failed step is retried until it succeeds, the error is reported by the progress query,
+// except for open executions in the domain, which fail the workflow once they are still open after a while.
+// Task lists only used by executions already removed by retention are not known, and are left behind.
+func domainDeletionWorkflow(ctx workflow.Context, params common.DomainDeletionParams) (*common.DomainDeletionProgress, error) {
+	progress := params.Progress
+	if progress == nil {
+		progress = &common.DomainDeletionProgress{Stage: common.DomainDeletionStageExecutions}
+	}
+	err := workflow.SetQueryHandler(ctx, common.DomainDeletionProgressQueryType,
+		func() (*common.DomainDeletionProgress, error) {
+			return progress, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	if params.LatestStartTime == 0 {
+		if err := workflow.Sleep(ctx, domainDeletionStartDelay); err != nil {
+			return nil, err
+		}
+		params.LatestStartTime = workflow.Now(ctx).UnixNano()
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		ScheduleToStartTimeout: domainDeletionActivityTimeout,
+		StartToCloseTimeout:    domainDeletionActivityTimeout,
+		HeartbeatTimeout:       domainDeletionHeartbeatTimeout,
+	})
+	activities := 0
+	openExecutionsAttempts := 0
+	execute := func(activityName string, result interface{}, args ...interface{}) error {
+		for {
+			activities++
+			err := workflow.ExecuteActivity(ctx, activityName, args...).Get(ctx, result)
+			if err == nil {
+				progress.LastError = ""
+				return nil
+			}
+			if ctx.Err() != nil {
+				return err
+			}
+			progress.LastError = err.Error()
+			if customErr, ok := err.(*cadence.CustomError); ok && customErr.Reason() == errDomainHasOpenExecutions.Reason() {
+				openExecutionsAttempts++
+				if openExecutionsAttempts >= domainDeletionMaxOpenExecutionsAttempts {
+					return err
+				}
+			}
+			if err := workflow.Sleep(ctx, domainDeletionRetryBackoff); err != nil {
+				return err
+			}
+		}
+	}
+
+	for progress.Stage == common.DomainDeletionStageExecutions {
+		if activities >= domainDeletionMaxActivitiesInRun {
+			// keep the history of the workflow bounded for domains with a lot of executions
+			params.Progress = progress
+			return nil, workflow.NewContinueAsNewError(ctx, common.DomainDeletionWorkflowTypeName, params)
+		}
+		var result domainDeletionExecutionsResult
+		err := execute(domainDeletionDeleteExecutionsActivityName, &result, &domainDeletionExecutionsRequest{
+			DomainID:        params.DomainID,
+			DomainName:      params.DomainName,
+			LatestStartTime: params.LatestStartTime,
+			NextPageToken:   params.NextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		progress.ExecutionsDeleted += result.ExecutionsDeleted
+		progress.TaskListsDeleted += result.TaskListsDeleted
+		params.NextPageToken = result.NextPageToken
+		if len(params.NextPageToken) == 0 {
+			progress.Stage = common.DomainDeletionStageVisibility
+		}
+	}
+
+	if progress.Stage == common.DomainDeletionStageVisibility {
+		if err := execute(domainDeletionDeleteVisibilityActivityName, nil, params.DomainID); err != nil {
+			return nil, err
+		}
+		progress.Stage = common.DomainDeletionStageMetadata
+	}
+
+	if progress.Stage == common.DomainDeletionStageMetadata {
+		if err := execute(domainDeletionDeleteMetadataActivityName, nil, params.DomainID); err != nil {
+			return nil, err
+		}
+		progress.Stage = common.DomainDeletionStageCompleted
+	}
+	return progress, nil
+}
+
+func domainDeletionDeleteExecutionsActivity(ctx context.Context,
+	request *domainDeletionExecutionsRequest) (*domainDeletionExecutionsResult, error) {
+	return getDomainDeleter(ctx).deleteExecutions(ctx, request, func(executionsDeleted int64) {
+		activity.RecordHeartbeat(ctx, executionsDeleted)
+	})
+}
+
+func domainDeletionDeleteVisibilityActivity(ctx context.Context, domainID string) error {
+	return getDomainDeleter(ctx).deleteVisibility(domainID)
+}
+
+func domainDeletionDeleteMetadataActivity(ctx context.Context, domainID string) error {
+	return getDomainDeleter(ctx).deleteMetadata(domainID)
+}
+
+func getDomainDeleter(ctx context.Context) *domainDeleter {
+	return ctx.Value(domainDeleterContextKey{}).(*domainDeleter)
+}
+
+func newDomainDeleter(metadataMgr persistence.MetadataManager, visibilityMgr persistence.VisibilityManager,
+	taskMgr persistence.TaskManager, historyClient history.Client, logger bark.Logger,
+	metricsClient metrics.Client) *domainDeleter {
+
+	return &domainDeleter{
+		metadataMgr:   metadataMgr,
+		visibilityMgr: visibilityMgr,
+		taskMgr:       taskMgr,
+		historyClient: historyClient,
+		logger:        logger.WithField(logging.TagWorkflowComponent, logging.TagValueDomainDeletionComponent),
+		metricsClient: metricsClient,
+	}
+}
+
+// withContext returns a context carrying the deleter to the activities of the domain deletion workflow
+func (d *domainDeleter) withContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, domainDeleterContextKey{}, d)
+}
+
+// deleteExecutions deletes one page of the closed executions of the domain, together with their history and
+// the task lists they used. The executions are listed in the visibility store, which is cleaned up last.
+func (d *domainDeleter) deleteExecutions(ctx context.Context, request *domainDeletionExecutionsRequest,
+	recordHeartbeat func(executionsDeleted int64)) (*domainDeletionExecutionsResult, error) {
+
+	logger := d.logger.WithField(logging.TagDomainID, request.DomainID)
+	if len(request.NextPageToken) == 0 {
+		openResponse, err := d.visibilityMgr.ListOpenWorkflowExecutions(&persistence.ListWorkflowExecutionsRequest{
+			DomainUUID:        request.DomainID,
+			Domain:            request.DomainName,
+			EarliestStartTime: 0,
+			LatestStartTime:   time.Now().UnixNano(),
+			PageSize:          1,
+		})
+		if err != nil {
+			return nil, d.failure(logger, "Failed to list open workflow executions", err)
+		}
+		if len(openResponse.Executions) > 0 {
+			return nil, errDomainHasOpenExecutions
+		}
+	}
+
+	closedResponse, err := d.visibilityMgr.ListClosedWorkflowExecutions(&persistence.ListWorkflowExecutionsRequest{
+		DomainUUID:        request.DomainID,
+		Domain:            request.DomainName,
+		EarliestStartTime: 0,
+		LatestStartTime:   request.LatestStartTime,
+		PageSize:          domainDeletionPageSize,
+		NextPageToken:     request.NextPageToken,
+	})
+	if err != nil {
+		return nil, d.failure(logger, "Failed to list closed workflow executions", err)
+	}
+
+	result := &domainDeletionExecutionsResult{NextPageToken: closedResponse.NextPageToken}
+	taskLists := make(map[string]struct{})
+	for _, execution := range closedResponse.Executions {
+		response, err := d.deleteExecution(ctx, request.DomainID, execution.Execution)
+		if err != nil {
+			return nil, d.failure(logger.WithFields(bark.Fields{
+				logging.TagWorkflowExecutionID: execution.Execution.GetWorkflowId(),
+				logging.TagWorkflowRunID:       execution.Execution.GetRunId(),
+			}), "Failed to delete workflow execution", err)
+		}
+		if response == nil {
+			continue
+		}
+		result.ExecutionsDeleted++
+		d.metricsClient.IncCounter(metrics.DomainDeletionScope, metrics.DomainDeletionExecutionsDeleted)
+		recordHeartbeat(result.ExecutionsDeleted)
+		for _, taskList := range []string{response.GetTaskList(), response.GetStickyTaskList()} {
+			if taskList != "" {
+				taskLists[taskList] = struct{}{}
+			}
+		}
+	}
+
+	// a task list shared by executions of different pages is deleted, and counted, for each of them
+	for taskList := range taskLists {
+		for _, taskType := range []int{persistence.TaskListTypeDecision, persistence.TaskListTypeActivity} {
+			err := d.taskMgr.DeleteTaskList(&persistence.DeleteTaskListRequest{
+				DomainID: request.DomainID,
+				TaskList: taskList,
+				TaskType: taskType,
+			})
+			if err != nil {
+				return nil, d.failure(logger.WithField(logging.TagTaskListName, taskList),
+					"Failed to delete task list", err)
+			}
+		}
+		result.TaskListsDeleted++
+		d.metricsClient.IncCounter(metrics.DomainDeletionScope, metrics.DomainDeletionTaskListsDeleted)
+	}
+	return result, nil
+}
+
+// deleteExecution deletes an execution through the history service, which owns its mutable state and caches it,
+// it returns the task lists the execution used, or nil if the execution does not exist anymore
+func (d *domainDeleter) deleteExecution(ctx context.Context, domainID string,
+	execution *shared.WorkflowExecution) (*h.DeleteWorkflowExecutionResponse, error) {
+
+	response, err := d.historyClient.DeleteWorkflowExecution(ctx, &h.DeleteWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  execution,
+	})
+	if err != nil {
+		if _, ok := err.(*shared.EntityNotExistsError); ok {
+			// removed by retention, or by a previous attempt
+			return nil, nil
+		}
+		return nil, err
+	}
+	return response, nil
+}
+
+func (d *domainDeleter) deleteVisibility(domainID string) error {
+	err := d.visibilityMgr.DeleteWorkflowExecutionsByDomain(&persistence.DeleteWorkflowExecutionsByDomainRequest{
+		DomainUUID: domainID,
+	})
+	if err != nil {
+		return d.failure(d.logger.WithField(logging.TagDomainID, domainID), "Failed to delete visibility records", err)
+	}
+	return nil
+}
+
+func (d *domainDeleter) deleteMetadata(domainID string) error {
+	err := d.metadataMgr.DeleteDomain(&persistence.DeleteDomainRequest{ID: domainID})
+	if err != nil {
+		return d.failure(d.logger.WithField(logging.TagDomainID, domainID), "Failed to delete domain metadata", err)
+	}
+	d.logger.WithField(logging.TagDomainID, domainID).Info("Deleted domain.")
+	return nil
+}
+
+func (d *domainDeleter) failure(logger bark.Logger, message string, err error) error {
+	d.metricsClient.IncCounter(metrics.DomainDeletionScope, metrics.DomainDeletionFailures)
+	logger.Warnf("%v: %v", message, err)
+	return err
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package worker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/testsuite"
+)
+
+type (
+	domainDeletionSuite struct {
+		suite.Suite
+		testsuite.WorkflowTestSuite
+		metadataMgr   *mocks.MetadataManager
+		visibilityMgr *mocks.VisibilityManager
+		taskMgr       *mocks.TaskManager
+		historyClient *mocks.HistoryClient
+		deleter       *domainDeleter
+	}
+)
+
+func TestDomainDeletionSuite(t *testing.T) {
+	s := new(domainDeletionSuite)
+	suite.Run(t, s)
+}
+
+func (s *domainDeletionSuite) SetupTest() {
+	s.metadataMgr = &mocks.MetadataManager{}
+	s.visibilityMgr = &mocks.VisibilityManager{}
+	s.taskMgr = &mocks.TaskManager{}
+	s.historyClient = &mocks.HistoryClient{}
+	s.deleter = newDomainDeleter(s.metadataMgr, s.visibilityMgr, s.taskMgr, s.historyClient,
+		bark.NewLoggerFromLogrus(logrus.New()), metrics.NewClient(tally.NoopScope, metrics.Worker))
+}
+
+func (s *domainDeletionSuite) TearDownTest() {
+	s.visibilityMgr.AssertExpectations(s.T())
+	s.taskMgr.AssertExpectations(s.T())
+	s.historyClient.AssertExpectations(s.T())
+}
+
+func (s *domainDeletionSuite) TestDeleteExecutions() {
+	deleted := &shared.WorkflowExecution{WorkflowId: common.StringPtr("wid1"), RunId: common.StringPtr("rid1")}
+	missing := &shared.WorkflowExecution{WorkflowId: common.StringPtr("wid2"), RunId: common.StringPtr("rid2")}
+	s.visibilityMgr.On("ListOpenWorkflowExecutions", mock.Anything).
+		Return(&persistence.ListWorkflowExecutionsResponse{}, nil).Once()
+	s.visibilityMgr.On("ListClosedWorkflowExecutions", mock.MatchedBy(func(request *persistence.ListWorkflowExecutionsRequest) bool {
+		return request.DomainUUID == "domain-id" && request.LatestStartTime == 100
+	})).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*shared.WorkflowExecutionInfo{
+			{Execution: deleted},
+			{Execution: missing},
+		},
+		NextPageToken: []byte("next"),
+	}, nil).Once()
+
+	s.historyClient.On("DeleteWorkflowExecution", mock.Anything, &h.DeleteWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr("domain-id"),
+		Execution:  deleted,
+	}).Return(&h.DeleteWorkflowExecutionResponse{
+		TaskList:       common.StringPtr("tl"),
+		StickyTaskList: common.StringPtr("sticky-tl"),
+	}, nil).Once()
+	s.historyClient.On("DeleteWorkflowExecution", mock.Anything, &h.DeleteWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr("domain-id"),
+		Execution:  missing,
+	}).Return(nil, &shared.EntityNotExistsError{}).Once()
+	for _, taskList := range []string{"tl", "sticky-tl"} {
+		for _, taskType := range []int{persistence.TaskListTypeDecision, persistence.TaskListTypeActivity} {
+			s.taskMgr.On("DeleteTaskList", &persistence.DeleteTaskListRequest{
+				DomainID: "domain-id",
+				TaskList: taskList,
+				TaskType: taskType,
+			}).Return(nil).Once()
+		}
+	}
+
+	var heartbeats []int64
+	result, err := s.deleter.deleteExecutions(context.Background(), &domainDeletionExecutionsRequest{
+		DomainID:        "domain-id",
+		DomainName:      "domain",
+		LatestStartTime: 100,
+	}, func(executionsDeleted int64) {
+		heartbeats = append(heartbeats, executionsDeleted)
+	})
+	s.Nil(err)
+	s.Equal(int64(1), result.ExecutionsDeleted)
+	s.Equal(int64(2), result.TaskListsDeleted)
+	s.Equal([]byte("next"), result.NextPageToken)
+	s.Equal([]int64{1}, heartbeats)
+}
+
+func (s *domainDeletionSuite) TestDeleteExecutions_OpenExecutions() {
+	s.visibilityMgr.On("ListOpenWorkflowExecutions", mock.Anything).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*shared.WorkflowExecutionInfo{{}},
+	}, nil).Once()
+
+	_, err := s.deleter.deleteExecutions(context.Background(), &domainDeletionExecutionsRequest{
+		DomainID:   "domain-id",
+		DomainName: "domain",
+	}, func(int64) {})
+	s.Equal(errDomainHasOpenExecutions, err)
+}
+
+func (s *domainDeletionSuite) TestWorkflow_OpenExecutions() {
+	env := s.NewTestWorkflowEnvironment()
+	env.OnActivity(domainDeletionDeleteExecutionsActivityName, mock.Anything, mock.Anything).
+		Return(nil, errDomainHasOpenExecutions).Times(domainDeletionMaxOpenExecutionsAttempts)
+
+	env.ExecuteWorkflow(domainDeletionWorkflow, common.DomainDeletionParams{DomainID: "domain-id", DomainName: "domain"})
+	s.True(env.IsWorkflowCompleted())
+	customErr, ok := env.GetWorkflowError().(*cadence.CustomError)
+	s.True(ok)
+	s.Equal(errDomainHasOpenExecutions.Reason(), customErr.Reason())
+	env.AssertExpectations(s.T())
+}
+
+func (s *domainDeletionSuite) TestDeleteExecutions_NextPage() {
+	s.visibilityMgr.On("ListClosedWorkflowExecutions", mock.MatchedBy(func(request *persistence.ListWorkflowExecutionsRequest) bool {
+		return string(request.NextPageToken) == "next"
+	})).Return(&persistence.ListWorkflowExecutionsResponse{}, nil).Once()
+
+	result, err := s.deleter.deleteExecutions(context.Background(), &domainDeletionExecutionsRequest{
+		DomainID:      "domain-id",
+		DomainName:    "domain",
+		NextPageToken: []byte("next"),
+	}, func(int64) {})
+	s.Nil(err)
+	s.Equal(int64(0), result.ExecutionsDeleted)
+	s.Empty(result.NextPageToken)
+}
@@ -154,6 +154,8 @@ func (domainReplicator *domainReplicatorImpl) handleDomainUpdateReplicationTask(
 		ConfigVersion:               resp.ConfigVersion,
 		FailoverVersion:             resp.FailoverVersion,
 		FailoverNotificationVersion: resp.FailoverNotificationVersion,
+		PendingActiveClusterName:    resp.PendingActiveClusterName,
+		FailoverEndTime:             resp.FailoverEndTime,
 		NotificationVersion:         notificationVersion,
 	}
 
@@ -179,6 +181,9 @@ func (domainReplicator *domainReplicatorImpl) handleDomainUpdateReplicationTask(
 		request.ReplicationConfig.ActiveClusterName = task.ReplicationConfig.GetActiveClusterName()
 		request.FailoverVersion = task.GetFailoverVersion()
 		request.FailoverNotificationVersion = notificationVersion
+		// the domain is failed over by another cluster, any local graceful failover is superseded
+		request.PendingActiveClusterName = ""
+		request.FailoverEndTime = 0
 	}
 
 	if !recordUpdated {
@@ -37,6 +37,8 @@ import (
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/xdc"
 	"go.uber.org/yarpc/yarpcerrors"
 )
 
@@ -63,6 +65,10 @@ type (
 		domainReplicator DomainReplicator
 		historyClient    history.Client
 		msgEncoder       codec.BinaryEncoder
+		dlqManager       persistence.ReplicationDLQManager
+		numberOfShards   int
+		historyResender  xdc.HistoryResender
+		lastDLQTaskID    int64
 	}
 )
 
@@ -74,6 +80,8 @@ const (
 	replicationTaskInitialRetryInterval = 100 * time.Millisecond
 	replicationTaskMaxRetryInterval     = 2 * time.Second
 	replicationTaskExpirationInterval   = 10 * time.Second
+
+	putToDLQMaxAttempts = 3
 )
 
 var (
@@ -89,7 +97,8 @@ var (
 
 func newReplicationTaskProcessor(currentCluster, sourceCluster, consumer string, client messaging.Client, config *Config,
 	logger bark.Logger, metricsClient metrics.Client, domainReplicator DomainReplicator,
-	historyClient history.Client) *replicationTaskProcessor {
+	historyClient history.Client, dlqManager persistence.ReplicationDLQManager, numberOfShards int,
+	historyResender xdc.HistoryResender) *replicationTaskProcessor {
 
 	retryableHistoryClient := history.NewRetryableClient(historyClient, common.CreateHistoryServiceRetryPolicy(),
 		common.IsWhitelistServiceTransientError)
@@ -106,10 +115,13 @@ func newReplicationTaskProcessor(currentCluster, sourceCluster, consumer string,
 			logging.TagSourceCluster:     sourceCluster,
 			logging.TagConsumerName:      consumer,
 		}),
-		metricsClient:    metricsClient,
+		metricsClient:    metricsClient.Tagged(map[string]string{metrics.SourceClusterTagName: sourceCluster}),
 		domainReplicator: domainReplicator,
 		historyClient:    retryableHistoryClient,
 		msgEncoder:       codec.NewThriftRWEncoder(),
+		dlqManager:       dlqManager,
+		numberOfShards:   numberOfShards,
+		historyResender:  historyResender,
 	}
 }
 
@@ -258,13 +270,83 @@ ProcessRetryLoop:
 		msg.Ack()
 	} else {
 		// Task still failed after all retries.  This is most probably due to a bug in replication code.
-		// Nack the task to move it to DLQ to not block replication for other workflow executions.
+		// Move the task to DLQ to not block replication for other workflow executions.
 		logger.WithFields(bark.Fields{
 			logging.TagErr:          err,
 			logging.TagAttemptCount: attempt,
 			logging.TagAttemptEnd:   time.Now(),
 		}).Error("Error processing replication task.")
-		msg.Nack()
+		if p.putToDLQ(msg, logger) {
+			msg.Ack()
+		} else {
+			msg.Nack()
+		}
+	}
+}
+
+// putToDLQ persists the failed history or activity replication task into the replication DLQ so it can be
+// inspected and re-applied later by operators.  Returns false if the task was not persisted, in which case the
+// message should be nack'ed.
+func (p *replicationTaskProcessor) putToDLQ(msg messaging.Message, logger bark.Logger) bool {
+	if p.dlqManager == nil {
+		return false
+	}
+
+	task, err := p.deserialize(msg.Value())
+	if err != nil {
+		return false
+	}
+
+	var workflowID string
+	switch task.GetTaskType() {
+	case replicator.ReplicationTaskTypeHistory:
+		workflowID = task.HistoryTaskAttributes.GetWorkflowId()
+	case replicator.ReplicationTaskTypeSyncActivity:
+		workflowID = task.SyncActicvityTaskAttributes.GetWorkflowId()
+	default:
+		return false
+	}
+
+	for attempt := 1; attempt <= putToDLQMaxAttempts; attempt++ {
+		err = p.dlqManager.PutReplicationTaskToDLQ(&persistence.PutReplicationTaskToDLQRequest{
+			TaskInfo: &persistence.ReplicationDLQTaskInfo{
+				SourceCluster: p.sourceCluster,
+				ShardID:       common.WorkflowIDToHistoryShard(workflowID, p.numberOfShards),
+				TaskID:        p.nextDLQTaskID(),
+				CreatedTime:   time.Now(),
+				Data:          msg.Value(),
+				Encoding:      common.EncodingTypeThriftRW,
+			},
+		})
+		if _, ok := err.(*persistence.ConditionFailedError); !ok {
+			break
+		}
+		// the task ID is taken by a task put by another host, retry with a new one
+	}
+	if err != nil {
+		p.metricsClient.IncCounter(metrics.ReplicatorScope, metrics.ReplicatorDLQFailures)
+		logger.WithFields(bark.Fields{
+			logging.TagErr: err,
+		}).Error("Failed to put replication task to DLQ.")
+		return false
+	}
+
+	p.metricsClient.IncCounter(metrics.ReplicatorScope, metrics.ReplicatorDLQMessages)
+	return true
+}
+
+// nextDLQTaskID returns increasing task IDs derived from the current time, the IDs are unique within the processor
+// and the DLQ persistence rejects the IDs already used by the processors of other hosts
+func (p *replicationTaskProcessor) nextDLQTaskID() int64 {
+	for {
+		lastTaskID := atomic.LoadInt64(&p.lastDLQTaskID)
+		taskID := time.Now().UnixNano()
+		if taskID <= lastTaskID {
+			taskID = lastTaskID + 1
+		}
+		if atomic.CompareAndSwapInt64(&p.lastDLQTaskID, lastTaskID, taskID) {
+			return taskID
+		}
 	}
 }
 
@@ -344,7 +426,9 @@ func (p *replicationTaskProcessor) handleSyncShardTask(task *replicator.Replicat
 	attr := task.SyncShardStatusTaskAttributes
 	logger.Debugf("Received sync shard task %v.", attr)
 
-	if time.Now().Sub(time.Unix(0, attr.GetTimestamp())) > dropSyncShardTaskTimeThreshold {
+	lag := time.Now().Sub(time.Unix(0, attr.GetTimestamp()))
+	p.metricsClient.RecordTimer(metrics.SyncShardTaskScope, metrics.ReplicatorTaskLag, lag)
+	if lag > dropSyncShardTaskTimeThreshold {
 		return nil
 	}
 
@@ -403,6 +487,12 @@ Loop:
 		return nil
 	}
 
+	if attr.History != nil && len(attr.History.Events) > 0 {
+		lastEvent := attr.History.Events[len(attr.History.Events)-1]
+		p.metricsClient.RecordTimer(metrics.HistoryReplicationTaskScope, metrics.ReplicatorTaskLag,
+			time.Now().Sub(time.Unix(0, lastEvent.GetTimestamp())))
+	}
+
 	var err error
 	req := &h.ReplicateEventsRequest{
 		SourceCluster: common.StringPtr(p.sourceCluster),
@@ -422,12 +512,34 @@ Loop:
 		NewRunEventStoreVersion: attr.NewRunEventStoreVersion,
 	}
 
+	resentRuns := make(map[string]bool)
 RetryLoop:
 	for i := 0; i < p.config.ReplicatorBufferRetryCount; i++ {
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		err = p.historyClient.ReplicateEvents(ctx, req)
 		cancel()
 
+		// The events before this task are missing on the current cluster, ask the source cluster to resend them
+		// once per run instead of waiting for the missing replication tasks to show up, when the run of this task
+		// is missing the rest of the previous run is resent, which carries the start of the new run
+		if p.shouldResendHistory(attr, err) && !resentRuns[err.(*shared.RetryTaskError).GetRunId()] {
+			retryErr := err.(*shared.RetryTaskError)
+			resentRuns[retryErr.GetRunId()] = true
+			nextEventID := int64(0)
+			if retryErr.GetRunId() == attr.GetRunId() {
+				nextEventID = attr.GetFirstEventId()
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			resendErr := p.historyResender.SendMissingHistory(ctx, attr.GetDomainId(), attr.GetWorkflowId(), retryErr.GetRunId(),
+				retryErr.GetNextEventId(), nextEventID)
+			cancel()
+			if resendErr == nil {
+				p.metricsClient.IncCounter(metrics.HistoryReplicationTaskScope, metrics.ReplicatorHistoryResendCounter)
+				continue RetryLoop
+			}
+			logger.WithField(logging.TagErr, resendErr).Warn("Failed to resend missing history.")
+		}
+
 		// Replication tasks could be slightly out of order for a particular workflow execution
 		// We first try to apply the events without buffering enabled with a small delay to account for such delays
 		// Caller should try to apply the event with buffering enabled once we return RetryTaskError after all retries
@@ -467,6 +579,23 @@ func (p *replicationTaskProcessor) updateFailureMetric(scope int, err error) {
 	}
 }
 
+// shouldResendHistory returns true if the error of applying the task tells which events are missing on the
+// current cluster and these can be resent by the source cluster, either events of the same workflow run before
+// the task or, when the run of the task is missing, the events of the current run it is continued from
+func (p *replicationTaskProcessor) shouldResendHistory(attr *replicator.HistoryTaskAttributes, err error) bool {
+	if p.historyResender == nil {
+		return false
+	}
+	retryErr, ok := err.(*shared.RetryTaskError)
+	if !ok || retryErr.NextEventId == nil || retryErr.RunId == nil {
+		return false
+	}
+	if retryErr.GetRunId() != attr.GetRunId() {
+		return true
+	}
+	return retryErr.GetNextEventId() < attr.GetFirstEventId()
+}
+
 func (p *replicationTaskProcessor) isRetryTaskError(err error) bool {
 	if _, ok := err.(*shared.RetryTaskError); ok {
 		return true
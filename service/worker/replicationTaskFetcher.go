@@ -0,0 +1,383 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	// rpcReplicationClient is the messaging client used when the replication tasks of the source cluster
+	// are pulled over rpc instead of being consumed from kafka
+	rpcReplicationClient struct {
+		adminClient    adminserviceclient.Interface
+		numberOfShards int
+		config         *Config
+		logger         bark.Logger
+		metricsClient  metrics.Client
+	}
+
+	// replicationTaskFetcher polls the replication tasks of all the shards from the source cluster
+	// and exposes them as messages, so they are applied by the same processor used for kafka
+	replicationTaskFetcher struct {
+		currentCluster string
+		sourceCluster  string
+		numberOfShards int
+		adminClient    adminserviceclient.Interface
+		msgEncoder     codec.BinaryEncoder
+		config         *Config
+		logger         bark.Logger
+		metricsClient  metrics.Client
+		msgChan        chan messaging.Message
+		isStarted      int32
+		isStopped      int32
+		shutdownWG     sync.WaitGroup
+		shutdownCh     chan struct{}
+
+		sync.Mutex
+		shards map[int32]*shardReplicationState
+	}
+
+	// shardReplicationState tracks the replication tasks fetched for a shard, tasks of a shard are fetched
+	// one batch at a time and the batch is acked to the source cluster once all its tasks are processed,
+	// a batch with failed tasks is fetched again from the last acked task, up to a max number of attempts
+	shardReplicationState struct {
+		lastRetrievedMessageID int64
+		lastProcessedMessageID int64
+		pendingTasks           int
+		failed                 bool
+		failedAttempts         int
+	}
+
+	replicationTaskMessage struct {
+		fetcher   *replicationTaskFetcher
+		shardID   int32
+		messageID int64
+		value     []byte
+	}
+)
+
+const (
+	emptyMessageID = -1
+
+	fetchReplicationTasksTimeout = 30 * time.Second
+)
+
+var (
+	errProducerNotSupported = errors.New("publishing replication tasks is not supported when pulling them over rpc")
+)
+
+var _ messaging.Client = (*rpcReplicationClient)(nil)
+var _ messaging.Consumer = (*replicationTaskFetcher)(nil)
+var _ messaging.Message = (*replicationTaskMessage)(nil)
+
+func newRPCReplicationClient(adminClient adminserviceclient.Interface, numberOfShards int, config *Config,
+	logger bark.Logger, metricsClient metrics.Client) messaging.Client {
+	return &rpcReplicationClient{
+		adminClient:    adminClient,
+		numberOfShards: numberOfShards,
+		config:         config,
+		logger:         logger,
+		metricsClient:  metricsClient,
+	}
+}
+
+// NewConsumer creates a consumer pulling the replication tasks of the source cluster
+func (c *rpcReplicationClient) NewConsumer(currentCluster, sourceCluster, consumerName string,
+	concurrency int) (messaging.Consumer, error) {
+	return newReplicationTaskFetcher(currentCluster, sourceCluster, c.numberOfShards, c.adminClient, c.config,
+		c.logger, c.metricsClient), nil
+}
+
+// NewProducer is not supported, replication tasks are pulled by the remote clusters
+func (c *rpcReplicationClient) NewProducer(sourceCluster string) (messaging.Producer, error) {
+	return nil, errProducerNotSupported
+}
+
+func newReplicationTaskFetcher(currentCluster, sourceCluster string, numberOfShards int,
+	adminClient adminserviceclient.Interface, config *Config, logger bark.Logger,
+	metricsClient metrics.Client) *replicationTaskFetcher {
+
+	shards := make(map[int32]*shardReplicationState)
+	for shardID := 0; shardID < numberOfShards; shardID++ {
+		shards[int32(shardID)] = &shardReplicationState{
+			lastRetrievedMessageID: emptyMessageID,
+			lastProcessedMessageID: emptyMessageID,
+		}
+	}
+
+	return &replicationTaskFetcher{
+		currentCluster: currentCluster,
+		sourceCluster:  sourceCluster,
+		numberOfShards: numberOfShards,
+		adminClient:    adminClient,
+		msgEncoder:     codec.NewThriftRWEncoder(),
+		config:         config,
+		logger: logger.WithFields(bark.Fields{
+			logging.TagSourceCluster: sourceCluster,
+		}),
+		metricsClient: metricsClient,
+		msgChan:       make(chan messaging.Message, config.ReplicatorConcurrency()),
+		shutdownCh:    make(chan struct{}),
+		shards:        shards,
+	}
+}
+
+// Start starts polling the replication tasks from the source cluster
+func (f *replicationTaskFetcher) Start() error {
+	if !atomic.CompareAndSwapInt32(&f.isStarted, 0, 1) {
+		return nil
+	}
+
+	f.shutdownWG.Add(1)
+	go f.fetchLoop()
+
+	f.logger.Info("Replication task fetcher started.")
+	return nil
+}
+
+// Stop stops polling and closes the message channel
+func (f *replicationTaskFetcher) Stop() {
+	if !atomic.CompareAndSwapInt32(&f.isStopped, 0, 1) {
+		return
+	}
+
+	if atomic.LoadInt32(&f.isStarted) == 1 {
+		close(f.shutdownCh)
+		if success := common.AwaitWaitGroup(&f.shutdownWG, time.Minute); !success {
+			f.logger.Warn("Replication task fetcher timed out on shutdown.")
+		}
+	}
+	close(f.msgChan)
+	f.logger.Info("Replication task fetcher stopped.")
+}
+
+// Messages returns the channel of the replication tasks pulled from the source cluster
+func (f *replicationTaskFetcher) Messages() <-chan messaging.Message {
+	return f.msgChan
+}
+
+func (f *replicationTaskFetcher) fetchLoop() {
+	defer f.shutdownWG.Done()
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-f.shutdownCh:
+			return
+		case <-timer.C:
+			hasMore, err := f.fetchTasks()
+			if err != nil {
+				f.logger.WithFields(bark.Fields{
+					logging.TagErr: err,
+				}).Warn("Failed to fetch replication tasks.")
+			}
+
+			pollInterval := f.config.ReplicationTaskFetcherPollInterval()
+			if hasMore && err == nil {
+				pollInterval = 0
+			}
+			timer.Reset(pollInterval)
+		}
+	}
+}
+
+// fetchTasks pulls the next batch of replication tasks for all the shards without pending tasks,
+// it returns true if the source cluster has more tasks available for any of the shards
+func (f *replicationTaskFetcher) fetchTasks() (bool, error) {
+	tokens := f.getTokens()
+	if len(tokens) == 0 {
+		return false, nil
+	}
+
+	scope := metrics.ReplicationTaskFetcherScope
+	f.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := f.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	ctx, cancel := context.WithTimeout(context.Background(), fetchReplicationTasksTimeout)
+	response, err := f.adminClient.GetReplicationMessages(ctx, &replicator.GetReplicationMessagesRequest{
+		Tokens:      tokens,
+		ClusterName: common.StringPtr(f.currentCluster),
+	})
+	cancel()
+	sw.Stop()
+	if err != nil {
+		f.metricsClient.IncCounter(scope, metrics.CadenceFailures)
+		return false, err
+	}
+
+	hasMore := false
+	for shardID, messages := range response.GetMessagesByShard() {
+		msgs, err := f.toMessages(shardID, messages)
+		if err != nil {
+			return false, err
+		}
+
+		f.Lock()
+		state, ok := f.shards[shardID]
+		if !ok || state.pendingTasks > 0 {
+			f.Unlock()
+			continue
+		}
+		state.lastRetrievedMessageID = messages.GetLastRetrievedMessageId()
+		state.pendingTasks = len(msgs)
+		if state.pendingTasks == 0 {
+			state.lastProcessedMessageID = state.lastRetrievedMessageID
+		}
+		f.Unlock()
+
+		for _, msg := range msgs {
+			select {
+			case f.msgChan <- msg:
+			case <-f.shutdownCh:
+				return false, nil
+			}
+		}
+		hasMore = hasMore || messages.GetHasMore()
+	}
+
+	return hasMore, nil
+}
+
+func (f *replicationTaskFetcher) getTokens() []*replicator.ReplicationToken {
+	f.Lock()
+	defer f.Unlock()
+
+	var tokens []*replicator.ReplicationToken
+	for shardID, state := range f.shards {
+		if state.pendingTasks > 0 {
+			continue
+		}
+		tokens = append(tokens, &replicator.ReplicationToken{
+			ShardID:                common.Int32Ptr(shardID),
+			LastRetrievedMessageId: common.Int64Ptr(state.lastRetrievedMessageID),
+			LastProcessedMessageId: common.Int64Ptr(state.lastProcessedMessageID),
+		})
+	}
+	return tokens
+}
+
+func (f *replicationTaskFetcher) toMessages(shardID int32,
+	messages *replicator.ReplicationMessages) ([]messaging.Message, error) {
+
+	tasks := messages.ReplicationTasks
+	// the shard status is only synced once the shard has caught up with the source cluster, otherwise
+	// the remote time could be moved forward before the replication tasks are applied
+	if len(tasks) == 0 && !messages.GetHasMore() && messages.SyncShardStatus != nil {
+		tasks = append(tasks, &replicator.ReplicationTask{
+			TaskType: replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeSyncShardStatus),
+			SyncShardStatusTaskAttributes: &replicator.SyncShardStatusTaskAttributes{
+				SourceCluster: common.StringPtr(f.sourceCluster),
+				ShardId:       common.Int64Ptr(int64(shardID)),
+				Timestamp:     messages.SyncShardStatus.Timestamp,
+			},
+		})
+	}
+
+	var msgs []messaging.Message
+	for _, task := range tasks {
+		value, err := f.msgEncoder.Encode(task)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, &replicationTaskMessage{
+			fetcher:   f,
+			shardID:   shardID,
+			messageID: messages.GetLastRetrievedMessageId(),
+			value:     value,
+		})
+	}
+	return msgs, nil
+}
+
+func (f *replicationTaskFetcher) completeTask(shardID int32, failed bool) {
+	f.Lock()
+	defer f.Unlock()
+
+	state := f.shards[shardID]
+	state.pendingTasks--
+	state.failed = state.failed || failed
+	if state.pendingTasks > 0 {
+		return
+	}
+	if state.failed {
+		state.failed = false
+		state.failedAttempts++
+		if state.failedAttempts < f.config.ReplicationTaskFetcherMaxBatchAttempts() {
+			// refetch the whole batch on the next poll, applying the replication tasks is idempotent
+			// so the tasks of the batch already processed are dropped as duplicates
+			state.lastRetrievedMessageID = state.lastProcessedMessageID
+			return
+		}
+		// the failed tasks could neither be applied nor put to the DLQ, skip the batch
+		// instead of stalling the replication of the shard
+		f.metricsClient.IncCounter(metrics.ReplicationTaskFetcherScope, metrics.ReplicatorSkippedBatches)
+		f.logger.WithFields(bark.Fields{
+			logging.TagHistoryShardID: shardID,
+			logging.TagAttemptCount:   state.failedAttempts,
+		}).Errorf("Skipping replication tasks with message IDs in (%v, %v] after repeated failures.",
+			state.lastProcessedMessageID, state.lastRetrievedMessageID)
+	}
+	// all the tasks of the batch are done, ack the batch on the next poll
+	state.lastProcessedMessageID = state.lastRetrievedMessageID
+	state.failedAttempts = 0
+}
+
+// Value returns the encoded replication task
+func (m *replicationTaskMessage) Value() []byte {
+	return m.value
+}
+
+// Partition returns the shard of the replication task
+func (m *replicationTaskMessage) Partition() int32 {
+	return m.shardID
+}
+
+// Offset returns the last message ID of the batch the replication task belongs to
+func (m *replicationTaskMessage) Offset() int64 {
+	return m.messageID
+}
+
+// Ack marks the replication task as processed
+func (m *replicationTaskMessage) Ack() error {
+	m.fetcher.completeTask(m.shardID, false)
+	return nil
+}
+
+// Nack marks the replication task as failed, the batch of the task is fetched again once all its tasks are done
+func (m *replicationTaskMessage) Nack() error {
+	m.fetcher.completeTask(m.shardID, true)
+	return nil
+}
@@ -0,0 +1,204 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/admin/adminservicetest"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	replicationTaskFetcherSuite struct {
+		suite.Suite
+		mockCtrl    *gomock.Controller
+		adminClient *adminservicetest.MockClient
+		fetcher     *replicationTaskFetcher
+	}
+)
+
+func TestReplicationTaskFetcherSuite(t *testing.T) {
+	s := new(replicationTaskFetcherSuite)
+	suite.Run(t, s)
+}
+
+func (s *replicationTaskFetcherSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+	s.adminClient = adminservicetest.NewMockClient(s.mockCtrl)
+	config := NewConfig(dynamicconfig.NewNopCollection())
+	s.fetcher = newReplicationTaskFetcher("active", "standby", 2, s.adminClient, config,
+		bark.NewLoggerFromLogrus(logrus.New()), metrics.NewClient(tally.NoopScope, metrics.Worker))
+}
+
+func (s *replicationTaskFetcherSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *replicationTaskFetcherSuite) TestFetchTasks() {
+	task := &replicator.ReplicationTask{
+		TaskType: replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeHistory),
+		HistoryTaskAttributes: &replicator.HistoryTaskAttributes{
+			DomainId:   common.StringPtr("some random domain ID"),
+			WorkflowId: common.StringPtr("some random workflow ID"),
+		},
+	}
+	s.adminClient.EXPECT().GetReplicationMessages(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ interface{}, request *replicator.GetReplicationMessagesRequest, _ ...interface{}) (*replicator.GetReplicationMessagesResponse, error) {
+			s.Equal("active", request.GetClusterName())
+			s.Len(request.Tokens, 2)
+			for _, token := range request.Tokens {
+				s.Equal(int64(emptyMessageID), token.GetLastRetrievedMessageId())
+				s.Equal(int64(emptyMessageID), token.GetLastProcessedMessageId())
+			}
+			return &replicator.GetReplicationMessagesResponse{
+				MessagesByShard: map[int32]*replicator.ReplicationMessages{
+					0: {
+						ReplicationTasks:       []*replicator.ReplicationTask{task},
+						LastRetrievedMessageId: common.Int64Ptr(10),
+						HasMore:                common.BoolPtr(true),
+					},
+					1: {
+						LastRetrievedMessageId: common.Int64Ptr(20),
+						HasMore:                common.BoolPtr(false),
+						SyncShardStatus:        &replicator.SyncShardStatus{Timestamp: common.Int64Ptr(100)},
+					},
+				},
+			}, nil
+		})
+
+	hasMore, err := s.fetcher.fetchTasks()
+	s.Nil(err)
+	s.True(hasMore)
+
+	decoder := codec.NewThriftRWEncoder()
+	received := make(map[int32]*replicator.ReplicationTask)
+	for i := 0; i < 2; i++ {
+		msg := <-s.fetcher.Messages()
+		decoded := &replicator.ReplicationTask{}
+		s.Nil(decoder.Decode(msg.Value(), decoded))
+		received[msg.Partition()] = decoded
+	}
+	s.Equal(task, received[0])
+	s.Equal(replicator.ReplicationTaskTypeSyncShardStatus, received[1].GetTaskType())
+	s.Equal("standby", received[1].SyncShardStatusTaskAttributes.GetSourceCluster())
+	s.Equal(int64(1), received[1].SyncShardStatusTaskAttributes.GetShardId())
+	s.Equal(int64(100), received[1].SyncShardStatusTaskAttributes.GetTimestamp())
+
+	// shards with pending tasks are not polled until all their tasks are processed
+	s.Equal(1, s.fetcher.shards[0].pendingTasks)
+	s.Equal(int64(emptyMessageID), s.fetcher.shards[0].lastProcessedMessageID)
+	s.Len(s.fetcher.getTokens(), 0)
+
+	s.fetcher.completeTask(0, false)
+	s.fetcher.completeTask(1, false)
+	tokens := s.fetcher.getTokens()
+	s.Len(tokens, 2)
+	for _, token := range tokens {
+		s.Equal(token.GetLastRetrievedMessageId(), token.GetLastProcessedMessageId())
+	}
+}
+
+func (s *replicationTaskFetcherSuite) TestFetchTasks_SyncShardStatusSkippedWhenBehind() {
+	s.adminClient.EXPECT().GetReplicationMessages(gomock.Any(), gomock.Any()).Return(
+		&replicator.GetReplicationMessagesResponse{
+			MessagesByShard: map[int32]*replicator.ReplicationMessages{
+				0: {
+					LastRetrievedMessageId: common.Int64Ptr(10),
+					HasMore:                common.BoolPtr(true),
+					SyncShardStatus:        &replicator.SyncShardStatus{Timestamp: common.Int64Ptr(100)},
+				},
+			},
+		}, nil)
+
+	hasMore, err := s.fetcher.fetchTasks()
+	s.Nil(err)
+	s.True(hasMore)
+	s.Equal(0, s.fetcher.shards[0].pendingTasks)
+	s.Equal(int64(10), s.fetcher.shards[0].lastProcessedMessageID)
+}
+
+func (s *replicationTaskFetcherSuite) TestFetchTasks_NackedBatchRefetched() {
+	task := &replicator.ReplicationTask{
+		TaskType: replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeHistory),
+	}
+	s.adminClient.EXPECT().GetReplicationMessages(gomock.Any(), gomock.Any()).Return(
+		&replicator.GetReplicationMessagesResponse{
+			MessagesByShard: map[int32]*replicator.ReplicationMessages{
+				0: {
+					ReplicationTasks:       []*replicator.ReplicationTask{task, task},
+					LastRetrievedMessageId: common.Int64Ptr(10),
+					HasMore:                common.BoolPtr(false),
+				},
+			},
+		}, nil)
+
+	_, err := s.fetcher.fetchTasks()
+	s.Nil(err)
+	s.Nil((<-s.fetcher.Messages()).Nack())
+	s.Nil((<-s.fetcher.Messages()).Ack())
+
+	// the failed batch is fetched again from the last processed task
+	s.Equal(0, s.fetcher.shards[0].pendingTasks)
+	s.Equal(int64(emptyMessageID), s.fetcher.shards[0].lastRetrievedMessageID)
+	s.Equal(int64(emptyMessageID), s.fetcher.shards[0].lastProcessedMessageID)
+	s.False(s.fetcher.shards[0].failed)
+}
+
+func (s *replicationTaskFetcherSuite) TestFetchTasks_NackedBatchSkippedAfterMaxAttempts() {
+	s.fetcher.config.ReplicationTaskFetcherMaxBatchAttempts = dynamicconfig.GetIntPropertyFn(2)
+	task := &replicator.ReplicationTask{
+		TaskType: replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeHistory),
+	}
+	s.adminClient.EXPECT().GetReplicationMessages(gomock.Any(), gomock.Any()).Return(
+		&replicator.GetReplicationMessagesResponse{
+			MessagesByShard: map[int32]*replicator.ReplicationMessages{
+				0: {
+					ReplicationTasks:       []*replicator.ReplicationTask{task},
+					LastRetrievedMessageId: common.Int64Ptr(10),
+					HasMore:                common.BoolPtr(false),
+				},
+			},
+		}, nil).Times(2)
+
+	_, err := s.fetcher.fetchTasks()
+	s.Nil(err)
+	s.Nil((<-s.fetcher.Messages()).Nack())
+	s.Equal(int64(emptyMessageID), s.fetcher.shards[0].lastRetrievedMessageID)
+	s.Equal(1, s.fetcher.shards[0].failedAttempts)
+
+	// the poison task fails again, the batch is skipped instead of being fetched forever
+	_, err = s.fetcher.fetchTasks()
+	s.Nil(err)
+	s.Nil((<-s.fetcher.Messages()).Nack())
+	s.Equal(int64(10), s.fetcher.shards[0].lastRetrievedMessageID)
+	s.Equal(int64(10), s.fetcher.shards[0].lastProcessedMessageID)
+	s.Equal(0, s.fetcher.shards[0].failedAttempts)
+}
@@ -24,12 +24,20 @@ import (
 	"fmt"
 
 	"github.com/uber-common/bark"
+	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
 	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/xdc"
+)
+
+const (
+	replicatorCallerName = "cadence-replicator"
 )
 
 type (
@@ -40,6 +48,9 @@ type (
 		historyClient    history.Client
 		config           *Config
 		client           messaging.Client
+		rpcFactory       common.RPCFactory
+		numberOfShards   int
+		dlqManager       persistence.ReplicationDLQManager
 		processors       []*replicationTaskProcessor
 		logger           bark.Logger
 		metricsClient    metrics.Client
@@ -48,7 +59,8 @@ type (
 
 // NewReplicator creates a new replicator for processing replication tasks
 func NewReplicator(clusterMetadata cluster.Metadata, metadataManagerV2 persistence.MetadataManager,
-	historyClient history.Client, config *Config, client messaging.Client, logger bark.Logger,
+	historyClient history.Client, config *Config, client messaging.Client, rpcFactory common.RPCFactory,
+	dlqManager persistence.ReplicationDLQManager, numberOfShards int, logger bark.Logger,
 	metricsClient metrics.Client) *Replicator {
 	logger = logger.WithFields(bark.Fields{
 		logging.TagWorkflowComponent: logging.TagValueReplicatorComponent,
@@ -59,6 +71,9 @@ func NewReplicator(clusterMetadata cluster.Metadata, metadataManagerV2 persisten
 		historyClient:    historyClient,
 		config:           config,
 		client:           client,
+		rpcFactory:       rpcFactory,
+		numberOfShards:   numberOfShards,
+		dlqManager:       dlqManager,
 		logger:           logger,
 		metricsClient:    metricsClient,
 	}
@@ -70,8 +85,9 @@ func (r *Replicator) Start() error {
 	for cluster := range r.clusterMetadata.GetAllClusterFailoverVersions() {
 		if cluster != currentClusterName {
 			consumerName := getConsumerName(currentClusterName, cluster)
-			r.processors = append(r.processors, newReplicationTaskProcessor(currentClusterName, cluster, consumerName, r.client,
-				r.config, r.logger, r.metricsClient, r.domainReplicator, r.historyClient))
+			r.processors = append(r.processors, newReplicationTaskProcessor(currentClusterName, cluster, consumerName,
+				r.getClient(cluster), r.config, r.logger, r.metricsClient, r.domainReplicator, r.historyClient,
+				r.dlqManager, r.numberOfShards, r.getHistoryResender(cluster)))
 		}
 	}
 
@@ -91,6 +107,29 @@ func (r *Replicator) Stop() {
 	}
 }
 
+// getClient returns the messaging client used to receive the replication tasks of the source cluster
+func (r *Replicator) getClient(sourceCluster string) messaging.Client {
+	consumerConfig := r.clusterMetadata.GetReplicationConsumerConfig(sourceCluster)
+	if consumerConfig.Type != config.ReplicationConsumerTypeRPC {
+		return r.client
+	}
+
+	dispatcher := r.rpcFactory.CreateDispatcherForOutbound(replicatorCallerName, consumerConfig.RPCName,
+		consumerConfig.RPCAddress)
+	adminClient := adminserviceclient.New(dispatcher.ClientConfig(consumerConfig.RPCName))
+	return newRPCReplicationClient(adminClient, r.numberOfShards, r.config, r.logger, r.metricsClient)
+}
+
+// getHistoryResender returns the resender used to fetch missing history events from the source cluster,
+// nil is returned if the rpc address of the source cluster is not configured
+func (r *Replicator) getHistoryResender(sourceCluster string) xdc.HistoryResender {
+	adminClient := xdc.NewSourceAdminClient(replicatorCallerName, sourceCluster, r.clusterMetadata, r.rpcFactory)
+	if adminClient == nil {
+		return nil
+	}
+	return xdc.NewHistoryResender(sourceCluster, adminClient, r.historyClient, r.logger)
+}
+
 func getConsumerName(currentCluster, remoteCluster string) string {
 	return fmt.Sprintf("%v_consumer_for_%v", currentCluster, remoteCluster)
 }
@@ -21,8 +21,12 @@
 package worker
 
 import (
+	"context"
+	"time"
+
 	"github.com/uber-common/bark"
 	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/metrics"
 	persistencefactory "github.com/uber/cadence/common/persistence/persistence-factory"
@@ -31,18 +35,10 @@ import (
 	"go.uber.org/cadence/worker"
 )
 
-const (
-	// SystemWorkflowDomain is the domain for cadence system workflows
-	SystemWorkflowDomain = "cadence-system"
-
-	// SystemTaskList is the task list worker polls on
-	SystemTaskList = "system-task-list"
-)
-
 type (
 	// Service represents the cadence-worker service.  This service host all background processing which needs to happen
 	// for a Cadence cluster.  This service runs the replicator which is responsible for applying replication tasks
-	// generated by remote clusters.
+	// generated by remote clusters, and the system workflows such as the domain deletion.
 	Service struct {
 		stopC         chan struct{}
 		params        *service.BootstrapParams
@@ -54,9 +50,20 @@ type (
 	Config struct {
 		// Replicator settings
 		PersistenceMaxQPS          dynamicconfig.IntPropertyFn
+		PersistenceDomainMaxQPS    dynamicconfig.IntPropertyFnWithDomainIDFilter
 		ReplicatorConcurrency      dynamicconfig.IntPropertyFn
 		ReplicatorBufferRetryCount int
 		ReplicationTaskMaxRetry    dynamicconfig.IntPropertyFn
+		// ReplicationTaskFetcherPollInterval is only used when replication tasks are pulled over rpc
+		ReplicationTaskFetcherPollInterval dynamicconfig.DurationPropertyFn
+		// ReplicationTaskFetcherMaxBatchAttempts is only used when replication tasks are pulled over rpc
+		ReplicationTaskFetcherMaxBatchAttempts dynamicconfig.IntPropertyFn
+
+		// Consistency checker settings
+		ConsistencyCheckerEnabled     dynamicconfig.BoolPropertyFn
+		ConsistencyCheckerInterval    dynamicconfig.DurationPropertyFn
+		ConsistencyCheckerSampleSize  dynamicconfig.IntPropertyFn
+		ConsistencyCheckerMinIdleTime dynamicconfig.DurationPropertyFn
 	}
 )
 
@@ -74,9 +81,17 @@ func NewService(params *service.BootstrapParams) common.Daemon {
 func NewConfig(dc *dynamicconfig.Collection) *Config {
 	return &Config{
 		PersistenceMaxQPS:          dc.GetIntProperty(dynamicconfig.WorkerPersistenceMaxQPS, 500),
+		PersistenceDomainMaxQPS:    dc.GetIntPropertyFilteredByDomainID(dynamicconfig.WorkerPersistenceDomainMaxQPS, 0),
 		ReplicatorConcurrency:      dc.GetIntProperty(dynamicconfig.WorkerReplicatorConcurrency, 1000),
 		ReplicatorBufferRetryCount: 8,
 		ReplicationTaskMaxRetry:    dc.GetIntProperty(dynamicconfig.WorkerReplicationTaskMaxRetry, 50),
+		ReplicationTaskFetcherPollInterval: dc.GetDurationProperty(dynamicconfig.WorkerReplicationTaskFetcherPollInterval,
+			time.Second),
+		ReplicationTaskFetcherMaxBatchAttempts: dc.GetIntProperty(dynamicconfig.WorkerReplicationTaskFetcherMaxBatchAttempts, 5),
+		ConsistencyCheckerEnabled:              dc.GetBoolProperty(dynamicconfig.WorkerConsistencyCheckerEnabled, false),
+		ConsistencyCheckerInterval:             dc.GetDurationProperty(dynamicconfig.WorkerConsistencyCheckerInterval, 10*time.Minute),
+		ConsistencyCheckerSampleSize:           dc.GetIntProperty(dynamicconfig.WorkerConsistencyCheckerSampleSize, 10),
+		ConsistencyCheckerMinIdleTime:          dc.GetDurationProperty(dynamicconfig.WorkerConsistencyCheckerMinIdleTime, time.Minute),
 	}
 }
 
@@ -91,12 +106,21 @@ func (s *Service) Start() {
 
 	s.metricsClient = base.GetMetricsClient()
 
+	pConfig := params.PersistenceConfig
+	pConfig.SetMaxQPS(pConfig.DefaultStore, s.config.PersistenceMaxQPS())
+	pConfig.DomainMaxQPS = s.config.PersistenceDomainMaxQPS
+	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), s.metricsClient, log)
+
+	frontendClient := s.getFrontendClient(base, log)
+	var checker *consistencyChecker
 	if s.params.ClusterMetadata.IsGlobalDomainEnabled() {
-		s.startReplicator(params, base, log)
+		checker = s.startReplicator(params, base, pFactory, frontendClient, log)
 	}
 
-	frontendClient := s.getFrontendClient(base, log)
-	w := worker.New(frontendClient, SystemWorkflowDomain, SystemTaskList, worker.Options{})
+	deleter := s.newDomainDeleter(base, pFactory, log)
+	w := worker.New(frontendClient, common.SystemDomainName, common.SystemTaskListName, worker.Options{
+		BackgroundActivityContext: deleter.withContext(context.Background()),
+	})
 	if err := w.Start(); err != nil {
 		w.Stop()
 		log.Fatalf("failed to start worker: %v", err)
@@ -106,6 +130,9 @@ func (s *Service) Start() {
 
 	<-s.stopC
 	w.Stop()
+	if checker != nil {
+		checker.Stop()
+	}
 	base.Stop()
 }
 
@@ -127,25 +154,62 @@ func (s *Service) getFrontendClient(base service.Service, log bark.Logger) front
 		common.IsWhitelistServiceTransientError)
 }
 
-func (s *Service) startReplicator(params *service.BootstrapParams, base service.Service, log bark.Logger) {
-	pConfig := params.PersistenceConfig
-	pConfig.SetMaxQPS(pConfig.DefaultStore, s.config.PersistenceMaxQPS())
-	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), s.metricsClient, log)
+func (s *Service) newDomainDeleter(base service.Service, pFactory persistencefactory.Factory,
+	log bark.Logger) *domainDeleter {
+	// the domains to delete can be in either metadata table
+	metadataManager, err := pFactory.NewMetadataManager(persistencefactory.MetadataV1V2)
+	if err != nil {
+		log.Fatalf("failed to create metadata manager: %v", err)
+	}
 
+	visibilityManager, err := pFactory.NewVisibilityManager(false)
+	if err != nil {
+		log.Fatalf("failed to create visibility manager: %v", err)
+	}
+
+	taskManager, err := pFactory.NewTaskManager()
+	if err != nil {
+		log.Fatalf("failed to create task manager: %v", err)
+	}
+
+	// the executions are deleted by the history service, so its cache never serves a deleted execution
+	historyClient, err := base.GetClientFactory().NewHistoryClient()
+	if err != nil {
+		log.Fatalf("failed to create history service client: %v", err)
+	}
+	historyClient = history.NewRetryableClient(historyClient, common.CreateHistoryServiceRetryPolicy(),
+		common.IsWhitelistServiceTransientError)
+
+	return newDomainDeleter(metadataManager, visibilityManager, taskManager, historyClient, log, s.metricsClient)
+}
+
+func (s *Service) startReplicator(params *service.BootstrapParams, base service.Service,
+	pFactory persistencefactory.Factory, frontendClient frontend.Client, log bark.Logger) *consistencyChecker {
 	metadataManager, err := pFactory.NewMetadataManager(persistencefactory.MetadataV2)
 	if err != nil {
 		log.Fatalf("failed to create metadata manager: %v", err)
 	}
 
+	dlqManager, err := pFactory.NewReplicationDLQManager()
+	if err != nil {
+		// TODO change this to Fatalf when SQL also support replication DLQ
+		log.Warnf("failed to create replication DLQ manager: %v, failed replication tasks will be nacked", err)
+	}
+
 	history, err := base.GetClientFactory().NewHistoryClient()
 	if err != nil {
 		log.Fatalf("failed to create history service client: %v", err)
 	}
 
-	replicator := NewReplicator(params.ClusterMetadata, metadataManager, history, s.config, params.MessagingClient, log,
-		s.metricsClient)
+	replicator := NewReplicator(params.ClusterMetadata, metadataManager, history, s.config, params.MessagingClient,
+		params.RPCFactory, dlqManager, params.PersistenceConfig.NumHistoryShards, log, s.metricsClient)
 	if err := replicator.Start(); err != nil {
 		replicator.Stop()
 		log.Fatalf("Fail to start replicator: %v", err)
 	}
+
+	checker := newConsistencyChecker(params.ClusterMetadata, s.config, metadataManager, history, frontendClient,
+		params.RPCFactory, log, s.metricsClient)
+	checker.Start()
+	return checker
 }
@@ -42,6 +42,84 @@ func newAdminWorkflowCommands() []cli.Command {
 				AdminDescribeWorkflow(c)
 			},
 		},
+		{
+			Name:    "migrate-history",
+			Aliases: []string{"mh"},
+			Usage:   "Migrate history of workflow execution to events v2",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.BoolFlag{
+					Name:  FlagDryRunWithAlias,
+					Usage: "Only read the history and report its size without migrating it",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminMigrateWorkflowHistory(c)
+			},
+		},
+		{
+			Name:    "resend",
+			Aliases: []string{"rs"},
+			Usage:   "Resend history events of workflow execution missing on current cluster from the source cluster",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagSourceClusterWithAlias,
+					Usage: "Cluster the history events are resent from",
+				},
+				cli.Int64Flag{
+					Name:  FlagFirstEventIDWithAlias,
+					Usage: "First event ID to resend, default to the first event of the workflow execution",
+				},
+				cli.Int64Flag{
+					Name:  FlagNextEventIDWithAlias,
+					Usage: "Event ID after the last event to resend, default to the next event ID of the workflow execution",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminResendWorkflowHistory(c)
+			},
+		},
+		{
+			Name:    "check-consistency",
+			Aliases: []string{"cc"},
+			Usage:   "Compare mutable state of workflow execution between current cluster and a remote cluster",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagRemoteClusterWithAlias,
+					Usage: "Cluster to compare with",
+				},
+				cli.BoolFlag{
+					Name:  FlagResend,
+					Usage: "Resend the missing history events to the cluster behind if next event IDs do not match",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminCheckReplicationConsistency(c)
+			},
+		},
 	}
 }
 
@@ -110,3 +188,314 @@ func newAdminKafkaCommands() []cli.Command {
 		},
 	}
 }
+
+func newAdminDLQCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "read",
+			Aliases: []string{"r"},
+			Usage:   "Read replication tasks from the replication dead letter queue of a shard",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagSourceClusterWithAlias,
+					Usage: "Cluster the replication tasks were replicated from",
+				},
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ShardID",
+				},
+				cli.Int64Flag{
+					Name:  FlagLastMessageIDWithAlias,
+					Usage: "The upper bound (inclusive) of the message id to read, if not provided all messages are read",
+				},
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID, if not provided then no filters by WorkflowID are applied",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID, if not provided then no filters by RunID are applied",
+				},
+				cli.StringFlag{
+					Name:  FlagOutputFilenameWithAlias,
+					Usage: "Output file to write to, if not provided output is written to stdout",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminReadDLQMessages(c)
+			},
+		},
+		{
+			Name:    "merge",
+			Aliases: []string{"m"},
+			Usage:   "Re-apply replication tasks from the replication dead letter queue of a shard and remove them from the queue",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagSourceClusterWithAlias,
+					Usage: "Cluster the replication tasks were replicated from",
+				},
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ShardID",
+				},
+				cli.Int64Flag{
+					Name:  FlagLastMessageIDWithAlias,
+					Usage: "The upper bound (inclusive) of the message id to merge, if not provided all messages are merged",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminMergeDLQMessages(c)
+			},
+		},
+		{
+			Name:    "purge",
+			Aliases: []string{"p"},
+			Usage:   "Delete replication tasks from the replication dead letter queue of a shard",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagSourceClusterWithAlias,
+					Usage: "Cluster the replication tasks were replicated from",
+				},
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ShardID",
+				},
+				cli.Int64Flag{
+					Name:  FlagLastMessageIDWithAlias,
+					Usage: "The upper bound (inclusive) of the message id to delete, if not provided all messages are deleted",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminPurgeDLQMessages(c)
+			},
+		},
+	}
+}
+
+func newAdminClusterCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "replication-status",
+			Aliases: []string{"rs"},
+			Usage:   "Show replication tasks of current cluster pending for each remote cluster, of a domain if --domain is set",
+			Action: func(c *cli.Context) {
+				AdminGetReplicationStatus(c)
+			},
+		},
+	}
+}
+
+func newAdminDomainCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "delete",
+			Usage: "Delete a deprecated domain with no open workflows, and remove its data in the background",
+			Action: func(c *cli.Context) {
+				AdminDeleteDomain(c)
+			},
+		},
+		{
+			Name:    "deletion-progress",
+			Aliases: []string{"dp"},
+			Usage:   "Show the progress of removing the data of a deleted domain",
+			Action: func(c *cli.Context) {
+				AdminDescribeDomainDeletion(c)
+			},
+		},
+	}
+}
+
+func newAdminShardCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "describe",
+			Aliases: []string{"d"},
+			Usage:   "Describe the ack levels, read levels, outstanding tasks and failover levels of the task queues of a shard",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ShardID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDescribeShard(c)
+			},
+		},
+		{
+			Name:    "list-tasks",
+			Aliases: []string{"lt"},
+			Usage:   "List the pending tasks of a task queue of a shard, starting from the queue ack level",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ShardID",
+				},
+				cli.StringFlag{
+					Name:  FlagQueueTypeWithAlias,
+					Usage: "Task queue: transfer, timer or replication",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: defaultShardTasksPageSize,
+					Usage: "Result page size",
+				},
+				cli.BoolFlag{
+					Name:  FlagMoreWithAlias,
+					Usage: "List more pages, default is to list one page of default page size 100",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminListShardTasks(c)
+			},
+		},
+		{
+			Name:    "remove-task",
+			Aliases: []string{"rmt"},
+			Usage:   "Remove a transfer or timer task of a shard without processing it, e.g. a poison task blocking the ack level",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ShardID",
+				},
+				cli.StringFlag{
+					Name:  FlagQueueTypeWithAlias,
+					Usage: "Task queue: transfer or timer",
+				},
+				cli.Int64Flag{
+					Name:  FlagTaskIDWithAlias,
+					Usage: "TaskID",
+				},
+				cli.Int64Flag{
+					Name:  FlagTaskTimestampWithAlias,
+					Usage: "Visibility timestamp of a timer task in Unix Nano, as shown by list-tasks",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRemoveShardTask(c)
+			},
+		},
+		{
+			Name:    "reschedule-task",
+			Aliases: []string{"rst"},
+			Usage:   "Process a transfer or timer task of a shard right away, the task is acked if the processing succeeds",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ShardID",
+				},
+				cli.StringFlag{
+					Name:  FlagQueueTypeWithAlias,
+					Usage: "Task queue: transfer or timer",
+				},
+				cli.Int64Flag{
+					Name:  FlagTaskIDWithAlias,
+					Usage: "TaskID",
+				},
+				cli.Int64Flag{
+					Name:  FlagTaskTimestampWithAlias,
+					Usage: "Visibility timestamp of a timer task in Unix Nano, as shown by list-tasks",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRescheduleShardTask(c)
+			},
+		},
+		{
+			Name:    "list-quarantined",
+			Aliases: []string{"lq"},
+			Usage:   "List the transfer or timer tasks of a shard which were quarantined after failing with non-transient errors",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ShardID",
+				},
+				cli.StringFlag{
+					Name:  FlagQueueTypeWithAlias,
+					Usage: "Task queue: transfer or timer",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: defaultShardTasksPageSize,
+					Usage: "Result page size",
+				},
+				cli.BoolFlag{
+					Name:  FlagMoreWithAlias,
+					Usage: "List more pages, default is to list one page of default page size 100",
+				},
+				cli.BoolFlag{
+					Name:  FlagPrintFullyDetailWithAlias,
+					Usage: "Print full task details in JSON format, including the stack trace",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminListQuarantinedTasks(c)
+			},
+		},
+		{
+			Name:    "retry-quarantined",
+			Aliases: []string{"rq"},
+			Usage:   "Process a quarantined task of a shard right away, the task is removed from the quarantine if the processing succeeds",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ShardID",
+				},
+				cli.StringFlag{
+					Name:  FlagQueueTypeWithAlias,
+					Usage: "Task queue: transfer or timer",
+				},
+				cli.Int64Flag{
+					Name:  FlagTaskIDWithAlias,
+					Usage: "TaskID",
+				},
+				cli.Int64Flag{
+					Name:  FlagTaskTimestampWithAlias,
+					Usage: "Visibility timestamp of a timer task in Unix Nano, as shown by list-quarantined",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRetryQuarantinedTask(c)
+			},
+		},
+		{
+			Name:    "drop-quarantined",
+			Aliases: []string{"dq"},
+			Usage:   "Remove a quarantined task of a shard without processing it",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ShardID",
+				},
+				cli.StringFlag{
+					Name:  FlagQueueTypeWithAlias,
+					Usage: "Task queue: transfer or timer",
+				},
+				cli.Int64Flag{
+					Name:  FlagTaskIDWithAlias,
+					Usage: "TaskID",
+				},
+				cli.Int64Flag{
+					Name:  FlagTaskTimestampWithAlias,
+					Usage: "Visibility timestamp of a timer task in Unix Nano, as shown by list-quarantined",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDropQuarantinedTask(c)
+			},
+		},
+		{
+			Name:    "close",
+			Aliases: []string{"cl"},
+			Usage:   "Close a shard on its current owner, the shard is then reacquired by its owner according to the membership ring, the drained hosts and the shard ownership overrides",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ShardID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminCloseShard(c)
+			},
+		},
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/uber/cadence/.gen/go/admin"
+	s "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/urfave/cli"
+)
+
+// AdminGetReplicationStatus shows the replication status of current cluster against each remote cluster
+func AdminGetReplicationStatus(c *cli.Context) {
+	serviceClient := getAdminServiceClient(c)
+
+	ctx, cancel := newContext()
+	defer cancel()
+	resp, err := serviceClient.GetReplicationStatus(ctx, &admin.GetReplicationStatusRequest{
+		Domain: common.StringPtr(c.GlobalString(FlagDomain)),
+	})
+	if err != nil {
+		ErrorAndExit("Get replication status failed", err)
+	}
+
+	fmt.Printf("Current cluster: %s\n", resp.GetCurrentClusterName())
+	if len(resp.MissingShardIDs) != 0 {
+		fmt.Printf("Shards not reporting: %v\n", resp.MissingShardIDs)
+	}
+
+	clusterNames := []string{}
+	for clusterName := range resp.Clusters {
+		clusterNames = append(clusterNames, clusterName)
+	}
+	sort.Strings(clusterNames)
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Remote Cluster", "Pending Tasks"})
+	table.SetHeaderLine(false)
+	for _, clusterName := range clusterNames {
+		status := resp.Clusters[clusterName]
+		table.Append([]string{
+			clusterName,
+			strconv.FormatInt(status.GetPendingTaskCount(), 10),
+		})
+	}
+	table.Render()
+}
+
+// AdminCheckReplicationConsistency compares the mutable state of a workflow execution between the current cluster
+// and a remote cluster
+func AdminCheckReplicationConsistency(c *cli.Context) {
+	serviceClient := getAdminServiceClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	remoteCluster := getRequiredOption(c, FlagRemoteCluster)
+
+	ctx, cancel := newContext()
+	defer cancel()
+	resp, err := serviceClient.CheckReplicationConsistency(ctx, &admin.CheckReplicationConsistencyRequest{
+		Domain: common.StringPtr(domain),
+		Execution: &s.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      common.StringPtr(rid),
+		},
+		RemoteCluster:    common.StringPtr(remoteCluster),
+		ResendOnMismatch: common.BoolPtr(c.Bool(FlagResend)),
+	})
+	if err != nil {
+		ErrorAndExit("Check replication consistency failed", err)
+	}
+
+	if len(resp.Diffs) == 0 {
+		fmt.Println("Mutable state is consistent.")
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Field", "Current Cluster", remoteCluster})
+	table.SetHeaderLine(false)
+	for _, diff := range resp.Diffs {
+		table.Append([]string{diff.GetField(), diff.GetCurrentCluster(), diff.GetRemoteCluster()})
+	}
+	table.Render()
+	if resp.GetResent() {
+		fmt.Println("Missing history events resent.")
+	}
+}
@@ -21,11 +21,13 @@
 package cli
 
 import (
+	"fmt"
+
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
+	s "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/urfave/cli"
-	"go.uber.org/cadence/.gen/go/admin"
-	"go.uber.org/cadence/.gen/go/admin/adminserviceclient"
-	s "go.uber.org/cadence/.gen/go/shared"
 )
 
 func getAdminServiceClient(c *cli.Context) adminserviceclient.Interface {
@@ -63,6 +65,61 @@ func AdminDescribeWorkflow(c *cli.Context) {
 	prettyPrintJSONObject(resp)
 }
 
+// AdminMigrateWorkflowHistory migrates history of workflow execution to events v2
+func AdminMigrateWorkflowHistory(c *cli.Context) {
+	serviceClient := getAdminServiceClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	dryRun := c.Bool(FlagDryRun)
+
+	ctx, cancel := newContext()
+	defer cancel()
+
+	resp, err := serviceClient.MigrateWorkflowHistory(ctx, &admin.MigrateWorkflowHistoryRequest{
+		Domain: common.StringPtr(domain),
+		Execution: &s.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      common.StringPtr(rid),
+		},
+		DryRun: common.BoolPtr(dryRun),
+	})
+	if err != nil {
+		ErrorAndExit("Migrate workflow history failed", err)
+	}
+
+	prettyPrintJSONObject(resp)
+}
+
+// AdminResendWorkflowHistory resends history events of a workflow execution from the source cluster
+func AdminResendWorkflowHistory(c *cli.Context) {
+	serviceClient := getAdminServiceClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := getRequiredOption(c, FlagRunID)
+	sourceCluster := getRequiredOption(c, FlagSourceCluster)
+
+	ctx, cancel := newContext()
+	defer cancel()
+
+	err := serviceClient.ResendWorkflowHistory(ctx, &admin.ResendWorkflowHistoryRequest{
+		Domain: common.StringPtr(domain),
+		Execution: &s.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      common.StringPtr(rid),
+		},
+		SourceCluster: common.StringPtr(sourceCluster),
+		FirstEventId:  common.Int64Ptr(c.Int64(FlagFirstEventID)),
+		NextEventId:   common.Int64Ptr(c.Int64(FlagNextEventID)),
+	})
+	if err != nil {
+		ErrorAndExit("Resend workflow history failed", err)
+	}
+	fmt.Println("Successfully resent workflow history.")
+}
+
 // AdminDescribeHistoryHost describes history host
 func AdminDescribeHistoryHost(c *cli.Context) {
 	// using service client instead of cadence.Client because we need to directly pass the json blob as input.
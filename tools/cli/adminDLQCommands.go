@@ -0,0 +1,161 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/common"
+	"github.com/urfave/cli"
+)
+
+const (
+	defaultDLQPageSize = 100
+)
+
+// AdminReadDLQMessages reads replication tasks from the replication DLQ of a shard and outputs them
+func AdminReadDLQMessages(c *cli.Context) {
+	serviceClient := getAdminServiceClient(c)
+
+	sourceCluster := getRequiredOption(c, FlagSourceCluster)
+	shardID := getRequiredShardID(c)
+	lastMessageID := getLastMessageID(c)
+	filter := buildDLQFilterFn(c.String(FlagWorkflowID), c.String(FlagRunID))
+	outputFile := getOutputFile(c.String(FlagOutputFilename))
+	defer outputFile.Close()
+
+	var token []byte
+	for {
+		ctx, cancel := newContext()
+		resp, err := serviceClient.ReadDLQMessages(ctx, &admin.ReadDLQMessagesRequest{
+			SourceCluster:         common.StringPtr(sourceCluster),
+			ShardID:               common.Int32Ptr(shardID),
+			InclusiveEndMessageID: common.Int64Ptr(lastMessageID),
+			MaximumPageSize:       common.Int32Ptr(defaultDLQPageSize),
+			NextPageToken:         token,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("Read DLQ messages failed", err)
+		}
+
+		for _, message := range resp.Messages {
+			if filter(message.ReplicationTask) {
+				outputFile.WriteString(fmt.Sprintf("MessageID: %v, %v\n", message.GetMessageId(), message.ReplicationTask.String()))
+			}
+		}
+
+		token = resp.NextPageToken
+		if len(token) == 0 {
+			break
+		}
+	}
+}
+
+// AdminMergeDLQMessages re-applies replication tasks from the replication DLQ of a shard
+func AdminMergeDLQMessages(c *cli.Context) {
+	serviceClient := getAdminServiceClient(c)
+
+	sourceCluster := getRequiredOption(c, FlagSourceCluster)
+	shardID := getRequiredShardID(c)
+	lastMessageID := getLastMessageID(c)
+
+	var token []byte
+	for {
+		ctx, cancel := newContext()
+		resp, err := serviceClient.MergeDLQMessages(ctx, &admin.MergeDLQMessagesRequest{
+			SourceCluster:         common.StringPtr(sourceCluster),
+			ShardID:               common.Int32Ptr(shardID),
+			InclusiveEndMessageID: common.Int64Ptr(lastMessageID),
+			MaximumPageSize:       common.Int32Ptr(defaultDLQPageSize),
+			NextPageToken:         token,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("Merge DLQ messages failed", err)
+		}
+
+		token = resp.NextPageToken
+		if len(token) == 0 {
+			break
+		}
+	}
+	fmt.Println("Successfully merged all DLQ messages.")
+}
+
+// AdminPurgeDLQMessages deletes replication tasks from the replication DLQ of a shard
+func AdminPurgeDLQMessages(c *cli.Context) {
+	serviceClient := getAdminServiceClient(c)
+
+	sourceCluster := getRequiredOption(c, FlagSourceCluster)
+	shardID := getRequiredShardID(c)
+	lastMessageID := getLastMessageID(c)
+
+	ctx, cancel := newContext()
+	defer cancel()
+	err := serviceClient.PurgeDLQMessages(ctx, &admin.PurgeDLQMessagesRequest{
+		SourceCluster:         common.StringPtr(sourceCluster),
+		ShardID:               common.Int32Ptr(shardID),
+		InclusiveEndMessageID: common.Int64Ptr(lastMessageID),
+	})
+	if err != nil {
+		ErrorAndExit("Purge DLQ messages failed", err)
+	}
+	fmt.Println("Successfully purged DLQ messages.")
+}
+
+func getRequiredShardID(c *cli.Context) int32 {
+	if !c.IsSet(FlagShardID) {
+		ExitIfError(fmt.Errorf("%s is required", FlagShardID))
+	}
+	return int32(c.Int(FlagShardID))
+}
+
+func getLastMessageID(c *cli.Context) int64 {
+	if c.IsSet(FlagLastMessageID) {
+		return c.Int64(FlagLastMessageID)
+	}
+	return math.MaxInt64
+}
+
+func buildDLQFilterFn(workflowID, runID string) filterFn {
+	return func(task *replicator.ReplicationTask) bool {
+		var taskWorkflowID, taskRunID string
+		switch task.GetTaskType() {
+		case replicator.ReplicationTaskTypeHistory:
+			taskWorkflowID = task.HistoryTaskAttributes.GetWorkflowId()
+			taskRunID = task.HistoryTaskAttributes.GetRunId()
+		case replicator.ReplicationTaskTypeSyncActivity:
+			taskWorkflowID = task.SyncActicvityTaskAttributes.GetWorkflowId()
+			taskRunID = task.SyncActicvityTaskAttributes.GetRunId()
+		}
+		if len(workflowID) != 0 && taskWorkflowID != workflowID {
+			return false
+		}
+		if len(runID) != 0 && taskRunID != runID {
+			return false
+		}
+		return true
+	}
+}
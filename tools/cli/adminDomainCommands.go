@@ -0,0 +1,81 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/common"
+	"github.com/urfave/cli"
+	s "go.uber.org/cadence/.gen/go/shared"
+)
+
+// AdminDeleteDomain deletes a deprecated domain and starts the system workflow removing its data
+func AdminDeleteDomain(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	serviceClient := getAdminServiceClient(c)
+
+	ctx, cancel := newContext()
+	defer cancel()
+	resp, err := serviceClient.DeleteDomain(ctx, &admin.DeleteDomainRequest{
+		Domain: common.StringPtr(domain),
+	})
+	if err != nil {
+		ErrorAndExit("Delete domain failed", err)
+	}
+
+	fmt.Printf("Domain %s is deleted, its data is being removed by workflow %s, run %s in domain %s.\n",
+		domain, resp.GetWorkflowId(), resp.GetRunId(), common.SystemDomainName)
+}
+
+// AdminDescribeDomainDeletion shows the progress of the system workflow removing the data of a deleted domain
+func AdminDescribeDomainDeletion(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	serviceClient := getWorkflowServiceClient(c)
+
+	ctx, cancel := newContext()
+	defer cancel()
+	queryResponse, err := serviceClient.QueryWorkflow(ctx, &s.QueryWorkflowRequest{
+		Domain: common.StringPtr(common.SystemDomainName),
+		Execution: &s.WorkflowExecution{
+			WorkflowId: common.StringPtr(common.DomainDeletionWorkflowID(domain)),
+		},
+		Query: &s.WorkflowQuery{
+			QueryType: common.StringPtr(common.DomainDeletionProgressQueryType),
+		},
+	})
+	if err != nil {
+		ErrorAndExit("Query domain deletion progress failed", err)
+	}
+
+	var progress common.DomainDeletionProgress
+	if err := json.Unmarshal(queryResponse.QueryResult, &progress); err != nil {
+		ErrorAndExit("Failed to decode domain deletion progress", err)
+	}
+	fmt.Printf("Stage: %s\n", progress.Stage)
+	fmt.Printf("Executions deleted: %d\n", progress.ExecutionsDeleted)
+	fmt.Printf("Task lists deleted: %d\n", progress.TaskListsDeleted)
+	if progress.LastError != "" {
+		fmt.Printf("Last error, retrying: %s\n", progress.LastError)
+	}
+}
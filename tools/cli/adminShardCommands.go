@@ -0,0 +1,355 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/common"
+	"github.com/urfave/cli"
+)
+
+const (
+	defaultShardTasksPageSize = 100
+)
+
+// AdminDescribeShard shows the ack levels, read levels, outstanding tasks and failover levels of the queues of a shard
+func AdminDescribeShard(c *cli.Context) {
+	serviceClient := getAdminServiceClient(c)
+
+	shardID := getRequiredShardID(c)
+
+	ctx, cancel := newContext()
+	defer cancel()
+	resp, err := serviceClient.DescribeShard(ctx, &h.DescribeShardRequest{
+		ShardID: common.Int32Ptr(shardID),
+	})
+	if err != nil {
+		ErrorAndExit("Describe shard failed", err)
+	}
+
+	fmt.Printf("Shard %v is owned by %v, transfer max read level: %v\n",
+		resp.GetShardID(), resp.GetAddress(), resp.GetTransferMaxReadLevel())
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Queue", "Cluster", "Ack Level", "Read Level", "Outstanding Tasks"})
+	table.SetHeaderLine(false)
+	for _, queue := range resp.Queues {
+		table.Append([]string{
+			queue.GetQueueType().String(),
+			queue.GetClusterName(),
+			formatQueueLevel(queue.GetQueueType(), queue.GetAckLevel()),
+			formatQueueLevel(queue.GetQueueType(), queue.GetReadLevel()),
+			strconv.Itoa(int(queue.GetOutstandingTasks())),
+		})
+	}
+	table.Render()
+
+	if len(resp.FailoverLevels) == 0 {
+		return
+	}
+	fmt.Println("Failover levels:")
+	table = tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Queue", "Failover ID", "Domain IDs", "Start Time", "Min Level", "Current Level", "Max Level"})
+	table.SetHeaderLine(false)
+	for _, level := range resp.FailoverLevels {
+		table.Append([]string{
+			level.GetQueueType().String(),
+			level.GetFailoverID(),
+			strings.Join(level.DomainIDs, ","),
+			convertTime(level.GetStartTime(), false),
+			formatQueueLevel(level.GetQueueType(), level.GetMinLevel()),
+			formatQueueLevel(level.GetQueueType(), level.GetCurrentLevel()),
+			formatQueueLevel(level.GetQueueType(), level.GetMaxLevel()),
+		})
+	}
+	table.Render()
+}
+
+// AdminListShardTasks lists the pending tasks of a queue of a shard
+func AdminListShardTasks(c *cli.Context) {
+	serviceClient := getAdminServiceClient(c)
+
+	shardID := getRequiredShardID(c)
+	queueType := getRequiredQueueType(c)
+	pageSize := c.Int(FlagPageSize)
+	more := c.Bool(FlagMore)
+
+	var token []byte
+	for {
+		ctx, cancel := newContext()
+		resp, err := serviceClient.ListShardTasks(ctx, &h.ListShardTasksRequest{
+			ShardID:       common.Int32Ptr(shardID),
+			QueueType:     queueType.Ptr(),
+			PageSize:      common.Int32Ptr(int32(pageSize)),
+			NextPageToken: token,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("List shard tasks failed", err)
+		}
+
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetBorder(false)
+		table.SetColumnSeparator("|")
+		table.SetHeader([]string{"Task ID", "Type", "Domain ID", "Workflow ID", "Run ID", "Visibility Timestamp", "Version", "Event ID"})
+		table.SetHeaderLine(false)
+		for _, task := range resp.Tasks {
+			table.Append([]string{
+				strconv.FormatInt(task.GetTaskID(), 10),
+				strconv.Itoa(int(task.GetTaskType())),
+				task.GetDomainID(),
+				task.GetWorkflowID(),
+				task.GetRunID(),
+				strconv.FormatInt(task.GetVisibilityTimestamp(), 10),
+				strconv.FormatInt(task.GetVersion(), 10),
+				strconv.FormatInt(task.GetEventID(), 10),
+			})
+		}
+		table.Render()
+
+		token = resp.NextPageToken
+		if !more || len(token) == 0 {
+			break
+		}
+		fmt.Printf("Press %s to show next page, press %s to quit: ",
+			color.GreenString("Enter"), color.RedString("any other key then Enter"))
+		var input string
+		fmt.Scanln(&input)
+		if strings.Trim(input, " ") != "" {
+			break
+		}
+	}
+}
+
+// AdminRemoveShardTask force completes a transfer or timer task of a shard without processing it
+func AdminRemoveShardTask(c *cli.Context) {
+	serviceClient := getAdminServiceClient(c)
+
+	shardID := getRequiredShardID(c)
+	queueType := getRequiredQueueType(c)
+	taskID := getRequiredTaskID(c)
+
+	ctx, cancel := newContext()
+	defer cancel()
+	err := serviceClient.RemoveShardTask(ctx, &h.RemoveShardTaskRequest{
+		ShardID:             common.Int32Ptr(shardID),
+		QueueType:           queueType.Ptr(),
+		TaskID:              common.Int64Ptr(taskID),
+		VisibilityTimestamp: getTaskTimestamp(c, queueType),
+	})
+	if err != nil {
+		ErrorAndExit("Remove shard task failed", err)
+	}
+	fmt.Println("Successfully removed task.")
+}
+
+// AdminRescheduleShardTask processes a transfer or timer task of a shard immediately
+func AdminRescheduleShardTask(c *cli.Context) {
+	serviceClient := getAdminServiceClient(c)
+
+	shardID := getRequiredShardID(c)
+	queueType := getRequiredQueueType(c)
+	taskID := getRequiredTaskID(c)
+
+	ctx, cancel := newContext()
+	defer cancel()
+	err := serviceClient.RescheduleShardTask(ctx, &h.RescheduleShardTaskRequest{
+		ShardID:             common.Int32Ptr(shardID),
+		QueueType:           queueType.Ptr(),
+		TaskID:              common.Int64Ptr(taskID),
+		VisibilityTimestamp: getTaskTimestamp(c, queueType),
+	})
+	if err != nil {
+		ErrorAndExit("Reschedule shard task failed", err)
+	}
+	fmt.Println("Successfully processed task.")
+}
+
+// AdminListQuarantinedTasks lists the transfer or timer tasks of a shard which were moved to the quarantine
+func AdminListQuarantinedTasks(c *cli.Context) {
+	serviceClient := getAdminServiceClient(c)
+
+	shardID := getRequiredShardID(c)
+	queueType := getRequiredQueueType(c)
+	pageSize := c.Int(FlagPageSize)
+	more := c.Bool(FlagMore)
+	printFully := c.Bool(FlagPrintFullyDetail)
+
+	var token []byte
+	for {
+		ctx, cancel := newContext()
+		resp, err := serviceClient.ListQuarantinedTasks(ctx, &h.ListQuarantinedTasksRequest{
+			ShardID:       common.Int32Ptr(shardID),
+			QueueType:     queueType.Ptr(),
+			PageSize:      common.Int32Ptr(int32(pageSize)),
+			NextPageToken: token,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("List quarantined tasks failed", err)
+		}
+
+		if printFully {
+			for _, task := range resp.Tasks {
+				prettyPrintJSONObject(task)
+			}
+		} else {
+			table := tablewriter.NewWriter(os.Stdout)
+			table.SetBorder(false)
+			table.SetColumnSeparator("|")
+			table.SetHeader([]string{"Task ID", "Type", "Domain ID", "Workflow ID", "Run ID", "Visibility Timestamp",
+				"Attempt", "Quarantined Time", "Error"})
+			table.SetHeaderLine(false)
+			for _, task := range resp.Tasks {
+				table.Append([]string{
+					strconv.FormatInt(task.GetTaskID(), 10),
+					strconv.Itoa(int(task.GetTaskType())),
+					task.GetDomainID(),
+					task.GetWorkflowID(),
+					task.GetRunID(),
+					strconv.FormatInt(task.GetVisibilityTimestamp(), 10),
+					strconv.Itoa(int(task.GetAttempt())),
+					convertTime(task.GetQuarantinedTimestamp(), false),
+					task.GetError(),
+				})
+			}
+			table.Render()
+		}
+
+		token = resp.NextPageToken
+		if !more || len(token) == 0 {
+			break
+		}
+		fmt.Printf("Press %s to show next page, press %s to quit: ",
+			color.GreenString("Enter"), color.RedString("any other key then Enter"))
+		var input string
+		fmt.Scanln(&input)
+		if strings.Trim(input, " ") != "" {
+			break
+		}
+	}
+}
+
+// AdminRetryQuarantinedTask processes a quarantined task of a shard immediately
+func AdminRetryQuarantinedTask(c *cli.Context) {
+	serviceClient := getAdminServiceClient(c)
+
+	shardID := getRequiredShardID(c)
+	queueType := getRequiredQueueType(c)
+	taskID := getRequiredTaskID(c)
+
+	ctx, cancel := newContext()
+	defer cancel()
+	err := serviceClient.RetryQuarantinedTask(ctx, &h.RetryQuarantinedTaskRequest{
+		ShardID:             common.Int32Ptr(shardID),
+		QueueType:           queueType.Ptr(),
+		TaskID:              common.Int64Ptr(taskID),
+		VisibilityTimestamp: getTaskTimestamp(c, queueType),
+	})
+	if err != nil {
+		ErrorAndExit("Retry quarantined task failed", err)
+	}
+	fmt.Println("Successfully processed task and removed it from the quarantine.")
+}
+
+// AdminDropQuarantinedTask removes a quarantined task of a shard without processing it
+func AdminDropQuarantinedTask(c *cli.Context) {
+	serviceClient := getAdminServiceClient(c)
+
+	shardID := getRequiredShardID(c)
+	queueType := getRequiredQueueType(c)
+	taskID := getRequiredTaskID(c)
+
+	ctx, cancel := newContext()
+	defer cancel()
+	err := serviceClient.DropQuarantinedTask(ctx, &h.DropQuarantinedTaskRequest{
+		ShardID:             common.Int32Ptr(shardID),
+		QueueType:           queueType.Ptr(),
+		TaskID:              common.Int64Ptr(taskID),
+		VisibilityTimestamp: getTaskTimestamp(c, queueType),
+	})
+	if err != nil {
+		ErrorAndExit("Drop quarantined task failed", err)
+	}
+	fmt.Println("Successfully dropped task.")
+}
+
+// AdminCloseShard closes a shard on its current owner so that it is reacquired by its owner
+func AdminCloseShard(c *cli.Context) {
+	serviceClient := getAdminServiceClient(c)
+
+	shardID := getRequiredShardID(c)
+
+	ctx, cancel := newContext()
+	defer cancel()
+	err := serviceClient.CloseShard(ctx, &h.CloseShardRequest{
+		ShardID: common.Int32Ptr(shardID),
+	})
+	if err != nil {
+		ErrorAndExit("Close shard failed", err)
+	}
+	fmt.Println("Successfully closed shard.")
+}
+
+func getRequiredQueueType(c *cli.Context) h.ShardQueueType {
+	var queueType h.ShardQueueType
+	if err := queueType.UnmarshalText([]byte(strings.Title(strings.ToLower(getRequiredOption(c, FlagQueueType))))); err != nil {
+		ExitIfError(fmt.Errorf("invalid %s, it must be one of transfer, timer or replication", FlagQueueType))
+	}
+	return queueType
+}
+
+func getRequiredTaskID(c *cli.Context) int64 {
+	if !c.IsSet(FlagTaskID) {
+		ExitIfError(fmt.Errorf("%s is required", FlagTaskID))
+	}
+	return c.Int64(FlagTaskID)
+}
+
+func getTaskTimestamp(c *cli.Context, queueType h.ShardQueueType) *int64 {
+	if queueType != h.ShardQueueTypeTimer {
+		return nil
+	}
+	if !c.IsSet(FlagTaskTimestamp) {
+		ExitIfError(fmt.Errorf("%s is required for timer tasks", FlagTaskTimestamp))
+	}
+	return common.Int64Ptr(c.Int64(FlagTaskTimestamp))
+}
+
+// formatQueueLevel formats a queue level, which is a task ID for the transfer and replication queues
+// and a Unix Nano timestamp for the timer queue
+func formatQueueLevel(queueType h.ShardQueueType, level int64) string {
+	if queueType == h.ShardQueueTypeTimer {
+		return convertTime(level, false)
+	}
+	return strconv.FormatInt(level, 10)
+}
@@ -89,6 +89,29 @@ func NewCliApp() *cli.App {
 					Usage:       "Run admin operation on kafka messages",
 					Subcommands: newAdminKafkaCommands(),
 				},
+				{
+					Name:        "dlq",
+					Usage:       "Run admin operation on replication dead letter queue",
+					Subcommands: newAdminDLQCommands(),
+				},
+				{
+					Name:        "domain",
+					Aliases:     []string{"d"},
+					Usage:       "Run admin operation on domain",
+					Subcommands: newAdminDomainCommands(),
+				},
+				{
+					Name:        "cluster",
+					Aliases:     []string{"cl"},
+					Usage:       "Run admin operation on cluster",
+					Subcommands: newAdminClusterCommands(),
+				},
+				{
+					Name:        "shard",
+					Aliases:     []string{"sh"},
+					Usage:       "Run admin operation on the task queues of a shard",
+					Subcommands: newAdminShardCommands(),
+				},
 			},
 		},
 	}
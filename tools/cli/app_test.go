@@ -21,6 +21,7 @@
 package cli
 
 import (
+	"math"
 	"testing"
 	"time"
 
@@ -28,11 +29,16 @@ import (
 	"github.com/olekukonko/tablewriter"
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
+	"github.com/uber/cadence/.gen/go/admin/adminservicetest"
+	serverFrontend "github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
+	serverFrontendTest "github.com/uber/cadence/.gen/go/cadence/workflowservicetest"
+	serverHistory "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
+	serverShared "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/urfave/cli"
-	"go.uber.org/cadence/.gen/go/admin"
-	"go.uber.org/cadence/.gen/go/admin/adminserviceclient"
-	"go.uber.org/cadence/.gen/go/admin/adminservicetest"
 	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
 	"go.uber.org/cadence/.gen/go/cadence/workflowservicetest"
 	"go.uber.org/cadence/.gen/go/shared"
@@ -41,15 +47,17 @@ import (
 
 type cliAppSuite struct {
 	suite.Suite
-	app          *cli.App
-	mockCtrl     *gomock.Controller
-	service      *workflowservicetest.MockClient
-	adminService *adminservicetest.MockClient
+	app            *cli.App
+	mockCtrl       *gomock.Controller
+	service        *workflowservicetest.MockClient
+	adminService   *adminservicetest.MockClient
+	serverFrontend *serverFrontendTest.MockClient
 }
 
 type workflowClientBuilderMock struct {
-	service      workflowserviceclient.Interface
-	adminService adminserviceclient.Interface
+	service        workflowserviceclient.Interface
+	adminService   adminserviceclient.Interface
+	serverFrontend serverFrontend.Interface
 }
 
 func (mock *workflowClientBuilderMock) BuildServiceClient(c *cli.Context) (workflowserviceclient.Interface, error) {
@@ -60,6 +68,10 @@ func (mock *workflowClientBuilderMock) BuildAdminServiceClient(c *cli.Context) (
 	return mock.adminService, nil
 }
 
+func (mock *workflowClientBuilderMock) BuildServerFrontendClient(c *cli.Context) (serverFrontend.Interface, error) {
+	return mock.serverFrontend, nil
+}
+
 // this is the mock for yarpcCallOptions, make sure length are the same
 var callOptions = []interface{}{gomock.Any(), gomock.Any(), gomock.Any()}
 
@@ -84,7 +96,8 @@ func (s *cliAppSuite) SetupTest() {
 	s.mockCtrl = gomock.NewController(s.T())
 	s.service = workflowservicetest.NewMockClient(s.mockCtrl)
 	s.adminService = adminservicetest.NewMockClient(s.mockCtrl)
-	SetBuilder(&workflowClientBuilderMock{service: s.service, adminService: s.adminService})
+	s.serverFrontend = serverFrontendTest.NewMockClient(s.mockCtrl)
+	SetBuilder(&workflowClientBuilderMock{service: s.service, adminService: s.adminService, serverFrontend: s.serverFrontend})
 }
 
 func (s *cliAppSuite) TearDownTest() {
@@ -171,6 +184,29 @@ func (s *cliAppSuite) TestDomainUpdate_Failed() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestDomainFailover() {
+	s.service.EXPECT().UpdateDomain(gomock.Any(), gomock.Any(), callOptions...).Do(func(_ interface{}, request *shared.UpdateDomainRequest, _ ...interface{}) {
+		s.Equal("standby", request.ReplicationConfiguration.GetActiveClusterName())
+	}).Return(nil, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "domain", "failover", "--ac", "standby"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDomainFailover_Graceful() {
+	s.adminService.EXPECT().GracefulFailoverDomain(gomock.Any(), gomock.Any()).Do(func(_ interface{}, request *admin.GracefulFailoverDomainRequest) {
+		s.Equal(domainName, request.GetDomain())
+		s.Equal("standby", request.GetActiveClusterName())
+		s.Equal(int32(30), request.GetFailoverTimeoutInSeconds())
+	}).Return(&admin.GracefulFailoverDomainResponse{
+		FailoverInfo: &serverShared.DomainFailoverInfo{
+			PendingActiveClusterName: common.StringPtr("standby"),
+			FailoverExpireTimestamp:  common.Int64Ptr(time.Now().UnixNano()),
+		},
+	}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "domain", "failover", "--ac", "standby", "--graceful", "--fts", "30"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestDomainDescribe() {
 	resp := describeDomainResponse
 	s.service.EXPECT().DescribeDomain(gomock.Any(), gomock.Any(), callOptions...).Return(resp, nil)
@@ -278,6 +314,79 @@ func (s *cliAppSuite) TestCancelWorkflow() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestPauseWorkflow() {
+	s.serverFrontend.EXPECT().PauseWorkflowExecution(gomock.Any(), gomock.Any()).
+		Do(func(_ interface{}, request *serverShared.PauseWorkflowExecutionRequest) {
+			s.Equal(domainName, request.GetDomain())
+			s.Equal("wid", request.WorkflowExecution.GetWorkflowId())
+			s.Nil(request.WorkflowExecution.RunId)
+			s.Equal("investigating", request.GetReason())
+		}).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "pause", "-w", "wid", "--reason", "investigating"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestResumeWorkflow() {
+	s.serverFrontend.EXPECT().ResumeWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "resume", "-w", "wid", "-r", "rid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestRetryActivity() {
+	s.serverFrontend.EXPECT().RetryActivityTask(gomock.Any(), gomock.Any()).
+		Do(func(_ interface{}, request *serverShared.RetryActivityTaskRequest) {
+			s.Equal("wid", request.WorkflowExecution.GetWorkflowId())
+			s.Equal("rid", request.WorkflowExecution.GetRunId())
+			s.Equal("aid", request.GetActivityID())
+			s.True(request.GetResetAttempts())
+			s.Equal("downstream recovered", request.GetReason())
+		}).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "activity", "retry", "-w", "wid", "-r", "rid",
+		"--activity_id", "aid", "--reset_attempts", "--reason", "downstream recovered"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestCompleteActivity() {
+	s.serverFrontend.EXPECT().CompleteActivityTask(gomock.Any(), gomock.Any()).
+		Do(func(_ interface{}, request *serverShared.CompleteActivityTaskRequest) {
+			s.Equal("aid", request.GetActivityID())
+			s.Equal([]byte("result"), request.Result)
+			s.Equal("manual fix", request.GetReason())
+		}).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "activity", "complete", "-w", "wid",
+		"--activity_id", "aid", "--result", "result", "--reason", "manual fix"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestFailActivity() {
+	s.serverFrontend.EXPECT().FailActivityTask(gomock.Any(), gomock.Any()).
+		Do(func(_ interface{}, request *serverShared.FailActivityTaskRequest) {
+			s.Equal("aid", request.GetActivityID())
+			s.Equal("bad input", request.GetReason())
+			s.Equal([]byte("detail"), request.Details)
+		}).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "act", "fail", "-w", "wid",
+		"--activity_id", "aid", "--reason", "bad input", "--detail", "detail"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDescribeWorkflow() {
+	resp := &serverShared.DescribeWorkflowExecutionResponse{
+		ExecutionConfiguration: &serverShared.WorkflowExecutionConfiguration{},
+		WorkflowExecutionInfo: &serverShared.WorkflowExecutionInfo{
+			Execution: &serverShared.WorkflowExecution{
+				WorkflowId: common.StringPtr("wid"),
+				RunId:      common.StringPtr("rid"),
+			},
+			StartTime: common.Int64Ptr(time.Now().UnixNano()),
+		},
+		Paused: common.BoolPtr(true),
+	}
+	s.serverFrontend.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "describe", "-w", "wid"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestSignalWorkflow() {
 	s.service.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
 	err := s.app.Run([]string{"", "--do", domainName, "workflow", "signal", "-w", "wid", "-n", "signal-name"})
@@ -417,6 +526,266 @@ func (s *cliAppSuite) TestAdminDescribeWorkflow() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestAdminMigrateWorkflowHistory() {
+	resp := &admin.MigrateWorkflowHistoryResponse{
+		Migrated:          common.BoolPtr(false),
+		HistoryBatchCount: common.Int64Ptr(2),
+		HistoryEventCount: common.Int64Ptr(3),
+		HistorySize:       common.Int64Ptr(100),
+	}
+
+	s.adminService.EXPECT().MigrateWorkflowHistory(gomock.Any(), gomock.Any()).Do(func(_ interface{}, request *admin.MigrateWorkflowHistoryRequest) {
+		s.True(request.GetDryRun())
+	}).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "wf", "migrate-history", "-w", "test-wf-id", "--dry_run"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminResendWorkflowHistory() {
+	s.adminService.EXPECT().ResendWorkflowHistory(gomock.Any(), gomock.Any()).Do(func(_ interface{}, request *admin.ResendWorkflowHistoryRequest) {
+		s.Equal(domainName, request.GetDomain())
+		s.Equal("test-wf-id", request.Execution.GetWorkflowId())
+		s.Equal("test-run-id", request.Execution.GetRunId())
+		s.Equal("standby", request.GetSourceCluster())
+		s.Equal(int64(5), request.GetFirstEventId())
+		s.Equal(int64(0), request.GetNextEventId())
+	}).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "wf", "resend", "-w", "test-wf-id", "-r", "test-run-id",
+		"--sc", "standby", "--fe", "5"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminCheckReplicationConsistency() {
+	s.adminService.EXPECT().CheckReplicationConsistency(gomock.Any(), gomock.Any()).Do(func(_ interface{}, request *admin.CheckReplicationConsistencyRequest) {
+		s.Equal(domainName, request.GetDomain())
+		s.Equal("test-wf-id", request.Execution.GetWorkflowId())
+		s.Equal("standby", request.GetRemoteCluster())
+		s.True(request.GetResendOnMismatch())
+	}).Return(&admin.CheckReplicationConsistencyResponse{
+		Diffs: []*admin.MutableStateDiff{
+			{
+				Field:          common.StringPtr("NextEventID"),
+				CurrentCluster: common.StringPtr("12"),
+				RemoteCluster:  common.StringPtr("10"),
+			},
+		},
+		Resent: common.BoolPtr(true),
+	}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "wf", "check-consistency", "-w", "test-wf-id",
+		"--rc", "standby", "--resend"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDeleteDomain() {
+	s.adminService.EXPECT().DeleteDomain(gomock.Any(), gomock.Any()).Do(func(_ interface{}, request *admin.DeleteDomainRequest) {
+		s.Equal(domainName, request.GetDomain())
+	}).Return(&admin.DeleteDomainResponse{
+		WorkflowId: common.StringPtr(common.DomainDeletionWorkflowID(domainName)),
+		RunId:      common.StringPtr("test-run-id"),
+	}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "domain", "delete"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDescribeDomainDeletion() {
+	s.service.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).Do(func(_ interface{}, request *shared.QueryWorkflowRequest) {
+		s.Equal(common.SystemDomainName, request.GetDomain())
+		s.Equal(common.DomainDeletionWorkflowID(domainName), request.Execution.GetWorkflowId())
+		s.Equal(common.DomainDeletionProgressQueryType, request.Query.GetQueryType())
+	}).Return(&shared.QueryWorkflowResponse{
+		QueryResult: []byte(`{"Stage":"executions","ExecutionsDeleted":10,"TaskListsDeleted":2}`),
+	}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "domain", "dp"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminReadDLQMessages() {
+	resp := &admin.ReadDLQMessagesResponse{
+		Messages: []*admin.ReplicationDLQMessage{
+			{
+				MessageId: common.Int64Ptr(1),
+				ReplicationTask: &replicator.ReplicationTask{
+					TaskType: replicator.ReplicationTaskTypeHistory.Ptr(),
+					HistoryTaskAttributes: &replicator.HistoryTaskAttributes{
+						WorkflowId: common.StringPtr("test-wf-id"),
+						RunId:      common.StringPtr("test-run-id"),
+					},
+				},
+			},
+		},
+	}
+
+	s.adminService.EXPECT().ReadDLQMessages(gomock.Any(), gomock.Any()).Do(func(_ interface{}, request *admin.ReadDLQMessagesRequest) {
+		s.Equal("standby", request.GetSourceCluster())
+		s.Equal(int32(3), request.GetShardID())
+		s.Equal(int64(math.MaxInt64), request.GetInclusiveEndMessageID())
+	}).Return(resp, nil)
+	err := s.app.Run([]string{"", "admin", "dlq", "read", "--sc", "standby", "--sid", "3", "-w", "test-wf-id"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminMergeDLQMessages() {
+	s.adminService.EXPECT().MergeDLQMessages(gomock.Any(), gomock.Any()).Return(&admin.MergeDLQMessagesResponse{
+		NextPageToken: []byte("token"),
+	}, nil)
+	s.adminService.EXPECT().MergeDLQMessages(gomock.Any(), gomock.Any()).Do(func(_ interface{}, request *admin.MergeDLQMessagesRequest) {
+		s.Equal([]byte("token"), request.NextPageToken)
+		s.Equal(int64(10), request.GetInclusiveEndMessageID())
+	}).Return(&admin.MergeDLQMessagesResponse{}, nil)
+	err := s.app.Run([]string{"", "admin", "dlq", "merge", "--sc", "standby", "--sid", "3", "--lm", "10"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminPurgeDLQMessages() {
+	s.adminService.EXPECT().PurgeDLQMessages(gomock.Any(), gomock.Any()).Do(func(_ interface{}, request *admin.PurgeDLQMessagesRequest) {
+		s.Equal("standby", request.GetSourceCluster())
+		s.Equal(int64(10), request.GetInclusiveEndMessageID())
+	}).Return(nil)
+	err := s.app.Run([]string{"", "admin", "dlq", "purge", "--sc", "standby", "--sid", "3", "--lm", "10"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminGetReplicationStatus() {
+	s.adminService.EXPECT().GetReplicationStatus(gomock.Any(), gomock.Any()).Do(func(_ interface{}, request *admin.GetReplicationStatusRequest) {
+		s.Equal(domainName, request.GetDomain())
+	}).Return(&admin.GetReplicationStatusResponse{
+		CurrentClusterName: common.StringPtr("active"),
+		Clusters: map[string]*admin.ClusterReplicationStatus{
+			"standby": {
+				PendingTaskCount: common.Int64Ptr(10),
+			},
+		},
+		MissingShardIDs: []int32{1},
+	}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "cluster", "replication-status"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDescribeShard() {
+	s.adminService.EXPECT().DescribeShard(gomock.Any(), gomock.Any()).Do(func(_ interface{}, request *serverHistory.DescribeShardRequest) {
+		s.Equal(int32(3), request.GetShardID())
+	}).Return(&serverHistory.DescribeShardResponse{
+		ShardID:              common.Int32Ptr(3),
+		Address:              common.StringPtr("127.0.0.1:7934"),
+		TransferMaxReadLevel: common.Int64Ptr(1200),
+		Queues: []*serverHistory.ShardQueueState{
+			{
+				QueueType:        serverHistory.ShardQueueTypeTransfer.Ptr(),
+				ClusterName:      common.StringPtr("active"),
+				AckLevel:         common.Int64Ptr(1000),
+				ReadLevel:        common.Int64Ptr(1100),
+				OutstandingTasks: common.Int32Ptr(2),
+			},
+			{
+				QueueType:        serverHistory.ShardQueueTypeTimer.Ptr(),
+				ClusterName:      common.StringPtr("active"),
+				AckLevel:         common.Int64Ptr(time.Now().UnixNano()),
+				ReadLevel:        common.Int64Ptr(time.Now().UnixNano()),
+				OutstandingTasks: common.Int32Ptr(0),
+			},
+		},
+	}, nil)
+	err := s.app.Run([]string{"", "admin", "shard", "describe", "--sid", "3"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminListShardTasks() {
+	s.adminService.EXPECT().ListShardTasks(gomock.Any(), gomock.Any()).Do(func(_ interface{}, request *serverHistory.ListShardTasksRequest) {
+		s.Equal(int32(3), request.GetShardID())
+		s.Equal(serverHistory.ShardQueueTypeTimer, request.GetQueueType())
+		s.Equal(int32(20), request.GetPageSize())
+	}).Return(&serverHistory.ListShardTasksResponse{
+		Tasks: []*serverHistory.ShardTask{
+			{
+				TaskID:              common.Int64Ptr(1001),
+				TaskType:            common.Int32Ptr(1),
+				DomainID:            common.StringPtr(uuid.New()),
+				WorkflowID:          common.StringPtr("test-wf-id"),
+				RunID:               common.StringPtr(uuid.New()),
+				VisibilityTimestamp: common.Int64Ptr(time.Now().UnixNano()),
+			},
+		},
+	}, nil)
+	err := s.app.Run([]string{"", "admin", "shard", "list-tasks", "--sid", "3", "--qt", "timer", "--ps", "20"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminRemoveShardTask() {
+	s.adminService.EXPECT().RemoveShardTask(gomock.Any(), gomock.Any()).Do(func(_ interface{}, request *serverHistory.RemoveShardTaskRequest) {
+		s.Equal(int32(3), request.GetShardID())
+		s.Equal(serverHistory.ShardQueueTypeTransfer, request.GetQueueType())
+		s.Equal(int64(1001), request.GetTaskID())
+		s.Nil(request.VisibilityTimestamp)
+	}).Return(nil)
+	err := s.app.Run([]string{"", "admin", "shard", "remove-task", "--sid", "3", "--qt", "transfer", "--tid", "1001"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminRescheduleShardTask() {
+	s.adminService.EXPECT().RescheduleShardTask(gomock.Any(), gomock.Any()).Do(func(_ interface{}, request *serverHistory.RescheduleShardTaskRequest) {
+		s.Equal(serverHistory.ShardQueueTypeTimer, request.GetQueueType())
+		s.Equal(int64(1001), request.GetTaskID())
+		s.Equal(int64(1546300800000000000), request.GetVisibilityTimestamp())
+	}).Return(nil)
+	err := s.app.Run([]string{"", "admin", "shard", "reschedule-task", "--sid", "3", "--qt", "timer", "--tid", "1001",
+		"--tts", "1546300800000000000"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminListQuarantinedTasks() {
+	s.adminService.EXPECT().ListQuarantinedTasks(gomock.Any(), gomock.Any()).Do(func(_ interface{}, request *serverHistory.ListQuarantinedTasksRequest) {
+		s.Equal(int32(3), request.GetShardID())
+		s.Equal(serverHistory.ShardQueueTypeTransfer, request.GetQueueType())
+		s.Equal(int32(20), request.GetPageSize())
+	}).Return(&serverHistory.ListQuarantinedTasksResponse{
+		Tasks: []*serverHistory.QuarantinedTask{
+			{
+				QueueType:            serverHistory.ShardQueueTypeTransfer.Ptr(),
+				TaskID:               common.Int64Ptr(1001),
+				TaskType:             common.Int32Ptr(1),
+				DomainID:             common.StringPtr(uuid.New()),
+				WorkflowID:           common.StringPtr("test-wf-id"),
+				RunID:                common.StringPtr(uuid.New()),
+				Attempt:              common.Int32Ptr(10),
+				QuarantinedTimestamp: common.Int64Ptr(time.Now().UnixNano()),
+				Error:                common.StringPtr("test error"),
+			},
+		},
+	}, nil)
+	err := s.app.Run([]string{"", "admin", "shard", "list-quarantined", "--sid", "3", "--qt", "transfer", "--ps", "20"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminRetryQuarantinedTask() {
+	s.adminService.EXPECT().RetryQuarantinedTask(gomock.Any(), gomock.Any()).Do(func(_ interface{}, request *serverHistory.RetryQuarantinedTaskRequest) {
+		s.Equal(serverHistory.ShardQueueTypeTimer, request.GetQueueType())
+		s.Equal(int64(1001), request.GetTaskID())
+		s.Equal(int64(1546300800000000000), request.GetVisibilityTimestamp())
+	}).Return(nil)
+	err := s.app.Run([]string{"", "admin", "shard", "retry-quarantined", "--sid", "3", "--qt", "timer", "--tid", "1001",
+		"--tts", "1546300800000000000"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDropQuarantinedTask() {
+	s.adminService.EXPECT().DropQuarantinedTask(gomock.Any(), gomock.Any()).Do(func(_ interface{}, request *serverHistory.DropQuarantinedTaskRequest) {
+		s.Equal(int32(3), request.GetShardID())
+		s.Equal(serverHistory.ShardQueueTypeTransfer, request.GetQueueType())
+		s.Equal(int64(1001), request.GetTaskID())
+		s.Nil(request.VisibilityTimestamp)
+	}).Return(nil)
+	err := s.app.Run([]string{"", "admin", "shard", "drop-quarantined", "--sid", "3", "--qt", "transfer", "--tid", "1001"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminCloseShard() {
+	s.adminService.EXPECT().CloseShard(gomock.Any(), gomock.Any()).Do(func(_ interface{}, request *serverHistory.CloseShardRequest) {
+		s.Equal(int32(3), request.GetShardID())
+	}).Return(nil)
+	err := s.app.Run([]string{"", "admin", "shard", "close", "--sid", "3"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestDescribeTaskList() {
 	resp := describeTaskListResponse
 	s.service.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any(), callOptions...).Return(resp, nil)
@@ -37,6 +37,9 @@ import (
 	"github.com/fatih/color"
 	"github.com/olekukonko/tablewriter"
 	"github.com/pborman/uuid"
+	"github.com/uber/cadence/.gen/go/admin"
+	serverFrontend "github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
+	serverShared "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/urfave/cli"
 
@@ -129,6 +132,37 @@ const (
 	FlagSecurityTokenWithAlias     = FlagSecurityToken + ", st"
 	FlagSkipErrorMode              = "skip_errors"
 	FlagSkipErrorModeWithAlias     = FlagSkipErrorMode + ", serr"
+	FlagDryRun                     = "dry_run"
+	FlagDryRunWithAlias            = FlagDryRun + ", dr"
+	FlagSourceCluster              = "source_cluster"
+	FlagSourceClusterWithAlias     = FlagSourceCluster + ", sc"
+	FlagLastMessageID              = "last_message_id"
+	FlagLastMessageIDWithAlias     = FlagLastMessageID + ", lm"
+	FlagGraceful                   = "graceful"
+	FlagGracefulWithAlias          = FlagGraceful + ", g"
+	FlagFailoverTimeout            = "failover_timeout_seconds"
+	FlagFailoverTimeoutWithAlias   = FlagFailoverTimeout + ", fts"
+	FlagFirstEventID               = "first_event_id"
+	FlagFirstEventIDWithAlias      = FlagFirstEventID + ", fe"
+	FlagNextEventID                = "next_event_id"
+	FlagNextEventIDWithAlias       = FlagNextEventID + ", ne"
+	FlagRemoteCluster              = "remote_cluster"
+	FlagRemoteClusterWithAlias     = FlagRemoteCluster + ", rc"
+	FlagResend                     = "resend"
+	FlagQueueType                  = "queue_type"
+	FlagQueueTypeWithAlias         = FlagQueueType + ", qt"
+	FlagTaskID                     = "task_id"
+	FlagTaskIDWithAlias            = FlagTaskID + ", tid"
+	FlagTaskTimestamp              = "task_timestamp"
+	FlagTaskTimestampWithAlias     = FlagTaskTimestamp + ", tts"
+	FlagActivityID                 = "activity_id"
+	FlagActivityIDWithAlias        = FlagActivityID + ", aid"
+	FlagResult                     = "result"
+	FlagResultWithAlias            = FlagResult + ", rs"
+	FlagDetail                     = "detail"
+	FlagDetailWithAlias            = FlagDetail + ", de"
+	FlagResetAttempts              = "reset_attempts"
+	FlagResetAttemptsWithAlias     = FlagResetAttempts + ", ra"
 )
 
 const (
@@ -145,6 +179,7 @@ const (
 	defaultContextTimeoutForLongPoll = 2 * time.Minute
 	defaultDecisionTimeoutInSeconds  = 10
 	defaultPageSizeForList           = 500
+	defaultGracefulFailoverTimeout   = 60
 
 	workflowStatusNotSet = -1
 )
@@ -397,6 +432,43 @@ func UpdateDomain(c *cli.Context) {
 	}
 }
 
+// FailoverDomain fails over a global domain to another cluster
+func FailoverDomain(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	activeCluster := getRequiredOption(c, FlagActiveClusterName)
+
+	ctx, cancel := newContext()
+	defer cancel()
+
+	if !c.Bool(FlagGraceful) {
+		domainClient := getDomainClient(c)
+		err := domainClient.Update(ctx, &s.UpdateDomainRequest{
+			Name: common.StringPtr(domain),
+			ReplicationConfiguration: &s.DomainReplicationConfiguration{
+				ActiveClusterName: common.StringPtr(activeCluster),
+			},
+			SecurityToken: common.StringPtr(c.String(FlagSecurityToken)),
+		})
+		if err != nil {
+			ErrorAndExit("Failover domain failed", err)
+		}
+		fmt.Printf("Domain %s succeesfully failed over to %s.\n", domain, activeCluster)
+		return
+	}
+
+	adminClient := getAdminServiceClient(c)
+	resp, err := adminClient.GracefulFailoverDomain(ctx, &admin.GracefulFailoverDomainRequest{
+		Domain:                   common.StringPtr(domain),
+		ActiveClusterName:        common.StringPtr(activeCluster),
+		FailoverTimeoutInSeconds: common.Int32Ptr(int32(c.Int(FlagFailoverTimeout))),
+	})
+	if err != nil {
+		ErrorAndExit("Graceful failover domain failed", err)
+	}
+	fmt.Printf("Graceful failover of domain %s to %s started, the domain will be handed over no later than %s.\n",
+		domain, activeCluster, convertTime(resp.FailoverInfo.GetFailoverExpireTimestamp(), false))
+}
+
 // DescribeDomain updates a domain
 func DescribeDomain(c *cli.Context) {
 	domainClient := getDomainClient(c)
@@ -706,6 +778,157 @@ func TerminateWorkflow(c *cli.Context) {
 	}
 }
 
+// PauseWorkflow pauses a workflow execution, its decision and activity tasks are held until it is resumed
+func PauseWorkflow(c *cli.Context) {
+	serviceClient := getServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	reason := c.String(FlagReason)
+
+	ctx, cancel := newContext()
+	defer cancel()
+	err := serviceClient.PauseWorkflowExecution(ctx, &serverShared.PauseWorkflowExecutionRequest{
+		Domain: common.StringPtr(domain),
+		WorkflowExecution: &serverShared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      getPtrOrNilIfEmpty(rid),
+		},
+		Reason:   getPtrOrNilIfEmpty(reason),
+		Identity: common.StringPtr(getCliIdentity()),
+	})
+
+	if err != nil {
+		ErrorAndExit("Pause workflow failed", err)
+	} else {
+		fmt.Println("Pause workflow succeed.")
+	}
+}
+
+// ResumeWorkflow resumes a paused workflow execution
+func ResumeWorkflow(c *cli.Context) {
+	serviceClient := getServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	reason := c.String(FlagReason)
+
+	ctx, cancel := newContext()
+	defer cancel()
+	err := serviceClient.ResumeWorkflowExecution(ctx, &serverShared.ResumeWorkflowExecutionRequest{
+		Domain: common.StringPtr(domain),
+		WorkflowExecution: &serverShared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      getPtrOrNilIfEmpty(rid),
+		},
+		Reason:   getPtrOrNilIfEmpty(reason),
+		Identity: common.StringPtr(getCliIdentity()),
+	})
+
+	if err != nil {
+		ErrorAndExit("Resume workflow failed", err)
+	} else {
+		fmt.Println("Resume workflow succeed.")
+	}
+}
+
+// RetryActivity dispatches a pending activity immediately instead of waiting for its retry backoff
+func RetryActivity(c *cli.Context) {
+	serviceClient := getServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	activityID := getRequiredOption(c, FlagActivityID)
+	reason := getRequiredOption(c, FlagReason)
+
+	ctx, cancel := newContext()
+	defer cancel()
+	err := serviceClient.RetryActivityTask(ctx, &serverShared.RetryActivityTaskRequest{
+		Domain: common.StringPtr(domain),
+		WorkflowExecution: &serverShared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      getPtrOrNilIfEmpty(rid),
+		},
+		ActivityID:    common.StringPtr(activityID),
+		ResetAttempts: common.BoolPtr(c.Bool(FlagResetAttempts)),
+		Reason:        common.StringPtr(reason),
+		Identity:      common.StringPtr(getCliIdentity()),
+	})
+
+	if err != nil {
+		ErrorAndExit("Retry activity failed", err)
+	} else {
+		fmt.Println("Retry activity succeed.")
+	}
+}
+
+// CompleteActivity completes a pending activity on behalf of the worker
+func CompleteActivity(c *cli.Context) {
+	serviceClient := getServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	activityID := getRequiredOption(c, FlagActivityID)
+	result := getRequiredOption(c, FlagResult)
+	reason := getRequiredOption(c, FlagReason)
+
+	ctx, cancel := newContext()
+	defer cancel()
+	err := serviceClient.CompleteActivityTask(ctx, &serverShared.CompleteActivityTaskRequest{
+		Domain: common.StringPtr(domain),
+		WorkflowExecution: &serverShared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      getPtrOrNilIfEmpty(rid),
+		},
+		ActivityID: common.StringPtr(activityID),
+		Result:     []byte(result),
+		Reason:     common.StringPtr(reason),
+		Identity:   common.StringPtr(getCliIdentity()),
+	})
+
+	if err != nil {
+		ErrorAndExit("Complete activity failed", err)
+	} else {
+		fmt.Println("Complete activity succeed.")
+	}
+}
+
+// FailActivity fails a pending activity on behalf of the worker, the activity is not retried
+func FailActivity(c *cli.Context) {
+	serviceClient := getServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	activityID := getRequiredOption(c, FlagActivityID)
+	reason := getRequiredOption(c, FlagReason)
+	detail := c.String(FlagDetail)
+
+	ctx, cancel := newContext()
+	defer cancel()
+	err := serviceClient.FailActivityTask(ctx, &serverShared.FailActivityTaskRequest{
+		Domain: common.StringPtr(domain),
+		WorkflowExecution: &serverShared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      getPtrOrNilIfEmpty(rid),
+		},
+		ActivityID: common.StringPtr(activityID),
+		Reason:     common.StringPtr(reason),
+		Details:    []byte(detail),
+		Identity:   common.StringPtr(getCliIdentity()),
+	})
+
+	if err != nil {
+		ErrorAndExit("Fail activity failed", err)
+	} else {
+		fmt.Println("Fail activity succeed.")
+	}
+}
+
 // CancelWorkflow cancels a workflow execution
 func CancelWorkflow(c *cli.Context) {
 	wfClient := getWorkflowClient(c)
@@ -877,14 +1100,22 @@ func DescribeWorkflowWithID(c *cli.Context) {
 }
 
 func describeWorkflowHelper(c *cli.Context, wid, rid string) {
-	wfClient := getWorkflowClient(c)
+	// using server frontend client as the pause state is not known by the client library
+	serviceClient := getServerFrontendClient(c)
 
+	domain := getRequiredGlobalOption(c, FlagDomain)
 	printRawTime := c.Bool(FlagPrintRawTime) // default show datetime instead of raw time
 
 	ctx, cancel := newContext()
 	defer cancel()
 
-	resp, err := wfClient.DescribeWorkflowExecution(ctx, wid, rid)
+	resp, err := serviceClient.DescribeWorkflowExecution(ctx, &serverShared.DescribeWorkflowExecutionRequest{
+		Domain: common.StringPtr(domain),
+		Execution: &serverShared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      getPtrOrNilIfEmpty(rid),
+		},
+	})
 	if err != nil {
 		ErrorAndExit("Describe workflow execution failed", err)
 	}
@@ -909,31 +1140,32 @@ func prettyPrintJSONObject(o interface{}) {
 
 // describeWorkflowExecutionResponse is used to print datetime instead of print raw time
 type describeWorkflowExecutionResponse struct {
-	ExecutionConfiguration *s.WorkflowExecutionConfiguration
+	ExecutionConfiguration *serverShared.WorkflowExecutionConfiguration
 	WorkflowExecutionInfo  workflowExecutionInfo
 	PendingActivities      []*pendingActivityInfo
+	Paused                 *bool
 }
 
 // workflowExecutionInfo has same fields as shared.WorkflowExecutionInfo, but has datetime instead of raw time
 type workflowExecutionInfo struct {
-	Execution     *s.WorkflowExecution
-	Type          *s.WorkflowType
+	Execution     *serverShared.WorkflowExecution
+	Type          *serverShared.WorkflowType
 	StartTime     *string // change from *int64
 	CloseTime     *string // change from *int64
-	CloseStatus   *s.WorkflowExecutionCloseStatus
+	CloseStatus   *serverShared.WorkflowExecutionCloseStatus
 	HistoryLength *int64
 }
 
 // pendingActivityInfo has same fields as shared.PendingActivityInfo, but different field type for better display
 type pendingActivityInfo struct {
 	ActivityID             *string
-	ActivityType           *s.ActivityType
-	State                  *s.PendingActivityState
+	ActivityType           *serverShared.ActivityType
+	State                  *serverShared.PendingActivityState
 	HeartbeatDetails       *string // change from byte[]
 	LastHeartbeatTimestamp *string // change from *int64
 }
 
-func convertDescribeWorkflowExecutionResponse(resp *s.DescribeWorkflowExecutionResponse) *describeWorkflowExecutionResponse {
+func convertDescribeWorkflowExecutionResponse(resp *serverShared.DescribeWorkflowExecutionResponse) *describeWorkflowExecutionResponse {
 	info := resp.WorkflowExecutionInfo
 	executionInfo := workflowExecutionInfo{
 		Execution:     info.Execution,
@@ -960,6 +1192,7 @@ func convertDescribeWorkflowExecutionResponse(resp *s.DescribeWorkflowExecutionR
 		ExecutionConfiguration: resp.ExecutionConfiguration,
 		WorkflowExecutionInfo:  executionInfo,
 		PendingActivities:      pendingActs,
+		Paused:                 resp.Paused,
 	}
 }
 
@@ -1183,6 +1416,15 @@ func getWorkflowServiceClient(c *cli.Context) workflowserviceclient.Interface {
 	return client
 }
 
+func getServerFrontendClient(c *cli.Context) serverFrontend.Interface {
+	client, err := cBuilder.BuildServerFrontendClient(c)
+	if err != nil {
+		ExitIfError(err)
+	}
+
+	return client
+}
+
 func getRequiredOption(c *cli.Context, optionName string) string {
 	value := c.String(optionName)
 	if len(value) == 0 {
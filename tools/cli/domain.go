@@ -148,6 +148,33 @@ func newDomainCommands() []cli.Command {
 				UpdateDomain(c)
 			},
 		},
+		{
+			Name:    "failover",
+			Aliases: []string{"fo"},
+			Usage:   "Failover global domain to another cluster",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagActiveClusterNameWithAlias,
+					Usage: "Target active cluster name",
+				},
+				cli.BoolFlag{
+					Name:  FlagGracefulWithAlias,
+					Usage: "Drain the replication tasks of the domain before handing it over to the target cluster",
+				},
+				cli.IntFlag{
+					Name:  FlagFailoverTimeoutWithAlias,
+					Value: defaultGracefulFailoverTimeout,
+					Usage: "Graceful failover only, the domain is handed over once timed out even if replication is not drained",
+				},
+				cli.StringFlag{
+					Name:  FlagSecurityTokenWithAlias,
+					Usage: "Security token with permission",
+				},
+			},
+			Action: func(c *cli.Context) {
+				FailoverDomain(c)
+			},
+		},
 		{
 			Name:    "describe",
 			Aliases: []string{"desc"},
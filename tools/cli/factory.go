@@ -23,8 +23,9 @@ package cli
 import (
 	"errors"
 
+	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
+	serverFrontend "github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
 	"github.com/urfave/cli"
-	"go.uber.org/cadence/.gen/go/admin/adminserviceclient"
 	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
 	"go.uber.org/yarpc"
 	"go.uber.org/yarpc/transport/tchannel"
@@ -43,6 +44,7 @@ const (
 type WorkflowClientBuilderInterface interface {
 	BuildServiceClient(c *cli.Context) (workflowserviceclient.Interface, error)
 	BuildAdminServiceClient(c *cli.Context) (adminserviceclient.Interface, error)
+	BuildServerFrontendClient(c *cli.Context) (serverFrontend.Interface, error)
 }
 
 // WorkflowClientBuilder build client to cadence service
@@ -100,6 +102,25 @@ func (b *WorkflowClientBuilder) BuildAdminServiceClient(c *cli.Context) (adminse
 	return adminserviceclient.New(b.dispatcher.ClientConfig(_cadenceFrontendService)), nil
 }
 
+// BuildServerFrontendClient builds a rpc service client to cadence frontend service using the server IDL,
+// which also covers the APIs not yet exposed by the client library
+func (b *WorkflowClientBuilder) BuildServerFrontendClient(c *cli.Context) (serverFrontend.Interface, error) {
+	b.hostPort = localHostPort
+	if addr := c.GlobalString(FlagAddress); addr != "" {
+		b.hostPort = addr
+	}
+
+	if err := b.build(); err != nil {
+		return nil, err
+	}
+
+	if b.dispatcher == nil {
+		b.logger.Fatal("No RPC dispatcher provided to create a connection to Cadence Service")
+	}
+
+	return serverFrontend.New(b.dispatcher.ClientConfig(_cadenceFrontendService)), nil
+}
+
 func (b *WorkflowClientBuilder) build() error {
 	if b.dispatcher != nil {
 		return nil
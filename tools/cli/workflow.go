@@ -269,6 +269,54 @@ func newWorkflowCommands() []cli.Command {
 				TerminateWorkflow(c)
 			},
 		},
+		{
+			Name:  "pause",
+			Usage: "pause a workflow execution, decision and activity tasks are held until it is resumed",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "The reason you want to pause the workflow",
+				},
+			},
+			Action: func(c *cli.Context) {
+				PauseWorkflow(c)
+			},
+		},
+		{
+			Name:  "resume",
+			Usage: "resume a paused workflow execution",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "The reason you want to resume the workflow",
+				},
+			},
+			Action: func(c *cli.Context) {
+				ResumeWorkflow(c)
+			},
+		},
+		{
+			Name:        "activity",
+			Aliases:     []string{"act"},
+			Usage:       "operate on pending activities of a workflow execution",
+			Subcommands: newActivityCommands(),
+		},
 		{
 			Name:        "list",
 			Aliases:     []string{"l"},
@@ -520,3 +568,95 @@ func newWorkflowCommands() []cli.Command {
 		},
 	}
 }
+
+func newActivityCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "retry",
+			Usage: "dispatch a pending activity now instead of waiting for its retry backoff",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagActivityIDWithAlias,
+					Usage: "ActivityID of the pending activity",
+				},
+				cli.BoolFlag{
+					Name:  FlagResetAttemptsWithAlias,
+					Usage: "Reset the attempt counter of the activity",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "The reason you want to retry the activity",
+				},
+			},
+			Action: func(c *cli.Context) {
+				RetryActivity(c)
+			},
+		},
+		{
+			Name:  "complete",
+			Usage: "complete a pending activity with the given result",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagActivityIDWithAlias,
+					Usage: "ActivityID of the pending activity",
+				},
+				cli.StringFlag{
+					Name:  FlagResultWithAlias,
+					Usage: "Result of the activity",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "The reason you want to complete the activity",
+				},
+			},
+			Action: func(c *cli.Context) {
+				CompleteActivity(c)
+			},
+		},
+		{
+			Name:  "fail",
+			Usage: "fail a pending activity, the activity is not retried",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagActivityIDWithAlias,
+					Usage: "ActivityID of the pending activity",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "The reason you want to fail the activity",
+				},
+				cli.StringFlag{
+					Name:  FlagDetailWithAlias,
+					Usage: "Details of the failure",
+				},
+			},
+			Action: func(c *cli.Context) {
+				FailActivity(c)
+			},
+		},
+	}
+}